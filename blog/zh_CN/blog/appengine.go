@@ -11,12 +11,13 @@ package main
 import (
 	"net/http"
 
-	"golang.org/x/tools/blog"
+	"github.com/golang-china/golangdoc.translations/internal/blog"
 )
 
 func init() {
 	config.ContentPath = "content/"
 	config.TemplatePath = "template/"
+	config.TagNamesPath = "content/_tr/tags.txt"
 	s, err := blog.NewServer(config)
 	if err != nil {
 		panic(err)
@@ -11,13 +11,16 @@ import (
 	"strings"
 	"time"
 
-	"golang.org/x/tools/blog"
+	"github.com/golang-china/golangdoc.translations/internal/blog"
 	"golang.org/x/tools/godoc/static"
 
 	_ "golang.org/x/tools/playground"
 )
 
-const hostname = "blog.golang.org" // default hostname for blog server
+const (
+	hostname    = "blog.golang-china.appspot.com" // default hostname for blog server
+	upstreamURL = "https://blog.golang.org"       // the blog the articles are translated from
+)
 
 var config = blog.Config{
 	Hostname:     hostname,
@@ -27,6 +30,13 @@ var config = blog.Config{
 	FeedArticles: 10, // articles to include in Atom and JSON feeds
 	PlayEnabled:  true,
 	FeedTitle:    "The Go Programming Language Blog",
+
+	Language:    "zh-CN",
+	UpstreamURL: upstreamURL,
+	Sitemap:     true,
+	OpenGraph:   true,
+	SiteName:    "Go 语言博客",
+	Description: "Go 语言官方博客的中文翻译。",
 }
 
 func init() {
@@ -0,0 +1,43 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/golang-china/golangdoc.translations/internal/bilingual"
+)
+
+// checkContent checks the translated articles in the content directory as
+// selected by the -check flag. It exits the process in "only" mode and when
+// problems are found in "fail" mode.
+func checkContent(mode, root string) {
+	switch mode {
+	case "off":
+		return
+	case "warn", "fail", "only":
+	default:
+		log.Fatalf("invalid -check value %q", mode)
+	}
+	problems, err := bilingual.CheckDir(root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, p := range problems {
+		fmt.Fprintln(os.Stderr, p)
+	}
+	switch {
+	case len(problems) > 0 && mode != "warn":
+		log.Fatalf("%d problems found in %s", len(problems), root)
+	case mode == "only":
+		os.Exit(0)
+	case len(problems) > 0:
+		log.Printf("%d problems found in %s", len(problems), root)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/golang-china/golangdoc.translations/internal/blog"
+	"golang.org/x/tools/godoc/static"
+)
+
+// exportBlog writes a static copy of the blog served by s to dir, together
+// with the files the stand-alone server serves under /lib/godoc/ and
+// /static/.
+func exportBlog(s *blog.Server, dir, staticDir string) error {
+	files := make(map[string][]byte)
+	for name, b := range static.Files {
+		files[path.Join("/lib/godoc", name)] = []byte(b)
+	}
+	err := filepath.Walk(staticDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(staticDir, p)
+		if err != nil {
+			return err
+		}
+		files[path.Join("/static", filepath.ToSlash(rel))] = b
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return s.Export(dir, files)
+}
@@ -10,45 +10,121 @@ package main
 
 import (
 	"flag"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"time"
 
-	"golang.org/x/tools/blog"
+	"github.com/golang-china/golangdoc.translations/internal/blog"
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
 )
 
 var (
 	httpAddr     = flag.String("http", "localhost:8080", "HTTP listen address")
+	host         = flag.String("hostname", hostname, "host name used in permalinks, feeds and the sitemap")
 	contentPath  = flag.String("content", "content/", "path to content files")
 	templatePath = flag.String("template", "template/", "path to template files")
 	staticPath   = flag.String("static", "static/", "path to static files")
-	reload       = flag.Bool("reload", false, "reload content on each page load")
+	tagNamesPath = flag.String("tagnames", "content/_tr/tags.txt", "path to tag display names (optional)")
+	reload       = flag.Bool("reload", false, "reload content and templates when they change")
+	check        = flag.String("check", "off", `check translated articles on start: "off", "warn", "fail" (refuse to start on problems) or "only" (check and exit)`)
+
+	reloadInterval = flag.Duration("reload_interval", time.Second, "how often to check for changes in -reload mode")
+	reloadEvents   = flag.Bool("reload_events", false, "in -reload mode, make open pages reload themselves on changes")
+
+	exportDir = flag.String("export", "", "if set, write a static copy of the blog to this directory and exit")
+
+	play        = flag.String("play", "remote", `where to run playground snippets: "remote" (golang.org) or "local"`)
+	playTimeout = flag.Duration("play_timeout", 10*time.Second, "time limit for running a snippet locally")
+
+	tlsCert = flag.String("tls_cert", "", "TLS certificate file; if set with -tls_key, serve HTTPS")
+	tlsKey  = flag.String("tls_key", "", "TLS private key file")
+
+	readTimeout     = flag.Duration("read_timeout", 10*time.Second, "maximum duration for reading a request")
+	writeTimeout    = flag.Duration("write_timeout", 30*time.Second, "maximum duration for writing a response (0 for none; always none with -reload_events, whose event streams stay open)")
+	idleTimeout     = flag.Duration("idle_timeout", 2*time.Minute, "how long to keep idle keep-alive connections open")
+	shutdownTimeout = flag.Duration("shutdown_timeout", 10*time.Second, "how long to wait for requests in progress when shutting down")
+
+	accessLog = flag.String("access_log", "", `file to append JSON access log lines to ("-" for standard error)`)
+	compress  = flag.Bool("gzip", true, "compress textual responses for clients that accept gzip")
 )
 
 func main() {
 	flag.Parse()
+	checkContent(*check, *contentPath)
+	config.Hostname = *host
+	config.BaseURL = "//" + *host
 	config.ContentPath = *contentPath
 	config.TemplatePath = *templatePath
-	if *reload {
-		http.HandleFunc("/", reloadingBlogServer)
-	} else {
-		s, err := blog.NewServer(config)
-		if err != nil {
+	config.TagNamesPath = *tagNamesPath
+	config.ReloadEvents = *reload && *reloadEvents
+	s, err := blog.NewServer(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *exportDir != "" {
+		if err := exportBlog(s, *exportDir, *staticPath); err != nil {
 			log.Fatal(err)
 		}
-		http.Handle("/", s)
+		return
+	}
+	if *reload {
+		go s.Watch(*reloadInterval)
 	}
+	http.Handle("/", s)
 	fs := http.FileServer(http.Dir(*staticPath))
 	http.Handle("/static/", http.StripPrefix("/static/", fs))
-	log.Fatal(http.ListenAndServe(*httpAddr, nil))
-}
 
-// reloadingBlogServer is an handler that restarts the blog server on each page
-// view. Inefficient; don't enable by default. Handy when editing blog content.
-func reloadingBlogServer(w http.ResponseWriter, r *http.Request) {
-	s, err := blog.NewServer(config)
-	if err != nil {
-		http.Error(w, err.Error(), 500)
-		return
+	// The playground package registers a /compile handler that forwards
+	// snippets to golang.org; route them to a local runner instead if asked.
+	mux := http.NewServeMux()
+	m := newMetrics(s)
+	mux.Handle("/healthz", healthz(s))
+	mux.Handle("/metrics", m)
+	switch *play {
+	case "remote":
+	case "local":
+		mux.Handle("/compile", sandbox.NewRunner(sandbox.Config{Timeout: *playTimeout}))
+	default:
+		log.Fatalf("invalid -play value %q", *play)
+	}
+	mux.Handle("/", http.DefaultServeMux)
+
+	var h http.Handler = mux
+	if *compress {
+		h = gzipHandler(h)
+	}
+	var logw io.Writer
+	switch *accessLog {
+	case "":
+	case "-":
+		logw = os.Stderr
+	default:
+		f, err := os.OpenFile(*accessLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		logw = f
+	}
+	h = logAccess(h, logw, m)
+
+	srv := &http.Server{
+		Addr:         *httpAddr,
+		Handler:      h,
+		ReadTimeout:  *readTimeout,
+		WriteTimeout: *writeTimeout,
+		IdleTimeout:  *idleTimeout,
+	}
+	if config.ReloadEvents {
+		// The write timeout would cut the event streams of the open
+		// pages, which last until the pages are closed, or until the
+		// server shuts down.
+		srv.WriteTimeout = 0
+		srv.RegisterOnShutdown(s.CloseEvents)
+	}
+	if err := serve(srv, *tlsCert, *tlsKey, *shutdownTimeout); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
 	}
-	s.ServeHTTP(w, r)
 }
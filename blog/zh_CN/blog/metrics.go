@@ -0,0 +1,108 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/blog"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the request latency
+// histogram.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// metrics counts the requests served by the stand-alone server and exports
+// the counts, together with the number of articles, in the Prometheus text
+// format.
+type metrics struct {
+	blog  *blog.Server
+	start time.Time
+
+	mu      sync.Mutex
+	codes   map[int]int64 // requests by status code
+	buckets []int64       // requests by latency bucket (not cumulative)
+	count   int64
+	sum     float64 // total latency in seconds
+}
+
+func newMetrics(s *blog.Server) *metrics {
+	return &metrics{
+		blog:    s,
+		start:   time.Now(),
+		codes:   make(map[int]int64),
+		buckets: make([]int64, len(latencyBuckets)+1),
+	}
+}
+
+// observe records a request that was answered with the given status code
+// after d.
+func (m *metrics) observe(code int, d time.Duration) {
+	sec := d.Seconds()
+	i := sort.SearchFloat64s(latencyBuckets, sec)
+	m.mu.Lock()
+	m.codes[code]++
+	m.buckets[i]++
+	m.count++
+	m.sum += sec
+	m.mu.Unlock()
+}
+
+// ServeHTTP writes the metrics.
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	fmt.Fprintln(w, "# HELP blog_http_requests_total Number of HTTP requests served, by status code.")
+	fmt.Fprintln(w, "# TYPE blog_http_requests_total counter")
+	var codes []int
+	for c := range m.codes {
+		codes = append(codes, c)
+	}
+	sort.Ints(codes)
+	for _, c := range codes {
+		fmt.Fprintf(w, "blog_http_requests_total{code=\"%d\"} %d\n", c, m.codes[c])
+	}
+
+	fmt.Fprintln(w, "# HELP blog_http_request_duration_seconds Latency of HTTP requests.")
+	fmt.Fprintln(w, "# TYPE blog_http_request_duration_seconds histogram")
+	var cum int64
+	for i, le := range latencyBuckets {
+		cum += m.buckets[i]
+		fmt.Fprintf(w, "blog_http_request_duration_seconds_bucket{le=\"%g\"} %d\n", le, cum)
+	}
+	fmt.Fprintf(w, "blog_http_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", m.count)
+	fmt.Fprintf(w, "blog_http_request_duration_seconds_sum %g\n", m.sum)
+	fmt.Fprintf(w, "blog_http_request_duration_seconds_count %d\n", m.count)
+
+	fmt.Fprintln(w, "# HELP blog_articles Number of articles being served.")
+	fmt.Fprintln(w, "# TYPE blog_articles gauge")
+	fmt.Fprintf(w, "blog_articles %d\n", m.blog.NumDocs())
+
+	fmt.Fprintln(w, "# HELP blog_uptime_seconds Time since the server started.")
+	fmt.Fprintln(w, "# TYPE blog_uptime_seconds gauge")
+	fmt.Fprintf(w, "blog_uptime_seconds %g\n", time.Since(m.start).Seconds())
+}
+
+// healthz reports that the server is up and has articles to serve.
+func healthz(s *blog.Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		if s.NumDocs() == 0 {
+			http.Error(w, "no articles loaded", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	}
+}
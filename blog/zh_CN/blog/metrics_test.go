@@ -0,0 +1,124 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHealthz(t *testing.T) {
+	s, dir := newTestServer(t, map[string]string{"test.article": testArticle})
+	defer os.RemoveAll(dir)
+	w := get(healthz(s), "/healthz")
+	if w.Code != http.StatusOK || w.Body.String() != "ok\n" {
+		t.Errorf("healthz: status %d, body %q; want 200 ok", w.Code, w.Body)
+	}
+
+	empty, dir := newTestServer(t, nil)
+	defer os.RemoveAll(dir)
+	w = get(healthz(empty), "/healthz")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("healthz without articles: status %d, want 503", w.Code)
+	}
+}
+
+// testHandler returns the handler of a stand-alone server of one article,
+// without compression, that logs to log, with its metrics and a function that
+// removes its content.
+func testHandler(t *testing.T, log io.Writer) (http.Handler, *metrics, func()) {
+	s, dir := newTestServer(t, map[string]string{"test.article": testArticle})
+	m := newMetrics(s)
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", healthz(s))
+	mux.Handle("/metrics", m)
+	mux.Handle("/", s)
+	return logAccess(mux, log, m), m, func() { os.RemoveAll(dir) }
+}
+
+func TestMetrics(t *testing.T) {
+	h, m, cleanup := testHandler(t, nil)
+	defer cleanup()
+	for _, p := range []string{"/test", "/healthz", "/missing"} {
+		get(h, p)
+	}
+	m.observe(http.StatusOK, 30*time.Second) // beyond the last bucket
+
+	w := get(h, "/metrics")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	metrics := make(map[string]string)
+	sc := bufio.NewScanner(w.Body)
+	for sc.Scan() {
+		if line := sc.Text(); !strings.HasPrefix(line, "#") {
+			f := strings.Fields(line)
+			metrics[f[0]] = f[1]
+		}
+	}
+	for name, want := range map[string]string{
+		`blog_http_requests_total{code="200"}`:                 "3",
+		`blog_http_requests_total{code="404"}`:                 "1",
+		`blog_http_request_duration_seconds_bucket{le="10"}`:   "3",
+		`blog_http_request_duration_seconds_bucket{le="+Inf"}`: "4",
+		`blog_http_request_duration_seconds_count`:             "4",
+		`blog_articles`: "1",
+	} {
+		if got := metrics[name]; got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if sum := metrics["blog_http_request_duration_seconds_sum"]; !strings.HasPrefix(sum, "30") {
+		t.Errorf("blog_http_request_duration_seconds_sum = %q, want 30.x", sum)
+	}
+	if metrics["blog_uptime_seconds"] == "" {
+		t.Error("blog_uptime_seconds is missing")
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	var log bytes.Buffer
+	h, _, cleanup := testHandler(t, &log)
+	defer cleanup()
+	w := get(h, "/missing?x=1", "Referer", "http://example.com/", "User-Agent", "test")
+
+	var e accessEntry
+	dec := json.NewDecoder(&log)
+	if err := dec.Decode(&e); err != nil {
+		t.Fatalf("access log %q: %v", log.String(), err)
+	}
+	if dec.More() {
+		t.Error("more than one access log line for one request")
+	}
+	want := accessEntry{
+		Remote:    "192.0.2.1", // of httptest.NewRequest
+		Method:    "GET",
+		URI:       "/missing?x=1",
+		Proto:     "HTTP/1.1",
+		Status:    http.StatusNotFound,
+		Bytes:     int64(w.Body.Len()),
+		Referer:   "http://example.com/",
+		UserAgent: "test",
+	}
+	if _, err := time.Parse(time.RFC3339Nano, e.Time); err != nil {
+		t.Errorf("time: %v", err)
+	}
+	if e.Duration < 0 {
+		t.Errorf("duration = %v, want >= 0", e.Duration)
+	}
+	e.Time, e.Duration = "", 0
+	if e != want {
+		t.Errorf("access log entry = %+v, want %+v", e, want)
+	}
+}
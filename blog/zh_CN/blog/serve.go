@@ -0,0 +1,254 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+// This file implements the HTTP plumbing of the stand-alone blog server:
+// access logging, compression and graceful shutdown.
+
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// serve runs srv until it fails or the process receives SIGINT or SIGTERM, in
+// which case it stops accepting connections and waits up to grace for the
+// requests in progress to finish.
+func serve(srv *http.Server, certFile, keyFile string, grace time.Duration) error {
+	tls := certFile != "" || keyFile != ""
+	addr := srv.Addr
+	if addr == "" {
+		addr = ":http"
+		if tls {
+			addr = ":https"
+		}
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	return serveUntil(srv, l, certFile, keyFile, grace, sig)
+}
+
+// serveUntil runs srv on l until it fails or a signal is received from
+// stop, and then shuts srv down as serve does.
+func serveUntil(srv *http.Server, l net.Listener, certFile, keyFile string, grace time.Duration, stop <-chan os.Signal) error {
+	errc := make(chan error, 1)
+	go func() {
+		if certFile != "" || keyFile != "" {
+			errc <- srv.ServeTLS(l, certFile, keyFile)
+		} else {
+			errc <- srv.Serve(l)
+		}
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case s := <-stop:
+		log.Printf("received %v, shutting down", s)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	return srv.Shutdown(ctx)
+}
+
+// responseRecorder records the status code and size of a response.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (w *responseRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *responseRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// accessEntry is one line of the access log.
+type accessEntry struct {
+	Time      string  `json:"time"`
+	Remote    string  `json:"remote"`
+	Method    string  `json:"method"`
+	URI       string  `json:"uri"`
+	Proto     string  `json:"proto"`
+	Status    int     `json:"status"`
+	Bytes     int64   `json:"bytes"`
+	Duration  float64 `json:"duration_ms"`
+	Referer   string  `json:"referer,omitempty"`
+	UserAgent string  `json:"user_agent,omitempty"`
+}
+
+// logAccess returns a handler that serves requests with h and writes one
+// JSON object per request to w, and records the request in m.
+func logAccess(h http.Handler, w io.Writer, m *metrics) http.Handler {
+	var mu sync.Mutex
+	enc := json.NewEncoder(w)
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &responseRecorder{ResponseWriter: rw}
+		h.ServeHTTP(rec, r)
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		d := time.Since(start)
+		m.observe(rec.status, d)
+		if w == nil {
+			return
+		}
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		enc.Encode(accessEntry{
+			Time:      start.UTC().Format(time.RFC3339Nano),
+			Remote:    host,
+			Method:    r.Method,
+			URI:       r.RequestURI,
+			Proto:     r.Proto,
+			Status:    rec.status,
+			Bytes:     rec.size,
+			Duration:  float64(d) / float64(time.Millisecond),
+			Referer:   r.Referer(),
+			UserAgent: r.UserAgent(),
+		})
+	})
+}
+
+// gzipHandler returns a handler that serves requests with h and compresses
+// textual responses for clients that accept gzip encoding.
+func gzipHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" || !acceptsGzip(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		if inm := r.Header.Get("If-None-Match"); inm != "" {
+			// Undo the ETag suffix added by gzipWriter.
+			r.Header.Set("If-None-Match", strings.Replace(inm, gzipETagSuffix, `"`, -1))
+		}
+		gw := &gzipWriter{ResponseWriter: w}
+		defer gw.Close()
+		h.ServeHTTP(gw, r)
+	})
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(strings.SplitN(enc, ";", 2)[0]) == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
+// compressible reports whether responses of the given content type are
+// worth compressing. Event streams are not, as they must be delivered
+// promptly.
+func compressible(contentType string) bool {
+	t := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	switch {
+	case t == "text/event-stream":
+		return false
+	case strings.HasPrefix(t, "text/"), t == "image/svg+xml",
+		t == "application/json", t == "application/javascript",
+		t == "application/atom+xml", t == "application/xml":
+		return true
+	}
+	return false
+}
+
+// gzipETagSuffix marks the ETags of responses served to clients that accept
+// gzip encoding, as their representation differs from the plain one.
+const gzipETagSuffix = `-gzip"`
+
+// gzipWriter compresses the response written to it if its status and
+// content type allow; the decision is made when the header is written.
+type gzipWriter struct {
+	http.ResponseWriter
+	gz          *gzip.Writer
+	wroteHeader bool
+}
+
+func (w *gzipWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	h := w.Header()
+	if etag := h.Get("ETag"); strings.HasSuffix(etag, `"`) {
+		h.Set("ETag", strings.TrimSuffix(etag, `"`)+gzipETagSuffix)
+	}
+	if code == http.StatusOK && h.Get("Content-Encoding") == "" && h.Get("Content-Range") == "" && compressible(h.Get("Content-Type")) {
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", "gzip")
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *gzipWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.gz != nil {
+		return w.gz.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *gzipWriter) Flush() {
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close flushes any compressed data still buffered.
+func (w *gzipWriter) Close() error {
+	if w.gz != nil {
+		return w.gz.Close()
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !appengine
+
+package main
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/blog"
+)
+
+const testArticle = `Test article
+1 Jan 2015
+Tags: test
+
+Gopher
+
+* Section
+
+Hello, gopher.
+`
+
+// newTestServer returns a blog server of the templates of the blog and of
+// a temporary content directory holding the given files, keyed by name. The
+// caller must remove the directory, which newTestServer also returns.
+func newTestServer(t *testing.T, files map[string]string) (*blog.Server, string) {
+	dir, err := ioutil.TempDir("", "blog-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, text := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg := config
+	cfg.ContentPath = dir
+	cfg.TemplatePath = "../template"
+	cfg.TagNamesPath = ""
+	s, err := blog.NewServer(cfg)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return s, dir
+}
+
+func get(h http.Handler, path string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("GET", path, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestGzip(t *testing.T) {
+	s, dir := newTestServer(t, map[string]string{
+		"test.article": testArticle,
+		"test.png":     "\x89PNG\r\n\x1a\n",
+	})
+	defer os.RemoveAll(dir)
+	h := gzipHandler(s)
+
+	w := get(h, "/test", "Accept-Encoding", "deflate, gzip;q=0.8")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Errorf("Content-Encoding = %q, want gzip", got)
+	}
+	if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+		t.Errorf("Vary = %q, want Accept-Encoding", got)
+	}
+	etag := w.Header().Get("ETag")
+	if !strings.HasSuffix(etag, gzipETagSuffix) {
+		t.Errorf("ETag = %q, want suffix %s", etag, gzipETagSuffix)
+	}
+	zr, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), "Hello, gopher.") {
+		t.Errorf("decompressed body does not hold the article:\n%s", body)
+	}
+
+	w = get(h, "/test")
+	if got := w.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Content-Encoding without Accept-Encoding = %q, want none", got)
+	}
+	if got := w.Header().Get("ETag"); strings.HasSuffix(got, gzipETagSuffix) || got == "" {
+		t.Errorf("ETag without Accept-Encoding = %q, want one without suffix", got)
+	}
+	if !strings.Contains(w.Body.String(), "Hello, gopher.") {
+		t.Errorf("body does not hold the article:\n%s", w.Body)
+	}
+
+	// Images are not compressed.
+	w = get(h, "/test.png", "Accept-Encoding", "gzip")
+	if w.Code != http.StatusOK {
+		t.Fatalf("image: status = %d, want 200", w.Code)
+	}
+	if got := w.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Content-Encoding of an image = %q, want none", got)
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	s, dir := newTestServer(t, map[string]string{"test.article": testArticle})
+	defer os.RemoveAll(dir)
+	for _, gz := range []bool{false, true} {
+		var h http.Handler = s
+		enc := "identity"
+		if gz {
+			h, enc = gzipHandler(s), "gzip"
+		}
+		w := get(h, "/test", "Accept-Encoding", enc)
+		etag, modified := w.Header().Get("ETag"), w.Header().Get("Last-Modified")
+		if w.Code != http.StatusOK || etag == "" || modified == "" {
+			t.Fatalf("gzip %v: status %d, ETag %q, Last-Modified %q; want 200 with both headers", gz, w.Code, etag, modified)
+		}
+
+		w = get(h, "/test", "Accept-Encoding", enc, "If-None-Match", etag)
+		if w.Code != http.StatusNotModified {
+			t.Errorf("gzip %v: If-None-Match: status %d, want 304", gz, w.Code)
+		}
+		if got := w.Header().Get("ETag"); got != etag {
+			t.Errorf("gzip %v: If-None-Match: ETag = %q, want %q", gz, got, etag)
+		}
+		if w.Body.Len() != 0 {
+			t.Errorf("gzip %v: If-None-Match: body of %d bytes, want none", gz, w.Body.Len())
+		}
+
+		w = get(h, "/test", "Accept-Encoding", enc, "If-None-Match", `"other"`)
+		if w.Code != http.StatusOK {
+			t.Errorf("gzip %v: If-None-Match of another ETag: status %d, want 200", gz, w.Code)
+		}
+
+		w = get(h, "/test", "Accept-Encoding", enc, "If-Modified-Since", modified)
+		if w.Code != http.StatusNotModified {
+			t.Errorf("gzip %v: If-Modified-Since: status %d, want 304", gz, w.Code)
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	started, release := make(chan bool), make(chan bool)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- true
+		<-release
+		w.Write([]byte("done"))
+	})}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan os.Signal, 1)
+	served := make(chan error, 1)
+	go func() { served <- serveUntil(srv, l, "", "", 10*time.Second, stop) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	res := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String() + "/")
+		if err != nil {
+			res <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		res <- result{string(b), err}
+	}()
+	<-started
+
+	stop <- syscall.SIGTERM
+	select {
+	case err := <-served:
+		t.Fatalf("server stopped with a request in progress: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if _, err := net.DialTimeout("tcp", l.Addr().String(), time.Second); err == nil {
+		t.Error("server accepts connections while shutting down")
+	}
+
+	close(release)
+	if r := <-res; r.err != nil || r.body != "done" {
+		t.Errorf("request in progress: body %q, error %v; want done", r.body, r.err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("serveUntil: %v", err)
+	}
+}
+
+func TestShutdownReloadEvents(t *testing.T) {
+	defer func(events bool) { config.ReloadEvents = events }(config.ReloadEvents)
+	config.ReloadEvents = true
+	s, dir := newTestServer(t, map[string]string{"test.article": testArticle})
+	defer os.RemoveAll(dir)
+	srv := &http.Server{Handler: logAccess(gzipHandler(s), nil, newMetrics(s))}
+	srv.RegisterOnShutdown(s.CloseEvents)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan os.Signal, 1)
+	served := make(chan error, 1)
+	go func() { served <- serveUntil(srv, l, "", "", 10*time.Second, stop) }()
+
+	resp, err := http.Get("http://" + l.Addr().String() + "/.reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+	ended := make(chan error, 1)
+	go func() {
+		_, err := ioutil.ReadAll(resp.Body)
+		ended <- err
+	}()
+
+	stop <- syscall.SIGTERM
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("serveUntil: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown waits for the reload event stream")
+	}
+	if err := <-ended; err != nil {
+		t.Errorf("reading the event stream: %v", err)
+	}
+}
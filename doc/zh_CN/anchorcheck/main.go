@@ -0,0 +1,130 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command anchorcheck checks that the translated documents keep the anchors
+// of the upstream English documents, so that links such as
+// go_spec.html#Method_sets and effective_go.html#interfaces lead to the
+// translation.
+//
+// Usage:
+//
+//	anchorcheck [-upstream=dir] [-fix [-fixlinks] [-root=dir]] [file.html ...]
+//
+// With no arguments, anchorcheck checks go_spec.html and effective_go.html
+// of doc/zh_CN, found in the current directory or its parent.
+//
+// The English blocks of a document are hidden and come before their
+// translation, so the translation must carry every anchor, id or name, of
+// the upstream document under its upstream name, the English blocks none of
+// them, and no anchor may be found twice. The productions of the EBNF
+// sections are anchors too, and keep their upstream names in the
+// translation. Anchorcheck reports the anchors that break these rules and
+// the links to anchors of the document, href="#...", that lead nowhere (see
+// internal/bilingual.CheckAnchors).
+//
+// The -upstream flag names the directory of the upstream English documents,
+// such as the doc directory of the Go release that the translation follows.
+// Without it, the upstream anchors are taken from the document itself: the
+// anchors of the English blocks, and those of the text that is not
+// translated.
+//
+// With -fix, anchorcheck moves the anchors of the English blocks to their
+// translation, renaming the anchors that the translation gave them and the
+// links to those, rewrites the documents and then checks them. The links of
+// the other files of the tree named by -root, the repository by default, to
+// the anchors renamed, such as [[/doc/effective_go.html#切片]] in a blog
+// article, are reported as problems, or rewritten with -fixlinks.
+//
+// Anchorcheck exits with status 1 if it finds problems.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-china/golangdoc.translations/internal/bilingual"
+)
+
+var (
+	upstream = flag.String("upstream", "", "directory of the upstream English documents (default: the English blocks of each document)")
+	fix      = flag.Bool("fix", false, "move the anchors of the English blocks to their translation")
+	fixLinks = flag.Bool("fixlinks", false, "with -fix, also rewrite the links of other files to the anchors renamed")
+	root     = flag.String("root", "", "tree whose links to the anchors renamed by -fix are checked (default: the repository of the documents)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: anchorcheck [-upstream=dir] [-fix [-fixlinks] [-root=dir]] [file.html ...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("anchorcheck: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	files := flag.Args()
+	if len(files) == 0 {
+		var err error
+		if files, err = defaultDocs(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	n := 0
+	for _, name := range files {
+		if *fix {
+			moved, renames, err := bilingual.FixAnchors(name, *upstream)
+			if err != nil {
+				log.Fatalf("%s: %v", name, err)
+			}
+			if moved > 0 {
+				log.Printf("%s: %d anchors moved", name, moved)
+			}
+			dir := *root
+			if dir == "" {
+				dir = filepath.Join(filepath.Dir(name), "..", "..")
+			}
+			links, err := bilingual.FixLinks(dir, name, renames, *fixLinks)
+			if err != nil {
+				log.Fatalf("%s: %v", name, err)
+			}
+			for _, l := range links {
+				if *fixLinks {
+					log.Print(l)
+					continue
+				}
+				fmt.Fprintln(os.Stderr, l)
+				n++
+			}
+		}
+		problems, err := bilingual.CheckAnchors(name, *upstream)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		for _, p := range problems {
+			fmt.Fprintln(os.Stderr, p)
+			n++
+		}
+	}
+	if n > 0 {
+		log.Fatalf("%d problems found", n)
+	}
+}
+
+// defaultDocs returns go_spec.html and effective_go.html of the current
+// directory or its parent.
+func defaultDocs() ([]string, error) {
+	for _, dir := range []string{".", ".."} {
+		spec := filepath.Join(dir, "go_spec.html")
+		if _, err := os.Stat(spec); err == nil {
+			return []string{spec, filepath.Join(dir, "effective_go.html")}, nil
+		}
+	}
+	return nil, fmt.Errorf("no documents found; run anchorcheck in doc/zh_CN or name the documents")
+}
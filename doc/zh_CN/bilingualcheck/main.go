@@ -0,0 +1,86 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command bilingualcheck checks that the English blocks of the translated
+// HTML documents are paired with their translation.
+//
+// Usage:
+//
+//	bilingualcheck [file.html ...]
+//
+// With no arguments, bilingualcheck checks the documents of doc/zh_CN, the
+// current directory or its parent, and of its subdirectories. Documents
+// without English blocks are not checked.
+//
+// Each <div class="english"> must be followed by its translation, either a
+// <div class="chinese"> or the text up to the next English block or to the
+// first heading that the English block has no counterpart for. It reports
+// the English blocks that are not translated, the Chinese divs that do not
+// follow an English block, the translations whose headings differ from
+// those of their English block, the ids of one language that have no
+// counterpart in the other, and, once for each stretch, the sections that
+// have no English block, such as the untranslated rest of a document (see
+// internal/bilingual.CheckHTML).
+//
+// Bilingualcheck exits with status 1 if it finds problems.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-china/golangdoc.translations/internal/bilingual"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: bilingualcheck [file.html ...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("bilingualcheck: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	var problems []bilingual.Problem
+	if flag.NArg() == 0 {
+		dir, err := defaultDoc()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if problems, err = bilingual.CheckHTMLDir(dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, name := range flag.Args() {
+		pp, err := bilingual.CheckHTML(name)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		problems = append(problems, pp...)
+	}
+
+	for _, p := range problems {
+		fmt.Fprintln(os.Stderr, p)
+	}
+	if len(problems) > 0 {
+		log.Fatalf("%d problems found", len(problems))
+	}
+}
+
+// defaultDoc returns the directory of the translated documents: the
+// current directory or its parent, whichever holds effective_go.html.
+func defaultDoc() (string, error) {
+	for _, dir := range []string{".", ".."} {
+		if _, err := os.Stat(filepath.Join(dir, "effective_go.html")); err == nil {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("no documents found; run bilingualcheck in doc/zh_CN or name the documents")
+}
@@ -0,0 +1,92 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command codewalkcheck checks the addresses of the steps of the translated
+// codewalks.
+//
+// Usage:
+//
+//	codewalkcheck [-doc=dir] [codewalk.xml ...]
+//
+// With no arguments, codewalkcheck checks the codewalks of
+// doc/zh_CN/codewalk: codewalk.xml, functions.xml, markov.xml and
+// sharemem.xml. The -doc flag names the directory of the translated
+// documents, doc/zh_CN, in which the files shown by the steps, such as
+// doc/codewalk/markov.go, are found; it defaults to the parent of the
+// directory of the first codewalk.
+//
+// It reports the addresses that match nothing in their file, the Chinese
+// steps of Go files that show other code than their English step, and the
+// steps without their English step or translation (see
+// internal/codewalk.Check).
+//
+// Codewalkcheck exits with status 1 if it finds problems.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-china/golangdoc.translations/internal/codewalk"
+)
+
+var docDir = flag.String("doc", "", "directory of the translated documents (default: found from the codewalks)")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: codewalkcheck [-doc=dir] [codewalk.xml ...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("codewalkcheck: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	files := flag.Args()
+	if len(files) == 0 {
+		var err error
+		if files, err = defaultCodewalks(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	dir := *docDir
+	if dir == "" {
+		dir = filepath.Dir(filepath.Dir(files[0]))
+	}
+
+	n := 0
+	for _, name := range files {
+		cw, err := codewalk.Read(name)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		for _, p := range codewalk.Check(dir, cw) {
+			fmt.Fprintln(os.Stderr, p)
+			n++
+		}
+	}
+	if n > 0 {
+		log.Fatalf("%d problems found", n)
+	}
+}
+
+// defaultCodewalks returns the codewalks in the codewalk directory of the
+// current directory or its parent.
+func defaultCodewalks() ([]string, error) {
+	for _, dir := range []string{".", ".."} {
+		files, err := filepath.Glob(filepath.Join(dir, "codewalk", "*.xml"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) > 0 {
+			return files, nil
+		}
+	}
+	return nil, fmt.Errorf("no codewalks found; run codewalkcheck in doc/zh_CN or name the codewalks")
+}
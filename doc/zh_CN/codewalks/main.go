@@ -0,0 +1,81 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command codewalks serves the translated codewalks of doc/zh_CN/codewalk
+// without godoc.
+//
+// The codewalks are shown as by godoc, with codewalk.js and codewalk.css:
+// the steps on one side, and on the other the file each step shows, with
+// the region selected by its address highlighted. The lang parameter of a
+// codewalk page chooses the steps shown:
+//
+//	zh    the Chinese steps, or the English ones that are not translated
+//	en    the English steps
+//	both  each Chinese step followed by its English step
+//
+// The codewalks are read from the directory at each request, so the XML
+// files dropped into it are listed and served without restarting the
+// server.
+//
+// Usage:
+//
+//	codewalks [-http=127.0.0.1:6060] [-doc=dir]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+var (
+	httpListen = flag.String("http", "127.0.0.1:6060", "host:port to listen on")
+	docDir     = flag.String("doc", "", "directory of the translated documents, holding the codewalk directory (default: the current directory or its parent)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: codewalks [-http=127.0.0.1:6060] [-doc=dir]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("codewalks: ")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		usage()
+	}
+
+	dir := *docDir
+	if dir == "" {
+		var err error
+		if dir, err = defaultDoc(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	http.Handle("/", http.RedirectHandler("/doc/codewalk/", http.StatusFound))
+	http.Handle("/doc/codewalk/", &server{docDir: dir})
+	http.HandleFunc("/lib/godoc/", serveStatic)
+
+	log.Printf("Serving the codewalks of %s", filepath.Join(dir, "codewalk"))
+	log.Printf("Open your web browser and visit http://%s/doc/codewalk/", *httpListen)
+	log.Fatal(http.ListenAndServe(*httpListen, nil))
+}
+
+// defaultDoc returns the directory of the translated documents: the
+// current directory or its parent, whichever holds the codewalks.
+func defaultDoc() (string, error) {
+	for _, dir := range []string{".", ".."} {
+		if fi, err := os.Stat(filepath.Join(dir, "codewalk")); err == nil && fi.IsDir() {
+			return filepath.Abs(dir)
+		}
+	}
+	return "", fmt.Errorf("no codewalks found; run codewalks in doc/zh_CN or use -doc")
+}
@@ -0,0 +1,316 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/address"
+	"github.com/golang-china/golangdoc.translations/internal/codewalk"
+	"golang.org/x/tools/godoc"
+	"golang.org/x/tools/godoc/static"
+)
+
+// startTime is the modification time of the static files of godoc.
+var startTime = time.Now()
+
+// A server serves /doc/codewalk/: the list of the codewalks, the codewalks,
+// the files they show and the files of the codewalk directory.
+type server struct {
+	docDir string // directory of the translated documents
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f := r.FormValue("fileprint"); f != "" {
+		s.serveFileprint(w, r, f)
+		return
+	}
+	dir := filepath.Join(s.docDir, "codewalk")
+	rel := strings.TrimPrefix(path.Clean(r.URL.Path), "/doc/codewalk")
+	if rel == "" || rel == "/" {
+		s.serveDir(w, r, dir)
+		return
+	}
+	name := filepath.Join(dir, filepath.FromSlash(rel))
+	if fi, err := os.Stat(name); err == nil && !fi.IsDir() {
+		http.ServeFile(w, r, name)
+		return
+	}
+	cw, err := codewalk.Read(name + ".xml")
+	if err != nil {
+		log.Print(err)
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	// Canonicalize the path, as godoc does.
+	if canonical := path.Clean(r.URL.Path) + "/"; r.URL.Path != canonical {
+		u := *r.URL
+		u.Path = canonical
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+		return
+	}
+	s.serveCodewalk(w, r, cw)
+}
+
+// A dirEntry is a codewalk of the list of the codewalks.
+type dirEntry struct {
+	Name         string
+	Title        string
+	EnglishTitle string
+	Err          error
+}
+
+// serveDir serves the list of the codewalks of the directory dir.
+func (s *server) serveDir(w http.ResponseWriter, r *http.Request, dir string) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.xml"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sort.Strings(files)
+	var entries []dirEntry
+	for _, f := range files {
+		e := dirEntry{Name: strings.TrimSuffix(filepath.Base(f), ".xml")}
+		if cw, err := codewalk.Read(f); err != nil {
+			e.Err = err
+		} else {
+			e.Title, e.EnglishTitle = cw.Title, cw.EnglishTitle
+		}
+		entries = append(entries, e)
+	}
+	render(w, dirTemplate, struct {
+		Title     string
+		Codewalks []dirEntry
+	}{Codewalks: entries})
+}
+
+// The languages of the steps shown, as chosen by the lang parameter.
+var langs = []struct{ Code, Name string }{
+	{"zh", "中文"},
+	{"en", "English"},
+	{"both", "中英对照"},
+}
+
+// A page is a codewalk as shown.
+type page struct {
+	Title string
+	Lang  string
+	Langs []struct{ Code, Name string }
+	Files []string // the files shown
+	Steps []*step
+}
+
+// A step is a step as shown: its text, in one language or both, and the
+// region of code it shows.
+type step struct {
+	Title        string
+	XML          template.HTML
+	EnglishTitle string        // with lang=both
+	EnglishXML   template.HTML // with lang=both
+	File         string
+	Lo, Hi       int // lines of the region; 0 for the whole file
+	Err          error
+}
+
+// Link returns the address of the code pane of s.
+func (s *step) Link() template.URL {
+	mark := s.Lo - 4 // show a few lines before the region
+	if mark < 1 {
+		mark = 1
+	}
+	return template.URL(fmt.Sprintf("/doc/codewalk/?fileprint=/%s&lo=%d&hi=%d#L%d", s.File, s.Lo, s.Hi, mark))
+}
+
+// String returns the file and lines of s, as godoc shows them.
+func (s *step) String() string {
+	str := s.File
+	if s.Lo != 0 || s.Hi != 0 {
+		str += fmt.Sprintf(":%d", s.Lo)
+		if s.Lo != s.Hi {
+			str += fmt.Sprintf(",%d", s.Hi)
+		}
+	}
+	return str
+}
+
+// serveCodewalk serves the steps of cw in the language chosen by the
+// request.
+func (s *server) serveCodewalk(w http.ResponseWriter, r *http.Request, cw *codewalk.Codewalk) {
+	p := &page{Title: cw.Title, Lang: r.FormValue("lang"), Langs: langs}
+	switch p.Lang {
+	case "en", "both":
+	default:
+		p.Lang = "zh"
+	}
+	if p.Lang == "en" && cw.EnglishTitle != "" {
+		p.Title = cw.EnglishTitle
+	}
+	files := make(map[string]bool)
+	for _, pair := range cw.Pairs {
+		shown, other := pair.Chinese, pair.English
+		if p.Lang == "en" {
+			shown, other = other, shown
+		}
+		if shown == nil {
+			shown, other = other, nil
+		}
+		st := s.step(shown)
+		if p.Lang == "both" && other != nil {
+			st.EnglishTitle, st.EnglishXML = other.Title, template.HTML(other.XML)
+		}
+		p.Steps = append(p.Steps, st)
+		files[st.File] = true
+	}
+	for f := range files {
+		p.Files = append(p.Files, f)
+	}
+	sort.Strings(p.Files)
+	render(w, codewalkTemplate, p)
+}
+
+// step returns the step showing cs, with the lines of its region.
+func (s *server) step(cs *codewalk.Step) *step {
+	st := &step{Title: cs.Title, XML: template.HTML(cs.XML), File: cs.File()}
+	if cs.Addr() == "" {
+		return st
+	}
+	data, err := ioutil.ReadFile(codewalk.Path(s.docDir, st.File))
+	if err != nil {
+		st.Err = err
+		return st
+	}
+	lo, hi, err := cs.Range(data)
+	if err != nil {
+		st.Err = err
+		return st
+	}
+	st.Lo = address.Line(data, lo)
+	st.Hi = st.Lo
+	if hi > lo {
+		st.Hi = address.Line(data, hi-1)
+	}
+	return st
+}
+
+// cleanFile returns the name of the file f of a step or a request, without
+// leading slash, as serveFileprint compares them.
+func cleanFile(f string) string {
+	return strings.TrimPrefix(path.Clean("/"+f), "/")
+}
+
+// shown reports whether the file f is shown by a step of a codewalk of the
+// directory, in either language.
+func (s *server) shown(f string) bool {
+	files, err := filepath.Glob(filepath.Join(s.docDir, "codewalk", "*.xml"))
+	if err != nil {
+		return false
+	}
+	for _, name := range files {
+		cw, err := codewalk.Read(name)
+		if err != nil {
+			continue
+		}
+		for _, pair := range cw.Pairs {
+			for _, cs := range []*codewalk.Step{pair.English, pair.Chinese} {
+				if cs != nil && cleanFile(cs.File()) == f {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// serveFileprint serves the code pane of the file f, a file shown by a
+// step, with the lines lo to hi of the request highlighted. Go files are
+// also syntax highlighted. The files not shown by any step are not served,
+// as codewalk.Path maps them out of the translated documents.
+func (s *server) serveFileprint(w http.ResponseWriter, r *http.Request, f string) {
+	f = cleanFile(f)
+	if !s.shown(f) {
+		http.NotFound(w, r)
+		return
+	}
+	data, err := ioutil.ReadFile(codewalk.Path(s.docDir, f))
+	if err != nil {
+		log.Print(err)
+		http.NotFound(w, r)
+		return
+	}
+	lo, _ := strconv.Atoi(r.FormValue("lo"))
+	hi, _ := strconv.Atoi(r.FormValue("hi"))
+	var sel godoc.Selection
+	if lo > 0 {
+		if hi < lo {
+			hi = lo
+		}
+		sel = godoc.RangeSelection(fmt.Sprintf("%d:%d", lineToByte(data, lo), lineToByte(data, hi+1)))
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`<!DOCTYPE html><meta charset="utf-8">`)
+	b.WriteString(`<link type="text/css" rel="stylesheet" href="/lib/godoc/style.css">`)
+	b.WriteString(`<style type="text/css">@import "/doc/codewalk/codewalk.css";</style><pre>`)
+	godoc.FormatText(&b, data, 1, path.Ext(f) == ".go", "", sel)
+	b.WriteString("</pre>")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(b.Bytes())
+}
+
+// lineToByte returns the offset of the first byte of line n of data,
+// counting from 1.
+func lineToByte(data []byte, n int) int {
+	if n <= 1 {
+		return 0
+	}
+	n--
+	for i, c := range data {
+		if c == '\n' {
+			if n--; n == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(data)
+}
+
+// serveStatic serves the style sheet and scripts of godoc.
+func serveStatic(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/lib/godoc/")
+	s, ok := static.Files[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeContent(w, r, name, startTime, strings.NewReader(s))
+}
+
+func render(w http.ResponseWriter, t *template.Template, data interface{}) {
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		log.Print(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.Copy(w, &b)
+}
@@ -0,0 +1,29 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFileprint(t *testing.T) {
+	s := &server{docDir: ".."}
+	for file, want := range map[string]int{
+		"/doc/codewalk/pig.go":          http.StatusOK,
+		"doc/codewalk/markov.go":        http.StatusOK,
+		"/doc/codewalk/../../README.md": http.StatusNotFound,
+		"/README.md":                    http.StatusNotFound,
+		"/.git/config":                  http.StatusNotFound,
+		"/doc/codewalk/codewalk.css":    http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest("GET", "/doc/codewalk/?fileprint="+file, nil))
+		if w.Code != want {
+			t.Errorf("fileprint %s: status %d, want %d", file, w.Code, want)
+		}
+	}
+}
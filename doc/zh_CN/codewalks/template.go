@@ -0,0 +1,134 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "html/template"
+
+// The pages are those of godoc/static/codewalk.html and codewalkdir.html,
+// in a page of their own rather than in that of godoc.
+var (
+	codewalkTemplate = template.Must(template.New("codewalk").Parse(header + codewalkHTML + footer))
+	dirTemplate      = template.Must(template.New("codewalkdir").Parse(header + dirHTML + footer))
+)
+
+const header = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<title>{{with .Title}}Codewalk: {{.}}{{else}}Codewalks{{end}}</title>
+<link type="text/css" rel="stylesheet" href="/lib/godoc/style.css">
+<style type="text/css">
+#lang-switch { float: right; font-size: 14px; }
+#lang-switch a.selected { font-weight: bold; color: #222; text-decoration: none; }
+.comment-english { color: #666; border-top: 1px dotted #ccc; margin-top: 6px; }
+.comment-english .comment-title { font-size: 14px; }
+</style>
+<script type="text/javascript">window.initFuncs = [];</script>
+<script type="text/javascript" src="/lib/godoc/jquery.js"></script>
+</head>
+<body>
+<div id="page" class="wide">
+<div class="container">
+`
+
+const footer = `
+</div>
+</div>
+<script type="text/javascript">
+$(function() {
+  for (var i = 0; i < window.initFuncs.length; i++) window.initFuncs[i]();
+});
+</script>
+</body>
+</html>
+`
+
+const codewalkHTML = `
+<style type='text/css'>@import "/doc/codewalk/codewalk.css";</style>
+<script type="text/javascript" src="/doc/codewalk/codewalk.js"></script>
+
+<div id="lang-switch">
+  {{$lang := .Lang}}
+  {{range $i, $l := .Langs}}{{if $i}} &bull; {{end}}<a href="?lang={{$l.Code}}"{{if eq $l.Code $lang}} class="selected"{{end}}>{{$l.Name}}</a>{{end}}
+  &bull; <a href="/doc/codewalk/">代码漫步列表</a>
+</div>
+<h1>Codewalk: {{.Title}}</h1>
+
+<div id="codewalk-main">
+  <div class="left" id="code-column">
+    <div id='sizer'></div>
+    <div id="code-area">
+      <div id="code-header" align="center">
+        <a id="code-popout-link" href="" target="_blank">
+          <img title="View code in new window" alt="Pop Out Code" src="/doc/codewalk/popout.png" style="display: block; float: right;"/>
+        </a>
+        <select id="code-selector">
+          {{range .Files}}
+          <option value="/doc/codewalk/?fileprint=/{{.}}">{{.}}</option>
+          {{end}}
+        </select>
+      </div>
+      <div id="code">
+        <iframe class="code-display" name="code-display" id="code-display"></iframe>
+      </div>
+    </div>
+    <div id="code-options" class="setting">
+      <span>code on <a id="set-code-left" class="selected" href="#">left</a> &bull; <a id="set-code-right" href="#">right</a></span>
+      <span>code width <span id="code-column-width">70%</span></span>
+      <span>filepaths <a id="show-filepaths" class="selected" href="#">shown</a> &bull; <a id="hide-filepaths" href="#">hidden</a></span>
+    </div>
+  </div>
+  <div class="right" id="comment-column">
+    <div id="comment-area">
+      {{range .Steps}}
+      <div class="comment first last">
+        <a class="comment-link" href="{{.Link}}" target="code-display"></a>
+        <div class="comment-title">{{.Title}}</div>
+        <div class="comment-text">
+        {{with .Err}}
+        ERROR LOADING FILE: {{.}}<br/><br/>
+        {{end}}
+        {{.XML}}
+        </div>
+        {{if .EnglishXML}}
+        <div class="comment-english">
+          <div class="comment-title">{{.EnglishTitle}}</div>
+          <div class="comment-text">{{.EnglishXML}}</div>
+        </div>
+        {{end}}
+        <div class="comment-text file-name"><span class="path-file">{{.String}}</span></div>
+      </div>
+      {{end}}
+    </div>
+    <div id="comment-options" class="setting">
+      <a id="prev-comment" href="#"><span class="hotkey">p</span>revious step</a>
+      &bull;
+      <a id="next-comment" href="#"><span class="hotkey">n</span>ext step</a>
+    </div>
+  </div>
+</div>
+`
+
+const dirHTML = `
+<h1>Codewalks</h1>
+
+<table class="layout">
+{{range .Codewalks}}
+<tr>
+	<td><a href="{{.Name}}/">{{.Name}}</a></td>
+	<td width="25">&nbsp;</td>
+	{{if .Err}}
+	<td>{{.Err}}</td>
+	{{else}}
+	<td>{{.Title}}</td>
+	<td width="25">&nbsp;</td>
+	<td>{{.EnglishTitle}}</td>
+	<td width="25">&nbsp;</td>
+	<td><a href="{{.Name}}/?lang=en">English</a> &bull; <a href="{{.Name}}/?lang=both">中英对照</a></td>
+	{{end}}
+</tr>
+{{end}}
+</table>
+`
@@ -0,0 +1,275 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The tests in this file build the example programs of the documents, in
+// progs and codewalk, and run those whose output is shown, so they take a
+// while; they are skipped in short mode. They replace the run scripts of
+// both directories.
+//
+// The first line of each program of progs says what is done with it:
+//
+//	// cmpout   build and run it, and compare its output with <name>.out
+//	// run      build and run it; its output is not compared
+//	// compile  only build it
+//	// skip     it needs cgo, and is only built if cgo is available;
+//	            or it is meant not to build, and must fail to
+//
+// The programs of the codewalks have no such line, as the steps address
+// their lines; their modes are listed in codewalkModes. The input of a
+// program run, if any, is <name>.in.
+//
+// The output of a program is its standard output and standard error. With
+// -update, the .out files of the programs that compare their output are
+// written rather than compared.
+
+var update = flag.Bool("update", false, "update the .out files of the programs")
+
+const programTimeout = 10 * time.Second
+
+// Modes of programs.
+const (
+	modeCmpout  = "cmpout"
+	modeRun     = "run"
+	modeCompile = "compile"
+	modeSkip    = "skip"
+)
+
+// codewalkModes lists the modes of the programs of the codewalks.
+var codewalkModes = map[string]string{
+	"markov.go":  modeCmpout,
+	"pig.go":     modeCmpout,  // with math/rand seeded as before Go 1.20
+	"urlpoll.go": modeCompile, // it uses the network
+}
+
+// cgoBroken lists the cgo programs that do not build or run on some
+// systems.
+var cgoBroken = map[string][]string{
+	"cgo1.go": {"freebsd", "netbsd"}, // srandom has a different signature
+	"cgo2.go": {"freebsd", "netbsd"}, // srandom has a different signature
+	"cgo3.go": {"netbsd", "openbsd"}, // cgo cannot handle stdout correctly
+	"cgo4.go": {"netbsd", "openbsd"}, // cgo cannot handle stdout correctly
+}
+
+// A program is an example program of the documents.
+type program struct {
+	dir  string // progs or codewalk
+	name string // file name
+	mode string
+	cgo  bool // it imports "C"
+}
+
+func (p *program) path() string {
+	return filepath.Join(p.dir, p.name)
+}
+
+// file returns the name of the file of p with the extension ext in place
+// of .go, such as progs/defer.out.
+func (p *program) file(ext string) string {
+	return strings.TrimSuffix(p.path(), ".go") + ext
+}
+
+// programs returns the programs of progs and codewalk.
+func programs(t *testing.T) []*program {
+	var progs []*program
+	for _, dir := range []string{"progs", "codewalk"} {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range files {
+			p := &program{dir: dir, name: filepath.Base(f)}
+			var err error
+			if dir == "codewalk" {
+				p.mode = codewalkModes[p.name]
+				if p.mode == "" {
+					err = fmt.Errorf("no mode listed in codewalkModes")
+				}
+			} else {
+				p.mode, err = directive(f)
+			}
+			if err == nil {
+				p.cgo, err = importsC(f)
+			}
+			if err != nil {
+				t.Errorf("%s: %v", f, err)
+				continue
+			}
+			progs = append(progs, p)
+		}
+	}
+	if len(progs) == 0 {
+		t.Fatal("no programs found")
+	}
+	return progs
+}
+
+// directive returns the mode given by the first line of the named file.
+func directive(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	sc.Scan()
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+	mode := strings.TrimSpace(strings.TrimPrefix(sc.Text(), "//"))
+	switch mode {
+	case modeCmpout, modeRun, modeCompile, modeSkip:
+		return mode, nil
+	}
+	return "", fmt.Errorf("first line %q is not one of // cmpout, run, compile or skip", sc.Text())
+}
+
+// importsC reports whether the named Go file imports "C".
+func importsC(name string) (bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), name, nil, parser.ImportsOnly)
+	if err != nil {
+		return false, err
+	}
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == "C" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cgoEnabled reports whether the go command builds with cgo.
+func cgoEnabled(t *testing.T) bool {
+	out, err := exec.Command("go", "env", "CGO_ENABLED").Output()
+	if err != nil {
+		t.Fatalf("go env CGO_ENABLED: %v", err)
+	}
+	return strings.TrimSpace(string(out)) == "1"
+}
+
+// build builds the program p into the directory dir and returns the name of
+// the executable, if p is a command, and the output of the go command.
+func build(p *program, dir string) (exe string, out []byte, err error) {
+	exe = filepath.Join(dir, strings.TrimSuffix(p.name, ".go"))
+	cmd := exec.Command("go", "build", "-o", exe, p.name)
+	cmd.Dir = p.dir
+	out, err = cmd.CombinedOutput()
+	return exe, out, err
+}
+
+// run runs the executable exe of the program p, in the directory of p, and
+// returns its output.
+func run(p *program, exe string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), programTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, exe)
+	cmd.Dir = p.dir
+	// Seed math/rand as before Go 1.20, for the output of pig.
+	cmd.Env = append(os.Environ(), "GODEBUG=randautoseed=0")
+	if in, err := ioutil.ReadFile(p.file(".in")); err == nil {
+		cmd.Stdin = bytes.NewReader(in)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		return out, fmt.Errorf("program took longer than %v", programTimeout)
+	}
+	return out, err
+}
+
+func TestPrograms(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	cgo := cgoEnabled(t)
+	tmp, err := ioutil.TempDir("", "progs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	// The subtests are run in a group, so that tmp is removed after them.
+	t.Run("group", func(t *testing.T) {
+		for _, p := range programs(t) {
+			p := p
+			t.Run(p.dir+"/"+p.name, func(t *testing.T) {
+				t.Parallel()
+				testProgram(t, p, cgo, tmp)
+			})
+		}
+	})
+}
+
+func testProgram(t *testing.T, p *program, cgo bool, tmp string) {
+	if p.cgo {
+		if !cgo {
+			t.Skip("cgo is not available")
+		}
+		for _, goos := range cgoBroken[p.name] {
+			if goos == runtime.GOOS {
+				t.Skipf("does not work on %s", goos)
+			}
+		}
+	}
+	dir := filepath.Join(tmp, p.dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	exe, out, err := build(p, dir)
+	if p.mode == modeSkip && !p.cgo {
+		if err == nil {
+			t.Errorf("program built, want build error")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	if p.mode == modeSkip || p.mode == modeCompile {
+		return
+	}
+
+	got, err := run(p, exe)
+	if err != nil {
+		t.Fatalf("run failed: %v\n%s", err, got)
+	}
+	if p.mode == modeRun {
+		return
+	}
+	golden := p.file(".out")
+	if *update {
+		if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s\ngot:\n%s\nwant:\n%s", golden, got, want)
+	}
+}
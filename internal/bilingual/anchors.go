@@ -0,0 +1,310 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bilingual
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// The English blocks of a translated document are hidden, but for the
+// readers who ask for them, and come before their translation: a link such
+// as go_spec.html#Method_sets must lead to the translation, which must
+// carry the anchors of the upstream English document under their upstream
+// names, each once, while the English blocks carry none of them.
+
+// CheckAnchors checks the anchors, the id and name attributes, of the named
+// translated document against those of the upstream English document. It
+// reports the upstream anchors that the document lacks or has only in an
+// English block, the anchors that are not upstream anchors, such as the
+// anchors renamed in Chinese, the anchors found more than once, and the
+// links to anchors of the document, href="#...", that lead nowhere.
+//
+// The productions of the EBNF sections keep their upstream names in the
+// translations, so that godoc links their uses to them; those of an English
+// block are not anchors of their own when its translation defines them
+// again.
+//
+// If upstream is "", the upstream anchors are those of the document, but
+// for the anchors of the translations that are renamed copies of those of
+// their English block.
+func CheckAnchors(name, upstream string) ([]Problem, error) {
+	doc, problems, err := readDocument(name)
+	if err != nil {
+		return problems, err
+	}
+	up, err := doc.upstreamAnchors(upstream)
+	if err != nil {
+		return problems, err
+	}
+	report := func(file string, line int, format string, args ...interface{}) {
+		problems = append(problems, Problem{file, line, fmt.Sprintf(format, args...)})
+	}
+
+	all := make(map[string]*anchor)     // first anchor of each id
+	visible := make(map[string]*anchor) // first anchor of each id outside of the English blocks
+	renamed := doc.renamed()
+	repeated := doc.repeated()
+	for _, b := range doc.blocks {
+		for _, a := range b.anchors {
+			if a.id == "" || repeated[a] {
+				continue
+			}
+			if first := all[a.id]; first != nil {
+				report(name, a.line, "duplicate anchor %q, also at line %d", a.id, first.line)
+			} else {
+				all[a.id] = a
+			}
+			if b.lang != langEN && visible[a.id] == nil {
+				visible[a.id] = a
+			}
+			if up.line[a.id] > 0 {
+				continue
+			}
+			if en := renamed[a]; en != nil {
+				report(name, a.line, "anchor %q is renamed from %q of the English block", a.id, en.id)
+			} else {
+				report(name, a.line, "anchor %q is not an anchor of the upstream document", a.id)
+			}
+		}
+	}
+	for _, id := range up.ids {
+		switch {
+		case all[id] == nil:
+			report(up.name, up.line[id], "anchor %q is missing from %s", id, name)
+		case visible[id] == nil:
+			report(name, all[id].line, "anchor %q is only in an English block", id)
+		}
+	}
+	for _, l := range doc.links {
+		if all[l.target] == nil {
+			report(name, l.line, "link to #%s leads to no anchor", l.target)
+		}
+	}
+	sort.Stable(byLine(problems))
+	return problems, nil
+}
+
+// FixAnchors restores the upstream anchors of the named translated
+// document (see CheckAnchors) and rewrites it. Each anchor of an English
+// block is moved to its counterpart in the translation (see CheckHTML),
+// under its upstream name, and the links to the name the counterpart had
+// are made to follow it. It returns the number of anchors moved and the new
+// name of each anchor it renamed, keyed by the old one: the links of other
+// files to those are left to FixLinks. The problems that it cannot fix, such
+// as anchors missing from both languages, are left to CheckAnchors.
+func FixAnchors(name, upstream string) (moved int, renames map[string]string, err error) {
+	doc, _, err := readDocument(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	up, err := doc.upstreamAnchors(upstream)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	tags := make(map[tagPos][]byte) // rewritten start tags
+	raw := func(pos tagPos) []byte {
+		if t, ok := tags[pos]; ok {
+			return t
+		}
+		return doc.data[pos.off:pos.end]
+	}
+	renames = make(map[string]string) // new name by old name of the translations
+	for i, b := range doc.blocks {
+		if b.lang != langEN {
+			continue
+		}
+		tr := doc.translation(i)
+		if tr == nil {
+			continue
+		}
+		match, _ := counterparts(b.anchors, tr.anchors)
+		for k, a := range b.anchors {
+			if a.attr == "" || up.line[a.id] == 0 || match[k] < 0 {
+				continue
+			}
+			c := tr.anchors[match[k]]
+			if c.id != a.id {
+				if c.id != "" && up.line[c.id] > 0 {
+					continue // c has an anchor of its own
+				}
+				if c.id != "" {
+					renames[c.id] = a.id
+				}
+				tags[c.pos] = setAttr(raw(c.pos), anchorAttr(c, a), a.id)
+				c.id = a.id
+			}
+			tags[a.pos] = deleteAttr(raw(a.pos), a.attr)
+			moved++
+		}
+	}
+	for _, l := range doc.links {
+		if id, ok := renames[l.target]; ok {
+			tags[l.pos] = setAttr(raw(l.pos), "href", "#"+id)
+		}
+	}
+	if len(tags) == 0 {
+		return 0, nil, nil
+	}
+
+	var positions []tagPos
+	for pos := range tags {
+		positions = append(positions, pos)
+	}
+	sort.Sort(byOffset(positions))
+	var buf bytes.Buffer
+	last := 0
+	for _, pos := range positions {
+		buf.Write(doc.data[last:pos.off])
+		buf.Write(tags[pos])
+		last = pos.end
+	}
+	buf.Write(doc.data[last:])
+	fi, err := os.Stat(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	return moved, renames, ioutil.WriteFile(name, buf.Bytes(), fi.Mode())
+}
+
+// anchorAttr returns the attribute that the counterpart c of the English
+// anchor en carries its anchor in: its own, or else that of en.
+func anchorAttr(c, en *anchor) string {
+	if c.attr != "" {
+		return c.attr
+	}
+	return en.attr
+}
+
+// renamed returns the anchors of the translations that are renamed copies
+// of an anchor of their English block, keyed by the anchor of the
+// translation.
+func (doc *document) renamed() map[*anchor]*anchor {
+	renamed := make(map[*anchor]*anchor)
+	for i, b := range doc.blocks {
+		if b.lang != langEN {
+			continue
+		}
+		tr := doc.translation(i)
+		if tr == nil {
+			continue
+		}
+		match, _ := counterparts(b.anchors, tr.anchors)
+		for k, a := range b.anchors {
+			if match[k] < 0 {
+				continue
+			}
+			if c := tr.anchors[match[k]]; a.id != "" && c.id != "" && c.id != a.id {
+				renamed[c] = a
+			}
+		}
+	}
+	return renamed
+}
+
+// repeated returns the productions of the English blocks that their
+// translation defines again.
+func (doc *document) repeated() map[*anchor]bool {
+	repeated := make(map[*anchor]bool)
+	for i, b := range doc.blocks {
+		if b.lang != langEN {
+			continue
+		}
+		tr := doc.translation(i)
+		if tr == nil {
+			continue
+		}
+		match, _ := counterparts(b.anchors, tr.anchors)
+		for k, a := range b.anchors {
+			if a.attr == "" && a.id != "" && match[k] >= 0 && tr.anchors[match[k]].id == a.id {
+				repeated[a] = true
+			}
+		}
+	}
+	return repeated
+}
+
+// upstreamSet is the set of the anchors of an upstream document.
+type upstreamSet struct {
+	name string
+	ids  []string       // in order
+	line map[string]int // line by id
+}
+
+// upstreamAnchors returns the anchors of the upstream document of doc, the
+// file of the same name in the directory dir, or those derived from doc if
+// dir is "" (see CheckAnchors).
+func (doc *document) upstreamAnchors(dir string) (*upstreamSet, error) {
+	up := &upstreamSet{line: make(map[string]int)}
+	add := func(a *anchor) {
+		if a.id != "" && up.line[a.id] == 0 {
+			up.ids = append(up.ids, a.id)
+			up.line[a.id] = a.line
+		}
+	}
+	if dir == "" {
+		up.name = doc.name
+		renamed := doc.renamed()
+		for _, b := range doc.blocks {
+			for _, a := range b.anchors {
+				if renamed[a] == nil {
+					add(a)
+				}
+			}
+		}
+		return up, nil
+	}
+
+	up.name = filepath.Join(dir, filepath.Base(doc.name))
+	u, _, err := readDocument(up.name)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range u.blocks {
+		for _, a := range b.anchors {
+			add(a)
+		}
+	}
+	return up, nil
+}
+
+// attrRE returns the regexp that matches the attribute key, with the space
+// before it, in a start tag.
+func attrRE(key string) *regexp.Regexp {
+	return regexp.MustCompile(`\s+` + key + `\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+)`)
+}
+
+// setAttr returns the start tag tag with the attribute key set to val.
+func setAttr(tag []byte, key, val string) []byte {
+	attr := []byte(" " + key + `="` + html.EscapeString(val) + `"`)
+	re := attrRE(key)
+	if loc := re.FindIndex(tag); loc != nil {
+		return concat(tag[:loc[0]], attr, tag[loc[1]:])
+	}
+	i := bytes.IndexAny(tag, " \t\r\n/>")
+	return concat(tag[:i], attr, tag[i:])
+}
+
+// deleteAttr returns the start tag tag without the attribute key.
+func deleteAttr(tag []byte, key string) []byte {
+	return attrRE(key).ReplaceAll(tag, nil)
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+type byOffset []tagPos
+
+func (p byOffset) Len() int           { return len(p) }
+func (p byOffset) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byOffset) Less(i, j int) bool { return p[i].off < p[j].off }
@@ -0,0 +1,237 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bilingual checks translated articles written in present format.
+//
+// A translated article interleaves English and Chinese sections, each
+// delimited by includes of the _tr helper files:
+//
+//	.html _tr/div_begin_en.html
+//
+//	English text.
+//
+//	.html _tr/div_end.html
+//
+//	.html _tr/div_begin_zh_CN.html
+//
+//	中文译文。
+//
+//	.html _tr/div_end.html
+//
+// The checks verify that these sections are balanced and paired, that both
+// languages have the same headings, and that the files referred to by .code,
+// .play, .image, .iframe and .html directives exist.
+//
+// The translated HTML documents of doc/zh_CN are checked likewise (see
+// CheckHTML), and so are their anchors (see CheckAnchors).
+package bilingual // import "github.com/golang-china/golangdoc.translations/internal/bilingual"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// A Problem is a defect found in an article or a document.
+type Problem struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Msg)
+}
+
+// CheckDir checks every .article file in the tree rooted at root.
+// Absolute references in the articles are resolved relative to root.
+func CheckDir(root string) ([]Problem, error) {
+	var problems []Problem
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || filepath.Ext(p) != ".article" {
+			return nil
+		}
+		pp, err := CheckFile(root, p)
+		problems = append(problems, pp...)
+		return err
+	})
+	return problems, err
+}
+
+// Section delimiters, named by the base name of the included file.
+const (
+	beginEN = "div_begin_en.html"
+	beginZH = "div_begin_zh_CN.html"
+	end     = "div_end.html"
+)
+
+var (
+	isHeading = regexp.MustCompile(`^\*+ `)
+	codeRE    = regexp.MustCompile(`^\.(code|play)\s+((?:(?:-edit|-numbers)\s+)*)([^\s]+)`)
+)
+
+// section is an open or recently closed language section.
+type section struct {
+	lang     string // beginEN or beginZH
+	line     int
+	headings []string // heading markers ("*", "**", ...) in order
+}
+
+// CheckFile checks the named article. Absolute references in the article are
+// resolved relative to root.
+func CheckFile(root, name string) ([]Problem, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		problems  []Problem
+		bilingual bool
+		open      *section // section being read
+		pendingEN *section // closed English section awaiting its translation
+		n         int
+	)
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, Problem{name, line, fmt.Sprintf(format, args...)})
+	}
+	var outside []int // lines of headings outside any section
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		n++
+		line := sc.Text()
+		switch {
+		case isHeading.MatchString(line):
+			if strings.TrimLeft(line, "* ") == "" {
+				// An empty heading only starts the article body.
+				continue
+			}
+			if open != nil {
+				open.headings = append(open.headings, strings.Fields(line)[0])
+			} else {
+				outside = append(outside, n)
+			}
+			continue
+		case !strings.HasPrefix(line, "."):
+			continue
+		}
+
+		args := strings.Fields(line)
+		switch args[0] {
+		case ".html":
+			if len(args) < 2 {
+				continue
+			}
+			switch path.Base(args[1]) {
+			case beginEN, beginZH:
+				bilingual = true
+				lang := path.Base(args[1])
+				if open != nil {
+					report(open.line, "%s is not closed before the section at line %d", open.lang, n)
+				}
+				if lang == beginEN && pendingEN != nil {
+					report(pendingEN.line, "English section is not followed by a Chinese section")
+				}
+				if lang == beginZH && pendingEN == nil {
+					report(n, "Chinese section does not follow an English section")
+				}
+				open = &section{lang: lang, line: n}
+				continue
+			case end:
+				bilingual = true
+				if open == nil {
+					report(n, "%s without a matching div_begin", end)
+					continue
+				}
+				if open.lang == beginEN {
+					pendingEN = open
+				} else if pendingEN != nil {
+					if !sameHeadings(pendingEN.headings, open.headings) {
+						report(open.line, "headings %v do not match those of the English section at line %d %v",
+							open.headings, pendingEN.line, pendingEN.headings)
+					}
+					pendingEN = nil
+				}
+				open = nil
+				continue
+			}
+			checkRef(root, name, n, args[1], report)
+		case ".code", ".play":
+			if m := codeRE.FindStringSubmatch(line); m != nil {
+				checkRef(root, name, n, m[3], report)
+			} else {
+				report(n, "syntax error in %s directive", args[0])
+			}
+		case ".image", ".iframe":
+			if len(args) < 2 {
+				report(n, "missing URL in %s directive", args[0])
+				continue
+			}
+			checkRef(root, name, n, args[1], report)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return problems, err
+	}
+	if open != nil {
+		report(open.line, "%s is never closed", open.lang)
+	}
+	if pendingEN != nil {
+		report(pendingEN.line, "English section is not followed by a Chinese section")
+	}
+	if bilingual {
+		for _, line := range outside {
+			report(line, "heading outside of a language section")
+		}
+	}
+	sort.Stable(byLine(problems))
+	return problems, nil
+}
+
+// checkRef reports a problem if ref, found at the given line of the named
+// article, refers to a local file that does not exist.
+func checkRef(root, name string, line int, ref string, report func(int, string, ...interface{})) {
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, "//") {
+		return // not a local file
+	}
+	if i := strings.IndexAny(ref, "?#"); i >= 0 {
+		ref = ref[:i]
+	}
+	var p string
+	if strings.HasPrefix(ref, "/") {
+		p = filepath.Join(root, filepath.FromSlash(ref))
+	} else {
+		p = filepath.Join(filepath.Dir(name), filepath.FromSlash(ref))
+	}
+	if _, err := os.Stat(p); err != nil {
+		report(line, "%s: no such file", ref)
+	}
+}
+
+func sameHeadings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type byLine []Problem
+
+func (p byLine) Len() int           { return len(p) }
+func (p byLine) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byLine) Less(i, j int) bool { return p[i].Line < p[j].Line }
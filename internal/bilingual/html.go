@@ -0,0 +1,370 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bilingual
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// The translated HTML documents of doc/zh_CN interleave English and Chinese
+// blocks. Each English block is a div of class english, and is followed by
+// its translation: a div of class chinese, or the text up to the next
+// English block. Text of the latter kind ends before a heading that the
+// English block has no counterpart for, as the text from there on, such as
+// the sections of a document that are not translated yet, has no English
+// block.
+//
+//	<div class="english">
+//	<h2 id="introduction">Introduction</h2>
+//	</div>
+//
+//	<h2 id="引言">引言</h2>
+
+// Languages of the blocks of an HTML document.
+const (
+	langEN   = "english"
+	langZH   = "chinese"
+	langBare = "" // text outside of the language divs
+)
+
+// A document is a translated HTML document split into its blocks.
+type document struct {
+	name    string
+	data    []byte
+	blocks  []*block
+	links   []*link
+	english bool // it has English blocks
+}
+
+// A block is a language div of an HTML document, or the text between such
+// divs.
+type block struct {
+	lang     string
+	line     int
+	headings []string  // heading tags ("h2", "h3", ...) in order
+	anchors  []*anchor // the elements, in order
+	en       *block    // for text outside of the divs, the English block it translates, if any
+}
+
+// An anchor is an element with an id or name attribute; the other
+// elements are kept as anchors without id, as the counterparts of the
+// anchors of the other language. The productions of the EBNF sections of
+// the spec, <pre class="ebnf">, are anchors too, as godoc makes them
+// anchors when it links their names to their definition.
+type anchor struct {
+	tag  string
+	attr string // "id" or "name"; "" for a production or an element without id
+	id   string
+	line int
+	pos  tagPos // of the start tag
+}
+
+// A link is an element with an href attribute to an anchor of its
+// document, such as href="#Method_sets".
+type link struct {
+	target string // without #
+	line   int
+	pos    tagPos // of the start tag
+}
+
+// A tagPos is the position of a start tag in its document.
+type tagPos struct {
+	off, end int
+}
+
+// CheckHTMLDir checks every .html file in the tree rooted at root that has
+// English blocks.
+func CheckHTMLDir(root string) ([]Problem, error) {
+	var problems []Problem
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || filepath.Ext(p) != ".html" {
+			return nil
+		}
+		pp, err := CheckHTML(p)
+		problems = append(problems, pp...)
+		return err
+	})
+	return problems, err
+}
+
+// CheckHTML checks the named HTML document. It pairs each English block
+// with its translation, and reports the English blocks that are not
+// translated, the Chinese divs that do not follow an English block, the
+// pairs whose headings differ, and, once for each stretch, the sections
+// outside of the pairs. As the translations name their anchors in
+// Chinese, the ids of a pair are compared by the elements that carry them:
+// an element of the English block with an id must be matched by an element
+// of the same tag with an id in the translation, and an element of the
+// translation with an id by an element of the same tag in the English
+// block, which may have no id (see CheckAnchors).
+//
+// Documents without English blocks are not checked.
+func CheckHTML(name string) ([]Problem, error) {
+	doc, problems, err := readDocument(name)
+	if err != nil || !doc.english {
+		return problems, err
+	}
+
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, Problem{name, line, fmt.Sprintf(format, args...)})
+	}
+	for i, b := range doc.blocks {
+		switch b.lang {
+		case langEN:
+			tr := doc.translation(i)
+			if tr == nil {
+				report(b.line, "English block is not followed by its translation")
+				continue
+			}
+			if !sameHeadings(b.headings, tr.headings) {
+				report(tr.line, "headings %v do not match those of the English block at line %d %v",
+					tr.headings, b.line, b.headings)
+			}
+			compareIDs(b, tr, report)
+		case langZH:
+			if i == 0 || doc.blocks[i-1].lang != langEN {
+				report(b.line, "Chinese block does not follow an English block")
+			}
+		case langBare:
+			if b.en == nil && len(b.headings) > 0 {
+				end := "the end"
+				if i+1 < len(doc.blocks) {
+					end = fmt.Sprintf("line %d", doc.blocks[i+1].line)
+				}
+				report(b.line, "text up to %s (%d headings) has no English block: it is not translated", end, len(b.headings))
+			}
+		}
+	}
+	sort.Stable(byLine(problems))
+	return problems, nil
+}
+
+// translation returns the translation of the English block i, or nil if it
+// has none.
+func (doc *document) translation(i int) *block {
+	if i+1 == len(doc.blocks) {
+		return nil
+	}
+	if tr := doc.blocks[i+1]; tr.lang == langZH || tr.en == doc.blocks[i] {
+		return tr
+	}
+	return nil
+}
+
+// compareIDs reports the anchors of the English block en that have no
+// counterpart with an id in its translation tr, and those of tr that have
+// no counterpart in en. The productions are not compared.
+func compareIDs(en, tr *block, report func(int, string, ...interface{})) {
+	match, used := counterparts(en.anchors, tr.anchors)
+	for i, a := range en.anchors {
+		if a.attr != "" && (match[i] < 0 || tr.anchors[match[i]].id == "") {
+			report(a.line, "id %q of <%s> has no counterpart in the translation at line %d", a.id, a.tag, tr.line)
+		}
+	}
+	for j, a := range tr.anchors {
+		if a.attr != "" && !used[j] {
+			report(a.line, "id %q of <%s> has no counterpart in the English block at line %d", a.id, a.tag, en.line)
+		}
+	}
+}
+
+// counterparts pairs the anchors of an English block, en, with those of its
+// translation, tr: first those of the same id, then those of the same tag,
+// in order. It returns the index in tr of the counterpart of each anchor of
+// en, or -1, and which anchors of tr have a counterpart.
+func counterparts(en, tr []*anchor) (match []int, used []bool) {
+	match = make([]int, len(en))
+	used = make([]bool, len(tr))
+	for i, a := range en {
+		match[i] = -1
+		if a.id == "" {
+			continue
+		}
+		for j, b := range tr {
+			if !used[j] && b.id == a.id {
+				match[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	for i, a := range en {
+		if match[i] >= 0 {
+			continue
+		}
+		for j, b := range tr {
+			if !used[j] && b.tag == a.tag {
+				match[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	return match, used
+}
+
+// productionRE matches the name of a production of an EBNF section.
+var productionRE = regexp.MustCompile(`(?m)^[ \t]*([\pL_][\pL\pN_]*)[ \t]*=[^=]`)
+
+// readDocument reads the named HTML document and splits it into its blocks.
+func readDocument(name string) (*document, []Problem, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc := &document{name: name, data: data}
+	var (
+		problems []Problem
+		open     *block  // language div being read
+		depth    int     // divs open in open
+		bare     *block  // text being read outside of the language divs
+		ebnf     *tagPos // <pre class="ebnf"> being read
+	)
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, Problem{name, line, fmt.Sprintf(format, args...)})
+	}
+	// startBare starts a block of text outside of the divs.
+	startBare := func(line int) {
+		bare = &block{lang: langBare, line: line}
+		if n := len(doc.blocks); n > 0 && doc.blocks[n-1].lang == langEN {
+			bare.en = doc.blocks[n-1]
+		}
+		doc.blocks = append(doc.blocks, bare)
+	}
+	n, off := 1, 0
+	z := html.NewTokenizer(bytes.NewReader(data))
+	for {
+		tt := z.Next()
+		raw := z.Raw()
+		line, pos := n, tagPos{off, off + len(raw)}
+		n += bytes.Count(raw, []byte("\n"))
+		off += len(raw)
+		if tt == html.ErrorToken {
+			if z.Err() == io.EOF {
+				break
+			}
+			return nil, problems, z.Err()
+		}
+		cur := open
+		if cur == nil {
+			cur = bare
+		}
+		switch tt {
+		case html.TextToken:
+			text := z.Text()
+			if cur == nil && len(bytes.TrimSpace(text)) > 0 {
+				startBare(line)
+			}
+			if ebnf != nil && cur != nil {
+				for _, m := range productionRE.FindAllSubmatchIndex(text, -1) {
+					l := line + bytes.Count(text[:m[0]], []byte("\n"))
+					cur.anchors = append(cur.anchors, &anchor{tag: "pre", id: string(text[m[2]:m[3]]), line: l, pos: *ebnf})
+				}
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if tok.DataAtom == atom.Pre && hasClass(tok, "ebnf") {
+				ebnf = &pos
+			}
+			if tok.DataAtom == atom.Div {
+				if lang := divLang(tok); lang != "" {
+					if open != nil {
+						report(line, "%s div nested in the %s div at line %d", lang, open.lang, open.line)
+						depth++
+						continue
+					}
+					doc.english = doc.english || lang == langEN
+					open, depth, bare = &block{lang: lang, line: line}, 1, nil
+					doc.blocks = append(doc.blocks, open)
+					continue
+				}
+				if open != nil && tt == html.StartTagToken {
+					depth++
+				}
+			}
+			if cur == nil || cur == bare && bare.en != nil && isHeadingTag(tok.DataAtom) &&
+				len(bare.headings) == len(bare.en.headings) {
+				// Text, or text beyond the headings of the
+				// English block it translates.
+				startBare(line)
+				cur = bare
+			}
+			if isHeadingTag(tok.DataAtom) {
+				cur.headings = append(cur.headings, tok.Data)
+			}
+			a := &anchor{tag: tok.Data, line: line, pos: pos}
+			for _, attr := range tok.Attr {
+				switch {
+				case attr.Key == "id", attr.Key == "name" && tok.DataAtom == atom.A:
+					a.attr, a.id = attr.Key, attr.Val
+				case attr.Key == "href" && strings.HasPrefix(attr.Val, "#") && len(attr.Val) > 1:
+					doc.links = append(doc.links, &link{attr.Val[1:], line, pos})
+				}
+			}
+			cur.anchors = append(cur.anchors, a)
+		case html.EndTagToken:
+			switch z.Token().DataAtom {
+			case atom.Div:
+				if open != nil {
+					if depth--; depth == 0 {
+						open = nil
+					}
+				}
+			case atom.Pre:
+				ebnf = nil
+			}
+		}
+	}
+	if open != nil {
+		report(open.line, "%s div is never closed", open.lang)
+	}
+	return doc, problems, nil
+}
+
+func isHeadingTag(a atom.Atom) bool {
+	switch a {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		return true
+	}
+	return false
+}
+
+// divLang returns the language of the div tok, or "" if it is not a
+// language div.
+func divLang(tok html.Token) string {
+	for _, lang := range []string{langEN, langZH} {
+		if hasClass(tok, lang) {
+			return lang
+		}
+	}
+	return ""
+}
+
+// hasClass reports whether the element tok is of the class c.
+func hasClass(tok html.Token, c string) bool {
+	for _, a := range tok.Attr {
+		if a.Key != "class" {
+			continue
+		}
+		for _, f := range strings.Fields(a.Val) {
+			if f == c {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bilingual
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// docAliases holds the other paths at which godoc serves the documents.
+var docAliases = map[string][]string{
+	"go_spec.html": {"/ref/spec"},
+}
+
+// linkExts are the extensions of the files searched for links to the
+// documents.
+var linkExts = map[string]bool{
+	".article": true,
+	".html":    true,
+	".md":      true,
+	".slide":   true,
+	".tmpl":    true,
+	".xml":     true,
+}
+
+// docLinkRE returns the regexp that matches the links to the anchors of the
+// named document, the anchor being its first group.
+func docLinkRE(name string) *regexp.Regexp {
+	var paths []string
+	for _, p := range append([]string{filepath.Base(name)}, docAliases[filepath.Base(name)]...) {
+		q := regexp.QuoteMeta(p)
+		if !strings.HasPrefix(p, "/") {
+			q = `\b` + q
+		}
+		paths = append(paths, q)
+	}
+	return regexp.MustCompile(`(?:` + strings.Join(paths, "|") + `)#([^\s"'<>\[\]()]+)`)
+}
+
+// FixLinks finds the links of the files in the tree rooted at root, other
+// than the named document, to the anchors of the document that renames
+// maps to new names, as FixAnchors returns them. It returns the links it
+// finds, and rewrites them to the new names if rewrite is set.
+func FixLinks(root, name string, renames map[string]string, rewrite bool) ([]Problem, error) {
+	if len(renames) == 0 {
+		return nil, nil
+	}
+	doc, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+	re := docLinkRE(name)
+	var problems []Problem
+	err = filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if fi.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !linkExts[filepath.Ext(p)] {
+			return nil
+		}
+		if abs, err := filepath.Abs(p); err != nil || abs == doc {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		var out bytes.Buffer
+		last := 0
+		for _, m := range re.FindAllSubmatchIndex(data, -1) {
+			old, err := url.PathUnescape(string(data[m[2]:m[3]]))
+			if err != nil {
+				continue
+			}
+			id, ok := renames[old]
+			if !ok {
+				continue
+			}
+			line := 1 + bytes.Count(data[:m[0]], []byte("\n"))
+			if !rewrite {
+				problems = append(problems, Problem{p, line, fmt.Sprintf("link to #%s of %s: the anchor is renamed to %q", old, filepath.Base(name), id)})
+				continue
+			}
+			problems = append(problems, Problem{p, line, fmt.Sprintf("link to #%s rewritten to #%s", old, id)})
+			out.Write(data[last:m[2]])
+			out.WriteString(id)
+			last = m[3]
+		}
+		if last == 0 {
+			return nil
+		}
+		out.Write(data[last:])
+		return ioutil.WriteFile(p, out.Bytes(), fi.Mode())
+	})
+	return problems, err
+}
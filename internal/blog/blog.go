@@ -0,0 +1,812 @@
+// Copyright 2013 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blog implements a web server for articles written in present format.
+//
+// It is derived from golang.org/x/tools/blog and keeps its Config and URL
+// layout, but loads articles one file at a time so that a running server can
+// re-parse only the articles, templates and included files that changed.
+package blog // import "github.com/golang-china/golangdoc.translations/internal/blog"
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/tools/blog/atom"
+	"golang.org/x/tools/present"
+)
+
+var (
+	validJSONPFunc = regexp.MustCompile(`(?i)^[a-z_][a-z0-9_.]*$`)
+	// used to serve relative paths when ServeLocalLinks is enabled.
+	golangOrgAbsLinkReplacer = strings.NewReplacer(
+		`href="https://golang.org/pkg`, `href="/pkg`,
+		`href="https://golang.org/cmd`, `href="/cmd`,
+	)
+)
+
+// Config specifies Server configuration values.
+type Config struct {
+	ContentPath  string // Relative or absolute location of article files and related content.
+	TemplatePath string // Relative or absolute location of template files.
+
+	BaseURL  string // Absolute base URL (for permalinks; no trailing slash).
+	BasePath string // Base URL path relative to server root (no trailing slash).
+	GodocURL string // The base URL of godoc (for menu bar; no trailing slash).
+	Hostname string // Server host name, used for rendering ATOM feeds.
+
+	HomeArticles int    // Articles to display on the home page.
+	FeedArticles int    // Articles to include in Atom and JSON feeds.
+	FeedTitle    string // The title of the Atom XML feed
+
+	TagNamesPath string // File mapping tags to display names (optional; see Tag).
+
+	PlayEnabled     bool
+	ServeLocalLinks bool // rewrite golang.org/{pkg,cmd} links to host-less, relative paths.
+
+	ReloadEvents bool // push reload events to open pages (see Server.Watch).
+
+	// Metadata for search engines and link previews (see Meta).
+	Language         string // BCP 47 tag of the language of the articles, e.g. "zh-CN".
+	UpstreamURL      string // Absolute base URL of the blog the articles are translated from (optional; no trailing slash).
+	UpstreamLanguage string // Language of the upstream blog (default "en").
+	Sitemap          bool   // serve a sitemap at /sitemap.xml.
+	OpenGraph        bool   // render Open Graph and Twitter card tags.
+	SiteName         string // Name of the site in link previews.
+	Description      string // Description of pages other than articles in link previews.
+	Image            string // Absolute URL of the preview image of pages without one (optional).
+	TwitterSite      string // Twitter account of the site, e.g. "@golang" (optional).
+}
+
+// Doc represents an article adorned with presentation data.
+type Doc struct {
+	*present.Doc
+	Permalink string        // Canonical URL for this document.
+	Path      string        // Path relative to server root (including base).
+	HTML      template.HTML // rendered article
+
+	Description string // first paragraph of the translation, as plain text
+	Image       string // absolute URL of the first image of the article, if any
+
+	Related      []*Doc
+	Newer, Older *Doc
+
+	modTime time.Time // last change to the article, its included files or the templates
+}
+
+// Server implements an http.Handler that serves blog articles.
+type Server struct {
+	cfg     Config
+	content http.Handler
+
+	// reloadMu serializes the reloads. The fields below are written with
+	// both reloadMu and mu held, so a reload reads them with reloadMu only,
+	// while requests read them with mu.
+	reloadMu sync.Mutex
+	mu       sync.RWMutex
+	site     *site            // last successfully loaded state
+	files    map[string]stamp // template files, as of the last load
+	cache    map[string]*article
+	loadErr  error // error of the most recent load, if it failed
+
+	tagNames map[string]string // contents of TagNamesPath, as of the last load
+
+	events
+}
+
+// site holds everything the server renders from one load of the content and
+// template directories. A site is never modified once it is published, so
+// requests may keep using it while a newer one is being built.
+type site struct {
+	docs     []*Doc
+	tags     []string
+	docPaths map[string]*Doc // key is path without BasePath.
+	docTags  map[string][]*Doc
+	tagInfo  map[string]*Tag
+	tagList  []*Tag
+	tagPaths map[string]*Tag // key is path without BasePath.
+	template struct {
+		home, index, article, tag, doc *template.Template
+	}
+	atomFeed []byte            // pre-rendered Atom feed
+	jsonFeed []byte            // pre-rendered JSON feed
+	tagFeeds map[string][]byte // pre-rendered Atom feeds, keyed by path without BasePath
+	sitemap  []byte            // pre-rendered sitemap
+}
+
+// article is a parsed article file together with the files it was built
+// from, so that it is parsed again only when one of them changes.
+type article struct {
+	doc   *present.Doc
+	html  template.HTML
+	files map[string]stamp // the article and every file it includes
+}
+
+// stamp identifies a version of a file.
+type stamp struct {
+	mod  int64 // modification time in nanoseconds
+	size int64
+}
+
+// NewServer constructs a new Server using the specified config.
+func NewServer(cfg Config) (*Server, error) {
+	present.PlayEnabled = cfg.PlayEnabled
+
+	if notExist(cfg.TemplatePath) {
+		return nil, fmt.Errorf("template directory not found: %s", cfg.TemplatePath)
+	}
+	s := &Server{
+		cfg:   cfg,
+		cache: make(map[string]*article),
+	}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+
+	// Set up content file server.
+	s.content = http.StripPrefix(s.cfg.BasePath, http.FileServer(http.Dir(cfg.ContentPath)))
+
+	return s, nil
+}
+
+var templateNames = []string{"root.tmpl", "home.tmpl", "index.tmpl", "article.tmpl", "tag.tmpl", "doc.tmpl"}
+
+// Reload brings the server up to date with the content and template
+// directories. Only articles whose source or included files changed since the
+// previous load are parsed again; all articles are rendered again when the
+// templates change. If loading fails the server keeps serving the previous
+// state and reports the error on every page until a later Reload succeeds.
+func (s *Server) Reload() error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	// The new state is built without s.mu, so that requests are served
+	// from the previous one in the meantime.
+	l, err := s.reload()
+
+	s.mu.Lock()
+	if l != nil {
+		s.site, s.files, s.tagNames, s.cache = l.site, l.files, l.tagNames, l.cache
+	}
+	changed := l != nil || !sameError(err, s.loadErr)
+	if changed {
+		s.loadErr = err
+	}
+	s.mu.Unlock()
+
+	if changed {
+		s.notify()
+	}
+	return err
+}
+
+// A load is the state built by a reload, to be published by Reload.
+type load struct {
+	site     *site
+	files    map[string]stamp
+	tagNames map[string]string
+	cache    map[string]*article
+}
+
+// reload does the work of Reload and returns the new state, or nil if
+// nothing changed. s.reloadMu must be held.
+func (s *Server) reload() (*load, error) {
+	files := make(map[string]stamp)
+	for _, name := range templateNames {
+		path := filepath.Join(s.cfg.TemplatePath, name)
+		st, err := stampOf(path)
+		if err != nil {
+			return nil, fmt.Errorf("template %s was not found in %s", name, s.cfg.TemplatePath)
+		}
+		files[path] = st
+	}
+	if s.cfg.TagNamesPath != "" {
+		st, err := stampOf(s.cfg.TagNamesPath)
+		if err != nil {
+			return nil, err
+		}
+		files[s.cfg.TagNamesPath] = st
+	}
+	tmplChanged := s.site == nil || !sameStamps(files, s.files)
+
+	next := new(site)
+	var tagNames map[string]string
+	if tmplChanged {
+		if err := next.parseTemplates(s.cfg.TemplatePath); err != nil {
+			return nil, err
+		}
+		if s.cfg.TagNamesPath != "" {
+			var err error
+			if tagNames, err = readTagNames(s.cfg.TagNamesPath); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		next.template = s.site.template
+		tagNames = s.tagNames
+	}
+
+	// Load content, reusing the articles that did not change.
+	root := filepath.Clean(s.cfg.ContentPath)
+	cache := make(map[string]*article)
+	contentChanged := false
+	const ext = ".article"
+	fn := func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(p) != ext {
+			return nil
+		}
+		a, ok := s.cache[p]
+		if ok && a.upToDate() {
+			if tmplChanged {
+				if a, err = a.rerender(next.template.doc); err != nil {
+					return fmt.Errorf("%s: %v", p, err)
+				}
+			}
+			cache[p] = a
+			return nil
+		}
+		a, err = loadArticle(p, next.template.doc)
+		if err != nil {
+			return err
+		}
+		cache[p] = a
+		contentChanged = true
+		return nil
+	}
+	if err := filepath.Walk(root, fn); err != nil {
+		return nil, err
+	}
+	if len(cache) != len(s.cache) {
+		contentChanged = true // an article was removed
+	}
+	if !tmplChanged && !contentChanged {
+		return nil, nil
+	}
+
+	var paths []string
+	for p := range cache {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		a := cache[p]
+		rel := p[len(root) : len(p)-len(ext)] // trim root and extension
+		rel = filepath.ToSlash(rel)
+		d := &Doc{
+			Doc:       a.doc,
+			Path:      s.cfg.BasePath + rel,
+			Permalink: s.cfg.BaseURL + rel,
+			HTML:      a.html,
+			modTime:   latest(a.files, files),
+		}
+		describe(d, s.cfg)
+		next.docs = append(next.docs, d)
+	}
+	next.index(s.cfg)
+	next.indexTags(s.cfg, tagNames)
+	if err := next.renderAtomFeed(s.cfg); err != nil {
+		return nil, err
+	}
+	if err := next.renderJSONFeed(s.cfg); err != nil {
+		return nil, err
+	}
+	if err := next.renderTagFeeds(s.cfg); err != nil {
+		return nil, err
+	}
+	if err := next.renderSitemap(s.cfg); err != nil {
+		return nil, err
+	}
+
+	return &load{next, files, tagNames, cache}, nil
+}
+
+var funcMap = template.FuncMap{
+	"sectioned": sectioned,
+	"authors":   authors,
+}
+
+// parseTemplates parses the page templates found in dir.
+func (s *site) parseTemplates(dir string) error {
+	root := filepath.Join(dir, "root.tmpl")
+	parse := func(name string) (*template.Template, error) {
+		t := template.New("").Funcs(funcMap)
+		return t.ParseFiles(root, filepath.Join(dir, name))
+	}
+
+	var err error
+	s.template.home, err = parse("home.tmpl")
+	if err != nil {
+		return err
+	}
+	s.template.index, err = parse("index.tmpl")
+	if err != nil {
+		return err
+	}
+	s.template.article, err = parse("article.tmpl")
+	if err != nil {
+		return err
+	}
+	s.template.tag, err = parse("tag.tmpl")
+	if err != nil {
+		return err
+	}
+	p := present.Template().Funcs(funcMap)
+	s.template.doc, err = p.ParseFiles(filepath.Join(dir, "doc.tmpl"))
+	return err
+}
+
+// loadArticle parses and renders the article in the named file, recording
+// the files it reads along the way.
+func loadArticle(name string, t *template.Template) (*article, error) {
+	a := &article{files: make(map[string]stamp)}
+	record := func(name string) error {
+		st, err := stampOf(name)
+		if err != nil {
+			return err
+		}
+		a.files[name] = st
+		return nil
+	}
+	if err := record(name); err != nil {
+		return nil, err
+	}
+	ctx := &present.Context{
+		ReadFile: func(name string) ([]byte, error) {
+			if err := record(name); err != nil {
+				return nil, err
+			}
+			return ioutil.ReadFile(name)
+		},
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	a.doc, err = ctx.Parse(f, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	return a.rerender(t)
+}
+
+// rerender returns a copy of a rendered with the template t.
+func (a *article) rerender(t *template.Template) (*article, error) {
+	var html bytes.Buffer
+	if err := a.doc.Render(&html, t); err != nil {
+		return nil, err
+	}
+	return &article{
+		doc:   a.doc,
+		html:  template.HTML(html.String()),
+		files: a.files,
+	}, nil
+}
+
+// upToDate reports whether none of the files a was built from has changed.
+func (a *article) upToDate() bool {
+	for name, old := range a.files {
+		st, err := stampOf(name)
+		if err != nil || st != old {
+			return false
+		}
+	}
+	return true
+}
+
+// sectioned returns true if the provided Doc contains more than one section.
+// This is used to control whether to display the table of contents and headings.
+func sectioned(d *present.Doc) bool {
+	return len(d.Sections) > 1
+}
+
+// authors returns a comma-separated list of author names.
+func authors(authors []present.Author) string {
+	var b bytes.Buffer
+	last := len(authors) - 1
+	for i, a := range authors {
+		if i > 0 {
+			if i == last {
+				b.WriteString(" and ")
+			} else {
+				b.WriteString(", ")
+			}
+		}
+		b.WriteString(authorName(a))
+	}
+	return b.String()
+}
+
+// authorName returns the first line of the Author text: the author's name.
+func authorName(a present.Author) string {
+	el := a.TextElem()
+	if len(el) == 0 {
+		return ""
+	}
+	text, ok := el[0].(present.Text)
+	if !ok || len(text.Lines) == 0 {
+		return ""
+	}
+	return text.Lines[0]
+}
+
+// index sorts the site's docs, computes the denormalized docPaths, docTags,
+// and tags fields, and populates the various helper fields (Next, Previous,
+// Related) for each Doc.
+func (s *site) index(cfg Config) {
+	sort.Stable(docsByTime(s.docs))
+
+	// Pull out doc paths and tags and put in reverse-associating maps.
+	s.docPaths = make(map[string]*Doc)
+	s.docTags = make(map[string][]*Doc)
+	for _, d := range s.docs {
+		s.docPaths[strings.TrimPrefix(d.Path, cfg.BasePath)] = d
+		for _, t := range d.Tags {
+			s.docTags[t] = append(s.docTags[t], d)
+		}
+	}
+
+	// Pull out unique sorted list of tags.
+	for t := range s.docTags {
+		s.tags = append(s.tags, t)
+	}
+	sort.Strings(s.tags)
+
+	// Set up presentation-related fields, Newer, Older, and Related.
+	for i, doc := range s.docs {
+		// Newer, Older: docs adjacent to doc
+		if i > 0 {
+			doc.Newer = s.docs[i-1]
+		}
+		if i+1 < len(s.docs) {
+			doc.Older = s.docs[i+1]
+		}
+
+		// Related: all docs that share tags with doc.
+		related := make(map[*Doc]bool)
+		for _, t := range doc.Tags {
+			for _, d := range s.docTags[t] {
+				if d != doc {
+					related[d] = true
+				}
+			}
+		}
+		for d := range related {
+			doc.Related = append(doc.Related, d)
+		}
+		sort.Sort(docsByTime(doc.Related))
+	}
+}
+
+// renderAtomFeed generates an XML Atom feed and stores it in the site's
+// atomFeed field.
+func (s *site) renderAtomFeed(cfg Config) error {
+	data, err := atomFeed(cfg, cfg.FeedTitle, "", s.docs)
+	if err != nil {
+		return err
+	}
+	s.atomFeed = data
+	return nil
+}
+
+// atomFeed generates an XML Atom feed with the given title of the newest of
+// docs. The feed lives at path feed.atom under dir, which is relative to
+// BaseURL.
+func atomFeed(cfg Config, title, dir string, docs []*Doc) ([]byte, error) {
+	var updated time.Time
+	if len(docs) > 0 {
+		updated = docs[0].Time
+	}
+	id := "tag:" + cfg.Hostname + ",2013:" + cfg.Hostname
+	feed := atom.Feed{
+		Title:   title,
+		ID:      id + dir,
+		Updated: atom.Time(updated),
+		Link: []atom.Link{{
+			Rel:  "self",
+			Href: cfg.BaseURL + dir + "/feed.atom",
+		}},
+	}
+	for i, doc := range docs {
+		if i >= cfg.FeedArticles {
+			break
+		}
+		e := &atom.Entry{
+			Title: doc.Title,
+			ID:    id + doc.Path,
+			Link: []atom.Link{{
+				Rel:  "alternate",
+				Href: doc.Permalink,
+			}},
+			Published: atom.Time(doc.Time),
+			Updated:   atom.Time(doc.Time),
+			Summary: &atom.Text{
+				Type: "html",
+				Body: summary(doc),
+			},
+			Content: &atom.Text{
+				Type: "html",
+				Body: string(doc.HTML),
+			},
+			Author: &atom.Person{
+				Name: authors(doc.Authors),
+			},
+		}
+		feed.Entry = append(feed.Entry, e)
+	}
+	return xml.Marshal(&feed)
+}
+
+type jsonItem struct {
+	Title   string
+	Link    string
+	Time    time.Time
+	Summary string
+	Content string
+	Author  string
+}
+
+// renderJSONFeed generates a JSON feed and stores it in the site's jsonFeed
+// field.
+func (s *site) renderJSONFeed(cfg Config) error {
+	var feed []jsonItem
+	for i, doc := range s.docs {
+		if i >= cfg.FeedArticles {
+			break
+		}
+		item := jsonItem{
+			Title:   doc.Title,
+			Link:    doc.Permalink,
+			Time:    doc.Time,
+			Summary: summary(doc),
+			Content: string(doc.HTML),
+			Author:  authors(doc.Authors),
+		}
+		feed = append(feed, item)
+	}
+	data, err := json.Marshal(feed)
+	if err != nil {
+		return err
+	}
+	s.jsonFeed = data
+	return nil
+}
+
+// summary returns the first paragraph of text from the provided Doc.
+func summary(d *Doc) string {
+	if len(d.Sections) == 0 {
+		return ""
+	}
+	for _, elem := range d.Sections[0].Elem {
+		text, ok := elem.(present.Text)
+		if !ok || text.Pre {
+			// skip everything but non-text elements
+			continue
+		}
+		var buf bytes.Buffer
+		for _, s := range text.Lines {
+			buf.WriteString(string(present.Style(s)))
+			buf.WriteByte('\n')
+		}
+		return buf.String()
+	}
+	return ""
+}
+
+// rootData encapsulates data destined for the root template.
+type rootData struct {
+	Doc      *Doc
+	BasePath string
+	GodocURL string
+	Data     interface{}
+
+	Meta       *Meta       // page metadata, if Config.OpenGraph is set
+	Alternates []Alternate // the page in every language, if Config.UpstreamURL is set
+
+	site *site
+}
+
+// Tag returns the named tag, for use in templates.
+func (d rootData) Tag(name string) *Tag {
+	if t, ok := d.site.tagInfo[name]; ok {
+		return t
+	}
+	return &Tag{Name: name, Display: name}
+}
+
+// Tags returns all tags, sorted by display name, for use in templates.
+func (d rootData) Tags() []*Tag {
+	return d.site.tagList
+}
+
+// ServeHTTP serves the front, index, and article pages
+// as well as the ATOM and JSON feeds.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	site, loadErr := s.site, s.loadErr
+	s.mu.RUnlock()
+
+	switch p := strings.TrimPrefix(r.URL.Path, s.cfg.BasePath); p {
+	case "/feed.atom", "/feeds/posts/default":
+		w.Header().Set("Content-type", "application/atom+xml; charset=utf-8")
+		w.Write(site.atomFeed)
+		return
+	case "/.json":
+		if p := r.FormValue("jsonp"); validJSONPFunc.MatchString(p) {
+			w.Header().Set("Content-type", "application/javascript; charset=utf-8")
+			fmt.Fprintf(w, "%v(%s)", p, site.jsonFeed)
+			return
+		}
+		w.Header().Set("Content-type", "application/json; charset=utf-8")
+		w.Write(site.jsonFeed)
+		return
+	case "/sitemap.xml":
+		if s.cfg.Sitemap {
+			w.Header().Set("Content-type", "application/xml; charset=utf-8")
+			w.Write(site.sitemap)
+			return
+		}
+	case reloadEventsPath:
+		if s.cfg.ReloadEvents {
+			s.serveEvents(w, r)
+			return
+		}
+	default:
+		if feed, ok := site.tagFeeds[p]; ok {
+			w.Header().Set("Content-type", "application/atom+xml; charset=utf-8")
+			w.Write(feed)
+			return
+		}
+	}
+	t, d, ok := s.page(site, strings.TrimPrefix(r.URL.Path, s.cfg.BasePath))
+	if !ok {
+		// Not a page; try to just serve static content.
+		s.content.ServeHTTP(w, r)
+		return
+	}
+	page, err := s.render(t, d)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	page = s.decorate(page, loadErr)
+
+	// Let clients revalidate pages cheaply. Articles also carry the time
+	// they last changed; the other pages list many articles and use the
+	// ETag alone.
+	var modTime time.Time
+	if d.Doc != nil {
+		modTime = d.Doc.modTime
+	}
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, sha1.Sum([]byte(page))))
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	http.ServeContent(w, r, "", modTime, strings.NewReader(page))
+}
+
+// NumDocs returns the number of articles the server is serving.
+func (s *Server) NumDocs() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.site.docs)
+}
+
+// page returns the template and data for the page at path p, relative to
+// BasePath, and reports whether there is such a page.
+func (s *Server) page(site *site, p string) (t *template.Template, d rootData, ok bool) {
+	d = rootData{BasePath: s.cfg.BasePath, GodocURL: s.cfg.GodocURL, site: site}
+	switch p {
+	case "/":
+		d.Data = site.docs
+		if len(site.docs) > s.cfg.HomeArticles {
+			d.Data = site.docs[:s.cfg.HomeArticles]
+		}
+		t = site.template.home
+	case "/index":
+		d.Data = site.docs
+		t = site.template.index
+	default:
+		if tag, ok := site.tagPaths[p]; ok {
+			d.Data = tagData{Tag: tag, Docs: site.docTags[tag.Name]}
+			d.Meta = meta(s.cfg, tagDir(tag.Name), nil)
+			t = site.template.tag
+			return t, d, true
+		}
+		doc, ok := site.docPaths[p]
+		if !ok {
+			return nil, d, false
+		}
+		d.Doc = doc
+		t = site.template.article
+	}
+	d.Meta = meta(s.cfg, p, d.Doc)
+	d.Alternates = alternates(s.cfg, p)
+	return t, d, true
+}
+
+// render executes the page template t with data d.
+func (s *Server) render(t *template.Template, d rootData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, "root", d); err != nil {
+		return "", err
+	}
+	page := buf.String()
+	if s.cfg.ServeLocalLinks {
+		page = golangOrgAbsLinkReplacer.Replace(page)
+	}
+	return page, nil
+}
+
+// docsByTime implements sort.Interface, sorting Docs by their Time field.
+type docsByTime []*Doc
+
+func (s docsByTime) Len() int           { return len(s) }
+func (s docsByTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s docsByTime) Less(i, j int) bool { return s[i].Time.After(s[j].Time) }
+
+// notExist reports whether the path exists or not.
+func notExist(path string) bool {
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
+}
+
+// stampOf returns the current stamp of the named file.
+func stampOf(name string) (stamp, error) {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return stamp{}, err
+	}
+	return stamp{fi.ModTime().UnixNano(), fi.Size()}, nil
+}
+
+// latest returns the latest modification time recorded in the stamp sets.
+func latest(sets ...map[string]stamp) time.Time {
+	var max int64
+	for _, set := range sets {
+		for _, st := range set {
+			if st.mod > max {
+				max = st.mod
+			}
+		}
+	}
+	return time.Unix(0, max)
+}
+
+// sameStamps reports whether a and b record the same versions of the same
+// files.
+func sameStamps(a, b map[string]stamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, st := range a {
+		if b[name] != st {
+			return false
+		}
+	}
+	return true
+}
+
+// sameError reports whether a and b are both nil or have the same message.
+func sameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Error() == b.Error()
+}
@@ -0,0 +1,207 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blog
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// manifestName is the file in which Export records the files it wrote, so
+// that the next export into the same directory can remove stale ones.
+const manifestName = ".export-manifest"
+
+// Export writes a static copy of the blog to dir, suitable for a plain file
+// server or for reading offline: the home page, the index, every article and
+// every tag page as HTML pages, the Atom and JSON feeds including the feed of
+// each tag, the sitemap if Config.Sitemap is set, and the non-article files of
+// the content directory. Files holds additional files to copy, keyed by the
+// URL path at which the server makes them available (for example
+// "/lib/godoc/style.css").
+//
+// Each page is written as an index.html file in a directory named after its
+// URL, and links between exported files are rewritten to relative links, so
+// the copy works under any base path. Export produces identical output for
+// identical input. Files written by a previous export into dir that are no
+// longer part of the blog are removed.
+func (s *Server) Export(dir string, files map[string][]byte) error {
+	s.mu.RLock()
+	site := s.site
+	s.mu.RUnlock()
+
+	out := make(map[string][]byte) // output file (slash-separated) -> content
+	for p, b := range files {
+		out[strings.TrimPrefix(p, "/")] = b
+	}
+
+	// Copy the content files the server would serve.
+	root := filepath.Clean(s.cfg.ContentPath)
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || filepath.Ext(p) == ".article" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		out[filepath.ToSlash(rel)] = b
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	out["feed.atom"] = site.atomFeed
+	out[".json"] = site.jsonFeed
+	for p, feed := range site.tagFeeds {
+		out[strings.TrimPrefix(p, "/")] = feed
+	}
+	if s.cfg.Sitemap {
+		out["sitemap.xml"] = site.sitemap
+	}
+
+	pages := site.pagePaths(s.cfg)
+	targets := make(map[string]string) // URL path -> output file
+	for name := range out {
+		targets["/"+name] = name
+	}
+	for _, p := range pages {
+		targets[p] = exportName(p)
+	}
+	for _, p := range pages {
+		t, d, _ := s.page(site, p)
+		page, err := s.render(t, d)
+		if err != nil {
+			return fmt.Errorf("rendering %s: %v", p, err)
+		}
+		out[exportName(p)] = []byte(relativizeLinks(page, s.cfg.BasePath+p, exportName(p), s.cfg.BasePath, targets))
+	}
+
+	return writeExport(dir, out)
+}
+
+// pagePaths returns the paths, relative to BasePath, of the site's pages.
+func (s *site) pagePaths(cfg Config) []string {
+	pages := []string{"/", "/index"}
+	for _, d := range s.docs {
+		pages = append(pages, strings.TrimPrefix(d.Path, cfg.BasePath))
+	}
+	for _, t := range s.tagList {
+		pages = append(pages, "/tag/"+t.Name)
+	}
+	return pages
+}
+
+// exportName returns the output file for the page at path p.
+func exportName(p string) string {
+	if p == "/" {
+		return "index.html"
+	}
+	return strings.TrimPrefix(p, "/") + "/index.html"
+}
+
+var linkAttr = regexp.MustCompile(`\b(href|src)="([^"]*)"`)
+
+// relativizeLinks rewrites the links in page, which is served at pageURL and
+// exported as file name, that point to exported files into relative links.
+// Links to anything else are left alone.
+func relativizeLinks(page, pageURL, name, basePath string, targets map[string]string) string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return page
+	}
+	return linkAttr.ReplaceAllStringFunc(page, func(attr string) string {
+		m := linkAttr.FindStringSubmatch(attr)
+		u, err := url.Parse(m[2])
+		if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" {
+			return attr
+		}
+		target, ok := targets[strings.TrimPrefix(base.ResolveReference(u).Path, basePath)]
+		if !ok {
+			return attr
+		}
+		rel, err := filepath.Rel(path.Dir(name), target)
+		if err != nil {
+			return attr
+		}
+		link := &url.URL{Path: filepath.ToSlash(rel), Fragment: u.Fragment}
+		return fmt.Sprintf(`%s="%s"`, m[1], link)
+	})
+}
+
+// writeExport writes the files in out to dir, replacing the files recorded in
+// the manifest of a previous export.
+func writeExport(dir string, out map[string][]byte) error {
+	old, err := readManifest(filepath.Join(dir, manifestName))
+	if err != nil {
+		return err
+	}
+	for _, name := range old {
+		if _, ok := out[name]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, filepath.FromSlash(name))); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	var names []string
+	for name := range out {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, out[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	var manifest bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintln(&manifest, name)
+	}
+	return ioutil.WriteFile(filepath.Join(dir, manifestName), manifest.Bytes(), 0644)
+}
+
+// readManifest returns the file names listed in the named manifest, if it
+// exists.
+func readManifest(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var names []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if name := sc.Text(); name != "" && !strings.HasPrefix(name, "../") {
+			names = append(names, name)
+		}
+	}
+	return names, sc.Err()
+}
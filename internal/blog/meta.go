@@ -0,0 +1,256 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blog
+
+import (
+	"encoding/xml"
+	"html"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/tools/present"
+)
+
+// Meta describes a page to search engines and to the link previews of
+// social networks and chat applications. Templates render it as Open Graph
+// and Twitter card tags; it is only set when Config.OpenGraph is true.
+type Meta struct {
+	URL         string    // absolute URL of the page
+	Title       string    // title of the article; empty for other pages
+	Description string    // plain text description
+	Image       string    // absolute URL of the preview image, if any
+	Type        string    // Open Graph type: "article" or "website"
+	Card        string    // Twitter card type: "summary" or "summary_large_image"
+	Locale      string    // Open Graph locale, e.g. "zh_CN"
+	SiteName    string    // Config.SiteName
+	TwitterSite string    // Config.TwitterSite
+	Published   time.Time // publication date of the article; zero for other pages
+}
+
+// Alternate is a version of a page in another language, for
+// <link rel="alternate" hreflang="..."> tags and the sitemap.
+type Alternate struct {
+	Lang string // BCP 47 language tag
+	URL  string // absolute URL of the page in that language
+}
+
+// maxDescription is the length, in characters, beyond which descriptions
+// are truncated.
+const maxDescription = 200
+
+// describe sets the Description and Image fields of d.
+func describe(d *Doc, cfg Config) {
+	var (
+		lang    string // language of the current bilingual section, if any
+		first   string // first paragraph in any language
+		chinese string // first paragraph of a Chinese section
+	)
+	var walk func(elems []present.Elem)
+	walk = func(elems []present.Elem) {
+		for _, e := range elems {
+			switch e := e.(type) {
+			case present.Section:
+				walk(e.Elem)
+			case present.HTML:
+				switch s := string(e.HTML); {
+				case strings.Contains(s, `class="chinese"`):
+					lang = "zh"
+				case strings.Contains(s, `class="english"`):
+					lang = "en"
+				case strings.TrimSpace(s) == "</div>":
+					lang = ""
+				}
+			case present.Text:
+				if e.Pre || (first != "" && chinese != "") {
+					continue
+				}
+				text := plainText(e.Lines)
+				if text == "" {
+					continue
+				}
+				if first == "" {
+					first = text
+				}
+				if lang == "zh" && chinese == "" {
+					chinese = text
+				}
+			case present.Image:
+				if d.Image == "" {
+					d.Image = absURL(resolve(d, cfg, e.URL))
+				}
+			}
+		}
+	}
+	for _, s := range d.Sections {
+		walk(s.Elem)
+	}
+	d.Description = chinese
+	if d.Description == "" {
+		d.Description = first
+	}
+	d.Description = truncate(d.Description, maxDescription)
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// plainText returns the text of a paragraph without present's markup.
+func plainText(lines []string) string {
+	var parts []string
+	for _, l := range lines {
+		s := htmlTag.ReplaceAllString(string(present.Style(l)), "")
+		if s = strings.TrimSpace(html.UnescapeString(s)); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// truncate shortens s to at most n characters, marking the cut with an
+// ellipsis.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return strings.TrimSpace(string(r[:n-1])) + "…"
+}
+
+// resolve returns the URL of a file that the article d refers to by the
+// relative URL ref.
+func resolve(d *Doc, cfg Config, ref string) string {
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, "//") || strings.HasPrefix(ref, "/") {
+		return ref
+	}
+	rel := strings.TrimPrefix(d.Path, cfg.BasePath)
+	return cfg.BaseURL + path.Join(path.Dir(rel), ref)
+}
+
+// absURL makes a protocol-relative URL, such as the default BaseURL,
+// absolute. Sitemaps and Open Graph tags require absolute URLs.
+func absURL(u string) string {
+	if strings.HasPrefix(u, "//") {
+		return "https:" + u
+	}
+	return u
+}
+
+// upstreamLanguage returns the language of the upstream blog.
+func upstreamLanguage(cfg Config) string {
+	if cfg.UpstreamLanguage != "" {
+		return cfg.UpstreamLanguage
+	}
+	return "en"
+}
+
+// alternates returns the versions in every language of the page at path p,
+// relative to BasePath, or nil if the blog has no upstream.
+func alternates(cfg Config, p string) []Alternate {
+	if cfg.UpstreamURL == "" || cfg.Language == "" {
+		return nil
+	}
+	return []Alternate{
+		{Lang: cfg.Language, URL: absURL(cfg.BaseURL + p)},
+		{Lang: upstreamLanguage(cfg), URL: absURL(cfg.UpstreamURL + p)},
+	}
+}
+
+// meta returns the metadata of the page at path p, relative to BasePath,
+// showing article d, or nil if Open Graph tags are disabled. d is nil for
+// pages other than articles.
+func meta(cfg Config, p string, d *Doc) *Meta {
+	if !cfg.OpenGraph {
+		return nil
+	}
+	m := &Meta{
+		URL:         absURL(cfg.BaseURL + p),
+		Description: cfg.Description,
+		Image:       absURL(cfg.Image),
+		Type:        "website",
+		Locale:      strings.Replace(cfg.Language, "-", "_", -1),
+		SiteName:    cfg.SiteName,
+		TwitterSite: cfg.TwitterSite,
+	}
+	if d != nil {
+		m.URL = absURL(d.Permalink)
+		m.Title = d.Title
+		m.Type = "article"
+		m.Published = d.Time
+		if d.Description != "" {
+			m.Description = d.Description
+		}
+		if d.Image != "" {
+			m.Image = d.Image
+		}
+	}
+	m.Card = "summary"
+	if m.Image != "" && m.Image != absURL(cfg.Image) {
+		m.Card = "summary_large_image"
+	}
+	return m
+}
+
+// Sitemap XML, as described at https://www.sitemaps.org/protocol.html, with
+// the language alternates of each page.
+type (
+	sitemapSet struct {
+		XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		XHTML   string       `xml:"xmlns:xhtml,attr,omitempty"`
+		URL     []sitemapURL `xml:"url"`
+	}
+	sitemapURL struct {
+		Loc     string        `xml:"loc"`
+		LastMod string        `xml:"lastmod,omitempty"`
+		Link    []sitemapLink `xml:"xhtml:link"`
+	}
+	sitemapLink struct {
+		Rel      string `xml:"rel,attr"`
+		HrefLang string `xml:"hreflang,attr"`
+		Href     string `xml:"href,attr"`
+	}
+)
+
+// renderSitemap generates the sitemap of the site's pages and stores it in
+// the site's sitemap field. The last modification date of a page is the
+// date of the newest article it shows.
+func (s *site) renderSitemap(cfg Config) error {
+	set := sitemapSet{}
+	add := func(p string, t time.Time) {
+		u := sitemapURL{Loc: absURL(cfg.BaseURL + p)}
+		if !t.IsZero() {
+			u.LastMod = t.Format("2006-01-02")
+		}
+		for _, a := range alternates(cfg, p) {
+			u.Link = append(u.Link, sitemapLink{"alternate", a.Lang, a.URL})
+			set.XHTML = "http://www.w3.org/1999/xhtml"
+		}
+		set.URL = append(set.URL, u)
+	}
+	var newest time.Time
+	if len(s.docs) > 0 {
+		newest = s.docs[0].Time
+	}
+	add("/", newest)
+	add("/index", newest)
+	for _, d := range s.docs {
+		add(strings.TrimPrefix(d.Path, cfg.BasePath), d.Time)
+	}
+	for _, t := range s.tagList {
+		// Tag pages have no upstream counterpart.
+		u := sitemapURL{Loc: absURL(cfg.BaseURL + tagDir(t.Name))}
+		if docs := s.docTags[t.Name]; len(docs) > 0 {
+			u.LastMod = docs[0].Time.Format("2006-01-02")
+		}
+		set.URL = append(set.URL, u)
+	}
+	data, err := xml.MarshalIndent(&set, "", "  ")
+	if err != nil {
+		return err
+	}
+	s.sitemap = append([]byte(xml.Header), data...)
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blog
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// reloadEventsPath is the path, relative to BasePath, of the server-sent
+// event stream that tells open pages to reload.
+const reloadEventsPath = "/.reload"
+
+// Watch checks the content and template directories for changes every
+// interval and reloads the server when it finds any. It never returns.
+//
+// Parse errors are logged once and shown in an overlay on every page until
+// they are fixed; in the meantime the last good version of the blog is
+// served. If Config.ReloadEvents is set, pages open in a browser reload
+// themselves whenever the server picks up a change.
+func (s *Server) Watch(interval time.Duration) {
+	for range time.Tick(interval) {
+		s.mu.RLock()
+		prev := s.loadErr
+		s.mu.RUnlock()
+		if err := s.Reload(); err != nil && !sameError(err, prev) {
+			log.Printf("reload: %v", err)
+		}
+	}
+}
+
+// events tracks the clients listening for reload events.
+type events struct {
+	mu      sync.Mutex
+	clients map[chan struct{}]bool
+	closed  bool // the channels of the clients are closed
+}
+
+// notify tells every listening client to reload.
+func (e *events) notify() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	for c := range e.clients {
+		select {
+		case c <- struct{}{}:
+		default:
+			// A reload is already pending for this client.
+		}
+	}
+}
+
+func (e *events) subscribe() chan struct{} {
+	c := make(chan struct{}, 1)
+	e.mu.Lock()
+	if e.closed {
+		close(c)
+	}
+	if e.clients == nil {
+		e.clients = make(map[chan struct{}]bool)
+	}
+	e.clients[c] = true
+	e.mu.Unlock()
+	return c
+}
+
+func (e *events) unsubscribe(c chan struct{}) {
+	e.mu.Lock()
+	delete(e.clients, c)
+	e.mu.Unlock()
+}
+
+// closeAll closes the channels of the clients, and of the clients that
+// subscribe later.
+func (e *events) closeAll() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	e.closed = true
+	for c := range e.clients {
+		close(c)
+	}
+}
+
+// CloseEvents ends the reload event streams in progress, and those started
+// later. As the streams last as long as the pages are open, a server shutting
+// down would otherwise wait for them; see http.Server.RegisterOnShutdown.
+func (s *Server) CloseEvents() {
+	s.events.closeAll()
+}
+
+// serveEvents streams a "reload" server-sent event to the client each time
+// the server reloads, until the client goes away or the streams are closed.
+func (s *Server) serveEvents(w http.ResponseWriter, r *http.Request) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	c := s.subscribe()
+	defer s.unsubscribe(c)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+	for {
+		select {
+		case _, ok := <-c:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprint(w, "event: reload\ndata: \n\n"); err != nil {
+				return
+			}
+			f.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// decorate adds the reload script and, if the last load failed, the error
+// overlay to the rendered page.
+func (s *Server) decorate(page string, loadErr error) string {
+	var extra string
+	if loadErr != nil {
+		extra += fmt.Sprintf(errorOverlay, template.HTMLEscapeString(loadErr.Error()))
+	}
+	if s.cfg.ReloadEvents {
+		extra += fmt.Sprintf(reloadScript, template.JSEscapeString(s.cfg.BasePath+reloadEventsPath))
+	}
+	if extra == "" {
+		return page
+	}
+	if i := strings.LastIndex(page, "</body>"); i >= 0 {
+		return page[:i] + extra + page[i:]
+	}
+	return page + extra
+}
+
+const errorOverlay = `
+<div id="reload-error" style="position: fixed; top: 0; left: 0; right: 0; z-index: 1000; max-height: 50%%; overflow: auto; padding: 10px 20px; background: #fee; border-bottom: 2px solid #c00; font-family: monospace;">
+<p><b>载入文章出错，当前显示的是上一次成功载入的版本：</b></p>
+<pre style="white-space: pre-wrap;">%s</pre>
+</div>
+`
+
+const reloadScript = `
+<script>
+if (window.EventSource) {
+	new EventSource("%s").addEventListener("reload", function() {
+		window.location.reload();
+	});
+}
+</script>
+`
@@ -0,0 +1,111 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blog
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Tag describes an article tag for display.
+//
+// Tags are shown by their display name, which is the tag itself unless
+// Config.TagNamesPath names a file that translates it. Each line of that file
+// holds a tag, white space, and its display name; blank lines and lines
+// starting with # are ignored.
+type Tag struct {
+	Name     string // tag as written in the Tags line of articles
+	Display  string // name to show to readers
+	Path     string // path of the tag page relative to server root (including base)
+	FeedPath string // path of the tag's Atom feed
+	Count    int    // number of articles with the tag
+	Weight   int    // popularity relative to other tags, from 1 to 5, for tag clouds
+}
+
+// tagData is the data for the tag template.
+type tagData struct {
+	Tag  *Tag
+	Docs []*Doc
+}
+
+// indexTags builds the site's tag descriptions from its docs.
+// It must be called after index.
+func (s *site) indexTags(cfg Config, names map[string]string) {
+	max := 0
+	for _, t := range s.tags {
+		if n := len(s.docTags[t]); n > max {
+			max = n
+		}
+	}
+	s.tagInfo = make(map[string]*Tag)
+	s.tagPaths = make(map[string]*Tag)
+	for _, name := range s.tags {
+		dir := tagDir(name)
+		tag := &Tag{
+			Name:     name,
+			Display:  name,
+			Path:     cfg.BasePath + dir,
+			FeedPath: cfg.BasePath + dir + "/feed.atom",
+			Count:    len(s.docTags[name]),
+			Weight:   1,
+		}
+		if d, ok := names[name]; ok {
+			tag.Display = d
+		}
+		if max > 1 {
+			tag.Weight = 1 + 4*(tag.Count-1)/(max-1)
+		}
+		s.tagInfo[name] = tag
+		s.tagList = append(s.tagList, tag)
+		s.tagPaths["/tag/"+name] = tag
+	}
+}
+
+// tagDir returns the URL path of the named tag's page, relative to BasePath.
+func tagDir(name string) string {
+	return "/tag/" + url.PathEscape(name)
+}
+
+// renderTagFeeds generates an XML Atom feed for each tag and stores them in
+// the site's tagFeeds field.
+func (s *site) renderTagFeeds(cfg Config) error {
+	s.tagFeeds = make(map[string][]byte)
+	for _, tag := range s.tagList {
+		title := cfg.FeedTitle + " - " + tag.Display
+		data, err := atomFeed(cfg, title, tagDir(tag.Name), s.docTags[tag.Name])
+		if err != nil {
+			return err
+		}
+		s.tagFeeds["/tag/"+tag.Name+"/feed.atom"] = data
+	}
+	return nil
+}
+
+// readTagNames reads the tag display names in the named file.
+func readTagNames(name string) (map[string]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			return nil, fmt.Errorf("%s:%d: missing display name for tag %q", name, n, f[0])
+		}
+		names[f[0]] = strings.Join(f[1:], " ")
+	}
+	return names, sc.Err()
+}
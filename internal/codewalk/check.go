@@ -0,0 +1,179 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codewalk
+
+import (
+	"bytes"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/address"
+)
+
+// A Problem is a broken step found in a codewalk.
+type Problem struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Msg)
+}
+
+// Check resolves the address of every step of cw against its file, given
+// the directory of the translated documents (see Path), and reports the
+// addresses that match nothing and the steps that lack their English step
+// or their translation.
+//
+// The Chinese steps of a Go file are also compared with their English
+// step: as they may select the Chinese comments of the file rather than the
+// English ones, their regions are compared without comments, and those that
+// show different code are reported. English steps that show only comments
+// are not compared, nor are the steps of other files, such as codewalk.xml,
+// which shows itself: their translations show the Chinese text.
+func Check(docDir string, cw *Codewalk) []Problem {
+	c := &checker{docDir: docDir, cw: cw, files: make(map[string]file)}
+	for _, p := range cw.Pairs {
+		switch {
+		case p.English == nil:
+			c.errorf(p.Chinese, "step %q has no English step", p.Chinese.Title)
+			c.resolve(p.Chinese)
+		case p.Chinese == nil:
+			c.errorf(p.English, "English step %q has no translation", p.English.Title)
+			c.resolve(p.English)
+		default:
+			c.compare(p.English, p.Chinese)
+		}
+	}
+	return c.problems
+}
+
+type checker struct {
+	docDir   string
+	cw       *Codewalk
+	files    map[string]file // by file name
+	problems []Problem
+}
+
+func (c *checker) errorf(s *Step, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{c.cw.Name, s.Line, fmt.Sprintf(format, args...)})
+}
+
+// A file is the content of a file shown by steps, or the error reading it.
+type file struct {
+	data []byte
+	err  error
+}
+
+// read returns the content of the file of s.
+func (c *checker) read(s *Step) ([]byte, error) {
+	name := Path(c.docDir, s.File())
+	f, ok := c.files[name]
+	if !ok {
+		f.data, f.err = ioutil.ReadFile(name)
+		c.files[name] = f
+	}
+	return f.data, f.err
+}
+
+// resolve returns the content of the file of s and the region it shows,
+// or ok == false after reporting why it shows nothing.
+func (c *checker) resolve(s *Step) (data []byte, lo, hi int, ok bool) {
+	data, err := c.read(s)
+	if err != nil {
+		c.errorf(s, "step %q: %v", s.Title, err)
+		return nil, 0, 0, false
+	}
+	lo, hi, err = s.Range(data)
+	if err != nil {
+		c.errorf(s, "step %q: address %q matches nothing in %s: %v", s.Title, s.Addr(), s.File(), err)
+		return nil, 0, 0, false
+	}
+	return data, lo, hi, true
+}
+
+// compare checks the English step en and its translation zh.
+func (c *checker) compare(en, zh *Step) {
+	enData, enLo, enHi, enOK := c.resolve(en)
+	zhData, zhLo, zhHi, zhOK := c.resolve(zh)
+	if !enOK || !zhOK {
+		return
+	}
+	if en.File() != zh.File() {
+		c.errorf(zh, "step %q shows %s, its English step %s", zh.Title, zh.File(), en.File())
+		return
+	}
+	if enLo == zhLo && enHi == zhHi || filepath.Ext(zh.File()) != ".go" {
+		return
+	}
+	code := stripComments(zhData)
+	enCode, zhCode := lines(code[enLo:enHi]), lines(code[zhLo:zhHi])
+	if enCode == "" {
+		// The English step shows a comment only, such as the package
+		// comment or an English copy of code kept in a comment, which
+		// the translation shows translated.
+		return
+	}
+	if enCode != zhCode {
+		c.errorf(zh, "step %q: address %q selects lines %s of %s, with other code than lines %s of its English step",
+			zh.Title, zh.Addr(), lineRange(zhData, zhLo, zhHi), zh.File(), lineRange(enData, enLo, enHi))
+	}
+}
+
+// lineRange returns the numbers of the lines of the region [lo, hi) of
+// data, such as "12-20".
+func lineRange(data []byte, lo, hi int) string {
+	first, last := address.Line(data, lo), address.Line(data, lo)
+	if hi > lo {
+		last = address.Line(data, hi-1)
+	}
+	if first == last {
+		return fmt.Sprint(first)
+	}
+	return fmt.Sprintf("%d-%d", first, last)
+}
+
+// stripComments returns a copy of the Go source src with the comments
+// replaced by spaces, keeping the offsets of the rest.
+func stripComments(src []byte) []byte {
+	out := append([]byte(nil), src...)
+	fset := token.NewFileSet()
+	f := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(f, src, nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.COMMENT {
+			continue
+		}
+		off := f.Offset(pos)
+		for i := off; i < off+len(lit); i++ {
+			if out[i] != '\n' {
+				out[i] = ' '
+			}
+		}
+	}
+	return out
+}
+
+// lines returns the non-blank lines of text, without trailing spaces.
+func lines(text []byte) string {
+	var b bytes.Buffer
+	for _, l := range strings.Split(string(text), "\n") {
+		if l = strings.TrimRight(l, " \t\r"); l != "" {
+			b.WriteString(l)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
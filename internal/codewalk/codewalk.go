@@ -0,0 +1,180 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package codewalk reads the translated codewalks of doc/zh_CN/codewalk.
+//
+// A codewalk is an XML file of steps, each showing a region of a file,
+// named by its src attribute, and explaining it:
+//
+//	<step title="The Chain struct" src="doc/codewalk/markov.go:/type Chain/,/}/">
+//
+// The part of src after the colon is an address in the syntax of acme and
+// sam (see internal/address). In the translated codewalks, each English
+// step is kept in a <div class="english"> and followed by its Chinese
+// translation, whose address may differ to select the Chinese comments of
+// the file.
+package codewalk // import "github.com/golang-china/golangdoc.translations/internal/codewalk"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/address"
+)
+
+// A Codewalk is a translated codewalk.
+type Codewalk struct {
+	Name         string // file name
+	Title        string
+	EnglishTitle string // title of the English codewalk, kept in a comment
+	Pairs        []Pair
+}
+
+// A Pair is an English step and its translation. Either may be nil if the
+// codewalk lacks it.
+type Pair struct {
+	English, Chinese *Step
+}
+
+// A Step is a step of a codewalk.
+type Step struct {
+	Title string `xml:"title,attr"`
+	Src   string `xml:"src,attr"`
+	XML   string `xml:",innerxml"` // the explanation, in HTML
+	Line  int    `xml:"-"`         // line of the step in the codewalk
+}
+
+// File returns the name of the file shown by s, such as
+// "doc/codewalk/markov.go".
+func (s *Step) File() string {
+	if i := strings.Index(s.Src, ":"); i >= 0 {
+		return s.Src[:i]
+	}
+	return s.Src
+}
+
+// Addr returns the address of the region of the file shown by s, or "" for
+// the whole file.
+func (s *Step) Addr() string {
+	if i := strings.Index(s.Src, ":"); i >= 0 {
+		return s.Src[i+1:]
+	}
+	return ""
+}
+
+// Range returns the byte offsets of the region shown by s of data, the
+// content of its file, extended to whole lines as godoc does.
+func (s *Step) Range(data []byte) (lo, hi int, err error) {
+	return address.Range(s.Addr(), data)
+}
+
+// Path returns the name of the file shown by a step of a codewalk in the
+// translation, given the directory of the translated documents, doc/zh_CN.
+// The steps name their files relative to the Go tree, such as
+// "doc/codewalk/markov.go"; those of doc are in the translated documents,
+// the others in the tree holding them.
+func Path(docDir, file string) string {
+	if strings.HasPrefix(file, "doc/") {
+		return filepath.Join(docDir, filepath.FromSlash(strings.TrimPrefix(file, "doc/")))
+	}
+	return filepath.Join(docDir, "..", "..", filepath.FromSlash(file))
+}
+
+var englishTitleRE = regexp.MustCompile(`^\s*<codewalk\s+title="([^"]*)"`)
+
+// Read reads the named codewalk.
+func Read(name string) (*Codewalk, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	cw, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	cw.Name = name
+	return cw, nil
+}
+
+// Parse parses the text of a codewalk, leniently, as godoc does.
+func Parse(data []byte) (*Codewalk, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	cw := new(Codewalk)
+	var (
+		divs    []bool // whether each open div is an English one
+		english *Step  // English step waiting for its translation
+	)
+	inEnglish := func() bool {
+		for _, e := range divs {
+			if e {
+				return true
+			}
+		}
+		return false
+	}
+	for {
+		off := d.InputOffset()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.Comment:
+			if m := englishTitleRE.FindSubmatch(tok); m != nil && cw.EnglishTitle == "" {
+				cw.EnglishTitle = string(m[1])
+			}
+		case xml.StartElement:
+			switch tok.Name.Local {
+			case "codewalk":
+				for _, a := range tok.Attr {
+					if a.Name.Local == "title" {
+						cw.Title = a.Value
+					}
+				}
+			case "div":
+				isEnglish := false
+				for _, a := range tok.Attr {
+					if a.Name.Local == "class" && a.Value == "english" {
+						isEnglish = true
+					}
+				}
+				divs = append(divs, isEnglish)
+			case "step":
+				s := &Step{Line: address.Line(data, int(off))}
+				if err := d.DecodeElement(s, &tok); err != nil {
+					return nil, err
+				}
+				if inEnglish() {
+					if english != nil {
+						cw.Pairs = append(cw.Pairs, Pair{English: english})
+					}
+					english = s
+					continue
+				}
+				cw.Pairs = append(cw.Pairs, Pair{English: english, Chinese: s})
+				english = nil
+			}
+		case xml.EndElement:
+			if tok.Name.Local == "div" && len(divs) > 0 {
+				divs = divs[:len(divs)-1]
+			}
+		}
+	}
+	if english != nil {
+		cw.Pairs = append(cw.Pairs, Pair{English: english})
+	}
+	return cw, nil
+}
@@ -0,0 +1,328 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package po reads and writes translation units in the PO format of GNU
+// gettext, so that translators can use the usual PO editors.
+//
+// Only the subset of the format that the translation tools of this
+// repository need is supported: translator and extracted comments,
+// references, flags, contexts, and singular messages. Obsolete entries are
+// dropped when reading.
+//
+// Writing a File and reading it back yields the same File.
+package po // import "github.com/golang-china/golangdoc.translations/internal/po"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A Unit is a translation unit: a source text and its translation.
+type Unit struct {
+	Comments  []string // translator comments
+	Extracted []string // comments of the extraction tool
+	Refs      []string // references to the source, like "file:line"
+	Flags     []string // flags, such as "fuzzy"
+	Context   string   // context, which identifies the unit in its source
+	ID        string   // source text
+	Str       string   // translation; empty if not translated
+}
+
+// Fuzzy reports whether the translation of u is marked as needing review.
+func (u *Unit) Fuzzy() bool {
+	for _, f := range u.Flags {
+		if f == "fuzzy" {
+			return true
+		}
+	}
+	return false
+}
+
+// A File is a PO file.
+type File struct {
+	Header string // message of the header entry, made of "Name: value\n" fields
+	Units  []*Unit
+}
+
+// Header returns the header message with the usual fields for a file in
+// the given language.
+func Header(lang string) string {
+	return "Project-Id-Version: golangdoc.translations\n" +
+		"Language: " + lang + "\n" +
+		"MIME-Version: 1.0\n" +
+		"Content-Type: text/plain; charset=UTF-8\n" +
+		"Content-Transfer-Encoding: 8bit\n"
+}
+
+// Write writes f to w in PO format.
+func Write(w io.Writer, f *File) error {
+	var b bytes.Buffer
+	if f.Header != "" {
+		writeString(&b, "msgid", "")
+		writeString(&b, "msgstr", f.Header)
+	}
+	for _, u := range f.Units {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		for _, c := range u.Comments {
+			writeComment(&b, "#", c)
+		}
+		for _, c := range u.Extracted {
+			writeComment(&b, "#.", c)
+		}
+		for _, r := range u.Refs {
+			b.WriteString("#: " + r + "\n")
+		}
+		if len(u.Flags) > 0 {
+			b.WriteString("#, " + strings.Join(u.Flags, ", ") + "\n")
+		}
+		if u.Context != "" {
+			writeString(&b, "msgctxt", u.Context)
+		}
+		writeString(&b, "msgid", u.ID)
+		writeString(&b, "msgstr", u.Str)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func writeComment(b *bytes.Buffer, prefix, c string) {
+	for _, line := range strings.Split(c, "\n") {
+		if line == "" {
+			b.WriteString(prefix + "\n")
+		} else {
+			b.WriteString(prefix + " " + line + "\n")
+		}
+	}
+}
+
+// writeString writes a keyword and its string. Strings with line breaks
+// are written one line per quoted string, after an empty one, as gettext
+// does.
+func writeString(b *bytes.Buffer, keyword, s string) {
+	if !strings.Contains(s, "\n") || s == "\n" {
+		b.WriteString(keyword + " " + quote(s) + "\n")
+		return
+	}
+	b.WriteString(keyword + " \"\"\n")
+	for s != "" {
+		i := strings.Index(s, "\n") + 1
+		if i == 0 {
+			i = len(s)
+		}
+		b.WriteString(quote(s[:i]) + "\n")
+		s = s[i:]
+	}
+}
+
+// quote quotes s with the C escapes of the PO format. Unlike strconv.Quote,
+// it leaves non-ASCII characters alone.
+func quote(s string) string {
+	var b bytes.Buffer
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// Read reads a PO file from r.
+func Read(r io.Reader) (*File, error) {
+	f := new(File)
+	var (
+		u       *Unit
+		target  *string // string that continuation lines append to
+		keyword string  // keyword of target
+		haveID  bool    // u has a msgid
+		n       int
+	)
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("line %d: %s", n, fmt.Sprintf(format, args...))
+	}
+	flush := func() error {
+		if u == nil {
+			return nil
+		}
+		if !haveID {
+			return errorf("entry without msgid")
+		}
+		if u.ID == "" && u.Context == "" {
+			f.Header = u.Str
+		} else {
+			f.Units = append(f.Units, u)
+		}
+		u, target, haveID = nil, nil, false
+		return nil
+	}
+	unit := func() *Unit {
+		if u == nil {
+			u = new(Unit)
+		}
+		return u
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		n++
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		case strings.HasPrefix(line, "#~"):
+			// Obsolete entry.
+			continue
+		case strings.HasPrefix(line, "#"):
+			if haveID {
+				// Comments start a new entry.
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			kind, text := line[:1], line[1:]
+			if len(line) > 1 && strings.IndexByte(".:,", line[1]) >= 0 {
+				kind, text = line[:2], line[2:]
+			}
+			text = strings.TrimPrefix(text, " ")
+			switch kind {
+			case "#":
+				unit().Comments = append(unit().Comments, text)
+			case "#.":
+				unit().Extracted = append(unit().Extracted, text)
+			case "#:":
+				unit().Refs = append(unit().Refs, strings.TrimSpace(text))
+			case "#,":
+				for _, flag := range strings.Split(text, ",") {
+					if flag = strings.TrimSpace(flag); flag != "" {
+						unit().Flags = append(unit().Flags, flag)
+					}
+				}
+			}
+			continue
+		case strings.HasPrefix(line, `"`):
+			if target == nil {
+				return nil, errorf("string outside of an entry")
+			}
+			s, err := unquote(line)
+			if err != nil {
+				return nil, errorf("%s: %v", keyword, err)
+			}
+			*target += s
+			continue
+		}
+
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			return nil, errorf("missing string after %s", line)
+		}
+		keyword = line[:i]
+		s, err := unquote(strings.TrimSpace(line[i:]))
+		if err != nil {
+			return nil, errorf("%s: %v", keyword, err)
+		}
+		switch keyword {
+		case "msgctxt":
+			if haveID {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			unit().Context = s
+			target = &u.Context
+		case "msgid":
+			if haveID {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			unit().ID = s
+			target = &u.ID
+			haveID = true
+		case "msgstr":
+			if !haveID {
+				return nil, errorf("msgstr without msgid")
+			}
+			u.Str = s
+			target = &u.Str
+		default:
+			return nil, errorf("unsupported keyword %s", keyword)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// unquote returns the value of a quoted PO string.
+func unquote(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("bad string %s", s)
+	}
+	var b bytes.Buffer
+	s = s[1 : len(s)-1]
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+1 == len(s) {
+			return "", fmt.Errorf("trailing backslash")
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case '"', '\\':
+			b.WriteByte(s[i])
+		default:
+			return "", fmt.Errorf("unknown escape \\%c", s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// Index returns the units of f keyed by context.
+func (f *File) Index() map[string]*Unit {
+	m := make(map[string]*Unit)
+	for _, u := range f.Units {
+		m[u.Context] = u
+	}
+	return m
+}
+
+// Ref returns the reference to a line of a file, for the Refs of a Unit.
+func Ref(file string, line int) string {
+	return file + ":" + strconv.Itoa(line)
+}
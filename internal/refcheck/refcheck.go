@@ -0,0 +1,228 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package refcheck checks the files referred to by the directives of talks
+// and articles written in present format.
+//
+// The .code and .play directives name a file and an optional address that
+// selects an excerpt of it, such as
+//
+//	.play concurrency/support/boring.go /START/,/STOP.*/
+//
+// Translating the comments of such a file can silently break the address,
+// or change the excerpt shown. The checks resolve every .code, .play,
+// .image, .iframe and .html directive as present does, and report the
+// files that do not exist and the addresses that match nothing.
+//
+// Given the upstream English copy of the content, the checks also compare
+// each excerpt with the excerpt of the same address in the upstream copy of
+// the file, and report those that differ. Files that have no upstream copy
+// are not compared.
+package refcheck // import "github.com/golang-china/golangdoc.translations/internal/refcheck"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/address"
+)
+
+// A Problem is a broken reference found in a talk or an article.
+type Problem struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Msg)
+}
+
+// CheckDir checks every .slide and .article file in the tree rooted at
+// root. If upstream is not empty, it names the root of the upstream copy of
+// the tree, and the excerpts are compared with those of the upstream files.
+func CheckDir(root, upstream string) ([]Problem, error) {
+	var problems []Problem
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".slide", ".article":
+			pp, err := CheckFile(root, upstream, p)
+			problems = append(problems, pp...)
+			return err
+		}
+		return nil
+	})
+	return problems, err
+}
+
+// The syntax of .code and .play, as parsed by present: the highlight is
+// removed first, then the flags, the file name and the address are split.
+var (
+	highlightRE = regexp.MustCompile(`\s+HL([a-zA-Z0-9_]+)?$`)
+	codeRE      = regexp.MustCompile(`^\.(code|play)\s+((?:(?:-edit|-numbers)\s+)*)([^\s]+)(?:\s+(.*))?$`)
+)
+
+// CheckFile checks the named talk or article of the tree rooted at root.
+// If upstream is not empty, it names the root of the upstream copy of the
+// tree.
+func CheckFile(root, upstream, name string) ([]Problem, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c := &checker{root: root, upstream: upstream, name: name}
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if !strings.HasPrefix(line, ".") {
+			continue
+		}
+		args := strings.Fields(line)
+		switch args[0] {
+		case ".code", ".play":
+			c.checkCode(n, line)
+		case ".image", ".iframe":
+			if len(args) < 2 {
+				c.report(n, "missing URL in %s directive", args[0])
+				continue
+			}
+			c.checkURL(n, args[1])
+		case ".html":
+			if len(args) < 2 {
+				c.report(n, "missing file name in .html directive")
+				continue
+			}
+			if _, err := os.Stat(c.path(args[1])); err != nil {
+				c.report(n, "%s: no such file", args[1])
+			}
+		}
+	}
+	sort.Stable(byLine(c.problems))
+	return c.problems, sc.Err()
+}
+
+// A checker checks the references of a file.
+type checker struct {
+	root, upstream string
+	name           string // of the file
+	problems       []Problem
+}
+
+func (c *checker) report(line int, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{c.name, line, fmt.Sprintf(format, args...)})
+}
+
+// path returns the path of the file named by a directive, which, as in
+// present, is relative to the directory of the file being checked.
+func (c *checker) path(file string) string {
+	return filepath.Join(filepath.Dir(c.name), filepath.FromSlash(file))
+}
+
+// checkCode checks the .code or .play directive at line n.
+func (c *checker) checkCode(n int, line string) {
+	line = strings.TrimSpace(line)
+	if m := highlightRE.FindStringSubmatchIndex(line); m != nil {
+		line = line[:m[0]]
+	}
+	m := codeRE.FindStringSubmatch(line)
+	if m == nil {
+		c.report(n, "syntax error in .%s directive", strings.Fields(line)[0][1:])
+		return
+	}
+	file, addr := m[3], strings.TrimSpace(m[4])
+	p := c.path(file)
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.report(n, "%s: no such file", file)
+		} else {
+			c.report(n, "%v", err)
+		}
+		return
+	}
+	text, err := address.Excerpt(addr, data)
+	if err != nil {
+		c.report(n, "%s %s: %v", file, addr, err)
+		return
+	}
+	if c.upstream == "" {
+		return
+	}
+	rel, err := filepath.Rel(c.root, p)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return // outside of the tree
+	}
+	orig, err := ioutil.ReadFile(filepath.Join(c.upstream, rel))
+	if err != nil {
+		return // no upstream copy
+	}
+	want, err := address.Excerpt(addr, orig)
+	switch {
+	case err != nil:
+		c.report(n, "%s %s: %v in the upstream copy", file, addr, err)
+	case !bytes.Equal(text, want):
+		c.report(n, "%s %s: excerpt differs from the upstream copy:\n%s", file, addr, diff(want, text))
+	}
+}
+
+// checkURL checks the URL of the .image or .iframe directive at line n. Only
+// local files are checked; absolute paths are relative to the root.
+func (c *checker) checkURL(n int, url string) {
+	if strings.Contains(url, "://") || strings.HasPrefix(url, "//") {
+		return
+	}
+	ref := url
+	if i := strings.IndexAny(ref, "?#"); i >= 0 {
+		ref = ref[:i]
+	}
+	p := c.path(ref)
+	if strings.HasPrefix(ref, "/") {
+		p = filepath.Join(c.root, filepath.FromSlash(ref))
+	}
+	if _, err := os.Stat(p); err != nil {
+		c.report(n, "%s: no such file", url)
+	}
+}
+
+// diff returns the lines of the excerpts a and b that differ, after the
+// lines they have in common at the start and the end, marked with - and +.
+func diff(a, b []byte) string {
+	al := strings.SplitAfter(string(a), "\n")
+	bl := strings.SplitAfter(string(b), "\n")
+	for len(al) > 0 && len(bl) > 0 && al[0] == bl[0] {
+		al, bl = al[1:], bl[1:]
+	}
+	for len(al) > 0 && len(bl) > 0 && al[len(al)-1] == bl[len(bl)-1] {
+		al, bl = al[:len(al)-1], bl[:len(bl)-1]
+	}
+	var buf bytes.Buffer
+	for _, l := range al {
+		fmt.Fprintf(&buf, "\t-%s", l)
+	}
+	for _, l := range bl {
+		fmt.Fprintf(&buf, "\t+%s", l)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+type byLine []Problem
+
+func (p byLine) Len() int           { return len(p) }
+func (p byLine) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byLine) Less(i, j int) bool { return p[i].Line < p[j].Line }
@@ -0,0 +1,111 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sandbox
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A Diagnostic is an error reported by the compiler when building a
+// program.
+type Diagnostic struct {
+	File    string // name of the file, such as "prog.go"
+	Line    int
+	Column  int // 0 if the compiler gave none
+	Message string
+
+	// Category classifies the error; see the Category constants. The
+	// errors of other kinds have the category Other.
+	Category string
+
+	// Explanation explains errors of the category to beginners, in
+	// Chinese, or is empty for errors of unknown kinds.
+	Explanation string
+}
+
+// Categories of diagnostics.
+const (
+	UnusedVariable = "unused-variable"
+	UnusedImport   = "unused-import"
+	MissingReturn  = "missing-return"
+	TypeMismatch   = "type-mismatch"
+	Undefined      = "undefined"
+	SyntaxError    = "syntax"
+	Other          = "other"
+)
+
+// categories lists the patterns of the messages of each category, in the
+// order they are tried. The patterns cover the messages of the current
+// compiler and of older ones, such as "x declared but not used".
+var categories = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{UnusedVariable, regexp.MustCompile(`declared (and|but) not used`)},
+	{UnusedImport, regexp.MustCompile(`imported (and|but) not used`)},
+	{MissingReturn, regexp.MustCompile(`^missing return`)},
+	{TypeMismatch, regexp.MustCompile(`mismatched types|^cannot use .* as .* in |^cannot convert`)},
+	{Undefined, regexp.MustCompile(`^undefined: `)},
+	{SyntaxError, regexp.MustCompile(`^syntax error`)},
+}
+
+// explanations holds the explanation of each category.
+var explanations = map[string]string{
+	UnusedVariable: "Go 不允许声明了却从未使用的局部变量。请删除这个变量，或者在后面用到它；" +
+		"调试时若想暂时保留它，可以写 _ = 变量名。",
+	UnusedImport: "Go 不允许导入了却从未使用的包。请删除这条导入，或者在代码中用到这个包；" +
+		"若只需要包的初始化效果，可以写 import _ \"包路径\"。",
+	MissingReturn: "函数声明了返回值，但并非每条执行路径都以 return 语句结束。" +
+		"请在函数末尾（或每个分支的末尾）加上 return 语句；Go 不会替你推断返回值。",
+	TypeMismatch: "Go 不会在类型之间隐式转换：运算的两个操作数，以及赋值的两边，类型必须相同。" +
+		"请用显式的类型转换，例如 float64(i) 或 int(f)，或者检查变量的声明。",
+	Undefined: "使用了没有定义的名字。请检查拼写和大小写（别的包导出的名字以大写字母开头，" +
+		"例如 fmt.Println），以及是否导入了所需的包。",
+	SyntaxError: "代码不符合 Go 的语法。常见的原因是括号或花括号不配对、参数之间缺少逗号，" +
+		"或者把左花括号 { 放在了下一行：Go 会在行尾自动插入分号。",
+}
+
+// diagLine matches the lines of compiler output that start an error:
+// file.go:line:column: message, the column being optional.
+var diagLine = regexp.MustCompile(`^([^:\s]+\.go):(\d+)(?::(\d+))?: (.*)$`)
+
+// Diagnostics parses the build errors of a Response into diagnostics.
+// Indented lines continue the message of the previous error; other lines
+// are ignored.
+func Diagnostics(errors string) []Diagnostic {
+	var diags []Diagnostic
+	for _, l := range strings.Split(errors, "\n") {
+		if m := diagLine.FindStringSubmatch(l); m != nil {
+			line, _ := strconv.Atoi(m[2])
+			col, _ := strconv.Atoi(m[3])
+			cat := category(m[4])
+			diags = append(diags, Diagnostic{
+				File:        m[1],
+				Line:        line,
+				Column:      col,
+				Message:     m[4],
+				Category:    cat,
+				Explanation: explanations[cat],
+			})
+			continue
+		}
+		if n := len(diags); n > 0 && strings.HasPrefix(l, "\t") {
+			diags[n-1].Message += "\n" + l
+		}
+	}
+	return diags
+}
+
+// category returns the category of the compiler error msg.
+func category(msg string) string {
+	for _, c := range categories {
+		if c.pattern.MatchString(msg) {
+			return c.name
+		}
+	}
+	return Other
+}
@@ -0,0 +1,305 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sandbox compiles and runs Go snippets on the local machine.
+//
+// A Runner implements the "/compile" protocol of the golang.org playground,
+// so the playground JavaScript used by the blog, the talks and the tour works
+// unchanged without access to the network. Each program is built and run in
+// its own temporary directory with a minimal environment, under a time limit
+// and an output size limit, and only a limited number of programs run at
+// once.
+//
+// The sandbox protects against runaway programs, not against malicious ones:
+// programs run with the privileges of the server.
+package sandbox // import "github.com/golang-china/golangdoc.translations/internal/sandbox"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config specifies the limits under which a Runner runs programs.
+// Zero values select the defaults.
+type Config struct {
+	Timeout      time.Duration // limit on the run time of a program (default 10s)
+	BuildTimeout time.Duration // limit on the build time of a program (default 60s)
+	MaxOutput    int           // limit on the bytes of output of a program (default 100KB)
+	MaxRunning   int           // limit on the number of programs built or run at once (default 4)
+	GoTool       string        // path of the go command (default: the one in GOROOT)
+
+	// GOPATH lists workspaces holding packages that programs may import
+	// besides the standard library, such as the support packages of the
+	// tour. They are searched in order after the program's own directory.
+	GOPATH []string
+}
+
+// Response is the reply to a compile request. It is encoded as JSON in the
+// form the playground JavaScript expects.
+type Response struct {
+	Errors string  // build errors, or "process took too long"
+	Events []Event // program output, in order
+	Status int     // exit status of the program
+
+	// IsTest and TestsFailed report the results of a program that runs
+	// tests. The Runner does not set them; its callers do when they run
+	// tests, and the playground JavaScript then shows how many failed.
+	IsTest      bool
+	TestsFailed int
+
+	// Diagnostics are the build errors, parsed. The playground
+	// JavaScript ignores them; the tour shows their explanations.
+	Diagnostics []Diagnostic `json:",omitempty"`
+}
+
+// Event is a piece of program output.
+type Event struct {
+	Message string
+	Kind    string        // "stdout" or "stderr"
+	Delay   time.Duration // time since the previous event
+}
+
+// errTimeout is the error the playground reports for programs that run too
+// long. The JavaScript front end recognizes this exact message.
+const errTimeout = "process took too long"
+
+// A Runner builds and runs programs. It is an http.Handler that serves the
+// playground "/compile" endpoint.
+type Runner struct {
+	cfg      Config
+	running  chan bool // semaphore limiting the number of running programs
+	cacheDir string    // build cache shared by all programs
+}
+
+// NewRunner returns a Runner that runs programs within the limits of cfg.
+func NewRunner(cfg Config) *Runner {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.BuildTimeout <= 0 {
+		cfg.BuildTimeout = 60 * time.Second
+	}
+	if cfg.MaxOutput <= 0 {
+		cfg.MaxOutput = 100 << 10
+	}
+	if cfg.MaxRunning <= 0 {
+		cfg.MaxRunning = 4
+	}
+	if cfg.GoTool == "" {
+		cfg.GoTool = filepath.Join(runtime.GOROOT(), "bin", "go")
+		if _, err := os.Stat(cfg.GoTool); err != nil {
+			cfg.GoTool = "go"
+		}
+	}
+	return &Runner{
+		cfg:      cfg,
+		running:  make(chan bool, cfg.MaxRunning),
+		cacheDir: filepath.Join(os.TempDir(), "sandbox-gocache"),
+	}
+}
+
+// ServeHTTP compiles and runs the program in the "body" form value and
+// replies with the JSON encoding of its Response.
+func (r *Runner) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(w, "POST only", http.StatusMethodNotAllowed)
+		return
+	}
+	resp, err := r.Run(req.Context(), req.FormValue("body"))
+	if err != nil {
+		log.Printf("sandbox: %v", err)
+		http.Error(w, "error running program", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("sandbox: %v", err)
+	}
+}
+
+// Run builds and runs the Go program in body. Build errors, timeouts and
+// failures of the program itself are reported in the Response; the error is
+// non-nil only if the sandbox could not do its job.
+func (r *Runner) Run(ctx context.Context, body string) (*Response, error) {
+	return r.RunFiles(ctx, map[string][]byte{"prog.go": []byte(body)})
+}
+
+// RunFiles is like Run but builds the program from several files, given by
+// name. The names must not contain path separators.
+func (r *Runner) RunFiles(ctx context.Context, files map[string][]byte) (*Response, error) {
+	select {
+	case r.running <- true:
+		defer func() { <-r.running }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	dir, err := ioutil.TempDir("", "sandbox")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var names []string
+	for name, b := range files {
+		if strings.ContainsAny(name, `/\`) || !strings.HasSuffix(name, ".go") {
+			return nil, fmt.Errorf("bad file name %q", name)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0644); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	bin := filepath.Join(dir, "prog")
+	if runtime.GOOS == "windows" {
+		bin += ".exe"
+	}
+	bctx, cancel := context.WithTimeout(ctx, r.cfg.BuildTimeout)
+	defer cancel()
+	build := exec.CommandContext(bctx, r.cfg.GoTool, append([]string{"build", "-o", bin}, names...)...)
+	build.Dir = dir
+	build.Env = r.buildEnv(dir)
+	if out, err := build.CombinedOutput(); err != nil {
+		if bctx.Err() == context.DeadlineExceeded {
+			return &Response{Errors: errTimeout}, nil
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return nil, fmt.Errorf("running go build: %v", err)
+		}
+		errs := cleanOutput(string(out), dir)
+		return &Response{Errors: errs, Diagnostics: Diagnostics(errs)}, nil
+	}
+
+	return r.run(ctx, dir, bin)
+}
+
+// run runs the program bin in dir and records its output.
+func (r *Runner) run(ctx context.Context, dir, bin string) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	rec := &recorder{limit: r.cfg.MaxOutput, last: time.Now(), kill: cancel}
+	cmd := exec.CommandContext(ctx, bin)
+	cmd.Dir = dir
+	cmd.Env = []string{"HOME=" + dir, "TMPDIR=" + dir, "PATH="}
+	cmd.Stdout = &eventWriter{rec, "stdout"}
+	cmd.Stderr = &eventWriter{rec, "stderr"}
+	err := cmd.Run()
+
+	resp := &Response{Events: rec.events}
+	switch {
+	case rec.truncated:
+		resp.Events = append(resp.Events, Event{
+			Message: fmt.Sprintf("\n[输出超过 %d 字节，程序已被终止]\n", r.cfg.MaxOutput),
+			Kind:    "stderr",
+		})
+	case ctx.Err() == context.DeadlineExceeded:
+		resp.Errors = errTimeout
+	case err != nil:
+		e, ok := err.(*exec.ExitError)
+		if !ok {
+			return nil, fmt.Errorf("running program: %v", err)
+		}
+		resp.Status = e.ExitCode()
+	}
+	return resp, nil
+}
+
+// buildEnv returns the environment for the go command building a program in
+// dir: enough to find the toolchain, a build cache and the workspaces of
+// Config.GOPATH, and nothing that lets the build reach the network or the
+// user's own workspace.
+func (r *Runner) buildEnv(dir string) []string {
+	env := []string{
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"GOPATH=" + strings.Join(append([]string{dir}, r.cfg.GOPATH...), string(filepath.ListSeparator)),
+		"GOCACHE=" + r.cacheDir,
+		"GO111MODULE=off",
+		"GOPROXY=off",
+		"GOFLAGS=",
+		"CGO_ENABLED=0",
+	}
+	for _, key := range []string{"PATH", "GOROOT", "SystemRoot"} {
+		if v := os.Getenv(key); v != "" {
+			env = append(env, key+"="+v)
+		}
+	}
+	return env
+}
+
+// cleanOutput removes the temporary directory from the file names in
+// compiler output.
+func cleanOutput(out, dir string) string {
+	out = strings.Replace(out, dir+string(filepath.Separator), "", -1)
+	var lines []string
+	for _, l := range strings.Split(out, "\n") {
+		if strings.HasPrefix(l, "# ") {
+			continue // package name header
+		}
+		lines = append(lines, strings.TrimPrefix(l, "./"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// A recorder collects the output of a program as a list of events.
+type recorder struct {
+	mu        sync.Mutex
+	events    []Event
+	size      int
+	limit     int
+	truncated bool
+	last      time.Time
+	kill      func()
+}
+
+func (r *recorder) write(kind string, b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.truncated {
+		return 0, errOutputLimit
+	}
+	n := len(b)
+	if r.size+len(b) > r.limit {
+		b = b[:r.limit-r.size]
+		r.truncated = true
+		r.kill()
+	}
+	r.size += len(b)
+	now := time.Now()
+	if i := len(r.events) - 1; i >= 0 && r.events[i].Kind == kind && now.Sub(r.last) < 10*time.Millisecond {
+		// Merge output that arrives in quick succession.
+		r.events[i].Message += string(b)
+	} else {
+		r.events = append(r.events, Event{Message: string(b), Kind: kind, Delay: now.Sub(r.last)})
+		r.last = now
+	}
+	if r.truncated {
+		return len(b), errOutputLimit
+	}
+	return n, nil
+}
+
+var errOutputLimit = errors.New("output limit exceeded")
+
+// An eventWriter writes to a recorder as one kind of output.
+type eventWriter struct {
+	r    *recorder
+	kind string
+}
+
+func (w *eventWriter) Write(b []byte) (int, error) { return w.r.write(w.kind, b) }
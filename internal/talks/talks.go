@@ -0,0 +1,171 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package talks parses and renders the bilingual talks of talks/zh_CN.
+//
+// A slide, or a section of an article, is translated by a subsection that
+// comes last in it and starts with a .translation directive naming the
+// language of the translation and, optionally, the hash of the English text
+// it translates (see Hash). The subsection holds the translated title, body
+// and speaker notes, as in this slide:
+//
+//	# A slide of 2014/hellogophers.slide.
+//	* Hello, gophers!
+//
+//	.play hellogophers/hellogophers.go
+//
+//	: Say hello to everyone in the room.
+//
+//	** 你好，地鼠们！
+//	.translation zh_CN 5f0c7e1a
+//
+//	.play hellogophers/hellogophers.go
+//
+//	: 向在场的每一位问好。
+//
+// The translation of a section of an article is one level deeper than the
+// other subsections of the section, and follows them. The templates show the
+// English slide, the Chinese one, or, for articles, both side by side.
+package talks // import "github.com/golang-china/golangdoc.translations/internal/talks"
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/present"
+)
+
+func init() {
+	present.Register("translation", parseTranslation)
+}
+
+// A Translation marks the section it starts as the translation of its
+// parent section.
+type Translation struct {
+	Lang   string // such as "zh_CN"
+	Source string // hash of the English text translated, or "" if unknown
+}
+
+func (t Translation) TemplateName() string { return "translation" }
+
+func parseTranslation(_ *present.Context, fileName string, lineno int, text string) (present.Elem, error) {
+	args := strings.Fields(text)
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("%s:%d: syntax: .translation <lang> [hash]", fileName, lineno)
+	}
+	t := Translation{Lang: args[1]}
+	if len(args) == 3 {
+		t.Source = args[2]
+	}
+	return t, nil
+}
+
+// Hash returns the hash of the English text of a slide or section, made of
+// its title, body and speaker notes, that records which text a translation
+// translates.
+func Hash(title string, body, notes []string) string {
+	h := sha1.New()
+	io.WriteString(h, title+"\n\n")
+	io.WriteString(h, strings.Join(body, "\n")+"\n\n")
+	io.WriteString(h, strings.Join(notes, "\n"))
+	return fmt.Sprintf("%x", h.Sum(nil))[:8]
+}
+
+// IsTranslation reports whether s is the translation of its parent section.
+func IsTranslation(s present.Section) bool {
+	if len(s.Elem) == 0 {
+		return false
+	}
+	_, ok := s.Elem[0].(Translation)
+	return ok
+}
+
+// TranslationOf returns the translation among the elements of a section, or
+// nil if the section has none.
+func TranslationOf(elems []present.Elem) *present.Section {
+	for _, e := range elems {
+		if s, ok := e.(present.Section); ok && IsTranslation(s) {
+			return &s
+		}
+	}
+	return nil
+}
+
+// Body returns the elements of a section other than its subsections.
+func Body(elems []present.Elem) []present.Elem {
+	var body []present.Elem
+	for _, e := range elems {
+		switch e.(type) {
+		case present.Section, Translation:
+		default:
+			body = append(body, e)
+		}
+	}
+	return body
+}
+
+// Subsections returns the subsections of a section, without its
+// translation.
+func Subsections(elems []present.Elem) []present.Section {
+	var subs []present.Section
+	for _, e := range elems {
+		if s, ok := e.(present.Section); ok && !IsTranslation(s) {
+			subs = append(subs, s)
+		}
+	}
+	return subs
+}
+
+// Progress returns the number of sections of doc, the slides of a talk or
+// the sections of an article, and how many of them are translated.
+func Progress(doc *present.Doc) (translated, total int) {
+	for _, s := range doc.Sections {
+		if TranslationOf(s.Elem) != nil {
+			translated++
+		}
+	}
+	return translated, len(doc.Sections)
+}
+
+// Funcs are the functions of the talk templates besides those of present:
+//
+//	translation  TranslationOf
+//	body         Body
+//	subsections  Subsections
+//	playable     whether a code element can be run
+var Funcs = template.FuncMap{
+	"translation": TranslationOf,
+	"body":        Body,
+	"subsections": Subsections,
+	"playable":    playable,
+}
+
+// playable reports whether c can be run: the runner of the talks runs Go
+// programs only.
+func playable(c present.Code) bool {
+	return present.PlayEnabled && c.Play && c.Ext == ".go"
+}
+
+// Templates parses the templates of the talks in the directory dir: the
+// action template and, for each kind of talk, the template of its pages. It
+// returns the templates keyed by the extension of the talks, ".slide" or
+// ".article".
+func Templates(dir string) (map[string]*template.Template, error) {
+	tmpls := make(map[string]*template.Template)
+	for ext, name := range map[string]string{
+		".slide":   "slides.tmpl",
+		".article": "article.tmpl",
+	} {
+		t := present.Template().Funcs(Funcs)
+		if _, err := t.ParseFiles(filepath.Join(dir, "action.tmpl"), filepath.Join(dir, name)); err != nil {
+			return nil, err
+		}
+		tmpls[ext] = t
+	}
+	return tmpls, nil
+}
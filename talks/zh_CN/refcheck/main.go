@@ -0,0 +1,87 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command refcheck checks the references of the talks, or of the blog
+// articles, to the files they show: the .code, .play, .image, .iframe and
+// .html directives.
+//
+// Usage:
+//
+//	refcheck [-upstream=dir] [dir]
+//
+// With no arguments, refcheck checks the talks in talks/zh_CN/content,
+// found in the current directory or its parent. To check the blog, name its
+// content directory:
+//
+//	refcheck blog/zh_CN/content
+//
+// It reports the files that do not exist and the addresses, such as
+// /START/,/STOP/, that match nothing in their file. The -upstream flag names
+// the upstream English copy of the directory, such as the root of a checkout
+// of golang.org/x/talks; refcheck then also reports the excerpts that differ
+// from those of the upstream copy, as happens when the comments of an
+// example are translated.
+//
+// Refcheck exits with status 1 if it finds problems.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-china/golangdoc.translations/internal/refcheck"
+)
+
+var upstream = flag.String("upstream", "", "upstream English copy of the directory, for comparing excerpts")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: refcheck [-upstream=dir] [dir]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("refcheck: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	var root string
+	switch flag.NArg() {
+	case 0:
+		var err error
+		if root, err = defaultRoot(); err != nil {
+			log.Fatal(err)
+		}
+	case 1:
+		root = flag.Arg(0)
+	default:
+		usage()
+	}
+
+	problems, err := refcheck.CheckDir(root, *upstream)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, p := range problems {
+		fmt.Fprintln(os.Stderr, p)
+	}
+	if len(problems) > 0 {
+		log.Fatalf("%d problems found in %s", len(problems), root)
+	}
+}
+
+// defaultRoot returns the content directory of the talks.
+func defaultRoot() (string, error) {
+	for _, dir := range []string{".", ".."} {
+		root := filepath.Join(dir, "content")
+		if fi, err := os.Stat(root); err == nil && fi.IsDir() {
+			return root, nil
+		}
+	}
+	return "", fmt.Errorf("no talks found; run refcheck in talks/zh_CN or name the directory")
+}
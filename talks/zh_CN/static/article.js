@@ -0,0 +1,68 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A bilingual article holds the English and the Chinese text of its
+// translated sections; see internal/talks. The reader shows either language,
+// or both side by side, and switches with the link in the top bar or with
+// the L key. The choice is kept for the next articles.
+
+(function() {
+  'use strict';
+
+  var LANGUAGES = ['zh', 'en', 'both'];
+  var LABELS = {zh: '中文', en: 'English', both: '中英对照'};
+
+  var link;
+
+  function getLanguage() {
+    var lang = null;
+    try {
+      lang = localStorage.getItem('articleLang');
+    } catch (e) {
+      // Local storage may be disabled.
+    }
+    return LANGUAGES.indexOf(lang) < 0 ? LANGUAGES[0] : lang;
+  }
+
+  function setLanguage(lang) {
+    for (var i = 0; i < LANGUAGES.length; i++) {
+      document.body.classList.remove('lang-' + LANGUAGES[i]);
+    }
+    document.body.classList.add('lang-' + lang);
+    if (link) {
+      var next = LANGUAGES[(LANGUAGES.indexOf(lang) + 1) % LANGUAGES.length];
+      link.textContent = LABELS[next];
+    }
+    try {
+      localStorage.setItem('articleLang', lang);
+    } catch (e) {
+    }
+  }
+
+  function nextLanguage() {
+    var i = LANGUAGES.indexOf(getLanguage());
+    setLanguage(LANGUAGES[(i + 1) % LANGUAGES.length]);
+  }
+
+  document.addEventListener('DOMContentLoaded', function() {
+    if (!document.querySelector('.bilingual')) return;
+    link = document.getElementById('lang-switch');
+    if (link) {
+      link.style.display = '';
+      link.addEventListener('click', function(e) {
+        nextLanguage();
+        e.preventDefault();
+      }, false);
+    }
+    setLanguage(getLanguage());
+
+    document.addEventListener('keydown', function(e) {
+      var t = e.target;
+      if (t.isContentEditable || t.tagName == 'INPUT' || t.tagName == 'TEXTAREA') return;
+      if (e.keyCode != 76 || e.ctrlKey || e.altKey || e.metaKey) return; // L
+      nextLanguage();
+      e.preventDefault();
+    }, false);
+  }, false);
+})();
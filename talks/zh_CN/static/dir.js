@@ -39,3 +39,32 @@ function godocs_bindSearchEvents() {
 }
 
 bindEvent(window, 'load', godocs_bindSearchEvents);
+
+// The index of the talks is filtered as the query is typed: the rows shown
+// hold all the words of the query.
+function talks_bindIndexFilter() {
+  var filter = document.getElementById('filter');
+  var table = document.getElementById('index');
+  if (!filter || !table) {
+    return;
+  }
+  function update() {
+    var words = filter.value.toLowerCase().split(/\s+/);
+    var rows = table.getElementsByTagName('tr');
+    for (var i = 1; i < rows.length; i++) {
+      var text = (rows[i].textContent || rows[i].innerText).toLowerCase();
+      var show = true;
+      for (var j = 0; j < words.length; j++) {
+        if (words[j] && text.indexOf(words[j]) < 0) {
+          show = false;
+          break;
+        }
+      }
+      rows[i].style.display = show ? '' : 'none';
+    }
+  }
+  bindEvent(filter, 'input', update);
+  bindEvent(filter, 'keyup', update);
+}
+
+bindEvent(window, 'load', talks_bindIndexFilter);
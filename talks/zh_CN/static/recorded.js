@@ -0,0 +1,119 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// RecordedTransport plays back the output of the snippets of a talk, as
+// recorded when the talk was exported to static HTML, in place of running
+// them. The recordings are those of window.recordedOutput: a list of
+// {Body, Nondeterministic, Response}, where Body is the text of the
+// snippet and Response what the playground returned for it.
+//
+// Snippets whose output varies from run to run are marked on the slides,
+// and so are edited snippets, for which nothing was recorded.
+function RecordedTransport() {
+  'use strict';
+
+  var recordings = {};
+  var list = window.recordedOutput || [];
+  for (var i = 0; i < list.length; i++) {
+    recordings[key(list[i].Body)] = list[i];
+  }
+
+  // text returns the text of a snippet, as play.js does.
+  function text(node) {
+    var s = '';
+    for (var i = 0; i < node.childNodes.length; i++) {
+      var n = node.childNodes[i];
+      if (n.nodeType === 1) {
+        if (n.tagName === 'BUTTON') continue;
+        if (n.tagName === 'SPAN' && n.className === 'number') continue;
+        if (n.tagName === 'DIV' || n.tagName == 'BR') {
+          s += '\n';
+        }
+        s += text(n);
+        continue;
+      }
+      if (n.nodeType === 3) {
+        s += n.nodeValue;
+      }
+    }
+    return s.replace('\xA0', ' ');
+  }
+
+  function key(body) {
+    return body.replace(/^\s+|\s+$/g, '');
+  }
+
+  // Mark the snippets whose recorded output is only an example.
+  var play = document.querySelectorAll('div.playground');
+  for (var i = 0; i < play.length; i++) {
+    var r = recordings[key(text(play[i]))];
+    if (r && r.Nondeterministic) {
+      play[i].classList.add('nondeterministic');
+      var note = document.createElement('div');
+      note.className = 'recorded-note';
+      note.innerHTML = '此程序每次运行的输出不同，下面是导出时记录的一次输出。' +
+          ' (Output varies from run to run; this is one recorded run.)';
+      play[i].parentNode.insertBefore(note, play[i]);
+    }
+  }
+
+  function playback(output, r) {
+    var resp = r.Response;
+    var events = (resp.Events || []).slice();
+    var timeout;
+    output({Kind: 'start'});
+    if (r.Nondeterministic) {
+      output({Kind: 'system', Body: '[记录的输出，每次运行可能不同]\n'});
+    }
+    if (resp.Errors && resp.Errors !== 'process took too long') {
+      output({Kind: 'stderr', Body: resp.Errors});
+      output({Kind: 'system', Body: '\nGo build failed.'});
+      return {Stop: function() {}};
+    }
+    function next() {
+      if (events.length === 0) {
+        if (resp.Status > 0) {
+          output({Kind: 'end', Body: 'status ' + resp.Status + '.'});
+        } else if (resp.Errors) {
+          output({Kind: 'end', Body: resp.Errors + '.'});
+        } else {
+          output({Kind: 'end'});
+        }
+        return;
+      }
+      var e = events.shift();
+      timeout = setTimeout(function() {
+        output({Kind: e.Kind, Body: e.Message});
+        next();
+      }, e.Delay / 1000000);
+    }
+    next();
+    return {
+      Stop: function() {
+        clearTimeout(timeout);
+      }
+    };
+  }
+
+  return {
+    Run: function(body, output, options) {
+      var r = recordings[key(body)];
+      if (!r) {
+        output({Kind: 'start'});
+        output({Kind: 'system', Body: '这是离线的静态页面，只记录了原始代码的输出；' +
+            '修改后的代码无法运行。\n(This static copy only has the recorded ' +
+            'output of the original code.)'});
+        output({Kind: 'end'});
+        return {Kill: function() {}};
+      }
+      var playing = playback(output, r);
+      return {
+        Kill: function() {
+          playing.Stop();
+          output({Kind: 'end', Body: 'killed'});
+        }
+      };
+    }
+  };
+}
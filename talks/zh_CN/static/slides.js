@@ -420,7 +420,53 @@ function handleBodyKeyDown(event) {
       prevSlide();
       event.preventDefault();
       break;
+
+    case 76: // L
+    case 78: // N
+      if (inCode || event.ctrlKey || event.altKey || event.metaKey) break;
+      handleLanguageKey(event.keyCode);
+      event.preventDefault();
+      break;
+  }
+};
+
+/* Languages and speaker notes */
+
+// A bilingual slide holds an English and a Chinese version; see
+// internal/talks. L switches the language of all the slides, and the choice
+// is kept for the next talks. N shows or hides the speaker notes of the
+// slides in the current language.
+
+var LANGUAGES = ['zh', 'en'];
+
+function setLanguage(lang) {
+  for (var i = 0; i < LANGUAGES.length; i++) {
+    document.body.classList.remove('lang-' + LANGUAGES[i]);
+  }
+  document.body.classList.add('lang-' + lang);
+  try {
+    localStorage.setItem('talksLang', lang);
+  } catch (e) {
+    // Local storage may be disabled.
+  }
+};
+
+function getLanguage() {
+  var lang = null;
+  try {
+    lang = localStorage.getItem('talksLang');
+  } catch (e) {
+  }
+  return LANGUAGES.indexOf(lang) < 0 ? LANGUAGES[0] : lang;
+};
+
+function handleLanguageKey(keyCode) {
+  if (keyCode == 78) {
+    document.body.classList.toggle('show-notes');
+    return;
   }
+  var i = LANGUAGES.indexOf(getLanguage());
+  setLanguage(LANGUAGES[(i + 1) % LANGUAGES.length]);
 };
 
 function addEventListeners() {
@@ -475,6 +521,7 @@ function handleDomLoaded() {
   addGeneralStyle();
   addPrintStyle();
   addEventListeners();
+  setLanguage(getLanguage());
 
   updateSlides();
 
@@ -0,0 +1,160 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/present"
+)
+
+// The directory listings and the rendering of the talks follow those of the
+// present command.
+
+// dirHandler serves the talk, or the directory listing, of the requested
+// path, rooted at *contentPath. Other files are served as they are.
+func dirHandler(w http.ResponseWriter, r *http.Request) {
+	name := filepath.Join(*contentPath, filepath.FromSlash(r.URL.Path))
+	if isDoc(name) {
+		if err := renderDoc(w, name); err != nil {
+			if os.IsNotExist(err) {
+				http.NotFound(w, r)
+				return
+			}
+			log.Println(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if isDir, err := dirList(w, name); err != nil {
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		}
+		log.Printf("request for %s: %v", r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if isDir {
+		return
+	}
+	http.FileServer(http.Dir(*contentPath)).ServeHTTP(w, r)
+}
+
+func isDoc(path string) bool {
+	_, ok := contentTemplate[filepath.Ext(path)]
+	return ok
+}
+
+// renderDoc parses the named talk and writes it, rendered by its template,
+// to w.
+func renderDoc(w io.Writer, name string) error {
+	doc, err := parse(name, 0)
+	if err != nil {
+		return err
+	}
+	return doc.Render(w, contentTemplate[filepath.Ext(name)])
+}
+
+func parse(name string, mode present.ParseMode) (*present.Doc, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return present.Parse(f, name, mode)
+}
+
+// dirList writes the listing of the directory name to w. It parses the
+// header of each talk to show its title. If name is not a directory, it
+// returns (false, nil) and writes nothing.
+func dirList(w io.Writer, name string) (isDir bool, err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	if isDir = fi.IsDir(); !isDir {
+		return false, nil
+	}
+	fis, err := f.Readdir(0)
+	if err != nil {
+		return false, err
+	}
+	strippedPath := strings.TrimPrefix(name, filepath.Clean(*contentPath))
+	strippedPath = strings.TrimPrefix(filepath.ToSlash(strippedPath), "/")
+	d := &dirListData{Path: strippedPath}
+	for _, fi := range fis {
+		e := dirEntry{
+			Name: fi.Name(),
+			Path: strings.TrimPrefix(strippedPath+"/"+fi.Name(), "/"),
+		}
+		if fi.IsDir() {
+			if showDir(e.Name) {
+				d.Dirs = append(d.Dirs, e)
+			}
+			continue
+		}
+		if isDoc(e.Name) {
+			fn := filepath.Join(name, fi.Name())
+			if p, err := parse(fn, present.TitlesOnly); err != nil {
+				log.Printf("parse(%q, present.TitlesOnly): %v", fn, err)
+			} else {
+				e.Title = p.Title
+			}
+			switch filepath.Ext(e.Name) {
+			case ".article":
+				d.Articles = append(d.Articles, e)
+			case ".slide":
+				d.Slides = append(d.Slides, e)
+			}
+		} else if showFile(e.Name) {
+			d.Other = append(d.Other, e)
+		}
+	}
+	sort.Sort(d.Dirs)
+	sort.Sort(d.Slides)
+	sort.Sort(d.Articles)
+	sort.Sort(d.Other)
+	return true, dirListTemplate.Execute(w, d)
+}
+
+// showFile reports whether the named file is listed.
+func showFile(n string) bool {
+	switch filepath.Ext(n) {
+	case ".pdf", ".html", ".go":
+		return true
+	}
+	return isDoc(n)
+}
+
+// showDir reports whether the named directory is listed.
+func showDir(n string) bool {
+	return len(n) > 0 && n[0] != '.' && n[0] != '_'
+}
+
+type dirListData struct {
+	Path                          string
+	Dirs, Slides, Articles, Other dirEntrySlice
+}
+
+type dirEntry struct {
+	Name, Path, Title string
+}
+
+type dirEntrySlice []dirEntry
+
+func (s dirEntrySlice) Len() int           { return len(s) }
+func (s dirEntrySlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s dirEntrySlice) Less(i, j int) bool { return s[i].Name < s[j].Name }
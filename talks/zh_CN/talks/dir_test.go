@@ -0,0 +1,38 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDirHandlerNotFound(t *testing.T) {
+	content, err := ioutil.TempDir("", "talks-content")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(content)
+	defer func(p string) { *contentPath = p }(*contentPath)
+	*contentPath = content
+	if err := initTemplates(filepath.Join("..", "template")); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"/2016/missing.slide", "/2016/missing.article", "/2016/"} {
+		w := httptest.NewRecorder()
+		dirHandler(w, httptest.NewRequest("GET", p, nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: status %d, want 404", p, w.Code)
+		}
+		if strings.Contains(w.Body.String(), content) {
+			t.Errorf("%s: the body shows the content directory: %s", p, w.Body)
+		}
+	}
+}
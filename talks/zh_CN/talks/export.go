@@ -0,0 +1,332 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
+	"golang.org/x/tools/godoc/static"
+	"golang.org/x/tools/present"
+)
+
+// exportTalks writes static HTML copies of the named talks, slash-separated
+// paths relative to the content directory such as "2012/concurrency.slide",
+// or of all the talks if names is empty, to the directory dir. Root is the
+// directory of the templates and static files.
+//
+// Each talk is written as <talk>.html, next to the static files, the images
+// it shows and a play.js of its own, and the root-relative links between
+// exported files are made relative, so the copy works from any directory
+// and without a server. The .play snippets are run when exporting, twice,
+// and the pages play back the recorded output when Run is pressed; the
+// snippets whose two runs differ, or that run until stopped, are marked as
+// nondeterministic.
+func exportTalks(dir, root string, names []string) error {
+	if len(names) == 0 {
+		var err error
+		if names, err = allTalks(*contentPath); err != nil {
+			return err
+		}
+	}
+
+	out := make(map[string][]byte) // output file (slash-separated) -> content
+	staticDir := filepath.Join(root, "static")
+	fis, err := ioutil.ReadDir(staticDir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		if fi.IsDir() {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(staticDir, fi.Name()))
+		if err != nil {
+			return err
+		}
+		out["static/"+fi.Name()] = b
+	}
+	if present.PlayEnabled {
+		b, err := exportPlayScript(root)
+		if err != nil {
+			return err
+		}
+		out["play.js"] = b
+	}
+
+	r := &recorder{
+		runner: sandbox.NewRunner(sandbox.Config{Timeout: *playTimeout}),
+		cache:  make(map[string]*recording),
+	}
+	for _, name := range names {
+		if err := exportTalk(out, r, name); err != nil {
+			return err
+		}
+	}
+
+	// Make the links between exported files relative, now that all of them
+	// are known.
+	targets := make(map[string]string) // URL path -> output file
+	for name := range out {
+		targets["/"+name] = name
+	}
+	for _, name := range names {
+		targets["/"+name] = pageName(name)
+	}
+	for _, name := range names {
+		p := pageName(name)
+		out[p] = relativizeLinks(out[p], p, targets)
+	}
+
+	for name, b := range out {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, b, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// allTalks returns the paths of the talks, .slide and .article files, of
+// the tree rooted at root, relative to root.
+func allTalks(root string) ([]string, error) {
+	var names []string
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		if _, ok := contentTemplate[filepath.Ext(p)]; !ok {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(rel))
+		return nil
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+// exportPlayScript returns the scripts of the playground, initialized with
+// the transport that plays back the recorded output.
+func exportPlayScript(root string) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, p := range append(scripts, "recorded.js") {
+		if s, ok := static.Files[p]; ok {
+			buf.WriteString(s)
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(root, "static", p))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	fmt.Fprintf(&buf, "\ninitPlayground(new RecordedTransport());\n")
+	return buf.Bytes(), nil
+}
+
+// exportTalk adds to out the page of the named talk and the local files it
+// shows.
+func exportTalk(out map[string][]byte, r *recorder, name string) error {
+	file := filepath.Join(*contentPath, filepath.FromSlash(name))
+	doc, err := parse(file, 0)
+	if err != nil {
+		return err
+	}
+	var (
+		recs   []*recording
+		files  []string
+		nondet int
+	)
+	walkElems(doc.Sections, func(e present.Elem) {
+		switch e := e.(type) {
+		case present.Code:
+			if !playable(e) || e.Ext != ".go" {
+				return // the sandbox runs Go programs only
+			}
+			rec, err := r.record(codeText(e))
+			if err != nil {
+				log.Printf("%s: running %s: %v", name, e.FileName, err)
+				return
+			}
+			if rec.Nondeterministic {
+				nondet++
+			}
+			recs = append(recs, rec)
+		case present.Image:
+			files = append(files, e.URL)
+		case present.Iframe:
+			files = append(files, e.URL)
+		}
+	})
+	for _, f := range files {
+		if strings.Contains(f, "://") || strings.HasPrefix(f, "/") {
+			continue
+		}
+		p := path.Join(path.Dir(name), f)
+		b, err := ioutil.ReadFile(filepath.Join(*contentPath, filepath.FromSlash(p)))
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		out[p] = b
+	}
+
+	var page bytes.Buffer
+	if err := doc.Render(&page, contentTemplate[filepath.Ext(name)]); err != nil {
+		return fmt.Errorf("rendering %s: %v", name, err)
+	}
+	rec, err := json.Marshal(recs)
+	if err != nil {
+		return err
+	}
+	if recs == nil {
+		rec = []byte("[]")
+	}
+	// The scripts of the head have run by the end of it: there the output
+	// is recorded for play.js, and slides.js is told where to find the
+	// styles of the slides.
+	script := fmt.Sprintf("window.recordedOutput = %s;", rec)
+	if path.Ext(name) == ".slide" {
+		top := strings.Repeat("../", strings.Count(name, "/"))
+		script += fmt.Sprintf(" PERMANENT_URL_PREFIX = '%sstatic/';", top)
+	}
+	b := bytes.Replace(page.Bytes(), []byte("</head>"), []byte("  <script>"+script+"</script>\n  </head>"), 1)
+	out[pageName(name)] = b
+	log.Printf("exported %s: %d snippets run, %d nondeterministic", name, len(recs), nondet)
+	return nil
+}
+
+// pageName returns the name of the exported page of the named talk.
+func pageName(name string) string {
+	return strings.TrimSuffix(name, path.Ext(name)) + ".html"
+}
+
+// linkAttr matches the href and src attributes, in either kind of quotes.
+var linkAttr = regexp.MustCompile(`\b(href|src)=("[^"]*"|'[^']*')`)
+
+// relativizeLinks rewrites the root-relative links in page, exported as the
+// file name, that point to exported files into relative links. Targets maps
+// the URL paths of the exported files, as the server serves them, to the
+// files.
+func relativizeLinks(page []byte, name string, targets map[string]string) []byte {
+	return linkAttr.ReplaceAllFunc(page, func(attr []byte) []byte {
+		m := linkAttr.FindSubmatch(attr)
+		quote, val := m[2][:1], m[2][1:len(m[2])-1]
+		u, err := url.Parse(html.UnescapeString(string(val)))
+		if err != nil || u.Scheme != "" || u.Host != "" || !strings.HasPrefix(u.Path, "/") {
+			return attr
+		}
+		target, ok := targets[u.Path]
+		if !ok {
+			return attr
+		}
+		rel, err := filepath.Rel(path.Dir(name), target)
+		if err != nil {
+			return attr
+		}
+		link := &url.URL{Path: filepath.ToSlash(rel), RawQuery: u.RawQuery, Fragment: u.Fragment}
+		return []byte(fmt.Sprintf("%s=%s%s%s", m[1], quote, html.EscapeString(link.String()), quote))
+	})
+}
+
+// walkElems calls f for each element of the sections, and of their
+// subsections, in order.
+func walkElems(sections []present.Section, f func(present.Elem)) {
+	for _, s := range sections {
+		for _, e := range s.Elem {
+			if sub, ok := e.(present.Section); ok {
+				walkElems([]present.Section{sub}, f)
+				continue
+			}
+			f(e)
+		}
+	}
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// codeText returns the program of a playable snippet: the text of its
+// HTML, hidden parts included, as the browser sends it to be run.
+func codeText(c present.Code) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTag.ReplaceAllString(string(c.Text), "")))
+}
+
+// A recording is the recorded output of a snippet.
+type recording struct {
+	Body             string
+	Nondeterministic bool // the output of two runs differed
+	Response         *sandbox.Response
+}
+
+// A recorder runs snippets and records their output. Snippets shown by
+// several talks are run once.
+type recorder struct {
+	runner *sandbox.Runner
+	cache  map[string]*recording // keyed by body
+}
+
+// errTimeout is the error of the runner for programs that run too long.
+const errTimeout = "process took too long"
+
+// record runs body twice and returns the recording of the first run.
+func (r *recorder) record(body string) (*recording, error) {
+	if rec := r.cache[body]; rec != nil {
+		return rec, nil
+	}
+	first, err := r.runner.Run(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	rec := &recording{Body: body, Response: first}
+	if first.Errors == errTimeout {
+		rec.Nondeterministic = true
+	} else if first.Errors == "" {
+		second, err := r.runner.Run(context.Background(), body)
+		if err != nil {
+			return nil, err
+		}
+		rec.Nondeterministic = !sameOutput(first, second)
+	}
+	r.cache[body] = rec
+	return rec, nil
+}
+
+// sameOutput reports whether two runs of a program gave the same output,
+// regardless of timing, which decides how the output is split in events.
+func sameOutput(a, b *sandbox.Response) bool {
+	return a.Status == b.Status && a.Errors == b.Errors &&
+		output(a, "stdout") == output(b, "stdout") &&
+		output(a, "stderr") == output(b, "stderr")
+}
+
+// output returns the output of the given kind of a run.
+func output(r *sandbox.Response, kind string) string {
+	var s string
+	for _, e := range r.Events {
+		if e.Kind == kind {
+			s += e.Message
+		}
+	}
+	return s
+}
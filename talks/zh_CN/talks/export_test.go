@@ -0,0 +1,290 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/tools/present"
+)
+
+var testTalks = map[string]string{
+	"2016/talk.slide": `A slide
+1 Jan 2016
+
+Gopher
+
+* Links
+
+.image gopher.png
+.link /2016/notes.article The article
+`,
+	"2016/notes.article": `An article
+1 Jan 2016
+
+Gopher
+
+* Section
+
+.image gopher.png
+`,
+	"2016/gopher.png": "\x89PNG\r\n\x1a\n",
+}
+
+// rootLink matches the root-relative links left in an exported page.
+var rootLink = regexp.MustCompile(`\b(href|src)=["']/[^/]`)
+
+// exportTestTalks exports the talks of files, keyed by path, with all the
+// talks of the content directory. It returns the function that reads an
+// exported file, and a function that removes the directories of the test.
+func exportTestTalks(t *testing.T, files map[string]string) (read func(name string) string, cleanup func()) {
+	content, err := ioutil.TempDir("", "talks-content")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "talks-export")
+	if err != nil {
+		os.RemoveAll(content)
+		t.Fatal(err)
+	}
+	p, play := *contentPath, present.PlayEnabled
+	cleanup = func() {
+		*contentPath, present.PlayEnabled = p, play
+		os.RemoveAll(content)
+		os.RemoveAll(dir)
+	}
+	for name, text := range files {
+		p := filepath.Join(content, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(text), 0644); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+	}
+
+	*contentPath, present.PlayEnabled = content, true
+	if err := initTemplates(filepath.Join("..", "template")); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	if err := exportTalks(dir, "..", nil); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	read = func(name string) string {
+		b, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+	return read, cleanup
+}
+
+func TestExport(t *testing.T) {
+	read, cleanup := exportTestTalks(t, testTalks)
+	defer cleanup()
+	for _, name := range []string{"play.js", "static/slides.js", "static/article.css", "2016/gopher.png"} {
+		read(name)
+	}
+	for name, want := range map[string][]string{
+		"2016/talk.html": {
+			"<script src='../static/slides.js'></script>",
+			"<script src='../play.js'></script>",
+			"PERMANENT_URL_PREFIX = '../static/';",
+			"window.recordedOutput = [];",
+			`src="gopher.png"`,
+			`href="notes.html"`,
+		},
+		"2016/notes.html": {
+			`href="../static/article.css"`,
+			"<script src='../static/article.js'></script>",
+			"<script src='../play.js'></script>",
+			"window.recordedOutput = [];",
+			`src="gopher.png"`,
+		},
+	} {
+		page := read(name)
+		for _, w := range want {
+			if !strings.Contains(page, w) {
+				t.Errorf("%s does not contain %s", name, w)
+			}
+		}
+		for _, l := range rootLink.FindAllString(page, -1) {
+			t.Errorf("%s keeps the root-relative link %s...", name, l)
+		}
+	}
+}
+
+var playTalks = map[string]string{
+	"2016/play.slide": `Snippets
+1 Jan 2016
+
+Gopher
+
+* Hello
+
+.play hello.go
+
+* Now
+
+.play now.go
+`,
+	"2016/hello.go": `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello, 世界 <&>")
+}
+`,
+	"2016/now.go": `package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	fmt.Println(time.Now().UnixNano())
+}
+`,
+}
+
+// recordedOutput returns the recordings of an exported page.
+func recordedOutput(t *testing.T, page string) []recording {
+	const prefix = "window.recordedOutput = "
+	i := strings.Index(page, prefix)
+	if i < 0 {
+		t.Fatal("no recorded output in the page")
+	}
+	var recs []recording
+	if err := json.NewDecoder(strings.NewReader(page[i+len(prefix):])).Decode(&recs); err != nil {
+		t.Fatalf("recorded output: %v", err)
+	}
+	return recs
+}
+
+// playText returns the text of a snippet of a page, as text of recorded.js
+// and play.js return it to run the snippet.
+func playText(n *html.Node) string {
+	var s string
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch c.Type {
+		case html.ElementNode:
+			if c.Data == "button" || c.Data == "span" && attr(c, "class") == "number" {
+				continue
+			}
+			if c.Data == "div" || c.Data == "br" {
+				s += "\n"
+			}
+			s += playText(c)
+		case html.TextNode:
+			s += c.Data
+		}
+	}
+	return strings.Replace(s, "\u00a0", " ", 1)
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// playgrounds returns the keys of recorded.js of the snippets of a page:
+// their text, trimmed.
+func playgrounds(t *testing.T, page string) []string {
+	doc, err := html.Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "div" {
+			for _, c := range strings.Fields(attr(n, "class")) {
+				if c == "playground" {
+					keys = append(keys, strings.TrimSpace(playText(n)))
+					return
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return keys
+}
+
+func TestExportPlay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the runs of the snippets in short mode")
+	}
+	read, cleanup := exportTestTalks(t, playTalks)
+	defer cleanup()
+	page := read("2016/play.html")
+
+	recs := recordedOutput(t, page)
+	if len(recs) != 2 {
+		t.Fatalf("%d recordings, want 2", len(recs))
+	}
+	for i, want := range []struct {
+		file             string
+		call             string // of the body
+		nondeterministic bool
+		stdout           string // if deterministic
+	}{
+		{"hello.go", `fmt.Println("Hello, 世界 <&>")`, false, "Hello, 世界 <&>\n"},
+		{"now.go", "time.Now().UnixNano()", true, ""},
+	} {
+		rec := recs[i]
+		if !strings.Contains(rec.Body, want.call) {
+			t.Errorf("recording %d is not of %s: %q", i, want.file, rec.Body)
+		}
+		if rec.Response == nil || rec.Response.Errors != "" || rec.Response.Status != 0 {
+			t.Errorf("%s: response %+v, want a successful run", want.file, rec.Response)
+			continue
+		}
+		if rec.Nondeterministic != want.nondeterministic {
+			t.Errorf("%s: Nondeterministic = %v, want %v", want.file, rec.Nondeterministic, want.nondeterministic)
+		}
+		out := output(rec.Response, "stdout")
+		if want.stdout != "" && out != want.stdout {
+			t.Errorf("%s: stdout %q, want %q", want.file, out, want.stdout)
+		}
+		if out == "" {
+			t.Errorf("%s: no output recorded", want.file)
+		}
+	}
+
+	// The page finds the recordings of its snippets by their text.
+	var bodies []string
+	for _, rec := range recs {
+		bodies = append(bodies, rec.Body)
+	}
+	keys := playgrounds(t, page)
+	sort.Strings(bodies)
+	sort.Strings(keys)
+	if strings.Join(keys, "\x00") != strings.Join(bodies, "\x00") {
+		t.Errorf("snippets of the page:\n%q\nwant the bodies of the recordings:\n%q", keys, bodies)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/talks"
+	"golang.org/x/tools/present"
+)
+
+// An index serves the list of all the talks of a directory tree, which may
+// be searched with the q parameter. The talks are parsed again when they
+// change.
+type index struct {
+	root string
+
+	mu      sync.Mutex
+	entries map[string]*indexEntry // keyed by path
+}
+
+// An indexEntry describes a talk of the index.
+type indexEntry struct {
+	Path     string // slash-separated, relative to the root
+	Title    string
+	Subtitle string
+	Authors  []string // names only
+	Time     time.Time
+	Err      error // of parsing the talk
+
+	// The talk has Sections slides, or sections for an article, of which
+	// Translated are translated.
+	Translated, Sections int
+
+	modTime time.Time
+}
+
+// Status describes how much of the talk is translated.
+func (e *indexEntry) Status() string {
+	switch {
+	case e.Err != nil:
+		return "error"
+	case e.Translated == 0:
+		return "not translated"
+	case e.Translated == e.Sections:
+		return "translated"
+	}
+	return fmt.Sprintf("partly translated (%d/%d)", e.Translated, e.Sections)
+}
+
+// statusKeyword returns the word searched for the status of the talk:
+// "translated", "partly", "untranslated" or "error".
+func (e *indexEntry) statusKeyword() string {
+	switch {
+	case e.Err != nil:
+		return "error"
+	case e.Translated == 0:
+		return "untranslated"
+	case e.Translated == e.Sections:
+		return "translated"
+	}
+	return "partly"
+}
+
+// matches reports whether each word of the query, in lower case, is the
+// status keyword of the entry or is found in any of its other fields,
+// regardless of case. As every status says "translated", the status is not
+// searched as text.
+func (e *indexEntry) matches(words []string) bool {
+	text := strings.ToLower(strings.Join([]string{
+		e.Path, e.Title, e.Subtitle, strings.Join(e.Authors, " "),
+		e.Time.Format("2006-01-02"),
+	}, "\n"))
+	for _, w := range words {
+		if w != e.statusKeyword() && !strings.Contains(text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func newIndex(root string) *index {
+	return &index{root: root, entries: make(map[string]*indexEntry)}
+}
+
+// update brings the index up to date with the talks in its tree and
+// returns its entries, the latest talks first.
+func (x *index) update() ([]*indexEntry, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	var list []*indexEntry
+	seen := make(map[string]bool)
+	err := filepath.Walk(x.root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if p != x.root && !showDir(fi.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isDoc(p) {
+			return nil
+		}
+		rel, err := filepath.Rel(x.root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		seen[rel] = true
+		e := x.entries[rel]
+		if e == nil || !e.modTime.Equal(fi.ModTime()) {
+			e = newIndexEntry(p, rel, fi.ModTime())
+			x.entries[rel] = e
+		}
+		list = append(list, e)
+		return nil
+	})
+	for rel := range x.entries {
+		if !seen[rel] {
+			delete(x.entries, rel)
+		}
+	}
+	sort.Sort(byTime(list))
+	return list, err
+}
+
+// newIndexEntry parses the named talk, of path rel in the index.
+func newIndexEntry(name, rel string, modTime time.Time) *indexEntry {
+	e := &indexEntry{Path: rel, Title: rel, modTime: modTime}
+	doc, err := parse(name, 0)
+	if err != nil {
+		log.Printf("index: %v", err)
+		e.Err = err
+		return e
+	}
+	e.Title, e.Subtitle, e.Time = doc.Title, doc.Subtitle, doc.Time
+	for _, a := range doc.Authors {
+		if elems := a.TextElem(); len(elems) > 0 {
+			if t, ok := elems[0].(present.Text); ok && len(t.Lines) > 0 {
+				e.Authors = append(e.Authors, t.Lines[0])
+			}
+		}
+	}
+	e.Translated, e.Sections = talks.Progress(doc)
+	return e
+}
+
+func (x *index) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	list, err := x.update()
+	if err != nil {
+		log.Printf("index: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	q := strings.TrimSpace(r.FormValue("q"))
+	words := strings.Fields(strings.ToLower(q))
+	var found []*indexEntry
+	for _, e := range list {
+		if e.matches(words) {
+			found = append(found, e)
+		}
+	}
+	data := struct {
+		Query   string
+		Total   int
+		Entries []*indexEntry
+	}{q, len(list), found}
+	if err := indexTemplate.Execute(w, data); err != nil {
+		log.Printf("index: %v", err)
+	}
+}
+
+type byTime []*indexEntry
+
+func (s byTime) Len() int      { return len(s) }
+func (s byTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byTime) Less(i, j int) bool {
+	if !s[i].Time.Equal(s[j].Time) {
+		return s[i].Time.After(s[j].Time)
+	}
+	return s[i].Path < s[j].Path
+}
@@ -0,0 +1,42 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestIndexMatches(t *testing.T) {
+	entries := map[string]*indexEntry{
+		"translated":   {Path: "2016/a.slide", Title: "Concurrency", Translated: 3, Sections: 3},
+		"partly":       {Path: "2016/b.slide", Title: "Translated words", Translated: 1, Sections: 3},
+		"untranslated": {Path: "2016/c.article", Title: "Go", Sections: 3},
+		"error":        {Path: "2016/d.slide", Title: "Broken", Err: errors.New("bad")},
+	}
+	for q, want := range map[string]string{
+		"translated":              "a b", // b by its title
+		"partly":                  "b",
+		"untranslated":            "c",
+		"error":                   "d",
+		"not translated":          "",
+		"concurrency translated":  "a",
+		"2016 untranslated":       "c",
+		"article":                 "c",
+		"Translated words partly": "b",
+	} {
+		var got []string
+		for _, name := range []string{"translated", "partly", "untranslated", "error"} {
+			e := entries[name]
+			if e.matches(strings.Fields(strings.ToLower(q))) {
+				got = append(got, strings.TrimSuffix(strings.TrimSuffix(e.Path[len("2016/"):], ".slide"), ".article"))
+			}
+		}
+		if g := strings.Join(got, " "); g != want {
+			t.Errorf("query %q matches %q, want %q", q, g, want)
+		}
+	}
+}
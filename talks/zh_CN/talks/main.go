@@ -0,0 +1,214 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command talks serves the talks of talks/zh_CN on the local machine.
+//
+// The directories of talks/zh_CN/content are listed as by the present
+// command, and the talks are rendered with the bilingual templates of
+// talks/zh_CN/template. The page /index lists all the talks with their
+// title, authors, date and how much of them is translated, and can be
+// searched; the status is searched by the keywords translated, partly,
+// untranslated and error.
+//
+// The -play flag chooses where the .play snippets run:
+//
+//	local   built and run by the server in a sandbox with time and output
+//	        limits; only Go programs are runnable, and no network is needed
+//	socket  built and run by the server and streamed to the browser over a
+//	        websocket, as by the present command; programs run until the
+//	        slide stops them, so talks that start servers, such as
+//	        2012/chat, can be played, and so can shell scripts
+//	remote  sent to the playground of golang.org
+//	off     not runnable
+//
+// The server runs code from the browser as the user running it: with
+// -play=local or -play=socket it should only listen on localhost. With
+// -play=socket, the websocket connections must come from pages opened at the
+// host of -http, or at localhost if it is a loopback address, or at the host
+// name of the machine if it is unspecified; -orighost names another host.
+//
+// With -export, talks writes static HTML copies of the named talks, or of
+// all of them, that work without a server, and exits; see exportTalks. The
+// .play snippets are run by the sandbox when exporting, and their recorded
+// output is played back by the Run buttons.
+//
+// Usage:
+//
+//	talks [-http=127.0.0.1:3999] [-orighost=host] [-root=dir] [-content=dir] [-play=local]
+//	talks -export=dir [-root=dir] [-content=dir] [talk.slide ...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/build"
+	"html/template"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/talks"
+	"golang.org/x/tools/present"
+)
+
+const basePkg = "github.com/golang-china/golangdoc.translations/talks/zh_CN"
+
+var (
+	httpListen  = flag.String("http", "127.0.0.1:3999", "host:port to listen on")
+	origHost    = flag.String("orighost", "", "host of the URL the pages are opened at, for the websocket connections of -play=socket (e.g., 'localhost')")
+	rootDir     = flag.String("root", "", "directory of the templates and static files (default: found in the current directory or GOPATH)")
+	contentPath = flag.String("content", "", "directory of the talks (default: content in the root directory)")
+	play        = flag.String("play", "local", `where to run .play snippets: "local", "socket", "remote" (golang.org) or "off"`)
+	playTimeout = flag.Duration("play_timeout", 10*time.Second, "time limit for running a snippet with -play=local or -export")
+	exportDir   = flag.String("export", "", "if set, write static HTML copies of the talks named by the arguments, or of all the talks, to this directory and exit")
+)
+
+var (
+	// dirListTemplate and indexTemplate hold the templates of the directory
+	// listings and of the index.
+	dirListTemplate, indexTemplate *template.Template
+
+	// contentTemplate maps the extensions of talks to their templates.
+	contentTemplate map[string]*template.Template
+)
+
+func main() {
+	flag.Parse()
+
+	root, err := findRoot()
+	if err != nil {
+		log.Fatalf("Couldn't find the talks files: %v", err)
+	}
+	if *contentPath == "" {
+		*contentPath = filepath.Join(root, "content")
+	}
+	log.Println("Serving talks from", *contentPath)
+
+	present.PlayEnabled = *play != "off"
+	if err := initTemplates(filepath.Join(root, "template")); err != nil {
+		log.Fatalf("Failed to parse templates: %v", err)
+	}
+	if *exportDir != "" {
+		if err := exportTalks(*exportDir, root, flag.Args()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	l, err := net.Listen("tcp", *httpListen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !l.Addr().(*net.TCPAddr).IP.IsLoopback() && (*play == "local" || *play == "socket") {
+		log.Print(localhostWarning)
+	}
+	origin, err := originURL(l.Addr().(*net.TCPAddr))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	if err := initPlayground(mux, root, origin); err != nil {
+		log.Fatal(err)
+	}
+	static := http.FileServer(http.Dir(filepath.Join(root, "static")))
+	mux.Handle("/static/", http.StripPrefix("/static/", static))
+	mux.Handle("/favicon.ico", static)
+	mux.Handle("/index", newIndex(*contentPath))
+	mux.HandleFunc("/", dirHandler)
+
+	log.Printf("Open your web browser and visit %s", origin)
+	log.Fatal(http.Serve(l, mux))
+}
+
+// originURL returns the URL of the server listening at addr, as the pages
+// are opened at, following the present command: the host is that of -orighost
+// or -http, or the host name of the machine if -http names no host.
+func originURL(addr *net.TCPAddr) (*url.URL, error) {
+	port := strconv.Itoa(addr.Port)
+	host := *origHost
+	if host == "" {
+		h, _, err := net.SplitHostPort(*httpListen)
+		if err != nil {
+			return nil, err
+		}
+		host = h
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		name, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		host = name
+	}
+	return &url.URL{Scheme: "http", Host: net.JoinHostPort(host, port)}, nil
+}
+
+// findRoot returns the directory of the templates and static files of the
+// talks.
+func findRoot() (string, error) {
+	if *rootDir != "" {
+		return *rootDir, nil
+	}
+	isRoot := func(dir string) bool {
+		_, err := os.Stat(filepath.Join(dir, "template", "dir.tmpl"))
+		return err == nil
+	}
+	for _, dir := range []string{".", ".."} {
+		if isRoot(dir) {
+			return filepath.Abs(dir)
+		}
+	}
+	p, err := build.Default.Import(basePkg, "", build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+	if !isRoot(p.Dir) {
+		return "", fmt.Errorf("no talks templates in %s", p.Dir)
+	}
+	return p.Dir, nil
+}
+
+// initTemplates parses the templates in the directory dir.
+func initTemplates(dir string) error {
+	var err error
+	if contentTemplate, err = talks.Templates(dir); err != nil {
+		return err
+	}
+	for _, t := range contentTemplate {
+		t.Funcs(template.FuncMap{"playable": playable})
+	}
+	if dirListTemplate, err = template.ParseFiles(filepath.Join(dir, "dir.tmpl")); err != nil {
+		return err
+	}
+	indexTemplate, err = template.New("index.tmpl").Funcs(template.FuncMap{
+		"date": func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.Format("2006-01-02")
+		},
+	}).ParseFiles(filepath.Join(dir, "index.tmpl"))
+	return err
+}
+
+const localhostWarning = `
+WARNING!  WARNING!  WARNING!
+
+The talks server appears to be listening on an address that is not localhost
+and is configured to run code snippets locally. Anyone with access to this
+address and port will have access to this machine as the user running talks.
+
+To avoid this message, listen on localhost, or run with -play=remote or
+-play=off.
+
+If you don't understand this message, hit Control-C to terminate this process.
+
+WARNING!  WARNING!  WARNING!
+`
@@ -0,0 +1,135 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
+	"golang.org/x/net/websocket"
+	"golang.org/x/tools/godoc/static"
+	"golang.org/x/tools/playground/socket"
+	"golang.org/x/tools/present"
+
+	// The playground package registers handlers at /compile and /share
+	// that forward the snippets to golang.org, for -play=remote.
+	_ "golang.org/x/tools/playground"
+)
+
+// scripts are the scripts of the playground, served as /play.js. Those that
+// godoc does not have are read from the static directory of the talks.
+var scripts = []string{"jquery.js", "jquery-ui.js", "playground.js", "play.js"}
+
+// initPlayground registers on mux the handlers that run the snippets as
+// selected by the -play flag, and the scripts of the playground. Origin is
+// the URL of the server, from which the websocket connections must come.
+func initPlayground(mux *http.ServeMux, root string, origin *url.URL) error {
+	var transport string
+	switch *play {
+	case "off":
+		return nil
+	case "local":
+		transport = "HTTPTransport"
+		mux.Handle("/compile", sandbox.NewRunner(sandbox.Config{Timeout: *playTimeout}))
+	case "socket":
+		transport = "SocketTransport"
+		h := socket.NewHandler(origin)
+		h.Handshake = checkOrigin(origin)
+		mux.Handle("/socket", h)
+	case "remote":
+		transport = "HTTPTransport"
+		mux.Handle("/compile", http.DefaultServeMux)
+		mux.Handle("/share", http.DefaultServeMux)
+	default:
+		return fmt.Errorf("invalid -play value %q", *play)
+	}
+	return playScript(mux, root, transport)
+}
+
+// checkOrigin returns the websocket handshake that accepts the connections
+// from the pages opened at origin, or, if its host is a loopback address or
+// localhost, at any of them with the same port: the socket package only
+// accepts the host of origin itself.
+func checkOrigin(origin *url.URL) func(*websocket.Config, *http.Request) error {
+	return func(c *websocket.Config, r *http.Request) error {
+		o, err := websocket.Origin(c, r)
+		if err != nil || o == nil || !sameOrigin(o, origin) {
+			log.Println("bad websocket origin:", r.Header.Get("Origin"))
+			return websocket.ErrBadWebSocketOrigin
+		}
+		return nil
+	}
+}
+
+// sameOrigin reports whether the pages opened at o may connect to the server
+// of origin, as checkOrigin does.
+func sameOrigin(o, origin *url.URL) bool {
+	if o.Scheme != origin.Scheme {
+		return false
+	}
+	if o.Host == origin.Host {
+		return true
+	}
+	host, port, err := net.SplitHostPort(o.Host)
+	if err != nil {
+		return false
+	}
+	ohost, oport, err := net.SplitHostPort(origin.Host)
+	return err == nil && port == oport && isLoopback(host) && isLoopback(ohost)
+}
+
+// isLoopback reports whether host is localhost or a loopback address.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// playScript registers on mux a handler at /play.js that serves the
+// scripts of the playground, followed by a line that initializes the
+// playground with the named transport.
+func playScript(mux *http.ServeMux, root, transport string) error {
+	modTime := time.Now()
+	var buf bytes.Buffer
+	for _, p := range scripts {
+		if s, ok := static.Files[p]; ok {
+			buf.WriteString(s)
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(root, "static", p))
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+	}
+	fmt.Fprintf(&buf, "\ninitPlayground(new %v());\n", transport)
+	b := buf.Bytes()
+	mux.HandleFunc("/play.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-type", "application/javascript")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(b))
+	})
+	return nil
+}
+
+// playable reports whether c can be run. The sandbox and the playground of
+// golang.org only run Go programs; the websocket runner also runs shell
+// scripts.
+func playable(c present.Code) bool {
+	runnable := present.PlayEnabled && c.Play
+	if *play != "socket" {
+		return runnable && c.Ext == ".go"
+	}
+	return runnable
+}
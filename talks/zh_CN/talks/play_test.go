@@ -0,0 +1,55 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"testing"
+)
+
+func TestOriginURL(t *testing.T) {
+	name, err := os.Hostname()
+	if err != nil {
+		t.Skip(err)
+	}
+	defer func(l, h string) { *httpListen, *origHost = l, h }(*httpListen, *origHost)
+	addr := &net.TCPAddr{IP: net.IPv6unspecified, Port: 3999}
+	for _, tt := range []struct{ listen, orig, want string }{
+		{"127.0.0.1:3999", "", "http://127.0.0.1:3999"},
+		{"localhost:3999", "", "http://localhost:3999"},
+		{":3999", "", "http://" + net.JoinHostPort(name, "3999")},
+		{"0.0.0.0:3999", "", "http://" + net.JoinHostPort(name, "3999")},
+		{":3999", "talks.example.com", "http://talks.example.com:3999"},
+	} {
+		*httpListen, *origHost = tt.listen, tt.orig
+		u, err := originURL(addr)
+		if err != nil || u.String() != tt.want {
+			t.Errorf("-http=%s -orighost=%s: origin %v, %v; want %s", tt.listen, tt.orig, u, err, tt.want)
+		}
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	for _, tt := range []struct {
+		page, server string
+		want         bool
+	}{
+		{"http://127.0.0.1:3999", "http://127.0.0.1:3999", true},
+		{"http://localhost:3999", "http://127.0.0.1:3999", true},
+		{"http://[::1]:3999", "http://localhost:3999", true},
+		{"http://localhost:4000", "http://127.0.0.1:3999", false},
+		{"https://localhost:3999", "http://localhost:3999", false},
+		{"http://example.com:3999", "http://127.0.0.1:3999", false},
+		{"http://localhost:3999", "http://gopher:3999", false},
+	} {
+		page, _ := url.Parse(tt.page)
+		server, _ := url.Parse(tt.server)
+		if got := sameOrigin(page, server); got != tt.want {
+			t.Errorf("sameOrigin(%s, %s) = %v, want %v", tt.page, tt.server, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,416 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command talkspo extracts the slides of the talks, speaker notes included,
+// into PO files for translators, merges the translations back into the
+// talks, and reports the translations whose English slide has changed.
+//
+// Usage:
+//
+//	talkspo extract [-content=dir] [-po=dir] [talk.slide ...]
+//	talkspo merge [-content=dir] [-po=dir] [talk.slide ...]
+//	talkspo check [-content=dir] [talk.slide ...]
+//
+// The talks are named by their path in the content directory, such as
+// 2014/hellogophers.slide. With no arguments, talkspo works on all the talks
+// in talks/zh_CN/content, found in the current directory or its parent.
+//
+// Extract writes, for each talk, the file <talk>.po in the PO directory,
+// with a translation unit for the title, one for the body and one for the
+// speaker notes of each slide. The context of a unit, such as
+// "2014/hellogophers#3-5f0c7e1a/notes", names the talk, the slide, the hash
+// of its English text (see internal/talks.Hash) and the part of the slide.
+// The units of the slides translated already hold their translation; those
+// of the slides whose English text changed since they were translated are
+// marked fuzzy, and so are those of the translations that record no hash,
+// as the English text they translate is unknown.
+//
+// Both extract and merge record the hash of the current English text in the
+// .translation directives that have none, so that later changes to the
+// English text are found.
+//
+// Merge writes the translations of the PO file into each talk as the
+// translation subsections of its slides (see internal/talks), recording the
+// hash of the English text translated in their .translation directive.
+// Units are found by context or, when slides were added or removed before
+// theirs, by hash. Units of a slide whose English text changed since the PO
+// file was extracted are stale and are not merged; the same goes for fuzzy
+// units. Stale and fuzzy units are reported.
+//
+// Check reports the translated slides whose English text changed since they
+// were translated, and those whose translation records no hash, which need
+// review, and exits with status 1 if there are any.
+//
+// Extracting a talk and merging the result gives back the same talk, byte
+// for byte, but for the hashes recorded in the directives that had none;
+// talkspo refuses to work on talks it could not write back unchanged.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/po"
+)
+
+var (
+	contentDir = flag.String("content", "", "directory of the talks (default: content in the current directory or its parent)")
+	poDir      = flag.String("po", "", "directory of the PO files (default: i18n next to the content directory)")
+)
+
+// lang is the language of the translations.
+const lang = "zh_CN"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: talkspo extract|merge|check [-content=dir] [-po=dir] [talk.slide ...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("talkspo: ")
+	flag.Usage = usage
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+	flag.CommandLine.Parse(os.Args[2:])
+
+	if *contentDir == "" {
+		var err error
+		if *contentDir, err = defaultContent(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	names := flag.Args()
+	if len(names) == 0 {
+		var err error
+		if names, err = allSlides(*contentDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	dir := *poDir
+	if dir == "" {
+		dir = filepath.Join(*contentDir, "..", "i18n")
+	}
+
+	var run func(t *talk, poFile string) error
+	switch cmd {
+	case "extract":
+		run = extract
+	case "merge":
+		run = merge
+	case "check":
+		run = check
+	default:
+		usage()
+	}
+	failed := false
+	for _, name := range names {
+		name = filepath.ToSlash(name)
+		path := strings.TrimSuffix(name, ".slide")
+		t, err := readTalk(filepath.Join(*contentDir, filepath.FromSlash(name)), path)
+		if err == nil {
+			err = run(t, filepath.Join(dir, filepath.FromSlash(path)+".po"))
+		}
+		if err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// defaultContent returns the content directory of the talks in the current
+// directory or its parent.
+func defaultContent() (string, error) {
+	for _, dir := range []string{".", ".."} {
+		root := filepath.Join(dir, "content")
+		if fi, err := os.Stat(root); err == nil && fi.IsDir() {
+			return root, nil
+		}
+	}
+	return "", fmt.Errorf("no talks found; run talkspo in talks/zh_CN or use -content")
+}
+
+// allSlides returns the paths of the .slide files of the tree rooted at
+// root, relative to root.
+func allSlides(root string) ([]string, error) {
+	var names []string
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() || filepath.Ext(p) != ".slide" {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(rel))
+		return nil
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+// slideID returns the identifier of the nth slide of t, whose English text
+// has the given hash.
+func slideID(t *talk, n int, hash string) string {
+	return t.path + "#" + strconv.Itoa(n) + "-" + hash
+}
+
+// parseContext splits the context of a unit into the number and hash of
+// its slide, and the part of the slide.
+func parseContext(ctx string) (n int, hash, part string, ok bool) {
+	i := strings.LastIndex(ctx, "#")
+	j := strings.LastIndex(ctx, "/")
+	if i < 0 || j < i {
+		return 0, "", "", false
+	}
+	id := strings.SplitN(ctx[i+1:j], "-", 2)
+	if len(id) != 2 {
+		return 0, "", "", false
+	}
+	n, err := strconv.Atoi(id[0])
+	if err != nil {
+		return 0, "", "", false
+	}
+	return n, id[1], ctx[j+1:], true
+}
+
+// changed reports whether the English text of s changed since s was
+// translated.
+func changed(s *slide) bool {
+	return s.tr != nil && s.tr.source != "" && s.tr.source != s.hash()
+}
+
+// unhashed reports whether s has a translation that records no hash, so
+// that whether its English text changed is unknown.
+func unhashed(s *slide) bool {
+	return s.tr != nil && s.tr.source == ""
+}
+
+// extract writes the translation units of t to the named PO file, and
+// writes t back if translations were given a hash.
+func extract(t *talk, poFile string) error {
+	f := &po.File{Header: po.Header(lang)}
+	for i, s := range t.slides {
+		id := slideID(t, i+1, s.hash())
+		ref := po.Ref(filepath.Base(t.name), s.line)
+		var flags, note []string
+		switch {
+		case changed(s):
+			log.Printf("%s:%d: the English slide changed since it was translated", t.name, s.line)
+			flags = []string{"fuzzy"}
+			note = []string{"The English slide changed since it was translated: review the translation."}
+		case unhashed(s):
+			log.Printf("%s:%d: the translation records no hash: review it", t.name, s.line)
+			flags = []string{"fuzzy"}
+			note = []string{"The English slide translated is unknown: review the translation."}
+			s.stamp()
+		}
+		var trTitle string
+		var trBody, trNotes []string
+		if s.tr != nil {
+			trTitle, trBody, trNotes = s.tr.title, s.tr.body, s.tr.notes
+		}
+		f.Units = append(f.Units, &po.Unit{
+			Extracted: note,
+			Refs:      []string{ref},
+			Flags:     flags,
+			Context:   id + "/title",
+			ID:        s.title,
+			Str:       trTitle,
+		})
+		if len(s.body) > 0 {
+			f.Units = append(f.Units, &po.Unit{
+				Extracted: append([]string{"Text in present format: keep the links, the code and the directives."}, note...),
+				Refs:      []string{ref},
+				Flags:     flags,
+				Context:   id + "/body",
+				ID:        strings.Join(s.body, "\n"),
+				Str:       strings.Join(trBody, "\n"),
+			})
+		}
+		if len(s.notes) > 0 {
+			f.Units = append(f.Units, &po.Unit{
+				Extracted: append([]string{"Speaker notes: one paragraph per line."}, note...),
+				Refs:      []string{ref},
+				Flags:     flags,
+				Context:   id + "/notes",
+				ID:        strings.Join(s.notes, "\n"),
+				Str:       strings.Join(trNotes, "\n"),
+			})
+		}
+	}
+	var b bytes.Buffer
+	if err := po.Write(&b, f); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(poFile), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(poFile, b.Bytes(), 0644); err != nil {
+		return err
+	}
+	return writeTalk(t)
+}
+
+// merge merges the translations of the named PO file into t and writes t
+// back if it changed.
+func merge(t *talk, poFile string) error {
+	r, err := os.Open(poFile)
+	if err != nil {
+		return err
+	}
+	f, err := po.Read(r)
+	r.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %v", poFile, err)
+	}
+	units := f.Index()
+	// Units are also found by the hash of their slide, so that
+	// translations survive slides being added or removed before them.
+	// The translated units of no current slide are stale.
+	current := make(map[string]bool)
+	for _, s := range t.slides {
+		current[s.hash()] = true
+	}
+	byHash := make(map[string]*po.Unit)
+	dup := make(map[string]bool)
+	for _, u := range f.Units {
+		_, hash, part, ok := parseContext(u.Context)
+		if !ok {
+			continue
+		}
+		if !current[hash] {
+			if u.Str != "" {
+				log.Printf("%s: %s: stale translation: the English slide changed", poFile, u.Context)
+			}
+			continue
+		}
+		if byHash[hash+"/"+part] != nil {
+			dup[hash+"/"+part] = true
+		}
+		byHash[hash+"/"+part] = u
+	}
+
+	// translation returns the translation of a part of the nth slide,
+	// whose English text has the given hash, and whether there is a
+	// usable one.
+	translation := func(n int, hash, part string) (string, bool) {
+		u := units[slideID(t, n, hash)+"/"+part]
+		if u == nil && !dup[hash+"/"+part] {
+			u = byHash[hash+"/"+part]
+		}
+		if u == nil || u.Str == "" {
+			return "", false
+		}
+		if u.Fuzzy() {
+			log.Printf("%s: %s: fuzzy translation not merged", poFile, u.Context)
+			return "", false
+		}
+		for _, l := range strings.Split(u.Str, "\n") {
+			if isHeading(l) || strings.HasPrefix(l, "** ") || strings.HasPrefix(l, translationCmd+" ") {
+				log.Printf("%s: %s: translation not merged: line %q would break the slide", poFile, u.Context, l)
+				return "", false
+			}
+		}
+		if part == "title" && strings.Contains(u.Str, "\n") {
+			log.Printf("%s: %s: translation not merged: titles are one line", poFile, u.Context)
+			return "", false
+		}
+		return u.Str, true
+	}
+
+	for i, s := range t.slides {
+		hash := s.hash()
+		// Keep the translations of the talk that have no unit.
+		var title string
+		var body, notes []string
+		if s.tr != nil {
+			title, body, notes = s.tr.title, s.tr.body, s.tr.notes
+		}
+		merged := false
+		if str, ok := translation(i+1, hash, "title"); ok {
+			title, merged = str, true
+		}
+		if len(s.body) > 0 {
+			if str, ok := translation(i+1, hash, "body"); ok {
+				body, merged = strings.Split(str, "\n"), true
+			}
+		}
+		if len(s.notes) > 0 {
+			if str, ok := translation(i+1, hash, "notes"); ok {
+				notes, merged = strings.Split(str, "\n"), true
+			}
+		}
+		if !merged || s.tr != nil && title == s.tr.title && equal(body, s.tr.body) && equal(notes, s.tr.notes) &&
+			(s.tr.source == hash || s.tr.source == "") {
+			// Unchanged. The translations of unknown English text
+			// are taken as translations of the current one.
+			if unhashed(s) {
+				s.stamp()
+			}
+			continue
+		}
+		s.setTranslation(title, body, notes)
+	}
+	return writeTalk(t)
+}
+
+// writeTalk writes t back to its file if it changed.
+func writeTalk(t *talk) error {
+	old, err := ioutil.ReadFile(t.name)
+	if err != nil {
+		return err
+	}
+	out := t.bytes()
+	if bytes.Equal(old, out) {
+		return nil
+	}
+	return ioutil.WriteFile(t.name, out, 0644)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// check reports the slides of t whose English text changed since they were
+// translated, and those whose translation records no hash.
+func check(t *talk, poFile string) error {
+	n, unknown := 0, 0
+	for _, s := range t.slides {
+		switch {
+		case changed(s):
+			fmt.Fprintf(os.Stderr, "%s:%d: %q changed since it was translated (%s, now %s)\n", t.name, s.line, s.title, s.tr.source, s.hash())
+			n++
+		case unhashed(s):
+			fmt.Fprintf(os.Stderr, "%s:%d: %q: translation of unknown English text, needs review (no hash)\n", t.name, s.line, s.title)
+			unknown++
+		}
+	}
+	if n > 0 || unknown > 0 {
+		return fmt.Errorf("%s: %d translated slides changed, %d need review", t.name, n, unknown)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/talks"
+)
+
+// translationCmd starts the translation of a slide, as described in
+// internal/talks.
+const translationCmd = ".translation"
+
+// A talk is a .slide file split into slides.
+type talk struct {
+	name   string // file name
+	path   string // slash-separated, relative to the content directory, without extension
+	header []string
+	slides []*slide
+}
+
+// A slide is a slide of a talk. Its lines are, in order, the heading, the
+// English text and its translation, if any.
+type slide struct {
+	line    int    // line number of the heading
+	heading string // heading, as in the file
+	title   string
+	en      []string // lines after the heading, as in the file
+
+	// The English text, for translation: the body without the speaker
+	// notes and the blank lines around it, and the text of the notes.
+	body, notes []string
+
+	tr *translation
+}
+
+// hash returns the hash of the English text of s.
+func (s *slide) hash() string {
+	return talks.Hash(s.title, s.body, s.notes)
+}
+
+// A translation is the translation of a slide.
+type translation struct {
+	raw    []string // lines as in the file, or nil if changed
+	title  string
+	source string // hash of the English text translated, or ""
+	body   []string
+	notes  []string
+	tail   []string // blank lines after the translation
+}
+
+func isHeading(line string) bool {
+	return strings.HasPrefix(line, "* ")
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func isNote(line string) bool {
+	return strings.HasPrefix(line, ": ")
+}
+
+// isTranslation reports whether lines start a translation: a subsection
+// heading followed by a .translation directive.
+func isTranslation(lines []string) bool {
+	return len(lines) >= 2 && strings.HasPrefix(lines[0], "** ") &&
+		strings.HasPrefix(lines[1], translationCmd+" ")
+}
+
+// split splits lines into the body, without the blank lines around it, and
+// the text of the speaker notes.
+func split(lines []string) (body, notes []string) {
+	for _, l := range lines {
+		if isNote(l) {
+			notes = append(notes, strings.TrimPrefix(l, ": "))
+		} else {
+			body = append(body, l)
+		}
+	}
+	return trimBlank(body), notes
+}
+
+func trimBlank(lines []string) []string {
+	for len(lines) > 0 && isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// readTalk reads and parses the named talk, of the given path.
+func readTalk(name, path string) (*talk, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseTalk(name, string(data))
+	if err != nil {
+		return nil, err
+	}
+	t.path = path
+	if out := t.bytes(); !bytes.Equal(out, data) {
+		return nil, fmt.Errorf("%s: unsupported layout: the talk cannot be written back unchanged", name)
+	}
+	return t, nil
+}
+
+// parseTalk parses the text of the named talk.
+func parseTalk(name, text string) (*talk, error) {
+	lines := strings.Split(text, "\n")
+	t := &talk{name: name}
+	i := 0
+	for i < len(lines) && !isHeading(lines[i]) {
+		i++
+	}
+	t.header = lines[:i]
+	for i < len(lines) {
+		j := i + 1
+		for j < len(lines) && !isHeading(lines[j]) {
+			j++
+		}
+		s, err := parseSlide(lines[i:j])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, i+1, err)
+		}
+		s.line = i + 1
+		t.slides = append(t.slides, s)
+		i = j
+	}
+	return t, nil
+}
+
+// parseSlide parses the lines of a slide, from its heading to the next one.
+func parseSlide(lines []string) (*slide, error) {
+	s := &slide{
+		heading: lines[0],
+		title:   strings.TrimSpace(strings.TrimPrefix(lines[0], "* ")),
+	}
+	lines = lines[1:]
+	i := 0
+	for i < len(lines) && !isTranslation(lines[i:]) {
+		i++
+	}
+	s.en = lines[:i]
+	s.body, s.notes = split(s.en)
+	if i == len(lines) {
+		return s, nil
+	}
+
+	raw := lines[i:]
+	args := strings.Fields(raw[1])
+	if len(args) < 2 || len(args) > 3 || args[1] != lang {
+		return nil, fmt.Errorf("%s directive %q: want %s %s [hash]", translationCmd, raw[1], translationCmd, lang)
+	}
+	tr := &translation{
+		raw:   raw,
+		title: strings.TrimSpace(strings.TrimPrefix(raw[0], "** ")),
+	}
+	if len(args) == 3 {
+		tr.source = args[2]
+	}
+	text := raw[2:]
+	j := len(text)
+	for j > 0 && isBlank(text[j-1]) {
+		j--
+	}
+	text, tr.tail = text[:j], text[j:]
+	tr.body, tr.notes = split(text)
+	s.tr = tr
+	return s, nil
+}
+
+// setTranslation sets the translation of s, made of the given parts, as a
+// translation of the current English text.
+func (s *slide) setTranslation(title string, body, notes []string) {
+	tr := s.tr
+	if tr == nil {
+		// Separate the translation from the English text by a blank
+		// line, and move the blank lines at the end of the slide after
+		// it.
+		j := len(s.en)
+		for j > 0 && isBlank(s.en[j-1]) {
+			j--
+		}
+		tail := append([]string(nil), s.en[j:]...)
+		s.en = append(s.en[:j:j], "")
+		tr = &translation{tail: tail}
+		s.tr = tr
+	}
+	if title == "" {
+		title = s.title
+	}
+	tr.raw = nil
+	tr.title, tr.body, tr.notes = title, body, notes
+	tr.source = s.hash()
+}
+
+// stamp records the hash of the current English text of s in its
+// translation, leaving the rest of the translation as it is.
+func (s *slide) stamp() {
+	tr := s.tr
+	tr.source = s.hash()
+	if tr.raw != nil {
+		tr.raw[1] = translationCmd + " " + lang + " " + tr.source
+	}
+}
+
+// lines returns the lines of tr.
+func (tr *translation) lines() []string {
+	if tr.raw != nil {
+		return tr.raw
+	}
+	lines := []string{"** " + tr.title, translationCmd + " " + lang + " " + tr.source}
+	if len(tr.body) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, tr.body...)
+	}
+	if len(tr.notes) > 0 {
+		lines = append(lines, "")
+		for _, n := range tr.notes {
+			lines = append(lines, ": "+n)
+		}
+	}
+	return append(lines, tr.tail...)
+}
+
+// bytes returns the text of the talk.
+func (t *talk) bytes() []byte {
+	lines := append([]string(nil), t.header...)
+	for _, s := range t.slides {
+		lines = append(lines, s.heading)
+		lines = append(lines, s.en...)
+		if s.tr != nil {
+			lines = append(lines, s.tr.lines()...)
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,322 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by "makebundle"; DO NOT EDIT.
+
+package bundle
+
+// Files holds the files of the tour.
+var Files = map[string]string{
+	"checks/check.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\n// This file is built together with a program of the learner and the checks\n// of the exercise, <lesson>/<program> in this directory. The init function\n// of the checks calls the functions the exercise asks for, reports each\n// check with expect and calls finish, so the program ends before its main\n// function runs.\n//\n// The checks are run by the tour server when the learner asks for them;\n// see gotour/check.go.\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\nvar checkFailed bool\n\n// expect reports a check, described by format and args, that passed if ok.\nfunc expect(ok bool, format string, args ...interface{}) {\n\tmsg := fmt.Sprintf(format, args...)\n\tif ok {\n\t\tfmt.Println(\"PASS: \" + msg)\n\t} else {\n\t\tcheckFailed = true\n\t\tfmt.Println(\"FAIL: \" + msg)\n\t}\n}\n\n// finish ends the program after the checks, before main runs.\nfunc finish() {\n\tif checkFailed {\n\t\tos.Exit(1)\n\t}\n\tos.Exit(0)\n}\n",
+
+	"checks/concurrency/exercise-equivalent-binary-trees.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"golang.org/x/tour/tree\"\n\nfunc init() {\n\tfor k := 1; k <= 10; k++ {\n\t\tvar got []int\n\t\tch := make(chan int)\n\t\tgo Walk(tree.New(k), ch)\n\t\tfor v := range ch {\n\t\t\tgot = append(got, v)\n\t\t}\n\t\tok := len(got) == 10\n\t\tfor i, v := range got {\n\t\t\tok = ok && v == (i+1)*k\n\t\t}\n\t\texpect(ok, \"Walk(tree.New(%d)) 发送了 %v，期望 %d, %d, ..., %d\", k, got, k, 2*k, 10*k)\n\n\t\texpect(Same(tree.New(k), tree.New(k)), \"Same(tree.New(%d), tree.New(%d)) = false，期望 true\", k, k)\n\t\texpect(!Same(tree.New(k), tree.New(k+1)), \"Same(tree.New(%d), tree.New(%d)) = true，期望 false\", k, k+1)\n\t}\n\tsmall := &tree.Tree{Value: 1}\n\texpect(!Same(small, tree.New(1)), \"Same(单节点树, tree.New(1)) = true，期望 false\")\n\texpect(!Same(tree.New(1), small), \"Same(tree.New(1), 单节点树) = true，期望 false\")\n\tfinish()\n}\n",
+
+	"checks/concurrency/exercise-web-crawler.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"sync\"\n\n// countingFetcher counts the fetches of each URL.\ntype countingFetcher struct {\n\tf Fetcher\n\n\tmu    sync.Mutex\n\tcount map[string]int\n}\n\nfunc (c *countingFetcher) Fetch(url string) (string, []string, error) {\n\tc.mu.Lock()\n\tc.count[url]++\n\tc.mu.Unlock()\n\treturn c.f.Fetch(url)\n}\n\nfunc init() {\n\tc := &countingFetcher{f: fetcher, count: make(map[string]int)}\n\tCrawl(\"http://golang.org/\", 4, c)\n\tfor _, url := range []string{\n\t\t\"http://golang.org/\",\n\t\t\"http://golang.org/pkg/\",\n\t\t\"http://golang.org/cmd/\",\n\t\t\"http://golang.org/pkg/fmt/\",\n\t\t\"http://golang.org/pkg/os/\",\n\t} {\n\t\texpect(c.count[url] == 1, \"%s 被抓取了 %d 次，期望只抓取一次\", url, c.count[url])\n\t}\n\texpect(len(c.count) == 5, \"抓取了 %d 个 URL，期望 5 个\", len(c.count))\n\tfinish()\n}\n",
+
+	"checks/flowcontrol/exercise-loops-and-functions.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"math\"\n\nfunc init() {\n\tfor _, x := range []float64{1, 2, 3, 10, 100, 12345} {\n\t\tgot := Sqrt(x)\n\t\texpect(math.Abs(got-math.Sqrt(x)) < 1e-5, \"Sqrt(%g) = %g，期望 %g\", x, got, math.Sqrt(x))\n\t}\n\tfinish()\n}\n",
+
+	"checks/methods/exercise-errors.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"math\"\n\t\"strings\"\n)\n\nfunc init() {\n\tfor _, x := range []float64{1, 2, 3, 10, 1e6} {\n\t\tgot, err := Sqrt(x)\n\t\texpect(err == nil && math.Abs(got-math.Sqrt(x)) < 1e-6, \"Sqrt(%g) = %g, %v，期望 %g, nil\", x, got, err, math.Sqrt(x))\n\t}\n\t_, err := Sqrt(-2)\n\texpect(err != nil, \"Sqrt(-2) 返回非 nil 的错误\")\n\tif err != nil {\n\t\t_, ok := err.(ErrNegativeSqrt)\n\t\texpect(ok, \"Sqrt(-2) 返回的错误类型为 %T，期望 ErrNegativeSqrt\", err)\n\t\t// Error must not format e with %v, which would call Error again.\n\t\tmsg := err.Error()\n\t\texpect(strings.Contains(msg, \"-2\"), \"Sqrt(-2) 的错误信息 %q 中应包含 -2\", msg)\n\t}\n\tfinish()\n}\n",
+
+	"checks/methods/exercise-http-handlers.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"net/http\"\n\t\"net/http/httptest\"\n)\n\nfunc init() {\n\tfor _, c := range []struct {\n\t\th    http.Handler\n\t\twant string\n\t}{\n\t\t{String(\"I'm a frayed knot.\"), \"I'm a frayed knot.\"},\n\t\t{&Struct{\"Hello\", \":\", \"Gophers!\"}, \"Hello: Gophers!\"},\n\t} {\n\t\tw := httptest.NewRecorder()\n\t\tc.h.ServeHTTP(w, httptest.NewRequest(\"GET\", \"/\", nil))\n\t\texpect(w.Body.String() == c.want, \"%#v 返回了 %q，期望 %q\", c.h, w.Body.String(), c.want)\n\t}\n\tfinish()\n}\n",
+
+	"checks/methods/exercise-images.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"image\"\n\t\"reflect\"\n)\n\nfunc init() {\n\t// The fields of Image are up to the learner: set those that are\n\t// exported integers, such as a width and a height, to 256.\n\tv := reflect.New(reflect.TypeOf(Image{})).Elem()\n\tfor i := 0; i < v.NumField(); i++ {\n\t\tif f := v.Field(i); f.CanSet() && f.Kind() == reflect.Int {\n\t\t\tf.SetInt(256)\n\t\t}\n\t}\n\tvar m image.Image = v.Interface().(Image)\n\n\tb := m.Bounds()\n\texpect(!b.Empty(), \"Bounds() = %v，期望非空矩形\", b)\n\texpect(m.ColorModel() != nil, \"ColorModel() 返回非 nil 的颜色模型\")\n\tif !b.Empty() && m.ColorModel() != nil {\n\t\tok := true\n\t\tfor _, p := range []image.Point{b.Min, b.Max.Sub(image.Pt(1, 1))} {\n\t\t\tc := m.At(p.X, p.Y)\n\t\t\tok = ok && c != nil && m.ColorModel().Convert(c) != nil\n\t\t}\n\t\texpect(ok, \"At 对 Bounds() 角上的点返回非 nil 的颜色\")\n\t}\n\tfinish()\n}\n",
+
+	"checks/methods/exercise-rot-reader.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"io/ioutil\"\n\t\"strings\"\n)\n\nfunc init() {\n\tfor _, c := range []struct{ in, want string }{\n\t\t{\"Lbh penpxrq gur pbqr!\", \"You cracked the code!\"},\n\t\t{\"ABCDEFGHIJKLMNOPQRSTUVWXYZ\", \"NOPQRSTUVWXYZABCDEFGHIJKLM\"},\n\t\t{\"abcdefghijklmnopqrstuvwxyz\", \"nopqrstuvwxyzabcdefghijklm\"},\n\t\t{\"0123 -_.!?\", \"0123 -_.!?\"},\n\t} {\n\t\tb, err := ioutil.ReadAll(rot13Reader{strings.NewReader(c.in)})\n\t\texpect(err == nil && string(b) == c.want, \"从 %q 的 rot13Reader 读到 %q, %v，期望 %q\", c.in, b, err, c.want)\n\t}\n\tfinish()\n}\n",
+
+	"checks/methods/exercise-stringer.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"fmt\"\n\nfunc init() {\n\tfor _, c := range []struct {\n\t\tip   IPAddr\n\t\twant string\n\t}{\n\t\t{IPAddr{127, 0, 0, 1}, \"127.0.0.1\"},\n\t\t{IPAddr{8, 8, 8, 8}, \"8.8.8.8\"},\n\t\t{IPAddr{255, 0, 10, 200}, \"255.0.10.200\"},\n\t} {\n\t\tgot := fmt.Sprint(c.ip)\n\t\texpect(got == c.want, \"fmt.Sprint(%#v) = %q，期望 %q\", [4]byte(c.ip), got, c.want)\n\t}\n\tfinish()\n}\n",
+
+	"checks/moretypes/exercise-fibonacci-closure.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nfunc init() {\n\tf := fibonacci()\n\tvar got []int\n\tfor i := 0; i < 10; i++ {\n\t\tgot = append(got, f())\n\t}\n\t// The sequence may start with 0, 1 or with 1, 1.\n\tok := len(got) == 10 && (got[0] == 0 || got[0] == 1) && got[1] == 1\n\tfor i := 2; ok && i < len(got); i++ {\n\t\tok = got[i] == got[i-1]+got[i-2]\n\t}\n\texpect(ok, \"fibonacci() 返回了 %v，期望连续的斐波那契数\", got)\n\n\tg := fibonacci()\n\tg()\n\texpect(f() != g(), \"fibonacci() 返回的每个闭包各自保存状态\")\n\tfinish()\n}\n",
+
+	"checks/moretypes/exercise-maps.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"reflect\"\n\nfunc init() {\n\tfor _, c := range []struct {\n\t\tin   string\n\t\twant map[string]int\n\t}{\n\t\t{\"\", map[string]int{}},\n\t\t{\"I am learning Go!\", map[string]int{\"I\": 1, \"am\": 1, \"learning\": 1, \"Go!\": 1}},\n\t\t{\"a  b\\ta\\nb a\", map[string]int{\"a\": 3, \"b\": 2}},\n\t} {\n\t\tgot := WordCount(c.in)\n\t\texpect(reflect.DeepEqual(got, c.want), \"WordCount(%q) = %v，期望 %v\", c.in, got, c.want)\n\t}\n\tfinish()\n}\n",
+
+	"checks/moretypes/exercise-slices.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nfunc init() {\n\tfor _, c := range []struct{ dx, dy int }{{256, 256}, {10, 20}, {30, 5}} {\n\t\tp := Pic(c.dx, c.dy)\n\t\tok := len(p) == c.dy\n\t\tfor _, row := range p {\n\t\t\tok = ok && len(row) == c.dx\n\t\t}\n\t\texpect(ok, \"Pic(%d, %d) 返回 %d 行、每行 %d 个值\", c.dx, c.dy, c.dy, c.dx)\n\t}\n\tfinish()\n}\n",
+
+	"content/_tr/div_begin_en.html": "<div class=\"english\">\n",
+
+	"content/_tr/div_begin_zh_CN.html": "<div class=\"chinese\">\n",
+
+	"content/_tr/div_end.html": "</div>\n",
+
+	"content/basics.article": "Packages, variables, and functions.\nLearn the basic components of any Go program.\n\nThe Go Authors\nhttp://golang.org\n\n* Packages\n\nEvery Go program is made up of packages.\n\nPrograms start running in package `main`.\n\nThis program is using the packages with import paths `\"fmt\"` and `\"math/rand\"`.\n\nBy convention, the package name is the same as the last element of the import path. For instance, the `\"math/rand\"` package comprises files that begin with the statement `package`rand`.\n\n#appengine: *Note:* the environment in which these programs are executed is\n#appengine: deterministic, so `rand.Intn` will always return the same number.\n#appengine:\n#appengine: (To see a different number, seed the number generator; see [[http://golang.org/pkg/math/rand/#Seed][`rand.Seed`]].)\n\n.play basics/packages.go\n\n* Imports\n\nThis code groups the imports into a parenthesized, \"factored\" import statement. \n\nYou can also write multiple import statements, like:\n\n\timport \"fmt\"\n\timport \"math\"\n\nBut it is good style to use the factored import statement.\n\n.play basics/imports.go\n\n* Exported names\n\nAfter importing a package, you can refer to the names it exports.\n\nIn Go, a name is exported if it begins with a capital letter.\n\n`Foo` is an exported name, as is `FOO`. The name `foo` is not exported.\n\nRun the code. Then rename `math.pi` to `math.Pi` and try it again.\n\n.play basics/exported-names.go\n\n* Functions\n\nA function can take zero or more arguments.\n\nIn this example, `add` takes two parameters of type `int`.\n\nNotice that the type comes _after_ the variable name.\n\n(For more about why types look the way they do, see the [[http://golang.org/doc/articles/gos_declaration_syntax.html][article on Go's declaration syntax]].)\n\n.play basics/functions.go\n\n* Functions continued\n\nWhen two or more consecutive named function parameters share a type, you can omit the type from all but the last.\n\nIn this example, we shortened\n\n\tx int, y int\n\nto\n\n\tx, y int\n\n.play basics/functions-continued.go\n\n* Multiple results\n\nA function can return any number of results.\n\nThe `swap` function returns two strings.\n\n.play basics/multiple-results.go\n\n* Named return values\n\nGo's return values may be named and act just like variables.\n\nThese names should be used to document the meaning of the return values.\n\nA `return` statement without arguments returns the current values of the results. This is known as a \"naked\" return.\n\nNaked return statements should be used only in short function, as with the example shown here. They can harm readability in longer functions.\n\n.play basics/named-results.go\n\n* Variables\n\nThe `var` statement declares a list of variables; as in function argument lists, the type is last.\n\nA `var` statement can be at package or function level. We see both in this example.\n\n.play basics/variables.go\n\n* Variables with initializers\n\nA var declaration can include initializers, one per variable.\n\nIf an initializer is present, the type can be omitted; the variable will take the type of the initializer.\n\n.play basics/variables-with-initializers.go\n\n* Short variable declarations\n\nInside a function, the `:=` short assignment statement can be used in place of a `var` declaration with implicit type.\n\nOutside a function, every statement begins with a keyword (`var`, `func`, and so on) and so the `:=` construct is not available.\n\n.play basics/short-variable-declarations.go\n\n* Basic types\n\nGo's basic types are\n\n\tbool\n\n\tstring\n\n\tint  int8  int16  int32  int64\n\tuint uint8 uint16 uint32 uint64 uintptr\n\n\tbyte // alias for uint8\n\n\trune // alias for int32\n\t     // represents a Unicode code point\n\n\tfloat32 float64\n\n\tcomplex64 complex128\n\nThe example shows variables of several types,\nand also that variable declarations may be \"factored\" into blocks,\nas with import statements.\n\n.play basics/basic-types.go\n\n* Zero values\n\nVariables declared without an explicit initial value are given their\n_zero_value_.\n\nThe zero value is:\n\n- `0` for numeric types,\n- `false` the boolean type, and\n- `\"\"` (the empty string) for strings.\n\n.play basics/zero.go\n\n* Type conversions\n\nThe expression `T(v)` converts the value `v` to the type `T`.\n\nSome numeric conversions:\n\n\tvar i int = 42\n\tvar f float64 = float64(i)\n\tvar u uint = uint(f)\n\nOr, put more simply:\n\n\ti := 42\n\tf := float64(i)\n\tu := uint(f)\n\nUnlike in C, in Go assignment between items of different type requires an\nexplicit conversion.\nTry removing the `float64` or `int` conversions in the example and see what happens.\n\n.play basics/type-conversions.go\n\n* Type inference\n\nWhen declaring a variable without specifying its type (using `var` without a type or the `:=` syntax), the variable's type is _inferred_ from the value on the right hand side.\n\nWhen the right hand side of the declaration is typed, the new variable is of that same type:\n\n\tvar i int\n\tj := i // j is an int\n\nBut when the right hand side contains an untyped numeric constant, the new variable may be an `int`, `float64`, or `complex128` depending on the precision of the constant:\n\n\ti := 42           // int\n\tf := 3.142        // float64\n\tg := 0.867 + 0.5i // complex128\n\nTry changing the initial value of `v` in the example code and observe how its type is affected.\n\n.play basics/type-inference.go\n\n* Constants\n\nConstants are declared like variables, but with the `const` keyword.\n\nConstants can be character, string, boolean, or numeric values.\n\nConstants cannot be declared using the `:=` syntax.\n\n.play basics/constants.go\n\n* Numeric Constants\n\nNumeric constants are high-precision _values_.\n\nAn untyped constant takes the type needed by its context.\n\nTry printing `needInt(Big)` too.\n\n.play basics/numeric-constants.go\n\n* Congratulations!\n\nYou finished this lesson!\n\nYou can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\n",
+
+	"content/basics/basic-types.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math/cmplx\"\n)\n\nvar (\n\tToBe   bool       = false\n\tMaxInt uint64     = 1<<64 - 1\n\tz      complex128 = cmplx.Sqrt(-5 + 12i)\n)\n\nfunc main() {\n\tconst f = \"%T(%v)\\n\"\n\tfmt.Printf(f, ToBe, ToBe)\n\tfmt.Printf(f, MaxInt, MaxInt)\n\tfmt.Printf(f, z, z)\n}\n",
+
+	"content/basics/constants.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nconst Pi = 3.14\n\nfunc main() {\n\tconst World = \"世界\"\n\tfmt.Println(\"Hello\", World)\n\tfmt.Println(\"Happy\", Pi, \"Day\")\n\n\tconst Truth = true\n\tfmt.Println(\"Go rules?\", Truth)\n}\n",
+
+	"content/basics/exported-names.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc main() {\n\tfmt.Println(math.pi)\n}\n",
+
+	"content/basics/functions-continued.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc add(x, y int) int {\n\treturn x + y\n}\n\nfunc main() {\n\tfmt.Println(add(42, 13))\n}\n",
+
+	"content/basics/functions.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc add(x int, y int) int {\n\treturn x + y\n}\n\nfunc main() {\n\tfmt.Println(add(42, 13))\n}\n",
+
+	"content/basics/imports.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc main() {\n\tfmt.Printf(\"Now you have %g problems.\", math.Nextafter(2, 3))\n}\n",
+
+	"content/basics/multiple-results.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc swap(x, y string) (string, string) {\n\treturn y, x\n}\n\nfunc main() {\n\ta, b := swap(\"hello\", \"world\")\n\tfmt.Println(a, b)\n}\n",
+
+	"content/basics/named-results.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc split(sum int) (x, y int) {\n\tx = sum * 4 / 9\n\ty = sum - x\n\treturn\n}\n\nfunc main() {\n\tfmt.Println(split(17))\n}\n",
+
+	"content/basics/numeric-constants.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nconst (\n\tBig   = 1 << 100\n\tSmall = Big >> 99\n)\n\nfunc needInt(x int) int { return x*10 + 1 }\nfunc needFloat(x float64) float64 {\n\treturn x * 0.1\n}\n\nfunc main() {\n\tfmt.Println(needInt(Small))\n\tfmt.Println(needFloat(Small))\n\tfmt.Println(needFloat(Big))\n}\n",
+
+	"content/basics/packages.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math/rand\"\n)\n\nfunc main() {\n\tfmt.Println(\"My favorite number is\", rand.Intn(10))\n}\n",
+
+	"content/basics/short-variable-declarations.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tvar i, j int = 1, 2\n\tk := 3\n\tc, python, java := true, false, \"no!\"\n\n\tfmt.Println(i, j, k, c, python, java)\n}\n",
+
+	"content/basics/type-conversions.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc main() {\n\tvar x, y int = 3, 4\n\tvar f float64 = math.Sqrt(float64(x*x + y*y))\n\tvar z int = int(f)\n\tfmt.Println(x, y, z)\n}\n",
+
+	"content/basics/type-inference.go": "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tv := 42 // change me!\n\tfmt.Printf(\"v is of type %T\\n\", v)\n}\n",
+
+	"content/basics/variables-with-initializers.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nvar i, j int = 1, 2\n\nfunc main() {\n\tvar c, python, java = true, false, \"no!\"\n\tfmt.Println(i, j, c, python, java)\n}\n",
+
+	"content/basics/variables.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nvar c, python, java bool\n\nfunc main() {\n\tvar i int\n\tfmt.Println(i, c, python, java)\n}\n",
+
+	"content/basics/zero.go": "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tvar i int\n\tvar f float64\n\tvar b bool\n\tvar s string\n\tfmt.Printf(\"%v %v %v %q\\n\", i, f, b, s)\n}\n",
+
+	"content/concurrency.article": "Concurrency\nGo provides concurrency constructions as part of the core language. This lessons presents them and gives some examples on how to use it.\n\nThe Go Authors\nhttp://golang.org\n\n* Goroutines\n\nA _goroutine_ is a lightweight thread managed by the Go runtime.\n\n\tgo f(x, y, z)\n\nstarts a new goroutine running\n\n\tf(x, y, z)\n\nThe evaluation of `f`, `x`, `y`, and `z` happens in the current goroutine and the execution of `f` happens in the new goroutine.\n\nGoroutines run in the same address space, so access to shared memory must be synchronized. The [[http://golang.org/pkg/sync/][`sync`]] package provides useful primitives, although you won't need them much in Go as there are other primitives. (See the next slide.)\n\n.play concurrency/goroutines.go\n\n* Channels\n\nChannels are a typed conduit through which you can send and receive values with the channel operator, `<-`.\n\n\tch <- v    // Send v to channel ch.\n\tv := <-ch  // Receive from ch, and\n\t           // assign value to v.\n\n(The data flows in the direction of the arrow.)\n\nLike maps and slices, channels must be created before use:\n\n\tch := make(chan int)\n\nBy default, sends and receives block until the other side is ready. This allows goroutines to synchronize without explicit locks or condition variables.\n\n.play concurrency/channels.go\n\n* Buffered Channels\n\nChannels can be _buffered_.  Provide the buffer length as the second argument to `make` to initialize a buffered channel:\n\n\tch := make(chan int, 100)\n\nSends to a buffered channel block only when the buffer is full. Receives block when the buffer is empty.\n\nModify the example to overfill the buffer and see what happens.\n\n.play concurrency/buffered-channels.go\n\n* Range and Close\n\nA sender can `close` a channel to indicate that no more values will be sent. Receivers can test whether a channel has been closed by assigning a second parameter to the receive expression: after\n\n\tv, ok := <-ch\n\n`ok` is `false` if there are no more values to receive and the channel is closed.\n\nThe loop `for`i`:=`range`c` receives values from the channel repeatedly until it is closed.\n\n*Note:* Only the sender should close a channel, never the receiver. Sending on a closed channel will cause a panic.\n\n*Another*note*: Channels aren't like files; you don't usually need to close them. Closing is only necessary when the receiver must be told there are no more values coming, such as to terminate a `range` loop.\n\n.play concurrency/range-and-close.go\n\n* Select\n\nThe `select` statement lets a goroutine wait on multiple communication operations.\n\nA `select` blocks until one of its cases can run, then it executes that case.  It chooses one at random if multiple are ready.\n\n.play concurrency/select.go\n\n* Default Selection\n\nThe `default` case in a `select` is run if no other case is ready.\n\nUse a `default` case to try a send or receive without blocking:\n\n\tselect {\n\tcase i := <-c:\n\t\t// use i\n\tdefault:\n\t\t// receiving from c would block\n\t}\n\n.play concurrency/default-selection.go\n\n* Exercise: Equivalent Binary Trees\n\nThere can be many different binary trees with the same sequence of values stored at the leaves. For example, here are two binary trees storing the sequence 1, 1, 2, 3, 5, 8, 13.\n\n.image /content/img/tree.png\n\nA function to check whether two binary trees store the same sequence is quite complex in most languages. We'll use Go's concurrency and channels to write a simple solution.\n\nThis example uses the `tree` package, which defines the type:\n\n\ttype Tree struct {\n\t\tLeft  *Tree\n\t\tValue int\n\t\tRight *Tree\n\t}\n\n* Exercise: Equivalent Binary Trees\n\n*1.* Implement the `Walk` function.\n\n*2.* Test the `Walk` function.\n\nThe function `tree.New(k)` constructs a randomly-structured binary tree holding the values `k`, `2k`, `3k`, ..., `10k`.\n\nCreate a new channel `ch` and kick off the walker:\n\n\tgo Walk(tree.New(1), ch)\n\nThen read and print 10 values from the channel. It should be the numbers 1, 2, 3, ..., 10.\n\n*3.* Implement the `Same` function using `Walk` to determine whether `t1` and `t2` store the same values.\n\n*4.* Test the `Same` function.\n\n`Same(tree.New(1),`tree.New(1))` should return true, and `Same(tree.New(1),`tree.New(2))` should return false.\n\n.play concurrency/exercise-equivalent-binary-trees.go\n\n* Exercise: Web Crawler\n\nIn this exercise you'll use Go's concurrency features to parallelize a web crawler.\n\nModify the `Crawl` function to fetch URLs in parallel without fetching the same URL twice.\n\n.play concurrency/exercise-web-crawler.go\n\n* Where to Go from here...\n\n#appengine: You can get started by\n#appengine: [[http://golang.org/doc/install/][installing Go]] or downloading the\n#appengine: [[http://code.google.com/appengine/downloads.html#Google_App_Engine_SDK_for_Go][Go App Engine SDK]].\n\n#appengine: Once you have Go installed, the\nThe\n[[http://golang.org/doc/][Go Documentation]] is a great place to\n#appengine: continue.\nstart.\nIt contains references, tutorials, videos, and more.\n\nTo learn how to organize and work with Go code, watch [[http://www.youtube.com/watch?v=XCsL89YtqCs][this screencast]] or read [[http://golang.org/doc/code.html][How to Write Go Code]].\n\nIf you need help with the standard library, see the [[http://golang.org/pkg/][package reference]]. For help with the language itself, you might be surprised to find the [[http://golang.org/ref/spec][Language Spec]] is quite readable.\n\nTo further explore Go's concurrency model, watch\n[[http://www.youtube.com/watch?v=f6kdp27TYZs][Go Concurrency Patterns]]\n([[http://talks.golang.org/2012/concurrency.slide][slides]])\nand\n[[https://www.youtube.com/watch?v=QDDwwePbDtw][Advanced Go Concurrency Patterns]]\n([[http://talks.golang.org/2013/advconc.slide][slides]])\nand read the\n[[http://golang.org/doc/codewalk/sharemem/][Share Memory by Communicating]]\ncodewalk.\n\nTo get started writing web applications, watch\n[[http://vimeo.com/53221558][A simple programming environment]]\n([[http://talks.golang.org/2012/simple.slide][slides]])\nand read the\n[[http://golang.org/doc/articles/wiki/][Writing Web Applications]] tutorial.\n\nThe [[http://golang.org/doc/codewalk/functions/][First Class Functions in Go]] codewalk gives an interesting perspective on Go's function types.\n\nThe [[http://blog.golang.org/][Go Blog]] has a large archive of informative Go articles.\n\nVisit [[http://golang.org][golang.org]] for more.\n\n",
+
+	"content/concurrency/buffered-channels.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tc := make(chan int, 2)\n\tc <- 1\n\tc <- 2\n\tfmt.Println(<-c)\n\tfmt.Println(<-c)\n}\n",
+
+	"content/concurrency/channels.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc sum(a []int, c chan int) {\n\tsum := 0\n\tfor _, v := range a {\n\t\tsum += v\n\t}\n\tc <- sum // send sum to c\n}\n\nfunc main() {\n\ta := []int{7, 2, 8, -9, 4, 0}\n\n\tc := make(chan int)\n\tgo sum(a[:len(a)/2], c)\n\tgo sum(a[len(a)/2:], c)\n\tx, y := <-c, <-c // receive from c\n\n\tfmt.Println(x, y, x+y)\n}\n",
+
+	"content/concurrency/default-selection.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc main() {\n\ttick := time.Tick(100 * time.Millisecond)\n\tboom := time.After(500 * time.Millisecond)\n\tfor {\n\t\tselect {\n\t\tcase <-tick:\n\t\t\tfmt.Println(\"tick.\")\n\t\tcase <-boom:\n\t\t\tfmt.Println(\"BOOM!\")\n\t\t\treturn\n\t\tdefault:\n\t\t\tfmt.Println(\"    .\")\n\t\t\ttime.Sleep(50 * time.Millisecond)\n\t\t}\n\t}\n}\n",
+
+	"content/concurrency/exercise-equivalent-binary-trees.go": "// +build OMIT\n\npackage main\n\nimport \"golang.org/x/tour/tree\"\n\n// Walk walks the tree t sending all values\n// from the tree to the channel ch.\nfunc Walk(t *tree.Tree, ch chan int)\n\n// Same determines whether the trees\n// t1 and t2 contain the same values.\nfunc Same(t1, t2 *tree.Tree) bool\n\nfunc main() {\n}\n",
+
+	"content/concurrency/exercise-web-crawler.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n)\n\ntype Fetcher interface {\n\t// Fetch returns the body of URL and\n\t// a slice of URLs found on that page.\n\tFetch(url string) (body string, urls []string, err error)\n}\n\n// Crawl uses fetcher to recursively crawl\n// pages starting with url, to a maximum of depth.\nfunc Crawl(url string, depth int, fetcher Fetcher) {\n\t// TODO: Fetch URLs in parallel.\n\t// TODO: Don't fetch the same URL twice.\n\t// This implementation doesn't do either:\n\tif depth <= 0 {\n\t\treturn\n\t}\n\tbody, urls, err := fetcher.Fetch(url)\n\tif err != nil {\n\t\tfmt.Println(err)\n\t\treturn\n\t}\n\tfmt.Printf(\"found: %s %q\\n\", url, body)\n\tfor _, u := range urls {\n\t\tCrawl(u, depth-1, fetcher)\n\t}\n\treturn\n}\n\nfunc main() {\n\tCrawl(\"http://golang.org/\", 4, fetcher)\n}\n\n// fakeFetcher is Fetcher that returns canned results.\ntype fakeFetcher map[string]*fakeResult\n\ntype fakeResult struct {\n\tbody string\n\turls []string\n}\n\nfunc (f fakeFetcher) Fetch(url string) (string, []string, error) {\n\tif res, ok := f[url]; ok {\n\t\treturn res.body, res.urls, nil\n\t}\n\treturn \"\", nil, fmt.Errorf(\"not found: %s\", url)\n}\n\n// fetcher is a populated fakeFetcher.\nvar fetcher = fakeFetcher{\n\t\"http://golang.org/\": &fakeResult{\n\t\t\"The Go Programming Language\",\n\t\t[]string{\n\t\t\t\"http://golang.org/pkg/\",\n\t\t\t\"http://golang.org/cmd/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/\": &fakeResult{\n\t\t\"Packages\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/cmd/\",\n\t\t\t\"http://golang.org/pkg/fmt/\",\n\t\t\t\"http://golang.org/pkg/os/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/fmt/\": &fakeResult{\n\t\t\"Package fmt\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/pkg/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/os/\": &fakeResult{\n\t\t\"Package os\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/pkg/\",\n\t\t},\n\t},\n}\n",
+
+	"content/concurrency/goroutines.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc say(s string) {\n\tfor i := 0; i < 5; i++ {\n\t\ttime.Sleep(100 * time.Millisecond)\n\t\tfmt.Println(s)\n\t}\n}\n\nfunc main() {\n\tgo say(\"world\")\n\tsay(\"hello\")\n}\n",
+
+	"content/concurrency/range-and-close.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n)\n\nfunc fibonacci(n int, c chan int) {\n\tx, y := 0, 1\n\tfor i := 0; i < n; i++ {\n\t\tc <- x\n\t\tx, y = y, x+y\n\t}\n\tclose(c)\n}\n\nfunc main() {\n\tc := make(chan int, 10)\n\tgo fibonacci(cap(c), c)\n\tfor i := range c {\n\t\tfmt.Println(i)\n\t}\n}\n",
+
+	"content/concurrency/select.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc fibonacci(c, quit chan int) {\n\tx, y := 0, 1\n\tfor {\n\t\tselect {\n\t\tcase c <- x:\n\t\t\tx, y = y, x+y\n\t\tcase <-quit:\n\t\t\tfmt.Println(\"quit\")\n\t\t\treturn\n\t\t}\n\t}\n}\n\nfunc main() {\n\tc := make(chan int)\n\tquit := make(chan int)\n\tgo func() {\n\t\tfor i := 0; i < 10; i++ {\n\t\t\tfmt.Println(<-c)\n\t\t}\n\t\tquit <- 0\n\t}()\n\tfibonacci(c, quit)\n}\n",
+
+	"content/flowcontrol.article": "Flow control statements: for, if, else, and switch\nLearn how to control the flow of your code with conditionals, loops, and switches.\n\nThe Go Authors\nhttp://golang.org\n\n* For\n\nGo has only one looping construct, the `for` loop.\n\nThe basic `for` loop looks as it does in C or Java, except that the `(`)` are gone (they are not even optional) and the `{`}` are required.\n\n.play flowcontrol/for.go\n\n* For continued\n\nAs in C or Java, you can leave the pre and post statements empty.\n\n.play flowcontrol/for-continued.go\n\n* For is Go's \"while\"\n\nAt that point you can drop the semicolons: C's `while` is spelled `for` in Go.\n\n.play flowcontrol/for-is-gos-while.go\n\n* Forever\n\nIf you omit the loop condition it loops forever, so an infinite loop is compactly expressed.\n\n.play flowcontrol/forever.go\n\n* If\n\nThe `if` statement looks as it does in C or Java, except that the `(`)` are gone and the `{`}` are required.\n\n(Sound familiar?)\n\n.play flowcontrol/if.go\n\n* If with a short statement\n\nLike `for`, the `if` statement can start with a short statement to execute before the condition.\n\nVariables declared by the statement are only in scope until the end of the `if`.\n\n(Try using `v` in the last `return` statement.)\n\n.play flowcontrol/if-with-a-short-statement.go\n\n* If and else\n\nVariables declared inside an `if` short statement are also available inside any of the `else` blocks.\n\n.play flowcontrol/if-and-else.go\n\n* Exercise: Loops and Functions\n\nAs a simple way to play with functions and loops, implement the square root function using Newton's method.\n\nIn this case, Newton's method is to approximate `Sqrt(x)` by picking a starting point _z_ and then repeating:\n\n.image /content/img/newton.png\n\nTo begin with, just repeat that calculation 10 times and see how close you get to the answer for various values (1, 2, 3, ...).\n\nNext, change the loop condition to stop once the value has stopped changing (or only changes by a very small delta). See if that's more or fewer iterations. How close are you to the [[http://golang.org/pkg/math/#Sqrt][math.Sqrt]]?\n\nHint: to declare and initialize a floating point value, give it floating point syntax or use a conversion:\n\n\tz := float64(1)\n\tz := 1.0\n\n.play flowcontrol/exercise-loops-and-functions.go\n\n* Switch\n\nYou probably knew what `switch` was going to look like.\n\nA case body breaks automatically, unless it ends with a `fallthrough` statement.\n\n.play flowcontrol/switch.go\n\n* Switch evaluation order\n\nSwitch cases evaluate cases from top to bottom, stopping when a case succeeds.\n\n(For example,\n\n\tswitch i {\n\tcase 0:\n\tcase f():\n\t}\n\ndoes not call `f` if `i==0`.)\n\n#appengine: *Note:* Time in the Go playground always appears to start at\n#appengine: 2009-11-10 23:00:00 UTC, a value whose significance is left as an\n#appengine: exercise for the reader.\n\n.play flowcontrol/switch-evaluation-order.go\n\n* Switch with no condition\n\nSwitch without a condition is the same as `switch`true`.\n\nThis construct can be a clean way to write long if-then-else chains.\n\n.play flowcontrol/switch-with-no-condition.go\n\n* Defer\n\nA defer statement defers the execution of a function until the surrounding\nfunction returns.\n\nThe deferred call's arguments are evaluated immediately, but the function call\nis not executed until the surrounding function returns.\n\n.play flowcontrol/defer.go\n\n* Stacking defers\n\nDeferred function calls are pushed onto a stack. When a function returns, its\ndeferred calls are executed in last-in-first-out order.\n\nTo learn more about defer statements read this\n[[http://blog.golang.org/defer-panic-and-recover][blog post]].\n\n.play flowcontrol/defer-multi.go\n\n* Congratulations!\n\nYou finished this lesson!\n\nYou can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\n",
+
+	"content/flowcontrol/defer-multi.go": "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"counting\")\n\n\tfor i := 0; i < 10; i++ {\n\t\tdefer fmt.Println(i)\n\t}\n\n\tfmt.Println(\"done\")\n}\n",
+
+	"content/flowcontrol/defer.go": "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tdefer fmt.Println(\"world\")\n\n\tfmt.Println(\"hello\")\n}\n",
+
+	"content/flowcontrol/exercise-loops-and-functions.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n)\n\nfunc Sqrt(x float64) float64 {\n}\n\nfunc main() {\n\tfmt.Println(Sqrt(2))\n}\n",
+
+	"content/flowcontrol/for-continued.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tsum := 1\n\tfor ; sum < 1000; {\n\t\tsum += sum\n\t}\n\tfmt.Println(sum)\n}\n",
+
+	"content/flowcontrol/for-is-gos-while.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tsum := 1\n\tfor sum < 1000 {\n\t\tsum += sum\n\t}\n\tfmt.Println(sum)\n}\n",
+
+	"content/flowcontrol/for.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tsum := 0\n\tfor i := 0; i < 10; i++ {\n\t\tsum += i\n\t}\n\tfmt.Println(sum)\n}\n",
+
+	"content/flowcontrol/forever.go": "// +build OMIT\n\npackage main\n\nfunc main() {\n\tfor {\n\t}\n}\n",
+
+	"content/flowcontrol/if-and-else.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc pow(x, n, lim float64) float64 {\n\tif v := math.Pow(x, n); v < lim {\n\t\treturn v\n\t} else {\n\t\tfmt.Printf(\"%g >= %g\\n\", v, lim)\n\t}\n\t// can't use v here, though\n\treturn lim\n}\n\nfunc main() {\n\tfmt.Println(\n\t\tpow(3, 2, 10),\n\t\tpow(3, 3, 20),\n\t)\n}\n",
+
+	"content/flowcontrol/if-with-a-short-statement.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc pow(x, n, lim float64) float64 {\n\tif v := math.Pow(x, n); v < lim {\n\t\treturn v\n\t}\n\treturn lim\n}\n\nfunc main() {\n\tfmt.Println(\n\t\tpow(3, 2, 10),\n\t\tpow(3, 3, 20),\n\t)\n}\n",
+
+	"content/flowcontrol/if.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc sqrt(x float64) string {\n\tif x < 0 {\n\t\treturn sqrt(-x) + \"i\"\n\t}\n\treturn fmt.Sprint(math.Sqrt(x))\n}\n\nfunc main() {\n\tfmt.Println(sqrt(2), sqrt(-4))\n}\n",
+
+	"content/flowcontrol/switch-evaluation-order.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc main() {\n\tfmt.Println(\"When's Saturday?\")\n\ttoday := time.Now().Weekday()\n\tswitch time.Saturday {\n\tcase today + 0:\n\t\tfmt.Println(\"Today.\")\n\tcase today + 1:\n\t\tfmt.Println(\"Tomorrow.\")\n\tcase today + 2:\n\t\tfmt.Println(\"In two days.\")\n\tdefault:\n\t\tfmt.Println(\"Too far away.\")\n\t}\n}\n",
+
+	"content/flowcontrol/switch-with-no-condition.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc main() {\n\tt := time.Now()\n\tswitch {\n\tcase t.Hour() < 12:\n\t\tfmt.Println(\"Good morning!\")\n\tcase t.Hour() < 17:\n\t\tfmt.Println(\"Good afternoon.\")\n\tdefault:\n\t\tfmt.Println(\"Good evening.\")\n\t}\n}\n",
+
+	"content/flowcontrol/switch.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"runtime\"\n)\n\nfunc main() {\n\tfmt.Print(\"Go runs on \")\n\tswitch os := runtime.GOOS; os {\n\tcase \"darwin\":\n\t\tfmt.Println(\"OS X.\")\n\tcase \"linux\":\n\t\tfmt.Println(\"Linux.\")\n\tdefault:\n\t\t// freebsd, openbsd,\n\t\t// plan9, windows...\n\t\tfmt.Printf(\"%s.\", os)\n\t}\n}\n",
+
+	"content/img/newton.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00`\x00\x00\x00 \b\x02\x00\x00\x00b\xa2\xea\x03\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x06\x14IDATh\x81ݘ_HS}\x18ǟ\x1d7\xb6eB#sv#D\xf4G\xec\xafۅ\x85\x04)\x13\xa1;C\xa2r\xd1f\xb5\xfe:Fa\xbb\xd8\x1f\x82\x95Eʉڅ\x15\xe2Z\x11\xe4\xac\xe1.\xc2M$#\xdav\x12\xb125G\xad.\xa4\x8d\x99:v\xd6\xe66\xcf\xf9\xbd\x17'L\xf6\xbe\xdaz=\xf5\xbe\xf3{\xf5\xfc\x9e\xfd\xce\xe7wγ\xe7\xf7\xfc\x9es8\b!X\x16\xfa\xf6\xed\x1b\x86aB\xa1\x90],\x97]\xdc\x7f\"\x84\x90\xc3\xe1@\b\x8d\x8f\x8f\x8f\x8e\x8e\u07bcy3''\x87Mz\xb6\xab\xa7\xa7\xe7\xf8\xf1\xe3\b!\x9a\xa6e2\xd9\xed۷Y\x84c\xacE\xfa\x0f\xca\xe7\xf3uuu1\xb6\xdb\xed\x16\b\x04\xe1p\x18\x008\x1c\x8e@ \xf8\xf2\xe5˿`F\xa3\xd1\xce\xceN\xbfߏ\x10z\xfa\xf4\xa9\xd3\xe9L\xa5R\x90\x8d[\xcc\xedv\x1b\f\x06\f\xc3\xfc~\xbfX,\x8e\xc7\xe3\xf5\xf5\xf5\xe5\xe5\xe5\x00\x10\b\x04H\x92\x94\xcb\xe5\xbf\xca$\b\xe2\xf5\xebׇ\x0e\x1d:v\xecضm\xdb\xf6\xedۧ\xd3\xe9\xdcn\xf7\xa5K\x97\xb2/\x83\b\x82\xb8w\xef\x9e\xd3\xe9,,,$\bB\xa9T2~\x9a\xa6\xaf^\xbd\xda\xdcܼ~\xfd\xfa_\x02\"\x84,\x16\x8bR\xa9\xcc\xcbˋD\"|>\x7fӦM\xb9\xb9\xb92\x99\f\xb21\x834\x1a\r\x00\x8c\x8c\x8c\x8c\x8d\x8d\xe18\xce\xe1p\x00\x80\xa6馦\xa6#G\x8eH$\x92\x99\x99\x19\x81@\x00\x008\x8e\x0f\r\r-đJ\xa5\xa7O\x9f\x06\x00\x84\x90L&\xe3\xf1x\x89D\"\x1c\x0eWTT\b\x85\xc2G\x8f\x1e1Ӳ/@\x000<<l\xb3٘\x8d\xc6x\xda\xdb\xdbKKK\xc5b\xb1\xcf\xe7\xeb\xef\xef?|\xf80\x00TWW\xefܹs!\xc8ڵk\x19\x03ð\x9a\x9a\x1a\x00\x18\x18\x18\xc00\xac\xa4\xa4d\xfe\xb4\xec\v\xd0\xc8Ȉ\xcdf\xd3\xeb\xf5\x18\x86\x8d\x8e\x8eF\xa3Q\x91H\xd4\xd3\xd3\xc3\xd4T\x00\xd0\xeb\xf5\x8cQ\\\\\\\\\\\x9c9\xf9\xf9\xf3\xe7\xeb֭\xe3\xf3\xf9\x00p\xf7\xee]\x85B\xc1\xe5r\xb3\xec\x98\xff\xf0\xe1\xc3\xc1\x83\a\x1f<x\x10\x8bł\xc1`]]\xdd\xc4\xc4\xc4\x12\x99\x0e\x87\xa3\xb2\xb22\x1c\x0e\x1f8p\xe0ܹs\b\xa1\x99\x99\x19\xb5ZM\xd34B(\x9b2\x88\xa6\xe9\xd6\xd6V\x1c\xc7\xedv\xbbL&\x13\b\x04\xf5\xf5\xf5\xf9\xf9\xf9KĎ\x8d\x8d\x89\xc5b\xa7ӹq\xe3\xc6H$\xe2\xf3\xf9\xbc^\xaf^\xafg\xaa[6e\x10Mӟ?\x7ff졡\xa1p8\xcc\x16yxx\x98!OOO{\xbd^\x8a\xa2\xe6~\xfa\x11\xa0\x17/^\xd4\xd5\xd5utt \x84\"\x91H[[\x1b+kG\xa3Q\xbd^\x7f\xf2\xe4\xc9h4\x8a\x10\xba\x7f\xff~(\x14b\x85\xfcg\xc4A\b\x01\xc0۷o\x1b\x1a\x1a\n\v\v\x03\x81@EE\x05I\x92\n\x85b~=\xef\xea\xea\xb2\xd9l\x8b\xe7jYY\xd9ٳgӜ*\x95*\x18\f\"\x84\xf8|\xbeT*M$\x12\x06\x83a\x89\x9b\xe2O\xea{\rz\xf5\xea\xd5\xe3ǏW\xaf^\x1d\x0e\x87q\x1c/--M;\xed\xca\xcbˋ\x8a\x8a\x16g\xfd\xbd\x1c\xc4b\xb1\x1d;v\x9c:u\n\x00<\x1e\x8f\xd5j\xbd~\xfd:{7\xff'\xf4=\x83\xe6\xd4\xd6ֶjժ\xfd\xfb\xf7\xb3\xbb\xcc\xc4\xc4DKK\x8bN\xa7[\xb9r\xe5|\xbfJ\xa5\"Ir\x91\v[ZZ\x98\x86\x85\xa2\xa8={\xf6\xb0{W?Uuu\xf5\x8f\x00Q\x14u\xe5ʕݻwWVV\xb2\xbb\x8c\xd7\xeb\xed\xee\xee\xbep\xe1BZt\x00\xe0\xfd\xfb\xf7s\xfd\xcb?j\xf3\xe6\xcd<\x1e\x8f\xb1\x17i\x8b\x7f\x93\n\n\n\xbe\a\x88\xa2(\x93\xc9TSS\xb3u\xebV\x00\xf0z\xbdeee\xf3\xa7\xda\xed\xf6\xb9\xee{!\xedڵK\xadV\xa79\t\x82x\xf6\xec\x99V\xab\x05\x80\xd9\xd9\xd97o\xdeH$\x126\x1f\xe27\x8b\v\x004Mk\xb5Z\x89D\xb2e\xcb\x16\x00\b\x06\x83\xcd\xcd͝\x9d\x9d\xf3\xe7\xedݻ\xf7\xa7]\xa9H$J\xf3x\xbdއ\x0f\x1f6662\xc3[\xb7n\xd14\x9d]\x01\xe2 \x84nܸ1==MQ\x94\xc7\xe3\xc9\xcf\xcf\x0f\x04\x02j\xb5z\xe9ehrrR\xa7\xd3mذ\xe1\xe5˗\xb3\xb3\xb3\x14E\t\x85B\xab\xd5\xca\xfaW\xd1߫x<~\xe7\xce\x1d\xe6̷X,r\xb9\xbc\xb7\xb7\x97\x95\x0e\xc2j\xb5NNN\"\x84\xa6\xa6\xa6N\x9c8\xd1\xd8\xd8\x18\x8b\xc5X!/\xa2D\"a4\x1aϟ?/\x97\xcbkkk].\xd7\x12\x81\xd9\xd4Ig\"\xadV\xfb\xf1\xe3Gƾv\xed\x9aD\"\xe9\xee\xee^\n0\xfb>\x98-\"\xbf\xdf\xefr\xb9\xda\xdbۙ\xa1F\xa3\xe1\xf1xO\x9e<Y\nsY\x05h͚5EEEs\xcd\x04\xd3\x1f$\x12\tf\x18\x8dF\xcdfsoo/\x00|\xfa\xf4i`` \x13f6\xbd\xcd\xffTyyyv\xbb}n\xe8v\xbb\x13\x89DUU\x153lhhH&\x93.\x97\x8b$ɾ\xbe>\x9dN\x97\x11\x94\x8d\x8d\xff?Umm\xedѣG\x19{jj\xcab\xb1 \x84\x92\xc9\xe4ŋ\x17=\x1eO\x86\x90e\x1b \x83\xc1\xa0R\xa9R\xa9T\x9a\xdfd2\xf5\xf7\xf7g\xceYV5hNF\xa3Q$\x12\xb5\xb6\xb6r\xb9\\\x8a\xa2\x18'B\xe8\xf2\xe5\xcbUUUR\xa9\xf4\x17Xl\xfek\xff\x0f\xf5\xf5\xf5Y\xadV\xc6&IR\xa3\xd1 \x84h\x9a6\x99L\x04A0~\xb3ٜ!mY\x15i\x00\xe8\xe8\xe8p8\x1c\x05\x05\x05\x83\x83\x83\x00\x10\n\x85\x98\xb7K\xa3\xd1\b\x00\xef\u07bd+))1\x9b\xcd\xc9d2C`\xfa玬\xd6ׯ_\x95Je\xda\xc3755\x8d\x8f\x8f\x87B!\x85Bq\xe6̙\xc1\xc1\xc1\xed۷\xe38\xbebŊL\x98\xcb*@\v)\x95J\xe5\xe4\xe4`\x18F\xd3t<\x1e\xcf\xcd\xcd\xcd\xfcڿ\x00@\x94\xd0\xe6l\xafΗ\x00\x00\x00\x00IEND\xaeB`\x82",
+
+	"content/img/newton3.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00_\x00\x00\x00 \b\x02\x00\x00\x00\xfbZ3\xb6\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x06\x9aIDATh\x81ݙ\x7fHS\xdf\x1b\xc7\xcfnε\x95\"\x96\xadI l\xc6p,\x82A\x05Fm\x81\xc3¨\xa8\x9c\x99\x88Z\xa0\x15\x14i$\x99+\x15\xc9؊\xfc\x11\xcd\xe9\xc0?\x14l\xae\xe1*\xa5A\x83\x81\x965F \xde\xdad\xe6\xcc\xe1Lٯ\xcaݹ\xdf;\x9f?n\xec\xe3\xa7Ϸ}?\xe5J\xd6\xfb\xafs\x9e\xfb\xdc\xd7=\xf7\xb9\xcf9\xf7\x9e\xe7\x12 \x84 \xf1\x15\f\x06}>\xdf\xfa\xf5\xeb\x89Db\x1c\xb1Iqd\xad\x95P\x14EQ\x94D\"\xa9T\xaa\xea\xea\xea]\xbbvŋ\x8c\xc4\v\xb4\x86\x92H$V\xab\xb5\xb0\xb00##\xe3\xfe\xfd\xfbq$'d\xee8\x9d\u038d\x1b7\x92H$\x9fχ \x88D\"!\x10\b\x00\x80\x0f\x1f>\xf0\xf9\xfc\x9fc\xba\\\xae\xa4\xa4\xa4\xd4\xd4T\xb7ۍa\x18\x8dF\x03\x89\x18\x9d\xc6\xc6F\x8dF\x93\x99\x99\xd9\xdf\xdf\xdf\xd2\xd2RVV\xc6`0fff\x14\nEvv\xf6\xf9\xf3\xe7\x7f\x82\xd9\xd1\xd1\xc1d2\x9f={\xc6\xe1pB\xa1\xd0۷o\x83\xc1\xe0\x83\a\x0f\x12lf-,,8\x9d\xce\xd1\xd1\xd1\xca\xcaʚ\x9a\x1a6\x9b\xcd`0\x00\x00t:\xfdڵk.\x97\xeb\xeaի?\xca\xec\xea\xeaڹsg~~~rr\xf2\x93'O***\x16\x16\x166l\xd8\x00\x12.wh4\x1a\xbe\xb2\xd8\xed\xf6\xdc\xdc\\\x81@\x00\x00\x10\n\x85<\x1e////''ghh\b\xf7\x9c\x9b\x9bS*\x95\xdf\xe3\x90H\xa4\v\x17.\xe0m\xaf\xd7\xcb\xe5r\x01\x00\x9f>}b\xb3\xd9\b\x82\f\f\f\xe0\x87\x12,wp\xc9\xe5\xf2H$R\\\\\x8cw\xcdfs0\x18\x04\x008\x9dN*\x95\x8a\x1b\xc3\xe1\xb0\xef\xfb\xf2\xfb\xfdQ\xda\xe5˗\x01\x00\x10B\xb3ټ\x7f\xff\xfe\x95\x17\"$\xdc\xf7\xce\xc0\xc0@ \x10(--\x05\x00\xe8\xf5\xfaݻw\xa3(\xfa\xee\xdd;\xbf\xdf?11Q]]\x9d\x95\x95\xf5\x13\xd8\xe9\xe9\xe9\xd2\xd2\xd2\xe7ϟ\xa7\xa4\xa4LNN\x92H$:\x9d\x0e`B\xa9\xaf\xaf\xaf\xa9\xa9\xe9\xf3\xe7\xcf\x10±\xb1\xb1\xba\xba\xbaU\x02=\x1eOUU\x95^\xaf\x97J\xa5|>\x1f7\xd6\xd7כ\xcdf\ba\"\xad;(\x8a\xbe\x7f\xff\x9e\xcf痔\x94\x90\xc9䥥\xa5\xc1\xc1\xc1\xd53Q\x14\xf5\xfb\xfdF\xa31555\x1c\x0e\xebt:.\x97K\xa7\xd3\x01\x00\x89\x94;\xaf_\xbf\xc60\fBh4\x1a{{{\x97\x97\x97\xe3\x82}\xf5\xea\x95J\xa5\xc20\xcc\xe1p\xf4\xf7\xf7\x1b\f\x86衿\xa3377w\xf3\xe6\xcd\xf1\xf1q\b\xa1\xc7\xe3Q\xab\xd5q\xb9v \x10\x10\x89D\x83\x83\x83x7\xdaH\b}\x9dYf\xb3\xb9\xbc\xbc\x9cH$\x8e\x8c\x8c\\\xbcx\xd1d2\xf1x\xbc\x95\x19\xa8V\xab_\xbcx\x11;K\x0f\x1f>\x9c\x9b\x9b\xbb\xd2\x12\n\x85\x8e\x1f?\xee\xf1xT*\x95\xc5bIII\t\x87ë\x9c\v\xbfS_\xa33<<\xdc\xd3ӳ}\xfb\xf6\x89\x89\x89\xee\xeen\x16\x8b\xf5\xcd}R\xa9\xd4\xec\xec\xecج\xf4\xf4\xf4o,o\u07bc9y\xf2dYY\x99\xddn\x97\xc9d&\x93\xa9\xb3\xb33\x8e\xa3\xff\xd5\xfa\xf6\x8d\xfe\xe8\xd1#\xb7\xdb}\xe6̙\xf8^frrR\xa1P\b\x85\xc2u\xeb\xd6E\x8dSSS\x12\x89$\xc6YL&3\xba3\x90J\xa5O\x9f>\x8d\xef\xa8b\x8b\xc1`\xfc\xfd\u0382\x10\xb6\xb6\xb6fee\xc5=4J\xa5\xd2j\xb5\u07b8q\x03A\xfe\xf1\xf1\xb9iӦ}\xfb\xf6\xc581333\xda>p\xe0@FFF|\a\x16[[\xb6l\xf9\x9a;\x10B\xb1X\xcc\xe3\xf1\xf6\xec\xd9\x03\x000\x99LL&s\xa5\xabB\xa1\xd0j\xb5\xb1q'N\x9c\xf8\xf7\x16Y\xa9T\xba\xdd\ue28a\n\x00\x80\xc3\xe1\x00\x00l\u07bc9\x8e\xf7\xf0K\x95\x04\x00\x80\x10677s8\x1c<46\x9b\xad\xb1\xb1\xf1\xe1Ç+\xfd8\x1cNZZZl\x16\x8b\xc5\xfa\xc6\"\x97\xcb\x17\x17\x17\xf1\x1dM8\x1c\xbe~\xfd\xfa\xa5K\x97\x12(:\x04\b\xa1T*\x9d\x9a\x9a\n\x06\x83333T*uvv\xb6\xaa\xaa\xaa\xa8\xa8h\x95h\x14E\xdb\xdb\xdbi4\x9a^\xaf߶m\x9b\xcdfc\xb1Xb\xb18.\xe3\xfe=J\xf2\xf9|\xa1P\xe8\u07bd{\x00\x80\xd6\xd6V\x9dNw\xe5ʕ\x82\x82\x82գ\xb5Z\xadL&C\x10D\xab\xd5vvvr\xb9ܟ(/\xfcG-,,tttx<\x1e\xb7۽u\xeb\xd6\xe6\xe6椤X\xdb\x00\x9b\xcd\xe6\xf5z\xff\xff\x8el-?\xb6\xe2'\x81@\xd0\xd0\xd0\x00!\xf4x<\\.\xb7\xb6\xb66\x86\xf3\xd0АF\xa3y\xfc\xf8\xb1@ \x98\x9f\x9f\x8fᙐ\x15\x8c\x7f\v\xc30\xa3\xd1\b\x00\xa0P(T*U\xaf\xd7\x7f\xcfs~~^&\x93\xb1\xd9\xec\xa3G\x8f\xa6\xa5\xa5ݹs'\x06\xf6\x0f\x89N___oo/\u07b6\xdb\xed;v\xec\x88\x1e\xb2Z\xadb\xb1xii\t\x00\xa0V\xab)\x14J\xf4\xb5@ \x10\xdcnw,n|3|m\xe5\xf5z\xef\u07bd[XX\xf8\xf1\xe3G\xdc\xe2r\xb9\x0e\x1e<X\\\\\\^^\xaeR\xa9ZZZV:\x1f:t\xe8\xe5˗1\x80\x89T\xc1\x88\xad\xe9\xe9i\x9dNg\xb1X\xd2\xd3\xd3\xf1R!\x00@\xa7\xd3ݺu\x8b\xc3\xe1\x18\f\x86\xae\xae.\x91H\x14\xf5\x17\x89D555{\xf7\xee\x8d\x05\xfd\xb5Os-t\xeaԩ\xbc\xbc<\xbcB\x86kqq\xb1\xae\xaeneţ\xad\xadM\xab\xd5B\b\xedv{\fԟ\xb0\xee\xf8|>\x85B\x81a\x18\xde\xcd\xc9\xc9q8\x1c\x1a\x8d\x06\xef\xdal\xb6\xf6\xf6v\xa1PH&\x93q\x8bR\xa9\x8cD\"\b\x82\x8c\x8c\x8cĮ\x9f\xfd\t3\xab\xa7\xa7\xa7\xbb\xbb{vv\xb6\xb6\xb6\x16\xb7@\b}>\x1f\x00\xc0f\xb3\xb5\xb5\xb5\xd5\xd7\xd7S(\x94/_\xbe\f\x0f\x0f\x1f;vltttyy\xd9`0\x00\x00\xf0\xbf\x1a\xdf\xd5/K\xf0\xdf'\x9dNWPP\x80\xafđH\xe4ȑ#\xf9\xf9\xf9\x18\x86\x05\x83\xc1\x92\x92\x92۷o\x8f\x8f\x8f[,\x96\xb3g\xcf\u2d7d\xff\xae\xc4\xfb'\xf1?%\x97\xcb\xc7\xc6\xc6\xc8d\xb2\xd5j\x05\x004440\x99̦\xa6\xa6\xa2\xa2\"\"\x91x\xeeܹ`0x\xfa\xf4\xe9\xca\xca\xca\x1f\xc2\xfe!\xd1\x01\x00@\b=\x1e\x0f\x82 \x14\n\x05\xb7\xf8\xfd~\x12\x89\x04\x00\b\x04\x02\x00\x80\xe4\xe4\xe4\x1fe\xfe\x05z\x1e\xe7\xc8\xcb\x7f\x9df\x00\x00\x00\x00IEND\xaeB`\x82",
+
+	"content/img/tree.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\xeb\x00\x00\x00\xb5\b\x02\x00\x00\x00\xf0b@\xc5\x00\x00\x00\x03sBIT\b\b\b\xdb\xe1O\xe0\x00\x00\x00\x19tEXtSoftware\x00gnome-screenshot\xef\x03\xbf>\x00\x00 \x00IDATx\x9c\xed\xddy T\xfb\xfb8\xf03f\x90(Z\xedi\xbb\xb4iӢE\xd1\xc2Er\xbbKW\xa5>\xddh\xe1v[\xa4[\xa2D\xe9\"ig\x94D*JER\xf8(R\xa9ԥMBd\x9d\xb1\xef\x8ce\xb6\xdf\x1f\xf3\xfb\xfaΗ\x99c\x96sf\xc2\xf3\xfa\xeb3s\xde\xe7\xed\xb9}x\xe6\xccs\xce\xfby\x13\xd8l6\x02\x00\x00\xa0\x0f\x92\x91v\x00\x00\x00\x00D\x04\x19\x1c\x00\x00\xfa*\xc8\xe0\x00\x00\xd0WA\x06\a\x00\x80\xbe\n28\x00\x00\xf4U\x90\xc1\x01\x00\xa0\xaf\x82\f\x0e\x00\x00}\x15dp\x00\x00\xe8\xab \x83\x03\x00@_\x05\x19\x1c\x00\x00\xfa*\xc8\xe0\x00\x00\xd0WA\x06\a\x00\x80\xbe\n28\x00\x00\xf4U\x90\xc1\x01\x00\xa0\xaf\x82\f\x0e\x00\x00}\x15dp\x00\x00\xe8\xab \x83\x03\x00@_E\x92v\x00\xfdJyy\xf9\x9b7o(\x14JEE\x85\xa2\xa2\xa2\x86\x86\xc6\xf8\xf1\xe3\r\r\r\x89D\xa2\xb4C\x03\x00\x88\x85\xc5beff\xe6\xe6\xe6VTT\xd4\xd7\u05eb\xaa\xaajjj\x1a\x18\x18\xe8\xe8\xe8H1*\x02\xec\xb2&\xbe\xce\xce\xce˗/\x7f\xfb\xf6MMMm\xfe\xfc\xf9\xea\xea\xea\x1a\x1a\x1a\xcd\xcd͕\x95\x95\xf9\xf9\xf9o\u07bca2\x99\x1b6l\x985k\x96\xb4#\x05\x00\b-??\xffʕ+t:\xdd\xc0\xc0@WWW]]]YY\xb9\xaa\xaa\x8aJ\xa5fdd\x14\x15\x15\xa9\xab\xab;88())I>6\xc8\xe0⊊\x8az\xf7\xeeݖ-[&N\x9c\xc8o\f\x93ɼq\xe3Fnn\xeeΝ;\xd5\xd5\xd5%\x19\x1e\x00@d\r\r\r\xa7O\x9fVSS\xb3\xb3\xb3\x93\x93\x93\xe37\x8cJ\xa5^\xbatIKK\xcb\xceΎ@ H2B\xc8\xe0\xa2c2\x99\xfe\xfe\xfe\x8b\x17/^\xb0`\x81 \xe3\xdb\xda\xda.^\xbchll<g\xce\x1c\xbcc\x03\x00\x88\xe9\xebׯ\x91\x91\x91\xbbw\xef\x1e:t\xa8 㳲\xb2\xa2\xa3\xa3\x9d\x9d\x9d\x15\x14\x14\xf0\x8e\xad\vdp\x11uvv\x1e;v\xcc\xd1\xd1Q\xd8kꈈ\x88aÆ\xfd\xf8\xe3\x8f8\x05\x06\x00\x10_zzzFF\x86\x83\x83\x83P\xd7ԍ\x8d\x8d~~~\xce\xce\xce\xca\xca\xca\xf8\xc5\xc6\r2\xb8\x88\xbc\xbd\xbd\xed\xec\xecF\x8d\x1a\xd5\xed}&\x93\x99\x94\x94TRR\xa2\xab\xabkll\xcc\xf3\xdc\xd0\xd0P\x03\x03\x03}}}ܣ\x04\x00\b\xaf\xac\xac\xecΝ;{\xf6\xec\xe9y\x88\xcdf\xa7\xa7\xa7\x97\x94\x94\x8c\x1e=\xda\xc8Ȩ\xe7C\n4\x1a\xcd\xd7\xd7\xf7\xf0\xe1Òy~\x01\x9e&\x14Ehh\xa8\x85\x85E\xcf\xf4]^^\xbet\xe9\xd2ӧO\x9f9s\xc6\xc4\xc4\xc4\xd6֖\xe7\xe9\x9b7o\xbew\xef^SS\x13\xfe\x91\x02\x00\x84\xc3`0.\\\xb8\xf0\xd7_\x7f\xf5<TZZjll\\XX8mڴ\xcc\xccL\x03\x03\x83\xca\xca\xcanc\x06\x0f\x1eloo\x7f\xee\xdc9\x89\x04\v\x19\\x\x8d\x8d\x8d%%%ӧO\xefy\xe8ĉ\x13QQQ\x89\x89\x89\xd9\xd9\xd9{\xf6\xec\xb9q\xe3Ƈ\x0f\x1fxN\xb2c\xc7\x0e2\x99\x8cs\xa4\x00\x00\xa1\x85\x86\x86nڴ\x89\xe7\x15\xb4\xa3\xa3\xa3\x91\x91Ѻu\xeb\xa6L\x99\xe2\xe4\xe44a\xc2\x04ww\xf7\x9eô\xb4\xb4H$RQQ\x11\xee\xb1B\x06\x17A@@\x80\xa3\xa3c\xcf\xf7[ZZ\xec\xec\xec\xba\xca\xe2vvv\b\x82\xb4\xb7\xb7\xf3\x9cDUU\x95\xcdfWTT\xe0\x17'\x00@X4\x1a\xad\xa8\xa8hʔ)<\x8ffddtvvv\xbdTSS+--\xe59r\xeb֭AAA\xb8\x84\xf8\x7fA\x06\x17\x0e\x8bŪ\xad\xad\x1d9rd\xcfCJJJ3g\xce\xeczYPP\xb0t\xe9\xd2y\xf3\xe6\xf1\x9bjݺu\xb7n\xdd\xc2%J\x00\x80H\x1e=zdaa\xc1慨\xbe~PPPVV\x16\x82 \xed\xed\xed\t\t\t\x1b6l\xe09rРA\xf2\xf2\xf2\x12\xa8\x94B\x06\x17Nzz\xfa\xfc\xf9\xf3{\x1d\xf6\xf1\xe3Ǔ'OFEE\xa1\xdc\xc8\x1e3f\f\xbf\x0fp\x00\x80T\xa4\xa7\xa7\x1b\x1a\x1a\xf2;\xea\xeb\xeb\xcbb\xb1\x96,Y\x12\x16\x16\xb6v\xedZgg\xe7\xf5\xeb\xd7\xf3\x1blnn\x9e\x98\x98\x88O\x98\xff\v2\xb8p\x92\x93\x93\x97/_\x8e2\xa0\xa9\xa9\xc9\xd5\xd5\xd5\xd0\xd00--m\xf1\xe2\xc5\x14\n\x05e0\x91H\xa4\xd3\xe9X\xc7\b\x00\x10\x11\x8bŒ\x91\xe1\x9b\x15g̘\x91\x92\x92B\"\x916o\xde,++\xbbe\xcb\x16\x94\xa9\xe6Ν\x9b\x9e\x9e\x8eC\x8c\xff\adp\xe1\xd4\xd6\xd6\x0e\x1f>\x1ce\xc0СC\xbd\xbc\xbc*++\x0f\x1c8\x90\x97\x97\xe7\xec\xec\x8c2X]]\xbd\xbc\xbc\x1c\xeb\x18\x01\x00\xa2`0\x18\xbd>\x02\x98\x90\x90\xb0nݺ\xe5˗\u07fbw\xcf\xd4Դ\xb5\xb5\x95\xdfH\x19\x19\x19\x16\x8b\x85u\x8c=~\n\xde?\xa0\x9f\x11\xf0\x19\xcf!C\x86x{{\x9b\x99\x99=}\xfa\x14}XAA\x016\x91\x01\x00\xc4SUU\xa5\xa6\xa6\x862\xc0\xd7\xd77!!\xe1\xecٳ\xff\xfd\xef\x7f\x0f\x1c8\xf0\xec\xd93\a\a\a\x94\xf1\x12xT\x012\xb8p\x84\xfaP]\xb6lِ!CP\x06\x10\x89D\x94f\v\x00\x00I\"\x12\x89L&\x13e\x80\xbf\xbf\xff/\xbf\xfc\x82 \x88\x8c\x8c\x8c\xb7\xb7\xf7\x96-[n\u07bcI\xa3\xd1\xf8\x8d\x97@\xaf+\xc8\xe08\xaa\xaf\xaf_\xb3f\r\xfa\x80\xb1c\xc7J*\x1c\x00\x00\x9aQ\xa3FUUU\xa1\f\xa8\xad\xad\xe5\xbe䲴\xb4d2\x99\x1d\x1d\x1d\xfcƣ_\xc0a\x022\xb8pF\x8f\x1e\xdds\x15\x16GKKˑ#G\xba\x96\xf0\xd4\xd4\xd4ddd\xb8\xba\xba\xa2\xccVQQ\xa1\xaa\xaa\x8a}\x94\x00\x00\xe1\xc9\xc8Ƞ_\x83\x9b\x9b\x9b\xc7\xc5\xc5u\xbd\xcc\xcb\xcb366\x1e6l\x18\xcf\xc1L&S\x02\v\xeba\x87\a\xe1\xac\\\xb92))\x89\xe7r\xf9\xf6\xf6\xf6\x90\x90\x10//\xaf\x9f~\xfaI__\x7f\xf0\xe0\xc1QQQ\xe8]\xcd\xe8t:\x89\x04\xff\x17\x00\U0003d415\x95e0\x18\xfc\xfe*CCC\xed\xed\xedmmmW\xaf^\x9d\x9f\x9f\x9f\x9e\x9e\x1e\x11\x11\xc1o\xaa\x17/^,\\\xb8\x10\xb7H\xff?\xe8l%\x1c6\x9b\xbd\x7f\xff~???\x9eG\x19\f\x06\x85B\x194h\xd0\xe8ѣ{mi\xf6\xf5\xeb\xd7'O\x9e\xecر\x03\x870\x01\x00\xa2x\xf0\xe0\x81\x92\x92\x92\x89\x89\tʘ\x9a\x9a\x1a\n\x85\xa2\xaa\xaa\x8a~\xdb\xd3\xcd\xcd\xcd\xd5\xd5\x15\xefN\xb3PE\x11\x0e\x81@\xd0\xd0\xd0(++\xe3y\x94D\"\xe9\xe8訪\xaa\nґ\xf2\xf6\xed\xdbk\u05ee\xc5:@\x00\x80\xe8\xcc\xcc̒\x92\x92\xd0ǌ\x1c9rƌ\x19\xe8黹\xb9\x99H$J\xa0Q8dp\xa1aҔ\xaa\xb8\xb8xȐ!菖\x03\x00$LNNN__\xff\xed۷b\xceC&\x93\xd1\x1f4\xc4\ndp\xa1\r\x1e<x֬Y⬗e\xb1X\x01\x01\x01۶m\xc30*\x00\x00&lllbcc\xb9;X\t+77WYY\x19\xfd\"\x1d+\x90\xc1\x85\xd6\xd2Ғ\x9c\x9c\xfc\xf0\xe1C~\xb5\x94^\xf9\xf9\xf9\xe5\xe6\xe6\xe6\xe4\xe4`\x1b\x18\x00@|\x04\x02a\u05ee]\xfe\xfe\xfe\xa2\x9d\xde\xd8\xd8\x18\x19\x19)\xb1\xeb3\xc8\xe0\xc2)((عs\xe7\x81\x03\aΜ9\x13\x16\x16\xf6\xf5\xebW\xa1Ng\xb3\xd9AAA\x8b\x17/\xbes\xe7Ν;w\"##q\x8a\x13\x00 \xb2Q\xa3FYYY\x9d<y\x12\xfd\xe1\u009e\xca\xcb\xcb\xfd\xfd\xfd\x0f\x1c8\x80S`=\xc1\xb3(BHLL|\xf4葏\x8fϠA\x83\x90\xffI\xc7\xda\xdaږ\x96\x96\x82\x9c^WWw\xe1\xc2\x05\x1b\x1b\x1b]]]\xce;ׯ_\xcf\xcb\xcbsww\x97̆L\x00\x00\xc1Q\xa9\xd4\xe3Ǐ\xefڵkҤI\x82\x8c'\x93\xc9\xcd\xcd\xcd{\xf7\xee\x95\xe4#\u0090\xc1\x05\xc2f\xb3\xfd\xfd\xfd\xe5\xe4\xe4z\xee\xbd\xf4\xf4\xe9\xd3\xd8\xd8X\x1b\x1b\x1b\x94V\xe0---!!!MMM\xbbv\xed\xea\xf6\x84xfff``\xa0\xb7\xb7\xf7\x88\x11#p\t\x1d\x00 \x12&\x93\xf9\xc7\x1f\x7fL\x9d:\x95\xcdfo߾\x9d\xdf\xca\x1d\x04A\xb2\xb2\xb2\xc2\xc3ÙL\xe6\xa2E\x8bЗac\x0e2x\xefZ[[\x0f\x1c8\xf0\xcb/\xbf\xf0{J\x94\xc5bݽ{\xf7\xf5\xeb\u05ca\x8a\x8a3f\xccPWWWWW\xa7\xd1h\x14\n\xa5\xb0\xb0077WQQq\xeb֭cƌ\xe1yzuu\xf5\xc1\x83\aw\xed\xda5c\xc6\f<\xff;\x00\x00B8uꔑ\x91Ѽy\U000eaaeb\x83\x82\x82\x1a\x1a\x1aƌ\x193q\xe2Dmm\xed!C\x86TVVVTT\xbc\x7f\xff\xbe\xa1\xa1A__\x7fӦM$\x12\xc9\xd1\xd1\xf1\xf8\xf1\xe3\x92|\xc6\f2x/\xbe}\xfb\xe6\xe9\xe9\xe9\xe1ᡣ\xa3\xd3\xeb`\x1a\x8d\xf6\xee\xdd;*\x95J\xa5R\x87\f\x19\xa2\xa6\xa6\xa6\xab\xab;q\xe2\xc4^Od0\x18\xee\xee\xeeӧO\xff\xfd\xf7߱\x88\x1a\x00 \x96\x9c\x9c\x9c\x88\x88\b\x0f\x0f\x0f\xee7\x8b\x8b\x8bsss\xcb\xcb\xcb\xeb\xeb\xebUUU\xb5\xb4\xb4\xa6O\x9f\xae\xac\xac\xdc5\xa0\xa2\xa2\xc2\xcb\xcb\xeb\xfc\xf9\xf3\x12\x8b\x1328\x9a\xa4\xa4\xa4\a\x0f\x1e\xf8\xf8\xf8H\xe0\xc9|\x04A\xc2\xc3\xc3\xf3\xf3\xf3\x8f\x1c9\x02eq\x00\xa4\x88\xc9dnݺ5  \x80s\xc7K(\x91\x91\x91\xf2\xf2\xf2\x12\xab\xa5\xc0\xb3(|\x9d>}:;;\xfbܹs\x92I\xdf\b\x82lܸq\xf5\xea\xd5;v쨫\xab\x93\xccO\x04\x00\xf4t\xe6̙\xed۷\x8b\x90\xbe\x11\x04\xb1\xb1\xb1IJJ\x92؟0dp\x1eh4\xdaΝ;\xa7O\x9f\xbe{\xf7n\t\xffh\x03\x03\x03//\xaf\xfd\xfb\xf7\x7f\xfc\xf8Q\xc2?\x1a\x00\x80 HNNNSS\x93 \xdb\xe1\xf2s\xe4\xc8\x11www\fCB\x01U\x94\ue28a\x8a\x8e\x1e=z\xf4\xe8Q)v\xee\xa6\xd3\xe9\xee\xee\xee\xb3f\xcd\xfa\xed\xb7ߤ\x15\x03\x00\x03\x908\xf5\x13n\x12\xab\xa5\xc05\xf8\xff\xf1\xe4\xc9\x13??\xbf\x80\x80\x00\xe9n\xbc ++{\xe2\xc4\t\x1a\x8d\xe6\xe1\xe1!\xec\x9a\x02\x00\x80\xc8ĩ\x9fp\x93X-\x052\xf8\xff:{\xf6\xecǏ\x1f/\\\xb80x\xf0`iǂ \b\xf2\x9f\xff\xfc\xc7\xc2\xc2\xc2\xc1\xc1\xa1\xbe\xbe^ڱ\x00\xd0\xff\x89_?\xe1&\x99Z\nTQ\x10\x04Ah4\xda\xc1\x83\a\xad\xac\xacV\xae\\)\xedX\xba\xab\xac\xactqqqrr\x9a6m\x9a\xb4c\x01\xa0\xdfª~\xc2M\x02\xb5\x14\xb8\x06G\x8a\x8b\x8b\xff\xfc\xf3\xcf={\xf6|\x87\xe9\x1bA\x10UUՠ\xa0\xa0\xebׯ߽{Wڱ\x00\xd0oaU?\xe1&\x81Z\xca@\xcf\xe0\xc9\xc9\xc9'O\x9e\xbcp\xe1\xc2\xf8\xf1\xe3\xa5\x1d\v_\xb2\xb2\xb2\xde\xde\xdeMMM\x9e\x9e\x9e,\x16K\xda\xe1\x00\xd0\xdf`[?\xe1\x86w-e@WQΟ?O\xa7\xd3\xf7\xee\xdd+Ȗ:߃7o\xde\x04\a\a\xfb\xfa\xfa\xaa\xa8\xa8H;\x16\x00\xfa\t<\xea'\xdcp\xad\xa5\f\xd0k\U00036db6ݻw\xeb\xea\xea:99\xf5\x95\xf4\x8d ȼy\xf3\x8e\x1d;\xb6w\xef\xde\xec\xecli\xc7\x02@?\x81G\xfd\x84\x1b\xae\xb5\x94\x81\x98\xc1KJJ\x1c\x1d\x1dw\xed\xdaeff&\xedX\x84\xa6\xaa\xaaz\xe9ҥ\xb0\xb0\xb0\xe8\xe8hi\xc7\x02@\x9f\x87_\xfd\x84\x1b~\xb5\x94\x01WEy\xfa\xf4iTT\x94\x8f\x8f\x8f\x92\x92\x92\xb4c\x11˕+W\xca\xcb\xcb\x0f\x1d:$#3\x10?\x86\x01@WSS\x13\x17\x17\x97\x95\x95\xc5b\xb1\b\x04\x02\x8b\xc5\"\x12\x89\x04\x02a\xe1\u0085fff\x9c'\x86\xf1\xae\x9fp\xebVK\xa1R\xa9\xf7\xef\xdf\xcf\xcf\xcfg\xb1X222l6\x9b@ \x90H$\x13\x13\x13\x13\x13\x13yyy\x01\xa7\x1dX\x19\xfc\xe2ŋmmm\xfb\xf6\xed\xebC\x95\x13\x14\xaf_\xbf\x0e\r\r\xf5\xf1\xf1\xe1\xee\x8e\x06\xc0\x00\x97\x9d\x9d\x1d\x16\x16\xa6\xa2\xa2\xb2j\xd5*}}}\xeeCL&\xf3իW\x89\x89\x89D\"q\xfb\xf6\xed7o\xde\\\xbcx1\xde\x17\xe0]8\xbdg\xf3\xf2\xf2\xeeݻ\xa7\xaa\xaa\xbaz\xf5\xea\x1f~\xf8\x81{@gggjjjrr\xb2\xb2\xb2\xf2\x8e\x1d;\x04\xb9\xdd5P2x{{\xfb\xc1\x83\a\xcd\xcc\xcc\xcc\xcdͥ\x1d\v\x968\x97\xe1\x7f\xff\xfd\xf7\xe4ɓ\xa5\x1d\v\x00R\xd6\xd9\xd9y\xf2\xe4IuuuN\xb7n\x94\x91---\x17/^\xfc\xf2\xe5Khh\xa8\xa4\xa2C\n\n\n\x0e\x1f>lmm\xbdv\xedZ\xf4\x8bȚ\x9a\x1a2\x99\xac\xa7\xa7\xd7{_\r\xf6\x00PRR\xb2y\xf3\xe6\xaf_\xbfJ;\x10\\ttt\xec߿?&&Fځ\x00 MUUUnnn\xd5\xd5Ղ\x9f\xf2\xf9\xf3g//\xaf\xf6\xf6v\xfc\xa2\xeaRXX\xe8\xee\xee\xde\xdc\xdc,\xf8)iii\xfe\xfe\xfeL&\x13eL\xff\xbf\x06OMM\xbdu떯\xafo_/|\xa3\xbb|\xf9ruu\xb5\x8b\x8bK\xff(\x10\x01 \x94\xc6\xc6\xc6s\xe7\xce\x1d<xPVV\x96\xfb}&\x93\x99\x94\x94TRR\xa2\xab\xabkll\xdc\xf3ĺ\xba\xbas\xe7\xce\x1d>|\x18צ\xfceee\x11\x11\x11\xce\xce\xce]\x7f\x9et:\x9dJ\xa5v\xdb7\xa6g\xb4\xa5\xa5\xa5\xb7n\xddrvv\xe6;\xb5\xb8\x9f,߷\x8b\x17/\xfa\xfa\xfa\xb2X,i\a\"\tiii۷ooll\x94v \x00H\x14\x83\xc18r\xe4Hkkk\xb7\xf7\xa9T\xea\xa2E\x8bLMM95\xc6\r\x1b6\xf0<\xbd\xb4\xb4\xd4\xdf\xdf\x1f\xbf\xf0Z[[\xdd\xdd\xdd\x19\f\x06\xe7%\x9dN\xbfr\xe5ʸq\xe3\x8e\x1d;&H\xb4\x1f?~\xbcz\xf5*\xbf\xc9\xfbm\x06okk۳g\xcfÇ\x0f\xa5\x1d\x88DQ(\x94͛7\xe7\xe4\xe4H;\x10\x00$\xe7\xf4\xe9\xd3%%%=\xdf߹s'\x95J\xe5\xfc\xef={\xf6 \b\xf2\xfe\xfd{\x9e3$&&&$$\xe0\x14\x9e\xbb\xbb{}}}\xd7\xcbG\x8f\x1e\x85\x85\x85!\b\xd2-\x83\xa3D\x1b\x1a\x1a\xfa\xee\xdd;\x9e\x93\xf7\xcf\a\xd1\xca\xca\xca\x1c\x1c\x1c\x1c\x1d\x1d-,,\xa4\x1d\x8bDihh\x90\xc9\xe4˗/?x\xf0@ڱ\x00 \t\xd5\xd5\xd5mmm\xda\xda\xda\xdd\xdeoii\xb1\xb3\xb3SWW缴\xb3\xb3C\x10\xa4\xbd\xbd\x9d\xe7$\xa6\xa6\xa6IIIx\xb4\xac\xc8\xcb\xcbSWW\xe7~\xaa\xc4\xdc\xdc\xdc\xd4\xd4T\xa8h7n\xdcx\xe3\xc6\r\x9e\xf3\xf7\xc3\f\xfe\xfc\xf9s//\xafs\xe7\xceu{Rg\x80\x90\x97\x97\xf7\xf3\xf3\xa3R\xa9\xde\xde\xde\xec\xfe~\x93\x03\x002\x99\xec\xe8\xe8\xd8\xf3}%%\xa5\x993gv\xbd,((X\xbat\xe9\xbcy\xf3\xf8ͳv\xed\xda۷oc\x1eޕ+W\xb6l\xd9\xd2\xeb0\xf4hedd\x16.\\\xf8\xe2ŋ\x9e'\xf6\xb7\fN&\x93_\xbe|\x19\x10\x100d\xc8\x10i\xc7\"M۷o722rttljj\x92v,\x00ਾ\xbe\xbe\xd7\xf5\x10\x1f?~<y\xf2dTT\x14\xca}\xfey\xf3\xe6\xbd}\xfb\x16\xdb\xd8:::\x88Db\xb7\x9b\xab\xbd\xe2\x19\xad\x85\x85ţG\x8fz\x0e\xee?\x19\xbc\xa3\xa3\xc3\xc9\xc9IKK\xeb\xc0\x81\x03\xf0<\x06\x82 \x8b\x16-rss۽{wnn\xae\xb4c\x01\x00\x17\x1f>|\x981c\x06ʀ\xa6\xa6&WWWCCô\xb4\xb4ŋ\x17S(\x14\x94\xc1\n\n\nmmm\x18\x86\xf7\xe4ɓ\xe5˗\v>\x1e%Zyy\xf9\x8e\x8e\x8e\x9e\xa7\xf4\x93\fN\xa1Pv\xecرcǎU\xabVI;\x96\uf226\xa6&\x99L\xbet\xe9R\\\\\x9c\xb4c\x01\x00{\x99\x99\x99\x06\x06\x06(\x03\x86\x0e\x1d\xea\xe5\xe5UYYy\xe0\xc0\x81\xbc\xbc<\xb4\xc7\xf2\x10DWW\x17\xdb˝\x8c\x8c\x8cٳg\v>\x1e=\xdaQ\xa3FUUUu;\xa5?d\U00034d34cǎ\x9d={VWWWڱ|w\xe4\xe5\xe5O\x9d:UVV\x06eq\xd0\xffP(\x94\xae\xbb\x7f(\x86\f\x19\xe2\xed\xedmff\xf6\xf4\xe9S\x94a\x9a\x9a\x9aT*\x15\xb3\xe0\x10\xa4\xbe\xbe~ذa\u009e\xc5/Zuu\xf5\xf2\xf2\xf2n\x83\xd1\x16\x9eJ\f\x8b\xc5z\xf5\xeaUFFFyyygg\xa7\x8c\x8c\xcc\xe8ѣ\x7f\xf8ᇕ+W***\xa2\x9f\x1b\x14\x14T__\x1f\x18\x18\b\x95\x13\x14;v\xecx\xf1Ⅳ\xa3\xa3\xaf\xafo\xafw\brrrRSS\xa9TjSS\x13\x89D\x1a:t\xe8\xb8q\xe3V\xae\\\xa9\xaa\xaa*\x99h\x01\x10Pmm\xed\x88\x11#\x04\x1c\xbclٲo߾\xa1\fPQQ\x89\x89\x89\xc1\xf0\x016q\xae\x99zF+//\xff\xeeݻnU#)gp\n\x85B&\x93\xdb\xda\xda\x16.\\hmm\xad\xa6\xa6&//\xcfb\xb1*++\xbf~\xfd\xea\xe3\xe3\xd3\xda\xda\xfa\xdbo\xbf\x19\x1a\x1a\xf6<\xb7\xa3\xa3\xe3СC\xc6\xc6\xc6۷o\x97|\xe4}\xce\xe2ŋǎ\x1d\xbbk\xd7.\x17\x17\x17\x9e_VZ[[/]\xbaTRR2e\xca\x14ccc---\xce\xc7gmmmIIIXXXee\xe5ܹs\x7f\xff\xfdw\xf8\xb0\x04߉\xa1C\x87\xb6\xb6\xb6\xf6z\x9d\xc7Q__\x8f\xbe\xcd\x02\x8dF\x9b5k\x16F\xa1!\b\x82\x88\xf3\x97\xd23Z\x02\x810u\xea\xd4\xee\xe3px\x80] \x9d\x9d\x9d\xbe\xbe\xbe\xa7N\x9djiiA\x19\xc6b\xb1\xa2\xa2\xa2\xf6\xef\xdf_VV\xc6\xfd>,]\x11M[[\xdb\u07bd{{.t\xbay\U000e6aebkii)\xfa\xe9\xe9\xe9\xe9NNN\xaf_\xbf\xc6-@\x00\x84@&\x93sssy\x1ejnn>|\xf8pע\x98\xea\xea\xea\x95+W\xa2\xafX\xbeu\xebVzz:\x86\xe1\x1d>|\xb8\xe7JQ6\x9b]ZZ\x8a \x88\xa7\xa7\xa7P\xd1\xfa\xfb\xfb\xf7\xfc\v\x95N\x06\xaf\xae\xaevss\xab\xac\xac\x14p|gg\xe7\xa9S\xa7\xba\x12GZZڶm\xdb`\xf9\xb8\xc8.^\xbc\xe8\xe3\xe3\xc3i6@\xa7\xd3}||222\x04?\xfd֭[\xb7n\xdd\xc2-:\x00\x04\x95\x9c\x9c\x1c\x1b\x1b\xcb\xf3Puu\xb5\xa6\xa6\xa6\x8c\x8c\xcc\xcf?\xff\xec\xee\xee\xee\xe3\xe3\xd3\xd0Ѐ>۱c\xc7jkk1\f\xefڵk\xff\xfe\xfbo\xb77\x93\x93\x939\vv\xe6̙\x13\x1d\x1d\xcd\xc9c\x82D\xeb\xec\xecL\xa7ӻ\xbd)\x85\f^QQq\xecر\x8e\x8e\x0eaO\xbcy\xf3fJJʥK\x97N\x9c81@Z\x9d\xe0'55\xd5\xc1\xc1\xa1\xa1\xa1\xc1\xc3ã\xbc\xbc\\\xd8\xd3_\xbf~\x1d\x12\x12\x82G`\x00\b\xae\xb3\xb3s\xff\xfe\xfd\xfc\x8e\xd2\xe9\xf4\xa2\xa2\xa2\x8a\x8a\n\x01Ӆ\x93\x93\x13v\xa1\xb1\xd9lvmm\xed\xf1\xe3\xc7\x05\x1c\x8c\x1e-\x8b\xc5\xe2\x19\x9e\xa4\x9fE\xe9\xe8\xe88\x7f\xfe\xbc\x8b\x8b\x8b\x9c\x9c\x1c\xcf\x01t:\xbd\xb8\xb8\x98\xe7\xa1u\xebֽx\xf1\x82\xc1`@\a>\xf1-Y\xb2\xc4\xc5\xc5\xe5\xcf?\xffܶm\x9b\x9a\x9aZ\xb7\xa3l6\xfb\xf5\xeb\u05f7o\xdf~\xfa\xf4)\x93\xc9\xecy\xfa\xfc\xf9\xf3Ǐ\x1f\x1f\x1b\x1b+\x91`\x01\xe0\x8d\xb3X\x86\xe7\xaf(\x82 $\x12IGGGUUU\x90tQZZ\xdasi\xbe\x98\x86\x0f\x1f.\xf8\xf6\x98\xe8Ѧ\xa7\xa7\xf3܉B\xd2\x19\xdc\xd7\xd7w\xff\xfe\xfd<\x1b92\x18\x8c\x90\x90\x10==\xbd\xf0\xf0p~\xa7\xbb\xb9\xb9\x95\x94\x94\xf0|\xb2\x1d\b\xebŋ\x17{\xf6\xec陾KKK\x8d\x8d\x8d\v\v\v\xa7M\x9b\xc6y\u07b6\xb2\xb2\xb2\xe7\xe9K\x97.\xa5R\xa9\xb0\\\bHצM\x9bP2\x86\xe0\x04\\\xfe.,ss\xf3\xf8\xf8x\xf1牎\x8e\xfe\xf9\xe7\x9f{\xbe/\xd1\f\xfe\xe9\xd3'---~K`\x93\x92\x92H$Raa!\xfa$۶m\v\x0e\x0e\xc6!\xba\x81\xa5\xad\xad-;;{Μ9=\x0f9::\x1a\x19\x19\xad[\xb7nʔ)NNN\x13&L\xe0\xb7I\xab\xbd\xbd\xfdիWq\x8e\x14\x004ӦM\xcb\xcfϧ\xd1h\xe2L\xc2\xe9?5t\xe8P\xac\xa2\xea\xb2bŊ\xe4\xe4d1{f=\x7f\xfe\xdc\xd0А\xe7\xaeC\x12\xcd\xe0\xe1\xe1\xe1\x9b6m\xe2w\x94gˮ\x9eƍ\x1bW]]\xdd\xdc܌ih\x03\x0e\x99L\u07bau+\xcfC\x19\x19\x19\x9d\x9d\x9d]/\xd5\xd4\xd48\xb7\xce{\"\x91H\xb3g\xcfNOO\xc7%D\x00\x04\xb3{\xf7\xee\xb3gϊ|zGGGxx\xb8\xbd\xbd=\x86!qstt\xbcx\xf1\xa2ȧ766\xa6\xa4\xa4\xf0{\x0eRr\x19\xbc\xa6\xa6FEE\x05\x93\x8d0V\xadZ\x05\xddS\xc5TVV6f\xcc\x18\x9e\x87\xf4\xf5\xf5\x83\x82\x82\xb2\xb2\xb2\x10\x04iooOHHذa\x03\xbfy\xac\xad\xadcbb\xf0\x8a\x12\x00\x01\x8c\x1a5jժUW\xae\\\x11\xe1\\6\x9b\xfd\xcf?\xff8;;\xe3\xb7Gϸq\xe3fΜy\xef\xde=\x11Υ\xd3\xe9~~~\a\x0e\x1c\xe07@r\x19<..\xce\xca\xca\n\x93\xa9\f\f\f\xfe\xfd\xf7_L\xa6\x1a\x98\n\n\n&L\x98\xc0\xef(gW\xa3%K\x96\x84\x85\x85\xad]\xbb\xd6\xd9\xd9y\xfd\xfa\xf5\xfc\x06\xcb\xcb\xcbwvv\xb2a\xbd>\x90*}}\xfd)S\xa6\x9c={V\xa8zEKK\x8b\x87\x87\xc7\xe6͛{\xedn(&###\x05\x05\x05aK\x8e555ǎ\x1d۽{\xb7\xbc\xbc<\xbf1\x92\xcb\xe0YYY\xfa\xfa\xfa\x98LE \x10dd\xfaCG\x17iy\xf6\xecْ%K\xf8\x1d\x9d1cFJJ\n\x89Dڼy\xb3\xac\xacl\xaf\xb7w&N\x9c\x88\xbeX\x19\x00\tX\xb0`\x81\x95\x95\x95\x99\x99Yvv\xb6 \xe3\x1f<x\xb0~\xfdzgg\xe7\xb1c\xc7\xe2\x1c\x1a\x82 \x88\xb9\xb9\xf9ܹs\x0f\x1d:TRR\"\xc8\xf8\xe8\xe8\xe8˗/\xbb\xb9\xb9\x8d\x1c9\x12e\x98\xe4\xf2 \xbf'~DC$\x12\xe9t:\x86\x13\x0e(\xbd\xf6\x03JHHX\xb7n\xdd\xf2\xe5\xcb\xefݻgjj\xda\xdaڊ2X]]\x1d\xbdi'\x00\x92\x11\x1d\x1d\xed\xe9\xe9\xf9\xf2\xe5K77\xb7\x17/^\xf0\xbc\x1eooo\x8f\x8b\x8b۷o\x9f\x8c\x8c\xccԩSy>g\x85\x93iӦ\x1d>|8&&\xe6\xe8ѣ\x9c5t=Ǵ\xb4\xb4ܹsg߾}#F\x8c@y꺋\xe4\xfa\xa2\xf0\xbc\x91*2:\x9dN\xa1P$\xf3\xe1\xd9\xff466\xa2\xf4\x03\xf2\xf5\xf5MHH\xe0\xfc\x01\x1c:t\xc8\xc7\xc7\xc7\xc1\xc1\xe1ڵk\xfcƏ\x1c9\xf2͛7(\x17\xf5\x00H@NNNSSӂ\x05\v\x16,X@\xa3\xd1\x12\x12\x12\x0e\x1c8\xc0f\xb3\t\x04¨Q\xa3:::\x1a\x1a\x1a\x10\x04\x91\x97\x97_\xbe|\xb9\xaf\xaf/\x91H466\u07b5kWpp\xb0\xc4֗(((\xecڵ\xab\xb1\xb1\xf1ѣG7o\xde\xe4\xfc\\555\x1a\x8d\xd6\xd8؈ \x88\x92\x92ҏ?\xfe\xe8\xe7\xe7'`H\x92\xcb\xe0\xd8^\x83\x0f\x1b6l\xf0\xe0\xc1\x18N8\xd00\x99L~\xb7n\xfc\xfd\xfd9wNddd\xbc\xbd\xbd\xab\xab\xab\xc3\xc2\xc2\xc8d2\xbf\x7fp6\x9b\x8d\xf9R\b\x00\x84\xc2d2}||\x02\x03\x039/\a\x0f\x1e\xfc\xf3\xcf?s\x1e\xa0f\xb3ٕ\x95\x95\xb2\xb2\xb2=\xafZ\x14\x15\x157m\xda\x14\x18\x18\xc8s\x9f6\xfc(++\xaf[\xb7nݺu\x9c\xc8+++\x15\x15\x15E\xab\xc5K\xae\x8a\x82m庱\xb1q\xf4\xe8\xd1\x18N8\xa0\xa8\xab\xab\xa3|y\xac\xad\xad\xe5\xfe\xeefii\xc9d2QVQ\x95\x97\x97\xeb\xe8\xe8`\x1c\"\x00\xc28s挃\x83àA\x83z\x1e\"\x10\bjjj\xfc\xbet.]\xba\xb4\xb4\xb4\xb4\xa0\xa0\x00\xe7\x00\xf9\"\x12\x89\x1a\x1a\x1a\"\xdfJ\x95\\\x06\x17\xa4r\xcd`0\x10\xc1\x9a\xeaⱫ\xf4\xc01f\xcc\x18~\xad\v\x10\x04177\xe7\xde\xd3'//\xcf\xd8\xd8\x18\xa5Q}aa!dp E\x9c\xfa\t\xca.\xc6\xe8\xdc\xdc\xdcN\x9c8\xd1G\x9f\xa7\x92\\\x06711A\xdf #%%\xe5\xe8ѣ\b\x82\xc4\xc6\xc6\xc6\xc4Ġl\xd1\xdb\xdeޮ\xa0\xa0\x80u\x80\x03Ȋ\x15+\x1e?~\xcc\xefhhh\xa8\x82\x82\x82\xad\xad\xed\xed۷O\x9c8\xf1\xeaի\x88\x88\b\x94٪\xaa\xaa`\xf3\a -\x9c\xfa\x89\x8b\x8b\x8b\xc83t\xd5R0\x8cJrD\xe9\xb8%\x92\xf6\xf6\xf6\x83\a\x0fb2U\\\\ܓ'O0\x99j\xc0ڻw/\xfa\x80\xea\xea\xea\xf7\xef\xdf\xf7ڶP\xa8\xeek\x00`\xce\xcf\xcf\x0f\x93\xa6\xde\a\x0f\x1e\xcc\xcf\xcf\x17\x7f\x1e\t\x93\xdc5\xb8\xbc\xbc\xbc\xa2\xa2\xa2\xe0\x9d\xbaP\xa4\xa4\xa4\x18\x1b\x1b\x8b?\xcf@\xb6t\xe9\xd2\xd4\xd4T\x94\x01#G\x8e\x9c1cFϾW\xdd\\\xbdz\xf5\x8f?\xfe\xc044\x00\x04%f\xfd\x84[\x1f\xad\xa5Ht]\x8c\xa3\xa3c@@\x80\x98\x93<~\xfcx\xf9\xf2尢GL\xd6\xd6֜\xd6\xf8\xe2LRUUE\xa7\xd3544\xb0\x8a\n\x00\xc1\x89_?\xe1\xd6Gk)\x12̓Ç\x0f\x1f?~\xfc۷oE\x9e\xa1\xb1\xb11--\xcd\xdc\xdc\x1cè\x06\xac\xff\xfc\xe7?\xa1\xa1\xa1\"\x9f\xcef\xb3\x03\x02\x02\xfe\xfc\xf3O\xec\"\x02@\b(ϟ\x88F\xeaϥ\x88@\xd2W\xb2\xebׯ\x7f\xf6\xec\x19\x95J\x15\xe1\\&\x93y\xfa\xf4\xe9\xfd\xfb\xf7c\x1e\xd5\xc04}\xfa\xf4\x91#G\xa2\xdf^FA&\x93mmm{\xdd\xf9\x1e\x00<`X?\xe1\xd6\xe7j)R\xa8E\xecٳ'$$\xe4\xebׯB\x9d\xd5\xda\xdaz\xfc\xf8q{{{Xȃ!++\xab\xd2\xd2\xd2\xc4\xc4D\xa1\xceb\xb3\xd9d2yڴi\x13'N\xc4)0\x00P`[?\xe1\xd6\xe7j)R\xc8\xe0D\"\xd1\xd5\xd55>>\xfeرc\x02~\xd6]\xbf~\xfd\xe8ѣNNNZZZx\x877\xd0lܸ\x11A\x10GGǶ\xb66A\xc6geemݺuŊ\x15FFF8\x87\x06\x00o\x98\xd7O\xb8\xf5\xadZ\x8at\xee\a\x12\b\x84\xca\xca\xca)S\xa68;;'%%\xa1,\xb8\xcf\xc9\xc99|\xf8pCCCKK\v|a\xc7Iss\xf3\xf4\xe9\xd3===\xc3\xc2\xc2P\xf6:\xa9\xaa\xaa\xf2\xf3\xf3{\xf8\xf0!\x83\xc1\xe8\xf5\x19\x15\x00p\x82S\xfd\x84[\x1f\xaa\xa5\x10\xa4\x12ejj\xea\xa7O\x9fv\xee܉ H|||rr\xb2\x8c\x8c\x8c\xb6\xb6\xb6\xb6\xb6\xb6\xaa\xaaj]]]EEŷo\xdfh4\xda\xe4ɓmmm\x15\x14\x14\xa2\xa3\xa3;::lll$\x1fm\xffVUU\xe5\xe1\xe1\xc1\xd9C\xe4Ç\x0fw\xeeܡ\xd1h\x9a\xff\xa3\xb3\xb3\x93B\xa1\x14\x17\x17\xd7\xd5թ\xa9\xa9mܸQ]]\xbd\xb0\xb00((\xc8\xdb\xdb[ڱ\x83~\x88\xc1`TUU\xd5\xd6֎\x1c9RUU\xb5\xdbSgL&\xd3\xde\xde>00\x10\xa7\v\xf0.\xa9\xa9\xa9YYY=o\xd4\xd3\xe9\xf4\xca\xcaʺ\xba:UU\xd5\xefa!\x9b\x142xkkk\xcf~`L&\xb3\xb8\xb8\x98J\xa5VVV*++khh\x8c\x1d;\xb6[\xc9\xfb\xaf\xbf\xferss\xfb\x1e\xfe\xd5\xfa\x93?\xff\xfc\xd3\xc3ã[\x0fb*\x95ZZZZQQA\"\x91\xb4\xb4\xb4\xb4\xb5\xb5\x87\x0f\x1f\xce= 00POOoٲe\x92\r\x16\xf4[\xe9\xe9\xe9\xf1\xf1\xf1---\b\x82hjj*++\xd7\xd6\xd6VUU\xb1\xd9l\x15\x15\x15kkk\xce\xee\x02\xa7N\x9d222\xc2\xf5\x02\xbcˡC\x87\xec\xec\xec&L\x98\xc0f\xb3SSS\x93\x92\x92:::ddd444\x86\x0e\x1dZUUUSS\xc3b\xb1\xd4\xd4\xd4~\xfe\xf9gi\xdd\x13\x92B\x06wuuݲe\v\xca\x1e1\xfc\xd4\xd6\xd6\x1e>|X\xfc'\xcaA\x97;w\xee \b\xf2믿\n{\"\x9b\xcd\u07b6m\xdb\xe9ӧ\x95\x94\x94p\x88\v\f \xef\u07bd\xbby\U000e6041\x81\xa5\xa5%\xcfJi}}}lllvv\xf6\xb2e\xcb^\xbe|\xe9\xe1\xe1!\x99\xc08ך\x9b6m\x8a\x8d\x8d566^\xb9r%\xcf\v\xff\xca\xcaʻw\xefR\xa9\xd4\x1d;vH\xfeF\x9d\xa438w\xfdD\x04PK\xc1\x10w\xfdD\x04PK\x01b\xe2<Ԥ\xa8\xa8hkk\xdb\xeb\x1a\xbd\xce\xceΓ'O\xaa\xa9\xa9\xd9\xd9\xd9I&<\x06\x83\xe1\xe6\xe66g\xce\x1cA.qh4ZPPЄ\t\x13V\xaf^-\x81\xd8\xfe\x97$\x97\U00037d34lٲ\x85\xc5b\x893\xc9Ν;+**\xb0\ni stt\xac\xae\xae\x16g\x86\xc0\xc0\xc0Ǐ\x1fc\x15\x0f\x18P:::<==\xbf~\xfd*\xd4Y\x99\x99\x99\xbe\xbe\xbe\f\x06\x03\xa7\xa8\xba466\x1e9r\xa4\u05fe@ݤ\xa4\xa4\x04\x06\x06\xe2\x14\x12O\x12\xcd\xe0\x87\x0e\x1d\x12\xbfwLMM\x8d\x83\x83\x03&\xf1\fdQQQQQQbN\xc2b\xb1\xec\xed훛\x9b1\t\t\f(ǎ\x1d\xab\xa9\xa9\xe1w\xb4\xb3\xb3\xb3\xa8\xa8\x88\xe7\xa1\xd2\xd2R\x7f\x7f\x7f\xdc\xe2b\xb3\xd9l\x06\x83q\xe4ȑ\xd6\xd6V\x9eG\x8b\x8b\x8b\xbf\xfe\x8f\xc4\xc4\xc4nG?~\xfcx\xf5\xeaU\\\xc3\xe3&\xb9\xa7\tSSS\xd5\xd5\xd5E(\x7fw3bĈ\x95+WFFFb\x12\xd5\xc0TUU\x95\x92\x92\"B\xf9\xbb\x1b\x02\x81p\xe8С\xe3Ǐc\x12\x15\x188.]\xba\xf4믿\xf2\xdcu\x81\xc1`\x84\x84\x84\xe8\xe9酇\x87\xf3<WKKk\xe1\u0085QQQ\xf8\x85w\xfa\xf4\xe9m۶\xf1\\<X\\\\<a\u0084\x1f\xfeG\xcf0\xf4\xf5\xf5G\x8d\x1a\xf5\xe4\xc9\x13\xfc\xc2\xe3&\xa1\f\xde\xda\xdaz\xed\xda5\xaczh\xacY\xb3&--M\x92[\x94\xf63\x1e\x1e\x1eX\xdd\x0e\x1a7n\xdcرc%\xf6\xfb\n\xfa\x81\x82\x82\x02:\x9d>i\xd2$\x9eG\x93\x92\x92H$Raa!\xca\f\xf3\xe7\xcf\xcf\xcfϯ\xa9\xa9\xc1#\xbcׯ_\x8f\x1d;VSS\x93\xe7\xd13g\xce\x04\x04\x04<x\xf0\xe0\xc1\x83\aqqq</_,--\x93\x93\x93\xdb\xdb\xdb\xf1\b\xaf;\xc9\\\xeacR?\xe1\x06\xb5\x14\x91ݾ}[\xfc\xfa\t7\xa8\xa5\x00\xa1\x1c<x\xb0\xbd\xbd\x1de@yy9\x82 \x9c5\xdb\xfc\xd4\xd7\xd7{zzb\x1d\x1a\x9b\xcdf;99\xf1\xbbWW__off\xc6d2{\x9d\xa4\xa8\xa8\xe8ܹsX\x87ƃ$\xae\xc1\xb1\xaa\x9fp\x83Z\x8ah\xaa\xaa\xaaRSSů\x9fp\x83Z\n\x10\\II\x89\x9a\x9a\x9a\xbc\xbc\xbc\x98\U000e8a280\x18\x8c\xe6\xe6fL\xa2꒞\x9e>\x7f\xfe|~\xfb\xc4\a\x06\x06&&&*++\xafZ\xb5*!!\x01e\x1e\x1d\x1d\x9d\x92\x92\x12\tl\x1d\x96\xc1\xbc\x00\x00\x18\x10IDAT\x06\x89{\x06Ƕ~\u008dSK\xa9\xa8\xa8\xc0|\xe6~\xcc\xc3Ã\xb3\x95\x1d\xb6\xa0\x96\x02\x04t\xff\xfe}\xac\x9e\xb7\xb3\xb0\xb0@O\xa3\"\x88\x8f\x8fGi^\xad\xad\xad\xbdw\xef\xdeq\xe3\xc6=|\xf8\xd0\xdc\xdc|Ϟ=(S\x19\x1a\x1a\xbez\xf5\n\xdb\xf0z\xc2=\x83\x9f8q\xe2СC\xfc>\xd3\xc4\xe4\xe1\xe1\xe1\xe9\xe9\x89\xc7\xcc\xfdRTT\x94\x89\x89I\xb7\xe5\x97Xپ}{dd$gM\x1d\x00\xfc\x14\x16\x16\x8e\x1b7\x0e\x93\xa9\xe6Ν\x9b\x9e\x9e\x8e\xc9T]\x9a\x9b\x9bQ\xfa/\xd9\xda\xda\xfa\xfb\xfb\x7f\xfc\xf8\xf1\xf9\xf3\xe7\x93&M:{\xf6,\xca\rU33\xb3\xa4\xa4$l\xc3\xeb\t\xdf\f\x8eG\xfd\x84\xdb\xf0\xe1á\x96\" <\xea'ܠ\x96\x02\x04\x81\xe1\xeeZ222(M\xf1D\xc3\x16l\x85\xe3\xe2ŋ\x9f={6jԨ+W\xae\xf0\x1b\xa3\xa4\xa4\x84\xb2];Vp\xcc\xe0\xf8\xd5O\xb8A-E@8\xd5O\xb8A-\x05\xf4\n\xdb\xd20\xb6\xbb-\xd6\xd5\xd5u\xeb\xff\x83bԨQ\xb6\xb6\xb6\xe8\xcf\xccH\x00I\x84s\xaa\xaa\xaabcc\xbf|\xf9\xc2b\xb1\x88D\"\x8bŒ\x91\x91a\xb1X***\x96\x96\x96\xb3g\xcf\xe6\xd4Lp\xad\x9fp\xf3\xf0\xf0pss\xeb\xea\x97RRR\x12\x1b\x1b[TT\xc4d2I$\x12\x83\xc1\xe0\x04\xa9\xaa\xaa\xbajժ\xa9S\xa7\xe2\x1d\x8f$\xd1h\xb4\x84\x84\x84\x97/_\x12\b\x04:\x9d.++\xcb\xf9\xef%\x91H˗/_\xbat\xa9\x9c\x9c\x1c\x82s\xfd\x84\xdb\xf6\xed۷m\xdb6\x7f\xfe|N\xbf\x94\x86\x86\x86\xf8\xf8\xf8\x8c\x8c\x8c\xaeK\x1b6\x9bM \x10\x14\x15\x15W\xacX\xb1h\xd1\"\"\x91\x88wH\xe0\xfbA\xa7\xd3I$Qr\x0e?999\x18\xce\xd6\xd2\xd22t\xe8P\xc1ǫ\xab\xab\xa37\xda\xcb\xcf\xcf\x17;\xa8^\b\xf7\xafYTT\x14\x14\x144lذU\xabV\xd9\xdb\xdbw;\xca\xf9s\x8d\x8c\x8c\\\xb4hѰa\xc3p\xad\x9fp몥L\x9f>=,,LMM\xcd\xda\xdaz\xfc\xf8\xf1݆UWW\xc7\xc6Ɔ\x84\x84XYY\xf5\x83\xad\ue6da\x9a\x02\x02\x02h4\x9a\xa9\xa9\xa9\x8f\x8fO\xb7T\xd8\xd9ٙ\x92\x92\xe2\xee\uebad\xadmmm\x9d\x9a\x9az\xe1\xc2\x05\tD\xd5UKqvv\xbex\xf1\"\x81@\xb0\xb0\xb0\xb0\xb1\xb1\xe9\xf6AN\xa3\xd1\x12\x13\x13\x0f\x1d:4y\xf2\xe4M\x9b6\xc1\xbe\xd5\x03\x84\xac\xac,\xb6\xd7\xe0zzz\x18Φ\xaa\xaa*Է\xf9ϟ?\xff\xfc\xf3\xcf(\x03$ѰP\xc0\xa7\x0eY,V``\xe0\xb9s\xe7\xda\xda\xdaz\x1d\xfc\xf4\xe9\xd3\xdf~\xfbM\x92\xddK\x18\fƖ-[\x82\x83\x83\xe9tz\xaf\x83ccc\xdd\xdcܚ\x9a\x9a$\x10\x18N\x12\x12\x12\x8e\x1c9RYY\xd9\xebȯ_\xbfnڴI\xc2\xddK\x0e\x1e<x\xfc\xf8\xf1\xfa\xfa\xfa^G\xbe\x7f\xff\xde\xd9\xd9Y\xd8\xe6\x18\xa0\xefڻwo\xafcJKK\x11\x04\x11\xe4qo''',\x82\xfa_\xfb\xf6\xed\xe3w(++\xcb\xc1\xc1\x81\xf3\x85\x92\xcdfgdd\xacX\xb1\xa2\xa3\xa3C\xb4ٰ\"P\x06okk\xf3\xf4\xf4\xcc\xcb\xcb\x13|\xde\xce\xce\xce\x7f\xfe\xf9\xe7ݻw\xa2\x06&\x84\x86\x86\x86Ç\x0fS(\x14\xc1Oiii\xf1\xf0\xf0(((\xc0/*\xfc\\\xbat\xa9g7\x06tׯ_\xbfw\xef\x1eN\xf1pc2\x99\xfe\xfe\xfe\xaf^\xbd\x12\xfc\x14\xce\xc5\xc1\x93'O\xf0\x8b\n|?\xfe\xfe\xfbo\xf4ˬ\xe4\xe4dN\xf7\xc19s\xe6DGG766\xf2\x1b\xd9\xd0\xd0\xe0\xe1\xe1\x81mx{\xf6\xec\xe1w\xe8\xf9\xf3\xe7\n\n\nD\"qӦM...\xee\xee\xee4\x1a\re\xaa\xfc\xfc\xfc\x8b\x17/b\x1b^O\xbdgp&\x93y\xf8\xf0a\x94\xeb)\x94\x1e4\xe7ϟ\xcf\xc9\xc9\x11=:\x01ttt\xb8\xb8\xb8\xf0\xfcf\xc0d2SSSo\u07bc\xd9\xf5\xb1ɍ\xc5byyyQ\xa9T\\\xc3\xc3\\@@@VV\x16\xcfC\xe8\rw\x92\x92\x92bbb\xf0\x0e\xcf\xc7ǧ\xb8\xb8\xb8\xeb%\xcf\xdf\r\x06\x83\x11\x1f\x1f\x1f\x14\x14\x94\x92\x92\xd2\xf5\xe6\xad[\xb7RSS\xf1\x0e\x0fH]lllrr2&SEDD\xa4\xa7\xa7c2U\x172\x99\x9c\x9b\x9b\xcb\xefhKKˇ\x0f\x1frrr\x04\xf9\xae\x7f\xeaԩ\xb2\xb22L\xa3\xe3\xa1\xf7\f\xee\xef\xef_ZZ\xca\xf3\x10\x9dN\xbfr\xe5ʸq\xe3Pֿzxx444\x88\x1e`o<<<x~\xba\x14\x17\x17Ϙ1\xa3\xab\xc0\xfa믿\xf6\xfcG\xe7\xf4\xffE_\xe0\xfb]\x89\x8b\x8b\xe3w\xadZTT\xc4}\x8f\xc8\xde\u07be\xe7\x98\xf0\xf0p\x9e\x1ffX\t\x0f\x0f\xcf\xcc\xcc\xe4\xfco~\xbf\x1bT*uѢE\xa6\xa6\xa6\x93'OF\x10dÆ\r]\x87Ο?\xdfG\xbf\x15\x01\xc1\xd1h\xb4C\x87\x0ea2վ}\xfb\xc4lU\xddSEE\x85\x8f\x8f\x0f&S\tR/\x12_/w\x90RSS'L\x98\xc0o\xe3\tAz\xd0\xfc\xfd\xf7߾\xbe\xbe\xc2T\xe6\x85\x10\x19\x19iaa\xa1\xa2\xa2\xd2\U000d08e3\xa3\x97\x97Wgggii\xa9\xa9\xa9\xe9\x9d;wz\xde\xca#\x12\x89\xbbw\xef\xf6\xf7\xf7\xc7)<l\xd5\xd6\xd6~\xf8\xf0\x81\xdf\xc6f\x824ܱ\xb5\xb5\xbd}\xfb6\x9dN\xc7#\xbc\xfc\xfc\xfc\xe6\xe6\xe6Y\xb3fq^\xf2\xfb\xdd8q\xe2DTTTbbbvv\xf6\x9e={nܸ\xf1\xe1\xc3\aΡ?\xff\xfc300\x10\x8f\xd8\xc0\xf7CAAASSS\xfc\x874\xd2\xd3\xd3\xe7͛\x87\xf9\xa3n\xaa\xaa\xaa\f\x06C\xfc\x9eY\xf7\xee\xddC\xbfɉ\x19\x94\xec\xceb\xb1z\xbdQ H\x0f\x9a\xb0\xb00<\n\xe2\xed\xed\xed...<\x0f\xbdy\xf3\xe6\xe6͛]/kkk\xe5\xe4\xe4\xcc\xcc\xccx\x0e\xf6\xf7\xf7/))\xc1<<\xccyzz\xd6\xd5\xd5\xf1<$xÝ\x9c\x9c\x1c2\x99\x8cuhl6\x9b}\xf0\xe0\xc1nwuz\xfen477s\xff&|\xfa\xf4\tA\x90ׯ_w\xbd\xf3\xecٳ\xe8\xe8h<\xc2\x03ߏ\x8e\x8e\x0e1/\xc3\x19\f\x06J\xff)1\xd5\xd5\xd5\x1d?~\\\x9c\x19\xda\xdb۱\xfa\x9e\xd1+\xb4kp\xf4\x16\x01\x82\xb3\xb5\xb5\xbd~\xfd\xba\xf8\xf3t\x13\x1e\x1e\xbei\xd3&\x9e\x87F\x8c\x18\xb1v\xedڮ\x97Ç\x0f\x1f;v\xac\xac\xac,\xcf\xc1۷o\xbf|\xf92\xe6\xe1a\xab\xba\xba\x9aD\"\r\x1b6\x8c\xe7Q\xc1\x1b\xee\xe8\xe9\xe9}\xfd\xfa\x15\xf3\x95l_\xbe|\x998q\"\xe7\xd9s\x14JJJ3g\xce\xeczYPP\xb0t\xe9R\xee]k\x8d\x8c\x8c\x9e?\x7f\x8eml\xe0{#''gccs\xf5\xeaU\x91g8w\xee\xdc\u07bd{qZk2l\xd80##\xa3\x98\x98\x18\x91g\xf0\xf1\xf1\xf9\xfb\xef\xbf1\f\t\x05Z\x06OII111\xc1\xe0g\xc8\xc8(((\xd0h4\xf1\xa7▝\x9dͯ\xc5\xf0\xf8\xf1㹟\x8ff\xb3\xd9555+V\xac\xe09x\xf0\xe0\xc1\x9c\xcd8\xb0\r\x0f[qqq\xabV\xad\xe2wT\xa8\x86;\x8b\x17/NKK\xc36\xbc\x98\x98\x18a\xdb\x15}\xfc\xf8\xf1\xe4ɓQQQ\xdd\xfe\x0e'L\x98PPP\x80it\u0ee3\xaf\xaf?z\xf4\xe8\xfb\xf7\xef\x8bp\xee\xf5\xeb\xd7\r\r\rq\xddSxɒ%\xad\xad\xadϞ=\x13\xe1ܳgϮ]\xbbVYY\x19\xf3\xa8x\xe2\x9b\xc19\x0f\xdec\xb5d\xce\xd4\xd4\x14\xdb&/MMM\x82\xaf\x9ez\xfa\xf4\xa9\x8a\x8a\xca֭[\xf9\r\x983g\xce۷o1\n\r\x17YYY\xfa\xfa\xfa\xfc\x8e\n\xdbp\xe7\xf1\xe3\xc7؆WSS3j\xd4(\x01\a755\xb9\xba\xba\x1a\x1a\x1a\xa6\xa5\xa5-^\xbc\x98B\xa1p\x1f]\xbdz\xb58\x97?\xa0\xaf\xb0\xb4\xb4TRR\n\f\f\x14\xfc\xc6\f\x8dF\xf3\xf3\xf3\xd3\xd3\xd3[\xb0`\x01\xae\xb1!\b\xb2aÆ\xda\xdaڛ7o\n~J{{\xfb\xb1c\xc7~\xfc\xf1G~W\x96x\xe0\x9b\xc1\xab\xaa\xaa\xd0\x17\x8c\nE__\xbf\xeb\x86\x15&\xf2\xf2\xf2\x04\\\x8e\xc5`0<==\xef\u07bd\xcbs\xcf$\x8e\xe9ӧgeea\x17\x1d\xf6\x04\xfc\xc2(H\xc3\x1d\x05\x05\x05\xcc;\b\n\xf5}v\xe8С^^^\x95\x95\x95\a\x0e\x1c\xc8\xcb\xcbsvv\xe6>\xaa\xa5\xa5E\xa5R\xb1\r\x0f|\x9f\x96/_^PP`gg'ȗ\u0084\x84\x04kk\xeb)S\xa6̝;W\x02\xb1!\b\xb2f\xcd\x1a===gg\xe7\xf7\xefߣ\x8fd\xb3ّ\x91\x91^^^\x7f\xfd\xf5\x17\xb6\xcbD{\xc5wU=\x85BQWW\xc7\xeaǨ\xa8\xa8\xd4\xd7\xd7c5\x1b\x82 T*U\xc0\xf0\\]]\xf7\xef\xdf\xcf]~\xedICC\xa3ە\xe0\xf7F\xf0\xb5Ȝ\x86;\x0f\x1f>\xc45\x1en\xb5\xb5\xb5<\x1f\aB7d\xc8\x10oo\xef\xf7\xef\xdf?}\xfa\xb4ۡＢ\x05\xb0\x92\x93\x93\xa3\xa8\xa8\x18\x16\x16\x16\x13\x13\xe3\xe4\xe44~\xfc\xf8իW\x8f\x193\x86{L~~\xfe\xfd\xfb\xf7)\x14\x8a\xb9\xb9yBB\xc2֭[\x97/_.\xfe\x06\x11\x02200\x985kֵk\xd7\xc2\xc3\xc3'O\x9e\xbcz\xf5\xeaѣGs\x0f\xf8\xf4\xe9\xd3Ç\x0fkkk\x7f\xf9\xe5\x17\x1b\x1b\x1b\xc9Dōo\x06\xaf\xa9\xa9\xc1\xb6\x11\xd2ׯ_1\x9c\xad\xa6\xa6F\x90.\xc3\xc1\xc1\xc1\xfa\xfa\xfa\x16\x16\x16\xe8Æ\r\x1b\x86y\xa3a\f\xb5\xb6\xb6***\n>\xbe׆;\xd8\xf6\x03\xaa\xad\xad\xe5\xb9e\xad \x96-[\xf6\xed۷no\xe6\xe5\xe5\x89\x1d\x14\xf8\xde1\x99L\x1f\x1f\x9f\xc0\xc0@\x02\x81\xb0f͚5k\xd6|\xfb\xf6-::\xba\xb0\xb0\x90D\"1\x99L\"\x91\xc8f\xb3'N\x9chccӵk\xe5\xbe}\xfb\xbc\xbd\xbd\xdd\xdd\xdd%\x16\xa7\x8c\x8c\xcc\xe6͛\x11\x04\xc9\xce\xce\x0e\v\v\xa3R\xa9\x9c\xf0\x10\x04!\x12\x89\x93'O\xb6\xb7\xb7\x97@\xcf8~\xf8f\xf0\x11#F`\xfb\x87\xa4\xab\xab\x8b\xe1l#F\x8c\xa8\xab\xabC\x1f\x13\x1b\x1b\xcbb\xb1lmm\xbb\xdey\xf7\xee]\xd7\x03\xcb\xdc\x1a\x1a\x1a$\xf6\xd5L\x04\x8a\x8a\x8aB\xdd\a\xee\xb5\xe1\x0e\xb6u\xba\x11#F\x88\xfc\x05\xab\xbe\xbe~͚5\xdd\xde\xc4\xf6W\x05|\x9fΜ9\xe3\xe0\xe00hР\xaewƏ\x1f\xbf{\xf7n\xf4\xb3\xa6N\x9d\x1a\x1f\x1f\xff\xe6\xcd\x1b\xeeG\x98$cʔ)S\xa6L\x91\xf0\x0f\xed\x15\xdf:\xb8\x86\x86\x86 m\xba\x18\f\x06\"\xc0\xd7\xde\xc6\xc6Flo\xcejhhp\x1e7\xe6\xe7\xe9ӧ\xa7N\x9db\xb1Xd2\x99L&\a\x06\x06\xeeܹ\x93_\x81\xb5\xbc\xbc\x1c\xd7[\xdb\xe2C)4\x7f\xfe\xfc\xd9\xd1\xd1133\x93\xf3233\x93B\xa18::\x8a6\x9b\bF\x8c\x18Q[[\xdb\xf3\xfd\x9e\xbf\x1b---G\x8e\x1c\xe9\xba#RSS\x93\x91\x91\xe1\xea\xea\xda\xedDhU\xd8\xef\xe5\xe4\xe4455\x89\x96\x85\xf7\xee\xddK&\x93;::0\x8f\xaa/\xe2{\r\xae\xaa\xaa\xda\xeb\r\xa5\x94\x94\x94\x1b7n \b\x12\x1b\x1b\xab\xaf\xaf\xbfl\xd92~χ|\xfe\xfcyڴi\xe2\x04ڍ\xae\xaen||<\xbf\xa3\x99\x99\x99VVV---\xdc\xcf\x03iii\x9d={\x96\xe7\xf8O\x9f>}\xe7}\xc39ِ\xa7\xfa\xfa\xfa\xd0\xd0\xd0K\x97.mذASSSNN.66\x16\xe5\xd1\xec\xf6\xf6v\xee\v\x1f\x9c\xf0\xfc\xddhoo\x0f\t\t\xf1\xf2\xf2\xfa駟\xf4\xf5\xf5\a\x0f\x1e\x1c\x15\x15\xd5\xedw\x86J\xa5bx\v\x1d|\x87\xba\xea'\xa2\x9dN$\x12%_K\xf9~\xa1\xac\xf6ٿ\x7f?\x83\xc1\xc0d\xe1\xd0\xe1Ç\x9b\x9b\x9b1\x99\xaa\v\x86m\a\xf6\xed\xdb'ȂF)\xba|\xf9\xf2\x97/_\xf8\x1d\x15\xaa\xe1\u0383\a\x0f\xb0j-\xd4\xe5\xf8\xf1㵵\xb5\x82\x8c\xa4\xd3\xe9EEE\x15\x15\x15\xfc\x16ԑ\xc9d\xbc\xbb\xa1\x01\xe9\xf2\xf3\xf3\x13\xbf#\xd5ɓ'1ok\xd5\x17\xa1}]]\xbcx1&\v\xe4\xd8l6\x8dF\xe3lڂ\xa1\x1f~\xf8\x01\x93\xbb\xa3\xed\xed\xedrrr\xdf\xf97w+++\x94\xe5\x0f\x8a\x8a\x8aӧO\xd7\xd3\xd3\x13d\x03\x94\xe7ϟ\x1b\x19\x19a\x1a]/\xe1q#\x91H:::\xaa\xaa\xaa\xfc*9\x82?'\n\xfa\"q\xea'ܠ\x96\u0081\x96\xb6V\xadZ\x15\x17\x17'\xfeψ\x8c\x8c\xfc\xfd\xf7\xdfş\xa7\x9b?\xfe\xf8#44T\xfcy._\xbe\xbcm\xdb6\xf1\xe7\xc1\x95\xaa\xaaj[[\x9b\xf8\x1b\xa7\x16\x14\x14\xe8\xe8\xe8`\xbb\xd3\x15\x82 ӧO\xcf\xcd\xcdE)\xf5\b\xe8\xf5\xeb\xd7\x12X\xac\x01\xa4\x85S?qqq\x11\x7f\xaa\xaeZ\x8a\xf8S\xf5ih\x19\\FF\xc6\xcc\xcc\f\xa5\xdc,\x88\x8e\x8e\x8eO\x9f>\xe1\xf1\xa4ǠA\x83\xf4\xf4\xf4\u07bd{'\xce$\xb5\xb5\xb5\x8d\x8d\x8dcǎ\xc5((\x1c9::v\xed\x05*\xb2\xe0\xe0`N\xfb|\xccm\u07bc9$$D\x9c\x19\xd8lvTT\xd4/\xbf\xfc\x82UH\xe0{\xd3\xf3\xf9\x13qL\x9d:UQQ\xf1͛7\x98\xcc\xd6G\xf5R:X\xb9revv\xb68\xab]N\x9f>\xbdw\xef^\x91OG\xb7q\xe3\xc6\a\x0f\x1e466\x8av:\x93\xc9<w\ue713\x93\x13\xb6Q\xe1d\xf4\xe8\xd1S\xa6LIMM\x15y\x86[\xb7n\xfd\xf4\xd3O8\xad\x86\x984i\x92\x9c\x9c\x1c\xa7ݠh\x02\x03\x03\x1d\x1c\x1c$\xb056\x90\n\xac\xea'ܠ\x96\xd2\xfb\x0e\x0f\f\x06C\xe4\xfb\x90\xc1\xc1\xc1\x1f?~\x14\xe1D\xc1\xb5\xb6\xb6\x1e=z\x14}\xb7:~N\x9e<\xc9o\xf3\x8a\xefօ\v\x17P\xf6\x10A\xf1\xf2\xe5\xcb\xc8\xc8H\xcc\xe3\xe9\xe6\x9f\x7f\xfe)//\x17\xe1\xc4\xd8\xd8X\to\xe6\t$\x89\xc1`l\u07bcY\x90]v\x85\x95\x95\x95u\xf4\xe8Q̧\xed+\x88G\x8f\x1eEO\xf1222\xf3\xe6\xcd;u\xea\xd4رc\x05_<\xcdd2Ϟ=k``0{\xf6lq?dP\xc9\xca\xca\xea\xeb\xeb\xfb\xf8\xf8\xe8\xeb\xeb\v\xbep\xb1\xbd\xbd\xdd\xdb\xdb{͚5=\xb7\xb4\xff\xce͝;\xf7\xf6\xedۭ\xad\xadBU~\xee\u07bd[WW'\x81U\xbf\v\x16,\b\b\bPRRRSS\x13\xfc\xac\xe0\xe0\xe0aÆ\x99\x9a\x9a\xe2\x17\x18\x90\xaeӧO\xff\xf2\xcb/:::\x98\xcf<z\xf4\xe8\x7f\xff\xfd\x97D\"u\xad\xdb\x1cX\x04\xcc\xf4,\x16\xeb\xecٳAAA\x82<\xaf\xf6\xe6\xcd\x1bggg\t\xec\x11ץ\xb3\xb3\xd3\xcb\xcb+\"\"B\x90\x87\x02\x9f<yr\xf0\xe0A~\xbb%\xf4\t\xf7\xef\xdf\x17p3\xf8\x92\x92\x1277\xb7\xb4\xb44\tD\xd5\xe5ڵk\xa7N\x9d\xe2\xf4\xecE\x97\x9b\x9b\xfb\xf7\xdf\x7f\xf3\xdb\xf9\x13\xf4\x0f_\xbe|9r\xe4\b~\xf33\x18\x8c?\xfe\xf8\xa3\x0fm\x97\x88!\x02[\x98.B_\xbe|\t\r\r\xd5\xd4Դ\xb6\xb6\xee\xf9qJ\xa3ђ\x92\x92^\xbe|9k֬\xdf\x7f\xff]\xf2\x05\xcd\x7f\xff\xfd7\"\"BOOo\xf5\xea\xd5=\xaf\x01\x9b\x9a\x9a\xe2\xe3\xe3_\xbf~\xbdr\xe5\xca^;\xa5|\xffjjj8\xbb\xedXZZ\xf6\xfc\xa2\xc3d2_\xbdz\x95\x94\x94\xa4\xac\xac\xec\xe0ࠠ\xa0 \xe1\xf0\xca\xca\xca\xc8d\xf2\x90!CV\xaf^\xcd\xd9\x12\x93\x1b\x9dNOMMMJJ\x9a0a\xc2\xe6͛{\xdd\x1a\x02\xf4]L&\xd3\xde\xde>00\x10\xd7ud\x9f?\x7f\xbes\xe7\xce\x00\\\xe3#\\\x06\xe7(..\x8e\x89\x89)**\x92\x91\x91a\xb1X$\x12\x89\xb3:c\xf0\xe0\xc1\xa6\xa6\xa6\x8b\x16-ª\xab\xb8hrrr\x1e<x\xc0\xa9\xc6r\"a0\x18D\"q\xe8С\xe6\xe6\xe6s\xe7\xce\xedO\xf7\xca\xea\xeb\xeb\xe3\xe2\xe28\x0f\xe4p\xfe{\t\x04\x02\xa7+\xd0\u0085\v\xcd\xcc\xccPz\xeaJ@eeelllvv6\x91H\xe4D\x85 \b\x83\xc1\x184h\x90\xb1\xb1\xb1\x89\x89\x89Ě\xcc\x01<\xd0h\xb4\x97/_R(\x14\n\x85\xc2f\xb3555555\x17-Z\xc4\xfd[w\xea\xd4)###\t\xb41\xf1\xf3\xf3[\xb2d\t\xf7\x0fjll|\xf5\xea\x15\x95J\xa5P(rrr\xea\xea\xea:::\v\x16,\xe8OW\f\xa2dp\x00\xc0\x00\xf7\xf0\xe1\xc3'O\x9e(**.X\xb0`̘1\x9c%Z\x15\x15\x15%%%\xaf^\xbdjii111Y\xbdzuNNNDD\x84\x87\x87\x87\x04Bb2\x99[\xb7n\r\f\f\x94\x95\x95\x8d\x8a\x8az\xf3\xe6\xcdСC\x17.\\\xa8\xa1\xa1\xa1\xae\xaeN\xa7\xd3+**\x8a\x8b\x8b_\xbdzE\xa7ӭ\xac\xac\x96.]*\x81\xa8\xf0\x06\x19\x1c\x00 \x84\xcc\xcc̈\x88\b\v\v\v\xf4-\x18SRR\x1e=zT^^\x1e\x1c\x1c,\x81><\x1c\x9f?\x7f\x0e\n\n\"\x91H\xeb֭CY\x83\xc2f\xb3\xef߿\xff\xea\xd5+{{\xfb\x1f~\xf8A2\xb1\xe1\x0428\x00@Pw\xefޥ\xd1h\xb6\xb6\xb6\x82\x94\"\xd9l\xf6\x8d\x1b7\xe4\xe4丷\x1d\xc7Օ+WF\x8e\x1cimm-\xc8`\x06\x83q\xf9\xf2\xe5\x89\x13'\xae\\\xb9\x12\xef\xc0\xf0\x03\x19\x1c\x00 \x90˗/O\x9d:u\xe1\u0085B\x9d\xf5\xeaի\xf7\xef\xdf;88\xe0\x14U\x97ӧO\x9b\x9a\x9a\n\xdbd411\xb1\xae\xaenݺu8E\x857\xc8\xe0\x00\x80\xdeݽ{WMMmѢE<\x8f\xd2\xe9t*\x95\xca\xefq\xefׯ_\x97\x96\x96\xfe\xf6\xdbo\xf8\x85\x17\x1c\x1c\xbcp\xe1\u009e;0\xb0X\xac\x17/^P(\x14===~kS\x1e>|\xa8\xa0\xa0\xb0l\xd92\xfc\xc2\xc3\xcfwݐ\x0f\x00\xf0=\xf8\xf0\xe1C[[\x1b\xcf\xf4\xcd`0BBB\xf4\xf4\xf4\xc2\xc3\xc3\xf9\x9dnhh\xd8\xd1\xd1\xd1\xeb~\xc1\"{\xfc\xf8\xb1\x86\x86F\xcf\xf4]RR2{\xf6l\x13\x13\x93\xf5\xeb\xd7\x1b\x18\x18\xfc\xf6\xdbo<\x9b\xafYZZ~\xfc\xf8\xb1\xa4\xa4\x04\xa7\xf0p\x05\x19\x1c\x00Ћ\xf0\xf0\xf0\xf5\xeb\xd7\xf3<\x94\x94\x94D\"\x91\n\v\v\xd1gذa\xc3\xf5\xeb\xd7q\b\ra2\x99\x89\x89\x89<Wx8::zyyuvv\x96\x96\x96\x9a\x9a\x9a\u07b9s\xe7\u0085\v<'ٱcGPP\x10\x1e\xe1\xe1\r28\x00\x00MRR\xd2ʕ+\xf95\xd0777\x17\xa4\x1d\x02\x81@\xf8\xf1\xc7\x1f\x1f=z\x84utHxx\xf8ƍ\x1b{\xbe\xff\xf6\xed\xdb\r\x1b6XZZ\x12\x89D--\xad\x88\x88\b99\xb9\x84\x84\x04\x9e\x93\f\x1a4hҤIb6:\x95\n\xc8\xe0\x00\x004\xf1\xf1\U00058d2cY\xb1bœ'Oğ\xa7\x9bO\x9f>M\x9f>\xbd\xe7\xfb#F\x8c\xe0~\x06f\xf8\xf0\xe1cǎ\x95\x95\x95\xe57\xcfڵk\xefܹ\x83yxx\x83\f\x0e\x00ૣ\xa3cРAX-c\x1e4hP[[\x1b&SqTUU\x8d\x1a5\x8a\xe7\xa1\xf1\xe3\xc7s/\x0eg\xb3\xd9555+V\xac\xe07\x95\xbc\xbc|{{;\x86\xb1I\x06dp\x00\x00_\xff\xfe\xfb\xaf\x81\x81\x01V\xb3\x19\x1a\x1a\xa6\xa7\xa7c5\x1b\x82 O\x9f>566\x16p\xa4\x8a\x8a\xca֭[Q\xc6L\x9c81??\x1f\x9b\xc8$\x0528\x00\x80/\n\x85\xa2\xad\xad\x8d\xd5l\x9a\x9a\x9aeeeX͆\b\x1c\x1e\x83\xc1\xf0\xf4\xf4\xbc{\xf7.z\x9b --\xad>\xf7D\ndp\x00\x00_\x14\nECC\x03\xab\xd9444>|\xf8\x80\xd5l\b\x82P\xa9TUU\xd5^\x87\xb9\xba\xba\xee߿\x7f\xe6̙\xe8\xc3TUU?~\xfc\x88Qh\x12\x02\x19\x1c\x00\xc0\x17\x81@`\xb1X\x18N\x88m7J\x02\xa1\xf75\x89\xc1\xc1\xc1\xfa\xfa\xfa\x824\x94&\x10\b\x12k\xe1\x82\x15\xc8\xe0\x00\x00\xbe455\xcb\xcb˱\x9a\xad\xac\xac\xac\xe7\xba\x1bq\xa8\xab\xabWVV\xa2\f\x88\x8d\x8de\xb1X\xb6\xb6\xb6]\xef\xa0<2X^^>i\xd2$\fÓ\x00\xc8\xe0\x00\x00\xbe\xb4\xb5\xb5{-\rs\x16:\nҟ\xa3\xac\xac\fê:\x82 \xda\xda\xda\xc5\xc5\xc5\xfc\x8e>}\xfa\xf4ԩS,\x16\x8bL&\x93\xc9\xe4\xc0\xc0\xc0\x9d;wR\xa9T~\xe3KJJ\xf0\xd8\a\x0e_\x12\xdf\x15\b\x00\xd0gtvv\xee߿\x1fe@rr\xb2\x9d\x9d\x1d\x82 s\xe6̉\x8e\x8enllD\x19\xec\xe2\xe2\x82\xed^h\xb5\xb5\xb5Ǐ\x1f\xe7y(##CII\xa9[\xba\xd3\xd2\xd2b0\x18\xfcfsrr\xc206\xc9 I\xe3S\x03\x00\xd07\xc8\xcaʲ\xd9l\x16\x8b\xc5oM\xa6\x89\x89\x89\x89\x89Ippp\xafS\xb1\xd9\xec\xf6\xf6vl\xeb\xe0Ç\x0f\xaf\xaf\xaf\xe7yh\xf6\xec\xd9\xcd\xcd͂O\xd5\xd6\xd6&\xf9\xcd\b\xc5\aU\x14\x00\x00\x1a++\xab\xd8\xd8X\xf1\xe7y\xf8𡕕\x95\xf8\xf3tc``\xf0\xe6\xcd\x1b\xf1\xe7\xb9q\xe3\x06\xbf\xde/\xdf3\xc8\xe0\x00\x004K\x96,y\xf9\xf2%Ϯ~\x82c2\x99Ϟ=C\xdf\xd6G4666\xb7o\xdf\x16s\x92\xd6\xd6֒\x92\x12l\xef\xb2J\x06dp\x00@/\xec\xed\xedCCCř\xe1\xeaի[\xb6l\xc1(\x9c\xff\x83@ \xacY\xb3F̖&\xe7ϟwtt\xc4*$I\x82\f\x0e\x00腮\xae\xae\x86\x86\x86\xc8}\xa9\x92\x93\x93\xd5\xd4\xd4\xf0{PoѢE\x1d\x1d\x1d\x19\x19\x19\xa2\x9d\x1e\x15\x15\xb5t\xe9R555l\xa3\x92\f\xc8\xe0\x00\x80\xdeYXXP(\x94Ǐ\x1f\v{\xe2\x93'OJJJV\xadZ\x85GT]6l\xd8\xf0\xf6\xed[\x11\x9a\xaeܽ{WNNn\xc1\x82\x05xD%\x01\xb0\xcb\x1a\x00@P\t\t\t\x85\x85\x85[\xb7n%\x91z\x7f\x8c\x8d\xc1`\x04\a\akkk[ZZJ 6\x04A\"##Y,ֺu\xeb\x04i\xa6\xd8\xd6\xd6\x16\x10\x10`hh\xc8o\xeb\xb8>\x0128\x00@\byyy!!!\xf3\xe6ͳ\xb6\xb6\xe6n\xdfʍ\xc5b\xc5\xc4ļ}\xfbv\xf3\xe6\xcdzzz\x92\f\xefݻw\xe1\xe1\xe1\x16\x16\x16˗/\xe7\x97\xc7\xe9tzDDDnn\xae\x83\x83\x83\x96\x96\x96$\xc3\xc3\x1cdp\x00\x80\xd0\xd2\xd2\xd2bbb\b\x04\xc2̙3ǌ\x19\xc3\xe9~E\xa5RKKK߿\x7f\xcfb\xb1\xac\xac\xac\x96,Y\"\xad\xf0\x12\x13\x13\xff\xfb\xdf\xff\xca\xc9\xc9\x19\x18\x18hhhhii\xb5\xb7\xb7WTT\x14\x16\x16fee\xc9\xc8\xc8\xd8\xd8\xd8̚5KZ\xe1a\b28\x00@DL&\xf3ݻw\x14\n\xa5\xb4\xb4\x94\xcdfkiiiii͚5K\x90\x1a\x8b\x04tvvfffR(\x14\n\x85B\"\x91\xb4\xb4\xb4ttt\xf4\xf5\xf5\xf9\xadN\xea\x8b \x83\x03\x00@_\xd5\x7f>\x8b\x00\x00`\xa0\x81\f\x0e\x00\x00}\x15dp\x00\x00\xe8\xab \x83\x03\x00@_\x05\x19\x1c\x00\x00\xfa*\xc8\xe0\x00\x00\xd0WA\x06\a\x00\x80\xbe\n28\x00\x00\xf4U\x90\xc1\x01\x00\xa0\xaf\x82\f\x0e\x00\x00}\x15dp\x00\x00\xe8\xab \x83\x03\x00@_\x05\x19\x1c\x00\x00\xfa*\xc8\xe0\x00\x00\xd0WA\x06\a\x00\x80\xbe\xea\xff\x01\xb4f=\x85As0\x03\x00\x00\x00\x00IEND\xaeB`\x82",
+
+	"content/methods.article": "Methods and interfaces\nThis lesson covers methods and interfaces, the constructs that define objects and their behavior.\n\nThe Go Authors\nhttp://golang.org\n\n* Methods\n\nGo does not have classes. However, you can define methods on struct types.\n\nThe _method_receiver_ appears in its own argument list between the `func` keyword and the method name.\n\n.play methods/methods.go\n\n* Methods continued\n\nYou can declare a method on _any_ type that is declared in your package, not just struct types.\n\nHowever, you cannot define a method on a type from another package (including built in types).\n\n.play methods/methods-continued.go\n\n* Methods with pointer receivers\n\nMethods can be associated with a named type or a pointer to a named type.\n\nWe just saw two `Abs` methods. One on the `*Vertex` pointer type and the other on the `MyFloat` value type.\n\nThere are two reasons to use a pointer receiver. First, to avoid copying the value on each method call (more efficient if the value type is a large struct). Second, so that the method can modify the value that its receiver points to.\n\nTry changing the declarations of the `Abs` and `Scale` methods to use `Vertex` as the receiver, instead of `*Vertex`.\n\nThe `Scale` method has no effect when `v` is a `Vertex`. `Scale` mutates `v`. When `v` is a value (non-pointer) type, the method sees a copy of the `Vertex` and cannot mutate the original value.\n\n`Abs` works either way. It only reads `v`. It doesn't matter whether it is reading the original value (through a pointer) or a copy of that value.\n\n.play methods/methods-with-pointer-receivers.go\n\n* Interfaces\n\nAn interface type is defined by a set of methods.\n\nA value of interface type can hold any value that implements those methods.\n\n*Note:* There is an error in the example code on line 22.\n`Vertex` (the value type) doesn't satisfy `Abser` because\t\nthe `Abs` method is defined only on `*Vertex` (the pointer type).\n\n.play methods/interfaces.go\n\n* Interfaces are satisfied implicitly\n\nA type implements an interface by implementing the methods.\nThere is no explicit declaration of intent; no \"implements\" keyword. \n\nImplicit interfaces decouple implementation packages from the packages that define the interfaces: neither depends on the other.\n\nIt also encourages the definition of precise interfaces, because you don't have to find every implementation and tag it with the new interface name.\n\n[[http://golang.org/pkg/io/][Package io]] defines `Reader` and `Writer`; you don't have to.\n\n.play methods/interfaces-are-satisfied-implicitly.go\n\n* Stringers\n\nOne of the most ubiquitous interfaces is [[//golang.org/pkg/fmt/#Stringer][`Stringer`]] defined by the [[//golang.org/pkg/fmt/][`fmt`]] package.\n\n\ttype Stringer interface {\n\t\tString() string\n\t}\n\nA `Stringer` is a type that can describe itself as a string. The `fmt` package\n(and many others) look for this interface to print values.\n\n.play methods/stringer.go\n\n* Exercise: Stringers\n\nMake the `IPAddr` type implement `fmt.Stringer` to print the address as\na dotted quad.\n\nFor instance, `IPAddr{1,`2,`3,`4}` should print as `\"1.2.3.4\"`.\n\n.play methods/exercise-stringer.go\n\n* Errors\n\nGo programs express error state with `error` values. \n\nThe `error` type is a built-in interface simliar to `fmt.Stringer`:\n\n\ttype error interface {\n\t\tError() string\n\t}\n\n(As with `fmt.Stringer`, the `fmt` package looks for the `error` interface when\nprinting values.)\n\nFunctions often return an `error` value, and calling code should handle errors\nby testing whether the error equals `nil`.\n\n\ti, err := strconv.Atoi(\"42\")\n\tif err != nil {\n\t\tfmt.Printf(\"couldn't convert number: %v\\n\", err)\n\t}\n\tfmt.Println(\"Converted integer:\", i)\n\nA nil `error` denotes success; a non-nil `error` denotes failure.\n\n.play methods/errors.go\n\n* Exercise: Errors\n\nCopy your `Sqrt` function from the earlier exercises and modify it to return an `error` value.\n\n`Sqrt` should return a non-nil error value when given a negative number, as it doesn't support complex numbers.\n\nCreate a new type\n\n\ttype ErrNegativeSqrt float64\n\nand make it an `error` by giving it a\n\n\tfunc (e ErrNegativeSqrt) Error() string\n\nmethod such that `ErrNegativeSqrt(-2).Error()` returns `\"cannot`Sqrt`negative`number:`-2\"`.\n\n*Note:* a call to `fmt.Sprint(e)` inside the `Error` method will send the program into an infinite loop. You can avoid this by converting `e` first: `fmt.Sprint(float64(e))`. Why?\n\nChange your `Sqrt` function to return an `ErrNegativeSqrt` value when given a negative number.\n\n.play methods/exercise-errors.go\n\n* Readers\n\nThe `io` package specifies the `io.Reader` interface,\nwhich represents the read end of a stream of data.\n\nThe Go standard library contains [[http://golang.org/search?q=Read#Global][many implementations]] of these interfaces, including files, network connections, compressors, ciphers, and others.\n\nThe `io.Reader` interface has a `Read` method:\n\n\tfunc (T) Read(b []byte) (n int, err error)\n\n`Read` populates the given byte slice with data and returns the number of bytes\npopulated and an error value. It returns an `io.EOF` error when the stream\nends.\n\nThe example code creates a \n[[//golang.org/pkg/strings/#Reader][`strings.Reader`]].\nand consumes its output 8 bytes at a time.\n\n.play methods/reader.go\n\n* Exercise: Readers\n\nImplement a `Reader` type that emits an infinite stream of the ASCII character\n`'A'`.\n\n.play methods/exercise-reader.go\n\n* Exercise: rot13Reader\n\nA common pattern is an [[http://golang.org/pkg/io/#Reader][io.Reader]] that wraps another `io.Reader`, modifying the stream in some way.\n\nFor example, the [[http://golang.org/pkg/compress/gzip/#NewReader][gzip.NewReader]] function takes an `io.Reader` (a stream of compressed data) and returns a `*gzip.Reader` that also implements `io.Reader` (a stream of the decompressed data).\n\nImplement a `rot13Reader` that implements `io.Reader` and reads from an `io.Reader`, modifying the stream by applying the [[http://en.wikipedia.org/wiki/ROT13][rot13]] substitution cipher to all alphabetical characters.\n\nThe `rot13Reader` type is provided for you.\nMake it an `io.Reader` by implementing its `Read` method.\n\n.play methods/exercise-rot-reader.go\n\n* Web servers\n\n[[http://golang.org/pkg/net/http/][Package http]] serves HTTP requests using any value that implements `http.Handler`:\n\n\tpackage http\n\n\ttype Handler interface {\n\t\tServeHTTP(w ResponseWriter, r *Request)\n\t}\n\nIn this example, the type `Hello` implements `http.Handler`.\n\nVisit [[http://localhost:4000/][http://localhost:4000/]] to see the greeting.\n\n#appengine: *Note:* This example won't run through the web-based tour user\n#appengine: interface. To try writing web servers you may want to\n#appengine: [[http://golang.org/doc/install/][Install Go]].\n\n.play methods/web-servers.go\n\n* Exercise: HTTP Handlers\n\nImplement the following types and define ServeHTTP methods on them. Register them to handle specific paths in your web server.\n\n\ttype String string\n\n\ttype Struct struct {\n\t\tGreeting string\n\t\tPunct    string\n\t\tWho      string\n\t}\n\nFor example, you should be able to register handlers using:\n\n\thttp.Handle(\"/string\", String(\"I'm a frayed knot.\"))\n\thttp.Handle(\"/struct\", &Struct{\"Hello\", \":\", \"Gophers!\"})\n\n#appengine: *Note:* This example won't run through the web-based tour user\n#appengine: interface. To try writing web servers you may want to\n#appengine: [[http://golang.org/doc/install/][Install Go]].\n\n.play methods/exercise-http-handlers.go\n\n* Images\n\n[[http://golang.org/pkg/image/#Image][Package image]] defines the `Image` interface:\n\n\tpackage image\n\n\ttype Image interface {\n\t\tColorModel() color.Model\n\t\tBounds() Rectangle\n\t\tAt(x, y int) color.Color\n\t}\n\n*Note*: the `Rectangle` return value of the `Bounds` method is actually an\n[[http://golang.org/pkg/image/#Rectangle][`image.Rectangle`]], as the\ndeclaration is inside package `image`.\n\n(See [[http://golang.org/pkg/image/#Image][the documentation]] for all the details.)\n\nThe `color.Color` and `color.Model` types are also interfaces, but we'll ignore that by using the predefined implementations `color.RGBA` and `color.RGBAModel`. These interfaces and types are specified by the [[http://golang.org/pkg/image/color/][image/color package]]\n\n.play methods/images.go\n\n* Exercise: Images\n\nRemember the picture generator you wrote earlier? Let's write another one, but this time it will return an implementation of `image.Image` instead of a slice of data.\n\nDefine your own `Image` type, implement [[http://golang.org/pkg/image/#Image][the necessary methods]], and call `pic.ShowImage`.\n\n`Bounds` should return a `image.Rectangle`, like `image.Rect(0,`0,`w,`h)`.\n\n`ColorModel` should return `color.RGBAModel`.\n\n`At` should return a color; the value `v` in the last picture generator corresponds to `color.RGBA{v,`v,`255,`255}` in this one.\n\n.play methods/exercise-images.go\n\n* Congratulations!\n\nYou finished this lesson!\n\nYou can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\n",
+
+	"content/methods/errors.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\ntype MyError struct {\n\tWhen time.Time\n\tWhat string\n}\n\nfunc (e *MyError) Error() string {\n\treturn fmt.Sprintf(\"at %v, %s\",\n\t\te.When, e.What)\n}\n\nfunc run() error {\n\treturn &MyError{\n\t\ttime.Now(),\n\t\t\"it didn't work\",\n\t}\n}\n\nfunc main() {\n\tif err := run(); err != nil {\n\t\tfmt.Println(err)\n\t}\n}\n",
+
+	"content/methods/exercise-errors.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n)\n\nfunc Sqrt(x float64) (float64, error) {\n\treturn 0, nil\n}\n\nfunc main() {\n\tfmt.Println(Sqrt(2))\n\tfmt.Println(Sqrt(-2))\n}\n",
+
+	"content/methods/exercise-http-handlers.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"log\"\n\t\"net/http\"\n)\n\nfunc main() {\n\t// your http.Handle calls here\n\tlog.Fatal(http.ListenAndServe(\"localhost:4000\", nil))\n}\n",
+
+	"content/methods/exercise-images.go": "// +build OMIT\n\npackage main\n\nimport \"golang.org/x/tour/pic\"\n\ntype Image struct{}\n\nfunc main() {\n\tm := Image{}\n\tpic.ShowImage(m)\n}\n",
+
+	"content/methods/exercise-reader.go": "package main\n\nimport \"golang.org/x/tour/reader\"\n\ntype MyReader struct{}\n\n// TODO: Add a Read([]byte) (int, error) method to MyReader.\n\nfunc main() {\n\treader.Validate(MyReader{})\n}\n",
+
+	"content/methods/exercise-rot-reader.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"io\"\n\t\"os\"\n\t\"strings\"\n)\n\ntype rot13Reader struct {\n\tr io.Reader\n}\n\nfunc main() {\n\ts := strings.NewReader(\"Lbh penpxrq gur pbqr!\")\n\tr := rot13Reader{s}\n\tio.Copy(os.Stdout, &r)\n}\n",
+
+	"content/methods/exercise-stringer.go": "package main\n\nimport \"fmt\"\n\ntype IPAddr [4]byte\n\n// TODO: Add a \"String() string\" method to IPAddr.\n\nfunc main() {\n\taddrs := map[string]IPAddr{\n\t\t\"loopback\":  {127, 0, 0, 1},\n\t\t\"googleDNS\": {8, 8, 8, 8},\n\t}\n\tfor n, a := range addrs {\n\t\tfmt.Printf(\"%v: %v\\n\", n, a)\n\t}\n}\n",
+
+	"content/methods/images.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"image\"\n)\n\nfunc main() {\n\tm := image.NewRGBA(image.Rect(0, 0, 100, 100))\n\tfmt.Println(m.Bounds())\n\tfmt.Println(m.At(0, 0).RGBA())\n}\n",
+
+	"content/methods/interfaces-are-satisfied-implicitly.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\ntype Reader interface {\n\tRead(b []byte) (n int, err error)\n}\n\ntype Writer interface {\n\tWrite(b []byte) (n int, err error)\n}\n\ntype ReadWriter interface {\n\tReader\n\tWriter\n}\n\nfunc main() {\n\tvar w Writer\n\n\t// os.Stdout implements Writer\n\tw = os.Stdout\n\n\tfmt.Fprintf(w, \"hello, writer\\n\")\n}\n",
+
+	"content/methods/interfaces.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\ntype Abser interface {\n\tAbs() float64\n}\n\nfunc main() {\n\tvar a Abser\n\tf := MyFloat(-math.Sqrt2)\n\tv := Vertex{3, 4}\n\n\ta = f  // a MyFloat implements Abser\n\ta = &v // a *Vertex implements Abser\n\n\t// In the following line, v is a Vertex (not *Vertex)\n\t// and does NOT implement Abser.\n\ta = v\n\n\tfmt.Println(a.Abs())\n}\n\ntype MyFloat float64\n\nfunc (f MyFloat) Abs() float64 {\n\tif f < 0 {\n\t\treturn float64(-f)\n\t}\n\treturn float64(f)\n}\n\ntype Vertex struct {\n\tX, Y float64\n}\n\nfunc (v *Vertex) Abs() float64 {\n\treturn math.Sqrt(v.X*v.X + v.Y*v.Y)\n}\n",
+
+	"content/methods/methods-continued.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\ntype MyFloat float64\n\nfunc (f MyFloat) Abs() float64 {\n\tif f < 0 {\n\t\treturn float64(-f)\n\t}\n\treturn float64(f)\n}\n\nfunc main() {\n\tf := MyFloat(-math.Sqrt2)\n\tfmt.Println(f.Abs())\n}\n",
+
+	"content/methods/methods-with-pointer-receivers.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\ntype Vertex struct {\n\tX, Y float64\n}\n\nfunc (v *Vertex) Scale(f float64) {\n\tv.X = v.X * f\n\tv.Y = v.Y * f\n}\n\nfunc (v *Vertex) Abs() float64 {\n\treturn math.Sqrt(v.X*v.X + v.Y*v.Y)\n}\n\nfunc main() {\n\tv := &Vertex{3, 4}\n\tv.Scale(5)\n\tfmt.Println(v, v.Abs())\n}\n",
+
+	"content/methods/methods.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\ntype Vertex struct {\n\tX, Y float64\n}\n\nfunc (v *Vertex) Abs() float64 {\n\treturn math.Sqrt(v.X*v.X + v.Y*v.Y)\n}\n\nfunc main() {\n\tv := &Vertex{3, 4}\n\tfmt.Println(v.Abs())\n}\n",
+
+	"content/methods/reader.go": "package main\n\nimport (\n\t\"fmt\"\n\t\"io\"\n\t\"strings\"\n)\n\nfunc main() {\n\tr := strings.NewReader(\"Hello, Reader!\")\n\n\tb := make([]byte, 8)\n\tfor {\n\t\tn, err := r.Read(b)\n\t\tfmt.Printf(\"n = %v err = %v b = %v\\n\", n, err, b)\n\t\tfmt.Printf(\"b[:n] = %q\\n\", b[:n])\n\t\tif err == io.EOF {\n\t\t\tbreak\n\t\t}\n\t}\n}\n",
+
+	"content/methods/stringer.go": "package main\n\nimport \"fmt\"\n\ntype Person struct {\n\tName string\n\tAge  int\n}\n\nfunc (p Person) String() string {\n\treturn fmt.Sprintf(\"%v (%v years)\", p.Name, p.Age)\n}\n\nfunc main() {\n\ta := Person{\"Arthur Dent\", 42}\n\tz := Person{\"Zaphod Beeblebrox\", 9001}\n\tfmt.Println(a, z)\n}\n",
+
+	"content/methods/web-servers.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"log\"\n\t\"net/http\"\n)\n\ntype Hello struct{}\n\nfunc (h Hello) ServeHTTP(\n\tw http.ResponseWriter,\n\tr *http.Request) {\n\tfmt.Fprint(w, \"Hello!\")\n}\n\nfunc main() {\n\tvar h Hello\n\terr := http.ListenAndServe(\"localhost:4000\", h)\n\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n}\n",
+
+	"content/moretypes.article": "More types: structs, slices, and maps.\nLearn how to define types based on existing ones: this lesson covers structs, arrays, slices, and maps.\n\nThe Go Authors\nhttp://golang.org\n\n* Pointers\n\nGo has pointers.\nA pointer holds the memory address of a variable.\n\nThe type `*T` is a pointer to a `T` value. Its zero value is `nil`.\n\n\tvar p *int\n\nThe `&` operator generates a pointer to its operand.\n\n\ti := 42\n\tp = &i\n\nThe `*` operator denotes the pointer's underlying value.\n\n\tfmt.Println(*p) // read i through the pointer p\n\t*p = 21         // set i through the pointer p\n\nThis is known as \"dereferencing\" or \"indirecting\".\n\nUnlike C, Go has no pointer arithmetic.\n\n.play moretypes/pointers.go\n\n* Structs\n\nA `struct` is a collection of fields.\n\n(And a `type` declaration does what you'd expect.)\n\n.play moretypes/structs.go\n\n* Struct Fields\n\nStruct fields are accessed using a dot.\n\n.play moretypes/struct-fields.go\n\n* Pointers to structs\n\nStruct fields can be accessed through a struct pointer.\n\nThe indirection through the pointer is transparent.\n\n.play moretypes/struct-pointers.go\n\n* Struct Literals\n\nA struct literal denotes a newly allocated struct value by listing the values of its fields.\n\nYou can list just a subset of fields by using the `Name:` syntax. (And the order of named fields is irrelevant.)\n\nThe special prefix `&` returns a pointer to the struct value.\n\n.play moretypes/struct-literals.go\n\n* Arrays\n\nThe type `[n]T` is an array of `n` values of type `T`.\n\nThe expression\n\n\tvar a [10]int\n\ndeclares a variable `a` as an array of ten integers.\n\nAn array's length is part of its type, so arrays cannot be resized.\nThis seems limiting, but don't worry;\nGo provides a convenient way of working with arrays.\n\n.play moretypes/array.go\n\n* Slices\n\nA slice points to an array of values and also includes a length.\n\n`[]T` is a slice with elements of type `T`.\n\n.play moretypes/slices.go\n\n* Slicing slices\n\nSlices can be re-sliced, creating a new slice value that points to the same array.\n\nThe expression\n\n\ts[lo:hi]\n\nevaluates to a slice of the elements from `lo` through `hi-1`, inclusive. Thus\n\n\ts[lo:lo]\n\nis empty and\n\n\ts[lo:lo+1]\n\nhas one element.\n\n.play moretypes/slicing-slices.go\n\n* Making slices\n\nSlices are created with the `make` function. It works by allocating a zeroed array and returning a slice that refers to that array:\n\n\ta := make([]int, 5)  // len(a)=5\n\nTo specify a capacity, pass a third argument to `make`:\n\n\tb := make([]int, 0, 5) // len(b)=0, cap(b)=5\n\n\tb = b[:cap(b)] // len(b)=5, cap(b)=5\n\tb = b[1:]      // len(b)=4, cap(b)=4\n\n.play moretypes/making-slices.go\n\n* Nil slices\n\nThe zero value of a slice is `nil`.\n\nA nil slice has a length and capacity of 0.\n\n.play moretypes/nil-slices.go\n\n* Adding elements to a slice\n\nIt is common to append new elements to a slice, and so Go provides a built-in\n`append` function. The [[http://golang.org/pkg/builtin/#append][documentation]]\nof the built-in package describes `append`.\n\n\tfunc append(s []T, vs ...T) []T\n\nThe first parameter `s` of `append` is a slice of type `T`, and the rest are\n`T` values to append to the slice.\n\nThe resulting value of `append` is a slice containing all the elements of the\noriginal slice plus the provided values.\n\nIf the backing array of `s` is too small to fit all the given values a bigger\narray will be allocated. The returned slice will point to the newly allocated\narray.\n\n(To learn more about slices, read the [[http://golang.org/doc/articles/slices_usage_and_internals.html][Slices: usage and internals]] article.)\n\n.play moretypes/append.go\n\n* Range\n\nThe `range` form of the `for` loop iterates over a slice or map.\n\n.play moretypes/range.go\n\n* Range continued\n\nYou can skip the index or value by assigning to `_`.\n\nIf you only want the index, drop the \", value\" entirely.\n\n.play moretypes/range-continued.go\n\n* Exercise: Slices\n\nImplement `Pic`. It should return a slice of length `dy`, each element of which is a slice of `dx` 8-bit unsigned integers. When you run the program, it will display your picture, interpreting the integers as grayscale (well, bluescale) values.\n\nThe choice of image is up to you. Interesting functions include `(x+y)/2`, `x*y`, and `x^y` (to compute the latter function, use [[http://golang.org/pkg/math/#Pow][`math.Pow`]]).\n\n(You need to use a loop to allocate each `[]uint8` inside the `[][]uint8`.)\n\n(Use `uint8(intValue)` to convert between types.)\n\n.play moretypes/exercise-slices.go\n\n* Maps\n\nA map maps keys to values.\n\nMaps must be created with `make` (not `new`) before use; the `nil` map is empty and cannot be assigned to.\n\n.play moretypes/maps.go\n\n* Map literals\n\nMap literals are like struct literals, but the keys are required.\n\n.play moretypes/map-literals.go\n\n* Map literals continued\n\nIf the top-level type is just a type name, you can omit it from the elements of the literal.\n\n.play moretypes/map-literals-continued.go\n\n* Mutating Maps\n\nInsert or update an element in map `m`:\n\n\tm[key] = elem\n\nRetrieve an element:\n\n\telem = m[key]\n\nDelete an element:\n\n\tdelete(m, key)\n\nTest that a key is present with a two-value assignment:\n\n\telem, ok = m[key]\n\nIf `key` is in `m`, `ok` is `true`. If not, `ok` is `false` and `elem` is the zero value for the map's element type.\n\nSimilarly, when reading from a map if the key is not present the result is the zero value for the map's element type.\n\n.play moretypes/mutating-maps.go\n\n* Exercise: Maps\n\nImplement `WordCount`.  It should return a map of the counts of each “word” in the string `s`. The `wc.Test` function runs a test suite against the provided function and prints success or failure.\n\nYou might find [[http://golang.org/pkg/strings/#Fields][strings.Fields]] helpful.\n\n.play moretypes/exercise-maps.go\n\n* Function values\n\nFunctions are values too.\n\n.play moretypes/function-values.go\n\n* Function closures\n\nGo functions may be closures. A closure is a function value that references variables from outside its body. The function may access and assign to the referenced variables; in this sense the function is \"bound\" to the variables.\n\nFor example, the `adder` function returns a closure. Each closure is bound to its own `sum` variable.\n\n.play moretypes/function-closures.go\n\n* Exercise: Fibonacci closure\n\nLet's have some fun with functions.\n\nImplement a `fibonacci` function that returns a function (a closure) that returns successive fibonacci numbers.\n\n.play moretypes/exercise-fibonacci-closure.go\n\n* Congratulations!\n\nYou finished this lesson!\n\nYou can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\n",
+
+	"content/moretypes/append.go": "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tvar a []int\n\tprintSlice(\"a\", a)\n\n\t// append works on nil slices.\n\ta = append(a, 0)\n\tprintSlice(\"a\", a)\n\n\t// the slice grows as needed.\n\ta = append(a, 1)\n\tprintSlice(\"a\", a)\n\n\t// we can add more than one element at a time.\n\ta = append(a, 2, 3, 4)\n\tprintSlice(\"a\", a)\n}\n\nfunc printSlice(s string, x []int) {\n\tfmt.Printf(\"%s len=%d cap=%d %v\\n\",\n\t\ts, len(x), cap(x), x)\n}\n",
+
+	"content/moretypes/array.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tvar a [2]string\n\ta[0] = \"Hello\"\n\ta[1] = \"World\"\n\tfmt.Println(a[0], a[1])\n\tfmt.Println(a)\n}\n",
+
+	"content/moretypes/exercise-fibonacci-closure.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\n// fibonacci is a function that returns\n// a function that returns an int.\nfunc fibonacci() func() int {\n}\n\nfunc main() {\n\tf := fibonacci()\n\tfor i := 0; i < 10; i++ {\n\t\tfmt.Println(f())\n\t}\n}\n",
+
+	"content/moretypes/exercise-maps.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"golang.org/x/tour/wc\"\n)\n\nfunc WordCount(s string) map[string]int {\n\treturn map[string]int{\"x\": 1}\n}\n\nfunc main() {\n\twc.Test(WordCount)\n}\n",
+
+	"content/moretypes/exercise-slices.go": "// +build OMIT\n\npackage main\n\nimport \"golang.org/x/tour/pic\"\n\nfunc Pic(dx, dy int) [][]uint8 {\n}\n\nfunc main() {\n\tpic.Show(Pic)\n}\n",
+
+	"content/moretypes/function-closures.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc adder() func(int) int {\n\tsum := 0\n\treturn func(x int) int {\n\t\tsum += x\n\t\treturn sum\n\t}\n}\n\nfunc main() {\n\tpos, neg := adder(), adder()\n\tfor i := 0; i < 10; i++ {\n\t\tfmt.Println(\n\t\t\tpos(i),\n\t\t\tneg(-2*i),\n\t\t)\n\t}\n}\n",
+
+	"content/moretypes/function-values.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nfunc main() {\n\thypot := func(x, y float64) float64 {\n\t\treturn math.Sqrt(x*x + y*y)\n\t}\n\n\tfmt.Println(hypot(3, 4))\n}\n",
+
+	"content/moretypes/making-slices.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\ta := make([]int, 5)\n\tprintSlice(\"a\", a)\n\tb := make([]int, 0, 5)\n\tprintSlice(\"b\", b)\n\tc := b[:2]\n\tprintSlice(\"c\", c)\n\td := c[2:5]\n\tprintSlice(\"d\", d)\n}\n\nfunc printSlice(s string, x []int) {\n\tfmt.Printf(\"%s len=%d cap=%d %v\\n\",\n\t\ts, len(x), cap(x), x)\n}\n",
+
+	"content/moretypes/map-literals-continued.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tLat, Long float64\n}\n\nvar m = map[string]Vertex{\n\t\"Bell Labs\": {40.68433, -74.39967},\n\t\"Google\":    {37.42202, -122.08408},\n}\n\nfunc main() {\n\tfmt.Println(m)\n}\n",
+
+	"content/moretypes/map-literals.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tLat, Long float64\n}\n\nvar m = map[string]Vertex{\n\t\"Bell Labs\": Vertex{\n\t\t40.68433, -74.39967,\n\t},\n\t\"Google\": Vertex{\n\t\t37.42202, -122.08408,\n\t},\n}\n\nfunc main() {\n\tfmt.Println(m)\n}\n",
+
+	"content/moretypes/maps.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tLat, Long float64\n}\n\nvar m map[string]Vertex\n\nfunc main() {\n\tm = make(map[string]Vertex)\n\tm[\"Bell Labs\"] = Vertex{\n\t\t40.68433, -74.39967,\n\t}\n\tfmt.Println(m[\"Bell Labs\"])\n}\n",
+
+	"content/moretypes/mutating-maps.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tm := make(map[string]int)\n\n\tm[\"Answer\"] = 42\n\tfmt.Println(\"The value:\", m[\"Answer\"])\n\n\tm[\"Answer\"] = 48\n\tfmt.Println(\"The value:\", m[\"Answer\"])\n\n\tdelete(m, \"Answer\")\n\tfmt.Println(\"The value:\", m[\"Answer\"])\n\n\tv, ok := m[\"Answer\"]\n\tfmt.Println(\"The value:\", v, \"Present?\", ok)\n}\n",
+
+	"content/moretypes/nil-slices.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tvar z []int\n\tfmt.Println(z, len(z), cap(z))\n\tif z == nil {\n\t\tfmt.Println(\"nil!\")\n\t}\n}\n",
+
+	"content/moretypes/pointers.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\ti, j := 42, 2701\n\n\tp := &i         // point to i\n\tfmt.Println(*p) // read i through the pointer\n\t*p = 21         // set i through the pointer\n\tfmt.Println(i)  // see the new value of i\n\n\tp = &j         // point to j\n\t*p = *p / 37   // divide j through the pointer\n\tfmt.Println(j) // see the new value of j\n}\n",
+
+	"content/moretypes/range-continued.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tpow := make([]int, 10)\n\tfor i := range pow {\n\t\tpow[i] = 1 << uint(i)\n\t}\n\tfor _, value := range pow {\n\t\tfmt.Printf(\"%d\\n\", value)\n\t}\n}\n",
+
+	"content/moretypes/range.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nvar pow = []int{1, 2, 4, 8, 16, 32, 64, 128}\n\nfunc main() {\n\tfor i, v := range pow {\n\t\tfmt.Printf(\"2**%d = %d\\n\", i, v)\n\t}\n}\n",
+
+	"content/moretypes/slices.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tp := []int{2, 3, 5, 7, 11, 13}\n\tfmt.Println(\"p ==\", p)\n\n\tfor i := 0; i < len(p); i++ {\n\t\tfmt.Printf(\"p[%d] == %d\\n\", i, p[i])\n\t}\n}\n",
+
+	"content/moretypes/slicing-slices.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tp := []int{2, 3, 5, 7, 11, 13}\n\tfmt.Println(\"p ==\", p)\n\tfmt.Println(\"p[1:4] ==\", p[1:4])\n\n\t// missing low index implies 0\n\tfmt.Println(\"p[:3] ==\", p[:3])\n\n\t// missing high index implies len(s)\n\tfmt.Println(\"p[4:] ==\", p[4:])\n}\n",
+
+	"content/moretypes/struct-fields.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tX int\n\tY int\n}\n\nfunc main() {\n\tv := Vertex{1, 2}\n\tv.X = 4\n\tfmt.Println(v.X)\n}\n",
+
+	"content/moretypes/struct-literals.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tX, Y int\n}\n\nvar (\n\tv1 = Vertex{1, 2}  // has type Vertex\n\tv2 = Vertex{X: 1}  // Y:0 is implicit\n\tv3 = Vertex{}      // X:0 and Y:0\n\tp  = &Vertex{1, 2} // has type *Vertex\n)\n\nfunc main() {\n\tfmt.Println(v1, p, v2, v3)\n}\n",
+
+	"content/moretypes/struct-pointers.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tX int\n\tY int\n}\n\nfunc main() {\n\tv := Vertex{1, 2}\n\tp := &v\n\tp.X = 1e9\n\tfmt.Println(v)\n}\n",
+
+	"content/moretypes/structs.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\ntype Vertex struct {\n\tX int\n\tY int\n}\n\nfunc main() {\n\tfmt.Println(Vertex{1, 2})\n}\n",
+
+	"content/welcome.article": "Welcome!\nLearn how to use this tour: including how to navigate the different lessons and how to run code.\n\nThe Go Authors\nhttp://golang.org\n\n* Hello, 世界\n\n.html _tr/div_begin_en.html\n\nWelcome to a tour of the [[http://golang.org/][Go programming language]].\n\nThe tour is divided into a list of modules that you can\naccess by clicking on\n[[javascript:highlight(\".logo\")][A Tour of Go]] on the top left of the page.\n\nYou can also view the table of contents at any time by clicking on the [[javascript:highlightAndClick(\".nav\")][menu]] on the top right of the page.\n\nThroughout the tour you will find a series of slides and exercises for you\nto complete.\n\nYou can navigate through them using\n\n- [[javascript:highlight(\".prev-page\")][\"previous\"]] or `PageUp` to go to the previous page,\n\n- [[javascript:highlight(\".next-page\")][\"next\"]] or `PageDown` to go to the next page.\n\nThe tour is interactive. Click the\n[[javascript:highlightAndClick(\"#run\")][Run]] button now \n(or type `shift-enter`) to compile and run the program on\n#appengine: a remote server.\nyour computer.\nThe result is displayed below the code.\n\nThese example programs demonstrate different aspects of Go. The programs in the tour are meant to be starting points for your own experimentation.\n\nEdit the program and run it again.\n\nNote that when you click on [[javascript:highlightAndClick(\"#format\")][Format]] or `ctrl-enter`\nthe text in the editor is formatted using the\n[[http://golang.org/cmd/gofmt/][gofmt]] tool. You can switch on and off syntax highlighting\nthe clicking on [[javascript:highlightAndClick(\".syntax-checkbox\")][syntax]] button.\n\nWhen you're ready to move on, click the [[javascript:highlightAndClick(\".next-page\")][right arrow]] below or type the `PageDown` key.\n\n.html _tr/div_end.html\n\n.html _tr/div_begin_zh_CN.html\n\n.title 你好，世界\n\n欢迎来到 [[http://golang.org/][Go 编程语言]]之旅。\n\n本教程分为若干模块，点击页面左上角的\n[[javascript:highlight(\".logo\")][Go 语言之旅]]即可访问。\n\n你也可以随时点击页面右上角的[[javascript:highlightAndClick(\".nav\")][菜单]]来查看目录。\n\n在本教程中，你会看到一系列的幻灯片和需要你完成的练习。\n\n你可以用\n\n- [[javascript:highlight(\".prev-page\")][“上一页”]]或 `PageUp` 键转到上一页，\n\n- [[javascript:highlight(\".next-page\")][“下一页”]]或 `PageDown` 键转到下一页。\n\n本教程是交互式的。现在点击\n[[javascript:highlightAndClick(\"#run\")][运行]]按钮\n（或按 `shift-enter`），就会编译程序并在\n#appengine: 远程服务器上运行它。\n你的电脑上运行它。\n结果会显示在代码下方。\n\n这些示例程序展示了 Go 的各个方面。教程中的程序只是你自己动手实验的起点。\n\n编辑程序并再次运行它。\n\n注意，当你点击[[javascript:highlightAndClick(\"#format\")][格式化]]或按 `ctrl-enter` 时，\n编辑器中的文本会用 [[http://golang.org/cmd/gofmt/][gofmt]] 工具进行格式化。\n点击[[javascript:highlightAndClick(\".syntax-checkbox\")][语法]]按钮可以打开或关闭语法高亮。\n\n准备好继续之后，点击下方的[[javascript:highlightAndClick(\".next-page\")][右箭头]]或按 `PageDown` 键。\n\n.html _tr/div_end.html\n\n.play welcome/hello.go\n\n* Go local\n\n.html _tr/div_begin_en.html\n\nThe tour is available in other languages:\n\n- [[http://go-tour-br.appspot.com/][Brazilian Portuguese — Português do Brasil]]\n- [[http://go-tour-ca.appspot.com/][Catalan — Català]]\n- [[http://go-tour-de1.appspot.com/][German — Deutsch]]\n- [[http://go-tour-es.appspot.com/][Spanish — Español]]\n- [[http://go-tour-fr.appspot.com/][French — Français]]\n- [[http://go-tour-he.appspot.com/][Hebrew — עִבְרִית]]\n- [[http://go-tour-jp.appspot.com/][Japanese — 日本語]]\n- [[http://go-tour-kr.appspot.com/][Korean — 한국어]]\n- [[http://go-tour-ro.appspot.com/][Romanian — Română]]\n- [[http://tour.go-zh.org/][Simplified Chinese — 中文（简体）]]\n- [[http://go-tour-zh-tw.appspot.com/][Traditional Chinese — 中文（繁體）]]\n\nClick the [[javascript:highlightAndClick(\".next-page\")][\"next\"]] button or type `PageDown` to continue.\n\n#appengine: * The Go Playground\n#appengine: \n#appengine: This tour is built atop the [[http://play.golang.org/][Go Playground]], a\n#appengine: web service that runs on [[http://golang.org/][golang.org]]'s servers.\n#appengine: \n#appengine: The service receives a Go program, compiles, links, and runs the program inside\n#appengine: a sandbox, then returns the output.\n#appengine: \n#appengine: There are limitations to the programs that can be run in the playground: \n#appengine: \n#appengine: - In the playground the time begins at 2009-11-10 23:00:00 UTC (determining the sigificance of this date is an exercise for the reader). This makes it easier to cache programs by giving them deterministic output.\n#appengine: \n#appengine: - There are also limits on execution time and on CPU and memory usage, and the program cannot access external network hosts. \n#appengine: \n#appengine: The playground uses the latest stable release of Go.\n#appengine: \n#appengine: Read \"[[http://blog.golang.org/playground][Inside the Go Playground]]\" to learn more.\n#appengine: \n#appengine: .play welcome/sandbox.go\n\n.html _tr/div_end.html\n\n.html _tr/div_begin_zh_CN.html\n\n.title Go 本地化\n\n本教程还有其它语言的版本：\n\n- [[http://go-tour-br.appspot.com/][巴西葡萄牙语 — Português do Brasil]]\n- [[http://go-tour-ca.appspot.com/][加泰罗尼亚语 — Català]]\n- [[http://go-tour-de1.appspot.com/][德语 — Deutsch]]\n- [[http://go-tour-es.appspot.com/][西班牙语 — Español]]\n- [[http://go-tour-fr.appspot.com/][法语 — Français]]\n- [[http://go-tour-he.appspot.com/][希伯来语 — עִבְרִית]]\n- [[http://go-tour-jp.appspot.com/][日语 — 日本語]]\n- [[http://go-tour-kr.appspot.com/][韩语 — 한국어]]\n- [[http://go-tour-ro.appspot.com/][罗马尼亚语 — Română]]\n- [[http://tour.go-zh.org/][简体中文 — 中文（简体）]]\n- [[http://go-tour-zh-tw.appspot.com/][繁体中文 — 中文（繁體）]]\n\n点击[[javascript:highlightAndClick(\".next-page\")][“下一页”]]按钮或按 `PageDown` 键继续。\n\n#appengine: * The Go Playground\n#appengine: \n#appengine: This tour is built atop the [[http://play.golang.org/][Go Playground]], a\n#appengine: web service that runs on [[http://golang.org/][golang.org]]'s servers.\n#appengine: \n#appengine: The service receives a Go program, compiles, links, and runs the program inside\n#appengine: a sandbox, then returns the output.\n#appengine: \n#appengine: There are limitations to the programs that can be run in the playground: \n#appengine: \n#appengine: - In the playground the time begins at 2009-11-10 23:00:00 UTC (determining the sigificance of this date is an exercise for the reader). This makes it easier to cache programs by giving them deterministic output.\n#appengine: \n#appengine: - There are also limits on execution time and on CPU and memory usage, and the program cannot access external network hosts. \n#appengine: \n#appengine: The playground uses the latest stable release of Go.\n#appengine: \n#appengine: Read \"[[http://blog.golang.org/playground][Inside the Go Playground]]\" to learn more.\n#appengine: \n#appengine: .play welcome/sandbox.go\n\n.html _tr/div_end.html\n\n* Congratulations\n\n.html _tr/div_begin_en.html\n\nYou've finished the first module of the tour!\n\nNow click on [[javascript:highlightAndClick(\".logo\")][A Tour of Go]] to find out what else\nyou can learn about Go, or go directly to the [[javascript:click('.next-page')][next lesson]].\n\n.html _tr/div_end.html\n\n.html _tr/div_begin_zh_CN.html\n\n.title 恭喜\n\n你已经完成了本教程的第一个模块！\n\n现在点击[[javascript:highlightAndClick(\".logo\")][Go 语言之旅]]看看还能学到 Go 的哪些知识，\n或者直接进入[[javascript:click('.next-page')][下一课]]。\n\n.html _tr/div_end.html\n",
+
+	"content/welcome/hello.go": "// +build OMIT\n\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello, 世界\")\n}\n",
+
+	"content/welcome/sandbox.go": "// +build OMIT\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"time\"\n)\n\nfunc main() {\n\tfmt.Println(\"Welcome to the playground!\")\n\n\tfmt.Println(\"The time is\", time.Now())\n}\n",
+
+	"gopath/README": "This directory is a Go workspace (GOPATH entry) holding the packages that the\nprograms of the tour import besides the standard library:\n\n\tgolang.org/x/tour/pic\n\tgolang.org/x/tour/reader\n\tgolang.org/x/tour/tree\n\tgolang.org/x/tour/wc\n\nThe tour server builds the programs against this workspace, so they run\nwithout downloading anything.\n",
+
+	"gopath/src/golang.org/x/tour/pic/pic.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// Package pic implements the picture display used by the exercises of the\n// tour. Programs print images as a line starting with \"IMAGE:\" followed by\n// the base64 encoding of a PNG file, which the tour front end displays.\npackage pic\n\nimport (\n\t\"bytes\"\n\t\"encoding/base64\"\n\t\"fmt\"\n\t\"image\"\n\t\"image/png\"\n)\n\n// Show displays a picture defined by the function f, which is called with\n// the width and height of the picture and returns a slice of rows of\n// grayscale values. The values are shown in shades of blue.\nfunc Show(f func(dx, dy int) [][]uint8) {\n\tconst (\n\t\tdx = 256\n\t\tdy = 256\n\t)\n\tdata := f(dx, dy)\n\tm := image.NewNRGBA(image.Rect(0, 0, dx, dy))\n\tfor y := 0; y < dy; y++ {\n\t\tfor x := 0; x < dx; x++ {\n\t\t\tv := data[y][x]\n\t\t\ti := y*m.Stride + x*4\n\t\t\tm.Pix[i] = v\n\t\t\tm.Pix[i+1] = v\n\t\t\tm.Pix[i+2] = 255\n\t\t\tm.Pix[i+3] = 255\n\t\t}\n\t}\n\tShowImage(m)\n}\n\n// ShowImage displays the image m.\nfunc ShowImage(m image.Image) {\n\tvar buf bytes.Buffer\n\tif err := png.Encode(&buf, m); err != nil {\n\t\tpanic(err)\n\t}\n\tfmt.Println(\"IMAGE:\" + base64.StdEncoding.EncodeToString(buf.Bytes()))\n}\n",
+
+	"gopath/src/golang.org/x/tour/reader/validate.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// Package reader checks the Reader exercise of the tour.\npackage reader\n\nimport (\n\t\"fmt\"\n\t\"io\"\n\t\"os\"\n)\n\n// Validate reads from r and checks that it yields an endless stream of the\n// byte 'A'. It prints \"OK!\" if so and describes the problem otherwise.\nfunc Validate(r io.Reader) {\n\tb := make([]byte, 1024, 2048)\n\ti, o := 0, 0\n\tfor ; i < 1<<20 && o < 1<<20; i++ { // test 1mb\n\t\tn, err := r.Read(b)\n\t\tfor i, v := range b[:n] {\n\t\t\tif v != 'A' {\n\t\t\t\tfmt.Fprintf(os.Stderr, \"got byte %x at offset %v, want 'A'\\n\", v, o+i)\n\t\t\t\treturn\n\t\t\t}\n\t\t}\n\t\to += n\n\t\tif err != nil {\n\t\t\tfmt.Fprintf(os.Stderr, \"read error: %v\\n\", err)\n\t\t\treturn\n\t\t}\n\t}\n\tif o == 0 {\n\t\tfmt.Fprintf(os.Stderr, \"read zero bytes after %d Read calls\\n\", i)\n\t\treturn\n\t}\n\tfmt.Println(\"OK!\")\n}\n",
+
+	"gopath/src/golang.org/x/tour/tree/tree.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// Package tree provides the binary trees of the Equivalent Binary Trees\n// exercise of the tour.\npackage tree\n\nimport (\n\t\"fmt\"\n\t\"math/rand\"\n)\n\n// A Tree is a binary tree with integer values.\ntype Tree struct {\n\tLeft  *Tree\n\tValue int\n\tRight *Tree\n}\n\n// New returns a new, random binary tree holding the values k, 2k, ..., 10k.\nfunc New(k int) *Tree {\n\tvar t *Tree\n\tfor _, v := range rand.Perm(10) {\n\t\tt = insert(t, (1+v)*k)\n\t}\n\treturn t\n}\n\nfunc insert(t *Tree, v int) *Tree {\n\tif t == nil {\n\t\treturn &Tree{nil, v, nil}\n\t}\n\tif v < t.Value {\n\t\tt.Left = insert(t.Left, v)\n\t} else {\n\t\tt.Right = insert(t.Right, v)\n\t}\n\treturn t\n}\n\nfunc (t *Tree) String() string {\n\tif t == nil {\n\t\treturn \"()\"\n\t}\n\ts := \"\"\n\tif t.Left != nil {\n\t\ts += t.Left.String() + \" \"\n\t}\n\ts += fmt.Sprint(t.Value)\n\tif t.Right != nil {\n\t\ts += \" \" + t.Right.String()\n\t}\n\treturn \"(\" + s + \")\"\n}\n",
+
+	"gopath/src/golang.org/x/tour/wc/wc.go": "// Copyright 2016 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// Package wc checks the Maps exercise of the tour.\npackage wc\n\nimport \"fmt\"\n\n// Test runs a test suite against f, a function that counts the words in a\n// string, and prints the results.\nfunc Test(f func(string) map[string]int) {\n\tok := true\n\tfor _, c := range testCases {\n\t\tgot := f(c.in)\n\t\tif len(c.want) != len(got) {\n\t\t\tok = false\n\t\t} else {\n\t\t\tfor k := range c.want {\n\t\t\t\tif c.want[k] != got[k] {\n\t\t\t\t\tok = false\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t\tif !ok {\n\t\t\tfmt.Printf(\"FAIL\\n f(%q) =\\n  %#v\\n want:\\n  %#v\\n\", c.in, got, c.want)\n\t\t\tbreak\n\t\t}\n\t\tfmt.Printf(\"PASS\\n f(%q) =\\n  %#v\\n\", c.in, got)\n\t}\n}\n\nvar testCases = []struct {\n\tin   string\n\twant map[string]int\n}{\n\t{\"I am learning Go!\", map[string]int{\n\t\t\"I\": 1, \"am\": 1, \"learning\": 1, \"Go!\": 1,\n\t}},\n\t{\"The quick brown fox jumped over the lazy dog.\", map[string]int{\n\t\t\"The\": 1, \"quick\": 1, \"brown\": 1, \"fox\": 1, \"jumped\": 1,\n\t\t\"over\": 1, \"the\": 1, \"lazy\": 1, \"dog.\": 1,\n\t}},\n\t{\"I ate a donut. Then I ate another donut.\", map[string]int{\n\t\t\"I\": 2, \"ate\": 2, \"a\": 1, \"donut.\": 2, \"Then\": 1, \"another\": 1,\n\t}},\n\t{\"A man a plan a canal panama.\", map[string]int{\n\t\t\"A\": 1, \"man\": 1, \"a\": 2, \"plan\": 1, \"canal\": 1, \"panama.\": 1,\n\t}},\n}\n",
+
+	"i18n/content/basics.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: basics.article:7\nmsgctxt \"basics/1/title\"\nmsgid \"Packages\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:7\nmsgctxt \"basics/1/body\"\nmsgid \"\"\n\"Every Go program is made up of packages.\\n\"\n\"\\n\"\n\"Programs start running in package `main`.\\n\"\n\"\\n\"\n\"This program is using the packages with import paths `\\\"fmt\\\"` and `\\\"math/rand\\\"`.\\n\"\n\"\\n\"\n\"By convention, the package name is the same as the last element of the import path. For instance, the `\\\"math/rand\\\"` package comprises files that begin with the statement `package`rand`.\\n\"\n\"\\n\"\n\"#appengine: *Note:* the environment in which these programs are executed is\\n\"\n\"#appengine: deterministic, so `rand.Intn` will always return the same number.\\n\"\n\"#appengine:\\n\"\n\"#appengine: (To see a different number, seed the number generator; see [[http://golang.org/pkg/math/rand/#Seed][`rand.Seed`]].)\"\nmsgstr \"\"\n\n#: basics.article:24\nmsgctxt \"basics/2/title\"\nmsgid \"Imports\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:24\nmsgctxt \"basics/2/body\"\nmsgid \"\"\n\"This code groups the imports into a parenthesized, \\\"factored\\\" import statement. \\n\"\n\"\\n\"\n\"You can also write multiple import statements, like:\\n\"\n\"\\n\"\n\"\\timport \\\"fmt\\\"\\n\"\n\"\\timport \\\"math\\\"\\n\"\n\"\\n\"\n\"But it is good style to use the factored import statement.\"\nmsgstr \"\"\n\n#: basics.article:37\nmsgctxt \"basics/3/title\"\nmsgid \"Exported names\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:37\nmsgctxt \"basics/3/body\"\nmsgid \"\"\n\"After importing a package, you can refer to the names it exports.\\n\"\n\"\\n\"\n\"In Go, a name is exported if it begins with a capital letter.\\n\"\n\"\\n\"\n\"`Foo` is an exported name, as is `FOO`. The name `foo` is not exported.\\n\"\n\"\\n\"\n\"Run the code. Then rename `math.pi` to `math.Pi` and try it again.\"\nmsgstr \"\"\n\n#: basics.article:49\nmsgctxt \"basics/4/title\"\nmsgid \"Functions\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:49\nmsgctxt \"basics/4/body\"\nmsgid \"\"\n\"A function can take zero or more arguments.\\n\"\n\"\\n\"\n\"In this example, `add` takes two parameters of type `int`.\\n\"\n\"\\n\"\n\"Notice that the type comes _after_ the variable name.\\n\"\n\"\\n\"\n\"(For more about why types look the way they do, see the [[http://golang.org/doc/articles/gos_declaration_syntax.html][article on Go's declaration syntax]].)\"\nmsgstr \"\"\n\n#: basics.article:61\nmsgctxt \"basics/5/title\"\nmsgid \"Functions continued\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:61\nmsgctxt \"basics/5/body\"\nmsgid \"\"\n\"When two or more consecutive named function parameters share a type, you can omit the type from all but the last.\\n\"\n\"\\n\"\n\"In this example, we shortened\\n\"\n\"\\n\"\n\"\\tx int, y int\\n\"\n\"\\n\"\n\"to\\n\"\n\"\\n\"\n\"\\tx, y int\"\nmsgstr \"\"\n\n#: basics.article:75\nmsgctxt \"basics/6/title\"\nmsgid \"Multiple results\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:75\nmsgctxt \"basics/6/body\"\nmsgid \"\"\n\"A function can return any number of results.\\n\"\n\"\\n\"\n\"The `swap` function returns two strings.\"\nmsgstr \"\"\n\n#: basics.article:83\nmsgctxt \"basics/7/title\"\nmsgid \"Named return values\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:83\nmsgctxt \"basics/7/body\"\nmsgid \"\"\n\"Go's return values may be named and act just like variables.\\n\"\n\"\\n\"\n\"These names should be used to document the meaning of the return values.\\n\"\n\"\\n\"\n\"A `return` statement without arguments returns the current values of the results. This is known as a \\\"naked\\\" return.\\n\"\n\"\\n\"\n\"Naked return statements should be used only in short function, as with the example shown here. They can harm readability in longer functions.\"\nmsgstr \"\"\n\n#: basics.article:95\nmsgctxt \"basics/8/title\"\nmsgid \"Variables\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:95\nmsgctxt \"basics/8/body\"\nmsgid \"\"\n\"The `var` statement declares a list of variables; as in function argument lists, the type is last.\\n\"\n\"\\n\"\n\"A `var` statement can be at package or function level. We see both in this example.\"\nmsgstr \"\"\n\n#: basics.article:103\nmsgctxt \"basics/9/title\"\nmsgid \"Variables with initializers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:103\nmsgctxt \"basics/9/body\"\nmsgid \"\"\n\"A var declaration can include initializers, one per variable.\\n\"\n\"\\n\"\n\"If an initializer is present, the type can be omitted; the variable will take the type of the initializer.\"\nmsgstr \"\"\n\n#: basics.article:111\nmsgctxt \"basics/10/title\"\nmsgid \"Short variable declarations\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:111\nmsgctxt \"basics/10/body\"\nmsgid \"\"\n\"Inside a function, the `:=` short assignment statement can be used in place of a `var` declaration with implicit type.\\n\"\n\"\\n\"\n\"Outside a function, every statement begins with a keyword (`var`, `func`, and so on) and so the `:=` construct is not available.\"\nmsgstr \"\"\n\n#: basics.article:119\nmsgctxt \"basics/11/title\"\nmsgid \"Basic types\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:119\nmsgctxt \"basics/11/body\"\nmsgid \"\"\n\"Go's basic types are\\n\"\n\"\\n\"\n\"\\tbool\\n\"\n\"\\n\"\n\"\\tstring\\n\"\n\"\\n\"\n\"\\tint  int8  int16  int32  int64\\n\"\n\"\\tuint uint8 uint16 uint32 uint64 uintptr\\n\"\n\"\\n\"\n\"\\tbyte // alias for uint8\\n\"\n\"\\n\"\n\"\\trune // alias for int32\\n\"\n\"\\t     // represents a Unicode code point\\n\"\n\"\\n\"\n\"\\tfloat32 float64\\n\"\n\"\\n\"\n\"\\tcomplex64 complex128\\n\"\n\"\\n\"\n\"The example shows variables of several types,\\n\"\n\"and also that variable declarations may be \\\"factored\\\" into blocks,\\n\"\n\"as with import statements.\"\nmsgstr \"\"\n\n#: basics.article:145\nmsgctxt \"basics/12/title\"\nmsgid \"Zero values\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:145\nmsgctxt \"basics/12/body\"\nmsgid \"\"\n\"Variables declared without an explicit initial value are given their\\n\"\n\"_zero_value_.\\n\"\n\"\\n\"\n\"The zero value is:\\n\"\n\"\\n\"\n\"- `0` for numeric types,\\n\"\n\"- `false` the boolean type, and\\n\"\n\"- `\\\"\\\"` (the empty string) for strings.\"\nmsgstr \"\"\n\n#: basics.article:158\nmsgctxt \"basics/13/title\"\nmsgid \"Type conversions\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:158\nmsgctxt \"basics/13/body\"\nmsgid \"\"\n\"The expression `T(v)` converts the value `v` to the type `T`.\\n\"\n\"\\n\"\n\"Some numeric conversions:\\n\"\n\"\\n\"\n\"\\tvar i int = 42\\n\"\n\"\\tvar f float64 = float64(i)\\n\"\n\"\\tvar u uint = uint(f)\\n\"\n\"\\n\"\n\"Or, put more simply:\\n\"\n\"\\n\"\n\"\\ti := 42\\n\"\n\"\\tf := float64(i)\\n\"\n\"\\tu := uint(f)\\n\"\n\"\\n\"\n\"Unlike in C, in Go assignment between items of different type requires an\\n\"\n\"explicit conversion.\\n\"\n\"Try removing the `float64` or `int` conversions in the example and see what happens.\"\nmsgstr \"\"\n\n#: basics.article:180\nmsgctxt \"basics/14/title\"\nmsgid \"Type inference\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:180\nmsgctxt \"basics/14/body\"\nmsgid \"\"\n\"When declaring a variable without specifying its type (using `var` without a type or the `:=` syntax), the variable's type is _inferred_ from the value on the right hand side.\\n\"\n\"\\n\"\n\"When the right hand side of the declaration is typed, the new variable is of that same type:\\n\"\n\"\\n\"\n\"\\tvar i int\\n\"\n\"\\tj := i // j is an int\\n\"\n\"\\n\"\n\"But when the right hand side contains an untyped numeric constant, the new variable may be an `int`, `float64`, or `complex128` depending on the precision of the constant:\\n\"\n\"\\n\"\n\"\\ti := 42           // int\\n\"\n\"\\tf := 3.142        // float64\\n\"\n\"\\tg := 0.867 + 0.5i // complex128\\n\"\n\"\\n\"\n\"Try changing the initial value of `v` in the example code and observe how its type is affected.\"\nmsgstr \"\"\n\n#: basics.article:199\nmsgctxt \"basics/15/title\"\nmsgid \"Constants\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:199\nmsgctxt \"basics/15/body\"\nmsgid \"\"\n\"Constants are declared like variables, but with the `const` keyword.\\n\"\n\"\\n\"\n\"Constants can be character, string, boolean, or numeric values.\\n\"\n\"\\n\"\n\"Constants cannot be declared using the `:=` syntax.\"\nmsgstr \"\"\n\n#: basics.article:209\nmsgctxt \"basics/16/title\"\nmsgid \"Numeric Constants\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:209\nmsgctxt \"basics/16/body\"\nmsgid \"\"\n\"Numeric constants are high-precision _values_.\\n\"\n\"\\n\"\n\"An untyped constant takes the type needed by its context.\\n\"\n\"\\n\"\n\"Try printing `needInt(Big)` too.\"\nmsgstr \"\"\n\n#: basics.article:219\nmsgctxt \"basics/17/title\"\nmsgid \"Congratulations!\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: basics.article:219\nmsgctxt \"basics/17/body\"\nmsgid \"\"\n\"You finished this lesson!\\n\"\n\"\\n\"\n\"You can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\"\nmsgstr \"\"\n",
+
+	"i18n/content/concurrency.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: concurrency.article:7\nmsgctxt \"concurrency/1/title\"\nmsgid \"Goroutines\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:7\nmsgctxt \"concurrency/1/body\"\nmsgid \"\"\n\"A _goroutine_ is a lightweight thread managed by the Go runtime.\\n\"\n\"\\n\"\n\"\\tgo f(x, y, z)\\n\"\n\"\\n\"\n\"starts a new goroutine running\\n\"\n\"\\n\"\n\"\\tf(x, y, z)\\n\"\n\"\\n\"\n\"The evaluation of `f`, `x`, `y`, and `z` happens in the current goroutine and the execution of `f` happens in the new goroutine.\\n\"\n\"\\n\"\n\"Goroutines run in the same address space, so access to shared memory must be synchronized. The [[http://golang.org/pkg/sync/][`sync`]] package provides useful primitives, although you won't need them much in Go as there are other primitives. (See the next slide.)\"\nmsgstr \"\"\n\n#: concurrency.article:23\nmsgctxt \"concurrency/2/title\"\nmsgid \"Channels\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:23\nmsgctxt \"concurrency/2/body\"\nmsgid \"\"\n\"Channels are a typed conduit through which you can send and receive values with the channel operator, `<-`.\\n\"\n\"\\n\"\n\"\\tch <- v    // Send v to channel ch.\\n\"\n\"\\tv := <-ch  // Receive from ch, and\\n\"\n\"\\t           // assign value to v.\\n\"\n\"\\n\"\n\"(The data flows in the direction of the arrow.)\\n\"\n\"\\n\"\n\"Like maps and slices, channels must be created before use:\\n\"\n\"\\n\"\n\"\\tch := make(chan int)\\n\"\n\"\\n\"\n\"By default, sends and receives block until the other side is ready. This allows goroutines to synchronize without explicit locks or condition variables.\"\nmsgstr \"\"\n\n#: concurrency.article:41\nmsgctxt \"concurrency/3/title\"\nmsgid \"Buffered Channels\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:41\nmsgctxt \"concurrency/3/body\"\nmsgid \"\"\n\"Channels can be _buffered_.  Provide the buffer length as the second argument to `make` to initialize a buffered channel:\\n\"\n\"\\n\"\n\"\\tch := make(chan int, 100)\\n\"\n\"\\n\"\n\"Sends to a buffered channel block only when the buffer is full. Receives block when the buffer is empty.\\n\"\n\"\\n\"\n\"Modify the example to overfill the buffer and see what happens.\"\nmsgstr \"\"\n\n#: concurrency.article:53\nmsgctxt \"concurrency/4/title\"\nmsgid \"Range and Close\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:53\nmsgctxt \"concurrency/4/body\"\nmsgid \"\"\n\"A sender can `close` a channel to indicate that no more values will be sent. Receivers can test whether a channel has been closed by assigning a second parameter to the receive expression: after\\n\"\n\"\\n\"\n\"\\tv, ok := <-ch\\n\"\n\"\\n\"\n\"`ok` is `false` if there are no more values to receive and the channel is closed.\\n\"\n\"\\n\"\n\"The loop `for`i`:=`range`c` receives values from the channel repeatedly until it is closed.\\n\"\n\"\\n\"\n\"*Note:* Only the sender should close a channel, never the receiver. Sending on a closed channel will cause a panic.\\n\"\n\"\\n\"\n\"*Another*note*: Channels aren't like files; you don't usually need to close them. Closing is only necessary when the receiver must be told there are no more values coming, such as to terminate a `range` loop.\"\nmsgstr \"\"\n\n#: concurrency.article:69\nmsgctxt \"concurrency/5/title\"\nmsgid \"Select\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:69\nmsgctxt \"concurrency/5/body\"\nmsgid \"\"\n\"The `select` statement lets a goroutine wait on multiple communication operations.\\n\"\n\"\\n\"\n\"A `select` blocks until one of its cases can run, then it executes that case.  It chooses one at random if multiple are ready.\"\nmsgstr \"\"\n\n#: concurrency.article:77\nmsgctxt \"concurrency/6/title\"\nmsgid \"Default Selection\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:77\nmsgctxt \"concurrency/6/body\"\nmsgid \"\"\n\"The `default` case in a `select` is run if no other case is ready.\\n\"\n\"\\n\"\n\"Use a `default` case to try a send or receive without blocking:\\n\"\n\"\\n\"\n\"\\tselect {\\n\"\n\"\\tcase i := <-c:\\n\"\n\"\\t\\t// use i\\n\"\n\"\\tdefault:\\n\"\n\"\\t\\t// receiving from c would block\\n\"\n\"\\t}\"\nmsgstr \"\"\n\n#: concurrency.article:92\nmsgctxt \"concurrency/7/title\"\nmsgid \"Exercise: Equivalent Binary Trees\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:92\nmsgctxt \"concurrency/7/body\"\nmsgid \"\"\n\"There can be many different binary trees with the same sequence of values stored at the leaves. For example, here are two binary trees storing the sequence 1, 1, 2, 3, 5, 8, 13.\\n\"\n\"\\n\"\n\".image /content/img/tree.png\\n\"\n\"\\n\"\n\"A function to check whether two binary trees store the same sequence is quite complex in most languages. We'll use Go's concurrency and channels to write a simple solution.\\n\"\n\"\\n\"\n\"This example uses the `tree` package, which defines the type:\\n\"\n\"\\n\"\n\"\\ttype Tree struct {\\n\"\n\"\\t\\tLeft  *Tree\\n\"\n\"\\t\\tValue int\\n\"\n\"\\t\\tRight *Tree\\n\"\n\"\\t}\"\nmsgstr \"\"\n\n#: concurrency.article:108\nmsgctxt \"concurrency/8/title\"\nmsgid \"Exercise: Equivalent Binary Trees\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:108\nmsgctxt \"concurrency/8/body\"\nmsgid \"\"\n\"*1.* Implement the `Walk` function.\\n\"\n\"\\n\"\n\"*2.* Test the `Walk` function.\\n\"\n\"\\n\"\n\"The function `tree.New(k)` constructs a randomly-structured binary tree holding the values `k`, `2k`, `3k`, ..., `10k`.\\n\"\n\"\\n\"\n\"Create a new channel `ch` and kick off the walker:\\n\"\n\"\\n\"\n\"\\tgo Walk(tree.New(1), ch)\\n\"\n\"\\n\"\n\"Then read and print 10 values from the channel. It should be the numbers 1, 2, 3, ..., 10.\\n\"\n\"\\n\"\n\"*3.* Implement the `Same` function using `Walk` to determine whether `t1` and `t2` store the same values.\\n\"\n\"\\n\"\n\"*4.* Test the `Same` function.\\n\"\n\"\\n\"\n\"`Same(tree.New(1),`tree.New(1))` should return true, and `Same(tree.New(1),`tree.New(2))` should return false.\"\nmsgstr \"\"\n\n#: concurrency.article:130\nmsgctxt \"concurrency/9/title\"\nmsgid \"Exercise: Web Crawler\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:130\nmsgctxt \"concurrency/9/body\"\nmsgid \"\"\n\"In this exercise you'll use Go's concurrency features to parallelize a web crawler.\\n\"\n\"\\n\"\n\"Modify the `Crawl` function to fetch URLs in parallel without fetching the same URL twice.\"\nmsgstr \"\"\n\n#: concurrency.article:138\nmsgctxt \"concurrency/10/title\"\nmsgid \"Where to Go from here...\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: concurrency.article:138\nmsgctxt \"concurrency/10/body\"\nmsgid \"\"\n\"#appengine: You can get started by\\n\"\n\"#appengine: [[http://golang.org/doc/install/][installing Go]] or downloading the\\n\"\n\"#appengine: [[http://code.google.com/appengine/downloads.html#Google_App_Engine_SDK_for_Go][Go App Engine SDK]].\\n\"\n\"\\n\"\n\"#appengine: Once you have Go installed, the\\n\"\n\"The\\n\"\n\"[[http://golang.org/doc/][Go Documentation]] is a great place to\\n\"\n\"#appengine: continue.\\n\"\n\"start.\\n\"\n\"It contains references, tutorials, videos, and more.\\n\"\n\"\\n\"\n\"To learn how to organize and work with Go code, watch [[http://www.youtube.com/watch?v=XCsL89YtqCs][this screencast]] or read [[http://golang.org/doc/code.html][How to Write Go Code]].\\n\"\n\"\\n\"\n\"If you need help with the standard library, see the [[http://golang.org/pkg/][package reference]]. For help with the language itself, you might be surprised to find the [[http://golang.org/ref/spec][Language Spec]] is quite readable.\\n\"\n\"\\n\"\n\"To further explore Go's concurrency model, watch\\n\"\n\"[[http://www.youtube.com/watch?v=f6kdp27TYZs][Go Concurrency Patterns]]\\n\"\n\"([[http://talks.golang.org/2012/concurrency.slide][slides]])\\n\"\n\"and\\n\"\n\"[[https://www.youtube.com/watch?v=QDDwwePbDtw][Advanced Go Concurrency Patterns]]\\n\"\n\"([[http://talks.golang.org/2013/advconc.slide][slides]])\\n\"\n\"and read the\\n\"\n\"[[http://golang.org/doc/codewalk/sharemem/][Share Memory by Communicating]]\\n\"\n\"codewalk.\\n\"\n\"\\n\"\n\"To get started writing web applications, watch\\n\"\n\"[[http://vimeo.com/53221558][A simple programming environment]]\\n\"\n\"([[http://talks.golang.org/2012/simple.slide][slides]])\\n\"\n\"and read the\\n\"\n\"[[http://golang.org/doc/articles/wiki/][Writing Web Applications]] tutorial.\\n\"\n\"\\n\"\n\"The [[http://golang.org/doc/codewalk/functions/][First Class Functions in Go]] codewalk gives an interesting perspective on Go's function types.\\n\"\n\"\\n\"\n\"The [[http://blog.golang.org/][Go Blog]] has a large archive of informative Go articles.\\n\"\n\"\\n\"\n\"Visit [[http://golang.org][golang.org]] for more.\"\nmsgstr \"\"\n",
+
+	"i18n/content/flowcontrol.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: flowcontrol.article:7\nmsgctxt \"flowcontrol/1/title\"\nmsgid \"For\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:7\nmsgctxt \"flowcontrol/1/body\"\nmsgid \"\"\n\"Go has only one looping construct, the `for` loop.\\n\"\n\"\\n\"\n\"The basic `for` loop looks as it does in C or Java, except that the `(`)` are gone (they are not even optional) and the `{`}` are required.\"\nmsgstr \"\"\n\n#: flowcontrol.article:15\nmsgctxt \"flowcontrol/2/title\"\nmsgid \"For continued\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:15\nmsgctxt \"flowcontrol/2/body\"\nmsgid \"As in C or Java, you can leave the pre and post statements empty.\"\nmsgstr \"\"\n\n#: flowcontrol.article:21\nmsgctxt \"flowcontrol/3/title\"\nmsgid \"For is Go's \\\"while\\\"\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:21\nmsgctxt \"flowcontrol/3/body\"\nmsgid \"At that point you can drop the semicolons: C's `while` is spelled `for` in Go.\"\nmsgstr \"\"\n\n#: flowcontrol.article:27\nmsgctxt \"flowcontrol/4/title\"\nmsgid \"Forever\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:27\nmsgctxt \"flowcontrol/4/body\"\nmsgid \"If you omit the loop condition it loops forever, so an infinite loop is compactly expressed.\"\nmsgstr \"\"\n\n#: flowcontrol.article:33\nmsgctxt \"flowcontrol/5/title\"\nmsgid \"If\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:33\nmsgctxt \"flowcontrol/5/body\"\nmsgid \"\"\n\"The `if` statement looks as it does in C or Java, except that the `(`)` are gone and the `{`}` are required.\\n\"\n\"\\n\"\n\"(Sound familiar?)\"\nmsgstr \"\"\n\n#: flowcontrol.article:41\nmsgctxt \"flowcontrol/6/title\"\nmsgid \"If with a short statement\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:41\nmsgctxt \"flowcontrol/6/body\"\nmsgid \"\"\n\"Like `for`, the `if` statement can start with a short statement to execute before the condition.\\n\"\n\"\\n\"\n\"Variables declared by the statement are only in scope until the end of the `if`.\\n\"\n\"\\n\"\n\"(Try using `v` in the last `return` statement.)\"\nmsgstr \"\"\n\n#: flowcontrol.article:51\nmsgctxt \"flowcontrol/7/title\"\nmsgid \"If and else\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:51\nmsgctxt \"flowcontrol/7/body\"\nmsgid \"Variables declared inside an `if` short statement are also available inside any of the `else` blocks.\"\nmsgstr \"\"\n\n#: flowcontrol.article:57\nmsgctxt \"flowcontrol/8/title\"\nmsgid \"Exercise: Loops and Functions\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:57\nmsgctxt \"flowcontrol/8/body\"\nmsgid \"\"\n\"As a simple way to play with functions and loops, implement the square root function using Newton's method.\\n\"\n\"\\n\"\n\"In this case, Newton's method is to approximate `Sqrt(x)` by picking a starting point _z_ and then repeating:\\n\"\n\"\\n\"\n\".image /content/img/newton.png\\n\"\n\"\\n\"\n\"To begin with, just repeat that calculation 10 times and see how close you get to the answer for various values (1, 2, 3, ...).\\n\"\n\"\\n\"\n\"Next, change the loop condition to stop once the value has stopped changing (or only changes by a very small delta). See if that's more or fewer iterations. How close are you to the [[http://golang.org/pkg/math/#Sqrt][math.Sqrt]]?\\n\"\n\"\\n\"\n\"Hint: to declare and initialize a floating point value, give it floating point syntax or use a conversion:\\n\"\n\"\\n\"\n\"\\tz := float64(1)\\n\"\n\"\\tz := 1.0\"\nmsgstr \"\"\n\n#: flowcontrol.article:76\nmsgctxt \"flowcontrol/9/title\"\nmsgid \"Switch\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:76\nmsgctxt \"flowcontrol/9/body\"\nmsgid \"\"\n\"You probably knew what `switch` was going to look like.\\n\"\n\"\\n\"\n\"A case body breaks automatically, unless it ends with a `fallthrough` statement.\"\nmsgstr \"\"\n\n#: flowcontrol.article:84\nmsgctxt \"flowcontrol/10/title\"\nmsgid \"Switch evaluation order\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:84\nmsgctxt \"flowcontrol/10/body\"\nmsgid \"\"\n\"Switch cases evaluate cases from top to bottom, stopping when a case succeeds.\\n\"\n\"\\n\"\n\"(For example,\\n\"\n\"\\n\"\n\"\\tswitch i {\\n\"\n\"\\tcase 0:\\n\"\n\"\\tcase f():\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"does not call `f` if `i==0`.)\\n\"\n\"\\n\"\n\"#appengine: *Note:* Time in the Go playground always appears to start at\\n\"\n\"#appengine: 2009-11-10 23:00:00 UTC, a value whose significance is left as an\\n\"\n\"#appengine: exercise for the reader.\"\nmsgstr \"\"\n\n#: flowcontrol.article:103\nmsgctxt \"flowcontrol/11/title\"\nmsgid \"Switch with no condition\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:103\nmsgctxt \"flowcontrol/11/body\"\nmsgid \"\"\n\"Switch without a condition is the same as `switch`true`.\\n\"\n\"\\n\"\n\"This construct can be a clean way to write long if-then-else chains.\"\nmsgstr \"\"\n\n#: flowcontrol.article:111\nmsgctxt \"flowcontrol/12/title\"\nmsgid \"Defer\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:111\nmsgctxt \"flowcontrol/12/body\"\nmsgid \"\"\n\"A defer statement defers the execution of a function until the surrounding\\n\"\n\"function returns.\\n\"\n\"\\n\"\n\"The deferred call's arguments are evaluated immediately, but the function call\\n\"\n\"is not executed until the surrounding function returns.\"\nmsgstr \"\"\n\n#: flowcontrol.article:121\nmsgctxt \"flowcontrol/13/title\"\nmsgid \"Stacking defers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:121\nmsgctxt \"flowcontrol/13/body\"\nmsgid \"\"\n\"Deferred function calls are pushed onto a stack. When a function returns, its\\n\"\n\"deferred calls are executed in last-in-first-out order.\\n\"\n\"\\n\"\n\"To learn more about defer statements read this\\n\"\n\"[[http://blog.golang.org/defer-panic-and-recover][blog post]].\"\nmsgstr \"\"\n\n#: flowcontrol.article:131\nmsgctxt \"flowcontrol/14/title\"\nmsgid \"Congratulations!\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: flowcontrol.article:131\nmsgctxt \"flowcontrol/14/body\"\nmsgid \"\"\n\"You finished this lesson!\\n\"\n\"\\n\"\n\"You can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\"\nmsgstr \"\"\n",
+
+	"i18n/content/methods.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: methods.article:7\nmsgctxt \"methods/1/title\"\nmsgid \"Methods\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:7\nmsgctxt \"methods/1/body\"\nmsgid \"\"\n\"Go does not have classes. However, you can define methods on struct types.\\n\"\n\"\\n\"\n\"The _method_receiver_ appears in its own argument list between the `func` keyword and the method name.\"\nmsgstr \"\"\n\n#: methods.article:15\nmsgctxt \"methods/2/title\"\nmsgid \"Methods continued\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:15\nmsgctxt \"methods/2/body\"\nmsgid \"\"\n\"You can declare a method on _any_ type that is declared in your package, not just struct types.\\n\"\n\"\\n\"\n\"However, you cannot define a method on a type from another package (including built in types).\"\nmsgstr \"\"\n\n#: methods.article:23\nmsgctxt \"methods/3/title\"\nmsgid \"Methods with pointer receivers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:23\nmsgctxt \"methods/3/body\"\nmsgid \"\"\n\"Methods can be associated with a named type or a pointer to a named type.\\n\"\n\"\\n\"\n\"We just saw two `Abs` methods. One on the `*Vertex` pointer type and the other on the `MyFloat` value type.\\n\"\n\"\\n\"\n\"There are two reasons to use a pointer receiver. First, to avoid copying the value on each method call (more efficient if the value type is a large struct). Second, so that the method can modify the value that its receiver points to.\\n\"\n\"\\n\"\n\"Try changing the declarations of the `Abs` and `Scale` methods to use `Vertex` as the receiver, instead of `*Vertex`.\\n\"\n\"\\n\"\n\"The `Scale` method has no effect when `v` is a `Vertex`. `Scale` mutates `v`. When `v` is a value (non-pointer) type, the method sees a copy of the `Vertex` and cannot mutate the original value.\\n\"\n\"\\n\"\n\"`Abs` works either way. It only reads `v`. It doesn't matter whether it is reading the original value (through a pointer) or a copy of that value.\"\nmsgstr \"\"\n\n#: methods.article:39\nmsgctxt \"methods/4/title\"\nmsgid \"Interfaces\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:39\nmsgctxt \"methods/4/body\"\nmsgid \"\"\n\"An interface type is defined by a set of methods.\\n\"\n\"\\n\"\n\"A value of interface type can hold any value that implements those methods.\\n\"\n\"\\n\"\n\"*Note:* There is an error in the example code on line 22.\\n\"\n\"`Vertex` (the value type) doesn't satisfy `Abser` because\\t\\n\"\n\"the `Abs` method is defined only on `*Vertex` (the pointer type).\"\nmsgstr \"\"\n\n#: methods.article:51\nmsgctxt \"methods/5/title\"\nmsgid \"Interfaces are satisfied implicitly\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:51\nmsgctxt \"methods/5/body\"\nmsgid \"\"\n\"A type implements an interface by implementing the methods.\\n\"\n\"There is no explicit declaration of intent; no \\\"implements\\\" keyword. \\n\"\n\"\\n\"\n\"Implicit interfaces decouple implementation packages from the packages that define the interfaces: neither depends on the other.\\n\"\n\"\\n\"\n\"It also encourages the definition of precise interfaces, because you don't have to find every implementation and tag it with the new interface name.\\n\"\n\"\\n\"\n\"[[http://golang.org/pkg/io/][Package io]] defines `Reader` and `Writer`; you don't have to.\"\nmsgstr \"\"\n\n#: methods.article:64\nmsgctxt \"methods/6/title\"\nmsgid \"Stringers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:64\nmsgctxt \"methods/6/body\"\nmsgid \"\"\n\"One of the most ubiquitous interfaces is [[//golang.org/pkg/fmt/#Stringer][`Stringer`]] defined by the [[//golang.org/pkg/fmt/][`fmt`]] package.\\n\"\n\"\\n\"\n\"\\ttype Stringer interface {\\n\"\n\"\\t\\tString() string\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"A `Stringer` is a type that can describe itself as a string. The `fmt` package\\n\"\n\"(and many others) look for this interface to print values.\"\nmsgstr \"\"\n\n#: methods.article:77\nmsgctxt \"methods/7/title\"\nmsgid \"Exercise: Stringers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:77\nmsgctxt \"methods/7/body\"\nmsgid \"\"\n\"Make the `IPAddr` type implement `fmt.Stringer` to print the address as\\n\"\n\"a dotted quad.\\n\"\n\"\\n\"\n\"For instance, `IPAddr{1,`2,`3,`4}` should print as `\\\"1.2.3.4\\\"`.\"\nmsgstr \"\"\n\n#: methods.article:86\nmsgctxt \"methods/8/title\"\nmsgid \"Errors\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:86\nmsgctxt \"methods/8/body\"\nmsgid \"\"\n\"Go programs express error state with `error` values. \\n\"\n\"\\n\"\n\"The `error` type is a built-in interface simliar to `fmt.Stringer`:\\n\"\n\"\\n\"\n\"\\ttype error interface {\\n\"\n\"\\t\\tError() string\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"(As with `fmt.Stringer`, the `fmt` package looks for the `error` interface when\\n\"\n\"printing values.)\\n\"\n\"\\n\"\n\"Functions often return an `error` value, and calling code should handle errors\\n\"\n\"by testing whether the error equals `nil`.\\n\"\n\"\\n\"\n\"\\ti, err := strconv.Atoi(\\\"42\\\")\\n\"\n\"\\tif err != nil {\\n\"\n\"\\t\\tfmt.Printf(\\\"couldn't convert number: %v\\\\n\\\", err)\\n\"\n\"\\t}\\n\"\n\"\\tfmt.Println(\\\"Converted integer:\\\", i)\\n\"\n\"\\n\"\n\"A nil `error` denotes success; a non-nil `error` denotes failure.\"\nmsgstr \"\"\n\n#: methods.article:112\nmsgctxt \"methods/9/title\"\nmsgid \"Exercise: Errors\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:112\nmsgctxt \"methods/9/body\"\nmsgid \"\"\n\"Copy your `Sqrt` function from the earlier exercises and modify it to return an `error` value.\\n\"\n\"\\n\"\n\"`Sqrt` should return a non-nil error value when given a negative number, as it doesn't support complex numbers.\\n\"\n\"\\n\"\n\"Create a new type\\n\"\n\"\\n\"\n\"\\ttype ErrNegativeSqrt float64\\n\"\n\"\\n\"\n\"and make it an `error` by giving it a\\n\"\n\"\\n\"\n\"\\tfunc (e ErrNegativeSqrt) Error() string\\n\"\n\"\\n\"\n\"method such that `ErrNegativeSqrt(-2).Error()` returns `\\\"cannot`Sqrt`negative`number:`-2\\\"`.\\n\"\n\"\\n\"\n\"*Note:* a call to `fmt.Sprint(e)` inside the `Error` method will send the program into an infinite loop. You can avoid this by converting `e` first: `fmt.Sprint(float64(e))`. Why?\\n\"\n\"\\n\"\n\"Change your `Sqrt` function to return an `ErrNegativeSqrt` value when given a negative number.\"\nmsgstr \"\"\n\n#: methods.article:134\nmsgctxt \"methods/10/title\"\nmsgid \"Readers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:134\nmsgctxt \"methods/10/body\"\nmsgid \"\"\n\"The `io` package specifies the `io.Reader` interface,\\n\"\n\"which represents the read end of a stream of data.\\n\"\n\"\\n\"\n\"The Go standard library contains [[http://golang.org/search?q=Read#Global][many implementations]] of these interfaces, including files, network connections, compressors, ciphers, and others.\\n\"\n\"\\n\"\n\"The `io.Reader` interface has a `Read` method:\\n\"\n\"\\n\"\n\"\\tfunc (T) Read(b []byte) (n int, err error)\\n\"\n\"\\n\"\n\"`Read` populates the given byte slice with data and returns the number of bytes\\n\"\n\"populated and an error value. It returns an `io.EOF` error when the stream\\n\"\n\"ends.\\n\"\n\"\\n\"\n\"The example code creates a \\n\"\n\"[[//golang.org/pkg/strings/#Reader][`strings.Reader`]].\\n\"\n\"and consumes its output 8 bytes at a time.\"\nmsgstr \"\"\n\n#: methods.article:155\nmsgctxt \"methods/11/title\"\nmsgid \"Exercise: Readers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:155\nmsgctxt \"methods/11/body\"\nmsgid \"\"\n\"Implement a `Reader` type that emits an infinite stream of the ASCII character\\n\"\n\"`'A'`.\"\nmsgstr \"\"\n\n#: methods.article:162\nmsgctxt \"methods/12/title\"\nmsgid \"Exercise: rot13Reader\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:162\nmsgctxt \"methods/12/body\"\nmsgid \"\"\n\"A common pattern is an [[http://golang.org/pkg/io/#Reader][io.Reader]] that wraps another `io.Reader`, modifying the stream in some way.\\n\"\n\"\\n\"\n\"For example, the [[http://golang.org/pkg/compress/gzip/#NewReader][gzip.NewReader]] function takes an `io.Reader` (a stream of compressed data) and returns a `*gzip.Reader` that also implements `io.Reader` (a stream of the decompressed data).\\n\"\n\"\\n\"\n\"Implement a `rot13Reader` that implements `io.Reader` and reads from an `io.Reader`, modifying the stream by applying the [[http://en.wikipedia.org/wiki/ROT13][rot13]] substitution cipher to all alphabetical characters.\\n\"\n\"\\n\"\n\"The `rot13Reader` type is provided for you.\\n\"\n\"Make it an `io.Reader` by implementing its `Read` method.\"\nmsgstr \"\"\n\n#: methods.article:175\nmsgctxt \"methods/13/title\"\nmsgid \"Web servers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:175\nmsgctxt \"methods/13/body\"\nmsgid \"\"\n\"[[http://golang.org/pkg/net/http/][Package http]] serves HTTP requests using any value that implements `http.Handler`:\\n\"\n\"\\n\"\n\"\\tpackage http\\n\"\n\"\\n\"\n\"\\ttype Handler interface {\\n\"\n\"\\t\\tServeHTTP(w ResponseWriter, r *Request)\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"In this example, the type `Hello` implements `http.Handler`.\\n\"\n\"\\n\"\n\"Visit [[http://localhost:4000/][http://localhost:4000/]] to see the greeting.\\n\"\n\"\\n\"\n\"#appengine: *Note:* This example won't run through the web-based tour user\\n\"\n\"#appengine: interface. To try writing web servers you may want to\\n\"\n\"#appengine: [[http://golang.org/doc/install/][Install Go]].\"\nmsgstr \"\"\n\n#: methods.article:195\nmsgctxt \"methods/14/title\"\nmsgid \"Exercise: HTTP Handlers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:195\nmsgctxt \"methods/14/body\"\nmsgid \"\"\n\"Implement the following types and define ServeHTTP methods on them. Register them to handle specific paths in your web server.\\n\"\n\"\\n\"\n\"\\ttype String string\\n\"\n\"\\n\"\n\"\\ttype Struct struct {\\n\"\n\"\\t\\tGreeting string\\n\"\n\"\\t\\tPunct    string\\n\"\n\"\\t\\tWho      string\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"For example, you should be able to register handlers using:\\n\"\n\"\\n\"\n\"\\thttp.Handle(\\\"/string\\\", String(\\\"I'm a frayed knot.\\\"))\\n\"\n\"\\thttp.Handle(\\\"/struct\\\", &Struct{\\\"Hello\\\", \\\":\\\", \\\"Gophers!\\\"})\\n\"\n\"\\n\"\n\"#appengine: *Note:* This example won't run through the web-based tour user\\n\"\n\"#appengine: interface. To try writing web servers you may want to\\n\"\n\"#appengine: [[http://golang.org/doc/install/][Install Go]].\"\nmsgstr \"\"\n\n#: methods.article:218\nmsgctxt \"methods/15/title\"\nmsgid \"Images\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:218\nmsgctxt \"methods/15/body\"\nmsgid \"\"\n\"[[http://golang.org/pkg/image/#Image][Package image]] defines the `Image` interface:\\n\"\n\"\\n\"\n\"\\tpackage image\\n\"\n\"\\n\"\n\"\\ttype Image interface {\\n\"\n\"\\t\\tColorModel() color.Model\\n\"\n\"\\t\\tBounds() Rectangle\\n\"\n\"\\t\\tAt(x, y int) color.Color\\n\"\n\"\\t}\\n\"\n\"\\n\"\n\"*Note*: the `Rectangle` return value of the `Bounds` method is actually an\\n\"\n\"[[http://golang.org/pkg/image/#Rectangle][`image.Rectangle`]], as the\\n\"\n\"declaration is inside package `image`.\\n\"\n\"\\n\"\n\"(See [[http://golang.org/pkg/image/#Image][the documentation]] for all the details.)\\n\"\n\"\\n\"\n\"The `color.Color` and `color.Model` types are also interfaces, but we'll ignore that by using the predefined implementations `color.RGBA` and `color.RGBAModel`. These interfaces and types are specified by the [[http://golang.org/pkg/image/color/][image/color package]]\"\nmsgstr \"\"\n\n#: methods.article:240\nmsgctxt \"methods/16/title\"\nmsgid \"Exercise: Images\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:240\nmsgctxt \"methods/16/body\"\nmsgid \"\"\n\"Remember the picture generator you wrote earlier? Let's write another one, but this time it will return an implementation of `image.Image` instead of a slice of data.\\n\"\n\"\\n\"\n\"Define your own `Image` type, implement [[http://golang.org/pkg/image/#Image][the necessary methods]], and call `pic.ShowImage`.\\n\"\n\"\\n\"\n\"`Bounds` should return a `image.Rectangle`, like `image.Rect(0,`0,`w,`h)`.\\n\"\n\"\\n\"\n\"`ColorModel` should return `color.RGBAModel`.\\n\"\n\"\\n\"\n\"`At` should return a color; the value `v` in the last picture generator corresponds to `color.RGBA{v,`v,`255,`255}` in this one.\"\nmsgstr \"\"\n\n#: methods.article:254\nmsgctxt \"methods/17/title\"\nmsgid \"Congratulations!\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: methods.article:254\nmsgctxt \"methods/17/body\"\nmsgid \"\"\n\"You finished this lesson!\\n\"\n\"\\n\"\n\"You can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\"\nmsgstr \"\"\n",
+
+	"i18n/content/moretypes.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: moretypes.article:7\nmsgctxt \"moretypes/1/title\"\nmsgid \"Pointers\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:7\nmsgctxt \"moretypes/1/body\"\nmsgid \"\"\n\"Go has pointers.\\n\"\n\"A pointer holds the memory address of a variable.\\n\"\n\"\\n\"\n\"The type `*T` is a pointer to a `T` value. Its zero value is `nil`.\\n\"\n\"\\n\"\n\"\\tvar p *int\\n\"\n\"\\n\"\n\"The `&` operator generates a pointer to its operand.\\n\"\n\"\\n\"\n\"\\ti := 42\\n\"\n\"\\tp = &i\\n\"\n\"\\n\"\n\"The `*` operator denotes the pointer's underlying value.\\n\"\n\"\\n\"\n\"\\tfmt.Println(*p) // read i through the pointer p\\n\"\n\"\\t*p = 21         // set i through the pointer p\\n\"\n\"\\n\"\n\"This is known as \\\"dereferencing\\\" or \\\"indirecting\\\".\\n\"\n\"\\n\"\n\"Unlike C, Go has no pointer arithmetic.\"\nmsgstr \"\"\n\n#: moretypes.article:32\nmsgctxt \"moretypes/2/title\"\nmsgid \"Structs\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:32\nmsgctxt \"moretypes/2/body\"\nmsgid \"\"\n\"A `struct` is a collection of fields.\\n\"\n\"\\n\"\n\"(And a `type` declaration does what you'd expect.)\"\nmsgstr \"\"\n\n#: moretypes.article:40\nmsgctxt \"moretypes/3/title\"\nmsgid \"Struct Fields\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:40\nmsgctxt \"moretypes/3/body\"\nmsgid \"Struct fields are accessed using a dot.\"\nmsgstr \"\"\n\n#: moretypes.article:46\nmsgctxt \"moretypes/4/title\"\nmsgid \"Pointers to structs\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:46\nmsgctxt \"moretypes/4/body\"\nmsgid \"\"\n\"Struct fields can be accessed through a struct pointer.\\n\"\n\"\\n\"\n\"The indirection through the pointer is transparent.\"\nmsgstr \"\"\n\n#: moretypes.article:54\nmsgctxt \"moretypes/5/title\"\nmsgid \"Struct Literals\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:54\nmsgctxt \"moretypes/5/body\"\nmsgid \"\"\n\"A struct literal denotes a newly allocated struct value by listing the values of its fields.\\n\"\n\"\\n\"\n\"You can list just a subset of fields by using the `Name:` syntax. (And the order of named fields is irrelevant.)\\n\"\n\"\\n\"\n\"The special prefix `&` returns a pointer to the struct value.\"\nmsgstr \"\"\n\n#: moretypes.article:64\nmsgctxt \"moretypes/6/title\"\nmsgid \"Arrays\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:64\nmsgctxt \"moretypes/6/body\"\nmsgid \"\"\n\"The type `[n]T` is an array of `n` values of type `T`.\\n\"\n\"\\n\"\n\"The expression\\n\"\n\"\\n\"\n\"\\tvar a [10]int\\n\"\n\"\\n\"\n\"declares a variable `a` as an array of ten integers.\\n\"\n\"\\n\"\n\"An array's length is part of its type, so arrays cannot be resized.\\n\"\n\"This seems limiting, but don't worry;\\n\"\n\"Go provides a convenient way of working with arrays.\"\nmsgstr \"\"\n\n#: moretypes.article:80\nmsgctxt \"moretypes/7/title\"\nmsgid \"Slices\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:80\nmsgctxt \"moretypes/7/body\"\nmsgid \"\"\n\"A slice points to an array of values and also includes a length.\\n\"\n\"\\n\"\n\"`[]T` is a slice with elements of type `T`.\"\nmsgstr \"\"\n\n#: moretypes.article:88\nmsgctxt \"moretypes/8/title\"\nmsgid \"Slicing slices\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:88\nmsgctxt \"moretypes/8/body\"\nmsgid \"\"\n\"Slices can be re-sliced, creating a new slice value that points to the same array.\\n\"\n\"\\n\"\n\"The expression\\n\"\n\"\\n\"\n\"\\ts[lo:hi]\\n\"\n\"\\n\"\n\"evaluates to a slice of the elements from `lo` through `hi-1`, inclusive. Thus\\n\"\n\"\\n\"\n\"\\ts[lo:lo]\\n\"\n\"\\n\"\n\"is empty and\\n\"\n\"\\n\"\n\"\\ts[lo:lo+1]\\n\"\n\"\\n\"\n\"has one element.\"\nmsgstr \"\"\n\n#: moretypes.article:108\nmsgctxt \"moretypes/9/title\"\nmsgid \"Making slices\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:108\nmsgctxt \"moretypes/9/body\"\nmsgid \"\"\n\"Slices are created with the `make` function. It works by allocating a zeroed array and returning a slice that refers to that array:\\n\"\n\"\\n\"\n\"\\ta := make([]int, 5)  // len(a)=5\\n\"\n\"\\n\"\n\"To specify a capacity, pass a third argument to `make`:\\n\"\n\"\\n\"\n\"\\tb := make([]int, 0, 5) // len(b)=0, cap(b)=5\\n\"\n\"\\n\"\n\"\\tb = b[:cap(b)] // len(b)=5, cap(b)=5\\n\"\n\"\\tb = b[1:]      // len(b)=4, cap(b)=4\"\nmsgstr \"\"\n\n#: moretypes.article:123\nmsgctxt \"moretypes/10/title\"\nmsgid \"Nil slices\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:123\nmsgctxt \"moretypes/10/body\"\nmsgid \"\"\n\"The zero value of a slice is `nil`.\\n\"\n\"\\n\"\n\"A nil slice has a length and capacity of 0.\"\nmsgstr \"\"\n\n#: moretypes.article:131\nmsgctxt \"moretypes/11/title\"\nmsgid \"Adding elements to a slice\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:131\nmsgctxt \"moretypes/11/body\"\nmsgid \"\"\n\"It is common to append new elements to a slice, and so Go provides a built-in\\n\"\n\"`append` function. The [[http://golang.org/pkg/builtin/#append][documentation]]\\n\"\n\"of the built-in package describes `append`.\\n\"\n\"\\n\"\n\"\\tfunc append(s []T, vs ...T) []T\\n\"\n\"\\n\"\n\"The first parameter `s` of `append` is a slice of type `T`, and the rest are\\n\"\n\"`T` values to append to the slice.\\n\"\n\"\\n\"\n\"The resulting value of `append` is a slice containing all the elements of the\\n\"\n\"original slice plus the provided values.\\n\"\n\"\\n\"\n\"If the backing array of `s` is too small to fit all the given values a bigger\\n\"\n\"array will be allocated. The returned slice will point to the newly allocated\\n\"\n\"array.\\n\"\n\"\\n\"\n\"(To learn more about slices, read the [[http://golang.org/doc/articles/slices_usage_and_internals.html][Slices: usage and internals]] article.)\"\nmsgstr \"\"\n\n#: moretypes.article:153\nmsgctxt \"moretypes/12/title\"\nmsgid \"Range\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:153\nmsgctxt \"moretypes/12/body\"\nmsgid \"The `range` form of the `for` loop iterates over a slice or map.\"\nmsgstr \"\"\n\n#: moretypes.article:159\nmsgctxt \"moretypes/13/title\"\nmsgid \"Range continued\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:159\nmsgctxt \"moretypes/13/body\"\nmsgid \"\"\n\"You can skip the index or value by assigning to `_`.\\n\"\n\"\\n\"\n\"If you only want the index, drop the \\\", value\\\" entirely.\"\nmsgstr \"\"\n\n#: moretypes.article:167\nmsgctxt \"moretypes/14/title\"\nmsgid \"Exercise: Slices\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:167\nmsgctxt \"moretypes/14/body\"\nmsgid \"\"\n\"Implement `Pic`. It should return a slice of length `dy`, each element of which is a slice of `dx` 8-bit unsigned integers. When you run the program, it will display your picture, interpreting the integers as grayscale (well, bluescale) values.\\n\"\n\"\\n\"\n\"The choice of image is up to you. Interesting functions include `(x+y)/2`, `x*y`, and `x^y` (to compute the latter function, use [[http://golang.org/pkg/math/#Pow][`math.Pow`]]).\\n\"\n\"\\n\"\n\"(You need to use a loop to allocate each `[]uint8` inside the `[][]uint8`.)\\n\"\n\"\\n\"\n\"(Use `uint8(intValue)` to convert between types.)\"\nmsgstr \"\"\n\n#: moretypes.article:179\nmsgctxt \"moretypes/15/title\"\nmsgid \"Maps\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:179\nmsgctxt \"moretypes/15/body\"\nmsgid \"\"\n\"A map maps keys to values.\\n\"\n\"\\n\"\n\"Maps must be created with `make` (not `new`) before use; the `nil` map is empty and cannot be assigned to.\"\nmsgstr \"\"\n\n#: moretypes.article:187\nmsgctxt \"moretypes/16/title\"\nmsgid \"Map literals\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:187\nmsgctxt \"moretypes/16/body\"\nmsgid \"Map literals are like struct literals, but the keys are required.\"\nmsgstr \"\"\n\n#: moretypes.article:193\nmsgctxt \"moretypes/17/title\"\nmsgid \"Map literals continued\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:193\nmsgctxt \"moretypes/17/body\"\nmsgid \"If the top-level type is just a type name, you can omit it from the elements of the literal.\"\nmsgstr \"\"\n\n#: moretypes.article:199\nmsgctxt \"moretypes/18/title\"\nmsgid \"Mutating Maps\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:199\nmsgctxt \"moretypes/18/body\"\nmsgid \"\"\n\"Insert or update an element in map `m`:\\n\"\n\"\\n\"\n\"\\tm[key] = elem\\n\"\n\"\\n\"\n\"Retrieve an element:\\n\"\n\"\\n\"\n\"\\telem = m[key]\\n\"\n\"\\n\"\n\"Delete an element:\\n\"\n\"\\n\"\n\"\\tdelete(m, key)\\n\"\n\"\\n\"\n\"Test that a key is present with a two-value assignment:\\n\"\n\"\\n\"\n\"\\telem, ok = m[key]\\n\"\n\"\\n\"\n\"If `key` is in `m`, `ok` is `true`. If not, `ok` is `false` and `elem` is the zero value for the map's element type.\\n\"\n\"\\n\"\n\"Similarly, when reading from a map if the key is not present the result is the zero value for the map's element type.\"\nmsgstr \"\"\n\n#: moretypes.article:223\nmsgctxt \"moretypes/19/title\"\nmsgid \"Exercise: Maps\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:223\nmsgctxt \"moretypes/19/body\"\nmsgid \"\"\n\"Implement `WordCount`.  It should return a map of the counts of each “word” in the string `s`. The `wc.Test` function runs a test suite against the provided function and prints success or failure.\\n\"\n\"\\n\"\n\"You might find [[http://golang.org/pkg/strings/#Fields][strings.Fields]] helpful.\"\nmsgstr \"\"\n\n#: moretypes.article:231\nmsgctxt \"moretypes/20/title\"\nmsgid \"Function values\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:231\nmsgctxt \"moretypes/20/body\"\nmsgid \"Functions are values too.\"\nmsgstr \"\"\n\n#: moretypes.article:237\nmsgctxt \"moretypes/21/title\"\nmsgid \"Function closures\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:237\nmsgctxt \"moretypes/21/body\"\nmsgid \"\"\n\"Go functions may be closures. A closure is a function value that references variables from outside its body. The function may access and assign to the referenced variables; in this sense the function is \\\"bound\\\" to the variables.\\n\"\n\"\\n\"\n\"For example, the `adder` function returns a closure. Each closure is bound to its own `sum` variable.\"\nmsgstr \"\"\n\n#: moretypes.article:245\nmsgctxt \"moretypes/22/title\"\nmsgid \"Exercise: Fibonacci closure\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:245\nmsgctxt \"moretypes/22/body\"\nmsgid \"\"\n\"Let's have some fun with functions.\\n\"\n\"\\n\"\n\"Implement a `fibonacci` function that returns a function (a closure) that returns successive fibonacci numbers.\"\nmsgstr \"\"\n\n#: moretypes.article:253\nmsgctxt \"moretypes/23/title\"\nmsgid \"Congratulations!\"\nmsgstr \"\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: moretypes.article:253\nmsgctxt \"moretypes/23/body\"\nmsgid \"\"\n\"You finished this lesson!\\n\"\n\"\\n\"\n\"You can go back to the list of [[/list][modules]] to find what to learn next, or continue with the [[javascript:click('.next-page')][next lesson]].\"\nmsgstr \"\"\n",
+
+	"i18n/content/welcome.po": "msgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: golangdoc.translations\\n\"\n\"Language: zh_CN\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n\n#: welcome.article:7\nmsgctxt \"welcome/1/title\"\nmsgid \"Hello, 世界\"\nmsgstr \"你好，世界\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: welcome.article:7\nmsgctxt \"welcome/1/body\"\nmsgid \"\"\n\"Welcome to a tour of the [[http://golang.org/][Go programming language]].\\n\"\n\"\\n\"\n\"The tour is divided into a list of modules that you can\\n\"\n\"access by clicking on\\n\"\n\"[[javascript:highlight(\\\".logo\\\")][A Tour of Go]] on the top left of the page.\\n\"\n\"\\n\"\n\"You can also view the table of contents at any time by clicking on the [[javascript:highlightAndClick(\\\".nav\\\")][menu]] on the top right of the page.\\n\"\n\"\\n\"\n\"Throughout the tour you will find a series of slides and exercises for you\\n\"\n\"to complete.\\n\"\n\"\\n\"\n\"You can navigate through them using\\n\"\n\"\\n\"\n\"- [[javascript:highlight(\\\".prev-page\\\")][\\\"previous\\\"]] or `PageUp` to go to the previous page,\\n\"\n\"\\n\"\n\"- [[javascript:highlight(\\\".next-page\\\")][\\\"next\\\"]] or `PageDown` to go to the next page.\\n\"\n\"\\n\"\n\"The tour is interactive. Click the\\n\"\n\"[[javascript:highlightAndClick(\\\"#run\\\")][Run]] button now \\n\"\n\"(or type `shift-enter`) to compile and run the program on\\n\"\n\"#appengine: a remote server.\\n\"\n\"your computer.\\n\"\n\"The result is displayed below the code.\\n\"\n\"\\n\"\n\"These example programs demonstrate different aspects of Go. The programs in the tour are meant to be starting points for your own experimentation.\\n\"\n\"\\n\"\n\"Edit the program and run it again.\\n\"\n\"\\n\"\n\"Note that when you click on [[javascript:highlightAndClick(\\\"#format\\\")][Format]] or `ctrl-enter`\\n\"\n\"the text in the editor is formatted using the\\n\"\n\"[[http://golang.org/cmd/gofmt/][gofmt]] tool. You can switch on and off syntax highlighting\\n\"\n\"the clicking on [[javascript:highlightAndClick(\\\".syntax-checkbox\\\")][syntax]] button.\\n\"\n\"\\n\"\n\"When you're ready to move on, click the [[javascript:highlightAndClick(\\\".next-page\\\")][right arrow]] below or type the `PageDown` key.\"\nmsgstr \"\"\n\"欢迎来到 [[http://golang.org/][Go 编程语言]]之旅。\\n\"\n\"\\n\"\n\"本教程分为若干模块，点击页面左上角的\\n\"\n\"[[javascript:highlight(\\\".logo\\\")][Go 语言之旅]]即可访问。\\n\"\n\"\\n\"\n\"你也可以随时点击页面右上角的[[javascript:highlightAndClick(\\\".nav\\\")][菜单]]来查看目录。\\n\"\n\"\\n\"\n\"在本教程中，你会看到一系列的幻灯片和需要你完成的练习。\\n\"\n\"\\n\"\n\"你可以用\\n\"\n\"\\n\"\n\"- [[javascript:highlight(\\\".prev-page\\\")][“上一页”]]或 `PageUp` 键转到上一页，\\n\"\n\"\\n\"\n\"- [[javascript:highlight(\\\".next-page\\\")][“下一页”]]或 `PageDown` 键转到下一页。\\n\"\n\"\\n\"\n\"本教程是交互式的。现在点击\\n\"\n\"[[javascript:highlightAndClick(\\\"#run\\\")][运行]]按钮\\n\"\n\"（或按 `shift-enter`），就会编译程序并在\\n\"\n\"#appengine: 远程服务器上运行它。\\n\"\n\"你的电脑上运行它。\\n\"\n\"结果会显示在代码下方。\\n\"\n\"\\n\"\n\"这些示例程序展示了 Go 的各个方面。教程中的程序只是你自己动手实验的起点。\\n\"\n\"\\n\"\n\"编辑程序并再次运行它。\\n\"\n\"\\n\"\n\"注意，当你点击[[javascript:highlightAndClick(\\\"#format\\\")][格式化]]或按 `ctrl-enter` 时，\\n\"\n\"编辑器中的文本会用 [[http://golang.org/cmd/gofmt/][gofmt]] 工具进行格式化。\\n\"\n\"点击[[javascript:highlightAndClick(\\\".syntax-checkbox\\\")][语法]]按钮可以打开或关闭语法高亮。\\n\"\n\"\\n\"\n\"准备好继续之后，点击下方的[[javascript:highlightAndClick(\\\".next-page\\\")][右箭头]]或按 `PageDown` 键。\"\n\n#: welcome.article:88\nmsgctxt \"welcome/2/title\"\nmsgid \"Go local\"\nmsgstr \"Go 本地化\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: welcome.article:88\nmsgctxt \"welcome/2/body\"\nmsgid \"\"\n\"The tour is available in other languages:\\n\"\n\"\\n\"\n\"- [[http://go-tour-br.appspot.com/][Brazilian Portuguese — Português do Brasil]]\\n\"\n\"- [[http://go-tour-ca.appspot.com/][Catalan — Català]]\\n\"\n\"- [[http://go-tour-de1.appspot.com/][German — Deutsch]]\\n\"\n\"- [[http://go-tour-es.appspot.com/][Spanish — Español]]\\n\"\n\"- [[http://go-tour-fr.appspot.com/][French — Français]]\\n\"\n\"- [[http://go-tour-he.appspot.com/][Hebrew — עִבְרִית]]\\n\"\n\"- [[http://go-tour-jp.appspot.com/][Japanese — 日本語]]\\n\"\n\"- [[http://go-tour-kr.appspot.com/][Korean — 한국어]]\\n\"\n\"- [[http://go-tour-ro.appspot.com/][Romanian — Română]]\\n\"\n\"- [[http://tour.go-zh.org/][Simplified Chinese — 中文（简体）]]\\n\"\n\"- [[http://go-tour-zh-tw.appspot.com/][Traditional Chinese — 中文（繁體）]]\\n\"\n\"\\n\"\n\"Click the [[javascript:highlightAndClick(\\\".next-page\\\")][\\\"next\\\"]] button or type `PageDown` to continue.\\n\"\n\"\\n\"\n\"#appengine: * The Go Playground\\n\"\n\"#appengine: \\n\"\n\"#appengine: This tour is built atop the [[http://play.golang.org/][Go Playground]], a\\n\"\n\"#appengine: web service that runs on [[http://golang.org/][golang.org]]'s servers.\\n\"\n\"#appengine: \\n\"\n\"#appengine: The service receives a Go program, compiles, links, and runs the program inside\\n\"\n\"#appengine: a sandbox, then returns the output.\\n\"\n\"#appengine: \\n\"\n\"#appengine: There are limitations to the programs that can be run in the playground: \\n\"\n\"#appengine: \\n\"\n\"#appengine: - In the playground the time begins at 2009-11-10 23:00:00 UTC (determining the sigificance of this date is an exercise for the reader). This makes it easier to cache programs by giving them deterministic output.\\n\"\n\"#appengine: \\n\"\n\"#appengine: - There are also limits on execution time and on CPU and memory usage, and the program cannot access external network hosts. \\n\"\n\"#appengine: \\n\"\n\"#appengine: The playground uses the latest stable release of Go.\\n\"\n\"#appengine: \\n\"\n\"#appengine: Read \\\"[[http://blog.golang.org/playground][Inside the Go Playground]]\\\" to learn more.\\n\"\n\"#appengine: \\n\"\n\"#appengine: .play welcome/sandbox.go\"\nmsgstr \"\"\n\"本教程还有其它语言的版本：\\n\"\n\"\\n\"\n\"- [[http://go-tour-br.appspot.com/][巴西葡萄牙语 — Português do Brasil]]\\n\"\n\"- [[http://go-tour-ca.appspot.com/][加泰罗尼亚语 — Català]]\\n\"\n\"- [[http://go-tour-de1.appspot.com/][德语 — Deutsch]]\\n\"\n\"- [[http://go-tour-es.appspot.com/][西班牙语 — Español]]\\n\"\n\"- [[http://go-tour-fr.appspot.com/][法语 — Français]]\\n\"\n\"- [[http://go-tour-he.appspot.com/][希伯来语 — עִבְרִית]]\\n\"\n\"- [[http://go-tour-jp.appspot.com/][日语 — 日本語]]\\n\"\n\"- [[http://go-tour-kr.appspot.com/][韩语 — 한국어]]\\n\"\n\"- [[http://go-tour-ro.appspot.com/][罗马尼亚语 — Română]]\\n\"\n\"- [[http://tour.go-zh.org/][简体中文 — 中文（简体）]]\\n\"\n\"- [[http://go-tour-zh-tw.appspot.com/][繁体中文 — 中文（繁體）]]\\n\"\n\"\\n\"\n\"点击[[javascript:highlightAndClick(\\\".next-page\\\")][“下一页”]]按钮或按 `PageDown` 键继续。\\n\"\n\"\\n\"\n\"#appengine: * The Go Playground\\n\"\n\"#appengine: \\n\"\n\"#appengine: This tour is built atop the [[http://play.golang.org/][Go Playground]], a\\n\"\n\"#appengine: web service that runs on [[http://golang.org/][golang.org]]'s servers.\\n\"\n\"#appengine: \\n\"\n\"#appengine: The service receives a Go program, compiles, links, and runs the program inside\\n\"\n\"#appengine: a sandbox, then returns the output.\\n\"\n\"#appengine: \\n\"\n\"#appengine: There are limitations to the programs that can be run in the playground: \\n\"\n\"#appengine: \\n\"\n\"#appengine: - In the playground the time begins at 2009-11-10 23:00:00 UTC (determining the sigificance of this date is an exercise for the reader). This makes it easier to cache programs by giving them deterministic output.\\n\"\n\"#appengine: \\n\"\n\"#appengine: - There are also limits on execution time and on CPU and memory usage, and the program cannot access external network hosts. \\n\"\n\"#appengine: \\n\"\n\"#appengine: The playground uses the latest stable release of Go.\\n\"\n\"#appengine: \\n\"\n\"#appengine: Read \\\"[[http://blog.golang.org/playground][Inside the Go Playground]]\\\" to learn more.\\n\"\n\"#appengine: \\n\"\n\"#appengine: .play welcome/sandbox.go\"\n\n#: welcome.article:172\nmsgctxt \"welcome/3/title\"\nmsgid \"Congratulations\"\nmsgstr \"恭喜\"\n\n#. Text in present format: keep the links, the code and the directives.\n#: welcome.article:172\nmsgctxt \"welcome/3/body\"\nmsgid \"\"\n\"You've finished the first module of the tour!\\n\"\n\"\\n\"\n\"Now click on [[javascript:highlightAndClick(\\\".logo\\\")][A Tour of Go]] to find out what else\\n\"\n\"you can learn about Go, or go directly to the [[javascript:click('.next-page')][next lesson]].\"\nmsgstr \"\"\n\"你已经完成了本教程的第一个模块！\\n\"\n\"\\n\"\n\"现在点击[[javascript:highlightAndClick(\\\".logo\\\")][Go 语言之旅]]看看还能学到 Go 的哪些知识，\\n\"\n\"或者直接进入[[javascript:click('.next-page')][下一课]]。\"\n",
+
+	"i18n/messages/en.txt": "# English messages of the tour, the reference catalog.\n#\n# Each line holds a key and its message; lines starting with white space\n# continue the previous message.\n\ntitle\t\tA Tour of Go\nwelcome\t\tWelcome to a tour of Go\n\noff\t\toff\non\t\ton\nsyntax\t\tSyntax-Highlighting\nlineno\t\tLine-Numbers\nreset\t\tReset Slide\nformat\t\tFormat Source Code\nkill\t\tKill Program\nrun\t\tRun\ncompile\t\tCompile and Run\ncheck\t\tCheck\ncheckpass\tAll checks passed.\ncheckfail\t{n} checks failed.\nmore\t\tOptions\ntoc\t\tTable of Contents\nprev\t\tPrevious\nnext\t\tNext\nwaiting\t\tWaiting for remote server...\nerrcomm\t\tError communicating with remote server.\nsessionname\tYour name, to resume the tour on another computer:\nsessionpin\tPIN:\npinwrong\tWrong PIN for this name.\npinrequired\tChoose a PIN to keep your session.\nresume\t\tResume\nvisited\t\tPages visited:\ncontinue\tContinue where you left off\nlangen\t\tEnglish\nlangzh\t\tChinese\nlangboth\tEnglish and Chinese\n\ntoc.mechanics.title\t\tUsing the tour\ntoc.mechanics.description\t<p>Welcome to a tour of the <a href=\"http://golang.org\">Go programming language</a>. The tour covers the most important features of the language, mainly:</p>\n\ntoc.basics.title\t\tBasics\ntoc.basics.description\t\t<p>The starting point, learn all the basics of the language.</p>\n\t<p>Declaring variables, calling functions, and all the things you need to know before moving to the next lessons.</p>\n\ntoc.methods.title\t\tMethods and interfaces\ntoc.methods.description\t\t<p>Learn how to define methods on types, how to declare interfaces, and how to put everything together.</p>\n\ntoc.concurrency.title\t\tConcurrency\ntoc.concurrency.description\t<p>Go provides concurrency features as part of the core language.</p>\n\t<p>This module goes over goroutines and channels, and how they are used to implement different concurrency patterns.</p>\n",
+
+	"i18n/messages/zh_CN.txt": "# 简体中文消息。格式见 en.txt。\n\ntitle\t\tGo 语言之旅\nwelcome\t\t欢迎来到 Go 语言之旅\n\noff\t\t关\non\t\t开\nsyntax\t\t语法高亮\nlineno\t\t行号\nreset\t\t重置\nformat\t\t格式化源代码\nkill\t\t终止程序\nrun\t\t运行\ncompile\t\t编译并运行\ncheck\t\t检查\ncheckpass\t全部检查通过。\ncheckfail\t{n} 项检查未通过。\nmore\t\t选项\ntoc\t\t目录\nprev\t\t上一页\nnext\t\t下一页\nwaiting\t\t等待服务器响应……\nerrcomm\t\t与服务器通信时出错。\nsessionname\t你的名字（用于在其它电脑上继续学习）：\nsessionpin\tPIN 码：\npinwrong\t该名字的 PIN 码不正确。\npinrequired\t请设置一个 PIN 码来保存你的进度。\nresume\t\t继续学习\nvisited\t\t已学习页数：\ncontinue\t从上次离开的地方继续\nlangen\t\t英文\nlangzh\t\t中文\nlangboth\t中英对照\n\ntoc.mechanics.title\t\t使用本教程\ntoc.mechanics.description\t<p>欢迎来到 <a href=\"http://golang.org\">Go 编程语言</a>之旅。本教程涵盖了该语言最重要的特性，主要包括：</p>\n\ntoc.basics.title\t\t基础\ntoc.basics.description\t\t<p>从这里开始，学习该语言的全部基础知识。</p>\n\t<p>声明变量、调用函数，以及在学习后面的课程之前你需要了解的一切。</p>\n\ntoc.methods.title\t\t方法和接口\ntoc.methods.description\t\t<p>学习如何为类型定义方法，如何声明接口，以及如何将它们组合在一起。</p>\n\ntoc.concurrency.title\t\t并发\ntoc.concurrency.description\t<p>Go 将并发特性作为语言核心的一部分提供。</p>\n\t<p>本模块将介绍 goroutine 和信道，以及如何用它们实现各种并发模式。</p>\n",
+
+	"i18n/messages/zh_TW.txt": "# 繁體中文訊息。格式見 en.txt。\n\ntitle\t\tGo 語言之旅\nwelcome\t\t歡迎來到 Go 語言之旅\n\noff\t\t關\non\t\t開\nsyntax\t\t語法高亮\nlineno\t\t行號\nreset\t\t重設\nformat\t\t格式化原始碼\nkill\t\t終止程式\nrun\t\t執行\ncompile\t\t編譯並執行\ncheck\t\t檢查\ncheckpass\t全部檢查通過。\ncheckfail\t{n} 項檢查未通過。\nmore\t\t選項\ntoc\t\t目錄\nprev\t\t上一頁\nnext\t\t下一頁\nwaiting\t\t等待伺服器回應……\nerrcomm\t\t與伺服器通訊時發生錯誤。\nsessionname\t你的名字（用於在其他電腦上繼續學習）：\nsessionpin\tPIN 碼：\npinwrong\t該名字的 PIN 碼不正確。\npinrequired\t請設定一個 PIN 碼來保存你的進度。\nresume\t\t繼續學習\nvisited\t\t已學習頁數：\ncontinue\t從上次離開的地方繼續\nlangen\t\t英文\nlangzh\t\t中文\nlangboth\t中英對照\n\ntoc.mechanics.title\t\t使用本教學\ntoc.mechanics.description\t<p>歡迎來到 <a href=\"http://golang.org\">Go 程式語言</a>之旅。本教學涵蓋了該語言最重要的特性，主要包括：</p>\n\ntoc.basics.title\t\t基礎\ntoc.basics.description\t\t<p>從這裡開始，學習該語言的全部基礎知識。</p>\n\t<p>宣告變數、呼叫函式，以及在學習後面的課程之前你需要了解的一切。</p>\n\ntoc.methods.title\t\t方法和介面\ntoc.methods.description\t\t<p>學習如何為型別定義方法，如何宣告介面，以及如何將它們組合在一起。</p>\n\ntoc.concurrency.title\t\t並行\ntoc.concurrency.description\t<p>Go 將並行特性作為語言核心的一部分提供。</p>\n\t<p>本單元將介紹 goroutine 和通道，以及如何用它們實作各種並行模式。</p>\n",
+
+	"i18n/toc.txt": "# Table of contents of the tour.\n#\n# Each line holds the id of a module followed by the names of its lessons,\n# in order. The title and description of module <id> are the messages\n# toc.<id>.title and toc.<id>.description of the message catalogs.\n\nmechanics\twelcome\nbasics\t\tbasics flowcontrol moretypes\nmethods\t\tmethods\nconcurrency\tconcurrency\n",
+
+	"solutions/README": "This directory contains the solutions to the exercises presented in the Go tour.\n\nIMPORTANT: The main point of the Go tour is to challenge you, so please try to\nsolve all problems by yourself before reading these solutions!!!\n\nIn any case, these are not the only valid solutions. But they have been reviewed\nby the Go team. Therefore you can use them as a guide on how to solve the\nmost basic problems in Go.\n",
+
+	"solutions/binarytrees.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"fmt\"\n\n\t\"golang.org/x/tour/tree\"\n)\n\nfunc walkImpl(t *tree.Tree, ch chan int) {\n\tif t == nil {\n\t\treturn\n\t}\n\twalkImpl(t.Left, ch)\n\tch <- t.Value\n\twalkImpl(t.Right, ch)\n}\n\n// Walk walks the tree t sending all values\n// from the tree to the channel ch.\nfunc Walk(t *tree.Tree, ch chan int) {\n\twalkImpl(t, ch)\n\t// Need to close the channel here\n\tclose(ch)\n}\n\n// Same determines whether the trees\n// t1 and t2 contain the same values.\n// NOTE: The implementation leaks goroutines when trees are different.\n// See binarytrees_quit.go for a better solution.\nfunc Same(t1, t2 *tree.Tree) bool {\n\tw1, w2 := make(chan int), make(chan int)\n\n\tgo Walk(t1, w1)\n\tgo Walk(t2, w2)\n\n\tfor {\n\t\tv1, ok1 := <-w1\n\t\tv2, ok2 := <-w2\n\t\tif !ok1 || !ok2 {\n\t\t\treturn ok1 == ok2\n\t\t}\n\t\tif v1 != v2 {\n\t\t\treturn false\n\t\t}\n\t}\n}\n\nfunc main() {\n\tfmt.Print(\"tree.New(1) == tree.New(1): \")\n\tif Same(tree.New(1), tree.New(1)) {\n\t\tfmt.Println(\"PASSED\")\n\t} else {\n\t\tfmt.Println(\"FAILED\")\n\t}\n\n\tfmt.Print(\"tree.New(1) != tree.New(2): \")\n\tif !Same(tree.New(1), tree.New(2)) {\n\t\tfmt.Println(\"PASSED\")\n\t} else {\n\t\tfmt.Println(\"FAILED\")\n\t}\n}\n",
+
+	"solutions/binarytrees_quit.go": "// Copyright 2015 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"fmt\"\n\n\t\"golang.org/x/tour/tree\"\n)\n\nfunc walkImpl(t *tree.Tree, ch, quit chan int) {\n\tif t == nil {\n\t\treturn\n\t}\n\twalkImpl(t.Left, ch, quit)\n\tselect {\n\tcase ch <- t.Value:\n\t\t// Value successfully sent.\n\tcase <-quit:\n\t\treturn\n\t}\n\twalkImpl(t.Right, ch, quit)\n}\n\n// Walk walks the tree t sending all values\n// from the tree to the channel ch.\nfunc Walk(t *tree.Tree, ch, quit chan int) {\n\twalkImpl(t, ch, quit)\n\tclose(ch)\n}\n\n// Same determines whether the trees\n// t1 and t2 contain the same values.\nfunc Same(t1, t2 *tree.Tree) bool {\n\tw1, w2 := make(chan int), make(chan int)\n\tquit := make(chan int)\n\tdefer close(quit)\n\n\tgo Walk(t1, w1, quit)\n\tgo Walk(t2, w2, quit)\n\n\tfor {\n\t\tv1, ok1 := <-w1\n\t\tv2, ok2 := <-w2\n\t\tif !ok1 || !ok2 {\n\t\t\treturn ok1 == ok2\n\t\t}\n\t\tif v1 != v2 {\n\t\t\treturn false\n\t\t}\n\t}\n}\n\nfunc main() {\n\tfmt.Print(\"tree.New(1) == tree.New(1): \")\n\tif Same(tree.New(1), tree.New(1)) {\n\t\tfmt.Println(\"PASSED\")\n\t} else {\n\t\tfmt.Println(\"FAILED\")\n\t}\n\n\tfmt.Print(\"tree.New(1) != tree.New(2): \")\n\tif !Same(tree.New(1), tree.New(2)) {\n\t\tfmt.Println(\"PASSED\")\n\t} else {\n\t\tfmt.Println(\"FAILED\")\n\t}\n}\n",
+
+	"solutions/errors.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\ntype ErrNegativeSqrt float64\n\nfunc (e ErrNegativeSqrt) Error() string {\n\treturn fmt.Sprintf(\"Sqrt: negative number %g\", e)\n}\n\nconst delta = 1e-10\n\nfunc Sqrt(f float64) (float64, error) {\n\tif f < 0 {\n\t\treturn 0, ErrNegativeSqrt(f)\n\t}\n\tz := f\n\tfor {\n\t\tn := z - (z*z-f)/(2*z)\n\t\tif math.Abs(n-z) < delta {\n\t\t\tbreak\n\t\t}\n\t\tz = n\n\t}\n\treturn z, nil\n}\n\nfunc main() {\n\tfmt.Println(Sqrt(2))\n\tfmt.Println(Sqrt(-2))\n}\n",
+
+	"solutions/fib.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"fmt\"\n\n// fibonacci is a function that returns\n// a function that returns an int.\nfunc fibonacci() func() int {\n\tf, g := 0, 1\n\treturn func() int {\n\t\tf, g = g, f+g\n\t\treturn f\n\t}\n}\n\nfunc main() {\n\tf := fibonacci()\n\tfor i := 0; i < 10; i++ {\n\t\tfmt.Println(f())\n\t}\n}\n",
+
+	"solutions/http.go": "// Copyright 2014 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"log\"\n\t\"net/http\"\n)\n\ntype String string\n\nfunc (s String) ServeHTTP(w http.ResponseWriter, r *http.Request) {\n\tfmt.Fprint(w, s)\n}\n\ntype Struct struct {\n\tGreeting string\n\tPunct    string\n\tWho      string\n}\n\nfunc (s *Struct) ServeHTTP(w http.ResponseWriter, r *http.Request) {\n\tfmt.Fprintf(w, \"%s%s %s\", s.Greeting, s.Punct, s.Who)\n}\n\nfunc main() {\n\thttp.Handle(\"/string\", String(\"I'm a frayed knot.\"))\n\thttp.Handle(\"/struct\", &Struct{\"Hello\", \":\", \"Gophers!\"})\n\terr := http.ListenAndServe(\"localhost:4000\", nil)\n\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n}\n",
+
+	"solutions/image.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"image\"\n\t\"image/color\"\n\n\t\"golang.org/x/tour/pic\"\n)\n\ntype Image struct {\n\tHeight, Width int\n}\n\nfunc (m Image) ColorModel() color.Model {\n\treturn color.RGBAModel\n}\n\nfunc (m Image) Bounds() image.Rectangle {\n\treturn image.Rect(0, 0, m.Height, m.Width)\n}\n\nfunc (m Image) At(x, y int) color.Color {\n\tc := uint8(x ^ y)\n\treturn color.RGBA{c, c, 255, 255}\n}\n\nfunc main() {\n\tm := Image{256, 256}\n\tpic.ShowImage(m)\n}\n",
+
+	"solutions/loops.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n)\n\nconst delta = 1e-6\n\nfunc Sqrt(x float64) float64 {\n\tz := x\n\tn := 0.0\n\tfor math.Abs(n-z) > delta {\n\t\tn, z = z, z-(z*z-x)/(2*z)\n\t}\n\treturn z\n}\n\nfunc main() {\n\tconst x = 2\n\tmine, theirs := Sqrt(x), math.Sqrt(x)\n\tfmt.Println(mine, theirs, mine-theirs)\n}\n",
+
+	"solutions/maps.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"strings\"\n\n\t\"golang.org/x/tour/wc\"\n)\n\nfunc WordCount(s string) map[string]int {\n\tm := make(map[string]int)\n\tfor _, f := range strings.Fields(s) {\n\t\tm[f]++\n\t}\n\treturn m\n}\n\nfunc main() {\n\twc.Test(WordCount)\n}\n",
+
+	"solutions/rot13.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"io\"\n\t\"os\"\n\t\"strings\"\n)\n\nfunc rot13(b byte) byte {\n\tvar a, z byte\n\tswitch {\n\tcase 'a' <= b && b <= 'z':\n\t\ta, z = 'a', 'z'\n\tcase 'A' <= b && b <= 'Z':\n\t\ta, z = 'A', 'Z'\n\tdefault:\n\t\treturn b\n\t}\n\treturn (b-a+13)%(z-a+1) + a\n}\n\ntype rot13Reader struct {\n\tr io.Reader\n}\n\nfunc (r rot13Reader) Read(p []byte) (n int, err error) {\n\tn, err = r.r.Read(p)\n\tfor i := 0; i < n; i++ {\n\t\tp[i] = rot13(p[i])\n\t}\n\treturn\n}\n\nfunc main() {\n\ts := strings.NewReader(\n\t\t\"Lbh penpxrq gur pbqr!\")\n\tr := rot13Reader{s}\n\tio.Copy(os.Stdout, &r)\n}\n",
+
+	"solutions/slices.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"golang.org/x/tour/pic\"\n\nfunc Pic(dx, dy int) [][]uint8 {\n\tp := make([][]uint8, dy)\n\tfor i := range p {\n\t\tp[i] = make([]uint8, dx)\n\t}\n\n\tfor y, row := range p {\n\t\tfor x := range row {\n\t\t\trow[x] = uint8(x * y)\n\t\t}\n\t}\n\n\treturn p\n}\n\nfunc main() {\n\tpic.Show(Pic)\n}\n",
+
+	"solutions/stringers.go": "// Copyright 2015 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport \"fmt\"\n\ntype IPAddr [4]byte\n\nfunc (ip IPAddr) String() string {\n\treturn fmt.Sprintf(\"%d.%d.%d.%d\", ip[0], ip[1], ip[2], ip[3])\n}\n\nfunc main() {\n\taddrs := map[string]IPAddr{\n\t\t\"loopback\":  {127, 0, 0, 1},\n\t\t\"googleDNS\": {8, 8, 8, 8},\n\t}\n\tfor n, a := range addrs {\n\t\tfmt.Printf(\"%v: %v\\n\", n, a)\n\t}\n}\n",
+
+	"solutions/webcrawler.go": "// Copyright 2012 The Go Authors.  All rights reserved.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\n// +build ignore\n\npackage main\n\nimport (\n\t\"errors\"\n\t\"fmt\"\n\t\"sync\"\n)\n\ntype Fetcher interface {\n\t// Fetch returns the body of URL and\n\t// a slice of URLs found on that page.\n\tFetch(url string) (body string, urls []string, err error)\n}\n\n// fetched tracks URLs that have been (or are being) fetched.\n// The lock must be held while reading from or writing to the map.\n// See http://golang.org/ref/spec#Struct_types section on embedded types.\nvar fetched = struct {\n\tm map[string]error\n\tsync.Mutex\n}{m: make(map[string]error)}\n\nvar loading = errors.New(\"url load in progress\") // sentinel value\n\n// Crawl uses fetcher to recursively crawl\n// pages starting with url, to a maximum of depth.\nfunc Crawl(url string, depth int, fetcher Fetcher) {\n\tif depth <= 0 {\n\t\tfmt.Printf(\"<- Done with %v, depth 0.\\n\", url)\n\t\treturn\n\t}\n\n\tfetched.Lock()\n\tif _, ok := fetched.m[url]; ok {\n\t\tfetched.Unlock()\n\t\tfmt.Printf(\"<- Done with %v, already fetched.\\n\", url)\n\t\treturn\n\t}\n\t// We mark the url to be loading to avoid others reloading it at the same time.\n\tfetched.m[url] = loading\n\tfetched.Unlock()\n\n\t// We load it concurrently.\n\tbody, urls, err := fetcher.Fetch(url)\n\n\t// And update the status in a synced zone.\n\tfetched.Lock()\n\tfetched.m[url] = err\n\tfetched.Unlock()\n\n\tif err != nil {\n\t\tfmt.Printf(\"<- Error on %v: %v\\n\", url, err)\n\t\treturn\n\t}\n\tfmt.Printf(\"Found: %s %q\\n\", url, body)\n\tdone := make(chan bool)\n\tfor i, u := range urls {\n\t\tfmt.Printf(\"-> Crawling child %v/%v of %v : %v.\\n\", i, len(urls), url, u)\n\t\tgo func(url string) {\n\t\t\tCrawl(url, depth-1, fetcher)\n\t\t\tdone <- true\n\t\t}(u)\n\t}\n\tfor i, u := range urls {\n\t\tfmt.Printf(\"<- [%v] %v/%v Waiting for child %v.\\n\", url, i, len(urls), u)\n\t\t<-done\n\t}\n\tfmt.Printf(\"<- Done with %v\\n\", url)\n}\n\nfunc main() {\n\tCrawl(\"http://golang.org/\", 4, fetcher)\n\n\tfmt.Println(\"Fetching stats\\n--------------\")\n\tfor url, err := range fetched.m {\n\t\tif err != nil {\n\t\t\tfmt.Printf(\"%v failed: %v\\n\", url, err)\n\t\t} else {\n\t\t\tfmt.Printf(\"%v was fetched\\n\", url)\n\t\t}\n\t}\n}\n\n// fakeFetcher is Fetcher that returns canned results.\ntype fakeFetcher map[string]*fakeResult\n\ntype fakeResult struct {\n\tbody string\n\turls []string\n}\n\nfunc (f *fakeFetcher) Fetch(url string) (string, []string, error) {\n\tif res, ok := (*f)[url]; ok {\n\t\treturn res.body, res.urls, nil\n\t}\n\treturn \"\", nil, fmt.Errorf(\"not found: %s\", url)\n}\n\n// fetcher is a populated fakeFetcher.\nvar fetcher = &fakeFetcher{\n\t\"http://golang.org/\": &fakeResult{\n\t\t\"The Go Programming Language\",\n\t\t[]string{\n\t\t\t\"http://golang.org/pkg/\",\n\t\t\t\"http://golang.org/cmd/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/\": &fakeResult{\n\t\t\"Packages\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/cmd/\",\n\t\t\t\"http://golang.org/pkg/fmt/\",\n\t\t\t\"http://golang.org/pkg/os/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/fmt/\": &fakeResult{\n\t\t\"Package fmt\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/pkg/\",\n\t\t},\n\t},\n\t\"http://golang.org/pkg/os/\": &fakeResult{\n\t\t\"Package os\",\n\t\t[]string{\n\t\t\t\"http://golang.org/\",\n\t\t\t\"http://golang.org/pkg/\",\n\t\t},\n\t},\n}\n",
+
+	"static/css/app.css": "/* Generic elements */\n html, body {\n    margin: 0;\n    padding: 0;\n    font-size: 16px;\n    height: 100%;\n    font-family: sans-serif;\n    line-height: 24px;\n    word-wrap: break-word;\n    -webkit-tap-highlight-color: rgba(0, 0, 0, 0);\n    /* Prevent font scaling in landscape */\n    -webkit-text-size-adjust: none;\n    -webkit-font-smoothing:antialiased;\n}\n* {\n    outline: none;\n}\na {\n    color: inherit;\n    text-decoration: none;\n}\nh1, h2, h3, h4 {\n    color: #333;\n    line-height: 32px;\n    margin: 0;\n}\npre, code {\n    font-family:'Inconsolata', monospace;\n    border-radius: 4px;\n    color: #333;\n    background-color: #fafafa;\n}\npre {\n    padding: 10px;\n}\ncode {\n    padding: 2px;\n}\n.left {\n    display: block;\n    float: left;\n    margin-right: 10px;\n}\n.right {\n    display: block;\n    float: right;\n    margin-left: 10px;\n}\n.bar {\n    display: block;\n    overflow: hidden;\n    -moz-user-select: none;\n    -webkit-user-select: none;\n    -ms-user-select: none;\n    user-select: none;\n}\n.wrapper {\n    position: fixed;\n    overflow: auto;\n    top: 48px;\n    bottom: 0;\n    left: 0;\n    right: 0;\n}\n.container {\n    max-width: 800px;\n    width: 90%;\n    margin: 0 auto 36px auto;\n    padding: 16px 5%;\n    background: #ffffff;\n}\n.container a {\n    color: #375eab;\n}\n.relative-content {\n    display: block;\n    position: relative;\n    height: 100%;\n}\n.highlight {\n    background: #b5533b !important;\n    color: yellow !important;\n}\n.hidden {\n    display: none;\n}\np {\n    margin: 16px 0;\n}\nli {\n    margin: 8px 0;\n}\nul {\n    list-style: none;\n    margin: 0;\n    padding-left: 32px;\n}\n/* Navigation bars */\n .top-bar {\n    position: fixed;\n    left: 0;\n    right: 0;\n    top: 0;\n    z-index: 1000;\n    font-size: 1.4em;\n    padding: 8px 24px;\n    line-height: 32px;\n    color: #222;\n    background: #E0EBF5;\n}\n.nav {\n    float: right;\n    padding: 5px;\n    height: 20px;\n    width: 20px;\n    cursor: pointer;\n}\n.lang-switch {\n    float: right;\n    font-size: 0.7em;\n    margin-right: 16px;\n    color: #375eab;\n}\n/* Bilingual pages */\n.lang-en .chinese, .lang-zh .english {\n    display: none;\n}\n.lang-both .english {\n    color: #666;\n    border-left: 3px solid #ddd;\n    padding-left: 8px;\n}\n/* Module list */\n .page-header {\n    font-size: 1.2em;\n    line-height: 32px;\n    margin: 32px 0;\n}\n@media (max-width: 515px) {\n    .page-header {\n        font-size: 0.75em;\n    }\n}\n.module {\n    margin: 32px 0;\n}\n.session {\n    background: #E0EBF5;\n    padding: 8px 16px;\n}\n.session a {\n    color: #375eab;\n}\n.session .error {\n    color: #D00A0A;\n}\n.module-title {\n    font-size: 1.3em;\n    font-weight: bold;\n    color: #333;\n    margin: 0;\n}\n.lesson {\n    background: #E0EBF5;\n    padding: 8px 16px;\n    margin: 16px 0;\n    position: relative;\n}\n.lesson-title {\n    display: inline-block;\n    font-size: 1.2em;\n    font-weight: bold;\n    margin: 16px 0 0 0;\n    padding-right: 48px;\n}\n/* Lesson viewer */\n .slide-content {\n    padding: 16px;\n}\n.module-bar {\n    font-size: 1.5em;\n    padding: 8px 0;\n    text-align: center;\n    line-height: 24px;\n    font-size: 24px;\n}\n#right-side .module-bar a {\n    color: #375eab;\n    position: relative;\n    font-weight: bold;\n    margin: 5px;\n}\n.menu-button {\n    display: inline-block;\n    text-decoration: none;\n    cursor: pointer;\n    font-size: 0.9em;\n    border-radius: 2px;\n    background-color: #E0EBF5;\n    border: 1px solid rgba(0, 0, 0, 0.1);\n    margin: 2px;\n    height: 24px;\n    padding: 1px 8px;\n    line-height: 24px;\n    color: #444;\n    -moz-user-select: none;\n    -webkit-user-select: none;\n    -ms-user-select: none;\n    user-select: none;\n}\n.menu-button:hover:not(.active) {\n    border: 1px solid #C6C6C6;\n    background-color: #fafafa;\n}\n.menu-button.active {\n    background: #fff;\n}\n.menu-button[syntax-checkbox]:after {\n    content:' off';\n}\n.menu-button[syntax-checkbox].active:after {\n    content:' on';\n}\n#right-side a {\n    color: #375eab;\n    text-decoration: none;\n}\n#file-menu .menu-button {\n    float: right;\n}\n#run {\n    background-color: #375eab;\n    color: #fff;\n}\n#run:hover:not(:active) {\n    background-color: #fff;\n    color: #375eab;\n}\n.output:not(.active) {\n    display: none;\n}\n.output > pre {\n    font-family:'Inconsolata', monospace;\n    background: #fafafa;\n    margin: 0;\n}\n.output .system {\n    color: #888;\n}\n.output .stderr {\n    color: #D00A0A;\n}\n.output-menu .menu-button {\n    float: left;\n}\n.output-menu, #file-menu {\n    background: #fafafa;\n}\n#explorer {\n    height: 32px;\n    padding-left: 30px;\n    background: #fafafa;\n}\n#explorer .menu-button.active {\n    cursor: default;\n}\n#explorer .syntax-checkbox {\n    float: right;\n}\n/* CodeMirror */\n\n #file-editor {\n    background: #FFFFD8;\n    overflow: auto;\n}\n#file-editor > textarea {\n    display: none;\n}\n#file-editor .CodeMirror {\n    height: auto;\n}\n#file-editor .CodeMirror-lines, #file-editor .CodeMirror-gutters {\n    background: #FFFFD8;\n    font-family:'Inconsolata', monospace;\n    line-height: 1.2em;\n}\n.CodeMirror-code > .line-error {\n    background: #FF8080;\n}\n.CodeMirror-code > .line-error .CodeMirror-linenumber {\n    color: #FF5555;\n    font-weight: bolder;\n}\n.CodeMirror-code .line-explanation {\n    background: #FFF5D6;\n    border-left: 3px solid #FF5555;\n    color: #333;\n    font-family: sans-serif;\n    font-size: 0.9em;\n    line-height: 1.4em;\n    padding: 0.2em 0.5em;\n    white-space: normal;\n}\n#file-editor .CodeMirror-gutters {\n    width: 32px;\n}\n@media (min-width: 601px) {\n    #editor-container {\n        position: fixed;\n        top: 48px;\n        left: 0px;\n        right: 0px;\n        bottom: 0px;\n        overflow: hidden;\n        background: #fafafa;\n    }\n    #left-side {\n        position: absolute;\n        top: 0;\n        bottom: 0;\n        left: 0;\n        width: 50%;\n        overflow: hidden;\n        background-image: url(/static/img/gopher.png);\n        background-repeat: no-repeat;\n        background-position: bottom;\n        background-color: #fafafa;\n    }\n    div[vertical-slide] {\n        position: absolute;\n        top: 0px;\n        bottom: 0px;\n        width: 5px;\n        background: #e0ebf5;\n        left: 50%;\n        right: 50%;\n        z-index: 100;\n        cursor: move;\n    }\n    #right-side {\n        position: absolute;\n        top: 0;\n        bottom: 0;\n        right: 0;\n        left: 50%;\n        background: #fff;\n    }\n    #right-side .slide-content {\n        position: absolute;\n        left: 0;\n        right: 0;\n        top: 0;\n        bottom: 30px;\n        overflow: auto;\n    }\n    .module-bar {\n        position: absolute;\n        left: 0;\n        right: 0;\n        bottom: 0;\n        padding: 4px 0;\n        margin: 0;\n    }\n    #top-part {\n        position: absolute;\n        left: 0;\n        right: 0;\n        top: 0;\n        bottom: 33%;\n        background: #e0ebf5;\n    }\n    #file-editor {\n        position: absolute;\n        left: 0;\n        right: 0;\n        top: 0;\n        bottom: 0;\n    }\n    div[horizontal-slide] {\n        position: absolute;\n        left: 0;\n        right: 0;\n        bottom: 33%;\n        height: 5px;\n        background: #e0ebf5;\n        z-index: 100;\n        cursor: move;\n    }\n    #bottom-part {\n        position: absolute;\n        left: 0;\n        right: 0;\n        bottom: 0;\n        top: 67%;\n        min-height: 100px;\n        z-index: 50;\n    }\n    #explorer {\n        position: absolute;\n        top: 0;\n        left: 0;\n        right: 0;\n    }\n    #explorer + div {\n        top: 32px;\n    }\n    #file-menu {\n        position: absolute;\n        top:0;\n        right: 0;\n        left: 0;\n        background: #fafafa;\n    }\n    .output {\n        position: absolute;\n        top: 34px;\n        bottom: 0;\n        left: 0;\n        right: 0;\n        margin: 0;\n        padding: 0;\n        overflow: auto;\n    }\n}\n@media (max-width: 600px) {\n    #top-part {\n        border: 1px solid #ccc;\n    }\n    #left-side {\n        background: #e0ebf5;\n    }\n    #right-side {\n        padding-top: 48px;\n    }\n    #file-menu {\n        height: 32px;\n    }\n    .output {\n        background: white;\n        max-height: 300px;\n        overflow: auto;\n    }\n    #editor-container {\n        padding-bottom: 40px;\n    }\n    .module-bar {\n        position: fixed;\n        background: #e0ebf5;\n        left: 0;\n        right: 0;\n        bottom: 0;\n        z-index: 10;\n        height: 42px;\n        padding: 0;\n        overflow: hidden;\n        text-align: center;\n    }\n    .module-bar * {\n        display: inline-block;\n        width: 25%;\n        font-size: 1.1em;\n        padding: 8px 0;\n    }\n    div[horizontal-slide], div[vertical-slide] {\n        display: none;\n    }\n}\n/* Table of contents */\n .toc {\n    display: none;\n    position: fixed;\n    z-index: 200;\n    font-size: 1.3em;\n    top: 48px;\n    bottom: 0;\n    right: 0;\n    width: 500px;\n    background: #e0ebf5;\n    color: black;\n    overflow-y: auto;\n    padding: 0;\n    margin: 0;\n    border-left: 4px solid #e0ebf5;\n    border-bottom: 4px solid #e0ebf5;\n    -moz-user-select: none;\n    -webkit-user-select: none;\n    -ms-user-select: none;\n    user-select: none;\n}\n.click-catcher {\n    position: fixed;\n    z-index: -100;\n    top:0;\n    bottom: 0;\n    left:0;\n    right: 10;  /* avoid covering the TOC scroller */\n    background: rgba(0, 0, 0, 0);\n}\n.toc * {\n    margin: 0;\n    padding: 0;\n    font-size: 0.95em;\n    display: block;\n}\n.toc span, .toc a {\n    padding: 4px;\n}\n.toc-module {\n    color: #375eab;\n    background: #e0ebf5;\n}\n.toc-lesson {\n    background: #fafafa;\n    color: #333;\n    margin: 1px 0;\n    cursor: pointer;\n}\n.toc-page {\n    background: #fff;\n    color: #333;\n    padding-left: 4px;\n    display: list-item;\n}\n.toc-lesson.active .toc-page {\n    display: list-item;\n}\n.toc-page.active {\n    color: #375eab;\n    font-weight: bold;\n}\n@media (max-width: 600px) {\n    .toc {\n        position: absolute;\n        left: 0;\n        right: 0;\n        bottom: 0;\n        width: 100%;\n        border: none;\n    }\n    .toc ul {\n        width: 100%;\n    }\n    .click-catcher {\n        display: none;\n    }\n}\n",
+
+	"static/img/burger.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xa8\x00\x00\x00\x89\b\x06\x00\x00\x00\xb8\xbbs'\x00\x00\x04$iCCPICC Profile\x00\x008\x11\x85U\xdfo\xdbT\x14>\x89oR\xa4\x16? XG\x87\x8aůUS[\xb9\x1b\x1a\xad\xc6\x06I\x93\xa5\xedJ\x16\xa5\xe9\xd8*$\xe4:7\x89\xa9\x1b\a\xdb鶪O{\x817\x06\xfc\x01@\xd9\x03\x0fH<!\r\x06b{\xd9\xf6\xc0\xb4IS\x87*\xaaIH{\xe8\xc4\x0f!&\xed\x05U\xe1\xbbvb'S\xc4\\\xf5\xfa\xcb9\xdf9\xe7;\xe7^\xdbD=_i\xb5\x9a\x19U\x88\x96\xab\xae\x9d\xcf$\x95\x93\xa7\x16\x94\x9eM\x8aҳ\xd4K\x03ԫ\xe9N-\x91\xcb\xcd\x12.\xc1\x15\xf7\xce\xeb\xe1\x1d\x8a\b\xcb\xed\x91\xee\xfeN\xf6#\xbfz\x8b\xdcщ\"O\xc0n\x15\x1d}\x19\xf8\fQ\xcc\xd4k\xb6K\x14\xbf\a\xfb\xf8i\xb7\x06\xdc\xf3\x1c\xf0\xd36\x04\x02\xab\x02\x97}\x9c\x12x\xd1\xc7'=N!?\t\x8e\xd0*\xeb\x15\xad\b\xbc\x06<\xbc\xd8f/\xb7a_\x03\x18ȓ\xe1Un\x1b\xba\"f\x91\xb3\xad\x92ar\xcf\xe1/\x8fq\xb71\xff\x0f.\x9bu\xf4\xec]\x83X\xfb\x9c\xa5\xb9c\xb8\x0f\x89\xde+\xf6T\xbe\x89?\u05f5\xf4\x1c\xf0K\xb0_\xaf\xb9Ia\x7f\x05\xf8\x8f\xfa\xd2|\x02x\x1fQ\xf4\xa9\x92}t\xde\xe7G__\xad\x14\xde\x06\xde\x05{\xd1p\xa7\vM\xfbju1{\x1c\x18\xb1\xd1\xf5%\xeb\x98\xc8#8\xd7ug\x12\xb3\xa4\x17\x80\xefV\xf8\xb4\xd8c葨\xc8Si\xe0a\xe0\xc1J}\xaa\x99_\x9aqV\xe6\x84\xdd˳Z\x99\xcc\x02#\x8fd\xbf\xa7\xcd\xe4\x80\a\x80?\xb4\xad\xbc\xa8\x05\xcd\xd2:73\xa2\x16\xf2KWkn\xae\xa9Aڮ\x9aYQ\v\xfd2\x99;^\x8f\xc8)m\xbb\x95\u0094\x1f\xcb\x0e\xbav\xa1\x19\xcb\x16J\xc6\xd1\xe9&\x7f\xadfzg\x11\xda\xd8\x05\xbb\x9e\x17ڐ\x9f\xdd\xd2\xect\x06\x18y\xd8?\xbc:/\xe6\x06\x1c\xdb]\xd4Rb\xb6\xa3\xc0G\xe8DD#N\x16-bթJ;\xa4P\x9e2\x94ĽF6<%2Ȅ\x85\xc3\xcba1\"O\xd2\x12l\xddy9\x8f\xe3Ǆ\x8c\xb2\x17\xbd-\xa2Q\xa5;ǯp\xbfɱX?S\xd9\x01\xfc\x1fb\xb3\xec0\x1bg\x13\xa4\xb07؛\xec\bK\xc1:\xc1\x0e\x05\nrm:\x15*\xd3}(\xf5\xf3\xbcOuT\x16:NP\xf2\xfc@}(\x88Q\xce\xd9\xef\x1a\xfa͏\xfe\x86\x9a\xb0K+\xf4#O\xd014[\xed\x13 \a\x13hu7\xd2>\xa7kk?\xec\x0e<\xca\x06\xbb\xf8\xce\xed\xbekkt\xfcq\xf3\x8d\u074bm\xc76\xb0nƶ\xc2\xf8د\xb1-\xfcmR\x02;`z\x8a\x96\xa1\xca\xf0v\xc2\tx#\x1d=\\\x01\xd3%\r\xebo\xe0Y\xd0\xdaRڱ\xa3\xa5\xea\xf9\x81\xd0#&\xc1?\xc8>\xccҹ\xe1Ъ\xfe\xa2\xfe\xa9n\xa8_\xa8\x17\xd4\xdf;j\x84\x19;\xa6$}*}+\xfd(}'}/\xfdL\x8atY\xba\"\xfd$]\x95\xbe\x91.\x059\xbb｟%\xd8{\xaf_a\x13݊]h\xd5k\x9f5'SN\xca{\xe4\x17\xe5\x94\xfc\xbc\xfc\xb2<\x1b\xb0\x14\xb9_\x1e\x93\xa7\xe4\xbd\xf0\xec\t\xf6\xcd\f\xfd\x1d\xbd\x18t\n\xb3jM\xb5{-\xf1\x04\x184\x0f%\x06\x9d\x06\xd7\xc6Tń\xabt\x16Y۟\x93\xa6R6\xc8\xc6\xd8\xf4#\xa7v\\\x9c喊x:\x9e\x8a'H\x89\xef\x8bO\xc4\xc7\xe23\x02\xb7\x9e\xbc\xf8^\xf8&\xb0\xa6\x03\xf5\xfe\x93\x130::\xe0m,L%\xc83\xe2\x9d:qVE\xf4\nt\x9b\xd0\xcd]~\x06\xdf\x0e\xa2I\xabv\xd66\xca\x15Wٯ\xaa\xaf)\t|ʸ2]\xd5G\x87\x15\xcd4\x15\xcf\xe5(6w\xb8\xbd\u008b\xa3$\xbe\x83\"\x8e\xe8A\xde\xfb\xbeEv\xdd\fm\xee[D\x87\xff\xc2;\xebVh[\xa8\x13}\xed\x10\xf5\xbf\x1aچ\xf0N|\xe63\xa2\x8b\a\xf5\xba\xbd\xe2\xe7\xa3H\xe4\x06\x91S:\xb0\xdf\xfb\x1d\xe9K\xe2\xddt\xb7\xd1x\x80\xf7U\xcf'D;\x1f7\x1a\xff\xae7\x1a;_\"\xff\x16\xd1e\xf3?Y\x00qxl\x1b+\x13\x00\x00\tvIDATx\x01\xed\x9d[\xac\\U\x19\xc7\xcf9\xb4E\x04\f\x16ĦP\x03R\r\xa8I\x8d\x9a\xb6xI\x13\xc2\vMD\x13Qcb4\xd1Ą\xa4FL\xf4\xc1Kb\x14y\xe0A\x13\xdf\xc4\a\xad&\x12#\x04\x1f1\xf8\xe6\x05\x8b\x81\a\x9a\x88\\\x04\x83E\xe5\xa2\xd4H\vm\x81\x1e\x7f\xffͬɞ\x993gfNgf\xef\xf6\xfc\xbe\xe4;k\xed\xb5\xd6^\xebۿ\xf9\x9f5;\xfb\xb2fq\xa1\x05\xb6\xbc\xbc\xbc\x9106\x0f\xf1\xf3(?\x1b\x7f]-\x1d\x96/\xedJ}\xb6\x97\xf1\x93C\xfc\xd5\x19\x97g\xdcQc\xbcB\x9b\xa3\x1d\x7f\xb1\x96/e+\xa6\x8b\x8b\x8b\xc7i{\xc6ۆY\x1e!»\x98\xfe\xdfZ\xf3K\xc8_\x88\xf7\x88q\xf7g\xef<\x9f2m\x02\x02\xb0\x8d\xf0W\x14\xef\x14\xca_\xe2\x1f \xff؍\xdb\xe2\xa9F\x00\xa8\xad\xf4\xf1n\xbc.\xc4*\x8f\xf0\xce=\xd5\xfe\xdd\x7f\xfe\x04\x0e\xfc\xf4\x86\x88s\xa5\xd9\xfc\x05ʟ\xad\xf93\xb5|U\x8e\xb0\xb3\xdf\xd4lb\x81\"ȷ0\xfa\x9e\xe2\x88p\xfbԢ\xb1\xa3Ӟ\x00\xe2>\xc2A\xd4E\x9c\xfc\xd3\xf8\xc3\xf8\xc1\xa4\x88\xf8eұl\xa4@\x11d\xce\xe3>\x8e_\x8b\xefA\x90\x97\x91j\x12X\x13\x01\x04|\x82\x1d\xff\x82G\xac\xf1\x03\b\xf6\xf7\xa4+\xdaP\x81\"̜'ވ\xefC\x94[V\xdc\xdbB\tL\x81\x00\xa2\xfd\x19\xdd\xecC\xa89\x85\xe8\xb1\x01\x81\"\xcc\xcbi\xf1e\xfcs\x9eC\xf6\xb0rc\x86\x04\x10\xe9\xdf\xe8\xfeӈ\xf4\xde\xfa0=\x02E\x9c\x1f\xa4\xf2n\x84\x99K;\x9a\x04\xe6J\x00\x91\xe6\xca\xc4-\xf8w\x10j\xf2\v]\x81*\xce\xe0\xd0\xda@\x00\xa1\xfe\x00\x81ޔX*\x81\"Ϋ\xc9\xdf\xe3\xccن\x8f\xc7\x18B\x00\x91~\n\x91\xfeb\xa9\x83\xe3\xfb\x8aSa\xb4\x8c\xc0\xf7\x9887-\xf2g'⼯e\xc1\x19\x8e\x042\x8b~!3\xe8gd!\x81\x96\x12\xd8\x1b\x81\xeehip\x86%\x81\x1d\x11\xe8\xbb\xe4 \x81\x96\x12xS\x04zVK\x833,\t\x1c\x8d@s3_\x93@\x1b\t<\x15\x81>\xd1\xc6ȌI\x02\x10\xf8y\x04z\x97($\xd06\x02\\bʛ\x06\x95@\xefdc\xec\xe7\xf3\xdav \xc6s\xc6\x12\xb8\x9b;I\xcf.\xf1\xe7\xdf\x1c\xe2\xadg\xecaz`\xa7+\x81\xfd\t\xbc܋\xcfC\xc9\a\xb9\xa3\xf4\xf6\x14j\x12h\x92\x00\xdf\xe8\x0f2\xfeN&\xcf\x139\a] \x937\x04\xf7Rq(ۚ\x04\x9a\"\x80\x06\x9fc\xec\x8fF\x9c\x89\xa1\xfb\xb8]6\xb8/\x7f9ɯ\x9dICC\x9b'\x01\x84\x99\x17\xf5\xf6\xe3_C\x9cy\x19\xaf\xb2\x1e\x81\xa6\x04\x91\xe6\xeb\xfe+\xf8\xd7\x11\xea\xebS\xa6I`\x16\x04:\xb3\xe5\xef\xe8\xfb\xb7\xf8=\b3\xef*\xf5\u0600@K-B\xcd;\xed7\xe0\x9f\xc0?\x84X\xabӁRo*\x81:\x01Ė'\xe0_\xea\xf3cl\xe7=\xa3\x7f\xe1\xff\xa8\xf9?\xc9\x1fB\x90#\xaf\xc1\x0f\x15(\x1dt\r\xb1F\x9c\x99Yˊ\x1dI\x8b\x97\xf2\xfe\xba\xb5\x94o\xa2\xdf\x12Ө\x94\xa6Sm;N\x7fkm\x93\xfdNN\xc1\xf358\x8d~V\xea#\xe7|E`\x11V\xc9\x0fK{\xda 6/U\x02M\x93\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x816\x12(K\x1c6\x16[mi\xc7,\xbd\x98%\x1b\xe3%_\xd2\xc4Y\x9cl7_\xe2/u\xa3\xb6Oe\xdf\xfe\xbe\x87\xf5\x95\xf2,\x93\x18KZ|\xb5\xed\xd5\xeaf\xd9W\x96a̲\x8bY\x02\xbe\xa4\xf5\xfc\t\x96UL\x9b\xc6lø##\xa4sh\xbb\xa5\xe3o\xae\xe5\xb3\xd0mY\x1b\xb4.\xaez\xbe\b\xad^V\xe5Y\x18w\xec\x18\x18G\x9b3\x01>\xf7\xfc^Q]\xb4\xe3\xe4\x8bس\xb6h~\xc9\xf0\xe9>\x7f\x06\xe1g}ёVf\x85\x9e\x86\x04\x95\xa5\xbfw\xe2\xbb;\xbe\v!E\x9c\x9a\x04\xa6B\x80\x15\x99\xb3\xe2\xf2\x81\x8e\xff\x91\xf4~D\x1b\xf1\xf7X\x8f@\x11\xe6\xb9\xd4~\t\xff*\x82\xbc\xa0\xa7\xa5\x1b\x12\x98!\x01\x04\x9b\x1fN\xb8\x05\xffQ]\xa8]\x81\"έT\x1e@\x98\xdbf\x18\x87]K`U\x02\b\xf5!\x1a|\x00\x91\xfe7\r\xab\x1fF@\x9c9\x0f\xfc\x95\xe2\f\x12\xadI\x02h\xf0\x1d\x8c\x7f\a\x9a\xac&\xcf\xf2\xcb\x1d\x9f\xa4\"眚\x04\x1a'\x80\x16\xaf%\x88\xeb\x12H\x11h~\x17I\x93@\x9b\bT\x9a\\d*}/\x8a\xbd\xbfM\x91\x19\x8b\x04B\x80\xf3\xd1+3\x83^#\x0e\t\xb4\x94\xc05\x11螖\x06gX\x12\xd8\x13\x81n\x96\x83\x04ZJ\xe0\xd2\b\xb4\xdc\xebmi\x8c\x86\xb5\x8e\t\x1c\x8b@\x1b}\x18`\x1d\xc3\xf7\xd0G\x13\xa8\x04\xea\f:\x1a\x94-\x9a!\xe0\f\xda\fwG\x1d\x93@%P\xaf\x81\x8eI\xcbfs'\xf0H\xceA\x7f<\xf7a\x1dP\x02\xe3\x11\xb8}\x89\xa7F\x1e\xe2\x8a\xfd}㵷\x95\x04\xe6C\x00M\xfe\x06m>\x9e\x194\xf6\x93\xd7\x12\xffJ\xa0y\x02\x883O\xdb\xefK$E\xa0\xb7S\xf8X\xf3\xa1\x19\xc1z'\x80\x0esU\xe9&f\xcfGâ\x12(\x1b/\x90\xbf\x8eʼ;\xa2I\xa0\x11\x02\xe8\xefU\x06\xfe<z\xbc\xad\x04\xd0}\xa2>\x05<ٔ\xd7<\xbe\x89_\x8f_\x84_\xc0\x93N=m(\xd3$0U\x02\x9dY\xf3\x0e:\xfd\x16\xe2|\xb8\xde\xf9\xaa\xe2C\xb0g\xd18\xf7\xea#\xd6\v;i\xf2o\xc0\xf3V\xe6\xc0[\x9a\x13\x96\xe7I\xfe\xcc\xe2c\xb9\xff,\x90\x9a\xb3!\x9e\xbc\xd5\xf92\x9e75\xeb>NY\xbdM^\xe1x\x1e\xffOͻ\xdb\b3}\x0fت\x02\x1dh݂\x02\xfei\xc6\x123\xa16\xd1.<s\x0e\x95\xdbǓ\xa6k٧\x7f\x8ci\xf4Q\xfa<\x89h\x92\xd7$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04$ \x01\tH@\x02\x12\x90\x80\x04ZB`\xae\x8b\x87\xb1\xf0\xd7&\x8e\xfb\xbc>?\x9f\xed\xac\x92\x97\x95\xf4\xe2Y\xf1n\xb5t\xb5\xba\xb2\xef8m&i;N\x7f\xa5\r\xe1/dE\xb8\xacuٟ\xaeT6\xab6\xa5\xdfS\x19\xf38\xc7p\xa4\xe3YC\xb6ʳ\xa8XV\xad\x9b\x8b\xe5C\x9a\xc8\x10Y\x96a\u070e_\x81o\xc5#\xb0~\xd1շ\xbb\xf5,\x9f\xb8\x91\xb6\xdaiN\x00\rd\xa9\xc4\x01\xe1\xd6\xca\xfa랢\xee\xaf\xf8\xe3\x88;\xcb0\x8emCgP\x82\xb8\x8a^v\xe1E\x8cI\xb7#\xb2,r\xabI`M\x04Xo4\xeb\x83Vb\xad\xa5\xf7\"ܔ\r\u0600@\x11ff\xbf\x9b\xf1/\"\xc6|mi\x12\x98)\x01D\x9bS\x86[\xf1\xef\"ԜVt\xadG\xa0\x88\xf3}\xd4܅0\xb7u[\x98\x91\xc0\x9c\b \xd4G\x18\xeazD\xfah\x19\xb2+P\xc4y1\x85\x0f \xceKK\xa5\xa9\x04\xe6M\x00\x91f\x8d\xfa]\x88\xf4\x7f\x19;\xcbd\x17\xfb\xa5\xe2,(L\x9b\"\x80\x06\xafd\xec\xfde\xfcj\x06e\xf6|\x0f\x15\x0f\x94BS\t4M\x80\x99\xf42f\xd1'\xcb\f\xfa\x91\xa6\x03r|\t\xf4\x11\xa84Y\x04\xfa\xe1\xbeJ7%\xd04\x81\xfcVW\xf7\x1c\xf4mMG\xe3\xf8\x12\xe8#\x90\x1bA\vK\x9c\x7fn\xe4\xfc3\xd7>5\t\xb4\x89\xc0E\t&_\xf1\xde\x19j\xd3\xc7b,\x15\x81L\x9aL\x9egG\xa0o\x94\x89\x04ZJ`s\x04\xea\x03\x1c-\xfdt\fk\xe1x\x04\xfa\xa2 $\xd06\x02\\\a\xcdc\x82\x87\x15h\xdb>\x19\xe3)\x04\x0es\xa1\xbe\xfa\xe5\xe0è\xf5\x95Rj*\x81\x96\x10\xa8\x1e\xbf[B\xa5y\xf8\xf4\xcf-\t\xca0$P\b\xfc!\x99|\xc5\xc7\xfe\xf4Z\xe2_\t\xb4\x86@%\xd0\xf2\xb0\xc8;\t\xeb מ\x8a`[\x13\xa5\x81\xac?\x02\x9cr浐m|\xbb\x1f\xa9\x04I&_\xf1\xb7\xad?\x14\x1eqK\t\xfc0\xe2Ll\xf5\a\x96#\xd6o\xe3\xdf`&햧\x91&\x81y\x11`\xf6|\x82\xb1v#\xd0\xe72\xe6\x80\x10\xb9\xbd\xf4~\xcao\xc4?\x86P\xcfI#M\x02\xb3\"\x80 \x8f\xd2\xf7\xa1\x8e\xff\x9d\xf4f\xc4\xf9d\x19o@\xa0\xa5\x02\xa1\xe6\x1e\xfd^\xfc\xea\x8e\xef@\xb0\x1bJ\xbd\xa9\x04B\x00\x81\x1d#\xc9;\xf3\x93x\xce1\xf3*\xf2!\xc4\xf8<\xe9P\x1b*\xd0\xfe=\x10lfӫ\xf0\xbcP\xb7\x92_\xa2\x80\xfb\xa9\xcdn\x1ba\x9c\xa4\xf7\xbc\x01\x19\x81$\xad\xe7'-\x9b\xb4}\x19\xeb\b\x02\x9b\xe95\xf4\xb1\x05\n\x80U\r\x01\xe7\x1cv\v^\x17o^\xc4\xcbj\"\x99y\x8b\xe7\xde\x7fɗ\xb4\xbf\xac\x7f;\xed\xfa\xcb\xfa\xb7K_˴\r\xb4xn\x97\xd5\xd3z\xbeԭ\xa5l-\xfb\x8c\x1a/}\x96\x0f\xbe\x9e\xd6\xf3]!!\x8c\xb9\xad\xeeA\\\x8d\xd9\xff\x01KΕ\xa3FG\xae\xaf\x00\x00\x00\x00IEND\xaeB`\x82",
+
+	"static/img/favicon.ico": "\x00\x00\x01\x00\x01\x00\x10\x10\x00\x00\x01\x00 \x00h\x04\x00\x00\x16\x00\x00\x00(\x00\x00\x00\x10\x00\x00\x00 \x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffϻy\xff²z\xff\xfe\xe1v\xff\xff\xdfw\xff\xff\xddw\xff\xff\xddw\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xe0y\xfft\x8c\xc3\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xfft\x8c\xc3\xff\xfe\xe1v\xff\xff\xddw\xff\xff\xddw\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xff\xdfw\xff\xfe\xe1v\xff\xfe\xe1v\xff\xa6\xa3\x89\xfft\x8c\xc3\xfft\x8c\xc3\xff\x8e\x99\xa6\xff\xfe\xe1v\xff\xff\xe1u\xff\xff\xdfx\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xff\xff\xff\x00TN8\xff\xfe\xe1v\xff\xf3\xd5t\xff\xff\xff\xff\xff\xff\xff\xff\xffȳr\xff\x1f #\xff\x1f #\xffȳr\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf3\xd5t\xff\xfe\xe1v\xffTN8\xff\xff\xff\xff\x00\xd7\xd9\xdd\xffTN8\xff\xfe\xe1v\xff\xe7\xe1\xd2\xff\xfb\xfe\xff\xff\x11\x13\x17\xff.,'\xff\xfe\xe1v\xff\xfe\xe1v\xff\xe7\xe1\xd2\xff\xfb\xfc\xff\xff\x11\x13\x17\xff.,'\xff\xfe\xe1v\xffTN8\xff\xd7\xd9\xdd\xffTN8\xffxh4\xffȧN\xff\xe7\xe1\xd2\xff\xff\xff\xff\xff\x11\x13\x17\xff.,'\xff\xfe\xe1v\xff\xfe\xe1v\xff\xe7\xe1\xd2\xff\xff\xff\xff\xff\x11\x13\x17\xff.,'\xffȧN\xffue7\xffTN8\xffTN8\xffȧN\xff\xaa\x92H\xffȳr\xff\xf5\xf6\xfb\xff\xf7\xfa\xff\xffȳr\xff\xfe\xe1v\xff\xfe\xe1v\xffȳr\xff\xff\xff\xff\xff\xff\xff\xff\xffȳr\xff\xaa\x92H\xffȧN\xffTN8\xff\xd7\xd9\xdd\xffTN8\xff{sN\xff\xaa\x92H\xffȧN\xffȧN\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xcc\xc1\x96\xffʿ\x8f\xff\xa5\x92T\xff{sN\xffTN8\xff\xd7\xd9\xdd\xff\xff\xff\xff\x00\xff\xff\xff\x00\xe1\xe2\xe5\xff\xa0\x9c\x8c\xff{sN\xff{sN\xffWN1\xffA:%\xffNE'\xffeW-\xff{sN\xff{sN\xff\xa0\x9c\x8c\xff\xe1\xe2\xe5\xff\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xea\xeb\xf1\xff\xdc\xdf\xe3\xff\xd7\xd9\xdd\xff\xea\xeb\xf1\xff\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+
+	"static/img/gopher.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xc8\x00\x00\x00x\b\x06\x00\x00\x00\xb7\x92\xbd\xeb\x00\x00\n/iCCPICC profile\x00\x00Hǝ\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\xd2\x19z\x93.0\x80\xf4. \x1d\x04Q\x18f\x06\x18\xca\x00\xc3\fMl\x88\xa8@D\x11\x11\x01E\x90\xa0\x80\x01\xa3\xa1H\xac\x88b!(\xa8`\x0fH\x10Pb0\x8a\xa8\xa8dF\xd6J|yy\xef\xe5\xe5\xf7ǽ\xdf\xdag\xefs\xf7\xd9{\x9f\xb5.\x00$O\x1f./\x05\x96\x02 \x99'\xe0\az8\xd3W\x85Gб\xfd\x00\x06x\x80\x01\xa6\x000Y驾A\xee\xc1@$/7\x17z\xba\xc8\t\xfc\x8b\xde\f\x01H\xfc\xbee\xe8\xe9O\xa7\x83\xffOҬT\xbe\x00\x00\xc8_\xc4\xe6lN:K\xc4\xf9\"N\xca\x14\xa4\x8a\xed3\"\xa6\xc6$\x8a\x19F\x89\x99/JP\xc4rb\x8e[䥟}\x16\xd9Q\xcc\xecd\x1e[\xc4\xe2\x9cS\xd9\xc9l1\xf7\x88x{\x86\x90#b\xc4G\xc4\x05\x19\\N\xa6\x88o\x8bX3I\x98\xcc\x15\xf1[ql2\x87\x99\x0e\x00\x8a$\xb6\v8\xacx\x11\x9b\x88\x98\xc4\x0f\x0et\x11\xf1r\x00p\xa4\xb8/8\xe6\v\x16p\xb2\x04\xe2C\xb9\xa4\xa4f\xf3\xb9q\xf1\x02\xba.K\x8fnjm͠{r2\x938\x02\x81\xa1?\x93\x95\xc8\xe4\xb3\xe9.)ɩL^6\x00\x8bg\xfe,\x19qm\xe9\xa2\"[\x9aZ[Z\x1a\x9a\x19\x99~Q\xa8\xff\xba\xf87%\xee\xed\"\xbd\n\xf8\xdc3\x88\xd6\xf7\x87\xed\xaf\xfcR\xea\x00`̊j\xb3\xeb\x0f[\xcc~\x00:\xb6\x02 w\xff\x0f\x9b\xe6!\x00$E}k\xbf\xf1\xc5yh\xe2y\x89\x17\bRm\x8c\x8d333\x8d\xb8\x1c\x96\x91\xb8\xa0\xbf\xeb\x7f:\xfc\r}\xf1=#\xf1v\xbf\x97\x87\xeeʉe\n\x93\x04tq\xddX)I)B>==\x95\xc9\xe2\xd0\r\xff<\xc4\xff8\xf0\xaf\xf3X\x1aȉ\xe5\xf09<QD\xa8hʸ\xbc8Q\xbbyl\xae\x80\x9b£sy\xff\xa9\x89\xff0\xecOZ\x9ck\x91(\xf5\x9f\x005\xca\bHݠ\x02\xe4\xe7>\x80\xa2\x10\x01\x12yP\xdc\xf5\xdf\xfb\xe6\x83\x0f\x05\xe2\x9b\x17\xa6:\xb18\xf7\x9f\x05\xfd\xfb\xaep\x89\xf8\x91\u038d\xfb\x1c\xe7\x12\x18Lg\t\xf9\x19\x8bk\xe2k\tЀ\x00$\x01\x15\xc8\x03\x15\xa0\x01t\x81!0\x03V\xc0\x168\x027\xb0\x02\xf8\x81`\x10\x0e\xd6\x02\x16\x88\aɀ\x0f2A.\xd8\f\n@\x11\xd8\x05\xf6\x82JP\x03\xeaA#h\x01'@\a8\r.\x80\xcb\xe0:\xb8\t\xee\x80\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\xe4!UH\v2\x80\xcc \x06d\x0f\xb9A>P \x14\x0eECq\x10\x0f\x12B\xb9\xd0\x16\xa8\b*\x85*\xa1Z\xa8\x11\xfa\x16:\x05]\x80\xaeB\x03\xd0=h\x14\x9a\x82~\x85\xde\xc3\bL\x82\xa9\xb02\xac\r\x1b\xc3\f\xd8\t\xf6\x86\x83\xe15p\x1c\x9c\x06\xe7\xc0\xf9\xf0N\xb8\x02\xae\x83\x8f\xc1\xed\xf0\x05\xf8:|\a\x1e\x81\x9fó\b@\x88\b\rQC\f\x11\x06\xe2\x82\xf8!\x11H,\xc2G6 \x85H9R\x87\xb4 ]H/r\v\x19A\xa6\x91w(\f\x8a\x82\xa2\xa3\fQ\xb6(OT\b\x8a\x85JCm@\x15\xa3*QGQ\xed\xa8\x1e\xd4-\xd4(j\x06\xf5\tMF+\xa1\r\xd06h/\xf4*t\x1c:\x13]\x80.G7\xa0\xdbЗ\xd0w\xd0\xe3\xe87\x18\f\x86\x86\xd1\xc1Xa<1\xe1\x98\x04\xcc:L1\xe6\x00\xa6\x15s\x1e3\x80\x19\xc3\xccb\xb1Xy\xac\x01\xd6\x0e\xeb\x87eb\x05\xd8\x02\xec~\xec1\xec9\xec v\x1c\xfb\x16Gĩ\xe2\xccp\xee\xb8\b\x1c\x0f\x97\x87+\xc75\xe1\xce\xe2\x06q\x13\xb8y\xbc\x14^\vo\x83\xf7ó\xf1\xd9\xf8\x12|=\xbe\v\x7f\x03?\x8e\x9f'H\x13t\bv\x84`B\x02a3\xa1\x82\xd0B\xb8DxHxE$\x12Չ\xd6\xc4\x00\"\x97\xb8\x89XA<N\xbcB\x1c%\xbe#ɐ\xf4I.\xa4H\x92\x90\xb4\x93t\x84t\x9et\x8f\xf4\x8aL&k\x93\x1d\xc9\x11d\x01y'\xb9\x91|\x91\xfc\x98\xfcV\x82\"a$\xe1%\xc1\x96\xd8(Q%\xd1.1(\xf1B\x12/\xa9%\xe9$\xb9V2G\xb2\\\xf2\xa4\xe4\r\xc9i)\xbc\x94\xb6\x94\x8b\x14Sj\x83T\x95\xd4)\xa9a\xa9Yi\x8a\xb4\xa9\xb4\x9ft\xb2t\xb1t\x93\xf4U\xe9I\x19\xac\x8c\xb6\x8c\x9b\f[&_\xe6\xb0\xccE\x991\nBѠ\xb8PX\x94-\x94z\xca%\xca8\x15CաzQ\x13\xa8E\xd4o\xa8\xfd\xd4\x19Y\x19\xd9e\xb2\xa1\xb2Y\xb2U\xb2gdGh\bM\x9b\xe6EK\xa2\x95\xd0NІh\xef\x97(/qZ\xc2Y\xb2cI˒\xc1%sr\x8ar\x8er\x1c\xb9B\xb9V\xb9;r\xef\xe5\xe9\xf2n\xf2\x89\xf2\xbb\xe5;\xe4\x1f)\xa0\x14\xf4\x15\x02\x142\x15\x0e*\\R\x98V\xa4*\xda*\xb2\x14\v\x15O(\xdeW\x82\x95\xf4\x95\x02\x95\xd6)\x1dV\xeaS\x9aUVQ\xf6PNUޯ|QyZ\x85\xa6⨒\xa0R\xa6rVeJ\x95\xa2j\xaf\xcaU-S=\xa7\xfa\x8c.Kw\xa2'\xd1+\xe8=\xf4\x195%5O5\xa1Z\xadZ\xbfڼ\xba\x8ez\x88z\x9ez\xab\xfa#\r\x82\x06C#V\xa3L\xa3[cFSU\xd3W3W\xb3Y\xf3\xbe\x16^\x8b\xa1\x15\xaf\xb5O\xabWkN[G;L{\x9bv\x87\xf6\xa4\x8e\x9c\x8e\x97N\x8eN\xb3\xceC]\xb2\xae\x83n\x9an\x9d\xeem=\x8c\x1eC/Q\xef\x80\xdeM}X\xdfB?^\xbfJ\xff\x86\x01l`i\xc058`0\xb0\x14\xbd\xd4z)oi\xdd\xd2aC\x92\xa1\x93a\x86a\xb3\xe1\xa8\x11\xcd\xc8\xc7(Ϩ\xc3腱\xa6q\x84\xf1n\xe3^\xe3O&\x16&I&\xf5&\x0fLeLW\x98\xe6\x99v\x99\xfej\xa6o\xc62\xab2\xbbmN6w7\xdfh\xdei\xfer\x99\xc12β\x83\xcb\xeeZP,|-\xb6Yt[|\xb4\xb4\xb2\xe4[\xb6XNYiZE[U[\r3\xa8\f\x7fF1\xe3\x8a5\xda\xda\xd9z\xa3\xf5i\xebw6\x966\x02\x9b\x136\xbf\xd8\x1a\xda&\xda6\xd9N.\xd7Y\xceY^\xbf|\xccNݎiWk7bO\xb7\x8f\xb6?d?\xe2\xa0\xe6\xc0t\xa8sx\xe2\xa8\xe1\xc8vlp\x9cp\xd2sJp:\xe6\xf4\xc2\xd9ę\xef\xdc\xe6<\xe7b\xe3\xb2\xde\xe5\xbc+\xe2\xea\xe1Z\xe8\xda\xef&\xe3\x16\xe2V\xe9\xf6\xd8]\xdd=ν\xd9}\xc6\xc3\xc2c\x9d\xc7yO\xb4\xa7\xb7\xe7n\xcfa/e/\x96W\xa3\xd7\xcc\n\xab\x15\xebW\xf4x\x93\xbc\x83\xbc+\xbd\x9f\xf8\xe8\xfb\xf0}\xba|a\xdf\x15\xbe{|\x1f\xae\xd4Z\xc9[\xd9\xe1\a\xfc\xbc\xfc\xf6\xf8=\xf2\xd7\xf1O\xf3\xff>\x00\x13\xe0\x1fP\x15\xf04\xd0407\xb07\x88\x12\x14\x15\xd4\x14\xf4&\xd89\xb8$\xf8A\x88n\x880\xa4;T242\xb41t.\xcc5\xac4ld\x95\xf1\xaa\xf5\xab\xae\x87+\x84s\xc3;#\xb0\x11\xa1\x11\r\x11\xb3\xab\xddV\xef]=\x1ei\x11Y\x109\xb4FgM֚\xabk\x15\xd6&\xad=\x13%\x19Ō:\x19\x8d\x8e\x0e\x8bn\x8a\xfe\xc0\xf4c\xd61gc\xbcb\xaacfX.\xac}\xac\xe7lGv\x19{\x8ac\xc7)\xe5L\xc4\xdaŖ\xc6N\xc6\xd9\xc5퉛\x8aw\x88/\x8f\x9f\xe6\xbap+\xb9/\x13<\x13j\x12\xe6\x12\xfd\x12\x8f$.$\x85%\xb5&㒣\x93O\xf1dx\x89\xbc\x9e\x14\x95\x94\xac\x94\x81T\x83Ԃԑ4\x9b\xb4\xbdi3|o~C:\x94\xbe&\xbdS@\x15\xfdL\xf5\tu\x85[\x85\xa3\x19\xf6\x19U\x19o3C3OfIg\xf1\xb2\xfa\xb2\xf5\xb3wdO\xe4\xb8\xe7|\xbd\x0e\xb5\x8e\xb5\xae;W-ws\xee\xe8z\xa7\xf5\xb5\x1b\xa0\r1\x1b\xba7jl\xcc\xdf8\xbe\xc9c\xd3\xd1͉̈́\x9b\x7f\xc83\xc9+\xcd{\xbd%lKW\xber\xfe\xa6\xfc\xb1\xad\x1e[\x9b\v$\n\xf8\x05\xc3\xdbl\xb7\xd5lGm\xe7n\xef\xdfa\xbec\xff\x8eO\x85\xec\xc2kE&E\xe5E\x1f\x8aY\xc5\u05fe2\xfd\xaa⫅\x9d\xb1;\xfbK,K\x0e\xee\xc2\xec\xe2\xed\x1a\xda\xed\xb0\xfbh\xa9tiN\xe9\xd8\x1e\xdf=\xede\xf4\xb2²\xd7{\xa3\xf6^-_V^\xb3\x8f\xb0O\xb8o\xa4§\xa2s\xbf\xe6\xfe]\xfb?T\xc6Wީr\xaej\xadV\xaa\xdeQ=w\x80}`\xf0\xa0\xe3\xc1\x96\x1a嚢\x9a\xf7\x87\xb8\x87\xee\xd6zԶ\xd7iו\x1f\xc6\x1c\xce8\xfc\xb4>\xb4\xbe\xf7k\xc6\u05cd\r\n\rE\r\x1f\x8f\xf0\x8e\x8c\x1c\r<\xda\xd3h\xd5\xd8ؤ\xd4T\xd2\f7\v\x9b\xa7\x8eE\x1e\xbb\xf9\x8d\xeb7\x9d-\x86-\xb5\xad\xb4֢\xe3\xe0\xb8\xf0\xf8\xb3o\xa3\xbf\x1d:\xe1}\xa2\xfb$\xe3d\xcbwZ\xdfU\xb7Q\xda\nۡ\xf6\xec\xf6\x99\x8e\xf8\x8e\x91\xce\xf0\u0381S+Nuw\xd9v\xb5}o\xf4\xfd\x91\xd3j\xa7\xab\xceȞ)9K8\x9b\x7fv\xe1\\ι\xd9\xf3\xa9\xe7\xa7/\xc4]\x18\xeb\x8e\xea~pq\xd5\xc5\xdb=\x01=\xfd\x97\xbc/]\xb9\xec~\xf9b\xafS\xef\xb9+vWN_\xb5\xb9z\xea\x1a\xe3Z\xc7u\xcb\xeb\xed}\x16}m?X\xfc\xd0\xd6o\xd9\xdf~\xc3\xeaF\xe7M\xeb\x9b]\x03\xcb\a\xce\x0e:\f^\xb8\xe5z\xeb\xf2m\xaf\xdb\xd7לּ30\x142tw8rx\xe4.\xfb\xee佤{/\xefgܟ\x7f\xb0\xe9!\xfaa\xe1#\xa9G叕\x1e\xd7\xfd\xa8\xf7c\xeb\x88\xe5șQ\xd7Ѿ'AO\x1e\x8c\xb1ƞ\xff\x94\xfeӇ\xf1\xfc\xa7\xe4\xa7\xe5\x13\xaa\x13\x8d\x93f\x93\xa7\xa7ܧn>[\xfdl\xfcy\xea\xf3\xf9邟\xa5\x7f\xae~\xa1\xfb\xe2\xbb_\x1c\x7f\xe9\x9bY53\xfe\x92\xffr\xe1\xd7\xe2W\U000af3bc^\xf6\xba{\xd6\x7f\xf6\xf1\x9b\xe47\xf3s\x85o\xe5\xdf\x1e}\xc7x\xd7\xfb>\xec\xfd\xc4|\xe6\a쇊\x8fz\x1f\xbb>y\x7fz\xb8\x90\xbc\xb0\xf0\x1b\xf7\x84\xf3\xfb\xcc;\xbcl\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00.#\x00\x00.#\x01x\xa5?v\x00\x00\x00\atIME\a\xdc\t\x03\f;\"VE\xec\xd3\x00\x00\x1b\xd3IDATx\xda\xed\x9dw\x98\\e\xbd\xc7?[\x93M6\x8dTZ \xd4\xd0\f\xb9\x88@ \x01D\xaf\xc0\xa5\b^D\xe9\xa2p\x05\x045\xd8\x10\x15\xb8\xa2\xa0\bX(J\x11\xec\\D\x11i\"\"M@/$\xd7@\b\x10H4\xa1%\xa4\x11B\xb2Ivw\xe6\xfe\xf1\xfb\xbe\x9ewfgg\xe7\x9c93\xe7,{\xbe\xcfs\x9e\xd92\xa7\xbd\xef\xfb\xeb\xe5m C\x9ch\x00\x1a\xf5٤\xa3Q\x9f\xcd\xc0f\xc0\b`S\x1d\xe3\x811\xc0(`\xa4\xfe7\x1c\x18\n\xb4\x01\x83\x81\x16\x9d\xdb\x04\xe4\x81\x1c\xd0\x05l\x04:\x80\xb7\x815\xc0j`\x05\xb0\x14X\x02\xbc\x02,\xd6ϋtn\xb7\xce\xed֑\xa1\x82\t\xcd\x10\x1en\xf1\x0e\x01ڵ\xa0\x87\x00\x93\x80-\xb5\xe0\xc7\xe9\x18\xa3\xcf\xd1\xfa^\x12X\x05\xbc.\x82Y\x04\xbc\n\xcc\x01^\x16Q\xbd\t\xbc\x95MkF a1\x12\xd8\\\x9c\x7f\x02\xb0\x89\x8em\xf5\xf7\xe1\x1e\x818\"i\ry\x8fn`\x1d\xb0\x1eؠ\xcf\xf5\x92\x10]:r:\xf2\x92&\r\x92,\xad\xc0 \x11\xab#\xdaa\x92Z}\xa1CıR\xc4\xf37\xe0\x19\xe0Y\xe0\xb9l\xea3\x02)\x85\xb1\xc0~\xc0>\"\x82\xad%\x11\x86j\xd1\x15\x1f\x95\xe0u\xa99\xaf\xe9s)\xf0\x86\xb8\xfaj\xa9Go\xe9o\xabE\x04\xee\xc0\xfb,5w\r\xde\x1c\x0e\xf5\x88x\x94\x8e\t\xc0Vz\x97I\xfa\xb97t\x01\x9d\"\xd6\xd9\xc0\x93\xc0=\xc0c\x19\x81\fL\fұ+p\x14\xb0\xbf\bbl/\xdfw\xfa\x7f\xb7w\xb8E\xf5*\xf0O\x1d/I\x8dY\xe4\xa9.\xabS\xf2\xbe\xe3D(\xbb\x03\xef\xd11N\x92\xa8UvN1Ѽ\x04\xfc\x1a\xf8\xa9\x88}]\x19\xa2\xcd\b\xe4\x1d\xa02m\a\xec\f\xbc\x1f8H\xc6r)\xb5g\xbdԏ\xe5\"\x80\xd7\xf5\xf3RO*,\x961\xdcՏ\xc7dk`*0E\x9f;H}\x1cVbL\x1e\x03\xee\x00\x1e\x02\xe6J\r\xcc\b\xe4\x1d\x80\xbd\x80#\xa4>m\xdf\vQ\xac\x14\xd7\x7f\x06x\x18X(\t\xb0\\\xbaz\xc7\x00\x18\xa7V\xa9a\x13\x80\xbd\x81C\x80w\xc9\xc1\xe0c\t\xf0\x04p+poJ$d\x86\x90\x18\a\x9c\x01\xfcYjAg\x91~\x9f\a\x16Hu8M\x9c\xb4]\xaaHf\x9b\x99\x8d5T\xe3\xf8\x15\xe0\xaf%ƯCc\xf8i\x92\xf3\xd0e\b\x816I\x87\x1fJ\x15\xf2'\xb3\x1bX+\xeew\rpt6\\\xa1\xb1?p\xad\xa4j1\xc3\xf9\xa7\x18M[\xc6`\xd2)-\x8e\x00~'\xa3؟\xb8.\xe9\xcb\xd7\x02\x87ɻ\x93\xa1:l\x05\xcc\x04\x1e\xc7\\\xd3\xfex\xcf\x06>\x86y\xd32$\x8c\xf1\xc0\x97\x81\xa7$\xee\xfd\x89Z\x01\xdc\x04|@\xeaS\x86\xf81VL\xe7v\x828\x8dcJ\x8f\x03'\xc7d'\xb7\x8b\t\x8e\x97\xa3\xa51\x1b\xfa\xf2\x18\x04|^jT\xb1\xa8_\x04|\t\v\xee\xb5fCU\x17\xb4\xc8\x01\xf2x\xd1\\l\x90\x11\xbfE\x88k\xed\x06\x9c*5\xf8\xcfR\xddV\x16\x1d\xaf\xe9^\xd7K\xad\xdb9\x9b\x02\xc3`\xe0D`~\x89\x89\x98\r|6\x1b\xa2\xc4q*0O6\x9f\x9b\x9f\xe5\xc0\x01X\x16@\xb1th\x03&\x03\x17\x01\x7f/\xe1\b\xa8\xf4xLN\x99!\xf4\x8c\xe7T%\xbe\xfa\x8bG\xe5pyK\x0e\xf4\xfe\xbe\x06\x8b\xf8ވ\xb9\x1c\xbb\xb2\xf5\xd9\xe78\x8e\xc1\x92\"\x87\x8a\xe14j\x81uʉ\xe1\xa2\xfa\x9dU\xdcg\b\xe6\xf9:\xb1Hz\\\t\\\xaa\xebo\x0e\xbc\x0f8\x1e\x98\xaeg\xf1\xd1-i\xb1F\xcf\xe5\xe6\xb6I\xdf\x1d!;\xa7\xa5\xe8\xbc\x171\xcf\xe4\xcdX\x8c*u\x04\xd2.\xdd\xd4Q\xf2F,\xe34\xea\xc3\xee$\x95\xe9C\x14\xba\x12\xef\a.\x03\xfe\x97\xcc\x0f\xdf\x17\x0eǢ\xe6;bi'c\xb1<\xb26\xcdQN\xf3\xb4F\x8br\xa1\x16\xda\\\x8d\xf3\xcb\x11\xef\xbb\x1b\xf0u\xe0H\xefo\x7f\x01~\x8fe/\xbc\xbbh\x81\xaf\a\x1e\x95M9[k\xc6'\x10\x97\x8b\xe6r\xcev\xd4=\xa6\x03ӊ\xee=\x0f\xb8\x04\xf8y҃?\x118\v\xb8E\xdc\xfc\x05\xbd\xd8R\x82\xa8\xf3\"=\xf0c\xc0\r\x12Õ\xf8\xcd\xcf\xc1r\x96|\x03\xf0\x9f\x9a\xf0\x96l\xdd\xf79/\xd7\x03˼\xc5\xf5:\xf0\x1b\xe0\x02\xe08,\x93`_\xcc}{\xa4<S7\x8a0\xdcx\xaf\x96-\xf0\xbe*\x18\xe6y\"@w͍E*\xd8\"ٔ\xe3\xc4X\x9bC\\\xbfY\x84>\x15\x8b\xf2\xe7\x8b\xee\xf3\xadz\x0f|\x13\x16i=\r\x98U\x85Θ\x97\xce9\x13K\xffh-\x9a\xdc;\x8b\xbe\xbb\x1c\xb8\xb0JCr(\xe6\xe6\xddN\x8b\xe2(\xe0\x14\xcc-\xf9a-\x98=$\xbe\xdb\xf5L\xfdɧ\xdf*\x95\xc6-\x94\x0e,\xe2}f\t\x15\xa6\x12\x1c\n\xfcJ*Q^R\xe5C\x11\x9d\x1f\a{\xd7q\fo)\xf1ǣ\xa6I\x02\xf9k\xe7\xaazM\xc0\xf6\xc07\t\np\xfc\xe3my\x17\xe6\x03\xff\xa7\x89\xf9\x8bT\xa0\xa7\xb1\xa4\xb7\xa5\xf4t\xc7\xe6\xb1H\xf7o\xa4N\xbd\x1bK\xb7\xf6\x03|w`\xb9Ba\xd1&\x0f\xc7G\x80바\xee\xe5\x04\xb9V\xab<I\xb7B\xa2<'\xae\xf9w\xe9\xb13u\xef\xe1)'\x8e\xc9X\x804/)~\t\x96\x94\x18\x97\xd7\xf0\xbf\x80G4v\xb3\x81\x0fF\xb8\xce\x14\x9d\xeb\xe6vI\x8c\xcfX<\xef\xd7x\x923/5\xbdfh\x00N/Z\xb8\xee\xf8\xb3\xc4\xd8\xe1⾓\xb1\x14\xf1\t\x98\xdfzS,\x0e\xb1\xb3\xf4\xe0\xe3\x81+t^q\x90\xe9OR\xa1|\xa9q:\xe1\xd3\x18\x9a\x81\x13\xa4?/\x91\xc1\xf98\xf0#\xa9m3\xf4,S\xf4\\;K\x97\xddC\xff;\x05\xf8\xbe\x9e\xe7-\x11\xceC\xc0ٔ\xce\xe3J\x1a_\xc0\xf2\xc6^\xd3\xfbmQ\xa3\xfb\x8c\xc0\x92<\x1fј\xfe8½&\x02wys\xbc\n\xf8h\x8d4\x9d\xcf{\xf7\xc9IK\xa8\tq\\Ga\xbc!'\xe3g\x86\xa8\xb59\xe4\xf5Zĕ\xf6\x94{\xcf\x11\x85O\xf1Ok\xf0ê8\x1f&p\x03/\xc2\\\xbf\xbb\xe89[\xa8<\xc8Ԣs\xc6`\x01\xaf9\xba\xe62\xe0|\xd2\x13c\xb9[\xcfu\x8b\x8c\xefz\xa8\x84͒(y\x11\xe5~!ϟ \x86\xe33\xc2=k\xf4\xac\x17z\xf7Y\x137\x83k\x02~\xebݠSvǻc~\x89\xbd5HN7}B\x83\x18\x06\xdbJ*\xe5\x81\xe7\x81cj0\xd8\xd3\b\x92\xf6^\x94dlN\x8806\xd73\xac\x95\x1a\x98\x04\xfeM\xea\xe9jI\x960\x18-U<\xef\x11\xda\xe65x\xc6\x16,\x05\xc9\xdd\xe7\x17q]xh\x91(|K\x9c3nl-\x82p\xf7\x99\x15\x92\xca[\xa5\x86u\x01\xff\x90Ĩ5\x17=\xca#\x94K\xa9\x7f\xde\xd1NX\x16\xed\xab2\xa4\x93\xc4(I\xb1\x8dR\xb3\xc3`\x8clT_k\x18[\x83g\xdcE\x1e<\x17T\xde/\x0e\xaa\xfbQ\x11q\x1c_\x83\a\x1f\x8c\xf9\xbd\xdd}\xe6KG\xad\x14Á\xdb$ynĊ}\xea\xb90.\xd0s?\x12A\xe2E\xc58\x19\xe1\ve3\xa5\x01\x83\xb0\xaa\xc3\xf5\xc0\xb1!\xcf\xdd\xc6S_\xf3\xc0\x1f#z\xdc\xfa\xc2\xf7\t\xea\xfb\x7fX\xed\xc5f\x10\x94VvJ߬\x05~\xee\r\xcc2\x19\xf7\x95b\xbc\b*W\xc3\xe7\xabD\x05\xddF\xea\xe1+\x98\xfb\xb8\xd6x\x12s\x97\xee\x95Bg\xc1\xe3R\xb7\x0e\x8c\xa0\xba.\xf1\xd6[-4\x95\x03\xa4\x8e\xba\xf0\xc2Ĩ\x17\x1a\x8aUԹ\x85{m\x8d\x06\xf3\f\xcf\xf0\x7f\x1bKW\x0f\xa3\x7f?-\x8epD\n\x16\xc6\x0e\x98\x1b\xfbm\xa9\r\xb5\xc2\x0f5fǒN\xb4\x8bx\xe7F\xb0'ΐ\x04r\x99\xd8\xfb\xeb\xef#\x80\x7f\xc7\xc2\vW\xcb3\xb5m\x84gk\xf5\xb4\x95\xb7\xbc\xeb\x87\xc6\xc1\x1eq\xcc\xc3\"\x9bqc/O'\xcccn\xe2J\xed\x86a\x12\xc3\xeb\x89\x1eݭ\x05v\x93\xd1\xfcJ\x8dԭ\x0fi\xac\xbeK\xba\xb1'\x16+\xf9v\x04[\xf06oM<\x82eḥgha\x85\xecΰ\xf8\x96w\x8d\x93\xa2\xbe\xa0\x8bB\xe6\xe8\x99\xdf\x12\a\x1a(t\xf1\xdd%\x1d\xb6R\xdc C\xeb\xa8\x14.\x8e\xed\xc5A\x1f\xa1g\x1dw\xb5v\xc7\x1c\xa9W\x83H?Α\xa4\xdb'\xe4y#\xb1\x9e\x00\xbde^\xf8)G\xdd\xc0'B^\xffH\xef\xfc\x9b\xa3\x8c\xe5\f\nӇk!=.\xf5\xee\xf1\x8f\x90\\\xe6,\r\xd2\xf9)^\x1c\x93\xf4n\x97\x13\x8f\v\xb8\x01\xcbb·\xb4ђ\xc4x\xa9\xc0s\"\x9c;\x91\xc2\x00r\xae\f\xa1\xac\xa2|\xaf\xafR\x0e\x81\xe5:\xf7!\"\xd4\xd1_\xe1=ĹD\xab\xdcr\x15`#\br\x9a\xdcB9\xa8\xe8Eø\xdb&K\xadz$勣I\x04\x9cǢ\xf5\xd5b\x14\x16#\xb8\x89\xfeU\x00v\xba\xc6 \xac\xa4o.b\xa2\xc5D\x92+\xfa\f\x1b\x03Z\xa8\xf3\xe6ҳ\xb5Q\x9f\xf8\xa3N~\x13\xcb\xf2\x8cb\xa4\x9d\x8a\xf9\xb6_\x95>>K\x06\xd6\xeeX[K\xf7\xa2\x97\x84\x90\x1e\rX\xd2\xdc\x1aq\x81\xb4c\x14\x16ۙC\xf5\xc5;'i\xbc\xf6\xa1\x7fa(\x96!17¹[\x10\x04\x11\xbb{Q\xb7\xdc\xdf\xc3&\"\xba5\xb8P\f<\x94h{\x89 \x1e\xb1c\x04/\xc1\xcf˼\x90\xdfVs\x0eV\x12[)\xf6\xd6y\x9f\xeeG\v\xe4X=\U000e7abc\xce\v\xc0\x03\xc4X%WG\\\x80y\x1a\x0f\x8ap\xee\x19\x04\x9d\xe8\xcb\x11\xc8wB^\xf7i\x82\xb2\x89P\x04\xb2\x17AG\x90\x87#\x18\x99\x9f\xf5t\xc7\\\xd1\xe1\x8b\xc7\r\x84K\x1a\x1b\x84\xe5\x19͗\x11ן\xf0 \x96\x8a1,\xe2\xf9\xd35n\x9f\xa3\xff\xa2\x9at\xf3\x87*P\xb1¦˻\x84\xdb\x05\x84\xcc\xd0\xfe\x00\xe6\xc7\xcfc]*\xc2\xe8\xbb\x13=\xca\xcc\xf7A\xf1\xff\x13\xf2\x856\xd5yg\xf5\xc3\xc5qD\x95\xcf\xfe\x03\xcdɤ2R{4\x96nr\x9e\xd4֙bv\xed)\x91:\xf7c)\xeeQRH\xf6\xe9c-=\x1a\xe1\x9a/\x13\xb4'\x1a\x16v2]\xa41l\x99\xe2\x9eX\\#Gy\xf7\xdc[\x11l\x88o`~\xef]\xfb)\a]\b\xdc\x17\xf1ܿ\x8aӕ\xc2nX:Њ^\xc6|!\xf05\xaa\x88\x18Ǆ\x93E\xe4Q\x13\\\x7f\u058b\xe4XN\xf8T\x9b=\xbd5~7\x96\xad]1>X\x05\x81L\xf3&*WF\x8a\x9c\x1ba\x80\xe6am\xf8\xcby\x8d\xa6b>\ue3caЧ\xa6\x88@\xce\xc7\xea\xbc\xc3\x16\x06M\xd2y\xd7\xf7\xa2\x9f/-b@\xb9^\xc6\x7f\x96l\xb8\xa40Q\xcf\x115\xa8\xbb=A\x84=\xe79\x91\x0e\x8fp\xadOy\xe3r)!\xdd\xf0\x87z\x04r[ȓw\xc3b\x1a\xb92:\xe3\\\xc2w5\xdcC\xe2\xf4\xf3\xbd\xfc\xff\x93\xf2\x16\xad\xd5ѡ\xcf5X\xf5\xe0i) \x10\xa7\"\x86\x9d\xd0\xfd\xb1\f\xd9Sz1\xfe\xfb:\xfc9x\x89\xe4zG\x8d\x14\xa1\x7f\x9aha\x83V,8\xec\xdee\xa9l\xb3(\xb8\x95 \xdf배'\xef\xe7\x19\xe9\x7f\ni\xe1\x0f\x93\x1aQ\xce-w\x06\xe1S\x0f\xce\x16\xf7(.\xa6\xd9V\x9e\x9d\xde\xfc\xe4\xfe\xefw\x93l\x15\xe0pl\x9b\x84\xb0\x81\xc3c\xe8\x19K\x19O\xcfMv*%\x94{ª\x141\xa1]\xf6\xe9\x0f\x88\xdehc\x1f\x11\x99#\x90\xf6\b\xd7\xd8\xdd\x1b\xbb\x05X\n|(l\xa7\x89\xccc\xbe\xe2\xb0\ta\xd3\b\xa2\x94\xc5b\xdeU\a\x86ŏ%N\x87\x16\xa9\x1e\xb3)\x1fiu\x84\xea\xfe\xf7W\x92\x8b\x9f\f\x02~\x89\x058\xc3d&\x9c\xa6g\xf7m\x88_T\xa0Ɩ;ޓ\xc0\xfb\xb7\x89I\xddB\xf44\x996\x82\xa2\xa7Έ^\xbd+\t\xd2\xdd#\xb7\x02rn\xb5\x15\x11\xf5֓K,\xdcN\xcc\x1f\x1e\x05\x8fR\x98\xae\xd0\xe8I\x8erzw\xae\xc4wnMЫ\xf3\x19,\x1a\x1eƭ\xe8\xf4e\x17/\x9aLϮ a\xa5\xc8\xed\t\xbc{+\xd6\xc8\xed\xf7TW\xe7q\x1cA\x18ᡐ\x1aή\x9eͶ\x01\xcb\x0e\xae\b\x8d%\fbd+L\x8d\xa0\x12\xfdD\xc6\xfe*\x9d\x9b\x97\x8a\xf4\xb3\b\x032BR\xe7E\xefo\xe7\x00\xef\xf5~o(\xfa\xf4\xff\x9e\xa7p\x0f\xbfc\b\xd2\x1e\x86\xd4y\x91,\xc3ܱa\b\xd4=w\u07b3\xf3\xda%\x19\xc3\u038b\xfb\xfe\xf4\x84\x18D\x17\xd5Wy\xde'\xcf\x1cX\xa9\xef\xbb*<\xaf\x05k08N\xbf\xff\x01\xcb\x18\x89D wy\x03zLD\x9d\xf5N\xac\xba\xcf]\xe7\x17ދ\x85\x15\xab\x9b\x88k:o\x95\xeb~\x91\xab`\xc0K\xfd\xffԄ\x16\xc8\xc6\b\xd2k\x837\xc1\x8ea4\xd2\xf7\xfe\x80yoQ\xae\xf1\x1c/\x10o\x86q\x18\xe2\x1cL\xf5۵\xad\xc0ZC9\x9b\xf7\xc8\nm\x9a˱\x12\x0e4\x16\xa12\x1b\x8a\t\xe4\x1e\x82ܙ\xe9\xe2ZQtn'\xc2:e\x9cEA\x8b\x16\xd5Z\xfd>U\x86U>\xe4B\xf1\xbf\xe7*\xdd\xd6\xd5y\x91tF\xe0\xfck<i\xee\x98B\xa5\vr\x03V\\4C\u07b3\xfbIn\xe3\xcdF\x11\xe6\x9a\x10\xefP\xce&}[?\x1f\xd5\a\x03o\x93\xe48\xdbc8'\x12\xbd\x8d\xea\xbf\xf0\xd1\"\xe3:\xaca\xf5~\xef\xfcjt\xde\xcd5\x18W\xe8w\xe7\x86\xeeK\xff\xceQ>Ez\x8b\x04\x16\xc9\xd1\xe2\xa0a\x9a;\x1c$\xc2rR\xf3\x10̍\xdd]\xc1\xfb\xdfU\xb4x\xa6\xc8\x01\xf3l\x02\xef>\x8c\xa0On\x1c\xe9\xff\xb7z\xef\xfa\xe12\x9e\xc3[(\xec\xc6sFT\xea.\xc6\xdd\xf2\xfa8\xbd\xf7\xb2\x90\xd7<\xc7\xfb\xf9\x96*Ւu\x1e\am,!\x11\xcaq\xd1'\x81\xff\xc0*\xf1\x9e\xf5\xb8w\x12\x86\xfa \xdd7\f\x17\x7fE\xf6\x9b\x8b@?&U\xb5\x12\xe9\xf9\x06\x85\x9b\x8e\xae\xd2x&a\xa4\xb7`\x1e\xc4\xf9\xc4\xd3}\xff\x1a\xef\xe7\x13K\xfc\x7f?9w\x8e\xf5\xc6\xe9\xab\xc4\\:~\x00A\xf4\xb2\x13\xf8x\x88s\x9d\xafy>\xd5m\xec8\x18\xab.s\x06\xd5\xde\x14n\xafVN\x92̣pW\xa9\x1d\b\x02\x99\xed\t,\x92\x13\xf5\xec#C2\xafY\x1a\x03\x87\x8b*\xf4Z\xbd\x8c\xc5\x0eZ\xe5\x908[\x7f\x1f\x91\xc0\xbbo\xa6{\x1f\x14\xd3\xf5\xda\xe5\xb8q\x05S\xce}?\x12s\xe5\x167\xaf\xfe\\\xad8\xde\xf5ލޤ\xb2\f\xdc\xcfx\xe7\\O\xf5\xe5\xa1w`i\xc9NT?Cen\xce;)t)\xb6c}\x82\x9fH`\x814\xcbP\x9cG\xf8\xacޛ(l\x04ъe\b\x94\x1b\x03\xf7\xf7\xd70\xf7\xaa\xdb\x1e-tjEL8K\xeb'\xae\xf4\x9f\x96\"B\xf86\xd6\xd5fq\xd18<O\x8d7jmǶ\xcf\xf2\x89\xe43}\x9c\xe3\xba0\xae'Zs\xe3b\\&\xe3\xceI\xa2\xff\xa6|\xb4\xde/\xe5\xf5\x03\x83\xd3$\tO\xf7\x16Z\xbd0\x04+\x1f\xf8-\xe1\xe3\x00.\x1bد\xbd\x9e\x8cՈT\x9af\xe2ڒ&\x11E\aK\xf9\x7f*f\x0f\xda\xe1\x04\x1dq6\x16\xbd\xefF\xac@\xaf.\xe5\xc9M\x14vW씍R\xea\xe6;a\xfe~זsr\f\xf7\xffO\xbd\xbcOl\xf3+\x94\"\xcf\x03\x17\xcb8\\*\xbb*\t\x15c\xa4t悔\xbb\x96H\"\xfa\x1e\xb0\xed)l\xb8WN\xed\xfc>\xc9aH\x8d\x9ea\x1b\n\xf70qL\xf3\xa7\x14\x96x\xd7\x05.\x96\x91/\x92&3e@\xbb\x879A\x86aN\x9e\x868\xb8\xf4X\xcc\xff\xed\x1bf\xbbS\x98\xe2]\xcakU\xfc\xfb\"*\x0f,ō\x83\xaa\xd4\xc1ϓ\xfdR\xaa\xb9\xf3\xc9\"\x94ץ\x8a\xad\x93\xc4],\xa2J\xba\xf3\xe2yb\xaa\a\xc7|\xddFO[\xe9Ʋ+&$\xf9\xa2\xcd؞s\xaf\x950\x86/\xc6B\xf9W\x8bSv\x12\xef^\f\xf7\xcaX\xf5]\xa4\xd3)\xbd\xe1c)\x0e\xfa\x14\xc9v!\xfc\xad\xbchQ\x1b3\xb7i\\\xbf\\\xe6;\x9317\xf8\xd1\"\xc4q$\x8f\x06\xaczo~\x8d\xae\x7f&\x16\xdb\xe8\x06\xbeGJ0\x15K\xbc+^\x84K\xc4\xe5r\xe2d\xef\x8d\xf1\x9e\x87\xe9\x1e\a\x14\xfd}\"VL\xf5\f=7\xe5Y\xaf\xbf\x7f\x85d[\xe5\x8c\x13ø\xaeJn麤lM\xff\xc1\xf1T٠\xad\x0fL!\xa8\x80\x9dM\xb8\x1e\a5\xc5 =\xdc=\xbd\xe8\xfeˉ\xb7\xf9\xf0H\xa9\fחPۚeWL\x97\x01~\xae>\xf7\"\xe6\xed\x80#\xe2\x1b2\x1a\xab\xedH\xb2#\xe6\xba\xfd]?!\x8e\xd1X\x9f\xde\xe7kx\x8f6\x822\xefe$[\x14V\xd6X\xba\xaaH\xf5\xba\xb7\x06\xa2ڹ\x8f\xb7\xa0\xff`k\xd9\x04\x7f\x8a\xe9zn\f\xce\xe8\a\xef\xfe\t=\xeb\x8c\x1a\xdf\xe7\x12oݝM\x8a\xf7\x93<\xd7{\xd0/\xd6\xe0\xfa\x131\xd7\xed\xfd\xfd\x88@\xae\x94\xf4\x98\x1c#\xa3xPc\xbcw\x8a\xdf\xfb]X\x00\xefj\xa2U\x10\x86\xc1\xa1\u07ba\xfb))m\xcbڊe\xef\xba\a\x9dV\xa3\xfb\xcc\xd4\xf5?\xde\x0f\x88cw\xd9c߉\xf9\xbacd\xf8\xce#\x9d{%N\x10#[Dmv\x8b*\xc6x\x82:\x8f\x17H\xe9F\xab\xed\x04;D\xad\xa7\xb6\xed\xff\x1f\xc7\\\xbc\xbb\xa5\x988Z\xb1\xa8\xfd\xb3\xd4f\xe7\xa9-4\x06O\x92\xdc\xf6o\xbd\xad\x83\xe71\x8fی:\xde\xd7\x0ff\xa7\xb2o\x9a\xab\xd9\xc8c-Gk\t\xd7!\xe3o\x84o\x00Q/|O\xcfX\xaauk\xa3\x16\xd2\xe6X\x10\xf4+X\xb6\xf2\x8dX\xec\xe86\xa9\n\x97aޟq\xbd8<\xf6\xc0rޢd[\xd7\x02\x9ba\x81\xd8nj\x9c\xdeы#\xc4\x11\xc8qi\\\x10~s\xaf\xeb\xebp\xbfC\xa4\xdb\xdfT\a\x1d7,\xbe\xa6q\xb8\xb0\xc4\xffv\xc1\x02gO\x12$\x82\xf6ut`\x8d\xf6\xf6(a\x80\x1e\x88e\xfc.!D\ti\r\xb0\xb7\bu\x1dV\xc4To\x1cI\x10\xff\xba!\x8d\x04rR\x91'\xa1\x1e8E\xa2\xfc\xc7)\x1a\a\xc7ɮ,\xb1\x98\xcfÚyW\x927U*ع\x9aҁ\u009d\xa5\xce\xe5\xb1\xfc\xa3\xc1u|\xdf\xc1z\xaf\x15\xb2;\x92*\xe7\x9d Fᚍ\xa4\x0e\x97y\x13yx\x1d\xef\xfb)-\xa6\x87\xa9.\xad>N\xb5\xea\xca\x12\xff\xbb\x96h\x1dHJ\x1d\xa5\x88d\x84\xee\x9b\xc3\xeaE>P\x87\xf7=\x80 \x93\xe1g\tK\xf2v\x82\f\xe7\xe5\xd4\x7f\xc7\xe1>q\xb7\xc7\xe5v\xaf\xf3\xbdϔ\xde\xfb\"\x11\xfa\x1c\xc5\xc4E\xef\xd0\xfb_VF\xe5\xcaUI\x18~\xbeYo\xdbR\xbcWR*\x87\xb9\xc3\xf7\x10\xe3\x88c\xf16\xe9]\x0f\xf6\xe6\xfbE\xac\x18-i\f&\xc8\xee\xe8\xae\x13\x83\b\x85\x85ހ%Q\x8ct\b\x16e^\x8d\x15&\xd5#\x0f\xa9\t\xabX[\"\xaeU*SwO\xe9\xe5\xdd\xf4\x9d\x9e_I\xea\xba\xfb,W\x19׆Ťܾ~O\xcb\x11\xb0/\xe1vb\x02\xf3\xc6\xed\xa8\x05\xf7M\x82,\xeaY\xc0\x17HO̡\t\xcb\xfds\xe3\xf3\xc54\x11G\x837\x91\x0f&\xa8\xeal\x89e\xfc:\x0f\xd7\xf15\xbc\xd7\xfb\tJ\x00\xee\xc0\xd2nJy\xab\xae\xa6\xb0y]\xb5R\xc4\x1d\xbf\xaa\xe0\x19ǋ\xdb\xdf\xec9\x04^\xc0\x8a\xa7\xae\xc6bJ\x1f\xd1\xe2?\x10\xeb\x9b{$\x16\x01\xbfH\xe7=@\xd0\t}\xa5\b\xf30ґ\bY\x8c\xc3\b\xf2\xf1nNӃ\xed\xe2M\xdc/\xebl$\x96Z\x94\xfb\x12\xec 4\x0fs\xfb\xc5\xd5\x03\xebPq\xcfNI\xab\xa3\xe9\xbd\bi\x14\x85\xf5\x1aq\x11G^\x86q\x18)\xe0\xb6G\xb8Y\xc6t\aA\x16l\xa7<\x82\x9d\xde\xef\x1dz\xbf\xa7\xb0j\xbd}\xb0J\xc8\x16\xd2\v\xbf\xab\xe7\x1f\xe3\xbcp\xb5\x81\xa6\x9d\xbc\x9f\x97i\x90\x93B\x0ekl\xb0\x8bl\x93\x992 \xbb\xb1l\xda\xfb\xb0@\xe3\xfa\x12j\x8fOd\x8d\x1a\x97\x06,\xe9q\xba\xbcf[IǿT\xc7\xda2\xcfҍe7C\xd0\xc0\xae\x1a\xf8\xd7\xd8_\xd2z\x8e'\x1dz\xc3Fy\x99\ue870C\xfe\x14,\xe08JL\xad\x1bˌ]\"\x95\xf9U\xfa\x17V\xe9\x18\xad\xf7\xda\f\xcb\x0fL\x9c@\xb6\xf5\x16\xc4b\xaa\xef{\x14\x17\xae\xc1\xe2$\a\xcb\xe3r\xb0ԇ\x0eq\xd1\xc5\"\xe8\x15\x04)\xd3C\xb0H\xecX\x11\xc3\xd6\xf2\x88\xbc,n\xfae-\xb27\xfb\xe0\xd8\xfba\x19\xc5\xfbj<\x1ac \x92\x06q\xf5{1\xd7\xee\xa3X\xca\xc9U\"\xfcE!\xaf7\x87h;Ц\x99@\xde\xc0\xfaK\x8f\xc7\\\xbf\xa9\"\x90\r\xd2q\xbbS4h\x1dҹo\xf7\xb8\xca{\xb4\xf8'bE^\xa3dl6\x88۾\x8d\xf9ԟ\xc0\x02t\xb3DL\xafH\xfd\xe8KݼDN\x83EXL\xa8\r+(\x1b\x1bA\x9a\xe4=☏m\x01q\x1fA[\xd8\x13\xb0\rt\x9e\xc7ܼ7\xa4\x88A\xd5\x1b\xab%\xfd\xf2\x92\x88\x13\xd2\xf2`\xaec\xdfrj\x97\xa4\x18\xb7S\xa1YD1T\xba\xf5p,\x960L^\xb8\xc1\x9e\x8aU)N\x15\x17\xcbK\x1d\x1b\xe4\xddo\xbc\f\xdcn*\xab\x80,>\xae\xa3t\x12^\xb3\x88\xfc~\xcfa\xd0\xc0\xc0\xc5\x0f4\x9e\x1dԮH+4\xdcV\xbd\x8b\xa9\x7f\f$-\x04wq\x85\xc6\xe1H\xcc\x05\xfb\xa0$\xcc\x1aI\xdeN\xef\xd8 )\xf6\x12\x96\x93U\xe9N\xc3g\xeb\xdc\xe7RnL\xd7\x12\x17zN\x87\x99q]\xb4\x1a\x15k\x14Aܣ\x83\xc2N~\x03\x05W`\x85Lߥ\xef\x06eob\xfd\xb1.\x97\xca7Ev\xcep̗\x9f\x93a\xff\x92T\xbc\xd5!\xb9\xe7ߥ\x16Ε\x1d\xb4l\x80\xcd\xc5k\x92\xd2\xcdD\xdb04v\xecH\x90_4\x8b\xfeU\xed\x17\a>Fи,-\xd8Y\x12\xe8w$\x13\xb4M\x12Gb\x9e\xc5n,\xe40$\xe9\a\x9a&\xae\x98\x97\x1e<z\x00M\xc6Dq\xeaGR\xf8lGS\xbe\xb1\xf3;\x15\xfbHm\xcdcA\xceX\xca!\xaa\xc9\xd3\x19-}7'q>\x90T,\xb7\x15Ù)|\xb6\xbbe\x0f\x9dFr\xdd\x14\x93\x80\x1f\x87\x1bNL\xa90\xd5\x10\xc8\x18\x82\x8e\xe5oP\xff=7\x92B\v\x96\x9e\xb1\x00\x8b\xa9\xa4\r\x1b\xb0«i\x98\am \x11\x88\xeb\x1e?\x88\x98\xb2:\xaa!\x90Q\x04A\xb0\x15\x03h\"\x9ado\xb9n\xf1i\xc4S\x9a\x97\xa9\x03h^Vc\x99\x05\x888\x12'\x90\xa1\x14\xeeC8P\x90\x93\xae;\x9c\xf4U4:t\xe8\x19\x871\xb0\xf0\xa6'A\x12W\xb1Z=\x02\x19H\xf6G\x17\x96\b\xb9\v\xe9\x8d94J\xd2u\x0f0\x02Y\x197\x81T\x13\a\x19\"\x02q\xedF\a\x92\x04\x99%\t\xba=Vc^\r\xb6\x90\xadЪ\x05\xbdB\xf6M5\x18\x89e\a,\x1b\xa0\x04\xd2JL[\\D%\x90V\x82\xd2\xc6<\xc1\x86\x93\x03\x05Oc\xc9~?\xc1b\x0fa\xb1+\xe6\xb7\xdf\vK\xb0ۜ \xdd|\xad\xae\x7f;\xb6\xafǆ\b\xd7\xff \xd6\xf1}\xce\x00\x9b\x97\x15\xde\xfaLT\xbd\x1c\x8a\xb5\xa9q\xd2\xe3`\x06\x1e\x8e\xd7\xfb\xff\x8a\xcar\xa0vê\xfb\xfe!\x9bm\xa3~\xbe\tۅ\xe99\x82\x8e\x84\v<[\xe7|\xc2\xf5\x1as{\xf3}5\xc56R\xadp\x85g\x13\x9fD\x82\xb9i#\xb1\xaa:7\x89\xfb30\xf1uq\xfe\xf9X\xd9\xed\fI\x87=\xc44N\xc76\x8fq\xe5\xaa\x1b\xb0\xfeQߤ\xb0\x12\xb1\x1dk>\xe1g\xf0~\x04\vxu\x8a\t\xfd\b\xab\x01wI\x95C\xb08G\x9b~ށ\xa0\x81\xc6\x1fHi\x13\xb5\x1a\xc3\xed\xe1\xe86,J\x8c@\xc6\x10d\x91\xae$\xd9\xfd7\x92\xc61X}\xc6r,\x97j\x95\xbc)oI]r\x91\xddOP\xba\xbf\x15X\x1b\xd1ٔ\xaea\xd8\x1f\xeb\x9a\xf2\x9c\x88\xe5\r\xacA\xdf\xc3X}\xc8\x03XT\x7f\xa3ο\x9c\x94\xb6\xe0\xac\x03>G\x90\x05=3\x0e\t\x1a\xd5\x06i\xf2\xbc\x04]\x11\xf5\xe4w\n~-\x8e\xbd\x15\x96$\xe7*\xf4\x96a\xb5\xd2_\x95\xc4x\xa0\x0f\x9bnp/\x04\xf2\xb0\x8eM\xb1\x9a\x96[\xb1\x1a\xfc[e\x88wau\xf8\xcf`\t\x8b\xcf\r\xe0\xb9\xf0\x9dEmqH\x90j\b\xc4y\t:\a8\x81 5sn\x89\xbf\x1f'\xce\xfeb\x1f緈ᔋ̿\xae\xe3q\x19\xf6\x17\x10d/\xb8\x12\xe2\x81\x0e\xbf\f:\xd1@a\xa3G\\\xddĳA\xfc;\x11\x93\xb5\x88\x17\xf7\xf1\xbdV\x11H%nم\x92&\xa3E|\x1b3\xe2\xf8\x17\xd6\x151\x9d\x86\xa4\b\xa4\x81`\xf7&W\x19\x97\xa1\xe7\x18mIe{\xf49\t\xb2\xb2\x82\xef.\x96j5\"\x1b\xe2\x1e\xe8\x88A;\x8aM\x824f\x12\xa4,&\xeax\xa6BU\xb7\x85\xf2\x9dR|U\xab\x89\x81W\xefQ\t6\x14\x99\x01\x89I\x90bN\x99\xa1'\xc6b\xee\xd7\xe7+$\x90\xe6\nm\xb9\xe5\xfan[6\xc4}\x12Hb\x12\xc4\xef'\xd5@\xf2\x9be\xa6\x11\xa3\xb5\x90\x17\x84 \x90|\x05\xdf}Q\xdf\x1d\x9c\rqY\x02I\xd4\x06\xc9{\x86acF %1R\xe3\xf2r\x05\xdfm\xd2Qi\xf2c\x031\xe5\x1a\xbd\xc3Н\x16\x1b$WD \x8d\xd9\xdc\U00100ac9^W!\x814Ryo\xe3\x06ҵ\xfdZZЙ\x16\x1b\xa4\xdb{\x18\xd7g*CO\xb5\xa9\x98\xab\xf5%\x95G\x87\xf8nf\xfb\xf5DWZl\x90\x1c\xe6\x7fw\xba^F =\xe1\x8fO_\x98\xa0\xb9\xa8\xb43LF\x1c\xbd\xaf\xcbX\x11\x95@\\\x17\xf0|&Az\x85\xeb\xf8\xd2W\x1b\xccf\xac\xe9^\x03\x95m\x02\xe4wc\xcc\xd0;\x814&M \xeb<Q6$\x9b\x9b\x1eX\x88\xa5]\x1fV\xc1w\x0f\xd5\xe7fXp\xb1\x1c6ј\xafφ\xb8\xac\r\x92(\x81\xb8F\xcf\xf9\x90\xc6\xe5@\xc23X\x12\xe1\xc9}H\xd8/Iʜ\xabE\x7f~\x1f\xd7\xfd\"\x96ѻ \x1bⲪg\xe2j\xe8U2@;\xb0\xad\xcf2\xf4\xc4\x11\x14\xee|\xdbH\xe0\x81j\x12Q\xe4\xb1}\xd2\xc1\xd2\u05fb\xb0=\xd4]]u+\x16\x14\x1c\x86\xb5\x18\xcdc)\xed\x19zb\x12\x85\x1b:U\xad\xfaW\xe3*|ӓ ó\xb9)\x89\xdfc\xe9\xee_\xc3Z\xf0\xdc!\xc9;\t\xdb&a\n\x96\xb6~\x8e\xbe\x7f\x02p'Vj;\x0fK]\xdf \t3M\xcc\xe8{\x92:\x19\xea\x80j\bd#\x81\xbb1K{\xe8\x1d\x17c\xe9&\x9f\xc4Jn\x9b\xb1\xda\xe9ǰ\xed\xd4\x1e\xf0t\xe7\x15\"\x9c\xf7a\x1b\xff\xec\x88E\xcc_չ\x0fc\x1d\xf53\xf4\x03\x02Y\xe3\x11Hf\xa4\x97\xc7mXKP\xb7YO7A]z1V\x03\xbf\x91\xb4i\xf1\xbe\xbf!\x1b\xc6\xfeE +5q-Xb\x9e둕\xa14\xc2n\x11\xd1E\x96%\x9d8\x1a\xab$\x90\x9c\bcl&E2\xa4\x00\xf94\x11\xc8r\xa9\b\x8d\"\x90\xcc\x0eɐ4\xfc\xf4\x92\\\xd2\x04\xb2\x8c\xa0\xc0g\x93L\x82dH\x99\xc9Н4\x81\xbcN\xd0QqhF \x192\x1b\xa4\x10k\xb1\xdeO\x8e@\xb2hz\x86\x8c@\x8a\xf0\x86>\x87\x90U\xb8e\xc8\b\xa4\a\x16\xeas0\x16\x1d\xceҰ3\xa4e=\xe7\xd3@ \v<\x02ي\xac\xb20C\xb2h)2\xd2\xf3I\x13\xc8\v\xde\xcf[\x92զg\xc8T\xac\x02<\xeb\xfd<>#\x90\f\x19\x81\x14\xe2\x15\x82D\xbb\x8c@2d\x04R\xc6\x0e\x99@\xd6i#CF =\xe0:\x97\x8f\x05\xb6Ɇ4CF \xa5\r\xf5v\xac\xa6:C\x86\x8c@<\xf8\xfbbl\x99\ri\x86\x8c@\n\xe1o\xfa\xb2]6\xa4\x192\x02)\xc4k\x04\xd5n\xdbfC\x9a!#\x90B\xbc\x01,\xd5ϓ\xb2!͐ \xba\x8b\xd6v*\xf6\a\xc9\x11l>\xb9\x15Y\x87\x93\fɡ+\xe6\xb5\xcd\xff\x031Cw.\xcbE\xdbK\x00\x00\x00\x00IEND\xaeB`\x82",
+
+	"static/js/app.js": "/* Copyright 2012 The Go Authors.   All rights reserved.\n * Use of this source code is governed by a BSD-style\n * license that can be found in the LICENSE file.\n */\n'use strict';\n\nangular.module('tour', ['ui', 'tour.services', 'tour.controllers', 'tour.directives', 'tour.values', 'ng']).\n\nconfig(['$routeProvider', '$locationProvider',\n    function($routeProvider, $locationProvider) {\n        $routeProvider.\n        when('/', {\n            redirectTo: '/welcome/1'\n        }).\n        when('/list', {\n            templateUrl: '/static/partials/list.html',\n        }).\n        when('/:lessonId/:pageNumber', {\n            templateUrl: '/static/partials/editor.html',\n            controller: 'EditorCtrl'\n        }).\n        when('/:lessonId', {\n            redirectTo: '/:lessonId/1'\n        }).\n        otherwise({\n            redirectTo: '/'\n        });\n\n        $locationProvider.html5Mode(true);\n    }\n]);\n",
+
+	"static/js/controllers.js": "/* Copyright 2012 The Go Authors.   All rights reserved.\n * Use of this source code is governed by a BSD-style\n * license that can be found in the LICENSE file.\n */\n'use strict';\n\n/* Controllers */\n\n\nangular.module('tour.controllers', []).\n\n// Navigation controller\ncontroller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n', 'run', 'check', 'fmt', 'editor', 'analytics', 'storage', 'progress',\n    function($scope, $routeParams, $location, toc, i18n, run, check, fmt, editor, analytics, storage, progress) {\n        var lessons = [];\n        toc.lessons.then(function(v) {\n            lessons = v;\n            $scope.gotoPage($scope.curPage);\n\n            // Store changes on the current file to local storage.\n            $scope.$watch(function() {\n                var f = file();\n                return f && f.Content;\n            }, function(val) {\n                var key = $scope.lessonId + '.' + ($scope.curPage - 1) + '.' + $scope.curFile;\n                storage.set(key, val);\n            });\n        });\n\n        $scope.toc = toc;\n        $scope.lessonId = $routeParams.lessonId;\n        $scope.curPage = parseInt($routeParams.pageNumber);\n        $scope.curFile = 0;\n\n        $scope.nextPage = function() {\n            $scope.gotoPage($scope.curPage + 1);\n        };\n        $scope.prevPage = function() {\n            $scope.gotoPage($scope.curPage - 1);\n        };\n        $scope.gotoPage = function(page) {\n            var l = $routeParams.lessonId;\n            if (page >= 1 && page <= lessons[$scope.lessonId].Pages.length) {\n                $scope.curPage = page;\n                progress.visit(l, page);\n            } else {\n                l = (page < 1) ? toc.prevLesson(l) : toc.nextLesson(l);\n                if (l === '') { // If there's not previous or next\n                    $location.path('/list');\n                    return;\n                }\n                page = (page < 1) ? lessons[l].Pages.length : 1;\n            }\n            $location.path('/' + l + '/' + page);\n            $scope.openFile($scope.curFile);\n            analytics.trackView();\n        };\n        $scope.openFile = function(file) {\n            $scope.curFile = file;\n            editor.paint();\n        };\n\n        function log(mode, text) {\n            $('.output.active').html('<pre class=\"' + mode + '\">' + text + '</pre>');\n        }\n\n        function clearOutput() {\n            $('.output.active').html('');\n        }\n\n        function file() {\n            return lessons[$scope.lessonId].Pages[$scope.curPage - 1].Files[$scope.curFile];\n        }\n\n        $scope.run = function() {\n            log('info', i18n.l('waiting'));\n            var f = file();\n            var lesson = $scope.lessonId,\n                page = $scope.curPage;\n            run(f.Content, $('.output.active > pre')[0], {\n                path: f.Name\n            }, function(ok) {\n                progress.run(lesson, page, f.Name, ok);\n            });\n        };\n\n        // check runs the hidden checks of the exercise on the current file.\n        $scope.check = function() {\n            log('info', i18n.l('waiting'));\n            var f = file();\n            var lesson = $scope.lessonId,\n                page = $scope.curPage;\n            check(f.Content, lesson + '/' + f.Name, $('.output.active > pre')[0], function(ok) {\n                progress.run(lesson, page, f.Name, ok);\n            });\n        };\n\n        $scope.format = function() {\n            log('info', i18n.l('waiting'));\n            fmt(file().Content).then(\n                function(data) {\n                    if (data.data.Error !== '') {\n                        log('stderr', data.data.Error);\n                        return;\n                    }\n                    clearOutput();\n                    file().Content = data.data.Body;\n                },\n                function(error) {\n                    log('stderr', error);\n                });\n        };\n\n        $scope.reset = function() {\n            file().Content = file().OrigContent;\n        };\n    }\n]).\n\n// Session controller, to resume the tour on another machine.\ncontroller('SessionCtrl', ['$scope', 'i18n', 'progress',\n    function($scope, i18n, progress) {\n        $scope.enabled = progress.enabled;\n        $scope.session = null;\n        $scope.name = '';\n        $scope.pin = '';\n        $scope.error = '';\n\n        var show = function(data) {\n            $scope.session = data.data;\n            $scope.name = data.data.Name;\n            $scope.pin = '';\n            $scope.error = '';\n        };\n        var fail = function(data) {\n            $scope.error = i18n.l(data.status == 403 ? 'pinwrong' : 'pinrequired');\n        };\n        if (progress.enabled) {\n            progress.session().then(show);\n        }\n\n        $scope.resume = function() {\n            progress.resume($scope.name, $scope.pin).then(show, fail);\n        };\n    }\n]);\n",
+
+	"static/js/directives.js": "/* Copyright 2012 The Go Authors.   All rights reserved.\n * Use of this source code is governed by a BSD-style\n * license that can be found in the LICENSE file.\n */\n'use strict';\n\n/* Directives */\n\nangular.module('tour.directives', []).\n\n// onpageup executes the given expression when Page Up is released.\ndirective('onpageup', function() {\n    return function(scope, elm, attrs) {\n        elm.attr('tabindex', 0);\n        elm.keyup(function(evt) {\n            var key = evt.key || evt.keyCode;\n            if (key == 33) {\n                scope.$apply(attrs.onpageup);\n                evt.preventDefault();\n            }\n        });\n    };\n}).\n\n// onpagedown executes the given expression when Page Down is released.\ndirective('onpagedown', function() {\n    return function(scope, elm, attrs) {\n        elm.attr('tabindex', 0);\n        elm.keyup(function(evt) {\n            var key = evt.key || evt.keyCode;\n            if (key == 34) {\n                scope.$apply(attrs.onpagedown);\n                evt.preventDefault();\n            }\n        });\n    };\n}).\n\n// autofocus sets the focus on the given element when the condition is true.\ndirective('autofocus', function() {\n    return function(scope, elm, attrs) {\n        elm.attr('tabindex', 0);\n        scope.$watch(function() {\n            return scope.$eval(attrs.autofocus);\n        }, function(val) {\n            if (val === true) $(elm).focus();\n        });\n    };\n}).\n\n// syntax-checkbox activates and deactivates\ndirective('syntaxCheckbox', ['editor',\n    function(editor) {\n        return function(scope, elm) {\n            elm.click(function() {\n                editor.toggleSyntax();\n                scope.$digest();\n            });\n            scope.editor = editor;\n        };\n    }\n]).\n\n// verticalSlide creates a sliding separator between the left and right elements.\n// e.g.:\n// <div id=\"header\">Some content</div>\n// <div vertical-slide top=\"#header\" bottom=\"#footer\"></div>\n// <div id=\"footer\">Some footer</div>\ndirective('verticalSlide', ['editor',\n    function(editor) {\n        return function(scope, elm, attrs) {\n            var moveTo = function(x) {\n                if (x < 0) {\n                    x = 0;\n                }\n                if (x > $(window).width()) {\n                    x = $(window).width();\n                }\n                elm.css('left', x);\n                $(attrs.left).width(x);\n                $(attrs.right).offset({\n                    left: x\n                });\n                editor.x = x;\n            };\n\n            elm.draggable({\n                axis: 'x',\n                drag: function(event) {\n                    moveTo(event.clientX);\n                    return true;\n                },\n                containment: 'parent',\n            });\n\n            if (editor.x !== undefined) {\n                moveTo(editor.x);\n            }\n        };\n    }\n]).\n\n// horizontalSlide creates a sliding separator between the top and bottom elements.\n// <div id=\"menu\">Some menu</div>\n// <div vertical-slide left=\"#menu\" bottom=\"#content\"></div>\n// <div id=\"content\">Some content</div>\ndirective('horizontalSlide', ['editor',\n    function(editor) {\n        return function(scope, elm, attrs) {\n            var moveTo = function(y) {\n                var top = $(attrs.top).offset().top;\n                if (y < top) {\n                    y = top;\n                }\n                elm.css('top', y - top);\n                $(attrs.top).height(y - top);\n                $(attrs.bottom).offset({\n                    top: y,\n                    height: 0\n                });\n                editor.y = y;\n            };\n            elm.draggable({\n                axis: 'y',\n                drag: function(event) {\n                    moveTo(event.clientY);\n                    return true;\n                },\n                containment: 'parent',\n            });\n\n            if (editor.y !== undefined) {\n                moveTo(editor.y);\n            }\n        };\n    }\n]).\n\n// language-switch shows the language of the content and changes it on\n// click, or when L is pressed outside of the editor.\ndirective('languageSwitch', ['contentLang', 'i18n',\n    function(contentLang, i18n) {\n        return {\n            restrict: 'A',\n            template: '<a class=\"lang-switch\" href=\"\" ng-click=\"switchLang()\">{{label()}}</a>',\n            link: function(scope) {\n                scope.label = function() {\n                    return i18n.l('lang' + contentLang.lang);\n                };\n                scope.switchLang = contentLang.next;\n                $(document).keyup(function(evt) {\n                    if ($(evt.target).closest('.CodeMirror, input, textarea').length > 0) return;\n                    var key = evt.key || evt.keyCode;\n                    if (key == 'l' || key == 76) {\n                        scope.$apply(contentLang.next);\n                    }\n                });\n            }\n        };\n    }\n]).\n\ndirective('tableOfContentsButton', function() {\n    var speed = 250;\n    return {\n        restrict: 'A',\n        templateUrl: '/static/partials/toc-button.html',\n        link: function(scope, elm, attrs) {\n            elm.on('click', function() {\n                var toc = $(attrs.tableOfContentsButton);\n                // hide all non active lessons before displaying the toc.\n                var visible = toc.css('display') != 'none';\n                if (!visible) {\n                    toc.find('.toc-lesson:not(.active) .toc-page').hide();\n                    toc.find('.toc-lesson.active .toc-page').show();\n                }\n                toc.toggle('slide', {\n                    direction: 'right'\n                }, speed);\n\n                // if fullscreen hide the rest of the content when showing the atoc.\n                var fullScreen = toc.width() == $(window).width();\n                if (fullScreen) $('#editor-container')[visible ? 'show' : 'hide']();\n            });\n        }\n    };\n}).\n\n// side bar with dynamic table of contents\ndirective('tableOfContents', ['$routeParams', 'toc',\n    function($routeParams, toc) {\n        var speed = 250;\n        return {\n            restrict: 'A',\n            templateUrl: '/static/partials/toc.html',\n            link: function(scope, elm) {\n                scope.toc = toc;\n                scope.params = $routeParams;\n\n                scope.toggleLesson = function(id) {\n                    var l = $('#toc-l-' + id + ' .toc-page');\n                    l[l.css('display') == 'none' ? 'slideDown' : 'slideUp']();\n                };\n\n                scope.$watch(function() {\n                    return scope.params.lessonId + scope.params.lessonId;\n                }, function() {\n                    $('.toc-lesson:not(#toc-l-' + scope.params.lessonId + ') .toc-page').slideUp(speed);\n                });\n\n                scope.hideTOC = function(fullScreenOnly) {\n                    var fullScreen = elm.find('.toc').width() == $(window).width();\n                    if (fullScreenOnly && !fullScreen) {\n                        return;\n                    }\n                    $('.toc').toggle('slide', {\n                        direction: 'right'\n                    }, speed);\n                };\n            }\n        };\n    }\n]);",
+
+	"static/js/services.js": "/* Copyright 2012 The Go Authors.   All rights reserved.\n * Use of this source code is governed by a BSD-style\n * license that can be found in the LICENSE file.\n */\n'use strict';\n\n/* Services */\n\nangular.module('tour.services', []).\n\n// Google Analytics\nfactory('analytics', ['$window',\n    function(win) {\n        var track = win.trackPageview || (function() {});\n        return {\n            trackView: track\n        };\n    }\n]).\n\n// Internationalization\nfactory('i18n', ['translation',\n    function(translation) {\n        return {\n            l: function(key) {\n                if (translation[key]) return translation[key];\n                return key;\n            }\n        };\n    }\n]).\n\n// Translation filter for templates: {{'run' | i18n}}\nfilter('i18n', ['i18n',\n    function(i18n) {\n        return i18n.l;\n    }\n]).\n\n// Running code\nfactory('run', ['$window', 'editor',\n    function(win, editor) {\n        // The playground transport passes on only the text of the build\n        // errors; keep the diagnostics of the last reply of /compile, see\n        // internal/sandbox/diag.go, to show their explanations.\n        var diagnostics = [];\n        $.ajaxPrefilter('json', function(options) {\n            if (options.url != '/compile') return;\n            options.dataFilter = function(text) {\n                try {\n                    diagnostics = $.parseJSON(text).Diagnostics || [];\n                } catch (e) {\n                    diagnostics = [];\n                }\n                return text;\n            };\n        });\n        // writeInterceptor highlights the lines with errors and calls done,\n        // if given, with whether the program built and exited successfully.\n        var writeInterceptor = function(writer, done) {\n            var finished = false;\n            var finish = function(ok) {\n                if (!finished && done) done(ok);\n                finished = true;\n            };\n            return function(write) {\n                if (write.Kind == 'start') {\n                    finished = false;\n                } else if (write.Kind == 'end') {\n                    // The body of the end event holds the exit status or\n                    // the timeout error.\n                    finish(!write.Body);\n                } else if (write.Kind == 'system') {\n                    // Build failures end with a system event.\n                    finish(false);\n                }\n                if (write.Kind == 'stderr') {\n                    editor.highlightErrors(write.Body, diagnostics);\n                }\n                writer(write);\n            };\n        };\n        return function(code, output, options, done) {\n            // PlaygroundOutput is defined in playground.js which is prepended\n            // to the generated script.js in gotour/tour.go.\n            // The next line removes the jshint warning.\n            // global PlaygroundOutput\n            win.transport.Run(code, writeInterceptor(PlaygroundOutput(output), done), options);\n        };\n    }\n]).\n\n// Checking the solution of an exercise with its hidden checks; see\n// gotour/check.go. The reply is that of a program run, with the result of\n// each check.\nfactory('check', ['$window', 'i18n', 'editor',\n    function(win, i18n, editor) {\n        return function(code, exercise, output, done) {\n            var write = PlaygroundOutput(output);\n            $.ajax('/compile', {\n                type: 'POST',\n                data: {\n                    'version': 2,\n                    'body': code,\n                    'check': exercise\n                },\n                dataType: 'json',\n                success: function(data) {\n                    write({Kind: 'start'});\n                    if (data.Errors && !data.IsTest) {\n                        editor.highlightErrors(data.Errors, data.Diagnostics || []);\n                        write({Kind: 'stderr', Body: data.Errors});\n                        write({Kind: 'system', Body: '\\nGo build failed.'});\n                        done(false);\n                        return;\n                    }\n                    for (var i = 0; i < data.Tests.length; i++) {\n                        var t = data.Tests[i];\n                        write({\n                            Kind: t.Passed ? 'stdout' : 'stderr',\n                            Body: (t.Passed ? '✓ ' : '✗ ') + t.Name + '\\n'\n                        });\n                    }\n                    var ok = data.TestsFailed === 0;\n                    write({\n                        Kind: 'system',\n                        Body: '\\n' + (ok ? i18n.l('checkpass') : i18n.l('checkfail').replace('{n}', data.TestsFailed))\n                    });\n                    done(ok);\n                },\n                error: function() {\n                    write({Kind: 'start'});\n                    write({Kind: 'stderr', Body: i18n.l('errcomm')});\n                    write({Kind: 'end'});\n                    done(false);\n                }\n            });\n        };\n    }\n]).\n\n// Formatting code\nfactory('fmt', ['$http',\n    function($http) {\n        return function(body) {\n            var params = $.param({\n                'body': body\n            });\n            var headers = {\n                'Content-Type': 'application/x-www-form-urlencoded'\n            };\n            return $http.post('/fmt', params, {\n                headers: headers\n            });\n        };\n    }\n]).\n\n// Progress kept by the tour server, if it keeps it; see gotour/progress.go.\nfactory('progress', ['$http', '$log', 'serverProgress',\n    function($http, $log, serverProgress) {\n        var headers = {\n            'Content-Type': 'application/x-www-form-urlencoded'\n        };\n        var post = function(path, params) {\n            return $http.post('/progress/' + path, $.param(params), {\n                headers: headers\n            });\n        };\n        var report = function(path, params) {\n            if (!serverProgress) return;\n            post(path, params).then(null, function(error) {\n                $log.error('error reporting progress: ', error);\n            });\n        };\n        return {\n            enabled: serverProgress,\n            // session returns a promise of the session of this browser.\n            session: function() {\n                return $http.get('/progress/session');\n            },\n            // resume switches to the session with the given name and PIN,\n            // creating it if needed, and returns a promise of it.\n            resume: function(name, pin) {\n                return post('session', {\n                    name: name,\n                    pin: pin\n                });\n            },\n            visit: function(lesson, page) {\n                report('visit', {\n                    lesson: lesson,\n                    page: page\n                });\n            },\n            run: function(lesson, page, file, ok) {\n                report('run', {\n                    lesson: lesson,\n                    page: page,\n                    file: file,\n                    ok: ok\n                });\n            }\n        };\n    }\n]).\n\n// Local storage, persistent to page refreshing.\nfactory('storage', ['$window',\n    function(win) {\n        try {\n            // This will raise an exception if cookies are disabled.\n            win.localStorage = win.localStorage;\n            return {\n                get: function(key) {\n                    return win.localStorage.getItem(key);\n                },\n                set: function(key, val) {\n                    win.localStorage.setItem(key, val);\n                }\n            };\n        } catch (e) {\n            return {\n                get: function() {\n                    return null;\n                },\n                set: function() {}\n            };\n        }\n    }\n]).\n\n// Language of the content of bilingual pages: 'en', 'zh' or 'both'. The\n// choice is kept in local storage, so it persists across lessons and\n// visits, and is shown by a class on the body: lang-en, lang-zh or\n// lang-both.\nfactory('contentLang', ['storage',\n    function(storage) {\n        var langs = ['zh', 'en', 'both'];\n        var ctx = {\n            lang: storage.get('contentLang') || 'zh',\n            set: function(lang) {\n                ctx.lang = lang;\n                storage.set('contentLang', lang);\n                $('body').removeClass('lang-en lang-zh lang-both').addClass('lang-' + lang);\n            },\n            next: function() {\n                ctx.set(langs[(langs.indexOf(ctx.lang) + 1) % langs.length]);\n            }\n        };\n        if (langs.indexOf(ctx.lang) < 0) ctx.lang = 'zh';\n        ctx.set(ctx.lang);\n        return ctx;\n    }\n]).\n\n// Title of a page in the chosen language: {{page | pageTitle}}\nfilter('pageTitle', ['contentLang',\n    function(contentLang) {\n        return function(page) {\n            if (!page) return '';\n            if (!page.TrTitle || contentLang.lang == 'en') return page.Title;\n            if (contentLang.lang == 'both') return page.TrTitle + ' (' + page.Title + ')';\n            return page.TrTitle;\n        };\n    }\n]).\n\n// Editor context service, kept through the whole app.\nfactory('editor', ['$window', 'storage',\n    function(win, storage) {\n        // Line widgets showing the explanations of errors, and the lines\n        // and explanations they show.\n        var widgets = [];\n        var explained = [];\n        var ctx = {\n            syntax: storage.get('syntax') === 'true',\n            toggleSyntax: function() {\n                ctx.syntax = !ctx.syntax;\n                storage.set('syntax', ctx.syntax);\n                ctx.paint();\n            },\n            paint: function() {\n                var mode = ctx.syntax && 'text/x-go' || 'text/x-go-comment';\n                // Wait for codemirror to start.\n                var set = function() {\n                    if ($('.CodeMirror').length > 0) {\n                        var cm = $('.CodeMirror')[0].CodeMirror;\n                        if (cm.getOption('mode') == mode) {\n                            cm.refresh();\n                            return;\n                        }\n                        cm.setOption('mode', mode);\n                    }\n                    win.setTimeout(set, 10);\n                };\n                set();\n            },\n            highlight: function(line, message, explanation) {\n                // Show the explanation below the line, once per line. This\n                // redraws the line, so it comes before the highlighting.\n                var key = line + ':' + explanation;\n                if (explanation && explained.indexOf(key) < 0 && $('.CodeMirror').length > 0) {\n                    explained.push(key);\n                    var node = $('<div class=\"line-explanation\"></div>').text(explanation)[0];\n                    widgets.push($('.CodeMirror')[0].CodeMirror.addLineWidget(line - 1, node));\n                }\n                $('.CodeMirror-code > div:nth-child(' + line + ')')\n                    .addClass('line-error').attr('title', message);\n            },\n            // highlightErrors highlights the lines with the build errors in\n            // text, with the explanations of the matching diagnostics.\n            highlightErrors: function(text, diagnostics) {\n                var lines = text.split('\\n');\n                for (var i in lines) {\n                    var match = lines[i].match(/.*\\.go:([0-9]+):(?:[0-9]+:)? ([^\\n]*)/);\n                    if (match === null) continue;\n                    var explanation = '';\n                    for (var j = 0; j < diagnostics.length; j++) {\n                        var d = diagnostics[j];\n                        if (d.Line == match[1] && d.Message.indexOf(match[2]) === 0) {\n                            explanation = d.Explanation;\n                            break;\n                        }\n                    }\n                    ctx.highlight(match[1], match[2], explanation);\n                }\n            },\n            onChange: function() {\n                $('.line-error').removeClass('line-error').attr('title', null);\n                for (var i = 0; i < widgets.length; i++) {\n                    widgets[i].clear();\n                }\n                widgets = [];\n                explained = [];\n            }\n        };\n        // Set in the window so the onChange function in the codemirror config\n        // can call it.\n        win.codeChanged = ctx.onChange;\n        return ctx;\n    }\n]).\n\n// Table of contents management and navigation\nfactory('toc', ['$http', '$q', '$log', 'tableOfContents', 'storage',\n    function($http, $q, $log, tableOfContents, storage) {\n        var modules = tableOfContents;\n\n        var lessons = {};\n\n        var prevLesson = function(id) {\n            var mod = lessons[id].module;\n            var idx = mod.lessons.indexOf(id);\n            if (idx < 0) return '';\n            if (idx > 0) return mod.lessons[idx - 1];\n\n            idx = modules.indexOf(mod);\n            if (idx <= 0) return '';\n            mod = modules[idx - 1];\n            return mod.lessons[mod.lessons.length - 1];\n        };\n\n        var nextLesson = function(id) {\n            var mod = lessons[id].module;\n            var idx = mod.lessons.indexOf(id);\n            if (idx < 0) return '';\n            if (idx + 1 < mod.lessons.length) return mod.lessons[idx + 1];\n\n            idx = modules.indexOf(mod);\n            if (idx < 0 || modules.length <= idx + 1) return '';\n            mod = modules[idx + 1];\n            return mod.lessons[0];\n        };\n\n        $http.get('/lesson/').then(\n            function(data) {\n                lessons = data.data;\n                for (var m = 0; m < modules.length; m++) {\n                    var module = modules[m];\n                    module.lesson = {};\n                    for (var l = 0; l < modules[m].lessons.length; l++) {\n                        var lessonName = module.lessons[l];\n                        var lesson = lessons[lessonName];\n                        lesson.module = module;\n                        module.lesson[lessonName] = lesson;\n\n                        // replace file contents with locally stored copies.\n                        for (var p = 0; p < lesson.Pages.length; p++) {\n                            var page = lesson.Pages[p];\n                            for (var f = 0; f < page.Files.length; f++) {\n                                page.Files[f].OrigContent = page.Files[f].Content;\n                                var val = storage.get(lessonName + '.' + p + '.' + f);\n                                if (val !== null) {\n                                    page.Files[f].Content = val;\n                                }\n                            }\n                        }\n                    }\n                }\n                moduleQ.resolve(modules);\n                lessonQ.resolve(lessons);\n            },\n            function(error) {\n                $log.error('error loading lessons : ', error);\n                moduleQ.reject(error);\n                lessonQ.reject(error);\n            }\n        );\n\n        var moduleQ = $q.defer();\n        var lessonQ = $q.defer();\n\n        return {\n            modules: moduleQ.promise,\n            lessons: lessonQ.promise,\n            prevLesson: prevLesson,\n            nextLesson: nextLesson\n        };\n    }\n]);\n",
+
+	"static/js/values.js": "/* Copyright 2012 The Go Authors.   All rights reserved.\n * Use of this source code is governed by a BSD-style\n * license that can be found in the LICENSE file.\n */\n'use strict';\n\nangular.module('tour.values', []).\n\n// The tableOfContents and translation values are generated by the tour\n// server from the files in the i18n directory; see gotour/i18n.go. The\n// serverProgress value is generated too; see gotour/progress.go.\n\n// Config for codemirror plugin\nvalue('ui.config', {\n    codemirror: {\n        mode: 'text/x-go',\n        matchBrackets: true,\n        lineNumbers: true,\n        autofocus: true,\n        indentWithTabs: true,\n        indentUnit: 4,\n        tabSize: 4,\n        lineWrapping: true,\n        extraKeys: {\n            'Shift-Enter': function() {\n                $('#run').click();\n            },\n            'Ctrl-Enter': function() {\n                $('#format').click();\n            },\n            'PageDown': function() {\n                return false;\n            },\n            'PageUp': function() {\n                return false;\n            },\n        },\n        // TODO: is there a better way to do this?\n        // AngularJS values can't depend on factories.\n        onChange: function() {\n            if (window.codeChanged !== null) window.codeChanged();\n        }\n    }\n});\n",
+
+	"static/lib/angular-ui.min.js": "/**\n * AngularUI - The companion suite for AngularJS\n * @version v0.4.0 - 2013-02-15\n * @link http://angular-ui.github.com\n * @license MIT License, http://www.opensource.org/licenses/MIT\n */\nangular.module(\"ui.config\",[]).value(\"ui.config\",{}),angular.module(\"ui.filters\",[\"ui.config\"]),angular.module(\"ui.directives\",[\"ui.config\"]),angular.module(\"ui\",[\"ui.filters\",\"ui.directives\",\"ui.config\"]),angular.module(\"ui.directives\").directive(\"uiAnimate\",[\"ui.config\",\"$timeout\",function(e,t){var n={};return angular.isString(e.animate)?n[\"class\"]=e.animate:e.animate&&(n=e.animate),{restrict:\"A\",link:function(e,r,i){var s={};i.uiAnimate&&(s=e.$eval(i.uiAnimate),angular.isString(s)&&(s={\"class\":s})),s=angular.extend({\"class\":\"ui-animate\"},n,s),r.addClass(s[\"class\"]),t(function(){r.removeClass(s[\"class\"])},20,!1)}}}]),angular.module(\"ui.directives\").directive(\"uiCalendar\",[\"ui.config\",\"$parse\",function(e,t){return e.uiCalendar=e.uiCalendar||{},{require:\"ngModel\",restrict:\"A\",link:function(t,n,r,i){function a(){t.calendar=n.html(\"\");var i=t.calendar.fullCalendar(\"getView\");i&&(i=i.name);var o,u={defaultView:i,eventSources:s};r.uiCalendar?o=t.$eval(r.uiCalendar):o={},angular.extend(u,e.uiCalendar,o),t.calendar.fullCalendar(u)}var s=t.$eval(r.ngModel),o=0,u=function(){var e=t.$eval(r.equalsTracker);return o=0,angular.forEach(s,function(e,t){angular.isArray(e)&&(o+=e.length)}),angular.isNumber(e)?o+s.length+e:o+s.length};a(),t.$watch(u,function(e,t){a()})}}}]),angular.module(\"ui.directives\").directive(\"uiCodemirror\",[\"ui.config\",\"$timeout\",function(e,t){\"use strict\";var n=[\"cursorActivity\",\"viewportChange\",\"gutterClick\",\"focus\",\"blur\",\"scroll\",\"update\"];return{restrict:\"A\",require:\"ngModel\",link:function(r,i,s,o){var u,a,f,l,c;if(i[0].type!==\"textarea\")throw new Error(\"uiCodemirror3 can only be applied to a textarea element\");u=e.codemirror||{},a=angular.extend({},u,r.$eval(s.uiCodemirror)),f=function(e){return function(t,n){var i=t.getValue();i!==o.$viewValue&&(o.$setViewValue(i),r.$apply()),typeof e==\"function\"&&e(t,n)}},l=function(){c=CodeMirror.fromTextArea(i[0],a),c.on(\"change\",f(a.onChange));for(var e=0,u=n.length,l;e<u;++e){l=a[\"on\"+n[e].charAt(0).toUpperCase()+n[e].slice(1)];if(l===void 0)continue;if(typeof l!=\"function\")continue;c.on(n[e],l)}o.$formatters.push(function(e){if(angular.isUndefined(e)||e===null)return\"\";if(angular.isObject(e)||angular.isArray(e))throw new Error(\"ui-codemirror cannot use an object or an array as a model\");return e}),o.$render=function(){c.setValue(o.$viewValue)},s.uiRefresh&&r.$watch(s.uiRefresh,function(e,n){e!==n&&t(c.refresh)})},t(l)}}}]),angular.module(\"ui.directives\").directive(\"uiCurrency\",[\"ui.config\",\"currencyFilter\",function(e,t){var n={pos:\"ui-currency-pos\",neg:\"ui-currency-neg\",zero:\"ui-currency-zero\"};return e.currency&&angular.extend(n,e.currency),{restrict:\"EAC\",require:\"ngModel\",link:function(e,r,i,s){var o,u,a;o=angular.extend({},n,e.$eval(i.uiCurrency)),u=function(e){var n;return n=e*1,r.toggleClass(o.pos,n>0),r.toggleClass(o.neg,n<0),r.toggleClass(o.zero,n===0),e===\"\"?r.text(\"\"):r.text(t(n,o.symbol)),!0},s.$render=function(){a=s.$viewValue,r.val(a),u(a)}}}}]),angular.module(\"ui.directives\").directive(\"uiDate\",[\"ui.config\",function(e){\"use strict\";var t;return t={},angular.isObject(e.date)&&angular.extend(t,e.date),{require:\"?ngModel\",link:function(t,n,r,i){var s=function(){return angular.extend({},e.date,t.$eval(r.uiDate))},o=function(){var e=s();if(i){var r=function(){t.$apply(function(){var e=n.datepicker(\"getDate\");n.datepicker(\"setDate\",n.val()),i.$setViewValue(e),n.blur()})};if(e.onSelect){var o=e.onSelect;e.onSelect=function(e,n){r(),t.$apply(function(){o(e,n)})}}else e.onSelect=r;n.bind(\"change\",r),i.$render=function(){var e=i.$viewValue;if(angular.isDefined(e)&&e!==null&&!angular.isDate(e))throw new Error(\"ng-Model value must be a Date object - currently it is a \"+typeof e+\" - use ui-date-format to convert it from a string\");n.datepicker(\"setDate\",e)}}n.datepicker(\"destroy\"),n.datepicker(e),i&&i.$render()};t.$watch(s,o,!0)}}}]).directive(\"uiDateFormat\",[\"ui.config\",function(e){var t={require:\"ngModel\",link:function(t,n,r,i){var s=r.uiDateFormat||e.dateFormat;s?(i.$formatters.push(function(e){if(angular.isString(e))return $.datepicker.parseDate(s,e)}),i.$parsers.push(function(e){if(e)return $.datepicker.formatDate(s,e)})):(i.$formatters.push(function(e){if(angular.isString(e))return new Date(e)}),i.$parsers.push(function(e){if(e)return e.toISOString()}))}};return t}]),angular.module(\"ui.directives\").directive(\"uiEvent\",[\"$parse\",function(e){return function(t,n,r){var i=t.$eval(r.uiEvent);angular.forEach(i,function(r,i){var s=e(r);n.bind(i,function(e){var n=Array.prototype.slice.call(arguments);n=n.splice(1),t.$apply(function(){s(t,{$event:e,$params:n})})})})}}]),angular.module(\"ui.directives\").directive(\"uiIf\",[function(){return{transclude:\"element\",priority:1e3,terminal:!0,restrict:\"A\",compile:function(e,t,n){return function(e,t,r){var i,s;e.$watch(r.uiIf,function(r){i&&(i.remove(),i=undefined),s&&(s.$destroy(),s=undefined),r&&(s=e.$new(),n(s,function(e){i=e,t.after(e)}))})}}}}]),angular.module(\"ui.directives\").directive(\"uiJq\",[\"ui.config\",\"$timeout\",function(t,n){return{restrict:\"A\",compile:function(r,i){if(!angular.isFunction(r[i.uiJq]))throw new Error('ui-jq: The \"'+i.uiJq+'\" function does not exist');var s=t.jq&&t.jq[i.uiJq];return function(t,r,i){function u(){n(function(){r[i.uiJq].apply(r,o)},0,!1)}var o=[];i.uiOptions?(o=t.$eval(\"[\"+i.uiOptions+\"]\"),angular.isObject(s)&&angular.isObject(o[0])&&(o[0]=angular.extend({},s,o[0]))):s&&(o=[s]),i.ngModel&&r.is(\"select,input,textarea\")&&r.on(\"change\",function(){r.trigger(\"input\")}),i.uiRefresh&&t.$watch(i.uiRefresh,function(e){u()}),u()}}}}]),angular.module(\"ui.directives\").factory(\"keypressHelper\",[\"$parse\",function(t){var n={8:\"backspace\",9:\"tab\",13:\"enter\",27:\"esc\",32:\"space\",33:\"pageup\",34:\"pagedown\",35:\"end\",36:\"home\",37:\"left\",38:\"up\",39:\"right\",40:\"down\",45:\"insert\",46:\"delete\"},r=function(e){return e.charAt(0).toUpperCase()+e.slice(1)};return function(e,i,s,o){var u,a=[];u=i.$eval(o[\"ui\"+r(e)]),angular.forEach(u,function(e,n){var r,i;i=t(e),angular.forEach(n.split(\" \"),function(e){r={expression:i,keys:{}},angular.forEach(e.split(\"-\"),function(e){r.keys[e]=!0}),a.push(r)})}),s.bind(e,function(t){var r=t.metaKey||t.altKey,s=t.ctrlKey,o=t.shiftKey,u=t.keyCode;e===\"keypress\"&&!o&&u>=97&&u<=122&&(u-=32),angular.forEach(a,function(e){var u=e.keys[n[t.keyCode]]||e.keys[t.keyCode.toString()]||!1,a=e.keys.alt||!1,f=e.keys.ctrl||!1,l=e.keys.shift||!1;u&&a==r&&f==s&&l==o&&i.$apply(function(){e.expression(i,{$event:t})})})})}}]),angular.module(\"ui.directives\").directive(\"uiKeydown\",[\"keypressHelper\",function(e){return{link:function(t,n,r){e(\"keydown\",t,n,r)}}}]),angular.module(\"ui.directives\").directive(\"uiKeypress\",[\"keypressHelper\",function(e){return{link:function(t,n,r){e(\"keypress\",t,n,r)}}}]),angular.module(\"ui.directives\").directive(\"uiKeyup\",[\"keypressHelper\",function(e){return{link:function(t,n,r){e(\"keyup\",t,n,r)}}}]),function(){function t(e,t,n,r){angular.forEach(t.split(\" \"),function(t){var i={type:\"map-\"+t};google.maps.event.addListener(n,t,function(t){r.triggerHandler(angular.extend({},i,t)),e.$$phase||e.$apply()})})}function n(n,r){e.directive(n,[function(){return{restrict:\"A\",link:function(e,i,s){e.$watch(s[n],function(n){t(e,r,n,i)})}}}])}var e=angular.module(\"ui.directives\");e.directive(\"uiMap\",[\"ui.config\",\"$parse\",function(e,n){var r=\"bounds_changed center_changed click dblclick drag dragend dragstart heading_changed idle maptypeid_changed mousemove mouseout mouseover projection_changed resize rightclick tilesloaded tilt_changed zoom_changed\",i=e.map||{};return{restrict:\"A\",link:function(e,s,o){var u=angular.extend({},i,e.$eval(o.uiOptions)),a=new google.maps.Map(s[0],u),f=n(o.uiMap);f.assign(e,a),t(e,r,a,s)}}}]),e.directive(\"uiMapInfoWindow\",[\"ui.config\",\"$parse\",\"$compile\",function(e,n,r){var i=\"closeclick content_change domready position_changed zindex_changed\",s=e.mapInfoWindow||{};return{link:function(e,o,u){var a=angular.extend({},s,e.$eval(u.uiOptions));a.content=o[0];var f=n(u.uiMapInfoWindow),l=f(e);l||(l=new google.maps.InfoWindow(a),f.assign(e,l)),t(e,i,l,o),o.replaceWith(\"<div></div>\");var c=l.open;l.open=function(n,i,s,u,a,f){r(o.contents())(e),c.call(l,n,i,s,u,a,f)}}}}]),n(\"uiMapMarker\",\"animation_changed click clickable_changed cursor_changed dblclick drag dragend draggable_changed dragstart flat_changed icon_changed mousedown mouseout mouseover mouseup position_changed rightclick shadow_changed shape_changed title_changed visible_changed zindex_changed\"),n(\"uiMapPolyline\",\"click dblclick mousedown mousemove mouseout mouseover mouseup rightclick\"),n(\"uiMapPolygon\",\"click dblclick mousedown mousemove mouseout mouseover mouseup rightclick\"),n(\"uiMapRectangle\",\"bounds_changed click dblclick mousedown mousemove mouseout mouseover mouseup rightclick\"),n(\"uiMapCircle\",\"center_changed click dblclick mousedown mousemove mouseout mouseover mouseup radius_changed rightclick\"),n(\"uiMapGroundOverlay\",\"click dblclick\")}(),angular.module(\"ui.directives\").directive(\"uiMask\",[function(){return{require:\"ngModel\",link:function(e,t,n,r){r.$render=function(){var i=r.$viewValue||\"\";t.val(i),t.mask(e.$eval(n.uiMask))},r.$parsers.push(function(e){var n=t.isMaskValid()||angular.isUndefined(t.isMaskValid())&&t.val().length>0;return r.$setValidity(\"mask\",n),n?e:undefined}),t.bind(\"keyup\",function(){e.$apply(function(){r.$setViewValue(t.mask())})})}}}]),angular.module(\"ui.directives\").directive(\"uiReset\",[\"ui.config\",function(e){var t=null;return e.reset!==undefined&&(t=e.reset),{require:\"ngModel\",link:function(e,n,r,i){var s;s=angular.element('<a class=\"ui-reset\" />'),n.wrap('<span class=\"ui-resetwrap\" />').after(s),s.bind(\"click\",function(n){n.preventDefault(),e.$apply(function(){r.uiReset?i.$setViewValue(e.$eval(r.uiReset)):i.$setViewValue(t),i.$render()})})}}}]),angular.module(\"ui.directives\").directive(\"uiRoute\",[\"$location\",\"$parse\",function(e,t){return{restrict:\"AC\",compile:function(n,r){var i;if(r.uiRoute)i=\"uiRoute\";else if(r.ngHref)i=\"ngHref\";else{if(!r.href)throw new Error(\"uiRoute missing a route or href property on \"+n[0]);i=\"href\"}return function(n,r,s){function a(t){(hash=t.indexOf(\"#\"))>-1&&(t=t.substr(hash+1)),u=function(){o(n,e.path().indexOf(t)>-1)},u()}function f(t){(hash=t.indexOf(\"#\"))>-1&&(t=t.substr(hash+1)),u=function(){var i=new RegExp(\"^\"+t+\"$\",[\"i\"]);o(n,i.test(e.path()))},u()}var o=t(s.ngModel||s.routeModel||\"$uiRoute\").assign,u=angular.noop;switch(i){case\"uiRoute\":s.uiRoute?f(s.uiRoute):s.$observe(\"uiRoute\",f);break;case\"ngHref\":s.ngHref?a(s.ngHref):s.$observe(\"ngHref\",a);break;case\"href\":a(s.href)}n.$on(\"$routeChangeSuccess\",function(){u()})}}}}]),angular.module(\"ui.directives\").directive(\"uiScrollfix\",[\"$window\",function(e){\"use strict\";return{link:function(t,n,r){var i=n.offset().top;r.uiScrollfix?r.uiScrollfix.charAt(0)===\"-\"?r.uiScrollfix=i-r.uiScrollfix.substr(1):r.uiScrollfix.charAt(0)===\"+\"&&(r.uiScrollfix=i+parseFloat(r.uiScrollfix.substr(1))):r.uiScrollfix=i,angular.element(e).on(\"scroll.ui-scrollfix\",function(){var t;if(angular.isDefined(e.pageYOffset))t=e.pageYOffset;else{var i=document.compatMode&&document.compatMode!==\"BackCompat\"?document.documentElement:document.body;t=i.scrollTop}!n.hasClass(\"ui-scrollfix\")&&t>r.uiScrollfix?n.addClass(\"ui-scrollfix\"):n.hasClass(\"ui-scrollfix\")&&t<r.uiScrollfix&&n.removeClass(\"ui-scrollfix\")})}}}]),angular.module(\"ui.directives\").directive(\"uiSelect2\",[\"ui.config\",\"$timeout\",function(e,t){var n={};return e.select2&&angular.extend(n,e.select2),{require:\"?ngModel\",compile:function(e,r){var i,s,o,u=e.is(\"select\"),a=r.multiple!==undefined;return e.is(\"select\")&&(s=e.find(\"option[ng-repeat], option[data-ng-repeat]\"),s.length&&(o=s.attr(\"ng-repeat\")||s.attr(\"data-ng-repeat\"),i=jQuery.trim(o.split(\"|\")[0]).split(\" \").pop())),function(e,r,s,o){var f=angular.extend({},n,e.$eval(s.uiSelect2));u?(delete f.multiple,delete f.initSelection):a&&(f.multiple=!0);if(o){o.$render=function(){u?r.select2(\"val\",o.$modelValue):a?o.$modelValue?angular.isArray(o.$modelValue)?r.select2(\"data\",o.$modelValue):r.select2(\"val\",o.$modelValue):r.select2(\"data\",[]):angular.isObject(o.$modelValue)?r.select2(\"data\",o.$modelValue):r.select2(\"val\",o.$modelValue)},i&&e.$watch(i,function(e,n,i){if(!e)return;t(function(){r.select2(\"val\",o.$viewValue),r.trigger(\"change\")})});if(!u){r.bind(\"change\",function(){e.$apply(function(){o.$setViewValue(r.select2(\"data\"))})});if(f.initSelection){var l=f.initSelection;f.initSelection=function(e,t){l(e,function(e){o.$setViewValue(e),t(e)})}}}}s.$observe(\"disabled\",function(e){r.select2(e&&\"disable\"||\"enable\")}),s.ngMultiple&&e.$watch(s.ngMultiple,function(e){r.select2(f)}),r.val(e.$eval(s.ngModel)),t(function(){r.select2(f),!f.initSelection&&!u&&o.$setViewValue(r.select2(\"data\"))})}}}}]),angular.module(\"ui.directives\").directive(\"uiShow\",[function(){return function(e,t,n){e.$watch(n.uiShow,function(e,n){e?t.addClass(\"ui-show\"):t.removeClass(\"ui-show\")})}}]).directive(\"uiHide\",[function(){return function(e,t,n){e.$watch(n.uiHide,function(e,n){e?t.addClass(\"ui-hide\"):t.removeClass(\"ui-hide\")})}}]).directive(\"uiToggle\",[function(){return function(e,t,n){e.$watch(n.uiToggle,function(e,n){e?t.removeClass(\"ui-hide\").addClass(\"ui-show\"):t.removeClass(\"ui-show\").addClass(\"ui-hide\")})}}]),angular.module(\"ui.directives\").directive(\"uiSortable\",[\"ui.config\",function(e){return{require:\"?ngModel\",link:function(t,n,r,i){var s,o,u,a,f,l,c,h,p;f=angular.extend({},e.sortable,t.$eval(r.uiSortable)),i&&(i.$render=function(){n.sortable(\"refresh\")},u=function(e,t){t.item.sortable={index:t.item.index()}},a=function(e,t){t.item.sortable.resort=i},s=function(e,t){t.item.sortable.relocate=!0,i.$modelValue.splice(t.item.index(),0,t.item.sortable.moved)},o=function(e,t){i.$modelValue.length===1?t.item.sortable.moved=i.$modelValue.splice(0,1)[0]:t.item.sortable.moved=i.$modelValue.splice(t.item.sortable.index,1)[0]},onStop=function(e,n){if(n.item.sortable.resort&&!n.item.sortable.relocate){var r,i;i=n.item.sortable.index,r=n.item.index(),i<r&&r--,n.item.sortable.resort.$modelValue.splice(r,0,n.item.sortable.resort.$modelValue.splice(i,1)[0])}(n.item.sortable.resort||n.item.sortable.relocate)&&t.$apply()},h=f.start,f.start=function(e,t){u(e,t),typeof h==\"function\"&&h(e,t)},_stop=f.stop,f.stop=function(e,t){onStop(e,t),typeof _stop==\"function\"&&_stop(e,t)},p=f.update,f.update=function(e,t){a(e,t),typeof p==\"function\"&&p(e,t)},l=f.receive,f.receive=function(e,t){s(e,t),typeof l==\"function\"&&l(e,t)},c=f.remove,f.remove=function(e,t){o(e,t),typeof c==\"function\"&&c(e,t)}),n.sortable(f)}}}]),angular.module(\"ui.directives\").directive(\"uiTinymce\",[\"ui.config\",function(e){return e.tinymce=e.tinymce||{},{require:\"ngModel\",link:function(t,n,r,i){var s,o={onchange_callback:function(e){e.isDirty()&&(e.save(),i.$setViewValue(n.val()),t.$$phase||t.$apply())},handle_event_callback:function(e){return this.isDirty()&&(this.save(),i.$setViewValue(n.val()),t.$$phase||t.$apply()),!0},setup:function(e){e.onSetContent.add(function(e,r){e.isDirty()&&(e.save(),i.$setViewValue(n.val()),t.$$phase||t.$apply())})}};r.uiTinymce?s=t.$eval(r.uiTinymce):s={},angular.extend(o,e.tinymce,s),setTimeout(function(){n.tinymce(o)})}}}]),angular.module(\"ui.directives\").directive(\"uiValidate\",function(){return{restrict:\"A\",require:\"ngModel\",link:function(e,t,n,r){var i,s,o={},u=e.$eval(n.uiValidate);if(!u)return;angular.isString(u)&&(u={validator:u}),angular.forEach(u,function(t,n){i=function(i){return e.$eval(t,{$value:i})?(r.$setValidity(n,!0),i):(r.$setValidity(n,!1),undefined)},o[n]=i,r.$formatters.push(i),r.$parsers.push(i)}),n.uiValidateWatch&&(s=e.$eval(n.uiValidateWatch),angular.isString(s)?e.$watch(s,function(){angular.forEach(o,function(e,t){e(r.$modelValue)})}):angular.forEach(s,function(t,n){e.$watch(t,function(){o[n](r.$modelValue)})}))}}}),angular.module(\"ui.filters\").filter(\"format\",function(){return function(e,t){if(!e)return e;var n=e.toString(),r;return t===undefined?n:!angular.isArray(t)&&!angular.isObject(t)?n.split(\"$0\").join(t):(r=angular.isArray(t)&&\"$\"||\":\",angular.forEach(t,function(e,t){n=n.split(r+t).join(e)}),n)}}),angular.module(\"ui.filters\").filter(\"highlight\",function(){return function(e,t,n){return t||angular.isNumber(t)?(e=e.toString(),t=t.toString(),n?e.split(t).join('<span class=\"ui-match\">'+t+\"</span>\"):e.replace(new RegExp(t,\"gi\"),'<span class=\"ui-match\">$&</span>')):e}}),angular.module(\"ui.filters\").filter(\"inflector\",function(){function e(e){return e.replace(/^([a-z])|\\s+([a-z])/g,function(e){return e.toUpperCase()})}function t(e,t){return e.replace(/[A-Z]/g,function(e){return t+e})}var n={humanize:function(n){return e(t(n,\" \").split(\"_\").join(\" \"))},underscore:function(e){return e.substr(0,1).toLowerCase()+t(e.substr(1),\"_\").toLowerCase().split(\" \").join(\"_\")},variable:function(t){return t=t.substr(0,1).toLowerCase()+e(t.split(\"_\").join(\" \")).substr(1).split(\" \").join(\"\"),t}};return function(e,t,r){return t!==!1&&angular.isString(e)?(t=t||\"humanize\",n[t](e)):e}}),angular.module(\"ui.filters\").filter(\"unique\",function(){return function(e,t){if(t===!1)return e;if((t||angular.isUndefined(t))&&angular.isArray(e)){var n={},r=[],i=function(e){return angular.isObject(e)&&angular.isString(t)?e[t]:e};angular.forEach(e,function(e){var t,n=!1;for(var s=0;s<r.length;s++)if(angular.equals(i(r[s]),i(e))){n=!0;break}n||r.push(e)}),e=r}return e}});",
+
+	"static/lib/angular.min.js": "/*\n AngularJS v1.0.6\n (c) 2010-2012 Google, Inc. http://angularjs.org\n License: MIT\n*/\n(function(N,Y,q){'use strict';function n(b,a,c){var d;if(b)if(H(b))for(d in b)d!=\"prototype\"&&d!=\"length\"&&d!=\"name\"&&b.hasOwnProperty(d)&&a.call(c,b[d],d);else if(b.forEach&&b.forEach!==n)b.forEach(a,c);else if(!b||typeof b.length!==\"number\"?0:typeof b.hasOwnProperty!=\"function\"&&typeof b.constructor!=\"function\"||b instanceof K||ca&&b instanceof ca||xa.call(b)!==\"[object Object]\"||typeof b.callee===\"function\")for(d=0;d<b.length;d++)a.call(c,b[d],d);else for(d in b)b.hasOwnProperty(d)&&a.call(c,b[d],\nd);return b}function nb(b){var a=[],c;for(c in b)b.hasOwnProperty(c)&&a.push(c);return a.sort()}function fc(b,a,c){for(var d=nb(b),e=0;e<d.length;e++)a.call(c,b[d[e]],d[e]);return d}function ob(b){return function(a,c){b(c,a)}}function ya(){for(var b=aa.length,a;b;){b--;a=aa[b].charCodeAt(0);if(a==57)return aa[b]=\"A\",aa.join(\"\");if(a==90)aa[b]=\"0\";else return aa[b]=String.fromCharCode(a+1),aa.join(\"\")}aa.unshift(\"0\");return aa.join(\"\")}function v(b){n(arguments,function(a){a!==b&&n(a,function(a,d){b[d]=\na})});return b}function G(b){return parseInt(b,10)}function za(b,a){return v(new (v(function(){},{prototype:b})),a)}function w(){}function na(b){return b}function I(b){return function(){return b}}function x(b){return typeof b==\"undefined\"}function y(b){return typeof b!=\"undefined\"}function L(b){return b!=null&&typeof b==\"object\"}function B(b){return typeof b==\"string\"}function Ra(b){return typeof b==\"number\"}function oa(b){return xa.apply(b)==\"[object Date]\"}function E(b){return xa.apply(b)==\"[object Array]\"}\nfunction H(b){return typeof b==\"function\"}function pa(b){return b&&b.document&&b.location&&b.alert&&b.setInterval}function O(b){return B(b)?b.replace(/^\\s*/,\"\").replace(/\\s*$/,\"\"):b}function gc(b){return b&&(b.nodeName||b.bind&&b.find)}function Sa(b,a,c){var d=[];n(b,function(b,g,h){d.push(a.call(c,b,g,h))});return d}function Aa(b,a){if(b.indexOf)return b.indexOf(a);for(var c=0;c<b.length;c++)if(a===b[c])return c;return-1}function Ta(b,a){var c=Aa(b,a);c>=0&&b.splice(c,1);return a}function V(b,a){if(pa(b)||\nb&&b.$evalAsync&&b.$watch)throw Error(\"Can't copy Window or Scope\");if(a){if(b===a)throw Error(\"Can't copy equivalent objects or arrays\");if(E(b))for(var c=a.length=0;c<b.length;c++)a.push(V(b[c]));else for(c in n(a,function(b,c){delete a[c]}),b)a[c]=V(b[c])}else(a=b)&&(E(b)?a=V(b,[]):oa(b)?a=new Date(b.getTime()):L(b)&&(a=V(b,{})));return a}function hc(b,a){var a=a||{},c;for(c in b)b.hasOwnProperty(c)&&c.substr(0,2)!==\"$$\"&&(a[c]=b[c]);return a}function ga(b,a){if(b===a)return!0;if(b===null||a===\nnull)return!1;if(b!==b&&a!==a)return!0;var c=typeof b,d;if(c==typeof a&&c==\"object\")if(E(b)){if((c=b.length)==a.length){for(d=0;d<c;d++)if(!ga(b[d],a[d]))return!1;return!0}}else if(oa(b))return oa(a)&&b.getTime()==a.getTime();else{if(b&&b.$evalAsync&&b.$watch||a&&a.$evalAsync&&a.$watch||pa(b)||pa(a))return!1;c={};for(d in b)if(!(d.charAt(0)===\"$\"||H(b[d]))){if(!ga(b[d],a[d]))return!1;c[d]=!0}for(d in a)if(!c[d]&&d.charAt(0)!==\"$\"&&a[d]!==q&&!H(a[d]))return!1;return!0}return!1}function Ua(b,a){var c=\narguments.length>2?ha.call(arguments,2):[];return H(a)&&!(a instanceof RegExp)?c.length?function(){return arguments.length?a.apply(b,c.concat(ha.call(arguments,0))):a.apply(b,c)}:function(){return arguments.length?a.apply(b,arguments):a.call(b)}:a}function ic(b,a){var c=a;/^\\$+/.test(b)?c=q:pa(a)?c=\"$WINDOW\":a&&Y===a?c=\"$DOCUMENT\":a&&a.$evalAsync&&a.$watch&&(c=\"$SCOPE\");return c}function da(b,a){return JSON.stringify(b,ic,a?\"  \":null)}function pb(b){return B(b)?JSON.parse(b):b}function Va(b){b&&b.length!==\n0?(b=A(\"\"+b),b=!(b==\"f\"||b==\"0\"||b==\"false\"||b==\"no\"||b==\"n\"||b==\"[]\")):b=!1;return b}function qa(b){b=u(b).clone();try{b.html(\"\")}catch(a){}var c=u(\"<div>\").append(b).html();try{return b[0].nodeType===3?A(c):c.match(/^(<[^>]+>)/)[1].replace(/^<([\\w\\-]+)/,function(a,b){return\"<\"+A(b)})}catch(d){return A(c)}}function Wa(b){var a={},c,d;n((b||\"\").split(\"&\"),function(b){b&&(c=b.split(\"=\"),d=decodeURIComponent(c[0]),a[d]=y(c[1])?decodeURIComponent(c[1]):!0)});return a}function qb(b){var a=[];n(b,function(b,\nd){a.push(Xa(d,!0)+(b===!0?\"\":\"=\"+Xa(b,!0)))});return a.length?a.join(\"&\"):\"\"}function Ya(b){return Xa(b,!0).replace(/%26/gi,\"&\").replace(/%3D/gi,\"=\").replace(/%2B/gi,\"+\")}function Xa(b,a){return encodeURIComponent(b).replace(/%40/gi,\"@\").replace(/%3A/gi,\":\").replace(/%24/g,\"$\").replace(/%2C/gi,\",\").replace(/%20/g,a?\"%20\":\"+\")}function jc(b,a){function c(a){a&&d.push(a)}var d=[b],e,g,h=[\"ng:app\",\"ng-app\",\"x-ng-app\",\"data-ng-app\"],f=/\\sng[:\\-]app(:\\s*([\\w\\d_]+);?)?\\s/;n(h,function(a){h[a]=!0;c(Y.getElementById(a));\na=a.replace(\":\",\"\\\\:\");b.querySelectorAll&&(n(b.querySelectorAll(\".\"+a),c),n(b.querySelectorAll(\".\"+a+\"\\\\:\"),c),n(b.querySelectorAll(\"[\"+a+\"]\"),c))});n(d,function(a){if(!e){var b=f.exec(\" \"+a.className+\" \");b?(e=a,g=(b[2]||\"\").replace(/\\s+/g,\",\")):n(a.attributes,function(b){if(!e&&h[b.name])e=a,g=b.value})}});e&&a(e,g?[g]:[])}function rb(b,a){var c=function(){b=u(b);a=a||[];a.unshift([\"$provide\",function(a){a.value(\"$rootElement\",b)}]);a.unshift(\"ng\");var c=sb(a);c.invoke([\"$rootScope\",\"$rootElement\",\n\"$compile\",\"$injector\",function(a,b,c,d){a.$apply(function(){b.data(\"$injector\",d);c(b)(a)})}]);return c},d=/^NG_DEFER_BOOTSTRAP!/;if(N&&!d.test(N.name))return c();N.name=N.name.replace(d,\"\");Za.resumeBootstrap=function(b){n(b,function(b){a.push(b)});c()}}function $a(b,a){a=a||\"_\";return b.replace(kc,function(b,d){return(d?a:\"\")+b.toLowerCase()})}function ab(b,a,c){if(!b)throw Error(\"Argument '\"+(a||\"?\")+\"' is \"+(c||\"required\"));return b}function ra(b,a,c){c&&E(b)&&(b=b[b.length-1]);ab(H(b),a,\"not a function, got \"+\n(b&&typeof b==\"object\"?b.constructor.name||\"Object\":typeof b));return b}function lc(b){function a(a,b,e){return a[b]||(a[b]=e())}return a(a(b,\"angular\",Object),\"module\",function(){var b={};return function(d,e,g){e&&b.hasOwnProperty(d)&&(b[d]=null);return a(b,d,function(){function a(c,d,e){return function(){b[e||\"push\"]([c,d,arguments]);return k}}if(!e)throw Error(\"No module: \"+d);var b=[],c=[],i=a(\"$injector\",\"invoke\"),k={_invokeQueue:b,_runBlocks:c,requires:e,name:d,provider:a(\"$provide\",\"provider\"),\nfactory:a(\"$provide\",\"factory\"),service:a(\"$provide\",\"service\"),value:a(\"$provide\",\"value\"),constant:a(\"$provide\",\"constant\",\"unshift\"),filter:a(\"$filterProvider\",\"register\"),controller:a(\"$controllerProvider\",\"register\"),directive:a(\"$compileProvider\",\"directive\"),config:i,run:function(a){c.push(a);return this}};g&&i(g);return k})}})}function tb(b){return b.replace(mc,function(a,b,d,e){return e?d.toUpperCase():d}).replace(nc,\"Moz$1\")}function bb(b,a){function c(){var e;for(var b=[this],c=a,h,f,j,\ni,k,m;b.length;){h=b.shift();f=0;for(j=h.length;f<j;f++){i=u(h[f]);c?i.triggerHandler(\"$destroy\"):c=!c;k=0;for(e=(m=i.children()).length,i=e;k<i;k++)b.push(ca(m[k]))}}return d.apply(this,arguments)}var d=ca.fn[b],d=d.$original||d;c.$original=d;ca.fn[b]=c}function K(b){if(b instanceof K)return b;if(!(this instanceof K)){if(B(b)&&b.charAt(0)!=\"<\")throw Error(\"selectors not implemented\");return new K(b)}if(B(b)){var a=Y.createElement(\"div\");a.innerHTML=\"<div>&#160;</div>\"+b;a.removeChild(a.firstChild);\ncb(this,a.childNodes);this.remove()}else cb(this,b)}function db(b){return b.cloneNode(!0)}function sa(b){ub(b);for(var a=0,b=b.childNodes||[];a<b.length;a++)sa(b[a])}function vb(b,a,c){var d=ba(b,\"events\");ba(b,\"handle\")&&(x(a)?n(d,function(a,c){eb(b,c,a);delete d[c]}):x(c)?(eb(b,a,d[a]),delete d[a]):Ta(d[a],c))}function ub(b){var a=b[Ba],c=Ca[a];c&&(c.handle&&(c.events.$destroy&&c.handle({},\"$destroy\"),vb(b)),delete Ca[a],b[Ba]=q)}function ba(b,a,c){var d=b[Ba],d=Ca[d||-1];if(y(c))d||(b[Ba]=d=++oc,\nd=Ca[d]={}),d[a]=c;else return d&&d[a]}function wb(b,a,c){var d=ba(b,\"data\"),e=y(c),g=!e&&y(a),h=g&&!L(a);!d&&!h&&ba(b,\"data\",d={});if(e)d[a]=c;else if(g)if(h)return d&&d[a];else v(d,a);else return d}function Da(b,a){return(\" \"+b.className+\" \").replace(/[\\n\\t]/g,\" \").indexOf(\" \"+a+\" \")>-1}function xb(b,a){a&&n(a.split(\" \"),function(a){b.className=O((\" \"+b.className+\" \").replace(/[\\n\\t]/g,\" \").replace(\" \"+O(a)+\" \",\" \"))})}function yb(b,a){a&&n(a.split(\" \"),function(a){if(!Da(b,a))b.className=O(b.className+\n\" \"+O(a))})}function cb(b,a){if(a)for(var a=!a.nodeName&&y(a.length)&&!pa(a)?a:[a],c=0;c<a.length;c++)b.push(a[c])}function zb(b,a){return Ea(b,\"$\"+(a||\"ngController\")+\"Controller\")}function Ea(b,a,c){b=u(b);for(b[0].nodeType==9&&(b=b.find(\"html\"));b.length;){if(c=b.data(a))return c;b=b.parent()}}function Ab(b,a){var c=Fa[a.toLowerCase()];return c&&Bb[b.nodeName]&&c}function pc(b,a){var c=function(c,e){if(!c.preventDefault)c.preventDefault=function(){c.returnValue=!1};if(!c.stopPropagation)c.stopPropagation=\nfunction(){c.cancelBubble=!0};if(!c.target)c.target=c.srcElement||Y;if(x(c.defaultPrevented)){var g=c.preventDefault;c.preventDefault=function(){c.defaultPrevented=!0;g.call(c)};c.defaultPrevented=!1}c.isDefaultPrevented=function(){return c.defaultPrevented};n(a[e||c.type],function(a){a.call(b,c)});Z<=8?(c.preventDefault=null,c.stopPropagation=null,c.isDefaultPrevented=null):(delete c.preventDefault,delete c.stopPropagation,delete c.isDefaultPrevented)};c.elem=b;return c}function fa(b){var a=typeof b,\nc;if(a==\"object\"&&b!==null)if(typeof(c=b.$$hashKey)==\"function\")c=b.$$hashKey();else{if(c===q)c=b.$$hashKey=ya()}else c=b;return a+\":\"+c}function Ga(b){n(b,this.put,this)}function fb(){}function Cb(b){var a,c;if(typeof b==\"function\"){if(!(a=b.$inject))a=[],c=b.toString().replace(qc,\"\"),c=c.match(rc),n(c[1].split(sc),function(b){b.replace(tc,function(b,c,d){a.push(d)})}),b.$inject=a}else E(b)?(c=b.length-1,ra(b[c],\"fn\"),a=b.slice(0,c)):ra(b,\"fn\",!0);return a}function sb(b){function a(a){return function(b,\nc){if(L(b))n(b,ob(a));else return a(b,c)}}function c(a,b){if(H(b)||E(b))b=m.instantiate(b);if(!b.$get)throw Error(\"Provider \"+a+\" must define $get factory method.\");return k[a+f]=b}function d(a,b){return c(a,{$get:b})}function e(a){var b=[];n(a,function(a){if(!i.get(a))if(i.put(a,!0),B(a)){var c=ta(a);b=b.concat(e(c.requires)).concat(c._runBlocks);try{for(var d=c._invokeQueue,c=0,f=d.length;c<f;c++){var g=d[c],j=g[0]==\"$injector\"?m:m.get(g[0]);j[g[1]].apply(j,g[2])}}catch(h){throw h.message&&(h.message+=\n\" from \"+a),h;}}else if(H(a))try{b.push(m.invoke(a))}catch(o){throw o.message&&(o.message+=\" from \"+a),o;}else if(E(a))try{b.push(m.invoke(a))}catch(k){throw k.message&&(k.message+=\" from \"+String(a[a.length-1])),k;}else ra(a,\"module\")});return b}function g(a,b){function c(d){if(typeof d!==\"string\")throw Error(\"Service name expected\");if(a.hasOwnProperty(d)){if(a[d]===h)throw Error(\"Circular dependency: \"+j.join(\" <- \"));return a[d]}else try{return j.unshift(d),a[d]=h,a[d]=b(d)}finally{j.shift()}}\nfunction d(a,b,e){var f=[],i=Cb(a),g,h,j;h=0;for(g=i.length;h<g;h++)j=i[h],f.push(e&&e.hasOwnProperty(j)?e[j]:c(j));a.$inject||(a=a[g]);switch(b?-1:f.length){case 0:return a();case 1:return a(f[0]);case 2:return a(f[0],f[1]);case 3:return a(f[0],f[1],f[2]);case 4:return a(f[0],f[1],f[2],f[3]);case 5:return a(f[0],f[1],f[2],f[3],f[4]);case 6:return a(f[0],f[1],f[2],f[3],f[4],f[5]);case 7:return a(f[0],f[1],f[2],f[3],f[4],f[5],f[6]);case 8:return a(f[0],f[1],f[2],f[3],f[4],f[5],f[6],f[7]);case 9:return a(f[0],\nf[1],f[2],f[3],f[4],f[5],f[6],f[7],f[8]);case 10:return a(f[0],f[1],f[2],f[3],f[4],f[5],f[6],f[7],f[8],f[9]);default:return a.apply(b,f)}}return{invoke:d,instantiate:function(a,b){var c=function(){},e;c.prototype=(E(a)?a[a.length-1]:a).prototype;c=new c;e=d(a,c,b);return L(e)?e:c},get:c,annotate:Cb}}var h={},f=\"Provider\",j=[],i=new Ga,k={$provide:{provider:a(c),factory:a(d),service:a(function(a,b){return d(a,[\"$injector\",function(a){return a.instantiate(b)}])}),value:a(function(a,b){return d(a,I(b))}),\nconstant:a(function(a,b){k[a]=b;l[a]=b}),decorator:function(a,b){var c=m.get(a+f),d=c.$get;c.$get=function(){var a=t.invoke(d,c);return t.invoke(b,null,{$delegate:a})}}}},m=g(k,function(){throw Error(\"Unknown provider: \"+j.join(\" <- \"));}),l={},t=l.$injector=g(l,function(a){a=m.get(a+f);return t.invoke(a.$get,a)});n(e(b),function(a){t.invoke(a||w)});return t}function uc(){var b=!0;this.disableAutoScrolling=function(){b=!1};this.$get=[\"$window\",\"$location\",\"$rootScope\",function(a,c,d){function e(a){var b=\nnull;n(a,function(a){!b&&A(a.nodeName)===\"a\"&&(b=a)});return b}function g(){var b=c.hash(),d;b?(d=h.getElementById(b))?d.scrollIntoView():(d=e(h.getElementsByName(b)))?d.scrollIntoView():b===\"top\"&&a.scrollTo(0,0):a.scrollTo(0,0)}var h=a.document;b&&d.$watch(function(){return c.hash()},function(){d.$evalAsync(g)});return g}]}function vc(b,a,c,d){function e(a){try{a.apply(null,ha.call(arguments,1))}finally{if(o--,o===0)for(;p.length;)try{p.pop()()}catch(b){c.error(b)}}}function g(a,b){(function S(){n(s,\nfunction(a){a()});P=b(S,a)})()}function h(){C!=f.url()&&(C=f.url(),n(W,function(a){a(f.url())}))}var f=this,j=a[0],i=b.location,k=b.history,m=b.setTimeout,l=b.clearTimeout,t={};f.isMock=!1;var o=0,p=[];f.$$completeOutstandingRequest=e;f.$$incOutstandingRequestCount=function(){o++};f.notifyWhenNoOutstandingRequests=function(a){n(s,function(a){a()});o===0?a():p.push(a)};var s=[],P;f.addPollFn=function(a){x(P)&&g(100,m);s.push(a);return a};var C=i.href,z=a.find(\"base\");f.url=function(a,b){if(a){if(C!=\na)return C=a,d.history?b?k.replaceState(null,\"\",a):(k.pushState(null,\"\",a),z.attr(\"href\",z.attr(\"href\"))):b?i.replace(a):i.href=a,f}else return i.href.replace(/%27/g,\"'\")};var W=[],J=!1;f.onUrlChange=function(a){J||(d.history&&u(b).bind(\"popstate\",h),d.hashchange?u(b).bind(\"hashchange\",h):f.addPollFn(h),J=!0);W.push(a);return a};f.baseHref=function(){var a=z.attr(\"href\");return a?a.replace(/^https?\\:\\/\\/[^\\/]*/,\"\"):\"\"};var r={},$=\"\",Q=f.baseHref();f.cookies=function(a,b){var d,e,f,i;if(a)if(b===q)j.cookie=\nescape(a)+\"=;path=\"+Q+\";expires=Thu, 01 Jan 1970 00:00:00 GMT\";else{if(B(b))d=(j.cookie=escape(a)+\"=\"+escape(b)+\";path=\"+Q).length+1,d>4096&&c.warn(\"Cookie '\"+a+\"' possibly not set or overflowed because it was too large (\"+d+\" > 4096 bytes)!\")}else{if(j.cookie!==$){$=j.cookie;d=$.split(\"; \");r={};for(f=0;f<d.length;f++)e=d[f],i=e.indexOf(\"=\"),i>0&&(r[unescape(e.substring(0,i))]=unescape(e.substring(i+1)))}return r}};f.defer=function(a,b){var c;o++;c=m(function(){delete t[c];e(a)},b||0);t[c]=!0;return c};\nf.defer.cancel=function(a){return t[a]?(delete t[a],l(a),e(w),!0):!1}}function wc(){this.$get=[\"$window\",\"$log\",\"$sniffer\",\"$document\",function(b,a,c,d){return new vc(b,d,a,c)}]}function xc(){this.$get=function(){function b(b,d){function e(a){if(a!=m){if(l){if(l==a)l=a.n}else l=a;g(a.n,a.p);g(a,m);m=a;m.n=null}}function g(a,b){if(a!=b){if(a)a.p=b;if(b)b.n=a}}if(b in a)throw Error(\"cacheId \"+b+\" taken\");var h=0,f=v({},d,{id:b}),j={},i=d&&d.capacity||Number.MAX_VALUE,k={},m=null,l=null;return a[b]=\n{put:function(a,b){var c=k[a]||(k[a]={key:a});e(c);x(b)||(a in j||h++,j[a]=b,h>i&&this.remove(l.key))},get:function(a){var b=k[a];if(b)return e(b),j[a]},remove:function(a){var b=k[a];if(b){if(b==m)m=b.p;if(b==l)l=b.n;g(b.n,b.p);delete k[a];delete j[a];h--}},removeAll:function(){j={};h=0;k={};m=l=null},destroy:function(){k=f=j=null;delete a[b]},info:function(){return v({},f,{size:h})}}}var a={};b.info=function(){var b={};n(a,function(a,e){b[e]=a.info()});return b};b.get=function(b){return a[b]};return b}}\nfunction yc(){this.$get=[\"$cacheFactory\",function(b){return b(\"templates\")}]}function Db(b){var a={},c=\"Directive\",d=/^\\s*directive\\:\\s*([\\d\\w\\-_]+)\\s+(.*)$/,e=/(([\\d\\w\\-_]+)(?:\\:([^;]+))?;?)/,g=\"Template must have exactly one root element. was: \",h=/^\\s*(https?|ftp|mailto|file):/;this.directive=function j(d,e){B(d)?(ab(e,\"directive\"),a.hasOwnProperty(d)||(a[d]=[],b.factory(d+c,[\"$injector\",\"$exceptionHandler\",function(b,c){var e=[];n(a[d],function(a){try{var g=b.invoke(a);if(H(g))g={compile:I(g)};\nelse if(!g.compile&&g.link)g.compile=I(g.link);g.priority=g.priority||0;g.name=g.name||d;g.require=g.require||g.controller&&g.name;g.restrict=g.restrict||\"A\";e.push(g)}catch(h){c(h)}});return e}])),a[d].push(e)):n(d,ob(j));return this};this.urlSanitizationWhitelist=function(a){return y(a)?(h=a,this):h};this.$get=[\"$injector\",\"$interpolate\",\"$exceptionHandler\",\"$http\",\"$templateCache\",\"$parse\",\"$controller\",\"$rootScope\",\"$document\",function(b,i,k,m,l,t,o,p,s){function P(a,b,c){a instanceof u||(a=u(a));\nn(a,function(b,c){b.nodeType==3&&b.nodeValue.match(/\\S+/)&&(a[c]=u(b).wrap(\"<span></span>\").parent()[0])});var d=z(a,b,a,c);return function(b,c){ab(b,\"scope\");for(var e=c?va.clone.call(a):a,g=0,i=e.length;g<i;g++){var h=e[g];(h.nodeType==1||h.nodeType==9)&&e.eq(g).data(\"$scope\",b)}C(e,\"ng-scope\");c&&c(e,b);d&&d(b,e,e);return e}}function C(a,b){try{a.addClass(b)}catch(c){}}function z(a,b,c,d){function e(a,c,d,i){var h,j,k,o,l,m,t,s=[];l=0;for(m=c.length;l<m;l++)s.push(c[l]);t=l=0;for(m=g.length;l<\nm;t++)j=s[t],c=g[l++],h=g[l++],c?(c.scope?(k=a.$new(L(c.scope)),u(j).data(\"$scope\",k)):k=a,(o=c.transclude)||!i&&b?c(h,k,j,d,function(b){return function(c){var d=a.$new();d.$$transcluded=!0;return b(d,c).bind(\"$destroy\",Ua(d,d.$destroy))}}(o||b)):c(h,k,j,q,i)):h&&h(a,j.childNodes,q,i)}for(var g=[],i,h,j,k=0;k<a.length;k++)h=new ia,i=W(a[k],[],h,d),h=(i=i.length?J(i,a[k],h,b,c):null)&&i.terminal||!a[k].childNodes||!a[k].childNodes.length?null:z(a[k].childNodes,i?i.transclude:b),g.push(i),g.push(h),\nj=j||i||h;return j?e:null}function W(a,b,c,i){var g=c.$attr,h;switch(a.nodeType){case 1:r(b,ea(gb(a).toLowerCase()),\"E\",i);var j,k,l;h=a.attributes;for(var o=0,m=h&&h.length;o<m;o++)if(j=h[o],j.specified)k=j.name,l=ea(k.toLowerCase()),g[l]=k,c[l]=j=O(Z&&k==\"href\"?decodeURIComponent(a.getAttribute(k,2)):j.value),Ab(a,l)&&(c[l]=!0),S(a,b,j,l),r(b,l,\"A\",i);a=a.className;if(B(a)&&a!==\"\")for(;h=e.exec(a);)l=ea(h[2]),r(b,l,\"C\",i)&&(c[l]=O(h[3])),a=a.substr(h.index+h[0].length);break;case 3:y(b,a.nodeValue);\nbreak;case 8:try{if(h=d.exec(a.nodeValue))l=ea(h[1]),r(b,l,\"M\",i)&&(c[l]=O(h[2]))}catch(t){}}b.sort(F);return b}function J(a,b,c,d,e){function i(a,b){if(a)a.require=r.require,m.push(a);if(b)b.require=r.require,s.push(b)}function h(a,b){var c,d=\"data\",e=!1;if(B(a)){for(;(c=a.charAt(0))==\"^\"||c==\"?\";)a=a.substr(1),c==\"^\"&&(d=\"inheritedData\"),e=e||c==\"?\";c=b[d](\"$\"+a+\"Controller\");if(!c&&!e)throw Error(\"No controller: \"+a);}else E(a)&&(c=[],n(a,function(a){c.push(h(a,b))}));return c}function j(a,d,e,\ni,g){var l,p,r,D,C;l=b===e?c:hc(c,new ia(u(e),c.$attr));p=l.$$element;if(J){var P=/^\\s*([@=&])\\s*(\\w*)\\s*$/,ja=d.$parent||d;n(J.scope,function(a,b){var c=a.match(P)||[],e=c[2]||b,c=c[1],i,g,h;d.$$isolateBindings[b]=c+e;switch(c){case \"@\":l.$observe(e,function(a){d[b]=a});l.$$observers[e].$$scope=ja;break;case \"=\":g=t(l[e]);h=g.assign||function(){i=d[b]=g(ja);throw Error(Eb+l[e]+\" (directive: \"+J.name+\")\");};i=d[b]=g(ja);d.$watch(function(){var a=g(ja);a!==d[b]&&(a!==i?i=d[b]=a:h(ja,a=i=d[b]));return a});\nbreak;case \"&\":g=t(l[e]);d[b]=function(a){return g(ja,a)};break;default:throw Error(\"Invalid isolate scope definition for directive \"+J.name+\": \"+a);}})}y&&n(y,function(a){var b={$scope:d,$element:p,$attrs:l,$transclude:g};C=a.controller;C==\"@\"&&(C=l[a.name]);p.data(\"$\"+a.name+\"Controller\",o(C,b))});i=0;for(r=m.length;i<r;i++)try{D=m[i],D(d,p,l,D.require&&h(D.require,p))}catch(z){k(z,qa(p))}a&&a(d,e.childNodes,q,g);i=0;for(r=s.length;i<r;i++)try{D=s[i],D(d,p,l,D.require&&h(D.require,p))}catch(zc){k(zc,\nqa(p))}}for(var l=-Number.MAX_VALUE,m=[],s=[],p=null,J=null,z=null,D=c.$$element=u(b),r,F,T,ka,S=d,y,x,X,v=0,A=a.length;v<A;v++){r=a[v];T=q;if(l>r.priority)break;if(X=r.scope)ua(\"isolated scope\",J,r,D),L(X)&&(C(D,\"ng-isolate-scope\"),J=r),C(D,\"ng-scope\"),p=p||r;F=r.name;if(X=r.controller)y=y||{},ua(\"'\"+F+\"' controller\",y[F],r,D),y[F]=r;if(X=r.transclude)ua(\"transclusion\",ka,r,D),ka=r,l=r.priority,X==\"element\"?(T=u(b),D=c.$$element=u(Y.createComment(\" \"+F+\": \"+c[F]+\" \")),b=D[0],w(e,u(T[0]),b),S=P(T,\nd,l)):(T=u(db(b)).contents(),D.html(\"\"),S=P(T,d));if(X=r.template)if(ua(\"template\",z,r,D),z=r,X=Fb(X),r.replace){T=u(\"<div>\"+O(X)+\"</div>\").contents();b=T[0];if(T.length!=1||b.nodeType!==1)throw Error(g+X);w(e,D,b);F={$attr:{}};a=a.concat(W(b,a.splice(v+1,a.length-(v+1)),F));$(c,F);A=a.length}else D.html(X);if(r.templateUrl)ua(\"template\",z,r,D),z=r,j=Q(a.splice(v,a.length-v),j,D,c,e,r.replace,S),A=a.length;else if(r.compile)try{x=r.compile(D,c,S),H(x)?i(null,x):x&&i(x.pre,x.post)}catch(G){k(G,qa(D))}if(r.terminal)j.terminal=\n!0,l=Math.max(l,r.priority)}j.scope=p&&p.scope;j.transclude=ka&&S;return j}function r(d,e,i,g){var h=!1;if(a.hasOwnProperty(e))for(var l,e=b.get(e+c),o=0,m=e.length;o<m;o++)try{if(l=e[o],(g===q||g>l.priority)&&l.restrict.indexOf(i)!=-1)d.push(l),h=!0}catch(t){k(t)}return h}function $(a,b){var c=b.$attr,d=a.$attr,e=a.$$element;n(a,function(d,e){e.charAt(0)!=\"$\"&&(b[e]&&(d+=(e===\"style\"?\";\":\" \")+b[e]),a.$set(e,d,!0,c[e]))});n(b,function(b,i){i==\"class\"?(C(e,b),a[\"class\"]=(a[\"class\"]?a[\"class\"]+\" \":\n\"\")+b):i==\"style\"?e.attr(\"style\",e.attr(\"style\")+\";\"+b):i.charAt(0)!=\"$\"&&!a.hasOwnProperty(i)&&(a[i]=b,d[i]=c[i])})}function Q(a,b,c,d,e,i,h){var j=[],k,o,t=c[0],s=a.shift(),p=v({},s,{controller:null,templateUrl:null,transclude:null,scope:null});c.html(\"\");m.get(s.templateUrl,{cache:l}).success(function(l){var m,s,l=Fb(l);if(i){s=u(\"<div>\"+O(l)+\"</div>\").contents();m=s[0];if(s.length!=1||m.nodeType!==1)throw Error(g+l);l={$attr:{}};w(e,c,m);W(m,a,l);$(d,l)}else m=t,c.html(l);a.unshift(p);k=J(a,m,\nd,h);for(o=z(c[0].childNodes,h);j.length;){var ia=j.pop(),l=j.pop();s=j.pop();var r=j.pop(),D=m;s!==t&&(D=db(m),w(l,u(s),D));k(function(){b(o,r,D,e,ia)},r,D,e,ia)}j=null}).error(function(a,b,c,d){throw Error(\"Failed to load template: \"+d.url);});return function(a,c,d,e,i){j?(j.push(c),j.push(d),j.push(e),j.push(i)):k(function(){b(o,c,d,e,i)},c,d,e,i)}}function F(a,b){return b.priority-a.priority}function ua(a,b,c,d){if(b)throw Error(\"Multiple directives [\"+b.name+\", \"+c.name+\"] asking for \"+a+\" on: \"+\nqa(d));}function y(a,b){var c=i(b,!0);c&&a.push({priority:0,compile:I(function(a,b){var d=b.parent(),e=d.data(\"$binding\")||[];e.push(c);C(d.data(\"$binding\",e),\"ng-binding\");a.$watch(c,function(a){b[0].nodeValue=a})})})}function S(a,b,c,d){var e=i(c,!0);e&&b.push({priority:100,compile:I(function(a,b,c){b=c.$$observers||(c.$$observers={});d===\"class\"&&(e=i(c[d],!0));c[d]=q;(b[d]||(b[d]=[])).$$inter=!0;(c.$$observers&&c.$$observers[d].$$scope||a).$watch(e,function(a){c.$set(d,a)})})})}function w(a,b,\nc){var d=b[0],e=d.parentNode,i,g;if(a){i=0;for(g=a.length;i<g;i++)if(a[i]==d){a[i]=c;break}}e&&e.replaceChild(c,d);c[u.expando]=d[u.expando];b[0]=c}var ia=function(a,b){this.$$element=a;this.$attr=b||{}};ia.prototype={$normalize:ea,$set:function(a,b,c,d){var e=Ab(this.$$element[0],a),i=this.$$observers;e&&(this.$$element.prop(a,b),d=e);this[a]=b;d?this.$attr[a]=d:(d=this.$attr[a])||(this.$attr[a]=d=$a(a,\"-\"));if(gb(this.$$element[0])===\"A\"&&a===\"href\")D.setAttribute(\"href\",b),e=D.href,e.match(h)||\n(this[a]=b=\"unsafe:\"+e);c!==!1&&(b===null||b===q?this.$$element.removeAttr(d):this.$$element.attr(d,b));i&&n(i[a],function(a){try{a(b)}catch(c){k(c)}})},$observe:function(a,b){var c=this,d=c.$$observers||(c.$$observers={}),e=d[a]||(d[a]=[]);e.push(b);p.$evalAsync(function(){e.$$inter||b(c[a])});return b}};var D=s[0].createElement(\"a\"),T=i.startSymbol(),ka=i.endSymbol(),Fb=T==\"{{\"||ka==\"}}\"?na:function(a){return a.replace(/\\{\\{/g,T).replace(/}}/g,ka)};return P}]}function ea(b){return tb(b.replace(Ac,\n\"\"))}function Bc(){var b={};this.register=function(a,c){L(a)?v(b,a):b[a]=c};this.$get=[\"$injector\",\"$window\",function(a,c){return function(d,e){if(B(d)){var g=d,d=b.hasOwnProperty(g)?b[g]:hb(e.$scope,g,!0)||hb(c,g,!0);ra(d,g,!0)}return a.instantiate(d,e)}}]}function Cc(){this.$get=[\"$window\",function(b){return u(b.document)}]}function Dc(){this.$get=[\"$log\",function(b){return function(a,c){b.error.apply(b,arguments)}}]}function Ec(){var b=\"{{\",a=\"}}\";this.startSymbol=function(a){return a?(b=a,this):\nb};this.endSymbol=function(b){return b?(a=b,this):a};this.$get=[\"$parse\",function(c){function d(d,f){for(var j,i,k=0,m=[],l=d.length,t=!1,o=[];k<l;)(j=d.indexOf(b,k))!=-1&&(i=d.indexOf(a,j+e))!=-1?(k!=j&&m.push(d.substring(k,j)),m.push(k=c(t=d.substring(j+e,i))),k.exp=t,k=i+g,t=!0):(k!=l&&m.push(d.substring(k)),k=l);if(!(l=m.length))m.push(\"\"),l=1;if(!f||t)return o.length=l,k=function(a){for(var b=0,c=l,d;b<c;b++){if(typeof(d=m[b])==\"function\")d=d(a),d==null||d==q?d=\"\":typeof d!=\"string\"&&(d=da(d));\no[b]=d}return o.join(\"\")},k.exp=d,k.parts=m,k}var e=b.length,g=a.length;d.startSymbol=function(){return b};d.endSymbol=function(){return a};return d}]}function Gb(b){for(var b=b.split(\"/\"),a=b.length;a--;)b[a]=Ya(b[a]);return b.join(\"/\")}function wa(b,a){var c=Hb.exec(b),c={protocol:c[1],host:c[3],port:G(c[5])||Ib[c[1]]||null,path:c[6]||\"/\",search:c[8],hash:c[10]};if(a)a.$$protocol=c.protocol,a.$$host=c.host,a.$$port=c.port;return c}function la(b,a,c){return b+\"://\"+a+(c==Ib[b]?\"\":\":\"+c)}function Fc(b,\na,c){var d=wa(b);return decodeURIComponent(d.path)!=a||x(d.hash)||d.hash.indexOf(c)!==0?b:la(d.protocol,d.host,d.port)+a.substr(0,a.lastIndexOf(\"/\"))+d.hash.substr(c.length)}function Gc(b,a,c){var d=wa(b);if(decodeURIComponent(d.path)==a&&!x(d.hash)&&d.hash.indexOf(c)===0)return b;else{var e=d.search&&\"?\"+d.search||\"\",g=d.hash&&\"#\"+d.hash||\"\",h=a.substr(0,a.lastIndexOf(\"/\")),f=d.path.substr(h.length);if(d.path.indexOf(h)!==0)throw Error('Invalid url \"'+b+'\", missing path prefix \"'+h+'\" !');return la(d.protocol,\nd.host,d.port)+a+\"#\"+c+f+e+g}}function ib(b,a,c){a=a||\"\";this.$$parse=function(b){var c=wa(b,this);if(c.path.indexOf(a)!==0)throw Error('Invalid url \"'+b+'\", missing path prefix \"'+a+'\" !');this.$$path=decodeURIComponent(c.path.substr(a.length));this.$$search=Wa(c.search);this.$$hash=c.hash&&decodeURIComponent(c.hash)||\"\";this.$$compose()};this.$$compose=function(){var b=qb(this.$$search),c=this.$$hash?\"#\"+Ya(this.$$hash):\"\";this.$$url=Gb(this.$$path)+(b?\"?\"+b:\"\")+c;this.$$absUrl=la(this.$$protocol,\nthis.$$host,this.$$port)+a+this.$$url};this.$$rewriteAppUrl=function(a){if(a.indexOf(c)==0)return a};this.$$parse(b)}function Ha(b,a,c){var d;this.$$parse=function(b){var c=wa(b,this);if(c.hash&&c.hash.indexOf(a)!==0)throw Error('Invalid url \"'+b+'\", missing hash prefix \"'+a+'\" !');d=c.path+(c.search?\"?\"+c.search:\"\");c=Hc.exec((c.hash||\"\").substr(a.length));this.$$path=c[1]?(c[1].charAt(0)==\"/\"?\"\":\"/\")+decodeURIComponent(c[1]):\"\";this.$$search=Wa(c[3]);this.$$hash=c[5]&&decodeURIComponent(c[5])||\n\"\";this.$$compose()};this.$$compose=function(){var b=qb(this.$$search),c=this.$$hash?\"#\"+Ya(this.$$hash):\"\";this.$$url=Gb(this.$$path)+(b?\"?\"+b:\"\")+c;this.$$absUrl=la(this.$$protocol,this.$$host,this.$$port)+d+(this.$$url?\"#\"+a+this.$$url:\"\")};this.$$rewriteAppUrl=function(a){if(a.indexOf(c)==0)return a};this.$$parse(b)}function Jb(b,a,c,d){Ha.apply(this,arguments);this.$$rewriteAppUrl=function(b){if(b.indexOf(c)==0)return c+d+\"#\"+a+b.substr(c.length)}}function Ia(b){return function(){return this[b]}}\nfunction Kb(b,a){return function(c){if(x(c))return this[b];this[b]=a(c);this.$$compose();return this}}function Ic(){var b=\"\",a=!1;this.hashPrefix=function(a){return y(a)?(b=a,this):b};this.html5Mode=function(b){return y(b)?(a=b,this):a};this.$get=[\"$rootScope\",\"$browser\",\"$sniffer\",\"$rootElement\",function(c,d,e,g){function h(a){c.$broadcast(\"$locationChangeSuccess\",f.absUrl(),a)}var f,j,i,k=d.url(),m=wa(k);a?(j=d.baseHref()||\"/\",i=j.substr(0,j.lastIndexOf(\"/\")),m=la(m.protocol,m.host,m.port)+i+\"/\",\nf=e.history?new ib(Fc(k,j,b),i,m):new Jb(Gc(k,j,b),b,m,j.substr(i.length+1))):(m=la(m.protocol,m.host,m.port)+(m.path||\"\")+(m.search?\"?\"+m.search:\"\")+\"#\"+b+\"/\",f=new Ha(k,b,m));g.bind(\"click\",function(a){if(!a.ctrlKey&&!(a.metaKey||a.which==2)){for(var b=u(a.target);A(b[0].nodeName)!==\"a\";)if(b[0]===g[0]||!(b=b.parent())[0])return;var d=b.prop(\"href\"),e=f.$$rewriteAppUrl(d);d&&!b.attr(\"target\")&&e&&(f.$$parse(e),c.$apply(),a.preventDefault(),N.angular[\"ff-684208-preventDefault\"]=!0)}});f.absUrl()!=\nk&&d.url(f.absUrl(),!0);d.onUrlChange(function(a){f.absUrl()!=a&&(c.$evalAsync(function(){var b=f.absUrl();f.$$parse(a);h(b)}),c.$$phase||c.$digest())});var l=0;c.$watch(function(){var a=d.url(),b=f.$$replace;if(!l||a!=f.absUrl())l++,c.$evalAsync(function(){c.$broadcast(\"$locationChangeStart\",f.absUrl(),a).defaultPrevented?f.$$parse(a):(d.url(f.absUrl(),b),h(a))});f.$$replace=!1;return l});return f}]}function Jc(){this.$get=[\"$window\",function(b){function a(a){a instanceof Error&&(a.stack?a=a.message&&\na.stack.indexOf(a.message)===-1?\"Error: \"+a.message+\"\\n\"+a.stack:a.stack:a.sourceURL&&(a=a.message+\"\\n\"+a.sourceURL+\":\"+a.line));return a}function c(c){var e=b.console||{},g=e[c]||e.log||w;return g.apply?function(){var b=[];n(arguments,function(c){b.push(a(c))});return g.apply(e,b)}:function(a,b){g(a,b)}}return{log:c(\"log\"),warn:c(\"warn\"),info:c(\"info\"),error:c(\"error\")}}]}function Kc(b,a){function c(a){return a.indexOf(s)!=-1}function d(){return o+1<b.length?b.charAt(o+1):!1}function e(a){return\"0\"<=\na&&a<=\"9\"}function g(a){return a==\" \"||a==\"\\r\"||a==\"\\t\"||a==\"\\n\"||a==\"\\u000b\"||a==\"\\u00a0\"}function h(a){return\"a\"<=a&&a<=\"z\"||\"A\"<=a&&a<=\"Z\"||\"_\"==a||a==\"$\"}function f(a){return a==\"-\"||a==\"+\"||e(a)}function j(a,c,d){d=d||o;throw Error(\"Lexer Error: \"+a+\" at column\"+(y(c)?\"s \"+c+\"-\"+o+\" [\"+b.substring(c,d)+\"]\":\" \"+d)+\" in expression [\"+b+\"].\");}function i(){for(var a=\"\",c=o;o<b.length;){var i=A(b.charAt(o));if(i==\".\"||e(i))a+=i;else{var g=d();if(i==\"e\"&&f(g))a+=i;else if(f(i)&&g&&e(g)&&a.charAt(a.length-\n1)==\"e\")a+=i;else if(f(i)&&(!g||!e(g))&&a.charAt(a.length-1)==\"e\")j(\"Invalid exponent\");else break}o++}a*=1;l.push({index:c,text:a,json:!0,fn:function(){return a}})}function k(){for(var c=\"\",d=o,f,i,j;o<b.length;){var k=b.charAt(o);if(k==\".\"||h(k)||e(k))k==\".\"&&(f=o),c+=k;else break;o++}if(f)for(i=o;i<b.length;){k=b.charAt(i);if(k==\"(\"){j=c.substr(f-d+1);c=c.substr(0,f-d);o=i;break}if(g(k))i++;else break}d={index:d,text:c};if(Ja.hasOwnProperty(c))d.fn=d.json=Ja[c];else{var m=Lb(c,a);d.fn=v(function(a,\nb){return m(a,b)},{assign:function(a,b){return Mb(a,c,b)}})}l.push(d);j&&(l.push({index:f,text:\".\",json:!1}),l.push({index:f+1,text:j,json:!1}))}function m(a){var c=o;o++;for(var d=\"\",e=a,f=!1;o<b.length;){var i=b.charAt(o);e+=i;if(f)i==\"u\"?(i=b.substring(o+1,o+5),i.match(/[\\da-f]{4}/i)||j(\"Invalid unicode escape [\\\\u\"+i+\"]\"),o+=4,d+=String.fromCharCode(parseInt(i,16))):(f=Lc[i],d+=f?f:i),f=!1;else if(i==\"\\\\\")f=!0;else if(i==a){o++;l.push({index:c,text:e,string:d,json:!0,fn:function(){return d}});\nreturn}else d+=i;o++}j(\"Unterminated quote\",c)}for(var l=[],t,o=0,p=[],s,P=\":\";o<b.length;){s=b.charAt(o);if(c(\"\\\"'\"))m(s);else if(e(s)||c(\".\")&&e(d()))i();else if(h(s)){if(k(),\"{,\".indexOf(P)!=-1&&p[0]==\"{\"&&(t=l[l.length-1]))t.json=t.text.indexOf(\".\")==-1}else if(c(\"(){}[].,;:\"))l.push({index:o,text:s,json:\":[,\".indexOf(P)!=-1&&c(\"{[\")||c(\"}]:,\")}),c(\"{[\")&&p.unshift(s),c(\"}]\")&&p.shift(),o++;else if(g(s)){o++;continue}else{var n=s+d(),z=Ja[s],W=Ja[n];W?(l.push({index:o,text:n,fn:W}),o+=2):z?(l.push({index:o,\ntext:s,fn:z,json:\"[,:\".indexOf(P)!=-1&&c(\"+-\")}),o+=1):j(\"Unexpected next character \",o,o+1)}P=s}return l}function Mc(b,a,c,d){function e(a,c){throw Error(\"Syntax Error: Token '\"+c.text+\"' \"+a+\" at column \"+(c.index+1)+\" of the expression [\"+b+\"] starting at [\"+b.substring(c.index)+\"].\");}function g(){if(Q.length===0)throw Error(\"Unexpected end of expression: \"+b);return Q[0]}function h(a,b,c,d){if(Q.length>0){var e=Q[0],f=e.text;if(f==a||f==b||f==c||f==d||!a&&!b&&!c&&!d)return e}return!1}function f(b,\nc,d,f){return(b=h(b,c,d,f))?(a&&!b.json&&e(\"is not valid json\",b),Q.shift(),b):!1}function j(a){f(a)||e(\"is unexpected, expecting [\"+a+\"]\",h())}function i(a,b){return function(c,d){return a(c,d,b)}}function k(a,b,c){return function(d,e){return b(d,e,a,c)}}function m(){for(var a=[];;)if(Q.length>0&&!h(\"}\",\")\",\";\",\"]\")&&a.push(x()),!f(\";\"))return a.length==1?a[0]:function(b,c){for(var d,e=0;e<a.length;e++){var f=a[e];f&&(d=f(b,c))}return d}}function l(){for(var a=f(),b=c(a.text),d=[];;)if(a=f(\":\"))d.push(F());\nelse{var e=function(a,c,e){for(var e=[e],f=0;f<d.length;f++)e.push(d[f](a,c));return b.apply(a,e)};return function(){return e}}}function t(){for(var a=o(),b;;)if(b=f(\"||\"))a=k(a,b.fn,o());else return a}function o(){var a=p(),b;if(b=f(\"&&\"))a=k(a,b.fn,o());return a}function p(){var a=s(),b;if(b=f(\"==\",\"!=\"))a=k(a,b.fn,p());return a}function s(){var a;a=n();for(var b;b=f(\"+\",\"-\");)a=k(a,b.fn,n());if(b=f(\"<\",\">\",\"<=\",\">=\"))a=k(a,b.fn,s());return a}function n(){for(var a=C(),b;b=f(\"*\",\"/\",\"%\");)a=k(a,\nb.fn,C());return a}function C(){var a;return f(\"+\")?z():(a=f(\"-\"))?k(r,a.fn,C()):(a=f(\"!\"))?i(a.fn,C()):z()}function z(){var a;if(f(\"(\"))a=x(),j(\")\");else if(f(\"[\"))a=W();else if(f(\"{\"))a=J();else{var b=f();(a=b.fn)||e(\"not a primary expression\",b)}for(var c;b=f(\"(\",\"[\",\".\");)b.text===\"(\"?(a=y(a,c),c=null):b.text===\"[\"?(c=a,a=S(a)):b.text===\".\"?(c=a,a=u(a)):e(\"IMPOSSIBLE\");return a}function W(){var a=[];if(g().text!=\"]\"){do a.push(F());while(f(\",\"))}j(\"]\");return function(b,c){for(var d=[],e=0;e<\na.length;e++)d.push(a[e](b,c));return d}}function J(){var a=[];if(g().text!=\"}\"){do{var b=f(),b=b.string||b.text;j(\":\");var c=F();a.push({key:b,value:c})}while(f(\",\"))}j(\"}\");return function(b,c){for(var d={},e=0;e<a.length;e++){var f=a[e],i=f.value(b,c);d[f.key]=i}return d}}var r=I(0),$,Q=Kc(b,d),F=function(){var a=t(),c,d;return(d=f(\"=\"))?(a.assign||e(\"implies assignment but [\"+b.substring(0,d.index)+\"] can not be assigned to\",d),c=t(),function(b,d){return a.assign(b,c(b,d),d)}):a},y=function(a,\nb){var c=[];if(g().text!=\")\"){do c.push(F());while(f(\",\"))}j(\")\");return function(d,e){for(var f=[],i=b?b(d,e):d,g=0;g<c.length;g++)f.push(c[g](d,e));g=a(d,e)||w;return g.apply?g.apply(i,f):g(f[0],f[1],f[2],f[3],f[4])}},u=function(a){var b=f().text,c=Lb(b,d);return v(function(b,d){return c(a(b,d),d)},{assign:function(c,d,e){return Mb(a(c,e),b,d)}})},S=function(a){var b=F();j(\"]\");return v(function(c,d){var e=a(c,d),f=b(c,d),i;if(!e)return q;if((e=e[f])&&e.then){i=e;if(!(\"$$v\"in e))i.$$v=q,i.then(function(a){i.$$v=\na});e=e.$$v}return e},{assign:function(c,d,e){return a(c,e)[b(c,e)]=d}})},x=function(){for(var a=F(),b;;)if(b=f(\"|\"))a=k(a,b.fn,l());else return a};a?(F=t,y=u=S=x=function(){e(\"is not valid json\",{text:b,index:0})},$=z()):$=m();Q.length!==0&&e(\"is an unexpected token\",Q[0]);return $}function Mb(b,a,c){for(var a=a.split(\".\"),d=0;a.length>1;d++){var e=a.shift(),g=b[e];g||(g={},b[e]=g);b=g}return b[a.shift()]=c}function hb(b,a,c){if(!a)return b;for(var a=a.split(\".\"),d,e=b,g=a.length,h=0;h<g;h++)d=a[h],\nb&&(b=(e=b)[d]);return!c&&H(b)?Ua(e,b):b}function Nb(b,a,c,d,e){return function(g,h){var f=h&&h.hasOwnProperty(b)?h:g,j;if(f===null||f===q)return f;if((f=f[b])&&f.then){if(!(\"$$v\"in f))j=f,j.$$v=q,j.then(function(a){j.$$v=a});f=f.$$v}if(!a||f===null||f===q)return f;if((f=f[a])&&f.then){if(!(\"$$v\"in f))j=f,j.$$v=q,j.then(function(a){j.$$v=a});f=f.$$v}if(!c||f===null||f===q)return f;if((f=f[c])&&f.then){if(!(\"$$v\"in f))j=f,j.$$v=q,j.then(function(a){j.$$v=a});f=f.$$v}if(!d||f===null||f===q)return f;\nif((f=f[d])&&f.then){if(!(\"$$v\"in f))j=f,j.$$v=q,j.then(function(a){j.$$v=a});f=f.$$v}if(!e||f===null||f===q)return f;if((f=f[e])&&f.then){if(!(\"$$v\"in f))j=f,j.$$v=q,j.then(function(a){j.$$v=a});f=f.$$v}return f}}function Lb(b,a){if(jb.hasOwnProperty(b))return jb[b];var c=b.split(\".\"),d=c.length,e;if(a)e=d<6?Nb(c[0],c[1],c[2],c[3],c[4]):function(a,b){var e=0,i;do i=Nb(c[e++],c[e++],c[e++],c[e++],c[e++])(a,b),b=q,a=i;while(e<d);return i};else{var g=\"var l, fn, p;\\n\";n(c,function(a,b){g+=\"if(s === null || s === undefined) return s;\\nl=s;\\ns=\"+\n(b?\"s\":'((k&&k.hasOwnProperty(\"'+a+'\"))?k:s)')+'[\"'+a+'\"];\\nif (s && s.then) {\\n if (!(\"$$v\" in s)) {\\n p=s;\\n p.$$v = undefined;\\n p.then(function(v) {p.$$v=v;});\\n}\\n s=s.$$v\\n}\\n'});g+=\"return s;\";e=Function(\"s\",\"k\",g);e.toString=function(){return g}}return jb[b]=e}function Nc(){var b={};this.$get=[\"$filter\",\"$sniffer\",function(a,c){return function(d){switch(typeof d){case \"string\":return b.hasOwnProperty(d)?b[d]:b[d]=Mc(d,!1,a,c.csp);case \"function\":return d;default:return w}}}]}function Oc(){this.$get=\n[\"$rootScope\",\"$exceptionHandler\",function(b,a){return Pc(function(a){b.$evalAsync(a)},a)}]}function Pc(b,a){function c(a){return a}function d(a){return h(a)}var e=function(){var f=[],j,i;return i={resolve:function(a){if(f){var c=f;f=q;j=g(a);c.length&&b(function(){for(var a,b=0,d=c.length;b<d;b++)a=c[b],j.then(a[0],a[1])})}},reject:function(a){i.resolve(h(a))},promise:{then:function(b,i){var g=e(),h=function(d){try{g.resolve((b||c)(d))}catch(e){a(e),g.reject(e)}},o=function(b){try{g.resolve((i||\nd)(b))}catch(c){a(c),g.reject(c)}};f?f.push([h,o]):j.then(h,o);return g.promise}}}},g=function(a){return a&&a.then?a:{then:function(c){var d=e();b(function(){d.resolve(c(a))});return d.promise}}},h=function(a){return{then:function(c,i){var g=e();b(function(){g.resolve((i||d)(a))});return g.promise}}};return{defer:e,reject:h,when:function(f,j,i){var k=e(),m,l=function(b){try{return(j||c)(b)}catch(d){return a(d),h(d)}},t=function(b){try{return(i||d)(b)}catch(c){return a(c),h(c)}};b(function(){g(f).then(function(a){m||\n(m=!0,k.resolve(g(a).then(l,t)))},function(a){m||(m=!0,k.resolve(t(a)))})});return k.promise},all:function(a){var b=e(),c=a.length,d=[];c?n(a,function(a,e){g(a).then(function(a){e in d||(d[e]=a,--c||b.resolve(d))},function(a){e in d||b.reject(a)})}):b.resolve(d);return b.promise}}}function Qc(){var b={};this.when=function(a,c){b[a]=v({reloadOnSearch:!0},c);if(a){var d=a[a.length-1]==\"/\"?a.substr(0,a.length-1):a+\"/\";b[d]={redirectTo:a}}return this};this.otherwise=function(a){this.when(null,a);return this};\nthis.$get=[\"$rootScope\",\"$location\",\"$routeParams\",\"$q\",\"$injector\",\"$http\",\"$templateCache\",function(a,c,d,e,g,h,f){function j(a,b){for(var b=\"^\"+b.replace(/[-\\/\\\\^$*+?.()|[\\]{}]/g,\"\\\\$&\")+\"$\",c=\"\",d=[],e={},f=/:(\\w+)/g,i,g=0;(i=f.exec(b))!==null;)c+=b.slice(g,i.index),c+=\"([^\\\\/]*)\",d.push(i[1]),g=f.lastIndex;c+=b.substr(g);var h=a.match(RegExp(c));h&&n(d,function(a,b){e[a]=h[b+1]});return h?e:null}function i(){var b=k(),i=t.current;if(b&&i&&b.$$route===i.$$route&&ga(b.pathParams,i.pathParams)&&\n!b.reloadOnSearch&&!l)i.params=b.params,V(i.params,d),a.$broadcast(\"$routeUpdate\",i);else if(b||i)l=!1,a.$broadcast(\"$routeChangeStart\",b,i),(t.current=b)&&b.redirectTo&&(B(b.redirectTo)?c.path(m(b.redirectTo,b.params)).search(b.params).replace():c.url(b.redirectTo(b.pathParams,c.path(),c.search())).replace()),e.when(b).then(function(){if(b){var a=[],c=[],d;n(b.resolve||{},function(b,d){a.push(d);c.push(B(b)?g.get(b):g.invoke(b))});if(!y(d=b.template))if(y(d=b.templateUrl))d=h.get(d,{cache:f}).then(function(a){return a.data});\ny(d)&&(a.push(\"$template\"),c.push(d));return e.all(c).then(function(b){var c={};n(b,function(b,d){c[a[d]]=b});return c})}}).then(function(c){if(b==t.current){if(b)b.locals=c,V(b.params,d);a.$broadcast(\"$routeChangeSuccess\",b,i)}},function(c){b==t.current&&a.$broadcast(\"$routeChangeError\",b,i,c)})}function k(){var a,d;n(b,function(b,e){if(!d&&(a=j(c.path(),e)))d=za(b,{params:v({},c.search(),a),pathParams:a}),d.$$route=b});return d||b[null]&&za(b[null],{params:{},pathParams:{}})}function m(a,b){var c=\n[];n((a||\"\").split(\":\"),function(a,d){if(d==0)c.push(a);else{var e=a.match(/(\\w+)(.*)/),f=e[1];c.push(b[f]);c.push(e[2]||\"\");delete b[f]}});return c.join(\"\")}var l=!1,t={routes:b,reload:function(){l=!0;a.$evalAsync(i)}};a.$on(\"$locationChangeSuccess\",i);return t}]}function Rc(){this.$get=I({})}function Sc(){var b=10;this.digestTtl=function(a){arguments.length&&(b=a);return b};this.$get=[\"$injector\",\"$exceptionHandler\",\"$parse\",function(a,c,d){function e(){this.$id=ya();this.$$phase=this.$parent=this.$$watchers=\nthis.$$nextSibling=this.$$prevSibling=this.$$childHead=this.$$childTail=null;this[\"this\"]=this.$root=this;this.$$destroyed=!1;this.$$asyncQueue=[];this.$$listeners={};this.$$isolateBindings={}}function g(a){if(j.$$phase)throw Error(j.$$phase+\" already in progress\");j.$$phase=a}function h(a,b){var c=d(a);ra(c,b);return c}function f(){}e.prototype={$new:function(a){if(H(a))throw Error(\"API-CHANGE: Use $controller to instantiate controllers.\");a?(a=new e,a.$root=this.$root):(a=function(){},a.prototype=\nthis,a=new a,a.$id=ya());a[\"this\"]=a;a.$$listeners={};a.$parent=this;a.$$asyncQueue=[];a.$$watchers=a.$$nextSibling=a.$$childHead=a.$$childTail=null;a.$$prevSibling=this.$$childTail;this.$$childHead?this.$$childTail=this.$$childTail.$$nextSibling=a:this.$$childHead=this.$$childTail=a;return a},$watch:function(a,b,c){var d=h(a,\"watch\"),e=this.$$watchers,g={fn:b,last:f,get:d,exp:a,eq:!!c};if(!H(b)){var j=h(b||w,\"listener\");g.fn=function(a,b,c){j(c)}}if(!e)e=this.$$watchers=[];e.unshift(g);return function(){Ta(e,\ng)}},$digest:function(){var a,d,e,h,t,o,p,s=b,n,C=[],z,q;g(\"$digest\");do{p=!1;n=this;do{for(t=n.$$asyncQueue;t.length;)try{n.$eval(t.shift())}catch(J){c(J)}if(h=n.$$watchers)for(o=h.length;o--;)try{if(a=h[o],(d=a.get(n))!==(e=a.last)&&!(a.eq?ga(d,e):typeof d==\"number\"&&typeof e==\"number\"&&isNaN(d)&&isNaN(e)))p=!0,a.last=a.eq?V(d):d,a.fn(d,e===f?d:e,n),s<5&&(z=4-s,C[z]||(C[z]=[]),q=H(a.exp)?\"fn: \"+(a.exp.name||a.exp.toString()):a.exp,q+=\"; newVal: \"+da(d)+\"; oldVal: \"+da(e),C[z].push(q))}catch(r){c(r)}if(!(h=\nn.$$childHead||n!==this&&n.$$nextSibling))for(;n!==this&&!(h=n.$$nextSibling);)n=n.$parent}while(n=h);if(p&&!s--)throw j.$$phase=null,Error(b+\" $digest() iterations reached. Aborting!\\nWatchers fired in the last 5 iterations: \"+da(C));}while(p||t.length);j.$$phase=null},$destroy:function(){if(!(j==this||this.$$destroyed)){var a=this.$parent;this.$broadcast(\"$destroy\");this.$$destroyed=!0;if(a.$$childHead==this)a.$$childHead=this.$$nextSibling;if(a.$$childTail==this)a.$$childTail=this.$$prevSibling;\nif(this.$$prevSibling)this.$$prevSibling.$$nextSibling=this.$$nextSibling;if(this.$$nextSibling)this.$$nextSibling.$$prevSibling=this.$$prevSibling;this.$parent=this.$$nextSibling=this.$$prevSibling=this.$$childHead=this.$$childTail=null}},$eval:function(a,b){return d(a)(this,b)},$evalAsync:function(a){this.$$asyncQueue.push(a)},$apply:function(a){try{return g(\"$apply\"),this.$eval(a)}catch(b){c(b)}finally{j.$$phase=null;try{j.$digest()}catch(d){throw c(d),d;}}},$on:function(a,b){var c=this.$$listeners[a];\nc||(this.$$listeners[a]=c=[]);c.push(b);return function(){c[Aa(c,b)]=null}},$emit:function(a,b){var d=[],e,f=this,g=!1,h={name:a,targetScope:f,stopPropagation:function(){g=!0},preventDefault:function(){h.defaultPrevented=!0},defaultPrevented:!1},j=[h].concat(ha.call(arguments,1)),n,C;do{e=f.$$listeners[a]||d;h.currentScope=f;n=0;for(C=e.length;n<C;n++)if(e[n])try{if(e[n].apply(null,j),g)return h}catch(z){c(z)}else e.splice(n,1),n--,C--;f=f.$parent}while(f);return h},$broadcast:function(a,b){var d=\nthis,e=this,f={name:a,targetScope:this,preventDefault:function(){f.defaultPrevented=!0},defaultPrevented:!1},g=[f].concat(ha.call(arguments,1)),h,j;do{d=e;f.currentScope=d;e=d.$$listeners[a]||[];h=0;for(j=e.length;h<j;h++)if(e[h])try{e[h].apply(null,g)}catch(n){c(n)}else e.splice(h,1),h--,j--;if(!(e=d.$$childHead||d!==this&&d.$$nextSibling))for(;d!==this&&!(e=d.$$nextSibling);)d=d.$parent}while(d=e);return f}};var j=new e;return j}]}function Tc(){this.$get=[\"$window\",function(b){var a={},c=G((/android (\\d+)/.exec(A(b.navigator.userAgent))||\n[])[1]);return{history:!(!b.history||!b.history.pushState||c<4),hashchange:\"onhashchange\"in b&&(!b.document.documentMode||b.document.documentMode>7),hasEvent:function(c){if(c==\"input\"&&Z==9)return!1;if(x(a[c])){var e=b.document.createElement(\"div\");a[c]=\"on\"+c in e}return a[c]},csp:!1}}]}function Uc(){this.$get=I(N)}function Ob(b){var a={},c,d,e;if(!b)return a;n(b.split(\"\\n\"),function(b){e=b.indexOf(\":\");c=A(O(b.substr(0,e)));d=O(b.substr(e+1));c&&(a[c]?a[c]+=\", \"+d:a[c]=d)});return a}function Pb(b){var a=\nL(b)?b:q;return function(c){a||(a=Ob(b));return c?a[A(c)]||null:a}}function Qb(b,a,c){if(H(c))return c(b,a);n(c,function(c){b=c(b,a)});return b}function Vc(){var b=/^\\s*(\\[|\\{[^\\{])/,a=/[\\}\\]]\\s*$/,c=/^\\)\\]\\}',?\\n/,d=this.defaults={transformResponse:[function(d){B(d)&&(d=d.replace(c,\"\"),b.test(d)&&a.test(d)&&(d=pb(d,!0)));return d}],transformRequest:[function(a){return L(a)&&xa.apply(a)!==\"[object File]\"?da(a):a}],headers:{common:{Accept:\"application/json, text/plain, */*\",\"X-Requested-With\":\"XMLHttpRequest\"},\npost:{\"Content-Type\":\"application/json;charset=utf-8\"},put:{\"Content-Type\":\"application/json;charset=utf-8\"}}},e=this.responseInterceptors=[];this.$get=[\"$httpBackend\",\"$browser\",\"$cacheFactory\",\"$rootScope\",\"$q\",\"$injector\",function(a,b,c,j,i,k){function m(a){function c(a){var b=v({},a,{data:Qb(a.data,a.headers,f)});return 200<=a.status&&a.status<300?b:i.reject(b)}a.method=ma(a.method);var e=a.transformRequest||d.transformRequest,f=a.transformResponse||d.transformResponse,g=d.headers,g=v({\"X-XSRF-TOKEN\":b.cookies()[\"XSRF-TOKEN\"]},\ng.common,g[A(a.method)],a.headers),e=Qb(a.data,Pb(g),e),j;x(a.data)&&delete g[\"Content-Type\"];j=l(a,e,g);j=j.then(c,c);n(p,function(a){j=a(j)});j.success=function(b){j.then(function(c){b(c.data,c.status,c.headers,a)});return j};j.error=function(b){j.then(null,function(c){b(c.data,c.status,c.headers,a)});return j};return j}function l(b,c,d){function e(a,b,c){n&&(200<=a&&a<300?n.put(q,[a,b,Ob(c)]):n.remove(q));f(b,a,c);j.$apply()}function f(a,c,d){c=Math.max(c,0);(200<=c&&c<300?k.resolve:k.reject)({data:a,\nstatus:c,headers:Pb(d),config:b})}function h(){var a=Aa(m.pendingRequests,b);a!==-1&&m.pendingRequests.splice(a,1)}var k=i.defer(),l=k.promise,n,p,q=t(b.url,b.params);m.pendingRequests.push(b);l.then(h,h);b.cache&&b.method==\"GET\"&&(n=L(b.cache)?b.cache:o);if(n)if(p=n.get(q))if(p.then)return p.then(h,h),p;else E(p)?f(p[1],p[0],V(p[2])):f(p,200,{});else n.put(q,l);p||a(b.method,q,c,e,d,b.timeout,b.withCredentials);return l}function t(a,b){if(!b)return a;var c=[];fc(b,function(a,b){a==null||a==q||(L(a)&&\n(a=da(a)),c.push(encodeURIComponent(b)+\"=\"+encodeURIComponent(a)))});return a+(a.indexOf(\"?\")==-1?\"?\":\"&\")+c.join(\"&\")}var o=c(\"$http\"),p=[];n(e,function(a){p.push(B(a)?k.get(a):k.invoke(a))});m.pendingRequests=[];(function(a){n(arguments,function(a){m[a]=function(b,c){return m(v(c||{},{method:a,url:b}))}})})(\"get\",\"delete\",\"head\",\"jsonp\");(function(a){n(arguments,function(a){m[a]=function(b,c,d){return m(v(d||{},{method:a,url:b,data:c}))}})})(\"post\",\"put\");m.defaults=d;return m}]}function Wc(){this.$get=\n[\"$browser\",\"$window\",\"$document\",function(b,a,c){return Xc(b,Yc,b.defer,a.angular.callbacks,c[0],a.location.protocol.replace(\":\",\"\"))}]}function Xc(b,a,c,d,e,g){function h(a,b){var c=e.createElement(\"script\"),d=function(){e.body.removeChild(c);b&&b()};c.type=\"text/javascript\";c.src=a;Z?c.onreadystatechange=function(){/loaded|complete/.test(c.readyState)&&d()}:c.onload=c.onerror=d;e.body.appendChild(c)}return function(e,j,i,k,m,l,t){function o(a,c,d,e){c=(j.match(Hb)||[\"\",g])[1]==\"file\"?d?200:404:\nc;a(c==1223?204:c,d,e);b.$$completeOutstandingRequest(w)}b.$$incOutstandingRequestCount();j=j||b.url();if(A(e)==\"jsonp\"){var p=\"_\"+(d.counter++).toString(36);d[p]=function(a){d[p].data=a};h(j.replace(\"JSON_CALLBACK\",\"angular.callbacks.\"+p),function(){d[p].data?o(k,200,d[p].data):o(k,-2);delete d[p]})}else{var s=new a;s.open(e,j,!0);n(m,function(a,b){a&&s.setRequestHeader(b,a)});var q;s.onreadystatechange=function(){if(s.readyState==4){var a=s.getAllResponseHeaders(),b=[\"Cache-Control\",\"Content-Language\",\n\"Content-Type\",\"Expires\",\"Last-Modified\",\"Pragma\"];a||(a=\"\",n(b,function(b){var c=s.getResponseHeader(b);c&&(a+=b+\": \"+c+\"\\n\")}));o(k,q||s.status,s.responseText,a)}};if(t)s.withCredentials=!0;s.send(i||\"\");l>0&&c(function(){q=-1;s.abort()},l)}}}function Zc(){this.$get=function(){return{id:\"en-us\",NUMBER_FORMATS:{DECIMAL_SEP:\".\",GROUP_SEP:\",\",PATTERNS:[{minInt:1,minFrac:0,maxFrac:3,posPre:\"\",posSuf:\"\",negPre:\"-\",negSuf:\"\",gSize:3,lgSize:3},{minInt:1,minFrac:2,maxFrac:2,posPre:\"\\u00a4\",posSuf:\"\",negPre:\"(\\u00a4\",\nnegSuf:\")\",gSize:3,lgSize:3}],CURRENCY_SYM:\"$\"},DATETIME_FORMATS:{MONTH:\"January,February,March,April,May,June,July,August,September,October,November,December\".split(\",\"),SHORTMONTH:\"Jan,Feb,Mar,Apr,May,Jun,Jul,Aug,Sep,Oct,Nov,Dec\".split(\",\"),DAY:\"Sunday,Monday,Tuesday,Wednesday,Thursday,Friday,Saturday\".split(\",\"),SHORTDAY:\"Sun,Mon,Tue,Wed,Thu,Fri,Sat\".split(\",\"),AMPMS:[\"AM\",\"PM\"],medium:\"MMM d, y h:mm:ss a\",\"short\":\"M/d/yy h:mm a\",fullDate:\"EEEE, MMMM d, y\",longDate:\"MMMM d, y\",mediumDate:\"MMM d, y\",\nshortDate:\"M/d/yy\",mediumTime:\"h:mm:ss a\",shortTime:\"h:mm a\"},pluralCat:function(b){return b===1?\"one\":\"other\"}}}}function $c(){this.$get=[\"$rootScope\",\"$browser\",\"$q\",\"$exceptionHandler\",function(b,a,c,d){function e(e,f,j){var i=c.defer(),k=i.promise,m=y(j)&&!j,f=a.defer(function(){try{i.resolve(e())}catch(a){i.reject(a),d(a)}m||b.$apply()},f),j=function(){delete g[k.$$timeoutId]};k.$$timeoutId=f;g[f]=i;k.then(j,j);return k}var g={};e.cancel=function(b){return b&&b.$$timeoutId in g?(g[b.$$timeoutId].reject(\"canceled\"),\na.defer.cancel(b.$$timeoutId)):!1};return e}]}function Rb(b){function a(a,e){return b.factory(a+c,e)}var c=\"Filter\";this.register=a;this.$get=[\"$injector\",function(a){return function(b){return a.get(b+c)}}];a(\"currency\",Sb);a(\"date\",Tb);a(\"filter\",ad);a(\"json\",bd);a(\"limitTo\",cd);a(\"lowercase\",dd);a(\"number\",Ub);a(\"orderBy\",Vb);a(\"uppercase\",ed)}function ad(){return function(b,a){if(!E(b))return b;var c=[];c.check=function(a){for(var b=0;b<c.length;b++)if(!c[b](a))return!1;return!0};var d=function(a,\nb){if(b.charAt(0)===\"!\")return!d(a,b.substr(1));switch(typeof a){case \"boolean\":case \"number\":case \"string\":return(\"\"+a).toLowerCase().indexOf(b)>-1;case \"object\":for(var c in a)if(c.charAt(0)!==\"$\"&&d(a[c],b))return!0;return!1;case \"array\":for(c=0;c<a.length;c++)if(d(a[c],b))return!0;return!1;default:return!1}};switch(typeof a){case \"boolean\":case \"number\":case \"string\":a={$:a};case \"object\":for(var e in a)e==\"$\"?function(){var b=(\"\"+a[e]).toLowerCase();b&&c.push(function(a){return d(a,b)})}():function(){var b=\ne,f=(\"\"+a[e]).toLowerCase();f&&c.push(function(a){return d(hb(a,b),f)})}();break;case \"function\":c.push(a);break;default:return b}for(var g=[],h=0;h<b.length;h++){var f=b[h];c.check(f)&&g.push(f)}return g}}function Sb(b){var a=b.NUMBER_FORMATS;return function(b,d){if(x(d))d=a.CURRENCY_SYM;return Wb(b,a.PATTERNS[1],a.GROUP_SEP,a.DECIMAL_SEP,2).replace(/\\u00A4/g,d)}}function Ub(b){var a=b.NUMBER_FORMATS;return function(b,d){return Wb(b,a.PATTERNS[0],a.GROUP_SEP,a.DECIMAL_SEP,d)}}function Wb(b,a,c,d,\ne){if(isNaN(b)||!isFinite(b))return\"\";var g=b<0,b=Math.abs(b),h=b+\"\",f=\"\",j=[],i=!1;if(h.indexOf(\"e\")!==-1){var k=h.match(/([\\d\\.]+)e(-?)(\\d+)/);k&&k[2]==\"-\"&&k[3]>e+1?h=\"0\":(f=h,i=!0)}if(!i){h=(h.split(Xb)[1]||\"\").length;x(e)&&(e=Math.min(Math.max(a.minFrac,h),a.maxFrac));var h=Math.pow(10,e),b=Math.round(b*h)/h,b=(\"\"+b).split(Xb),h=b[0],b=b[1]||\"\",i=0,k=a.lgSize,m=a.gSize;if(h.length>=k+m)for(var i=h.length-k,l=0;l<i;l++)(i-l)%m===0&&l!==0&&(f+=c),f+=h.charAt(l);for(l=i;l<h.length;l++)(h.length-\nl)%k===0&&l!==0&&(f+=c),f+=h.charAt(l);for(;b.length<e;)b+=\"0\";e&&e!==\"0\"&&(f+=d+b.substr(0,e))}j.push(g?a.negPre:a.posPre);j.push(f);j.push(g?a.negSuf:a.posSuf);return j.join(\"\")}function kb(b,a,c){var d=\"\";b<0&&(d=\"-\",b=-b);for(b=\"\"+b;b.length<a;)b=\"0\"+b;c&&(b=b.substr(b.length-a));return d+b}function M(b,a,c,d){return function(e){e=e[\"get\"+b]();if(c>0||e>-c)e+=c;e===0&&c==-12&&(e=12);return kb(e,a,d)}}function Ka(b,a){return function(c,d){var e=c[\"get\"+b](),g=ma(a?\"SHORT\"+b:b);return d[g][e]}}\nfunction Tb(b){function a(a){var b;if(b=a.match(c)){var a=new Date(0),g=0,h=0;b[9]&&(g=G(b[9]+b[10]),h=G(b[9]+b[11]));a.setUTCFullYear(G(b[1]),G(b[2])-1,G(b[3]));a.setUTCHours(G(b[4]||0)-g,G(b[5]||0)-h,G(b[6]||0),G(b[7]||0))}return a}var c=/^(\\d{4})-?(\\d\\d)-?(\\d\\d)(?:T(\\d\\d)(?::?(\\d\\d)(?::?(\\d\\d)(?:\\.(\\d+))?)?)?(Z|([+-])(\\d\\d):?(\\d\\d))?)?$/;return function(c,e){var g=\"\",h=[],f,j,e=e||\"mediumDate\",e=b.DATETIME_FORMATS[e]||e;B(c)&&(c=fd.test(c)?G(c):a(c));Ra(c)&&(c=new Date(c));if(!oa(c))return c;for(;e;)(j=\ngd.exec(e))?(h=h.concat(ha.call(j,1)),e=h.pop()):(h.push(e),e=null);n(h,function(a){f=hd[a];g+=f?f(c,b.DATETIME_FORMATS):a.replace(/(^'|'$)/g,\"\").replace(/''/g,\"'\")});return g}}function bd(){return function(b){return da(b,!0)}}function cd(){return function(b,a){if(!(b instanceof Array))return b;var a=G(a),c=[],d,e;if(!b||!(b instanceof Array))return c;a>b.length?a=b.length:a<-b.length&&(a=-b.length);a>0?(d=0,e=a):(d=b.length+a,e=b.length);for(;d<e;d++)c.push(b[d]);return c}}function Vb(b){return function(a,\nc,d){function e(a,b){return Va(b)?function(b,c){return a(c,b)}:a}if(!E(a))return a;if(!c)return a;for(var c=E(c)?c:[c],c=Sa(c,function(a){var c=!1,d=a||na;if(B(a)){if(a.charAt(0)==\"+\"||a.charAt(0)==\"-\")c=a.charAt(0)==\"-\",a=a.substring(1);d=b(a)}return e(function(a,b){var c;c=d(a);var e=d(b),f=typeof c,g=typeof e;f==g?(f==\"string\"&&(c=c.toLowerCase()),f==\"string\"&&(e=e.toLowerCase()),c=c===e?0:c<e?-1:1):c=f<g?-1:1;return c},c)}),g=[],h=0;h<a.length;h++)g.push(a[h]);return g.sort(e(function(a,b){for(var d=\n0;d<c.length;d++){var e=c[d](a,b);if(e!==0)return e}return 0},d))}}function R(b){H(b)&&(b={link:b});b.restrict=b.restrict||\"AC\";return I(b)}function Yb(b,a){function c(a,c){c=c?\"-\"+$a(c,\"-\"):\"\";b.removeClass((a?La:Ma)+c).addClass((a?Ma:La)+c)}var d=this,e=b.parent().controller(\"form\")||Na,g=0,h=d.$error={};d.$name=a.name;d.$dirty=!1;d.$pristine=!0;d.$valid=!0;d.$invalid=!1;e.$addControl(d);b.addClass(Oa);c(!0);d.$addControl=function(a){a.$name&&!d.hasOwnProperty(a.$name)&&(d[a.$name]=a)};d.$removeControl=\nfunction(a){a.$name&&d[a.$name]===a&&delete d[a.$name];n(h,function(b,c){d.$setValidity(c,!0,a)})};d.$setValidity=function(a,b,i){var k=h[a];if(b){if(k&&(Ta(k,i),!k.length)){g--;if(!g)c(b),d.$valid=!0,d.$invalid=!1;h[a]=!1;c(!0,a);e.$setValidity(a,!0,d)}}else{g||c(b);if(k){if(Aa(k,i)!=-1)return}else h[a]=k=[],g++,c(!1,a),e.$setValidity(a,!1,d);k.push(i);d.$valid=!1;d.$invalid=!0}};d.$setDirty=function(){b.removeClass(Oa).addClass(Zb);d.$dirty=!0;d.$pristine=!1;e.$setDirty()}}function U(b){return x(b)||\nb===\"\"||b===null||b!==b}function Pa(b,a,c,d,e,g){var h=function(){var c=O(a.val());d.$viewValue!==c&&b.$apply(function(){d.$setViewValue(c)})};if(e.hasEvent(\"input\"))a.bind(\"input\",h);else{var f;a.bind(\"keydown\",function(a){a=a.keyCode;a===91||15<a&&a<19||37<=a&&a<=40||f||(f=g.defer(function(){h();f=null}))});a.bind(\"change\",h)}d.$render=function(){a.val(U(d.$viewValue)?\"\":d.$viewValue)};var j=c.ngPattern,i=function(a,b){return U(b)||a.test(b)?(d.$setValidity(\"pattern\",!0),b):(d.$setValidity(\"pattern\",\n!1),q)};j&&(j.match(/^\\/(.*)\\/$/)?(j=RegExp(j.substr(1,j.length-2)),e=function(a){return i(j,a)}):e=function(a){var c=b.$eval(j);if(!c||!c.test)throw Error(\"Expected \"+j+\" to be a RegExp but was \"+c);return i(c,a)},d.$formatters.push(e),d.$parsers.push(e));if(c.ngMinlength){var k=G(c.ngMinlength),e=function(a){return!U(a)&&a.length<k?(d.$setValidity(\"minlength\",!1),q):(d.$setValidity(\"minlength\",!0),a)};d.$parsers.push(e);d.$formatters.push(e)}if(c.ngMaxlength){var m=G(c.ngMaxlength),c=function(a){return!U(a)&&\na.length>m?(d.$setValidity(\"maxlength\",!1),q):(d.$setValidity(\"maxlength\",!0),a)};d.$parsers.push(c);d.$formatters.push(c)}}function lb(b,a){b=\"ngClass\"+b;return R(function(c,d,e){function g(b){if(a===!0||c.$index%2===a)j&&b!==j&&h(j),f(b);j=b}function h(a){L(a)&&!E(a)&&(a=Sa(a,function(a,b){if(a)return b}));d.removeClass(E(a)?a.join(\" \"):a)}function f(a){L(a)&&!E(a)&&(a=Sa(a,function(a,b){if(a)return b}));a&&d.addClass(E(a)?a.join(\" \"):a)}var j=q;c.$watch(e[b],g,!0);e.$observe(\"class\",function(){var a=\nc.$eval(e[b]);g(a,a)});b!==\"ngClass\"&&c.$watch(\"$index\",function(d,g){var j=d%2;j!==g%2&&(j==a?f(c.$eval(e[b])):h(c.$eval(e[b])))})})}var A=function(b){return B(b)?b.toLowerCase():b},ma=function(b){return B(b)?b.toUpperCase():b},Z=G((/msie (\\d+)/.exec(A(navigator.userAgent))||[])[1]),u,ca,ha=[].slice,Qa=[].push,xa=Object.prototype.toString,Za=N.angular||(N.angular={}),ta,gb,aa=[\"0\",\"0\",\"0\"];w.$inject=[];na.$inject=[];gb=Z<9?function(b){b=b.nodeName?b:b[0];return b.scopeName&&b.scopeName!=\"HTML\"?ma(b.scopeName+\n\":\"+b.nodeName):b.nodeName}:function(b){return b.nodeName?b.nodeName:b[0].nodeName};var kc=/[A-Z]/g,id={full:\"1.0.6\",major:1,minor:0,dot:6,codeName:\"universal-irreversibility\"},Ca=K.cache={},Ba=K.expando=\"ng-\"+(new Date).getTime(),oc=1,$b=N.document.addEventListener?function(b,a,c){b.addEventListener(a,c,!1)}:function(b,a,c){b.attachEvent(\"on\"+a,c)},eb=N.document.removeEventListener?function(b,a,c){b.removeEventListener(a,c,!1)}:function(b,a,c){b.detachEvent(\"on\"+a,c)},mc=/([\\:\\-\\_]+(.))/g,nc=/^moz([A-Z])/,\nva=K.prototype={ready:function(b){function a(){c||(c=!0,b())}var c=!1;this.bind(\"DOMContentLoaded\",a);K(N).bind(\"load\",a)},toString:function(){var b=[];n(this,function(a){b.push(\"\"+a)});return\"[\"+b.join(\", \")+\"]\"},eq:function(b){return b>=0?u(this[b]):u(this[this.length+b])},length:0,push:Qa,sort:[].sort,splice:[].splice},Fa={};n(\"multiple,selected,checked,disabled,readOnly,required\".split(\",\"),function(b){Fa[A(b)]=b});var Bb={};n(\"input,select,option,textarea,button,form\".split(\",\"),function(b){Bb[ma(b)]=\n!0});n({data:wb,inheritedData:Ea,scope:function(b){return Ea(b,\"$scope\")},controller:zb,injector:function(b){return Ea(b,\"$injector\")},removeAttr:function(b,a){b.removeAttribute(a)},hasClass:Da,css:function(b,a,c){a=tb(a);if(y(c))b.style[a]=c;else{var d;Z<=8&&(d=b.currentStyle&&b.currentStyle[a],d===\"\"&&(d=\"auto\"));d=d||b.style[a];Z<=8&&(d=d===\"\"?q:d);return d}},attr:function(b,a,c){var d=A(a);if(Fa[d])if(y(c))c?(b[a]=!0,b.setAttribute(a,d)):(b[a]=!1,b.removeAttribute(d));else return b[a]||(b.attributes.getNamedItem(a)||\nw).specified?d:q;else if(y(c))b.setAttribute(a,c);else if(b.getAttribute)return b=b.getAttribute(a,2),b===null?q:b},prop:function(b,a,c){if(y(c))b[a]=c;else return b[a]},text:v(Z<9?function(b,a){if(b.nodeType==1){if(x(a))return b.innerText;b.innerText=a}else{if(x(a))return b.nodeValue;b.nodeValue=a}}:function(b,a){if(x(a))return b.textContent;b.textContent=a},{$dv:\"\"}),val:function(b,a){if(x(a))return b.value;b.value=a},html:function(b,a){if(x(a))return b.innerHTML;for(var c=0,d=b.childNodes;c<d.length;c++)sa(d[c]);\nb.innerHTML=a}},function(b,a){K.prototype[a]=function(a,d){var e,g;if((b.length==2&&b!==Da&&b!==zb?a:d)===q)if(L(a)){for(e=0;e<this.length;e++)if(b===wb)b(this[e],a);else for(g in a)b(this[e],g,a[g]);return this}else{if(this.length)return b(this[0],a,d)}else{for(e=0;e<this.length;e++)b(this[e],a,d);return this}return b.$dv}});n({removeData:ub,dealoc:sa,bind:function a(c,d,e){var g=ba(c,\"events\"),h=ba(c,\"handle\");g||ba(c,\"events\",g={});h||ba(c,\"handle\",h=pc(c,g));n(d.split(\" \"),function(d){var j=g[d];\nif(!j){if(d==\"mouseenter\"||d==\"mouseleave\"){var i=0;g.mouseenter=[];g.mouseleave=[];a(c,\"mouseover\",function(a){i++;i==1&&h(a,\"mouseenter\")});a(c,\"mouseout\",function(a){i--;i==0&&h(a,\"mouseleave\")})}else $b(c,d,h),g[d]=[];j=g[d]}j.push(e)})},unbind:vb,replaceWith:function(a,c){var d,e=a.parentNode;sa(a);n(new K(c),function(c){d?e.insertBefore(c,d.nextSibling):e.replaceChild(c,a);d=c})},children:function(a){var c=[];n(a.childNodes,function(a){a.nodeType===1&&c.push(a)});return c},contents:function(a){return a.childNodes||\n[]},append:function(a,c){n(new K(c),function(c){a.nodeType===1&&a.appendChild(c)})},prepend:function(a,c){if(a.nodeType===1){var d=a.firstChild;n(new K(c),function(c){d?a.insertBefore(c,d):(a.appendChild(c),d=c)})}},wrap:function(a,c){var c=u(c)[0],d=a.parentNode;d&&d.replaceChild(c,a);c.appendChild(a)},remove:function(a){sa(a);var c=a.parentNode;c&&c.removeChild(a)},after:function(a,c){var d=a,e=a.parentNode;n(new K(c),function(a){e.insertBefore(a,d.nextSibling);d=a})},addClass:yb,removeClass:xb,\ntoggleClass:function(a,c,d){x(d)&&(d=!Da(a,c));(d?yb:xb)(a,c)},parent:function(a){return(a=a.parentNode)&&a.nodeType!==11?a:null},next:function(a){if(a.nextElementSibling)return a.nextElementSibling;for(a=a.nextSibling;a!=null&&a.nodeType!==1;)a=a.nextSibling;return a},find:function(a,c){return a.getElementsByTagName(c)},clone:db,triggerHandler:function(a,c){var d=(ba(a,\"events\")||{})[c];n(d,function(c){c.call(a,null)})}},function(a,c){K.prototype[c]=function(c,e){for(var g,h=0;h<this.length;h++)g==\nq?(g=a(this[h],c,e),g!==q&&(g=u(g))):cb(g,a(this[h],c,e));return g==q?this:g}});Ga.prototype={put:function(a,c){this[fa(a)]=c},get:function(a){return this[fa(a)]},remove:function(a){var c=this[a=fa(a)];delete this[a];return c}};fb.prototype={push:function(a,c){var d=this[a=fa(a)];d?d.push(c):this[a]=[c]},shift:function(a){var c=this[a=fa(a)];if(c)return c.length==1?(delete this[a],c[0]):c.shift()},peek:function(a){if(a=this[fa(a)])return a[0]}};var rc=/^function\\s*[^\\(]*\\(\\s*([^\\)]*)\\)/m,sc=/,/,tc=\n/^\\s*(_?)(\\S+?)\\1\\s*$/,qc=/((\\/\\/.*$)|(\\/\\*[\\s\\S]*?\\*\\/))/mg,Eb=\"Non-assignable model expression: \";Db.$inject=[\"$provide\"];var Ac=/^(x[\\:\\-_]|data[\\:\\-_])/i,Hb=/^([^:]+):\\/\\/(\\w+:{0,1}\\w*@)?(\\{?[\\w\\.-]*\\}?)(:([0-9]+))?(\\/[^\\?#]*)?(\\?([^#]*))?(#(.*))?$/,ac=/^([^\\?#]*)?(\\?([^#]*))?(#(.*))?$/,Hc=ac,Ib={http:80,https:443,ftp:21};ib.prototype={$$replace:!1,absUrl:Ia(\"$$absUrl\"),url:function(a,c){if(x(a))return this.$$url;var d=ac.exec(a);d[1]&&this.path(decodeURIComponent(d[1]));if(d[2]||d[1])this.search(d[3]||\n\"\");this.hash(d[5]||\"\",c);return this},protocol:Ia(\"$$protocol\"),host:Ia(\"$$host\"),port:Ia(\"$$port\"),path:Kb(\"$$path\",function(a){return a.charAt(0)==\"/\"?a:\"/\"+a}),search:function(a,c){if(x(a))return this.$$search;y(c)?c===null?delete this.$$search[a]:this.$$search[a]=c:this.$$search=B(a)?Wa(a):a;this.$$compose();return this},hash:Kb(\"$$hash\",na),replace:function(){this.$$replace=!0;return this}};Ha.prototype=za(ib.prototype);Jb.prototype=za(Ha.prototype);var Ja={\"null\":function(){return null},\"true\":function(){return!0},\n\"false\":function(){return!1},undefined:w,\"+\":function(a,c,d,e){d=d(a,c);e=e(a,c);return y(d)?y(e)?d+e:d:y(e)?e:q},\"-\":function(a,c,d,e){d=d(a,c);e=e(a,c);return(y(d)?d:0)-(y(e)?e:0)},\"*\":function(a,c,d,e){return d(a,c)*e(a,c)},\"/\":function(a,c,d,e){return d(a,c)/e(a,c)},\"%\":function(a,c,d,e){return d(a,c)%e(a,c)},\"^\":function(a,c,d,e){return d(a,c)^e(a,c)},\"=\":w,\"==\":function(a,c,d,e){return d(a,c)==e(a,c)},\"!=\":function(a,c,d,e){return d(a,c)!=e(a,c)},\"<\":function(a,c,d,e){return d(a,c)<e(a,c)},\n\">\":function(a,c,d,e){return d(a,c)>e(a,c)},\"<=\":function(a,c,d,e){return d(a,c)<=e(a,c)},\">=\":function(a,c,d,e){return d(a,c)>=e(a,c)},\"&&\":function(a,c,d,e){return d(a,c)&&e(a,c)},\"||\":function(a,c,d,e){return d(a,c)||e(a,c)},\"&\":function(a,c,d,e){return d(a,c)&e(a,c)},\"|\":function(a,c,d,e){return e(a,c)(a,c,d(a,c))},\"!\":function(a,c,d){return!d(a,c)}},Lc={n:\"\\n\",f:\"\\u000c\",r:\"\\r\",t:\"\\t\",v:\"\\u000b\",\"'\":\"'\",'\"':'\"'},jb={},Yc=N.XMLHttpRequest||function(){try{return new ActiveXObject(\"Msxml2.XMLHTTP.6.0\")}catch(a){}try{return new ActiveXObject(\"Msxml2.XMLHTTP.3.0\")}catch(c){}try{return new ActiveXObject(\"Msxml2.XMLHTTP\")}catch(d){}throw Error(\"This browser does not support XMLHttpRequest.\");\n};Rb.$inject=[\"$provide\"];Sb.$inject=[\"$locale\"];Ub.$inject=[\"$locale\"];var Xb=\".\",hd={yyyy:M(\"FullYear\",4),yy:M(\"FullYear\",2,0,!0),y:M(\"FullYear\",1),MMMM:Ka(\"Month\"),MMM:Ka(\"Month\",!0),MM:M(\"Month\",2,1),M:M(\"Month\",1,1),dd:M(\"Date\",2),d:M(\"Date\",1),HH:M(\"Hours\",2),H:M(\"Hours\",1),hh:M(\"Hours\",2,-12),h:M(\"Hours\",1,-12),mm:M(\"Minutes\",2),m:M(\"Minutes\",1),ss:M(\"Seconds\",2),s:M(\"Seconds\",1),EEEE:Ka(\"Day\"),EEE:Ka(\"Day\",!0),a:function(a,c){return a.getHours()<12?c.AMPMS[0]:c.AMPMS[1]},Z:function(a){var a=\n-1*a.getTimezoneOffset(),c=a>=0?\"+\":\"\";c+=kb(Math[a>0?\"floor\":\"ceil\"](a/60),2)+kb(Math.abs(a%60),2);return c}},gd=/((?:[^yMdHhmsaZE']+)|(?:'(?:[^']|'')*')|(?:E+|y+|M+|d+|H+|h+|m+|s+|a|Z))(.*)/,fd=/^\\d+$/;Tb.$inject=[\"$locale\"];var dd=I(A),ed=I(ma);Vb.$inject=[\"$parse\"];var jd=I({restrict:\"E\",compile:function(a,c){Z<=8&&(!c.href&&!c.name&&c.$set(\"href\",\"\"),a.append(Y.createComment(\"IE fix\")));return function(a,c){c.bind(\"click\",function(a){c.attr(\"href\")||a.preventDefault()})}}}),mb={};n(Fa,function(a,\nc){var d=ea(\"ng-\"+c);mb[d]=function(){return{priority:100,compile:function(){return function(a,g,h){a.$watch(h[d],function(a){h.$set(c,!!a)})}}}}});n([\"src\",\"href\"],function(a){var c=ea(\"ng-\"+a);mb[c]=function(){return{priority:99,link:function(d,e,g){g.$observe(c,function(c){c&&(g.$set(a,c),Z&&e.prop(a,g[a]))})}}}});var Na={$addControl:w,$removeControl:w,$setValidity:w,$setDirty:w};Yb.$inject=[\"$element\",\"$attrs\",\"$scope\"];var Qa=function(a){return[\"$timeout\",function(c){var d={name:\"form\",restrict:\"E\",\ncontroller:Yb,compile:function(){return{pre:function(a,d,h,f){if(!h.action){var j=function(a){a.preventDefault?a.preventDefault():a.returnValue=!1};$b(d[0],\"submit\",j);d.bind(\"$destroy\",function(){c(function(){eb(d[0],\"submit\",j)},0,!1)})}var i=d.parent().controller(\"form\"),k=h.name||h.ngForm;k&&(a[k]=f);i&&d.bind(\"$destroy\",function(){i.$removeControl(f);k&&(a[k]=q);v(f,Na)})}}}};return a?v(V(d),{restrict:\"EAC\"}):d}]},kd=Qa(),ld=Qa(!0),md=/^(ftp|http|https):\\/\\/(\\w+:{0,1}\\w*@)?(\\S+)(:[0-9]+)?(\\/|\\/([\\w#!:.?+=&%@!\\-\\/]))?$/,\nnd=/^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\\.[A-Za-z]{2,4}$/,od=/^\\s*(\\-|\\+)?(\\d+|(\\d*(\\.\\d*)))\\s*$/,bc={text:Pa,number:function(a,c,d,e,g,h){Pa(a,c,d,e,g,h);e.$parsers.push(function(a){var c=U(a);return c||od.test(a)?(e.$setValidity(\"number\",!0),a===\"\"?null:c?a:parseFloat(a)):(e.$setValidity(\"number\",!1),q)});e.$formatters.push(function(a){return U(a)?\"\":\"\"+a});if(d.min){var f=parseFloat(d.min),a=function(a){return!U(a)&&a<f?(e.$setValidity(\"min\",!1),q):(e.$setValidity(\"min\",!0),a)};e.$parsers.push(a);\ne.$formatters.push(a)}if(d.max){var j=parseFloat(d.max),d=function(a){return!U(a)&&a>j?(e.$setValidity(\"max\",!1),q):(e.$setValidity(\"max\",!0),a)};e.$parsers.push(d);e.$formatters.push(d)}e.$formatters.push(function(a){return U(a)||Ra(a)?(e.$setValidity(\"number\",!0),a):(e.$setValidity(\"number\",!1),q)})},url:function(a,c,d,e,g,h){Pa(a,c,d,e,g,h);a=function(a){return U(a)||md.test(a)?(e.$setValidity(\"url\",!0),a):(e.$setValidity(\"url\",!1),q)};e.$formatters.push(a);e.$parsers.push(a)},email:function(a,\nc,d,e,g,h){Pa(a,c,d,e,g,h);a=function(a){return U(a)||nd.test(a)?(e.$setValidity(\"email\",!0),a):(e.$setValidity(\"email\",!1),q)};e.$formatters.push(a);e.$parsers.push(a)},radio:function(a,c,d,e){x(d.name)&&c.attr(\"name\",ya());c.bind(\"click\",function(){c[0].checked&&a.$apply(function(){e.$setViewValue(d.value)})});e.$render=function(){c[0].checked=d.value==e.$viewValue};d.$observe(\"value\",e.$render)},checkbox:function(a,c,d,e){var g=d.ngTrueValue,h=d.ngFalseValue;B(g)||(g=!0);B(h)||(h=!1);c.bind(\"click\",\nfunction(){a.$apply(function(){e.$setViewValue(c[0].checked)})});e.$render=function(){c[0].checked=e.$viewValue};e.$formatters.push(function(a){return a===g});e.$parsers.push(function(a){return a?g:h})},hidden:w,button:w,submit:w,reset:w},cc=[\"$browser\",\"$sniffer\",function(a,c){return{restrict:\"E\",require:\"?ngModel\",link:function(d,e,g,h){h&&(bc[A(g.type)]||bc.text)(d,e,g,h,c,a)}}}],Ma=\"ng-valid\",La=\"ng-invalid\",Oa=\"ng-pristine\",Zb=\"ng-dirty\",pd=[\"$scope\",\"$exceptionHandler\",\"$attrs\",\"$element\",\"$parse\",\nfunction(a,c,d,e,g){function h(a,c){c=c?\"-\"+$a(c,\"-\"):\"\";e.removeClass((a?La:Ma)+c).addClass((a?Ma:La)+c)}this.$modelValue=this.$viewValue=Number.NaN;this.$parsers=[];this.$formatters=[];this.$viewChangeListeners=[];this.$pristine=!0;this.$dirty=!1;this.$valid=!0;this.$invalid=!1;this.$name=d.name;var f=g(d.ngModel),j=f.assign;if(!j)throw Error(Eb+d.ngModel+\" (\"+qa(e)+\")\");this.$render=w;var i=e.inheritedData(\"$formController\")||Na,k=0,m=this.$error={};e.addClass(Oa);h(!0);this.$setValidity=function(a,\nc){if(m[a]!==!c){if(c){if(m[a]&&k--,!k)h(!0),this.$valid=!0,this.$invalid=!1}else h(!1),this.$invalid=!0,this.$valid=!1,k++;m[a]=!c;h(c,a);i.$setValidity(a,c,this)}};this.$setViewValue=function(d){this.$viewValue=d;if(this.$pristine)this.$dirty=!0,this.$pristine=!1,e.removeClass(Oa).addClass(Zb),i.$setDirty();n(this.$parsers,function(a){d=a(d)});if(this.$modelValue!==d)this.$modelValue=d,j(a,d),n(this.$viewChangeListeners,function(a){try{a()}catch(d){c(d)}})};var l=this;a.$watch(function(){var c=\nf(a);if(l.$modelValue!==c){var d=l.$formatters,e=d.length;for(l.$modelValue=c;e--;)c=d[e](c);if(l.$viewValue!==c)l.$viewValue=c,l.$render()}})}],qd=function(){return{require:[\"ngModel\",\"^?form\"],controller:pd,link:function(a,c,d,e){var g=e[0],h=e[1]||Na;h.$addControl(g);c.bind(\"$destroy\",function(){h.$removeControl(g)})}}},rd=I({require:\"ngModel\",link:function(a,c,d,e){e.$viewChangeListeners.push(function(){a.$eval(d.ngChange)})}}),dc=function(){return{require:\"?ngModel\",link:function(a,c,d,e){if(e){d.required=\n!0;var g=function(a){if(d.required&&(U(a)||a===!1))e.$setValidity(\"required\",!1);else return e.$setValidity(\"required\",!0),a};e.$formatters.push(g);e.$parsers.unshift(g);d.$observe(\"required\",function(){g(e.$viewValue)})}}}},sd=function(){return{require:\"ngModel\",link:function(a,c,d,e){var g=(a=/\\/(.*)\\//.exec(d.ngList))&&RegExp(a[1])||d.ngList||\",\";e.$parsers.push(function(a){var c=[];a&&n(a.split(g),function(a){a&&c.push(O(a))});return c});e.$formatters.push(function(a){return E(a)?a.join(\", \"):\nq})}}},td=/^(true|false|\\d+)$/,ud=function(){return{priority:100,compile:function(a,c){return td.test(c.ngValue)?function(a,c,g){g.$set(\"value\",a.$eval(g.ngValue))}:function(a,c,g){a.$watch(g.ngValue,function(a){g.$set(\"value\",a,!1)})}}}},vd=R(function(a,c,d){c.addClass(\"ng-binding\").data(\"$binding\",d.ngBind);a.$watch(d.ngBind,function(a){c.text(a==q?\"\":a)})}),wd=[\"$interpolate\",function(a){return function(c,d,e){c=a(d.attr(e.$attr.ngBindTemplate));d.addClass(\"ng-binding\").data(\"$binding\",c);e.$observe(\"ngBindTemplate\",\nfunction(a){d.text(a)})}}],xd=[function(){return function(a,c,d){c.addClass(\"ng-binding\").data(\"$binding\",d.ngBindHtmlUnsafe);a.$watch(d.ngBindHtmlUnsafe,function(a){c.html(a||\"\")})}}],yd=lb(\"\",!0),zd=lb(\"Odd\",0),Ad=lb(\"Even\",1),Bd=R({compile:function(a,c){c.$set(\"ngCloak\",q);a.removeClass(\"ng-cloak\")}}),Cd=[function(){return{scope:!0,controller:\"@\"}}],Dd=[\"$sniffer\",function(a){return{priority:1E3,compile:function(){a.csp=!0}}}],ec={};n(\"click dblclick mousedown mouseup mouseover mouseout mousemove mouseenter mouseleave\".split(\" \"),\nfunction(a){var c=ea(\"ng-\"+a);ec[c]=[\"$parse\",function(d){return function(e,g,h){var f=d(h[c]);g.bind(A(a),function(a){e.$apply(function(){f(e,{$event:a})})})}}]});var Ed=R(function(a,c,d){c.bind(\"submit\",function(){a.$apply(d.ngSubmit)})}),Fd=[\"$http\",\"$templateCache\",\"$anchorScroll\",\"$compile\",function(a,c,d,e){return{restrict:\"ECA\",terminal:!0,compile:function(g,h){var f=h.ngInclude||h.src,j=h.onload||\"\",i=h.autoscroll;return function(g,h){var l=0,n,o=function(){n&&(n.$destroy(),n=null);h.html(\"\")};\ng.$watch(f,function(f){var s=++l;f?a.get(f,{cache:c}).success(function(a){s===l&&(n&&n.$destroy(),n=g.$new(),h.html(a),e(h.contents())(n),y(i)&&(!i||g.$eval(i))&&d(),n.$emit(\"$includeContentLoaded\"),g.$eval(j))}).error(function(){s===l&&o()}):o()})}}}}],Gd=R({compile:function(){return{pre:function(a,c,d){a.$eval(d.ngInit)}}}}),Hd=R({terminal:!0,priority:1E3}),Id=[\"$locale\",\"$interpolate\",function(a,c){var d=/{}/g;return{restrict:\"EA\",link:function(e,g,h){var f=h.count,j=g.attr(h.$attr.when),i=h.offset||\n0,k=e.$eval(j),m={},l=c.startSymbol(),t=c.endSymbol();n(k,function(a,e){m[e]=c(a.replace(d,l+f+\"-\"+i+t))});e.$watch(function(){var c=parseFloat(e.$eval(f));return isNaN(c)?\"\":(k[c]||(c=a.pluralCat(c-i)),m[c](e,g,!0))},function(a){g.text(a)})}}}],Jd=R({transclude:\"element\",priority:1E3,terminal:!0,compile:function(a,c,d){return function(a,c,h){var f=h.ngRepeat,h=f.match(/^\\s*(.+)\\s+in\\s+(.*)\\s*$/),j,i,k;if(!h)throw Error(\"Expected ngRepeat in form of '_item_ in _collection_' but got '\"+f+\"'.\");f=h[1];\nj=h[2];h=f.match(/^(?:([\\$\\w]+)|\\(([\\$\\w]+)\\s*,\\s*([\\$\\w]+)\\))$/);if(!h)throw Error(\"'item' in 'item in collection' should be identifier or (key, value) but got '\"+f+\"'.\");i=h[3]||h[1];k=h[2];var m=new fb;a.$watch(function(a){var e,f,h=a.$eval(j),n=c,q=new fb,y,z,u,x,r,v;if(E(h))r=h||[];else{r=[];for(u in h)h.hasOwnProperty(u)&&u.charAt(0)!=\"$\"&&r.push(u);r.sort()}y=r.length-1;e=0;for(f=r.length;e<f;e++){u=h===r?e:r[e];x=h[u];if(v=m.shift(x)){z=v.scope;q.push(x,v);if(e!==v.index)v.index=e,n.after(v.element);\nn=v.element}else z=a.$new();z[i]=x;k&&(z[k]=u);z.$index=e;z.$first=e===0;z.$last=e===y;z.$middle=!(z.$first||z.$last);v||d(z,function(a){n.after(a);v={scope:z,element:n=a,index:e};q.push(x,v)})}for(u in m)if(m.hasOwnProperty(u))for(r=m[u];r.length;)x=r.pop(),x.element.remove(),x.scope.$destroy();m=q})}}}),Kd=R(function(a,c,d){a.$watch(d.ngShow,function(a){c.css(\"display\",Va(a)?\"\":\"none\")})}),Ld=R(function(a,c,d){a.$watch(d.ngHide,function(a){c.css(\"display\",Va(a)?\"none\":\"\")})}),Md=R(function(a,c,\nd){a.$watch(d.ngStyle,function(a,d){d&&a!==d&&n(d,function(a,d){c.css(d,\"\")});a&&c.css(a)},!0)}),Nd=I({restrict:\"EA\",require:\"ngSwitch\",controller:[\"$scope\",function(){this.cases={}}],link:function(a,c,d,e){var g,h,f;a.$watch(d.ngSwitch||d.on,function(j){h&&(f.$destroy(),h.remove(),h=f=null);if(g=e.cases[\"!\"+j]||e.cases[\"?\"])a.$eval(d.change),f=a.$new(),g(f,function(a){h=a;c.append(a)})})}}),Od=R({transclude:\"element\",priority:500,require:\"^ngSwitch\",compile:function(a,c,d){return function(a,g,h,\nf){f.cases[\"!\"+c.ngSwitchWhen]=d}}}),Pd=R({transclude:\"element\",priority:500,require:\"^ngSwitch\",compile:function(a,c,d){return function(a,c,h,f){f.cases[\"?\"]=d}}}),Qd=R({controller:[\"$transclude\",\"$element\",function(a,c){a(function(a){c.append(a)})}]}),Rd=[\"$http\",\"$templateCache\",\"$route\",\"$anchorScroll\",\"$compile\",\"$controller\",function(a,c,d,e,g,h){return{restrict:\"ECA\",terminal:!0,link:function(a,c,i){function k(){var i=d.current&&d.current.locals,k=i&&i.$template;if(k){c.html(k);m&&(m.$destroy(),\nm=null);var k=g(c.contents()),n=d.current;m=n.scope=a.$new();if(n.controller)i.$scope=m,i=h(n.controller,i),c.children().data(\"$ngControllerController\",i);k(m);m.$emit(\"$viewContentLoaded\");m.$eval(l);e()}else c.html(\"\"),m&&(m.$destroy(),m=null)}var m,l=i.onload||\"\";a.$on(\"$routeChangeSuccess\",k);k()}}}],Sd=[\"$templateCache\",function(a){return{restrict:\"E\",terminal:!0,compile:function(c,d){d.type==\"text/ng-template\"&&a.put(d.id,c[0].text)}}}],Td=I({terminal:!0}),Ud=[\"$compile\",\"$parse\",function(a,\nc){var d=/^\\s*(.*?)(?:\\s+as\\s+(.*?))?(?:\\s+group\\s+by\\s+(.*))?\\s+for\\s+(?:([\\$\\w][\\$\\w\\d]*)|(?:\\(\\s*([\\$\\w][\\$\\w\\d]*)\\s*,\\s*([\\$\\w][\\$\\w\\d]*)\\s*\\)))\\s+in\\s+(.*)$/,e={$setViewValue:w};return{restrict:\"E\",require:[\"select\",\"?ngModel\"],controller:[\"$element\",\"$scope\",\"$attrs\",function(a,c,d){var j=this,i={},k=e,m;j.databound=d.ngModel;j.init=function(a,c,d){k=a;m=d};j.addOption=function(c){i[c]=!0;k.$viewValue==c&&(a.val(c),m.parent()&&m.remove())};j.removeOption=function(a){this.hasOption(a)&&(delete i[a],\nk.$viewValue==a&&this.renderUnknownOption(a))};j.renderUnknownOption=function(c){c=\"? \"+fa(c)+\" ?\";m.val(c);a.prepend(m);a.val(c);m.prop(\"selected\",!0)};j.hasOption=function(a){return i.hasOwnProperty(a)};c.$on(\"$destroy\",function(){j.renderUnknownOption=w})}],link:function(e,h,f,j){function i(a,c,d,e){d.$render=function(){var a=d.$viewValue;e.hasOption(a)?(w.parent()&&w.remove(),c.val(a),a===\"\"&&v.prop(\"selected\",!0)):x(a)&&v?c.val(\"\"):e.renderUnknownOption(a)};c.bind(\"change\",function(){a.$apply(function(){w.parent()&&\nw.remove();d.$setViewValue(c.val())})})}function k(a,c,d){var e;d.$render=function(){var a=new Ga(d.$viewValue);n(c.find(\"option\"),function(c){c.selected=y(a.get(c.value))})};a.$watch(function(){ga(e,d.$viewValue)||(e=V(d.$viewValue),d.$render())});c.bind(\"change\",function(){a.$apply(function(){var a=[];n(c.find(\"option\"),function(c){c.selected&&a.push(c.value)});d.$setViewValue(a)})})}function m(e,f,g){function h(){var a={\"\":[]},c=[\"\"],d,i,p,u,v;p=g.$modelValue;u=t(e)||[];var x=l?nb(u):u,y,w,A;w=\n{};v=!1;var B,E;if(o)v=new Ga(p);else if(p===null||s)a[\"\"].push({selected:p===null,id:\"\",label:\"\"}),v=!0;for(A=0;y=x.length,A<y;A++){w[k]=u[l?w[l]=x[A]:A];d=m(e,w)||\"\";if(!(i=a[d]))i=a[d]=[],c.push(d);o?d=v.remove(n(e,w))!=q:(d=p===n(e,w),v=v||d);B=j(e,w);B=B===q?\"\":B;i.push({id:l?x[A]:A,label:B,selected:d})}!o&&!v&&a[\"\"].unshift({id:\"?\",label:\"\",selected:!0});w=0;for(x=c.length;w<x;w++){d=c[w];i=a[d];if(r.length<=w)p={element:z.clone().attr(\"label\",d),label:i.label},u=[p],r.push(u),f.append(p.element);\nelse if(u=r[w],p=u[0],p.label!=d)p.element.attr(\"label\",p.label=d);B=null;A=0;for(y=i.length;A<y;A++)if(d=i[A],v=u[A+1]){B=v.element;if(v.label!==d.label)B.text(v.label=d.label);if(v.id!==d.id)B.val(v.id=d.id);if(v.element.selected!==d.selected)B.prop(\"selected\",v.selected=d.selected)}else d.id===\"\"&&s?E=s:(E=C.clone()).val(d.id).attr(\"selected\",d.selected).text(d.label),u.push({element:E,label:d.label,id:d.id,selected:d.selected}),B?B.after(E):p.element.append(E),B=E;for(A++;u.length>A;)u.pop().element.remove()}for(;r.length>\nw;)r.pop()[0].element.remove()}var i;if(!(i=p.match(d)))throw Error(\"Expected ngOptions in form of '_select_ (as _label_)? for (_key_,)?_value_ in _collection_' but got '\"+p+\"'.\");var j=c(i[2]||i[1]),k=i[4]||i[6],l=i[5],m=c(i[3]||\"\"),n=c(i[2]?i[1]:k),t=c(i[7]),r=[[{element:f,label:\"\"}]];s&&(a(s)(e),s.removeClass(\"ng-scope\"),s.remove());f.html(\"\");f.bind(\"change\",function(){e.$apply(function(){var a,c=t(e)||[],d={},h,i,j,m,p,s;if(o){i=[];m=0;for(s=r.length;m<s;m++){a=r[m];j=1;for(p=a.length;j<p;j++)if((h=\na[j].element)[0].selected)h=h.val(),l&&(d[l]=h),d[k]=c[h],i.push(n(e,d))}}else h=f.val(),h==\"?\"?i=q:h==\"\"?i=null:(d[k]=c[h],l&&(d[l]=h),i=n(e,d));g.$setViewValue(i)})});g.$render=h;e.$watch(h)}if(j[1]){for(var l=j[0],t=j[1],o=f.multiple,p=f.ngOptions,s=!1,v,C=u(Y.createElement(\"option\")),z=u(Y.createElement(\"optgroup\")),w=C.clone(),j=0,A=h.children(),r=A.length;j<r;j++)if(A[j].value==\"\"){v=s=A.eq(j);break}l.init(t,s,w);if(o&&(f.required||f.ngRequired)){var B=function(a){t.$setValidity(\"required\",\n!f.required||a&&a.length);return a};t.$parsers.push(B);t.$formatters.unshift(B);f.$observe(\"required\",function(){B(t.$viewValue)})}p?m(e,h,t):o?k(e,h,t):i(e,h,t,l)}}}}],Vd=[\"$interpolate\",function(a){var c={addOption:w,removeOption:w};return{restrict:\"E\",priority:100,compile:function(d,e){if(x(e.value)){var g=a(d.text(),!0);g||e.$set(\"value\",d.text())}return function(a,d,e){var i=d.parent(),k=i.data(\"$selectController\")||i.parent().data(\"$selectController\");k&&k.databound?d.prop(\"selected\",!1):k=\nc;g?a.$watch(g,function(a,c){e.$set(\"value\",a);a!==c&&k.removeOption(c);k.addOption(a)}):k.addOption(e.value);d.bind(\"$destroy\",function(){k.removeOption(e.value)})}}}}],Wd=I({restrict:\"E\",terminal:!0});(ca=N.jQuery)?(u=ca,v(ca.fn,{scope:va.scope,controller:va.controller,injector:va.injector,inheritedData:va.inheritedData}),bb(\"remove\",!0),bb(\"empty\"),bb(\"html\")):u=K;Za.element=u;(function(a){v(a,{bootstrap:rb,copy:V,extend:v,equals:ga,element:u,forEach:n,injector:sb,noop:w,bind:Ua,toJson:da,fromJson:pb,\nidentity:na,isUndefined:x,isDefined:y,isString:B,isFunction:H,isObject:L,isNumber:Ra,isElement:gc,isArray:E,version:id,isDate:oa,lowercase:A,uppercase:ma,callbacks:{counter:0}});ta=lc(N);try{ta(\"ngLocale\")}catch(c){ta(\"ngLocale\",[]).provider(\"$locale\",Zc)}ta(\"ng\",[\"ngLocale\"],[\"$provide\",function(a){a.provider(\"$compile\",Db).directive({a:jd,input:cc,textarea:cc,form:kd,script:Sd,select:Ud,style:Wd,option:Vd,ngBind:vd,ngBindHtmlUnsafe:xd,ngBindTemplate:wd,ngClass:yd,ngClassEven:Ad,ngClassOdd:zd,ngCsp:Dd,\nngCloak:Bd,ngController:Cd,ngForm:ld,ngHide:Ld,ngInclude:Fd,ngInit:Gd,ngNonBindable:Hd,ngPluralize:Id,ngRepeat:Jd,ngShow:Kd,ngSubmit:Ed,ngStyle:Md,ngSwitch:Nd,ngSwitchWhen:Od,ngSwitchDefault:Pd,ngOptions:Td,ngView:Rd,ngTransclude:Qd,ngModel:qd,ngList:sd,ngChange:rd,required:dc,ngRequired:dc,ngValue:ud}).directive(mb).directive(ec);a.provider({$anchorScroll:uc,$browser:wc,$cacheFactory:xc,$controller:Bc,$document:Cc,$exceptionHandler:Dc,$filter:Rb,$interpolate:Ec,$http:Vc,$httpBackend:Wc,$location:Ic,\n$log:Jc,$parse:Nc,$route:Qc,$routeParams:Rc,$rootScope:Sc,$q:Oc,$sniffer:Tc,$templateCache:yc,$timeout:$c,$window:Uc})}])})(Za);u(Y).ready(function(){jc(Y,rb)})})(window,document);angular.element(document).find(\"head\").append('<style type=\"text/css\">@charset \"UTF-8\";[ng\\\\:cloak],[ng-cloak],[data-ng-cloak],[x-ng-cloak],.ng-cloak,.x-ng-cloak{display:none;}ng\\\\:form{display:block;}</style>');\n",
+
+	"static/lib/codemirror/AUTHORS": "List of CodeMirror contributors. Updated before every release.\n\n4r2r\nAaron Brooks\nAdam King\nadanlobato\nAdán Lobato\naeroson\nAhmad Amireh\nAhmad M. Zawawi\nahoward\nAkeksandr Motsjonov\nAlbert Xing\nAlexander Pavlov\nAlexander Schepanovski\nalexey-k\nAlex Piggott\nAmy\nAnanya Sen\nAndre von Houck\nAndrey Lushnikov\nAndy Kimball\nAndy Li\nangelozerr\nangelo.zerr@gmail.com\nAnkit Ahuja\nAnsel Santosa\nAnthony Grimes\nareos\nAtul Bhouraskar\nAurelian Oancea\nBastian Müller\nbenbro\nBenjamin DeCoste\nBen Keen\nboomyjee\nborawjm\nBrandon Frohs\nBrian Sletten\nBruce Mitchener\nChandra Sekhar Pydi\nCharles Skelton\nChris Coyier\nChris Granger\nChris Morgan\nChristopher Brown\nCodeAnimal\nComFreek\ndagsta\nDan Heberden\nDaniel, Dao Quang Minh\nDaniel Faust\nDaniel Huigens\nDaniel Neel\nDaniel Parnell\nDanny Yoo\nDavid Mignot\nDavid Pathakjee\ndeebugger\nDeep Thought\nDominator008\nDomizio Demichelis\nDrew Bratcher\nDrew Hintz\nDrew Khoury\nDror BG\nduralog\nedsharp\nekhaled\nEric Allam\neustas\nFauntleroy\nfbuchinger\nfeizhang365\nFelipe Lalanne\nFelix Raab\nFilip Noetzel\nflack\nFord_Lawnmower\nGabriel Nahmias\ngalambalazs\nGautam Mehta\nGlenn Ruehle\nGolevka\nGordon Smith\ngreengiant\nGuillaume Massé\nHans Engel\nHardest\nHasan Karahan\nHocdoc\nIan Beck\nIan Wehrman\nIan Wetherbee\nIce White\nICHIKAWA, Yuji\nIngo Richter\nIrakli Gozalishvili\nIvan Kurnosov\nJacob Lee\nJakub Vrana\nJames Campos\nJames Thorne\nJamie Hill\nJan Jongboom\njankeromnes\nJan Keromnes\nJan T. Sott\nJason\nJason Grout\nJason Johnston\nJason San Jose\nJason Siefken\nJean Boussier\njeffkenton\nJeff Pickhardt\njem (graphite)\nJochen Berger\nJohn Connor\nJohn Lees-Miller\nJohn Snelson\njongalloway\nJoost-Wim Boekesteijn\nJoseph Pecoraro\nJoshua Newman\njots\nJuan Benavides Romero\nJucovschi Constantin\njwallers@gmail.com\nkaniga\nKen Newman\nKen Rockot\nKevin Sawicki\nKlaus Silveira\nKoh Zi Han, Cliff\nkomakino\nKonstantin Lopuhin\nkoops\nks-ifware\nLanny\nleaf corcoran\nLeonya Khachaturov\nLiam Newman\nList of contributors. Updated before every release.\nLM\nLorenzo Stoakes\nlynschinzer\nMaksim Lin\nMaksym Taran\nMarat Dreizin\nMarco Aurélio\nMarijn Haverbeke\nMario Pietsch\nMark Lentczner\nMason Malone\nMateusz Paprocki\nmats cronqvist\nMatthew Beale\nMatthias BUSSONNIER\nMatt McDonald\nMatt Pass\nMatt Sacks\nMaximilian Hils\nMax Kirsch\nmbarkhau\nMetatheos\nMicah Dubinko\nMichael Lehenbauer\nMichael Zhou\nMighty Guava\nMiguel Castillo\nMike\nMike Brevoort\nMike Diaz\nMike Ivanov\nMike Kadin\nMinRK\nmisfo\nmps\nNarciso Jaramillo\nNathan Williams\nnerbert\nnguillaumin\nNiels van Groningen\nNikita Beloglazov\nNikita Vasilyev\nnlwillia\npablo\nPage\nPatrick Strawderman\nPaul Garvin\nPaul Ivanov\nPavel Feldman\nPaweł Bartkiewicz\npeteguhl\npeterkroon\nPeter Kroon\nprasanthj\nPrasanth J\nRahul\nRandy Edmunds\nRichard Z.H. Wang\nrobertop23\nRobert Plummer\nRuslan Osmanov\nsabaca\nSamuel Ainsworth\nsandeepshetty\nsantec\nSascha Peilicke\nsatchmorun\nsathyamoorthi\nSCLINIC\\jdecker\nshaund\nshaun gilchrist\nShmuel Englard\nsonson\nspastorelli\nStas Kobzar\nStefan Borsje\nSteffen Beyer\nSteve O'Hara\nTarmil\ntfjgeorge\nThaddee Tyl\nthink\nThomas Dvornik\nThomas Schmid\nTim Baumann\nTimothy Farrell\nTimothy Hatcher\nTomas Varaneckas\nTom Erik Støwer\nTom MacWright\nTony Jian\nVestimir Markov\nvf\nVolker Mische\nWilliam Jamieson\nWojtek Ptak\nXavier Mendez\nYunchi Luo\nYuvi Panda\nZachary Dremann\n",
+
+	"static/lib/codemirror/LICENSE": "Copyright (C) 2013 by Marijn Haverbeke <marijnh@gmail.com> and others\n\nPermission is hereby granted, free of charge, to any person obtaining a copy\nof this software and associated documentation files (the \"Software\"), to deal\nin the Software without restriction, including without limitation the rights\nto use, copy, modify, merge, publish, distribute, sublicense, and/or sell\ncopies of the Software, and to permit persons to whom the Software is\nfurnished to do so, subject to the following conditions:\n\nThe above copyright notice and this permission notice shall be included in\nall copies or substantial portions of the Software.\n\nTHE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\nIMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\nFITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\nAUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\nLIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\nOUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\nTHE SOFTWARE.\n\nPlease note that some subdirectories of the CodeMirror distribution\ninclude their own LICENSE files, and are released under different\nlicences.\n",
+
+	"static/lib/codemirror/README.md": "# CodeMirror\n[![Build Status](https://secure.travis-ci.org/marijnh/CodeMirror.png?branch=master)](http://travis-ci.org/marijnh/CodeMirror)\n[![NPM version](https://badge.fury.io/js/codemirror.png)](http://badge.fury.io/js/codemirror)\n\nCodeMirror is a JavaScript component that provides a code editor in\nthe browser. When a mode is available for the language you are coding\nin, it will color your code, and optionally help with indentation.\n\nThe project page is http://codemirror.net  \nThe manual is at http://codemirror.net/doc/manual.html  \nThe contributing guidelines are in [CONTRIBUTING.md](https://github.com/marijnh/CodeMirror/blob/master/CONTRIBUTING.md)\n",
+
+	"static/lib/codemirror/lib/codemirror.css": "/* BASICS */\n\n.CodeMirror {\n  /* Set height, width, borders, and global font properties here */\n  font-family: monospace;\n  height: 300px;\n}\n.CodeMirror-scroll {\n  /* Set scrolling behaviour here */\n  overflow: auto;\n}\n\n/* PADDING */\n\n.CodeMirror-lines {\n  padding: 4px 0; /* Vertical padding around content */\n}\n.CodeMirror pre {\n  padding: 0 4px; /* Horizontal padding of content */\n}\n\n.CodeMirror-scrollbar-filler, .CodeMirror-gutter-filler {\n  background-color: white; /* The little square between H and V scrollbars */\n}\n\n/* GUTTER */\n\n.CodeMirror-gutters {\n  border-right: 1px solid #ddd;\n  background-color: #f7f7f7;\n  white-space: nowrap;\n}\n.CodeMirror-linenumbers {}\n.CodeMirror-linenumber {\n  padding: 0 3px 0 5px;\n  min-width: 20px;\n  text-align: right;\n  color: #999;\n}\n\n/* CURSOR */\n\n.CodeMirror div.CodeMirror-cursor {\n  border-left: 1px solid black;\n  z-index: 3;\n}\n/* Shown when moving in bi-directional text */\n.CodeMirror div.CodeMirror-secondarycursor {\n  border-left: 1px solid silver;\n}\n.CodeMirror.cm-keymap-fat-cursor div.CodeMirror-cursor {\n  width: auto;\n  border: 0;\n  background: #7e7;\n  z-index: 1;\n}\n/* Can style cursor different in overwrite (non-insert) mode */\n.CodeMirror div.CodeMirror-cursor.CodeMirror-overwrite {}\n\n.cm-tab { display: inline-block; }\n\n/* DEFAULT THEME */\n\n.cm-s-default .cm-keyword {color: #708;}\n.cm-s-default .cm-atom {color: #219;}\n.cm-s-default .cm-number {color: #164;}\n.cm-s-default .cm-def {color: #00f;}\n.cm-s-default .cm-variable {color: black;}\n.cm-s-default .cm-variable-2 {color: #05a;}\n.cm-s-default .cm-variable-3 {color: #085;}\n.cm-s-default .cm-property {color: black;}\n.cm-s-default .cm-operator {color: black;}\n.cm-s-default .cm-comment {color: #a50;}\n.cm-s-default .cm-string {color: #a11;}\n.cm-s-default .cm-string-2 {color: #f50;}\n.cm-s-default .cm-meta {color: #555;}\n.cm-s-default .cm-error {color: #f00;}\n.cm-s-default .cm-qualifier {color: #555;}\n.cm-s-default .cm-builtin {color: #30a;}\n.cm-s-default .cm-bracket {color: #997;}\n.cm-s-default .cm-tag {color: #170;}\n.cm-s-default .cm-attribute {color: #00c;}\n.cm-s-default .cm-header {color: blue;}\n.cm-s-default .cm-quote {color: #090;}\n.cm-s-default .cm-hr {color: #999;}\n.cm-s-default .cm-link {color: #00c;}\n\n.cm-negative {color: #d44;}\n.cm-positive {color: #292;}\n.cm-header, .cm-strong {font-weight: bold;}\n.cm-em {font-style: italic;}\n.cm-link {text-decoration: underline;}\n\n.cm-invalidchar {color: #f00;}\n\ndiv.CodeMirror span.CodeMirror-matchingbracket {color: #0f0;}\ndiv.CodeMirror span.CodeMirror-nonmatchingbracket {color: #f22;}\n.CodeMirror-activeline-background {background: #e8f2ff;}\n\n/* STOP */\n\n/* The rest of this file contains styles related to the mechanics of\n   the editor. You probably shouldn't touch them. */\n\n.CodeMirror {\n  line-height: 1;\n  position: relative;\n  overflow: hidden;\n  background: white;\n  color: black;\n}\n\n.CodeMirror-scroll {\n  /* 30px is the magic margin used to hide the element's real scrollbars */\n  /* See overflow: hidden in .CodeMirror */\n  margin-bottom: -30px; margin-right: -30px;\n  padding-bottom: 30px; padding-right: 30px;\n  height: 100%;\n  outline: none; /* Prevent dragging from highlighting the element */\n  position: relative;\n}\n.CodeMirror-sizer {\n  position: relative;\n}\n\n/* The fake, visible scrollbars. Used to force redraw during scrolling\n   before actuall scrolling happens, thus preventing shaking and\n   flickering artifacts. */\n.CodeMirror-vscrollbar, .CodeMirror-hscrollbar, .CodeMirror-scrollbar-filler, .CodeMirror-gutter-filler {\n  position: absolute;\n  z-index: 6;\n  display: none;\n}\n.CodeMirror-vscrollbar {\n  right: 0; top: 0;\n  overflow-x: hidden;\n  overflow-y: scroll;\n}\n.CodeMirror-hscrollbar {\n  bottom: 0; left: 0;\n  overflow-y: hidden;\n  overflow-x: scroll;\n}\n.CodeMirror-scrollbar-filler {\n  right: 0; bottom: 0;\n}\n.CodeMirror-gutter-filler {\n  left: 0; bottom: 0;\n}\n\n.CodeMirror-gutters {\n  position: absolute; left: 0; top: 0;\n  padding-bottom: 30px;\n  z-index: 3;\n}\n.CodeMirror-gutter {\n  white-space: normal;\n  height: 100%;\n  padding-bottom: 30px;\n  margin-bottom: -32px;\n  display: inline-block;\n  /* Hack to make IE7 behave */\n  *zoom:1;\n  *display:inline;\n}\n.CodeMirror-gutter-elt {\n  position: absolute;\n  cursor: default;\n  z-index: 4;\n}\n\n.CodeMirror-lines {\n  cursor: text;\n}\n.CodeMirror pre {\n  /* Reset some styles that the rest of the page might have set */\n  -moz-border-radius: 0; -webkit-border-radius: 0; border-radius: 0;\n  border-width: 0;\n  background: transparent;\n  font-family: inherit;\n  font-size: inherit;\n  margin: 0;\n  white-space: pre;\n  word-wrap: normal;\n  line-height: inherit;\n  color: inherit;\n  z-index: 2;\n  position: relative;\n  overflow: visible;\n}\n.CodeMirror-wrap pre {\n  word-wrap: break-word;\n  white-space: pre-wrap;\n  word-break: normal;\n}\n.CodeMirror-code pre {\n  border-right: 30px solid transparent;\n  width: -webkit-fit-content;\n  width: -moz-fit-content;\n  width: fit-content;\n}\n.CodeMirror-wrap .CodeMirror-code pre {\n  border-right: none;\n  width: auto;\n}\n.CodeMirror-linebackground {\n  position: absolute;\n  left: 0; right: 0; top: 0; bottom: 0;\n  z-index: 0;\n}\n\n.CodeMirror-linewidget {\n  position: relative;\n  z-index: 2;\n  overflow: auto;\n}\n\n.CodeMirror-widget {\n}\n\n.CodeMirror-wrap .CodeMirror-scroll {\n  overflow-x: hidden;\n}\n\n.CodeMirror-measure {\n  position: absolute;\n  width: 100%; height: 0px;\n  overflow: hidden;\n  visibility: hidden;\n}\n.CodeMirror-measure pre { position: static; }\n\n.CodeMirror div.CodeMirror-cursor {\n  position: absolute;\n  visibility: hidden;\n  border-right: none;\n  width: 0;\n}\n.CodeMirror-focused div.CodeMirror-cursor {\n  visibility: visible;\n}\n\n.CodeMirror-selected { background: #d9d9d9; }\n.CodeMirror-focused .CodeMirror-selected { background: #d7d4f0; }\n\n.cm-searching {\n  background: #ffa;\n  background: rgba(255, 255, 0, .4);\n}\n\n/* IE7 hack to prevent it from returning funny offsetTops on the spans */\n.CodeMirror span { *vertical-align: text-bottom; }\n\n@media print {\n  /* Hide the cursor when printing */\n  .CodeMirror div.CodeMirror-cursor {\n    visibility: hidden;\n  }\n}\n",
+
+	"static/lib/codemirror/lib/codemirror.js": "// CodeMirror version 3.16\n//\n// CodeMirror is the only global var we claim\nwindow.CodeMirror = (function() {\n  \"use strict\";\n\n  // BROWSER SNIFFING\n\n  // Crude, but necessary to handle a number of hard-to-feature-detect\n  // bugs and behavior differences.\n  var gecko = /gecko\\/\\d/i.test(navigator.userAgent);\n  var ie = /MSIE \\d/.test(navigator.userAgent);\n  var ie_lt8 = ie && (document.documentMode == null || document.documentMode < 8);\n  var ie_lt9 = ie && (document.documentMode == null || document.documentMode < 9);\n  var webkit = /WebKit\\//.test(navigator.userAgent);\n  var qtwebkit = webkit && /Qt\\/\\d+\\.\\d+/.test(navigator.userAgent);\n  var chrome = /Chrome\\//.test(navigator.userAgent);\n  var opera = /Opera\\//.test(navigator.userAgent);\n  var safari = /Apple Computer/.test(navigator.vendor);\n  var khtml = /KHTML\\//.test(navigator.userAgent);\n  var mac_geLion = /Mac OS X 1\\d\\D([7-9]|\\d\\d)\\D/.test(navigator.userAgent);\n  var mac_geMountainLion = /Mac OS X 1\\d\\D([8-9]|\\d\\d)\\D/.test(navigator.userAgent);\n  var phantom = /PhantomJS/.test(navigator.userAgent);\n\n  var ios = /AppleWebKit/.test(navigator.userAgent) && /Mobile\\/\\w+/.test(navigator.userAgent);\n  // This is woefully incomplete. Suggestions for alternative methods welcome.\n  var mobile = ios || /Android|webOS|BlackBerry|Opera Mini|Opera Mobi|IEMobile/i.test(navigator.userAgent);\n  var mac = ios || /Mac/.test(navigator.platform);\n  var windows = /win/i.test(navigator.platform);\n\n  var opera_version = opera && navigator.userAgent.match(/Version\\/(\\d*\\.\\d*)/);\n  if (opera_version) opera_version = Number(opera_version[1]);\n  if (opera_version && opera_version >= 15) { opera = false; webkit = true; }\n  // Some browsers use the wrong event properties to signal cmd/ctrl on OS X\n  var flipCtrlCmd = mac && (qtwebkit || opera && (opera_version == null || opera_version < 12.11));\n  var captureMiddleClick = gecko || (ie && !ie_lt9);\n\n  // Optimize some code when these features are not used\n  var sawReadOnlySpans = false, sawCollapsedSpans = false;\n\n  // CONSTRUCTOR\n\n  function CodeMirror(place, options) {\n    if (!(this instanceof CodeMirror)) return new CodeMirror(place, options);\n\n    this.options = options = options || {};\n    // Determine effective options based on given values and defaults.\n    for (var opt in defaults) if (!options.hasOwnProperty(opt) && defaults.hasOwnProperty(opt))\n      options[opt] = defaults[opt];\n    setGuttersForLineNumbers(options);\n\n    var docStart = typeof options.value == \"string\" ? 0 : options.value.first;\n    var display = this.display = makeDisplay(place, docStart);\n    display.wrapper.CodeMirror = this;\n    updateGutters(this);\n    if (options.autofocus && !mobile) focusInput(this);\n\n    this.state = {keyMaps: [],\n                  overlays: [],\n                  modeGen: 0,\n                  overwrite: false, focused: false,\n                  suppressEdits: false, pasteIncoming: false,\n                  draggingText: false,\n                  highlight: new Delayed()};\n\n    themeChanged(this);\n    if (options.lineWrapping)\n      this.display.wrapper.className += \" CodeMirror-wrap\";\n\n    var doc = options.value;\n    if (typeof doc == \"string\") doc = new Doc(options.value, options.mode);\n    operation(this, attachDoc)(this, doc);\n\n    // Override magic textarea content restore that IE sometimes does\n    // on our hidden textarea on reload\n    if (ie) setTimeout(bind(resetInput, this, true), 20);\n\n    registerEventHandlers(this);\n    // IE throws unspecified error in certain cases, when\n    // trying to access activeElement before onload\n    var hasFocus; try { hasFocus = (document.activeElement == display.input); } catch(e) { }\n    if (hasFocus || (options.autofocus && !mobile)) setTimeout(bind(onFocus, this), 20);\n    else onBlur(this);\n\n    operation(this, function() {\n      for (var opt in optionHandlers)\n        if (optionHandlers.propertyIsEnumerable(opt))\n          optionHandlers[opt](this, options[opt], Init);\n      for (var i = 0; i < initHooks.length; ++i) initHooks[i](this);\n    })();\n  }\n\n  // DISPLAY CONSTRUCTOR\n\n  function makeDisplay(place, docStart) {\n    var d = {};\n\n    var input = d.input = elt(\"textarea\", null, null, \"position: absolute; padding: 0; width: 1px; height: 1em; outline: none; font-size: 4px;\");\n    if (webkit) input.style.width = \"1000px\";\n    else input.setAttribute(\"wrap\", \"off\");\n    // if border: 0; -- iOS fails to open keyboard (issue #1287)\n    if (ios) input.style.border = \"1px solid black\";\n    input.setAttribute(\"autocorrect\", \"off\"); input.setAttribute(\"autocapitalize\", \"off\"); input.setAttribute(\"spellcheck\", \"false\");\n\n    // Wraps and hides input textarea\n    d.inputDiv = elt(\"div\", [input], null, \"overflow: hidden; position: relative; width: 3px; height: 0px;\");\n    // The actual fake scrollbars.\n    d.scrollbarH = elt(\"div\", [elt(\"div\", null, null, \"height: 1px\")], \"CodeMirror-hscrollbar\");\n    d.scrollbarV = elt(\"div\", [elt(\"div\", null, null, \"width: 1px\")], \"CodeMirror-vscrollbar\");\n    d.scrollbarFiller = elt(\"div\", null, \"CodeMirror-scrollbar-filler\");\n    d.gutterFiller = elt(\"div\", null, \"CodeMirror-gutter-filler\");\n    // DIVs containing the selection and the actual code\n    d.lineDiv = elt(\"div\", null, \"CodeMirror-code\");\n    d.selectionDiv = elt(\"div\", null, null, \"position: relative; z-index: 1\");\n    // Blinky cursor, and element used to ensure cursor fits at the end of a line\n    d.cursor = elt(\"div\", \"\\u00a0\", \"CodeMirror-cursor\");\n    // Secondary cursor, shown when on a 'jump' in bi-directional text\n    d.otherCursor = elt(\"div\", \"\\u00a0\", \"CodeMirror-cursor CodeMirror-secondarycursor\");\n    // Used to measure text size\n    d.measure = elt(\"div\", null, \"CodeMirror-measure\");\n    // Wraps everything that needs to exist inside the vertically-padded coordinate system\n    d.lineSpace = elt(\"div\", [d.measure, d.selectionDiv, d.lineDiv, d.cursor, d.otherCursor],\n                         null, \"position: relative; outline: none\");\n    // Moved around its parent to cover visible view\n    d.mover = elt(\"div\", [elt(\"div\", [d.lineSpace], \"CodeMirror-lines\")], null, \"position: relative\");\n    // Set to the height of the text, causes scrolling\n    d.sizer = elt(\"div\", [d.mover], \"CodeMirror-sizer\");\n    // D is needed because behavior of elts with overflow: auto and padding is inconsistent across browsers\n    d.heightForcer = elt(\"div\", null, null, \"position: absolute; height: \" + scrollerCutOff + \"px; width: 1px;\");\n    // Will contain the gutters, if any\n    d.gutters = elt(\"div\", null, \"CodeMirror-gutters\");\n    d.lineGutter = null;\n    // Provides scrolling\n    d.scroller = elt(\"div\", [d.sizer, d.heightForcer, d.gutters], \"CodeMirror-scroll\");\n    d.scroller.setAttribute(\"tabIndex\", \"-1\");\n    // The element in which the editor lives.\n    d.wrapper = elt(\"div\", [d.inputDiv, d.scrollbarH, d.scrollbarV,\n                            d.scrollbarFiller, d.gutterFiller, d.scroller], \"CodeMirror\");\n    // Work around IE7 z-index bug\n    if (ie_lt8) { d.gutters.style.zIndex = -1; d.scroller.style.paddingRight = 0; }\n    if (place.appendChild) place.appendChild(d.wrapper); else place(d.wrapper);\n\n    // Needed to hide big blue blinking cursor on Mobile Safari\n    if (ios) input.style.width = \"0px\";\n    if (!webkit) d.scroller.draggable = true;\n    // Needed to handle Tab key in KHTML\n    if (khtml) { d.inputDiv.style.height = \"1px\"; d.inputDiv.style.position = \"absolute\"; }\n    // Need to set a minimum width to see the scrollbar on IE7 (but must not set it on IE8).\n    else if (ie_lt8) d.scrollbarH.style.minWidth = d.scrollbarV.style.minWidth = \"18px\";\n\n    // Current visible range (may be bigger than the view window).\n    d.viewOffset = d.lastSizeC = 0;\n    d.showingFrom = d.showingTo = docStart;\n\n    // Used to only resize the line number gutter when necessary (when\n    // the amount of lines crosses a boundary that makes its width change)\n    d.lineNumWidth = d.lineNumInnerWidth = d.lineNumChars = null;\n    // See readInput and resetInput\n    d.prevInput = \"\";\n    // Set to true when a non-horizontal-scrolling widget is added. As\n    // an optimization, widget aligning is skipped when d is false.\n    d.alignWidgets = false;\n    // Flag that indicates whether we currently expect input to appear\n    // (after some event like 'keypress' or 'input') and are polling\n    // intensively.\n    d.pollingFast = false;\n    // Self-resetting timeout for the poller\n    d.poll = new Delayed();\n\n    d.cachedCharWidth = d.cachedTextHeight = null;\n    d.measureLineCache = [];\n    d.measureLineCachePos = 0;\n\n    // Tracks when resetInput has punted to just putting a short\n    // string instead of the (large) selection.\n    d.inaccurateSelection = false;\n\n    // Tracks the maximum line length so that the horizontal scrollbar\n    // can be kept static when scrolling.\n    d.maxLine = null;\n    d.maxLineLength = 0;\n    d.maxLineChanged = false;\n\n    // Used for measuring wheel scrolling granularity\n    d.wheelDX = d.wheelDY = d.wheelStartX = d.wheelStartY = null;\n\n    return d;\n  }\n\n  // STATE UPDATES\n\n  // Used to get the editor into a consistent state again when options change.\n\n  function loadMode(cm) {\n    cm.doc.mode = CodeMirror.getMode(cm.options, cm.doc.modeOption);\n    cm.doc.iter(function(line) {\n      if (line.stateAfter) line.stateAfter = null;\n      if (line.styles) line.styles = null;\n    });\n    cm.doc.frontier = cm.doc.first;\n    startWorker(cm, 100);\n    cm.state.modeGen++;\n    if (cm.curOp) regChange(cm);\n  }\n\n  function wrappingChanged(cm) {\n    if (cm.options.lineWrapping) {\n      cm.display.wrapper.className += \" CodeMirror-wrap\";\n      cm.display.sizer.style.minWidth = \"\";\n    } else {\n      cm.display.wrapper.className = cm.display.wrapper.className.replace(\" CodeMirror-wrap\", \"\");\n      computeMaxLength(cm);\n    }\n    estimateLineHeights(cm);\n    regChange(cm);\n    clearCaches(cm);\n    setTimeout(function(){updateScrollbars(cm);}, 100);\n  }\n\n  function estimateHeight(cm) {\n    var th = textHeight(cm.display), wrapping = cm.options.lineWrapping;\n    var perLine = wrapping && Math.max(5, cm.display.scroller.clientWidth / charWidth(cm.display) - 3);\n    return function(line) {\n      if (lineIsHidden(cm.doc, line))\n        return 0;\n      else if (wrapping)\n        return (Math.ceil(line.text.length / perLine) || 1) * th;\n      else\n        return th;\n    };\n  }\n\n  function estimateLineHeights(cm) {\n    var doc = cm.doc, est = estimateHeight(cm);\n    doc.iter(function(line) {\n      var estHeight = est(line);\n      if (estHeight != line.height) updateLineHeight(line, estHeight);\n    });\n  }\n\n  function keyMapChanged(cm) {\n    var map = keyMap[cm.options.keyMap], style = map.style;\n    cm.display.wrapper.className = cm.display.wrapper.className.replace(/\\s*cm-keymap-\\S+/g, \"\") +\n      (style ? \" cm-keymap-\" + style : \"\");\n    cm.state.disableInput = map.disableInput;\n  }\n\n  function themeChanged(cm) {\n    cm.display.wrapper.className = cm.display.wrapper.className.replace(/\\s*cm-s-\\S+/g, \"\") +\n      cm.options.theme.replace(/(^|\\s)\\s*/g, \" cm-s-\");\n    clearCaches(cm);\n  }\n\n  function guttersChanged(cm) {\n    updateGutters(cm);\n    regChange(cm);\n    setTimeout(function(){alignHorizontally(cm);}, 20);\n  }\n\n  function updateGutters(cm) {\n    var gutters = cm.display.gutters, specs = cm.options.gutters;\n    removeChildren(gutters);\n    for (var i = 0; i < specs.length; ++i) {\n      var gutterClass = specs[i];\n      var gElt = gutters.appendChild(elt(\"div\", null, \"CodeMirror-gutter \" + gutterClass));\n      if (gutterClass == \"CodeMirror-linenumbers\") {\n        cm.display.lineGutter = gElt;\n        gElt.style.width = (cm.display.lineNumWidth || 1) + \"px\";\n      }\n    }\n    gutters.style.display = i ? \"\" : \"none\";\n  }\n\n  function lineLength(doc, line) {\n    if (line.height == 0) return 0;\n    var len = line.text.length, merged, cur = line;\n    while (merged = collapsedSpanAtStart(cur)) {\n      var found = merged.find();\n      cur = getLine(doc, found.from.line);\n      len += found.from.ch - found.to.ch;\n    }\n    cur = line;\n    while (merged = collapsedSpanAtEnd(cur)) {\n      var found = merged.find();\n      len -= cur.text.length - found.from.ch;\n      cur = getLine(doc, found.to.line);\n      len += cur.text.length - found.to.ch;\n    }\n    return len;\n  }\n\n  function computeMaxLength(cm) {\n    var d = cm.display, doc = cm.doc;\n    d.maxLine = getLine(doc, doc.first);\n    d.maxLineLength = lineLength(doc, d.maxLine);\n    d.maxLineChanged = true;\n    doc.iter(function(line) {\n      var len = lineLength(doc, line);\n      if (len > d.maxLineLength) {\n        d.maxLineLength = len;\n        d.maxLine = line;\n      }\n    });\n  }\n\n  // Make sure the gutters options contains the element\n  // \"CodeMirror-linenumbers\" when the lineNumbers option is true.\n  function setGuttersForLineNumbers(options) {\n    var found = false;\n    for (var i = 0; i < options.gutters.length; ++i) {\n      if (options.gutters[i] == \"CodeMirror-linenumbers\") {\n        if (options.lineNumbers) found = true;\n        else options.gutters.splice(i--, 1);\n      }\n    }\n    if (!found && options.lineNumbers)\n      options.gutters.push(\"CodeMirror-linenumbers\");\n  }\n\n  // SCROLLBARS\n\n  // Re-synchronize the fake scrollbars with the actual size of the\n  // content. Optionally force a scrollTop.\n  function updateScrollbars(cm) {\n    var d = cm.display, docHeight = cm.doc.height;\n    var totalHeight = docHeight + paddingVert(d);\n    d.sizer.style.minHeight = d.heightForcer.style.top = totalHeight + \"px\";\n    d.gutters.style.height = Math.max(totalHeight, d.scroller.clientHeight - scrollerCutOff) + \"px\";\n    var scrollHeight = Math.max(totalHeight, d.scroller.scrollHeight);\n    var needsH = d.scroller.scrollWidth > (d.scroller.clientWidth + 1);\n    var needsV = scrollHeight > (d.scroller.clientHeight + 1);\n    if (needsV) {\n      d.scrollbarV.style.display = \"block\";\n      d.scrollbarV.style.bottom = needsH ? scrollbarWidth(d.measure) + \"px\" : \"0\";\n      d.scrollbarV.firstChild.style.height =\n        (scrollHeight - d.scroller.clientHeight + d.scrollbarV.clientHeight) + \"px\";\n    } else {\n      d.scrollbarV.style.display = \"\";\n      d.scrollbarV.firstChild.style.height = \"0\";\n    }\n    if (needsH) {\n      d.scrollbarH.style.display = \"block\";\n      d.scrollbarH.style.right = needsV ? scrollbarWidth(d.measure) + \"px\" : \"0\";\n      d.scrollbarH.firstChild.style.width =\n        (d.scroller.scrollWidth - d.scroller.clientWidth + d.scrollbarH.clientWidth) + \"px\";\n    } else {\n      d.scrollbarH.style.display = \"\";\n      d.scrollbarH.firstChild.style.width = \"0\";\n    }\n    if (needsH && needsV) {\n      d.scrollbarFiller.style.display = \"block\";\n      d.scrollbarFiller.style.height = d.scrollbarFiller.style.width = scrollbarWidth(d.measure) + \"px\";\n    } else d.scrollbarFiller.style.display = \"\";\n    if (needsH && cm.options.coverGutterNextToScrollbar && cm.options.fixedGutter) {\n      d.gutterFiller.style.display = \"block\";\n      d.gutterFiller.style.height = scrollbarWidth(d.measure) + \"px\";\n      d.gutterFiller.style.width = d.gutters.offsetWidth + \"px\";\n    } else d.gutterFiller.style.display = \"\";\n\n    if (mac_geLion && scrollbarWidth(d.measure) === 0)\n      d.scrollbarV.style.minWidth = d.scrollbarH.style.minHeight = mac_geMountainLion ? \"18px\" : \"12px\";\n  }\n\n  function visibleLines(display, doc, viewPort) {\n    var top = display.scroller.scrollTop, height = display.wrapper.clientHeight;\n    if (typeof viewPort == \"number\") top = viewPort;\n    else if (viewPort) {top = viewPort.top; height = viewPort.bottom - viewPort.top;}\n    top = Math.floor(top - paddingTop(display));\n    var bottom = Math.ceil(top + height);\n    return {from: lineAtHeight(doc, top), to: lineAtHeight(doc, bottom)};\n  }\n\n  // LINE NUMBERS\n\n  function alignHorizontally(cm) {\n    var display = cm.display;\n    if (!display.alignWidgets && (!display.gutters.firstChild || !cm.options.fixedGutter)) return;\n    var comp = compensateForHScroll(display) - display.scroller.scrollLeft + cm.doc.scrollLeft;\n    var gutterW = display.gutters.offsetWidth, l = comp + \"px\";\n    for (var n = display.lineDiv.firstChild; n; n = n.nextSibling) if (n.alignable) {\n      for (var i = 0, a = n.alignable; i < a.length; ++i) a[i].style.left = l;\n    }\n    if (cm.options.fixedGutter)\n      display.gutters.style.left = (comp + gutterW) + \"px\";\n  }\n\n  function maybeUpdateLineNumberWidth(cm) {\n    if (!cm.options.lineNumbers) return false;\n    var doc = cm.doc, last = lineNumberFor(cm.options, doc.first + doc.size - 1), display = cm.display;\n    if (last.length != display.lineNumChars) {\n      var test = display.measure.appendChild(elt(\"div\", [elt(\"div\", last)],\n                                                 \"CodeMirror-linenumber CodeMirror-gutter-elt\"));\n      var innerW = test.firstChild.offsetWidth, padding = test.offsetWidth - innerW;\n      display.lineGutter.style.width = \"\";\n      display.lineNumInnerWidth = Math.max(innerW, display.lineGutter.offsetWidth - padding);\n      display.lineNumWidth = display.lineNumInnerWidth + padding;\n      display.lineNumChars = display.lineNumInnerWidth ? last.length : -1;\n      display.lineGutter.style.width = display.lineNumWidth + \"px\";\n      return true;\n    }\n    return false;\n  }\n\n  function lineNumberFor(options, i) {\n    return String(options.lineNumberFormatter(i + options.firstLineNumber));\n  }\n  function compensateForHScroll(display) {\n    return getRect(display.scroller).left - getRect(display.sizer).left;\n  }\n\n  // DISPLAY DRAWING\n\n  function updateDisplay(cm, changes, viewPort, forced) {\n    var oldFrom = cm.display.showingFrom, oldTo = cm.display.showingTo, updated;\n    var visible = visibleLines(cm.display, cm.doc, viewPort);\n    for (;;) {\n      if (!updateDisplayInner(cm, changes, visible, forced)) break;\n      forced = false;\n      updated = true;\n      updateSelection(cm);\n      updateScrollbars(cm);\n\n      // Clip forced viewport to actual scrollable area\n      if (viewPort)\n        viewPort = Math.min(cm.display.scroller.scrollHeight - cm.display.scroller.clientHeight,\n                            typeof viewPort == \"number\" ? viewPort : viewPort.top);\n      visible = visibleLines(cm.display, cm.doc, viewPort);\n      if (visible.from >= cm.display.showingFrom && visible.to <= cm.display.showingTo)\n        break;\n      changes = [];\n    }\n\n    if (updated) {\n      signalLater(cm, \"update\", cm);\n      if (cm.display.showingFrom != oldFrom || cm.display.showingTo != oldTo)\n        signalLater(cm, \"viewportChange\", cm, cm.display.showingFrom, cm.display.showingTo);\n    }\n    return updated;\n  }\n\n  // Uses a set of changes plus the current scroll position to\n  // determine which DOM updates have to be made, and makes the\n  // updates.\n  function updateDisplayInner(cm, changes, visible, forced) {\n    var display = cm.display, doc = cm.doc;\n    if (!display.wrapper.clientWidth) {\n      display.showingFrom = display.showingTo = doc.first;\n      display.viewOffset = 0;\n      return;\n    }\n\n    // Bail out if the visible area is already rendered and nothing changed.\n    if (!forced && changes.length == 0 &&\n        visible.from > display.showingFrom && visible.to < display.showingTo)\n      return;\n\n    if (maybeUpdateLineNumberWidth(cm))\n      changes = [{from: doc.first, to: doc.first + doc.size}];\n    var gutterW = display.sizer.style.marginLeft = display.gutters.offsetWidth + \"px\";\n    display.scrollbarH.style.left = cm.options.fixedGutter ? gutterW : \"0\";\n\n    // Used to determine which lines need their line numbers updated\n    var positionsChangedFrom = Infinity;\n    if (cm.options.lineNumbers)\n      for (var i = 0; i < changes.length; ++i)\n        if (changes[i].diff && changes[i].from < positionsChangedFrom) { positionsChangedFrom = changes[i].from; }\n\n    var end = doc.first + doc.size;\n    var from = Math.max(visible.from - cm.options.viewportMargin, doc.first);\n    var to = Math.min(end, visible.to + cm.options.viewportMargin);\n    if (display.showingFrom < from && from - display.showingFrom < 20) from = Math.max(doc.first, display.showingFrom);\n    if (display.showingTo > to && display.showingTo - to < 20) to = Math.min(end, display.showingTo);\n    if (sawCollapsedSpans) {\n      from = lineNo(visualLine(doc, getLine(doc, from)));\n      while (to < end && lineIsHidden(doc, getLine(doc, to))) ++to;\n    }\n\n    // Create a range of theoretically intact lines, and punch holes\n    // in that using the change info.\n    var intact = [{from: Math.max(display.showingFrom, doc.first),\n                   to: Math.min(display.showingTo, end)}];\n    if (intact[0].from >= intact[0].to) intact = [];\n    else intact = computeIntact(intact, changes);\n    // When merged lines are present, we might have to reduce the\n    // intact ranges because changes in continued fragments of the\n    // intact lines do require the lines to be redrawn.\n    if (sawCollapsedSpans)\n      for (var i = 0; i < intact.length; ++i) {\n        var range = intact[i], merged;\n        while (merged = collapsedSpanAtEnd(getLine(doc, range.to - 1))) {\n          var newTo = merged.find().from.line;\n          if (newTo > range.from) range.to = newTo;\n          else { intact.splice(i--, 1); break; }\n        }\n      }\n\n    // Clip off the parts that won't be visible\n    var intactLines = 0;\n    for (var i = 0; i < intact.length; ++i) {\n      var range = intact[i];\n      if (range.from < from) range.from = from;\n      if (range.to > to) range.to = to;\n      if (range.from >= range.to) intact.splice(i--, 1);\n      else intactLines += range.to - range.from;\n    }\n    if (!forced && intactLines == to - from && from == display.showingFrom && to == display.showingTo) {\n      updateViewOffset(cm);\n      return;\n    }\n    intact.sort(function(a, b) {return a.from - b.from;});\n\n    // Avoid crashing on IE's \"unspecified error\" when in iframes\n    try {\n      var focused = document.activeElement;\n    } catch(e) {}\n    if (intactLines < (to - from) * .7) display.lineDiv.style.display = \"none\";\n    patchDisplay(cm, from, to, intact, positionsChangedFrom);\n    display.lineDiv.style.display = \"\";\n    if (focused && document.activeElement != focused && focused.offsetHeight) focused.focus();\n\n    var different = from != display.showingFrom || to != display.showingTo ||\n      display.lastSizeC != display.wrapper.clientHeight;\n    // This is just a bogus formula that detects when the editor is\n    // resized or the font size changes.\n    if (different) {\n      display.lastSizeC = display.wrapper.clientHeight;\n      startWorker(cm, 400);\n    }\n    display.showingFrom = from; display.showingTo = to;\n\n    updateHeightsInViewport(cm);\n    updateViewOffset(cm);\n\n    return true;\n  }\n\n  function updateHeightsInViewport(cm) {\n    var display = cm.display;\n    var prevBottom = display.lineDiv.offsetTop;\n    for (var node = display.lineDiv.firstChild, height; node; node = node.nextSibling) if (node.lineObj) {\n      if (ie_lt8) {\n        var bot = node.offsetTop + node.offsetHeight;\n        height = bot - prevBottom;\n        prevBottom = bot;\n      } else {\n        var box = getRect(node);\n        height = box.bottom - box.top;\n      }\n      var diff = node.lineObj.height - height;\n      if (height < 2) height = textHeight(display);\n      if (diff > .001 || diff < -.001) {\n        updateLineHeight(node.lineObj, height);\n        var widgets = node.lineObj.widgets;\n        if (widgets) for (var i = 0; i < widgets.length; ++i)\n          widgets[i].height = widgets[i].node.offsetHeight;\n      }\n    }\n  }\n\n  function updateViewOffset(cm) {\n    var off = cm.display.viewOffset = heightAtLine(cm, getLine(cm.doc, cm.display.showingFrom));\n    // Position the mover div to align with the current virtual scroll position\n    cm.display.mover.style.top = off + \"px\";\n  }\n\n  function computeIntact(intact, changes) {\n    for (var i = 0, l = changes.length || 0; i < l; ++i) {\n      var change = changes[i], intact2 = [], diff = change.diff || 0;\n      for (var j = 0, l2 = intact.length; j < l2; ++j) {\n        var range = intact[j];\n        if (change.to <= range.from && change.diff) {\n          intact2.push({from: range.from + diff, to: range.to + diff});\n        } else if (change.to <= range.from || change.from >= range.to) {\n          intact2.push(range);\n        } else {\n          if (change.from > range.from)\n            intact2.push({from: range.from, to: change.from});\n          if (change.to < range.to)\n            intact2.push({from: change.to + diff, to: range.to + diff});\n        }\n      }\n      intact = intact2;\n    }\n    return intact;\n  }\n\n  function getDimensions(cm) {\n    var d = cm.display, left = {}, width = {};\n    for (var n = d.gutters.firstChild, i = 0; n; n = n.nextSibling, ++i) {\n      left[cm.options.gutters[i]] = n.offsetLeft;\n      width[cm.options.gutters[i]] = n.offsetWidth;\n    }\n    return {fixedPos: compensateForHScroll(d),\n            gutterTotalWidth: d.gutters.offsetWidth,\n            gutterLeft: left,\n            gutterWidth: width,\n            wrapperWidth: d.wrapper.clientWidth};\n  }\n\n  function patchDisplay(cm, from, to, intact, updateNumbersFrom) {\n    var dims = getDimensions(cm);\n    var display = cm.display, lineNumbers = cm.options.lineNumbers;\n    if (!intact.length && (!webkit || !cm.display.currentWheelTarget))\n      removeChildren(display.lineDiv);\n    var container = display.lineDiv, cur = container.firstChild;\n\n    function rm(node) {\n      var next = node.nextSibling;\n      if (webkit && mac && cm.display.currentWheelTarget == node) {\n        node.style.display = \"none\";\n        node.lineObj = null;\n      } else {\n        node.parentNode.removeChild(node);\n      }\n      return next;\n    }\n\n    var nextIntact = intact.shift(), lineN = from;\n    cm.doc.iter(from, to, function(line) {\n      if (nextIntact && nextIntact.to == lineN) nextIntact = intact.shift();\n      if (lineIsHidden(cm.doc, line)) {\n        if (line.height != 0) updateLineHeight(line, 0);\n        if (line.widgets && cur && cur.previousSibling) for (var i = 0; i < line.widgets.length; ++i) {\n          var w = line.widgets[i];\n          if (w.showIfHidden) {\n            var prev = cur.previousSibling;\n            if (/pre/i.test(prev.nodeName)) {\n              var wrap = elt(\"div\", null, null, \"position: relative\");\n              prev.parentNode.replaceChild(wrap, prev);\n              wrap.appendChild(prev);\n              prev = wrap;\n            }\n            var wnode = prev.appendChild(elt(\"div\", [w.node], \"CodeMirror-linewidget\"));\n            if (!w.handleMouseEvents) wnode.ignoreEvents = true;\n            positionLineWidget(w, wnode, prev, dims);\n          }\n        }\n      } else if (nextIntact && nextIntact.from <= lineN && nextIntact.to > lineN) {\n        // This line is intact. Skip to the actual node. Update its\n        // line number if needed.\n        while (cur.lineObj != line) cur = rm(cur);\n        if (lineNumbers && updateNumbersFrom <= lineN && cur.lineNumber)\n          setTextContent(cur.lineNumber, lineNumberFor(cm.options, lineN));\n        cur = cur.nextSibling;\n      } else {\n        // For lines with widgets, make an attempt to find and reuse\n        // the existing element, so that widgets aren't needlessly\n        // removed and re-inserted into the dom\n        if (line.widgets) for (var j = 0, search = cur, reuse; search && j < 20; ++j, search = search.nextSibling)\n          if (search.lineObj == line && /div/i.test(search.nodeName)) { reuse = search; break; }\n        // This line needs to be generated.\n        var lineNode = buildLineElement(cm, line, lineN, dims, reuse);\n        if (lineNode != reuse) {\n          container.insertBefore(lineNode, cur);\n        } else {\n          while (cur != reuse) cur = rm(cur);\n          cur = cur.nextSibling;\n        }\n\n        lineNode.lineObj = line;\n      }\n      ++lineN;\n    });\n    while (cur) cur = rm(cur);\n  }\n\n  function buildLineElement(cm, line, lineNo, dims, reuse) {\n    var lineElement = lineContent(cm, line);\n    var markers = line.gutterMarkers, display = cm.display, wrap;\n\n    if (!cm.options.lineNumbers && !markers && !line.bgClass && !line.wrapClass && !line.widgets)\n      return lineElement;\n\n    // Lines with gutter elements, widgets or a background class need\n    // to be wrapped again, and have the extra elements added to the\n    // wrapper div\n\n    if (reuse) {\n      reuse.alignable = null;\n      var isOk = true, widgetsSeen = 0, insertBefore = null;\n      for (var n = reuse.firstChild, next; n; n = next) {\n        next = n.nextSibling;\n        if (!/\\bCodeMirror-linewidget\\b/.test(n.className)) {\n          reuse.removeChild(n);\n        } else {\n          for (var i = 0; i < line.widgets.length; ++i) {\n            var widget = line.widgets[i];\n            if (widget.node == n.firstChild) {\n              if (!widget.above && !insertBefore) insertBefore = n;\n              positionLineWidget(widget, n, reuse, dims);\n              ++widgetsSeen;\n              break;\n            }\n          }\n          if (i == line.widgets.length) { isOk = false; break; }\n        }\n      }\n      reuse.insertBefore(lineElement, insertBefore);\n      if (isOk && widgetsSeen == line.widgets.length) {\n        wrap = reuse;\n        reuse.className = line.wrapClass || \"\";\n      }\n    }\n    if (!wrap) {\n      wrap = elt(\"div\", null, line.wrapClass, \"position: relative\");\n      wrap.appendChild(lineElement);\n    }\n    // Kludge to make sure the styled element lies behind the selection (by z-index)\n    if (line.bgClass)\n      wrap.insertBefore(elt(\"div\", null, line.bgClass + \" CodeMirror-linebackground\"), wrap.firstChild);\n    if (cm.options.lineNumbers || markers) {\n      var gutterWrap = wrap.insertBefore(elt(\"div\", null, null, \"position: absolute; left: \" +\n                                             (cm.options.fixedGutter ? dims.fixedPos : -dims.gutterTotalWidth) + \"px\"),\n                                         wrap.firstChild);\n      if (cm.options.fixedGutter) (wrap.alignable || (wrap.alignable = [])).push(gutterWrap);\n      if (cm.options.lineNumbers && (!markers || !markers[\"CodeMirror-linenumbers\"]))\n        wrap.lineNumber = gutterWrap.appendChild(\n          elt(\"div\", lineNumberFor(cm.options, lineNo),\n              \"CodeMirror-linenumber CodeMirror-gutter-elt\",\n              \"left: \" + dims.gutterLeft[\"CodeMirror-linenumbers\"] + \"px; width: \"\n              + display.lineNumInnerWidth + \"px\"));\n      if (markers)\n        for (var k = 0; k < cm.options.gutters.length; ++k) {\n          var id = cm.options.gutters[k], found = markers.hasOwnProperty(id) && markers[id];\n          if (found)\n            gutterWrap.appendChild(elt(\"div\", [found], \"CodeMirror-gutter-elt\", \"left: \" +\n                                       dims.gutterLeft[id] + \"px; width: \" + dims.gutterWidth[id] + \"px\"));\n        }\n    }\n    if (ie_lt8) wrap.style.zIndex = 2;\n    if (line.widgets && wrap != reuse) for (var i = 0, ws = line.widgets; i < ws.length; ++i) {\n      var widget = ws[i], node = elt(\"div\", [widget.node], \"CodeMirror-linewidget\");\n      if (!widget.handleMouseEvents) node.ignoreEvents = true;\n      positionLineWidget(widget, node, wrap, dims);\n      if (widget.above)\n        wrap.insertBefore(node, cm.options.lineNumbers && line.height != 0 ? gutterWrap : lineElement);\n      else\n        wrap.appendChild(node);\n      signalLater(widget, \"redraw\");\n    }\n    return wrap;\n  }\n\n  function positionLineWidget(widget, node, wrap, dims) {\n    if (widget.noHScroll) {\n      (wrap.alignable || (wrap.alignable = [])).push(node);\n      var width = dims.wrapperWidth;\n      node.style.left = dims.fixedPos + \"px\";\n      if (!widget.coverGutter) {\n        width -= dims.gutterTotalWidth;\n        node.style.paddingLeft = dims.gutterTotalWidth + \"px\";\n      }\n      node.style.width = width + \"px\";\n    }\n    if (widget.coverGutter) {\n      node.style.zIndex = 5;\n      node.style.position = \"relative\";\n      if (!widget.noHScroll) node.style.marginLeft = -dims.gutterTotalWidth + \"px\";\n    }\n  }\n\n  // SELECTION / CURSOR\n\n  function updateSelection(cm) {\n    var display = cm.display;\n    var collapsed = posEq(cm.doc.sel.from, cm.doc.sel.to);\n    if (collapsed || cm.options.showCursorWhenSelecting)\n      updateSelectionCursor(cm);\n    else\n      display.cursor.style.display = display.otherCursor.style.display = \"none\";\n    if (!collapsed)\n      updateSelectionRange(cm);\n    else\n      display.selectionDiv.style.display = \"none\";\n\n    // Move the hidden textarea near the cursor to prevent scrolling artifacts\n    if (cm.options.moveInputWithCursor) {\n      var headPos = cursorCoords(cm, cm.doc.sel.head, \"div\");\n      var wrapOff = getRect(display.wrapper), lineOff = getRect(display.lineDiv);\n      display.inputDiv.style.top = Math.max(0, Math.min(display.wrapper.clientHeight - 10,\n                                                        headPos.top + lineOff.top - wrapOff.top)) + \"px\";\n      display.inputDiv.style.left = Math.max(0, Math.min(display.wrapper.clientWidth - 10,\n                                                         headPos.left + lineOff.left - wrapOff.left)) + \"px\";\n    }\n  }\n\n  // No selection, plain cursor\n  function updateSelectionCursor(cm) {\n    var display = cm.display, pos = cursorCoords(cm, cm.doc.sel.head, \"div\");\n    display.cursor.style.left = pos.left + \"px\";\n    display.cursor.style.top = pos.top + \"px\";\n    display.cursor.style.height = Math.max(0, pos.bottom - pos.top) * cm.options.cursorHeight + \"px\";\n    display.cursor.style.display = \"\";\n\n    if (pos.other) {\n      display.otherCursor.style.display = \"\";\n      display.otherCursor.style.left = pos.other.left + \"px\";\n      display.otherCursor.style.top = pos.other.top + \"px\";\n      display.otherCursor.style.height = (pos.other.bottom - pos.other.top) * .85 + \"px\";\n    } else { display.otherCursor.style.display = \"none\"; }\n  }\n\n  // Highlight selection\n  function updateSelectionRange(cm) {\n    var display = cm.display, doc = cm.doc, sel = cm.doc.sel;\n    var fragment = document.createDocumentFragment();\n    var clientWidth = display.lineSpace.offsetWidth, pl = paddingLeft(cm.display);\n\n    function add(left, top, width, bottom) {\n      if (top < 0) top = 0;\n      fragment.appendChild(elt(\"div\", null, \"CodeMirror-selected\", \"position: absolute; left: \" + left +\n                               \"px; top: \" + top + \"px; width: \" + (width == null ? clientWidth - left : width) +\n                               \"px; height: \" + (bottom - top) + \"px\"));\n    }\n\n    function drawForLine(line, fromArg, toArg) {\n      var lineObj = getLine(doc, line);\n      var lineLen = lineObj.text.length;\n      var start, end;\n      function coords(ch, bias) {\n        return charCoords(cm, Pos(line, ch), \"div\", lineObj, bias);\n      }\n\n      iterateBidiSections(getOrder(lineObj), fromArg || 0, toArg == null ? lineLen : toArg, function(from, to, dir) {\n        var leftPos = coords(from, \"left\"), rightPos, left, right;\n        if (from == to) {\n          rightPos = leftPos;\n          left = right = leftPos.left;\n        } else {\n          rightPos = coords(to - 1, \"right\");\n          if (dir == \"rtl\") { var tmp = leftPos; leftPos = rightPos; rightPos = tmp; }\n          left = leftPos.left;\n          right = rightPos.right;\n        }\n        if (fromArg == null && from == 0) left = pl;\n        if (rightPos.top - leftPos.top > 3) { // Different lines, draw top part\n          add(left, leftPos.top, null, leftPos.bottom);\n          left = pl;\n          if (leftPos.bottom < rightPos.top) add(left, leftPos.bottom, null, rightPos.top);\n        }\n        if (toArg == null && to == lineLen) right = clientWidth;\n        if (!start || leftPos.top < start.top || leftPos.top == start.top && leftPos.left < start.left)\n          start = leftPos;\n        if (!end || rightPos.bottom > end.bottom || rightPos.bottom == end.bottom && rightPos.right > end.right)\n          end = rightPos;\n        if (left < pl + 1) left = pl;\n        add(left, rightPos.top, right - left, rightPos.bottom);\n      });\n      return {start: start, end: end};\n    }\n\n    if (sel.from.line == sel.to.line) {\n      drawForLine(sel.from.line, sel.from.ch, sel.to.ch);\n    } else {\n      var fromLine = getLine(doc, sel.from.line), toLine = getLine(doc, sel.to.line);\n      var singleVLine = visualLine(doc, fromLine) == visualLine(doc, toLine);\n      var leftEnd = drawForLine(sel.from.line, sel.from.ch, singleVLine ? fromLine.text.length : null).end;\n      var rightStart = drawForLine(sel.to.line, singleVLine ? 0 : null, sel.to.ch).start;\n      if (singleVLine) {\n        if (leftEnd.top < rightStart.top - 2) {\n          add(leftEnd.right, leftEnd.top, null, leftEnd.bottom);\n          add(pl, rightStart.top, rightStart.left, rightStart.bottom);\n        } else {\n          add(leftEnd.right, leftEnd.top, rightStart.left - leftEnd.right, leftEnd.bottom);\n        }\n      }\n      if (leftEnd.bottom < rightStart.top)\n        add(pl, leftEnd.bottom, null, rightStart.top);\n    }\n\n    removeChildrenAndAdd(display.selectionDiv, fragment);\n    display.selectionDiv.style.display = \"\";\n  }\n\n  // Cursor-blinking\n  function restartBlink(cm) {\n    if (!cm.state.focused) return;\n    var display = cm.display;\n    clearInterval(display.blinker);\n    var on = true;\n    display.cursor.style.visibility = display.otherCursor.style.visibility = \"\";\n    if (cm.options.cursorBlinkRate > 0)\n      display.blinker = setInterval(function() {\n        display.cursor.style.visibility = display.otherCursor.style.visibility = (on = !on) ? \"\" : \"hidden\";\n      }, cm.options.cursorBlinkRate);\n  }\n\n  // HIGHLIGHT WORKER\n\n  function startWorker(cm, time) {\n    if (cm.doc.mode.startState && cm.doc.frontier < cm.display.showingTo)\n      cm.state.highlight.set(time, bind(highlightWorker, cm));\n  }\n\n  function highlightWorker(cm) {\n    var doc = cm.doc;\n    if (doc.frontier < doc.first) doc.frontier = doc.first;\n    if (doc.frontier >= cm.display.showingTo) return;\n    var end = +new Date + cm.options.workTime;\n    var state = copyState(doc.mode, getStateBefore(cm, doc.frontier));\n    var changed = [], prevChange;\n    doc.iter(doc.frontier, Math.min(doc.first + doc.size, cm.display.showingTo + 500), function(line) {\n      if (doc.frontier >= cm.display.showingFrom) { // Visible\n        var oldStyles = line.styles;\n        line.styles = highlightLine(cm, line, state);\n        var ischange = !oldStyles || oldStyles.length != line.styles.length;\n        for (var i = 0; !ischange && i < oldStyles.length; ++i) ischange = oldStyles[i] != line.styles[i];\n        if (ischange) {\n          if (prevChange && prevChange.end == doc.frontier) prevChange.end++;\n          else changed.push(prevChange = {start: doc.frontier, end: doc.frontier + 1});\n        }\n        line.stateAfter = copyState(doc.mode, state);\n      } else {\n        processLine(cm, line, state);\n        line.stateAfter = doc.frontier % 5 == 0 ? copyState(doc.mode, state) : null;\n      }\n      ++doc.frontier;\n      if (+new Date > end) {\n        startWorker(cm, cm.options.workDelay);\n        return true;\n      }\n    });\n    if (changed.length)\n      operation(cm, function() {\n        for (var i = 0; i < changed.length; ++i)\n          regChange(this, changed[i].start, changed[i].end);\n      })();\n  }\n\n  // Finds the line to start with when starting a parse. Tries to\n  // find a line with a stateAfter, so that it can start with a\n  // valid state. If that fails, it returns the line with the\n  // smallest indentation, which tends to need the least context to\n  // parse correctly.\n  function findStartLine(cm, n, precise) {\n    var minindent, minline, doc = cm.doc, maxScan = cm.doc.mode.innerMode ? 1000 : 100;\n    for (var search = n, lim = n - maxScan; search > lim; --search) {\n      if (search <= doc.first) return doc.first;\n      var line = getLine(doc, search - 1);\n      if (line.stateAfter && (!precise || search <= doc.frontier)) return search;\n      var indented = countColumn(line.text, null, cm.options.tabSize);\n      if (minline == null || minindent > indented) {\n        minline = search - 1;\n        minindent = indented;\n      }\n    }\n    return minline;\n  }\n\n  function getStateBefore(cm, n, precise) {\n    var doc = cm.doc, display = cm.display;\n    if (!doc.mode.startState) return true;\n    var pos = findStartLine(cm, n, precise), state = pos > doc.first && getLine(doc, pos-1).stateAfter;\n    if (!state) state = startState(doc.mode);\n    else state = copyState(doc.mode, state);\n    doc.iter(pos, n, function(line) {\n      processLine(cm, line, state);\n      var save = pos == n - 1 || pos % 5 == 0 || pos >= display.showingFrom && pos < display.showingTo;\n      line.stateAfter = save ? copyState(doc.mode, state) : null;\n      ++pos;\n    });\n    return state;\n  }\n\n  // POSITION MEASUREMENT\n\n  function paddingTop(display) {return display.lineSpace.offsetTop;}\n  function paddingVert(display) {return display.mover.offsetHeight - display.lineSpace.offsetHeight;}\n  function paddingLeft(display) {\n    var e = removeChildrenAndAdd(display.measure, elt(\"pre\", null, null, \"text-align: left\")).appendChild(elt(\"span\", \"x\"));\n    return e.offsetLeft;\n  }\n\n  function measureChar(cm, line, ch, data, bias) {\n    var dir = -1;\n    data = data || measureLine(cm, line);\n\n    for (var pos = ch;; pos += dir) {\n      var r = data[pos];\n      if (r) break;\n      if (dir < 0 && pos == 0) dir = 1;\n    }\n    bias = pos > ch ? \"left\" : pos < ch ? \"right\" : bias;\n    if (bias == \"left\" && r.leftSide) r = r.leftSide;\n    else if (bias == \"right\" && r.rightSide) r = r.rightSide;\n    return {left: pos < ch ? r.right : r.left,\n            right: pos > ch ? r.left : r.right,\n            top: r.top,\n            bottom: r.bottom};\n  }\n\n  function findCachedMeasurement(cm, line) {\n    var cache = cm.display.measureLineCache;\n    for (var i = 0; i < cache.length; ++i) {\n      var memo = cache[i];\n      if (memo.text == line.text && memo.markedSpans == line.markedSpans &&\n          cm.display.scroller.clientWidth == memo.width &&\n          memo.classes == line.textClass + \"|\" + line.bgClass + \"|\" + line.wrapClass)\n        return memo;\n    }\n  }\n\n  function clearCachedMeasurement(cm, line) {\n    var exists = findCachedMeasurement(cm, line);\n    if (exists) exists.text = exists.measure = exists.markedSpans = null;\n  }\n\n  function measureLine(cm, line) {\n    // First look in the cache\n    var cached = findCachedMeasurement(cm, line);\n    if (cached) return cached.measure;\n\n    // Failing that, recompute and store result in cache\n    var measure = measureLineInner(cm, line);\n    var cache = cm.display.measureLineCache;\n    var memo = {text: line.text, width: cm.display.scroller.clientWidth,\n                markedSpans: line.markedSpans, measure: measure,\n                classes: line.textClass + \"|\" + line.bgClass + \"|\" + line.wrapClass};\n    if (cache.length == 16) cache[++cm.display.measureLineCachePos % 16] = memo;\n    else cache.push(memo);\n    return measure;\n  }\n\n  function measureLineInner(cm, line) {\n    var display = cm.display, measure = emptyArray(line.text.length);\n    var pre = lineContent(cm, line, measure, true);\n\n    // IE does not cache element positions of inline elements between\n    // calls to getBoundingClientRect. This makes the loop below,\n    // which gathers the positions of all the characters on the line,\n    // do an amount of layout work quadratic to the number of\n    // characters. When line wrapping is off, we try to improve things\n    // by first subdividing the line into a bunch of inline blocks, so\n    // that IE can reuse most of the layout information from caches\n    // for those blocks. This does interfere with line wrapping, so it\n    // doesn't work when wrapping is on, but in that case the\n    // situation is slightly better, since IE does cache line-wrapping\n    // information and only recomputes per-line.\n    if (ie && !ie_lt8 && !cm.options.lineWrapping && pre.childNodes.length > 100) {\n      var fragment = document.createDocumentFragment();\n      var chunk = 10, n = pre.childNodes.length;\n      for (var i = 0, chunks = Math.ceil(n / chunk); i < chunks; ++i) {\n        var wrap = elt(\"div\", null, null, \"display: inline-block\");\n        for (var j = 0; j < chunk && n; ++j) {\n          wrap.appendChild(pre.firstChild);\n          --n;\n        }\n        fragment.appendChild(wrap);\n      }\n      pre.appendChild(fragment);\n    }\n\n    removeChildrenAndAdd(display.measure, pre);\n\n    var outer = getRect(display.lineDiv);\n    var vranges = [], data = emptyArray(line.text.length), maxBot = pre.offsetHeight;\n    // Work around an IE7/8 bug where it will sometimes have randomly\n    // replaced our pre with a clone at this point.\n    if (ie_lt9 && display.measure.first != pre)\n      removeChildrenAndAdd(display.measure, pre);\n\n    function measureRect(rect) {\n      var top = rect.top - outer.top, bot = rect.bottom - outer.top;\n      if (bot > maxBot) bot = maxBot;\n      if (top < 0) top = 0;\n      for (var i = vranges.length - 2; i >= 0; i -= 2) {\n        var rtop = vranges[i], rbot = vranges[i+1];\n        if (rtop > bot || rbot < top) continue;\n        if (rtop <= top && rbot >= bot ||\n            top <= rtop && bot >= rbot ||\n            Math.min(bot, rbot) - Math.max(top, rtop) >= (bot - top) >> 1) {\n          vranges[i] = Math.min(top, rtop);\n          vranges[i+1] = Math.max(bot, rbot);\n          break;\n        }\n      }\n      if (i < 0) { i = vranges.length; vranges.push(top, bot); }\n      return {left: rect.left - outer.left,\n              right: rect.right - outer.left,\n              top: i, bottom: null};\n    }\n    function finishRect(rect) {\n      rect.bottom = vranges[rect.top+1];\n      rect.top = vranges[rect.top];\n    }\n\n    for (var i = 0, cur; i < measure.length; ++i) if (cur = measure[i]) {\n      var node = cur, rect = null;\n      // A widget might wrap, needs special care\n      if (/\\bCodeMirror-widget\\b/.test(cur.className) && cur.getClientRects) {\n        if (cur.firstChild.nodeType == 1) node = cur.firstChild;\n        var rects = node.getClientRects();\n        if (rects.length > 1) {\n          rect = data[i] = measureRect(rects[0]);\n          rect.rightSide = measureRect(rects[rects.length - 1]);\n        }\n      }\n      if (!rect) rect = data[i] = measureRect(getRect(node));\n      if (cur.measureRight) rect.right = getRect(cur.measureRight).left;\n      if (cur.leftSide) rect.leftSide = measureRect(getRect(cur.leftSide));\n    }\n    removeChildren(cm.display.measure);\n    for (var i = 0, cur; i < data.length; ++i) if (cur = data[i]) {\n      finishRect(cur);\n      if (cur.leftSide) finishRect(cur.leftSide);\n      if (cur.rightSide) finishRect(cur.rightSide);\n    }\n    return data;\n  }\n\n  function measureLineWidth(cm, line) {\n    var hasBadSpan = false;\n    if (line.markedSpans) for (var i = 0; i < line.markedSpans; ++i) {\n      var sp = line.markedSpans[i];\n      if (sp.collapsed && (sp.to == null || sp.to == line.text.length)) hasBadSpan = true;\n    }\n    var cached = !hasBadSpan && findCachedMeasurement(cm, line);\n    if (cached) return measureChar(cm, line, line.text.length, cached.measure, \"right\").right;\n\n    var pre = lineContent(cm, line, null, true);\n    var end = pre.appendChild(zeroWidthElement(cm.display.measure));\n    removeChildrenAndAdd(cm.display.measure, pre);\n    return getRect(end).right - getRect(cm.display.lineDiv).left;\n  }\n\n  function clearCaches(cm) {\n    cm.display.measureLineCache.length = cm.display.measureLineCachePos = 0;\n    cm.display.cachedCharWidth = cm.display.cachedTextHeight = null;\n    if (!cm.options.lineWrapping) cm.display.maxLineChanged = true;\n    cm.display.lineNumChars = null;\n  }\n\n  function pageScrollX() { return window.pageXOffset || (document.documentElement || document.body).scrollLeft; }\n  function pageScrollY() { return window.pageYOffset || (document.documentElement || document.body).scrollTop; }\n\n  // Context is one of \"line\", \"div\" (display.lineDiv), \"local\"/null (editor), or \"page\"\n  function intoCoordSystem(cm, lineObj, rect, context) {\n    if (lineObj.widgets) for (var i = 0; i < lineObj.widgets.length; ++i) if (lineObj.widgets[i].above) {\n      var size = widgetHeight(lineObj.widgets[i]);\n      rect.top += size; rect.bottom += size;\n    }\n    if (context == \"line\") return rect;\n    if (!context) context = \"local\";\n    var yOff = heightAtLine(cm, lineObj);\n    if (context == \"local\") yOff += paddingTop(cm.display);\n    else yOff -= cm.display.viewOffset;\n    if (context == \"page\" || context == \"window\") {\n      var lOff = getRect(cm.display.lineSpace);\n      yOff += lOff.top + (context == \"window\" ? 0 : pageScrollY());\n      var xOff = lOff.left + (context == \"window\" ? 0 : pageScrollX());\n      rect.left += xOff; rect.right += xOff;\n    }\n    rect.top += yOff; rect.bottom += yOff;\n    return rect;\n  }\n\n  // Context may be \"window\", \"page\", \"div\", or \"local\"/null\n  // Result is in \"div\" coords\n  function fromCoordSystem(cm, coords, context) {\n    if (context == \"div\") return coords;\n    var left = coords.left, top = coords.top;\n    // First move into \"page\" coordinate system\n    if (context == \"page\") {\n      left -= pageScrollX();\n      top -= pageScrollY();\n    } else if (context == \"local\" || !context) {\n      var localBox = getRect(cm.display.sizer);\n      left += localBox.left;\n      top += localBox.top;\n    }\n\n    var lineSpaceBox = getRect(cm.display.lineSpace);\n    return {left: left - lineSpaceBox.left, top: top - lineSpaceBox.top};\n  }\n\n  function charCoords(cm, pos, context, lineObj, bias) {\n    if (!lineObj) lineObj = getLine(cm.doc, pos.line);\n    return intoCoordSystem(cm, lineObj, measureChar(cm, lineObj, pos.ch, null, bias), context);\n  }\n\n  function cursorCoords(cm, pos, context, lineObj, measurement) {\n    lineObj = lineObj || getLine(cm.doc, pos.line);\n    if (!measurement) measurement = measureLine(cm, lineObj);\n    function get(ch, right) {\n      var m = measureChar(cm, lineObj, ch, measurement, right ? \"right\" : \"left\");\n      if (right) m.left = m.right; else m.right = m.left;\n      return intoCoordSystem(cm, lineObj, m, context);\n    }\n    function getBidi(ch, partPos) {\n      var part = order[partPos], right = part.level % 2;\n      if (ch == bidiLeft(part) && partPos && part.level < order[partPos - 1].level) {\n        part = order[--partPos];\n        ch = bidiRight(part) - (part.level % 2 ? 0 : 1);\n        right = true;\n      } else if (ch == bidiRight(part) && partPos < order.length - 1 && part.level < order[partPos + 1].level) {\n        part = order[++partPos];\n        ch = bidiLeft(part) - part.level % 2;\n        right = false;\n      }\n      if (right && ch == part.to && ch > part.from) return get(ch - 1);\n      return get(ch, right);\n    }\n    var order = getOrder(lineObj), ch = pos.ch;\n    if (!order) return get(ch);\n    var partPos = getBidiPartAt(order, ch);\n    var val = getBidi(ch, partPos);\n    if (bidiOther != null) val.other = getBidi(ch, bidiOther);\n    return val;\n  }\n\n  function PosWithInfo(line, ch, outside, xRel) {\n    var pos = new Pos(line, ch);\n    pos.xRel = xRel;\n    if (outside) pos.outside = true;\n    return pos;\n  }\n\n  // Coords must be lineSpace-local\n  function coordsChar(cm, x, y) {\n    var doc = cm.doc;\n    y += cm.display.viewOffset;\n    if (y < 0) return PosWithInfo(doc.first, 0, true, -1);\n    var lineNo = lineAtHeight(doc, y), last = doc.first + doc.size - 1;\n    if (lineNo > last)\n      return PosWithInfo(doc.first + doc.size - 1, getLine(doc, last).text.length, true, 1);\n    if (x < 0) x = 0;\n\n    for (;;) {\n      var lineObj = getLine(doc, lineNo);\n      var found = coordsCharInner(cm, lineObj, lineNo, x, y);\n      var merged = collapsedSpanAtEnd(lineObj);\n      var mergedPos = merged && merged.find();\n      if (merged && (found.ch > mergedPos.from.ch || found.ch == mergedPos.from.ch && found.xRel > 0))\n        lineNo = mergedPos.to.line;\n      else\n        return found;\n    }\n  }\n\n  function coordsCharInner(cm, lineObj, lineNo, x, y) {\n    var innerOff = y - heightAtLine(cm, lineObj);\n    var wrongLine = false, adjust = 2 * cm.display.wrapper.clientWidth;\n    var measurement = measureLine(cm, lineObj);\n\n    function getX(ch) {\n      var sp = cursorCoords(cm, Pos(lineNo, ch), \"line\",\n                            lineObj, measurement);\n      wrongLine = true;\n      if (innerOff > sp.bottom) return sp.left - adjust;\n      else if (innerOff < sp.top) return sp.left + adjust;\n      else wrongLine = false;\n      return sp.left;\n    }\n\n    var bidi = getOrder(lineObj), dist = lineObj.text.length;\n    var from = lineLeft(lineObj), to = lineRight(lineObj);\n    var fromX = getX(from), fromOutside = wrongLine, toX = getX(to), toOutside = wrongLine;\n\n    if (x > toX) return PosWithInfo(lineNo, to, toOutside, 1);\n    // Do a binary search between these bounds.\n    for (;;) {\n      if (bidi ? to == from || to == moveVisually(lineObj, from, 1) : to - from <= 1) {\n        var ch = x < fromX || x - fromX <= toX - x ? from : to;\n        var xDiff = x - (ch == from ? fromX : toX);\n        while (isExtendingChar.test(lineObj.text.charAt(ch))) ++ch;\n        var pos = PosWithInfo(lineNo, ch, ch == from ? fromOutside : toOutside,\n                              xDiff < 0 ? -1 : xDiff ? 1 : 0);\n        return pos;\n      }\n      var step = Math.ceil(dist / 2), middle = from + step;\n      if (bidi) {\n        middle = from;\n        for (var i = 0; i < step; ++i) middle = moveVisually(lineObj, middle, 1);\n      }\n      var middleX = getX(middle);\n      if (middleX > x) {to = middle; toX = middleX; if (toOutside = wrongLine) toX += 1000; dist = step;}\n      else {from = middle; fromX = middleX; fromOutside = wrongLine; dist -= step;}\n    }\n  }\n\n  var measureText;\n  function textHeight(display) {\n    if (display.cachedTextHeight != null) return display.cachedTextHeight;\n    if (measureText == null) {\n      measureText = elt(\"pre\");\n      // Measure a bunch of lines, for browsers that compute\n      // fractional heights.\n      for (var i = 0; i < 49; ++i) {\n        measureText.appendChild(document.createTextNode(\"x\"));\n        measureText.appendChild(elt(\"br\"));\n      }\n      measureText.appendChild(document.createTextNode(\"x\"));\n    }\n    removeChildrenAndAdd(display.measure, measureText);\n    var height = measureText.offsetHeight / 50;\n    if (height > 3) display.cachedTextHeight = height;\n    removeChildren(display.measure);\n    return height || 1;\n  }\n\n  function charWidth(display) {\n    if (display.cachedCharWidth != null) return display.cachedCharWidth;\n    var anchor = elt(\"span\", \"x\");\n    var pre = elt(\"pre\", [anchor]);\n    removeChildrenAndAdd(display.measure, pre);\n    var width = anchor.offsetWidth;\n    if (width > 2) display.cachedCharWidth = width;\n    return width || 10;\n  }\n\n  // OPERATIONS\n\n  // Operations are used to wrap changes in such a way that each\n  // change won't have to update the cursor and display (which would\n  // be awkward, slow, and error-prone), but instead updates are\n  // batched and then all combined and executed at once.\n\n  var nextOpId = 0;\n  function startOperation(cm) {\n    cm.curOp = {\n      // An array of ranges of lines that have to be updated. See\n      // updateDisplay.\n      changes: [],\n      forceUpdate: false,\n      updateInput: null,\n      userSelChange: null,\n      textChanged: null,\n      selectionChanged: false,\n      cursorActivity: false,\n      updateMaxLine: false,\n      updateScrollPos: false,\n      id: ++nextOpId\n    };\n    if (!delayedCallbackDepth++) delayedCallbacks = [];\n  }\n\n  function endOperation(cm) {\n    var op = cm.curOp, doc = cm.doc, display = cm.display;\n    cm.curOp = null;\n\n    if (op.updateMaxLine) computeMaxLength(cm);\n    if (display.maxLineChanged && !cm.options.lineWrapping && display.maxLine) {\n      var width = measureLineWidth(cm, display.maxLine);\n      display.sizer.style.minWidth = Math.max(0, width + 3 + scrollerCutOff) + \"px\";\n      display.maxLineChanged = false;\n      var maxScrollLeft = Math.max(0, display.sizer.offsetLeft + display.sizer.offsetWidth - display.scroller.clientWidth);\n      if (maxScrollLeft < doc.scrollLeft && !op.updateScrollPos)\n        setScrollLeft(cm, Math.min(display.scroller.scrollLeft, maxScrollLeft), true);\n    }\n    var newScrollPos, updated;\n    if (op.updateScrollPos) {\n      newScrollPos = op.updateScrollPos;\n    } else if (op.selectionChanged && display.scroller.clientHeight) { // don't rescroll if not visible\n      var coords = cursorCoords(cm, doc.sel.head);\n      newScrollPos = calculateScrollPos(cm, coords.left, coords.top, coords.left, coords.bottom);\n    }\n    if (op.changes.length || op.forceUpdate || newScrollPos && newScrollPos.scrollTop != null) {\n      updated = updateDisplay(cm, op.changes, newScrollPos && newScrollPos.scrollTop, op.forceUpdate);\n      if (cm.display.scroller.offsetHeight) cm.doc.scrollTop = cm.display.scroller.scrollTop;\n    }\n    if (!updated && op.selectionChanged) updateSelection(cm);\n    if (op.updateScrollPos) {\n      display.scroller.scrollTop = display.scrollbarV.scrollTop = doc.scrollTop = newScrollPos.scrollTop;\n      display.scroller.scrollLeft = display.scrollbarH.scrollLeft = doc.scrollLeft = newScrollPos.scrollLeft;\n      alignHorizontally(cm);\n      if (op.scrollToPos)\n        scrollPosIntoView(cm, clipPos(cm.doc, op.scrollToPos), op.scrollToPosMargin);\n    } else if (newScrollPos) {\n      scrollCursorIntoView(cm);\n    }\n    if (op.selectionChanged) restartBlink(cm);\n\n    if (cm.state.focused && op.updateInput)\n      resetInput(cm, op.userSelChange);\n\n    var hidden = op.maybeHiddenMarkers, unhidden = op.maybeUnhiddenMarkers;\n    if (hidden) for (var i = 0; i < hidden.length; ++i)\n      if (!hidden[i].lines.length) signal(hidden[i], \"hide\");\n    if (unhidden) for (var i = 0; i < unhidden.length; ++i)\n      if (unhidden[i].lines.length) signal(unhidden[i], \"unhide\");\n\n    var delayed;\n    if (!--delayedCallbackDepth) {\n      delayed = delayedCallbacks;\n      delayedCallbacks = null;\n    }\n    if (op.textChanged)\n      signal(cm, \"change\", cm, op.textChanged);\n    if (op.cursorActivity) signal(cm, \"cursorActivity\", cm);\n    if (delayed) for (var i = 0; i < delayed.length; ++i) delayed[i]();\n  }\n\n  // Wraps a function in an operation. Returns the wrapped function.\n  function operation(cm1, f) {\n    return function() {\n      var cm = cm1 || this, withOp = !cm.curOp;\n      if (withOp) startOperation(cm);\n      try { var result = f.apply(cm, arguments); }\n      finally { if (withOp) endOperation(cm); }\n      return result;\n    };\n  }\n  function docOperation(f) {\n    return function() {\n      var withOp = this.cm && !this.cm.curOp, result;\n      if (withOp) startOperation(this.cm);\n      try { result = f.apply(this, arguments); }\n      finally { if (withOp) endOperation(this.cm); }\n      return result;\n    };\n  }\n  function runInOp(cm, f) {\n    var withOp = !cm.curOp, result;\n    if (withOp) startOperation(cm);\n    try { result = f(); }\n    finally { if (withOp) endOperation(cm); }\n    return result;\n  }\n\n  function regChange(cm, from, to, lendiff) {\n    if (from == null) from = cm.doc.first;\n    if (to == null) to = cm.doc.first + cm.doc.size;\n    cm.curOp.changes.push({from: from, to: to, diff: lendiff});\n  }\n\n  // INPUT HANDLING\n\n  function slowPoll(cm) {\n    if (cm.display.pollingFast) return;\n    cm.display.poll.set(cm.options.pollInterval, function() {\n      readInput(cm);\n      if (cm.state.focused) slowPoll(cm);\n    });\n  }\n\n  function fastPoll(cm) {\n    var missed = false;\n    cm.display.pollingFast = true;\n    function p() {\n      var changed = readInput(cm);\n      if (!changed && !missed) {missed = true; cm.display.poll.set(60, p);}\n      else {cm.display.pollingFast = false; slowPoll(cm);}\n    }\n    cm.display.poll.set(20, p);\n  }\n\n  // prevInput is a hack to work with IME. If we reset the textarea\n  // on every change, that breaks IME. So we look for changes\n  // compared to the previous content instead. (Modern browsers have\n  // events that indicate IME taking place, but these are not widely\n  // supported or compatible enough yet to rely on.)\n  function readInput(cm) {\n    var input = cm.display.input, prevInput = cm.display.prevInput, doc = cm.doc, sel = doc.sel;\n    if (!cm.state.focused || hasSelection(input) || isReadOnly(cm) || cm.state.disableInput) return false;\n    if (cm.state.pasteIncoming && cm.state.fakedLastChar) {\n      input.value = input.value.substring(0, input.value.length - 1);\n      cm.state.fakedLastChar = false;\n    }\n    var text = input.value;\n    if (text == prevInput && posEq(sel.from, sel.to)) return false;\n    if (ie && !ie_lt9 && cm.display.inputHasSelection === text) {\n      resetInput(cm, true);\n      return false;\n    }\n\n    var withOp = !cm.curOp;\n    if (withOp) startOperation(cm);\n    sel.shift = false;\n    var same = 0, l = Math.min(prevInput.length, text.length);\n    while (same < l && prevInput.charCodeAt(same) == text.charCodeAt(same)) ++same;\n    var from = sel.from, to = sel.to;\n    if (same < prevInput.length)\n      from = Pos(from.line, from.ch - (prevInput.length - same));\n    else if (cm.state.overwrite && posEq(from, to) && !cm.state.pasteIncoming)\n      to = Pos(to.line, Math.min(getLine(doc, to.line).text.length, to.ch + (text.length - same)));\n\n    var updateInput = cm.curOp.updateInput;\n    var changeEvent = {from: from, to: to, text: splitLines(text.slice(same)),\n                       origin: cm.state.pasteIncoming ? \"paste\" : \"+input\"};\n    makeChange(cm.doc, changeEvent, \"end\");\n    cm.curOp.updateInput = updateInput;\n    signalLater(cm, \"inputRead\", cm, changeEvent);\n\n    if (text.length > 1000 || text.indexOf(\"\\n\") > -1) input.value = cm.display.prevInput = \"\";\n    else cm.display.prevInput = text;\n    if (withOp) endOperation(cm);\n    cm.state.pasteIncoming = false;\n    return true;\n  }\n\n  function resetInput(cm, user) {\n    var minimal, selected, doc = cm.doc;\n    if (!posEq(doc.sel.from, doc.sel.to)) {\n      cm.display.prevInput = \"\";\n      minimal = hasCopyEvent &&\n        (doc.sel.to.line - doc.sel.from.line > 100 || (selected = cm.getSelection()).length > 1000);\n      var content = minimal ? \"-\" : selected || cm.getSelection();\n      cm.display.input.value = content;\n      if (cm.state.focused) selectInput(cm.display.input);\n      if (ie && !ie_lt9) cm.display.inputHasSelection = content;\n    } else if (user) {\n      cm.display.prevInput = cm.display.input.value = \"\";\n      if (ie && !ie_lt9) cm.display.inputHasSelection = null;\n    }\n    cm.display.inaccurateSelection = minimal;\n  }\n\n  function focusInput(cm) {\n    if (cm.options.readOnly != \"nocursor\" && (!mobile || document.activeElement != cm.display.input))\n      cm.display.input.focus();\n  }\n\n  function isReadOnly(cm) {\n    return cm.options.readOnly || cm.doc.cantEdit;\n  }\n\n  // EVENT HANDLERS\n\n  function registerEventHandlers(cm) {\n    var d = cm.display;\n    on(d.scroller, \"mousedown\", operation(cm, onMouseDown));\n    if (ie)\n      on(d.scroller, \"dblclick\", operation(cm, function(e) {\n        if (signalDOMEvent(cm, e)) return;\n        var pos = posFromMouse(cm, e);\n        if (!pos || clickInGutter(cm, e) || eventInWidget(cm.display, e)) return;\n        e_preventDefault(e);\n        var word = findWordAt(getLine(cm.doc, pos.line).text, pos);\n        extendSelection(cm.doc, word.from, word.to);\n      }));\n    else\n      on(d.scroller, \"dblclick\", function(e) { signalDOMEvent(cm, e) || e_preventDefault(e); });\n    on(d.lineSpace, \"selectstart\", function(e) {\n      if (!eventInWidget(d, e)) e_preventDefault(e);\n    });\n    // Gecko browsers fire contextmenu *after* opening the menu, at\n    // which point we can't mess with it anymore. Context menu is\n    // handled in onMouseDown for Gecko.\n    if (!captureMiddleClick) on(d.scroller, \"contextmenu\", function(e) {onContextMenu(cm, e);});\n\n    on(d.scroller, \"scroll\", function() {\n      if (d.scroller.clientHeight) {\n        setScrollTop(cm, d.scroller.scrollTop);\n        setScrollLeft(cm, d.scroller.scrollLeft, true);\n        signal(cm, \"scroll\", cm);\n      }\n    });\n    on(d.scrollbarV, \"scroll\", function() {\n      if (d.scroller.clientHeight) setScrollTop(cm, d.scrollbarV.scrollTop);\n    });\n    on(d.scrollbarH, \"scroll\", function() {\n      if (d.scroller.clientHeight) setScrollLeft(cm, d.scrollbarH.scrollLeft);\n    });\n\n    on(d.scroller, \"mousewheel\", function(e){onScrollWheel(cm, e);});\n    on(d.scroller, \"DOMMouseScroll\", function(e){onScrollWheel(cm, e);});\n\n    function reFocus() { if (cm.state.focused) setTimeout(bind(focusInput, cm), 0); }\n    on(d.scrollbarH, \"mousedown\", reFocus);\n    on(d.scrollbarV, \"mousedown\", reFocus);\n    // Prevent wrapper from ever scrolling\n    on(d.wrapper, \"scroll\", function() { d.wrapper.scrollTop = d.wrapper.scrollLeft = 0; });\n\n    var resizeTimer;\n    function onResize() {\n      if (resizeTimer == null) resizeTimer = setTimeout(function() {\n        resizeTimer = null;\n        // Might be a text scaling operation, clear size caches.\n        d.cachedCharWidth = d.cachedTextHeight = knownScrollbarWidth = null;\n        clearCaches(cm);\n        runInOp(cm, bind(regChange, cm));\n      }, 100);\n    }\n    on(window, \"resize\", onResize);\n    // Above handler holds on to the editor and its data structures.\n    // Here we poll to unregister it when the editor is no longer in\n    // the document, so that it can be garbage-collected.\n    function unregister() {\n      for (var p = d.wrapper.parentNode; p && p != document.body; p = p.parentNode) {}\n      if (p) setTimeout(unregister, 5000);\n      else off(window, \"resize\", onResize);\n    }\n    setTimeout(unregister, 5000);\n\n    on(d.input, \"keyup\", operation(cm, function(e) {\n      if (signalDOMEvent(cm, e) || cm.options.onKeyEvent && cm.options.onKeyEvent(cm, addStop(e))) return;\n      if (e.keyCode == 16) cm.doc.sel.shift = false;\n    }));\n    on(d.input, \"input\", bind(fastPoll, cm));\n    on(d.input, \"keydown\", operation(cm, onKeyDown));\n    on(d.input, \"keypress\", operation(cm, onKeyPress));\n    on(d.input, \"focus\", bind(onFocus, cm));\n    on(d.input, \"blur\", bind(onBlur, cm));\n\n    function drag_(e) {\n      if (signalDOMEvent(cm, e) || cm.options.onDragEvent && cm.options.onDragEvent(cm, addStop(e))) return;\n      e_stop(e);\n    }\n    if (cm.options.dragDrop) {\n      on(d.scroller, \"dragstart\", function(e){onDragStart(cm, e);});\n      on(d.scroller, \"dragenter\", drag_);\n      on(d.scroller, \"dragover\", drag_);\n      on(d.scroller, \"drop\", operation(cm, onDrop));\n    }\n    on(d.scroller, \"paste\", function(e) {\n      if (eventInWidget(d, e)) return;\n      focusInput(cm);\n      fastPoll(cm);\n    });\n    on(d.input, \"paste\", function() {\n      // Workaround for webkit bug https://bugs.webkit.org/show_bug.cgi?id=90206\n      // Add a char to the end of textarea before paste occur so that\n      // selection doesn't span to the end of textarea.\n      if (webkit && !cm.state.fakedLastChar && !(new Date - cm.state.lastMiddleDown < 200)) {\n        var start = d.input.selectionStart, end = d.input.selectionEnd;\n        d.input.value += \"$\";\n        d.input.selectionStart = start;\n        d.input.selectionEnd = end;\n        cm.state.fakedLastChar = true;\n      }\n      cm.state.pasteIncoming = true;\n      fastPoll(cm);\n    });\n\n    function prepareCopy() {\n      if (d.inaccurateSelection) {\n        d.prevInput = \"\";\n        d.inaccurateSelection = false;\n        d.input.value = cm.getSelection();\n        selectInput(d.input);\n      }\n    }\n    on(d.input, \"cut\", prepareCopy);\n    on(d.input, \"copy\", prepareCopy);\n\n    // Needed to handle Tab key in KHTML\n    if (khtml) on(d.sizer, \"mouseup\", function() {\n        if (document.activeElement == d.input) d.input.blur();\n        focusInput(cm);\n    });\n  }\n\n  function eventInWidget(display, e) {\n    for (var n = e_target(e); n != display.wrapper; n = n.parentNode) {\n      if (!n || n.ignoreEvents || n.parentNode == display.sizer && n != display.mover) return true;\n    }\n  }\n\n  function posFromMouse(cm, e, liberal) {\n    var display = cm.display;\n    if (!liberal) {\n      var target = e_target(e);\n      if (target == display.scrollbarH || target == display.scrollbarH.firstChild ||\n          target == display.scrollbarV || target == display.scrollbarV.firstChild ||\n          target == display.scrollbarFiller || target == display.gutterFiller) return null;\n    }\n    var x, y, space = getRect(display.lineSpace);\n    // Fails unpredictably on IE[67] when mouse is dragged around quickly.\n    try { x = e.clientX; y = e.clientY; } catch (e) { return null; }\n    return coordsChar(cm, x - space.left, y - space.top);\n  }\n\n  var lastClick, lastDoubleClick;\n  function onMouseDown(e) {\n    if (signalDOMEvent(this, e)) return;\n    var cm = this, display = cm.display, doc = cm.doc, sel = doc.sel;\n    sel.shift = e.shiftKey;\n\n    if (eventInWidget(display, e)) {\n      if (!webkit) {\n        display.scroller.draggable = false;\n        setTimeout(function(){display.scroller.draggable = true;}, 100);\n      }\n      return;\n    }\n    if (clickInGutter(cm, e)) return;\n    var start = posFromMouse(cm, e);\n\n    switch (e_button(e)) {\n    case 3:\n      if (captureMiddleClick) onContextMenu.call(cm, cm, e);\n      return;\n    case 2:\n      if (webkit) cm.state.lastMiddleDown = +new Date;\n      if (start) extendSelection(cm.doc, start);\n      setTimeout(bind(focusInput, cm), 20);\n      e_preventDefault(e);\n      return;\n    }\n    // For button 1, if it was clicked inside the editor\n    // (posFromMouse returning non-null), we have to adjust the\n    // selection.\n    if (!start) {if (e_target(e) == display.scroller) e_preventDefault(e); return;}\n\n    if (!cm.state.focused) onFocus(cm);\n\n    var now = +new Date, type = \"single\";\n    if (lastDoubleClick && lastDoubleClick.time > now - 400 && posEq(lastDoubleClick.pos, start)) {\n      type = \"triple\";\n      e_preventDefault(e);\n      setTimeout(bind(focusInput, cm), 20);\n      selectLine(cm, start.line);\n    } else if (lastClick && lastClick.time > now - 400 && posEq(lastClick.pos, start)) {\n      type = \"double\";\n      lastDoubleClick = {time: now, pos: start};\n      e_preventDefault(e);\n      var word = findWordAt(getLine(doc, start.line).text, start);\n      extendSelection(cm.doc, word.from, word.to);\n    } else { lastClick = {time: now, pos: start}; }\n\n    var last = start;\n    if (cm.options.dragDrop && dragAndDrop && !isReadOnly(cm) && !posEq(sel.from, sel.to) &&\n        !posLess(start, sel.from) && !posLess(sel.to, start) && type == \"single\") {\n      var dragEnd = operation(cm, function(e2) {\n        if (webkit) display.scroller.draggable = false;\n        cm.state.draggingText = false;\n        off(document, \"mouseup\", dragEnd);\n        off(display.scroller, \"drop\", dragEnd);\n        if (Math.abs(e.clientX - e2.clientX) + Math.abs(e.clientY - e2.clientY) < 10) {\n          e_preventDefault(e2);\n          extendSelection(cm.doc, start);\n          focusInput(cm);\n        }\n      });\n      // Let the drag handler handle this.\n      if (webkit) display.scroller.draggable = true;\n      cm.state.draggingText = dragEnd;\n      // IE's approach to draggable\n      if (display.scroller.dragDrop) display.scroller.dragDrop();\n      on(document, \"mouseup\", dragEnd);\n      on(display.scroller, \"drop\", dragEnd);\n      return;\n    }\n    e_preventDefault(e);\n    if (type == \"single\") extendSelection(cm.doc, clipPos(doc, start));\n\n    var startstart = sel.from, startend = sel.to, lastPos = start;\n\n    function doSelect(cur) {\n      if (posEq(lastPos, cur)) return;\n      lastPos = cur;\n\n      if (type == \"single\") {\n        extendSelection(cm.doc, clipPos(doc, start), cur);\n        return;\n      }\n\n      startstart = clipPos(doc, startstart);\n      startend = clipPos(doc, startend);\n      if (type == \"double\") {\n        var word = findWordAt(getLine(doc, cur.line).text, cur);\n        if (posLess(cur, startstart)) extendSelection(cm.doc, word.from, startend);\n        else extendSelection(cm.doc, startstart, word.to);\n      } else if (type == \"triple\") {\n        if (posLess(cur, startstart)) extendSelection(cm.doc, startend, clipPos(doc, Pos(cur.line, 0)));\n        else extendSelection(cm.doc, startstart, clipPos(doc, Pos(cur.line + 1, 0)));\n      }\n    }\n\n    var editorSize = getRect(display.wrapper);\n    // Used to ensure timeout re-tries don't fire when another extend\n    // happened in the meantime (clearTimeout isn't reliable -- at\n    // least on Chrome, the timeouts still happen even when cleared,\n    // if the clear happens after their scheduled firing time).\n    var counter = 0;\n\n    function extend(e) {\n      var curCount = ++counter;\n      var cur = posFromMouse(cm, e, true);\n      if (!cur) return;\n      if (!posEq(cur, last)) {\n        if (!cm.state.focused) onFocus(cm);\n        last = cur;\n        doSelect(cur);\n        var visible = visibleLines(display, doc);\n        if (cur.line >= visible.to || cur.line < visible.from)\n          setTimeout(operation(cm, function(){if (counter == curCount) extend(e);}), 150);\n      } else {\n        var outside = e.clientY < editorSize.top ? -20 : e.clientY > editorSize.bottom ? 20 : 0;\n        if (outside) setTimeout(operation(cm, function() {\n          if (counter != curCount) return;\n          display.scroller.scrollTop += outside;\n          extend(e);\n        }), 50);\n      }\n    }\n\n    function done(e) {\n      counter = Infinity;\n      e_preventDefault(e);\n      focusInput(cm);\n      off(document, \"mousemove\", move);\n      off(document, \"mouseup\", up);\n    }\n\n    var move = operation(cm, function(e) {\n      if (!ie && !e_button(e)) done(e);\n      else extend(e);\n    });\n    var up = operation(cm, done);\n    on(document, \"mousemove\", move);\n    on(document, \"mouseup\", up);\n  }\n\n  function clickInGutter(cm, e) {\n    var display = cm.display;\n    try { var mX = e.clientX, mY = e.clientY; }\n    catch(e) { return false; }\n\n    if (mX >= Math.floor(getRect(display.gutters).right)) return false;\n    e_preventDefault(e);\n    if (!hasHandler(cm, \"gutterClick\")) return true;\n\n    var lineBox = getRect(display.lineDiv);\n    if (mY > lineBox.bottom) return true;\n    mY -= lineBox.top - display.viewOffset;\n\n    for (var i = 0; i < cm.options.gutters.length; ++i) {\n      var g = display.gutters.childNodes[i];\n      if (g && getRect(g).right >= mX) {\n        var line = lineAtHeight(cm.doc, mY);\n        var gutter = cm.options.gutters[i];\n        signalLater(cm, \"gutterClick\", cm, line, gutter, e);\n        break;\n      }\n    }\n    return true;\n  }\n\n  // Kludge to work around strange IE behavior where it'll sometimes\n  // re-fire a series of drag-related events right after the drop (#1551)\n  var lastDrop = 0;\n\n  function onDrop(e) {\n    var cm = this;\n    if (signalDOMEvent(cm, e) || eventInWidget(cm.display, e) || (cm.options.onDragEvent && cm.options.onDragEvent(cm, addStop(e))))\n      return;\n    e_preventDefault(e);\n    if (ie) lastDrop = +new Date;\n    var pos = posFromMouse(cm, e, true), files = e.dataTransfer.files;\n    if (!pos || isReadOnly(cm)) return;\n    if (files && files.length && window.FileReader && window.File) {\n      var n = files.length, text = Array(n), read = 0;\n      var loadFile = function(file, i) {\n        var reader = new FileReader;\n        reader.onload = function() {\n          text[i] = reader.result;\n          if (++read == n) {\n            pos = clipPos(cm.doc, pos);\n            makeChange(cm.doc, {from: pos, to: pos, text: splitLines(text.join(\"\\n\")), origin: \"paste\"}, \"around\");\n          }\n        };\n        reader.readAsText(file);\n      };\n      for (var i = 0; i < n; ++i) loadFile(files[i], i);\n    } else {\n      // Don't do a replace if the drop happened inside of the selected text.\n      if (cm.state.draggingText && !(posLess(pos, cm.doc.sel.from) || posLess(cm.doc.sel.to, pos))) {\n        cm.state.draggingText(e);\n        // Ensure the editor is re-focused\n        setTimeout(bind(focusInput, cm), 20);\n        return;\n      }\n      try {\n        var text = e.dataTransfer.getData(\"Text\");\n        if (text) {\n          var curFrom = cm.doc.sel.from, curTo = cm.doc.sel.to;\n          setSelection(cm.doc, pos, pos);\n          if (cm.state.draggingText) replaceRange(cm.doc, \"\", curFrom, curTo, \"paste\");\n          cm.replaceSelection(text, null, \"paste\");\n          focusInput(cm);\n          onFocus(cm);\n        }\n      }\n      catch(e){}\n    }\n  }\n\n  function onDragStart(cm, e) {\n    if (ie && (!cm.state.draggingText || +new Date - lastDrop < 100)) { e_stop(e); return; }\n    if (signalDOMEvent(cm, e) || eventInWidget(cm.display, e)) return;\n\n    var txt = cm.getSelection();\n    e.dataTransfer.setData(\"Text\", txt);\n\n    // Use dummy image instead of default browsers image.\n    // Recent Safari (~6.0.2) have a tendency to segfault when this happens, so we don't do it there.\n    if (e.dataTransfer.setDragImage && !safari) {\n      var img = elt(\"img\", null, null, \"position: fixed; left: 0; top: 0;\");\n      if (opera) {\n        img.width = img.height = 1;\n        cm.display.wrapper.appendChild(img);\n        // Force a relayout, or Opera won't use our image for some obscure reason\n        img._top = img.offsetTop;\n      }\n      e.dataTransfer.setDragImage(img, 0, 0);\n      if (opera) img.parentNode.removeChild(img);\n    }\n  }\n\n  function setScrollTop(cm, val) {\n    if (Math.abs(cm.doc.scrollTop - val) < 2) return;\n    cm.doc.scrollTop = val;\n    if (!gecko) updateDisplay(cm, [], val);\n    if (cm.display.scroller.scrollTop != val) cm.display.scroller.scrollTop = val;\n    if (cm.display.scrollbarV.scrollTop != val) cm.display.scrollbarV.scrollTop = val;\n    if (gecko) updateDisplay(cm, []);\n    startWorker(cm, 100);\n  }\n  function setScrollLeft(cm, val, isScroller) {\n    if (isScroller ? val == cm.doc.scrollLeft : Math.abs(cm.doc.scrollLeft - val) < 2) return;\n    val = Math.min(val, cm.display.scroller.scrollWidth - cm.display.scroller.clientWidth);\n    cm.doc.scrollLeft = val;\n    alignHorizontally(cm);\n    if (cm.display.scroller.scrollLeft != val) cm.display.scroller.scrollLeft = val;\n    if (cm.display.scrollbarH.scrollLeft != val) cm.display.scrollbarH.scrollLeft = val;\n  }\n\n  // Since the delta values reported on mouse wheel events are\n  // unstandardized between browsers and even browser versions, and\n  // generally horribly unpredictable, this code starts by measuring\n  // the scroll effect that the first few mouse wheel events have,\n  // and, from that, detects the way it can convert deltas to pixel\n  // offsets afterwards.\n  //\n  // The reason we want to know the amount a wheel event will scroll\n  // is that it gives us a chance to update the display before the\n  // actual scrolling happens, reducing flickering.\n\n  var wheelSamples = 0, wheelPixelsPerUnit = null;\n  // Fill in a browser-detected starting value on browsers where we\n  // know one. These don't have to be accurate -- the result of them\n  // being wrong would just be a slight flicker on the first wheel\n  // scroll (if it is large enough).\n  if (ie) wheelPixelsPerUnit = -.53;\n  else if (gecko) wheelPixelsPerUnit = 15;\n  else if (chrome) wheelPixelsPerUnit = -.7;\n  else if (safari) wheelPixelsPerUnit = -1/3;\n\n  function onScrollWheel(cm, e) {\n    var dx = e.wheelDeltaX, dy = e.wheelDeltaY;\n    if (dx == null && e.detail && e.axis == e.HORIZONTAL_AXIS) dx = e.detail;\n    if (dy == null && e.detail && e.axis == e.VERTICAL_AXIS) dy = e.detail;\n    else if (dy == null) dy = e.wheelDelta;\n\n    var display = cm.display, scroll = display.scroller;\n    // Quit if there's nothing to scroll here\n    if (!(dx && scroll.scrollWidth > scroll.clientWidth ||\n          dy && scroll.scrollHeight > scroll.clientHeight)) return;\n\n    // Webkit browsers on OS X abort momentum scrolls when the target\n    // of the scroll event is removed from the scrollable element.\n    // This hack (see related code in patchDisplay) makes sure the\n    // element is kept around.\n    if (dy && mac && webkit) {\n      for (var cur = e.target; cur != scroll; cur = cur.parentNode) {\n        if (cur.lineObj) {\n          cm.display.currentWheelTarget = cur;\n          break;\n        }\n      }\n    }\n\n    // On some browsers, horizontal scrolling will cause redraws to\n    // happen before the gutter has been realigned, causing it to\n    // wriggle around in a most unseemly way. When we have an\n    // estimated pixels/delta value, we just handle horizontal\n    // scrolling entirely here. It'll be slightly off from native, but\n    // better than glitching out.\n    if (dx && !gecko && !opera && wheelPixelsPerUnit != null) {\n      if (dy)\n        setScrollTop(cm, Math.max(0, Math.min(scroll.scrollTop + dy * wheelPixelsPerUnit, scroll.scrollHeight - scroll.clientHeight)));\n      setScrollLeft(cm, Math.max(0, Math.min(scroll.scrollLeft + dx * wheelPixelsPerUnit, scroll.scrollWidth - scroll.clientWidth)));\n      e_preventDefault(e);\n      display.wheelStartX = null; // Abort measurement, if in progress\n      return;\n    }\n\n    if (dy && wheelPixelsPerUnit != null) {\n      var pixels = dy * wheelPixelsPerUnit;\n      var top = cm.doc.scrollTop, bot = top + display.wrapper.clientHeight;\n      if (pixels < 0) top = Math.max(0, top + pixels - 50);\n      else bot = Math.min(cm.doc.height, bot + pixels + 50);\n      updateDisplay(cm, [], {top: top, bottom: bot});\n    }\n\n    if (wheelSamples < 20) {\n      if (display.wheelStartX == null) {\n        display.wheelStartX = scroll.scrollLeft; display.wheelStartY = scroll.scrollTop;\n        display.wheelDX = dx; display.wheelDY = dy;\n        setTimeout(function() {\n          if (display.wheelStartX == null) return;\n          var movedX = scroll.scrollLeft - display.wheelStartX;\n          var movedY = scroll.scrollTop - display.wheelStartY;\n          var sample = (movedY && display.wheelDY && movedY / display.wheelDY) ||\n            (movedX && display.wheelDX && movedX / display.wheelDX);\n          display.wheelStartX = display.wheelStartY = null;\n          if (!sample) return;\n          wheelPixelsPerUnit = (wheelPixelsPerUnit * wheelSamples + sample) / (wheelSamples + 1);\n          ++wheelSamples;\n        }, 200);\n      } else {\n        display.wheelDX += dx; display.wheelDY += dy;\n      }\n    }\n  }\n\n  function doHandleBinding(cm, bound, dropShift) {\n    if (typeof bound == \"string\") {\n      bound = commands[bound];\n      if (!bound) return false;\n    }\n    // Ensure previous input has been read, so that the handler sees a\n    // consistent view of the document\n    if (cm.display.pollingFast && readInput(cm)) cm.display.pollingFast = false;\n    var doc = cm.doc, prevShift = doc.sel.shift, done = false;\n    try {\n      if (isReadOnly(cm)) cm.state.suppressEdits = true;\n      if (dropShift) doc.sel.shift = false;\n      done = bound(cm) != Pass;\n    } finally {\n      doc.sel.shift = prevShift;\n      cm.state.suppressEdits = false;\n    }\n    return done;\n  }\n\n  function allKeyMaps(cm) {\n    var maps = cm.state.keyMaps.slice(0);\n    if (cm.options.extraKeys) maps.push(cm.options.extraKeys);\n    maps.push(cm.options.keyMap);\n    return maps;\n  }\n\n  var maybeTransition;\n  function handleKeyBinding(cm, e) {\n    // Handle auto keymap transitions\n    var startMap = getKeyMap(cm.options.keyMap), next = startMap.auto;\n    clearTimeout(maybeTransition);\n    if (next && !isModifierKey(e)) maybeTransition = setTimeout(function() {\n      if (getKeyMap(cm.options.keyMap) == startMap) {\n        cm.options.keyMap = (next.call ? next.call(null, cm) : next);\n        keyMapChanged(cm);\n      }\n    }, 50);\n\n    var name = keyName(e, true), handled = false;\n    if (!name) return false;\n    var keymaps = allKeyMaps(cm);\n\n    if (e.shiftKey) {\n      // First try to resolve full name (including 'Shift-'). Failing\n      // that, see if there is a cursor-motion command (starting with\n      // 'go') bound to the keyname without 'Shift-'.\n      handled = lookupKey(\"Shift-\" + name, keymaps, function(b) {return doHandleBinding(cm, b, true);})\n             || lookupKey(name, keymaps, function(b) {\n                  if (typeof b == \"string\" ? /^go[A-Z]/.test(b) : b.motion)\n                    return doHandleBinding(cm, b);\n                });\n    } else {\n      handled = lookupKey(name, keymaps, function(b) { return doHandleBinding(cm, b); });\n    }\n\n    if (handled) {\n      e_preventDefault(e);\n      restartBlink(cm);\n      if (ie_lt9) { e.oldKeyCode = e.keyCode; e.keyCode = 0; }\n      signalLater(cm, \"keyHandled\", cm, name, e);\n    }\n    return handled;\n  }\n\n  function handleCharBinding(cm, e, ch) {\n    var handled = lookupKey(\"'\" + ch + \"'\", allKeyMaps(cm),\n                            function(b) { return doHandleBinding(cm, b, true); });\n    if (handled) {\n      e_preventDefault(e);\n      restartBlink(cm);\n      signalLater(cm, \"keyHandled\", cm, \"'\" + ch + \"'\", e);\n    }\n    return handled;\n  }\n\n  var lastStoppedKey = null;\n  function onKeyDown(e) {\n    var cm = this;\n    if (!cm.state.focused) onFocus(cm);\n    if (signalDOMEvent(cm, e) || cm.options.onKeyEvent && cm.options.onKeyEvent(cm, addStop(e))) return;\n    if (ie && e.keyCode == 27) e.returnValue = false;\n    var code = e.keyCode;\n    // IE does strange things with escape.\n    cm.doc.sel.shift = code == 16 || e.shiftKey;\n    // First give onKeyEvent option a chance to handle this.\n    var handled = handleKeyBinding(cm, e);\n    if (opera) {\n      lastStoppedKey = handled ? code : null;\n      // Opera has no cut event... we try to at least catch the key combo\n      if (!handled && code == 88 && !hasCopyEvent && (mac ? e.metaKey : e.ctrlKey))\n        cm.replaceSelection(\"\");\n    }\n  }\n\n  function onKeyPress(e) {\n    var cm = this;\n    if (signalDOMEvent(cm, e) || cm.options.onKeyEvent && cm.options.onKeyEvent(cm, addStop(e))) return;\n    var keyCode = e.keyCode, charCode = e.charCode;\n    if (opera && keyCode == lastStoppedKey) {lastStoppedKey = null; e_preventDefault(e); return;}\n    if (((opera && (!e.which || e.which < 10)) || khtml) && handleKeyBinding(cm, e)) return;\n    var ch = String.fromCharCode(charCode == null ? keyCode : charCode);\n    if (this.options.electricChars && this.doc.mode.electricChars &&\n        this.options.smartIndent && !isReadOnly(this) &&\n        this.doc.mode.electricChars.indexOf(ch) > -1)\n      setTimeout(operation(cm, function() {indentLine(cm, cm.doc.sel.to.line, \"smart\");}), 75);\n    if (handleCharBinding(cm, e, ch)) return;\n    if (ie && !ie_lt9) cm.display.inputHasSelection = null;\n    fastPoll(cm);\n  }\n\n  function onFocus(cm) {\n    if (cm.options.readOnly == \"nocursor\") return;\n    if (!cm.state.focused) {\n      signal(cm, \"focus\", cm);\n      cm.state.focused = true;\n      if (cm.display.wrapper.className.search(/\\bCodeMirror-focused\\b/) == -1)\n        cm.display.wrapper.className += \" CodeMirror-focused\";\n      if (!cm.curOp) {\n        resetInput(cm, true);\n        if (webkit) setTimeout(bind(resetInput, cm, true), 0); // Issue #1730\n      }\n    }\n    slowPoll(cm);\n    restartBlink(cm);\n  }\n  function onBlur(cm) {\n    if (cm.state.focused) {\n      signal(cm, \"blur\", cm);\n      cm.state.focused = false;\n      cm.display.wrapper.className = cm.display.wrapper.className.replace(\" CodeMirror-focused\", \"\");\n    }\n    clearInterval(cm.display.blinker);\n    setTimeout(function() {if (!cm.state.focused) cm.doc.sel.shift = false;}, 150);\n  }\n\n  var detectingSelectAll;\n  function onContextMenu(cm, e) {\n    if (signalDOMEvent(cm, e, \"contextmenu\")) return;\n    var display = cm.display, sel = cm.doc.sel;\n    if (eventInWidget(display, e)) return;\n\n    var pos = posFromMouse(cm, e), scrollPos = display.scroller.scrollTop;\n    if (!pos || opera) return; // Opera is difficult.\n    if (posEq(sel.from, sel.to) || posLess(pos, sel.from) || !posLess(pos, sel.to))\n      operation(cm, setSelection)(cm.doc, pos, pos);\n\n    var oldCSS = display.input.style.cssText;\n    display.inputDiv.style.position = \"absolute\";\n    display.input.style.cssText = \"position: fixed; width: 30px; height: 30px; top: \" + (e.clientY - 5) +\n      \"px; left: \" + (e.clientX - 5) + \"px; z-index: 1000; background: white; outline: none;\" +\n      \"border-width: 0; outline: none; overflow: hidden; opacity: .05; -ms-opacity: .05; filter: alpha(opacity=5);\";\n    focusInput(cm);\n    resetInput(cm, true);\n    // Adds \"Select all\" to context menu in FF\n    if (posEq(sel.from, sel.to)) display.input.value = display.prevInput = \" \";\n\n    function prepareSelectAllHack() {\n      if (display.input.selectionStart != null) {\n        var extval = display.input.value = \" \" + (posEq(sel.from, sel.to) ? \"\" : display.input.value);\n        display.prevInput = \" \";\n        display.input.selectionStart = 1; display.input.selectionEnd = extval.length;\n      }\n    }\n    function rehide() {\n      display.inputDiv.style.position = \"relative\";\n      display.input.style.cssText = oldCSS;\n      if (ie_lt9) display.scrollbarV.scrollTop = display.scroller.scrollTop = scrollPos;\n      slowPoll(cm);\n\n      // Try to detect the user choosing select-all\n      if (display.input.selectionStart != null) {\n        if (!ie || ie_lt9) prepareSelectAllHack();\n        clearTimeout(detectingSelectAll);\n        var i = 0, poll = function(){\n          if (display.prevInput == \" \" && display.input.selectionStart == 0)\n            operation(cm, commands.selectAll)(cm);\n          else if (i++ < 10) detectingSelectAll = setTimeout(poll, 500);\n          else resetInput(cm);\n        };\n        detectingSelectAll = setTimeout(poll, 200);\n      }\n    }\n\n    if (ie && !ie_lt9) prepareSelectAllHack();\n    if (captureMiddleClick) {\n      e_stop(e);\n      var mouseup = function() {\n        off(window, \"mouseup\", mouseup);\n        setTimeout(rehide, 20);\n      };\n      on(window, \"mouseup\", mouseup);\n    } else {\n      setTimeout(rehide, 50);\n    }\n  }\n\n  // UPDATING\n\n  var changeEnd = CodeMirror.changeEnd = function(change) {\n    if (!change.text) return change.to;\n    return Pos(change.from.line + change.text.length - 1,\n               lst(change.text).length + (change.text.length == 1 ? change.from.ch : 0));\n  };\n\n  // Make sure a position will be valid after the given change.\n  function clipPostChange(doc, change, pos) {\n    if (!posLess(change.from, pos)) return clipPos(doc, pos);\n    var diff = (change.text.length - 1) - (change.to.line - change.from.line);\n    if (pos.line > change.to.line + diff) {\n      var preLine = pos.line - diff, lastLine = doc.first + doc.size - 1;\n      if (preLine > lastLine) return Pos(lastLine, getLine(doc, lastLine).text.length);\n      return clipToLen(pos, getLine(doc, preLine).text.length);\n    }\n    if (pos.line == change.to.line + diff)\n      return clipToLen(pos, lst(change.text).length + (change.text.length == 1 ? change.from.ch : 0) +\n                       getLine(doc, change.to.line).text.length - change.to.ch);\n    var inside = pos.line - change.from.line;\n    return clipToLen(pos, change.text[inside].length + (inside ? 0 : change.from.ch));\n  }\n\n  // Hint can be null|\"end\"|\"start\"|\"around\"|{anchor,head}\n  function computeSelAfterChange(doc, change, hint) {\n    if (hint && typeof hint == \"object\") // Assumed to be {anchor, head} object\n      return {anchor: clipPostChange(doc, change, hint.anchor),\n              head: clipPostChange(doc, change, hint.head)};\n\n    if (hint == \"start\") return {anchor: change.from, head: change.from};\n\n    var end = changeEnd(change);\n    if (hint == \"around\") return {anchor: change.from, head: end};\n    if (hint == \"end\") return {anchor: end, head: end};\n\n    // hint is null, leave the selection alone as much as possible\n    var adjustPos = function(pos) {\n      if (posLess(pos, change.from)) return pos;\n      if (!posLess(change.to, pos)) return end;\n\n      var line = pos.line + change.text.length - (change.to.line - change.from.line) - 1, ch = pos.ch;\n      if (pos.line == change.to.line) ch += end.ch - change.to.ch;\n      return Pos(line, ch);\n    };\n    return {anchor: adjustPos(doc.sel.anchor), head: adjustPos(doc.sel.head)};\n  }\n\n  function filterChange(doc, change, update) {\n    var obj = {\n      canceled: false,\n      from: change.from,\n      to: change.to,\n      text: change.text,\n      origin: change.origin,\n      cancel: function() { this.canceled = true; }\n    };\n    if (update) obj.update = function(from, to, text, origin) {\n      if (from) this.from = clipPos(doc, from);\n      if (to) this.to = clipPos(doc, to);\n      if (text) this.text = text;\n      if (origin !== undefined) this.origin = origin;\n    };\n    signal(doc, \"beforeChange\", doc, obj);\n    if (doc.cm) signal(doc.cm, \"beforeChange\", doc.cm, obj);\n\n    if (obj.canceled) return null;\n    return {from: obj.from, to: obj.to, text: obj.text, origin: obj.origin};\n  }\n\n  // Replace the range from from to to by the strings in replacement.\n  // change is a {from, to, text [, origin]} object\n  function makeChange(doc, change, selUpdate, ignoreReadOnly) {\n    if (doc.cm) {\n      if (!doc.cm.curOp) return operation(doc.cm, makeChange)(doc, change, selUpdate, ignoreReadOnly);\n      if (doc.cm.state.suppressEdits) return;\n    }\n\n    if (hasHandler(doc, \"beforeChange\") || doc.cm && hasHandler(doc.cm, \"beforeChange\")) {\n      change = filterChange(doc, change, true);\n      if (!change) return;\n    }\n\n    // Possibly split or suppress the update based on the presence\n    // of read-only spans in its range.\n    var split = sawReadOnlySpans && !ignoreReadOnly && removeReadOnlyRanges(doc, change.from, change.to);\n    if (split) {\n      for (var i = split.length - 1; i >= 1; --i)\n        makeChangeNoReadonly(doc, {from: split[i].from, to: split[i].to, text: [\"\"]});\n      if (split.length)\n        makeChangeNoReadonly(doc, {from: split[0].from, to: split[0].to, text: change.text}, selUpdate);\n    } else {\n      makeChangeNoReadonly(doc, change, selUpdate);\n    }\n  }\n\n  function makeChangeNoReadonly(doc, change, selUpdate) {\n    var selAfter = computeSelAfterChange(doc, change, selUpdate);\n    addToHistory(doc, change, selAfter, doc.cm ? doc.cm.curOp.id : NaN);\n\n    makeChangeSingleDoc(doc, change, selAfter, stretchSpansOverChange(doc, change));\n    var rebased = [];\n\n    linkedDocs(doc, function(doc, sharedHist) {\n      if (!sharedHist && indexOf(rebased, doc.history) == -1) {\n        rebaseHist(doc.history, change);\n        rebased.push(doc.history);\n      }\n      makeChangeSingleDoc(doc, change, null, stretchSpansOverChange(doc, change));\n    });\n  }\n\n  function makeChangeFromHistory(doc, type) {\n    if (doc.cm && doc.cm.state.suppressEdits) return;\n\n    var hist = doc.history;\n    var event = (type == \"undo\" ? hist.done : hist.undone).pop();\n    if (!event) return;\n\n    var anti = {changes: [], anchorBefore: event.anchorAfter, headBefore: event.headAfter,\n                anchorAfter: event.anchorBefore, headAfter: event.headBefore,\n                generation: hist.generation};\n    (type == \"undo\" ? hist.undone : hist.done).push(anti);\n    hist.generation = event.generation || ++hist.maxGeneration;\n\n    var filter = hasHandler(doc, \"beforeChange\") || doc.cm && hasHandler(doc.cm, \"beforeChange\");\n\n    for (var i = event.changes.length - 1; i >= 0; --i) {\n      var change = event.changes[i];\n      change.origin = type;\n      if (filter && !filterChange(doc, change, false)) {\n        (type == \"undo\" ? hist.done : hist.undone).length = 0;\n        return;\n      }\n\n      anti.changes.push(historyChangeFromChange(doc, change));\n\n      var after = i ? computeSelAfterChange(doc, change, null)\n                    : {anchor: event.anchorBefore, head: event.headBefore};\n      makeChangeSingleDoc(doc, change, after, mergeOldSpans(doc, change));\n      var rebased = [];\n\n      linkedDocs(doc, function(doc, sharedHist) {\n        if (!sharedHist && indexOf(rebased, doc.history) == -1) {\n          rebaseHist(doc.history, change);\n          rebased.push(doc.history);\n        }\n        makeChangeSingleDoc(doc, change, null, mergeOldSpans(doc, change));\n      });\n    }\n  }\n\n  function shiftDoc(doc, distance) {\n    function shiftPos(pos) {return Pos(pos.line + distance, pos.ch);}\n    doc.first += distance;\n    if (doc.cm) regChange(doc.cm, doc.first, doc.first, distance);\n    doc.sel.head = shiftPos(doc.sel.head); doc.sel.anchor = shiftPos(doc.sel.anchor);\n    doc.sel.from = shiftPos(doc.sel.from); doc.sel.to = shiftPos(doc.sel.to);\n  }\n\n  function makeChangeSingleDoc(doc, change, selAfter, spans) {\n    if (doc.cm && !doc.cm.curOp)\n      return operation(doc.cm, makeChangeSingleDoc)(doc, change, selAfter, spans);\n\n    if (change.to.line < doc.first) {\n      shiftDoc(doc, change.text.length - 1 - (change.to.line - change.from.line));\n      return;\n    }\n    if (change.from.line > doc.lastLine()) return;\n\n    // Clip the change to the size of this doc\n    if (change.from.line < doc.first) {\n      var shift = change.text.length - 1 - (doc.first - change.from.line);\n      shiftDoc(doc, shift);\n      change = {from: Pos(doc.first, 0), to: Pos(change.to.line + shift, change.to.ch),\n                text: [lst(change.text)], origin: change.origin};\n    }\n    var last = doc.lastLine();\n    if (change.to.line > last) {\n      change = {from: change.from, to: Pos(last, getLine(doc, last).text.length),\n                text: [change.text[0]], origin: change.origin};\n    }\n\n    change.removed = getBetween(doc, change.from, change.to);\n\n    if (!selAfter) selAfter = computeSelAfterChange(doc, change, null);\n    if (doc.cm) makeChangeSingleDocInEditor(doc.cm, change, spans, selAfter);\n    else updateDoc(doc, change, spans, selAfter);\n  }\n\n  function makeChangeSingleDocInEditor(cm, change, spans, selAfter) {\n    var doc = cm.doc, display = cm.display, from = change.from, to = change.to;\n\n    var recomputeMaxLength = false, checkWidthStart = from.line;\n    if (!cm.options.lineWrapping) {\n      checkWidthStart = lineNo(visualLine(doc, getLine(doc, from.line)));\n      doc.iter(checkWidthStart, to.line + 1, function(line) {\n        if (line == display.maxLine) {\n          recomputeMaxLength = true;\n          return true;\n        }\n      });\n    }\n\n    if (!posLess(doc.sel.head, change.from) && !posLess(change.to, doc.sel.head))\n      cm.curOp.cursorActivity = true;\n\n    updateDoc(doc, change, spans, selAfter, estimateHeight(cm));\n\n    if (!cm.options.lineWrapping) {\n      doc.iter(checkWidthStart, from.line + change.text.length, function(line) {\n        var len = lineLength(doc, line);\n        if (len > display.maxLineLength) {\n          display.maxLine = line;\n          display.maxLineLength = len;\n          display.maxLineChanged = true;\n          recomputeMaxLength = false;\n        }\n      });\n      if (recomputeMaxLength) cm.curOp.updateMaxLine = true;\n    }\n\n    // Adjust frontier, schedule worker\n    doc.frontier = Math.min(doc.frontier, from.line);\n    startWorker(cm, 400);\n\n    var lendiff = change.text.length - (to.line - from.line) - 1;\n    // Remember that these lines changed, for updating the display\n    regChange(cm, from.line, to.line + 1, lendiff);\n\n    if (hasHandler(cm, \"change\")) {\n      var changeObj = {from: from, to: to,\n                       text: change.text,\n                       removed: change.removed,\n                       origin: change.origin};\n      if (cm.curOp.textChanged) {\n        for (var cur = cm.curOp.textChanged; cur.next; cur = cur.next) {}\n        cur.next = changeObj;\n      } else cm.curOp.textChanged = changeObj;\n    }\n  }\n\n  function replaceRange(doc, code, from, to, origin) {\n    if (!to) to = from;\n    if (posLess(to, from)) { var tmp = to; to = from; from = tmp; }\n    if (typeof code == \"string\") code = splitLines(code);\n    makeChange(doc, {from: from, to: to, text: code, origin: origin}, null);\n  }\n\n  // POSITION OBJECT\n\n  function Pos(line, ch) {\n    if (!(this instanceof Pos)) return new Pos(line, ch);\n    this.line = line; this.ch = ch;\n  }\n  CodeMirror.Pos = Pos;\n\n  function posEq(a, b) {return a.line == b.line && a.ch == b.ch;}\n  function posLess(a, b) {return a.line < b.line || (a.line == b.line && a.ch < b.ch);}\n  function copyPos(x) {return Pos(x.line, x.ch);}\n\n  // SELECTION\n\n  function clipLine(doc, n) {return Math.max(doc.first, Math.min(n, doc.first + doc.size - 1));}\n  function clipPos(doc, pos) {\n    if (pos.line < doc.first) return Pos(doc.first, 0);\n    var last = doc.first + doc.size - 1;\n    if (pos.line > last) return Pos(last, getLine(doc, last).text.length);\n    return clipToLen(pos, getLine(doc, pos.line).text.length);\n  }\n  function clipToLen(pos, linelen) {\n    var ch = pos.ch;\n    if (ch == null || ch > linelen) return Pos(pos.line, linelen);\n    else if (ch < 0) return Pos(pos.line, 0);\n    else return pos;\n  }\n  function isLine(doc, l) {return l >= doc.first && l < doc.first + doc.size;}\n\n  // If shift is held, this will move the selection anchor. Otherwise,\n  // it'll set the whole selection.\n  function extendSelection(doc, pos, other, bias) {\n    if (doc.sel.shift || doc.sel.extend) {\n      var anchor = doc.sel.anchor;\n      if (other) {\n        var posBefore = posLess(pos, anchor);\n        if (posBefore != posLess(other, anchor)) {\n          anchor = pos;\n          pos = other;\n        } else if (posBefore != posLess(pos, other)) {\n          pos = other;\n        }\n      }\n      setSelection(doc, anchor, pos, bias);\n    } else {\n      setSelection(doc, pos, other || pos, bias);\n    }\n    if (doc.cm) doc.cm.curOp.userSelChange = true;\n  }\n\n  function filterSelectionChange(doc, anchor, head) {\n    var obj = {anchor: anchor, head: head};\n    signal(doc, \"beforeSelectionChange\", doc, obj);\n    if (doc.cm) signal(doc.cm, \"beforeSelectionChange\", doc.cm, obj);\n    obj.anchor = clipPos(doc, obj.anchor); obj.head = clipPos(doc, obj.head);\n    return obj;\n  }\n\n  // Update the selection. Last two args are only used by\n  // updateDoc, since they have to be expressed in the line\n  // numbers before the update.\n  function setSelection(doc, anchor, head, bias, checkAtomic) {\n    if (!checkAtomic && hasHandler(doc, \"beforeSelectionChange\") || doc.cm && hasHandler(doc.cm, \"beforeSelectionChange\")) {\n      var filtered = filterSelectionChange(doc, anchor, head);\n      head = filtered.head;\n      anchor = filtered.anchor;\n    }\n\n    var sel = doc.sel;\n    sel.goalColumn = null;\n    // Skip over atomic spans.\n    if (checkAtomic || !posEq(anchor, sel.anchor))\n      anchor = skipAtomic(doc, anchor, bias, checkAtomic != \"push\");\n    if (checkAtomic || !posEq(head, sel.head))\n      head = skipAtomic(doc, head, bias, checkAtomic != \"push\");\n\n    if (posEq(sel.anchor, anchor) && posEq(sel.head, head)) return;\n\n    sel.anchor = anchor; sel.head = head;\n    var inv = posLess(head, anchor);\n    sel.from = inv ? head : anchor;\n    sel.to = inv ? anchor : head;\n\n    if (doc.cm)\n      doc.cm.curOp.updateInput = doc.cm.curOp.selectionChanged =\n        doc.cm.curOp.cursorActivity = true;\n\n    signalLater(doc, \"cursorActivity\", doc);\n  }\n\n  function reCheckSelection(cm) {\n    setSelection(cm.doc, cm.doc.sel.from, cm.doc.sel.to, null, \"push\");\n  }\n\n  function skipAtomic(doc, pos, bias, mayClear) {\n    var flipped = false, curPos = pos;\n    var dir = bias || 1;\n    doc.cantEdit = false;\n    search: for (;;) {\n      var line = getLine(doc, curPos.line);\n      if (line.markedSpans) {\n        for (var i = 0; i < line.markedSpans.length; ++i) {\n          var sp = line.markedSpans[i], m = sp.marker;\n          if ((sp.from == null || (m.inclusiveLeft ? sp.from <= curPos.ch : sp.from < curPos.ch)) &&\n              (sp.to == null || (m.inclusiveRight ? sp.to >= curPos.ch : sp.to > curPos.ch))) {\n            if (mayClear) {\n              signal(m, \"beforeCursorEnter\");\n              if (m.explicitlyCleared) {\n                if (!line.markedSpans) break;\n                else {--i; continue;}\n              }\n            }\n            if (!m.atomic) continue;\n            var newPos = m.find()[dir < 0 ? \"from\" : \"to\"];\n            if (posEq(newPos, curPos)) {\n              newPos.ch += dir;\n              if (newPos.ch < 0) {\n                if (newPos.line > doc.first) newPos = clipPos(doc, Pos(newPos.line - 1));\n                else newPos = null;\n              } else if (newPos.ch > line.text.length) {\n                if (newPos.line < doc.first + doc.size - 1) newPos = Pos(newPos.line + 1, 0);\n                else newPos = null;\n              }\n              if (!newPos) {\n                if (flipped) {\n                  // Driven in a corner -- no valid cursor position found at all\n                  // -- try again *with* clearing, if we didn't already\n                  if (!mayClear) return skipAtomic(doc, pos, bias, true);\n                  // Otherwise, turn off editing until further notice, and return the start of the doc\n                  doc.cantEdit = true;\n                  return Pos(doc.first, 0);\n                }\n                flipped = true; newPos = pos; dir = -dir;\n              }\n            }\n            curPos = newPos;\n            continue search;\n          }\n        }\n      }\n      return curPos;\n    }\n  }\n\n  // SCROLLING\n\n  function scrollCursorIntoView(cm) {\n    var coords = scrollPosIntoView(cm, cm.doc.sel.head, cm.options.cursorScrollMargin);\n    if (!cm.state.focused) return;\n    var display = cm.display, box = getRect(display.sizer), doScroll = null;\n    if (coords.top + box.top < 0) doScroll = true;\n    else if (coords.bottom + box.top > (window.innerHeight || document.documentElement.clientHeight)) doScroll = false;\n    if (doScroll != null && !phantom) {\n      var hidden = display.cursor.style.display == \"none\";\n      if (hidden) {\n        display.cursor.style.display = \"\";\n        display.cursor.style.left = coords.left + \"px\";\n        display.cursor.style.top = (coords.top - display.viewOffset) + \"px\";\n      }\n      display.cursor.scrollIntoView(doScroll);\n      if (hidden) display.cursor.style.display = \"none\";\n    }\n  }\n\n  function scrollPosIntoView(cm, pos, margin) {\n    if (margin == null) margin = 0;\n    for (;;) {\n      var changed = false, coords = cursorCoords(cm, pos);\n      var scrollPos = calculateScrollPos(cm, coords.left, coords.top - margin, coords.left, coords.bottom + margin);\n      var startTop = cm.doc.scrollTop, startLeft = cm.doc.scrollLeft;\n      if (scrollPos.scrollTop != null) {\n        setScrollTop(cm, scrollPos.scrollTop);\n        if (Math.abs(cm.doc.scrollTop - startTop) > 1) changed = true;\n      }\n      if (scrollPos.scrollLeft != null) {\n        setScrollLeft(cm, scrollPos.scrollLeft);\n        if (Math.abs(cm.doc.scrollLeft - startLeft) > 1) changed = true;\n      }\n      if (!changed) return coords;\n    }\n  }\n\n  function scrollIntoView(cm, x1, y1, x2, y2) {\n    var scrollPos = calculateScrollPos(cm, x1, y1, x2, y2);\n    if (scrollPos.scrollTop != null) setScrollTop(cm, scrollPos.scrollTop);\n    if (scrollPos.scrollLeft != null) setScrollLeft(cm, scrollPos.scrollLeft);\n  }\n\n  function calculateScrollPos(cm, x1, y1, x2, y2) {\n    var display = cm.display, snapMargin = textHeight(cm.display);\n    if (y1 < 0) y1 = 0;\n    var screen = display.scroller.clientHeight - scrollerCutOff, screentop = display.scroller.scrollTop, result = {};\n    var docBottom = cm.doc.height + paddingVert(display);\n    var atTop = y1 < snapMargin, atBottom = y2 > docBottom - snapMargin;\n    if (y1 < screentop) {\n      result.scrollTop = atTop ? 0 : y1;\n    } else if (y2 > screentop + screen) {\n      var newTop = Math.min(y1, (atBottom ? docBottom : y2) - screen);\n      if (newTop != screentop) result.scrollTop = newTop;\n    }\n\n    var screenw = display.scroller.clientWidth - scrollerCutOff, screenleft = display.scroller.scrollLeft;\n    x1 += display.gutters.offsetWidth; x2 += display.gutters.offsetWidth;\n    var gutterw = display.gutters.offsetWidth;\n    var atLeft = x1 < gutterw + 10;\n    if (x1 < screenleft + gutterw || atLeft) {\n      if (atLeft) x1 = 0;\n      result.scrollLeft = Math.max(0, x1 - 10 - gutterw);\n    } else if (x2 > screenw + screenleft - 3) {\n      result.scrollLeft = x2 + 10 - screenw;\n    }\n    return result;\n  }\n\n  function updateScrollPos(cm, left, top) {\n    cm.curOp.updateScrollPos = {scrollLeft: left == null ? cm.doc.scrollLeft : left,\n                                scrollTop: top == null ? cm.doc.scrollTop : top};\n  }\n\n  function addToScrollPos(cm, left, top) {\n    var pos = cm.curOp.updateScrollPos || (cm.curOp.updateScrollPos = {scrollLeft: cm.doc.scrollLeft, scrollTop: cm.doc.scrollTop});\n    var scroll = cm.display.scroller;\n    pos.scrollTop = Math.max(0, Math.min(scroll.scrollHeight - scroll.clientHeight, pos.scrollTop + top));\n    pos.scrollLeft = Math.max(0, Math.min(scroll.scrollWidth - scroll.clientWidth, pos.scrollLeft + left));\n  }\n\n  // API UTILITIES\n\n  function indentLine(cm, n, how, aggressive) {\n    var doc = cm.doc;\n    if (how == null) how = \"add\";\n    if (how == \"smart\") {\n      if (!cm.doc.mode.indent) how = \"prev\";\n      else var state = getStateBefore(cm, n);\n    }\n\n    var tabSize = cm.options.tabSize;\n    var line = getLine(doc, n), curSpace = countColumn(line.text, null, tabSize);\n    var curSpaceString = line.text.match(/^\\s*/)[0], indentation;\n    if (how == \"smart\") {\n      indentation = cm.doc.mode.indent(state, line.text.slice(curSpaceString.length), line.text);\n      if (indentation == Pass) {\n        if (!aggressive) return;\n        how = \"prev\";\n      }\n    }\n    if (how == \"prev\") {\n      if (n > doc.first) indentation = countColumn(getLine(doc, n-1).text, null, tabSize);\n      else indentation = 0;\n    } else if (how == \"add\") {\n      indentation = curSpace + cm.options.indentUnit;\n    } else if (how == \"subtract\") {\n      indentation = curSpace - cm.options.indentUnit;\n    } else if (typeof how == \"number\") {\n      indentation = curSpace + how;\n    }\n    indentation = Math.max(0, indentation);\n\n    var indentString = \"\", pos = 0;\n    if (cm.options.indentWithTabs)\n      for (var i = Math.floor(indentation / tabSize); i; --i) {pos += tabSize; indentString += \"\\t\";}\n    if (pos < indentation) indentString += spaceStr(indentation - pos);\n\n    if (indentString != curSpaceString)\n      replaceRange(cm.doc, indentString, Pos(n, 0), Pos(n, curSpaceString.length), \"+input\");\n    line.stateAfter = null;\n  }\n\n  function changeLine(cm, handle, op) {\n    var no = handle, line = handle, doc = cm.doc;\n    if (typeof handle == \"number\") line = getLine(doc, clipLine(doc, handle));\n    else no = lineNo(handle);\n    if (no == null) return null;\n    if (op(line, no)) regChange(cm, no, no + 1);\n    else return null;\n    return line;\n  }\n\n  function findPosH(doc, pos, dir, unit, visually) {\n    var line = pos.line, ch = pos.ch, origDir = dir;\n    var lineObj = getLine(doc, line);\n    var possible = true;\n    function findNextLine() {\n      var l = line + dir;\n      if (l < doc.first || l >= doc.first + doc.size) return (possible = false);\n      line = l;\n      return lineObj = getLine(doc, l);\n    }\n    function moveOnce(boundToLine) {\n      var next = (visually ? moveVisually : moveLogically)(lineObj, ch, dir, true);\n      if (next == null) {\n        if (!boundToLine && findNextLine()) {\n          if (visually) ch = (dir < 0 ? lineRight : lineLeft)(lineObj);\n          else ch = dir < 0 ? lineObj.text.length : 0;\n        } else return (possible = false);\n      } else ch = next;\n      return true;\n    }\n\n    if (unit == \"char\") moveOnce();\n    else if (unit == \"column\") moveOnce(true);\n    else if (unit == \"word\" || unit == \"group\") {\n      var sawType = null, group = unit == \"group\";\n      for (var first = true;; first = false) {\n        if (dir < 0 && !moveOnce(!first)) break;\n        var cur = lineObj.text.charAt(ch) || \"\\n\";\n        var type = isWordChar(cur) ? \"w\"\n          : !group ? null\n          : /\\s/.test(cur) ? null\n          : \"p\";\n        if (sawType && sawType != type) {\n          if (dir < 0) {dir = 1; moveOnce();}\n          break;\n        }\n        if (type) sawType = type;\n        if (dir > 0 && !moveOnce(!first)) break;\n      }\n    }\n    var result = skipAtomic(doc, Pos(line, ch), origDir, true);\n    if (!possible) result.hitSide = true;\n    return result;\n  }\n\n  function findPosV(cm, pos, dir, unit) {\n    var doc = cm.doc, x = pos.left, y;\n    if (unit == \"page\") {\n      var pageSize = Math.min(cm.display.wrapper.clientHeight, window.innerHeight || document.documentElement.clientHeight);\n      y = pos.top + dir * (pageSize - (dir < 0 ? 1.5 : .5) * textHeight(cm.display));\n    } else if (unit == \"line\") {\n      y = dir > 0 ? pos.bottom + 3 : pos.top - 3;\n    }\n    for (;;) {\n      var target = coordsChar(cm, x, y);\n      if (!target.outside) break;\n      if (dir < 0 ? y <= 0 : y >= doc.height) { target.hitSide = true; break; }\n      y += dir * 5;\n    }\n    return target;\n  }\n\n  function findWordAt(line, pos) {\n    var start = pos.ch, end = pos.ch;\n    if (line) {\n      if ((pos.xRel < 0 || end == line.length) && start) --start; else ++end;\n      var startChar = line.charAt(start);\n      var check = isWordChar(startChar) ? isWordChar\n        : /\\s/.test(startChar) ? function(ch) {return /\\s/.test(ch);}\n        : function(ch) {return !/\\s/.test(ch) && !isWordChar(ch);};\n      while (start > 0 && check(line.charAt(start - 1))) --start;\n      while (end < line.length && check(line.charAt(end))) ++end;\n    }\n    return {from: Pos(pos.line, start), to: Pos(pos.line, end)};\n  }\n\n  function selectLine(cm, line) {\n    extendSelection(cm.doc, Pos(line, 0), clipPos(cm.doc, Pos(line + 1, 0)));\n  }\n\n  // PROTOTYPE\n\n  // The publicly visible API. Note that operation(null, f) means\n  // 'wrap f in an operation, performed on its `this` parameter'\n\n  CodeMirror.prototype = {\n    constructor: CodeMirror,\n    focus: function(){window.focus(); focusInput(this); onFocus(this); fastPoll(this);},\n\n    setOption: function(option, value) {\n      var options = this.options, old = options[option];\n      if (options[option] == value && option != \"mode\") return;\n      options[option] = value;\n      if (optionHandlers.hasOwnProperty(option))\n        operation(this, optionHandlers[option])(this, value, old);\n    },\n\n    getOption: function(option) {return this.options[option];},\n    getDoc: function() {return this.doc;},\n\n    addKeyMap: function(map, bottom) {\n      this.state.keyMaps[bottom ? \"push\" : \"unshift\"](map);\n    },\n    removeKeyMap: function(map) {\n      var maps = this.state.keyMaps;\n      for (var i = 0; i < maps.length; ++i)\n        if (maps[i] == map || (typeof maps[i] != \"string\" && maps[i].name == map)) {\n          maps.splice(i, 1);\n          return true;\n        }\n    },\n\n    addOverlay: operation(null, function(spec, options) {\n      var mode = spec.token ? spec : CodeMirror.getMode(this.options, spec);\n      if (mode.startState) throw new Error(\"Overlays may not be stateful.\");\n      this.state.overlays.push({mode: mode, modeSpec: spec, opaque: options && options.opaque});\n      this.state.modeGen++;\n      regChange(this);\n    }),\n    removeOverlay: operation(null, function(spec) {\n      var overlays = this.state.overlays;\n      for (var i = 0; i < overlays.length; ++i) {\n        var cur = overlays[i].modeSpec;\n        if (cur == spec || typeof spec == \"string\" && cur.name == spec) {\n          overlays.splice(i, 1);\n          this.state.modeGen++;\n          regChange(this);\n          return;\n        }\n      }\n    }),\n\n    indentLine: operation(null, function(n, dir, aggressive) {\n      if (typeof dir != \"string\" && typeof dir != \"number\") {\n        if (dir == null) dir = this.options.smartIndent ? \"smart\" : \"prev\";\n        else dir = dir ? \"add\" : \"subtract\";\n      }\n      if (isLine(this.doc, n)) indentLine(this, n, dir, aggressive);\n    }),\n    indentSelection: operation(null, function(how) {\n      var sel = this.doc.sel;\n      if (posEq(sel.from, sel.to)) return indentLine(this, sel.from.line, how);\n      var e = sel.to.line - (sel.to.ch ? 0 : 1);\n      for (var i = sel.from.line; i <= e; ++i) indentLine(this, i, how);\n    }),\n\n    // Fetch the parser token for a given character. Useful for hacks\n    // that want to inspect the mode state (say, for completion).\n    getTokenAt: function(pos, precise) {\n      var doc = this.doc;\n      pos = clipPos(doc, pos);\n      var state = getStateBefore(this, pos.line, precise), mode = this.doc.mode;\n      var line = getLine(doc, pos.line);\n      var stream = new StringStream(line.text, this.options.tabSize);\n      while (stream.pos < pos.ch && !stream.eol()) {\n        stream.start = stream.pos;\n        var style = mode.token(stream, state);\n      }\n      return {start: stream.start,\n              end: stream.pos,\n              string: stream.current(),\n              className: style || null, // Deprecated, use 'type' instead\n              type: style || null,\n              state: state};\n    },\n\n    getTokenTypeAt: function(pos) {\n      pos = clipPos(this.doc, pos);\n      var styles = getLineStyles(this, getLine(this.doc, pos.line));\n      var before = 0, after = (styles.length - 1) / 2, ch = pos.ch;\n      if (ch == 0) return styles[2];\n      for (;;) {\n        var mid = (before + after) >> 1;\n        if ((mid ? styles[mid * 2 - 1] : 0) >= ch) after = mid;\n        else if (styles[mid * 2 + 1] < ch) before = mid + 1;\n        else return styles[mid * 2 + 2];\n      }\n    },\n\n    getModeAt: function(pos) {\n      var mode = this.doc.mode;\n      if (!mode.innerMode) return mode;\n      return CodeMirror.innerMode(mode, this.getTokenAt(pos).state).mode;\n    },\n\n    getHelper: function(pos, type) {\n      if (!helpers.hasOwnProperty(type)) return;\n      var help = helpers[type], mode = this.getModeAt(pos);\n      return mode[type] && help[mode[type]] ||\n        mode.helperType && help[mode.helperType] ||\n        help[mode.name];\n    },\n\n    getStateAfter: function(line, precise) {\n      var doc = this.doc;\n      line = clipLine(doc, line == null ? doc.first + doc.size - 1: line);\n      return getStateBefore(this, line + 1, precise);\n    },\n\n    cursorCoords: function(start, mode) {\n      var pos, sel = this.doc.sel;\n      if (start == null) pos = sel.head;\n      else if (typeof start == \"object\") pos = clipPos(this.doc, start);\n      else pos = start ? sel.from : sel.to;\n      return cursorCoords(this, pos, mode || \"page\");\n    },\n\n    charCoords: function(pos, mode) {\n      return charCoords(this, clipPos(this.doc, pos), mode || \"page\");\n    },\n\n    coordsChar: function(coords, mode) {\n      coords = fromCoordSystem(this, coords, mode || \"page\");\n      return coordsChar(this, coords.left, coords.top);\n    },\n\n    lineAtHeight: function(height, mode) {\n      height = fromCoordSystem(this, {top: height, left: 0}, mode || \"page\").top;\n      return lineAtHeight(this.doc, height + this.display.viewOffset);\n    },\n    heightAtLine: function(line, mode) {\n      var end = false, last = this.doc.first + this.doc.size - 1;\n      if (line < this.doc.first) line = this.doc.first;\n      else if (line > last) { line = last; end = true; }\n      var lineObj = getLine(this.doc, line);\n      return intoCoordSystem(this, getLine(this.doc, line), {top: 0, left: 0}, mode || \"page\").top +\n        (end ? lineObj.height : 0);\n    },\n\n    defaultTextHeight: function() { return textHeight(this.display); },\n    defaultCharWidth: function() { return charWidth(this.display); },\n\n    setGutterMarker: operation(null, function(line, gutterID, value) {\n      return changeLine(this, line, function(line) {\n        var markers = line.gutterMarkers || (line.gutterMarkers = {});\n        markers[gutterID] = value;\n        if (!value && isEmpty(markers)) line.gutterMarkers = null;\n        return true;\n      });\n    }),\n\n    clearGutter: operation(null, function(gutterID) {\n      var cm = this, doc = cm.doc, i = doc.first;\n      doc.iter(function(line) {\n        if (line.gutterMarkers && line.gutterMarkers[gutterID]) {\n          line.gutterMarkers[gutterID] = null;\n          regChange(cm, i, i + 1);\n          if (isEmpty(line.gutterMarkers)) line.gutterMarkers = null;\n        }\n        ++i;\n      });\n    }),\n\n    addLineClass: operation(null, function(handle, where, cls) {\n      return changeLine(this, handle, function(line) {\n        var prop = where == \"text\" ? \"textClass\" : where == \"background\" ? \"bgClass\" : \"wrapClass\";\n        if (!line[prop]) line[prop] = cls;\n        else if (new RegExp(\"(?:^|\\\\s)\" + cls + \"(?:$|\\\\s)\").test(line[prop])) return false;\n        else line[prop] += \" \" + cls;\n        return true;\n      });\n    }),\n\n    removeLineClass: operation(null, function(handle, where, cls) {\n      return changeLine(this, handle, function(line) {\n        var prop = where == \"text\" ? \"textClass\" : where == \"background\" ? \"bgClass\" : \"wrapClass\";\n        var cur = line[prop];\n        if (!cur) return false;\n        else if (cls == null) line[prop] = null;\n        else {\n          var found = cur.match(new RegExp(\"(?:^|\\\\s+)\" + cls + \"(?:$|\\\\s+)\"));\n          if (!found) return false;\n          var end = found.index + found[0].length;\n          line[prop] = cur.slice(0, found.index) + (!found.index || end == cur.length ? \"\" : \" \") + cur.slice(end) || null;\n        }\n        return true;\n      });\n    }),\n\n    addLineWidget: operation(null, function(handle, node, options) {\n      return addLineWidget(this, handle, node, options);\n    }),\n\n    removeLineWidget: function(widget) { widget.clear(); },\n\n    lineInfo: function(line) {\n      if (typeof line == \"number\") {\n        if (!isLine(this.doc, line)) return null;\n        var n = line;\n        line = getLine(this.doc, line);\n        if (!line) return null;\n      } else {\n        var n = lineNo(line);\n        if (n == null) return null;\n      }\n      return {line: n, handle: line, text: line.text, gutterMarkers: line.gutterMarkers,\n              textClass: line.textClass, bgClass: line.bgClass, wrapClass: line.wrapClass,\n              widgets: line.widgets};\n    },\n\n    getViewport: function() { return {from: this.display.showingFrom, to: this.display.showingTo};},\n\n    addWidget: function(pos, node, scroll, vert, horiz) {\n      var display = this.display;\n      pos = cursorCoords(this, clipPos(this.doc, pos));\n      var top = pos.bottom, left = pos.left;\n      node.style.position = \"absolute\";\n      display.sizer.appendChild(node);\n      if (vert == \"over\") {\n        top = pos.top;\n      } else if (vert == \"above\" || vert == \"near\") {\n        var vspace = Math.max(display.wrapper.clientHeight, this.doc.height),\n        hspace = Math.max(display.sizer.clientWidth, display.lineSpace.clientWidth);\n        // Default to positioning above (if specified and possible); otherwise default to positioning below\n        if ((vert == 'above' || pos.bottom + node.offsetHeight > vspace) && pos.top > node.offsetHeight)\n          top = pos.top - node.offsetHeight;\n        else if (pos.bottom + node.offsetHeight <= vspace)\n          top = pos.bottom;\n        if (left + node.offsetWidth > hspace)\n          left = hspace - node.offsetWidth;\n      }\n      node.style.top = top + \"px\";\n      node.style.left = node.style.right = \"\";\n      if (horiz == \"right\") {\n        left = display.sizer.clientWidth - node.offsetWidth;\n        node.style.right = \"0px\";\n      } else {\n        if (horiz == \"left\") left = 0;\n        else if (horiz == \"middle\") left = (display.sizer.clientWidth - node.offsetWidth) / 2;\n        node.style.left = left + \"px\";\n      }\n      if (scroll)\n        scrollIntoView(this, left, top, left + node.offsetWidth, top + node.offsetHeight);\n    },\n\n    triggerOnKeyDown: operation(null, onKeyDown),\n\n    execCommand: function(cmd) {return commands[cmd](this);},\n\n    findPosH: function(from, amount, unit, visually) {\n      var dir = 1;\n      if (amount < 0) { dir = -1; amount = -amount; }\n      for (var i = 0, cur = clipPos(this.doc, from); i < amount; ++i) {\n        cur = findPosH(this.doc, cur, dir, unit, visually);\n        if (cur.hitSide) break;\n      }\n      return cur;\n    },\n\n    moveH: operation(null, function(dir, unit) {\n      var sel = this.doc.sel, pos;\n      if (sel.shift || sel.extend || posEq(sel.from, sel.to))\n        pos = findPosH(this.doc, sel.head, dir, unit, this.options.rtlMoveVisually);\n      else\n        pos = dir < 0 ? sel.from : sel.to;\n      extendSelection(this.doc, pos, pos, dir);\n    }),\n\n    deleteH: operation(null, function(dir, unit) {\n      var sel = this.doc.sel;\n      if (!posEq(sel.from, sel.to)) replaceRange(this.doc, \"\", sel.from, sel.to, \"+delete\");\n      else replaceRange(this.doc, \"\", sel.from, findPosH(this.doc, sel.head, dir, unit, false), \"+delete\");\n      this.curOp.userSelChange = true;\n    }),\n\n    findPosV: function(from, amount, unit, goalColumn) {\n      var dir = 1, x = goalColumn;\n      if (amount < 0) { dir = -1; amount = -amount; }\n      for (var i = 0, cur = clipPos(this.doc, from); i < amount; ++i) {\n        var coords = cursorCoords(this, cur, \"div\");\n        if (x == null) x = coords.left;\n        else coords.left = x;\n        cur = findPosV(this, coords, dir, unit);\n        if (cur.hitSide) break;\n      }\n      return cur;\n    },\n\n    moveV: operation(null, function(dir, unit) {\n      var sel = this.doc.sel;\n      var pos = cursorCoords(this, sel.head, \"div\");\n      if (sel.goalColumn != null) pos.left = sel.goalColumn;\n      var target = findPosV(this, pos, dir, unit);\n\n      if (unit == \"page\") addToScrollPos(this, 0, charCoords(this, target, \"div\").top - pos.top);\n      extendSelection(this.doc, target, target, dir);\n      sel.goalColumn = pos.left;\n    }),\n\n    toggleOverwrite: function(value) {\n      if (value != null && value == this.state.overwrite) return;\n      if (this.state.overwrite = !this.state.overwrite)\n        this.display.cursor.className += \" CodeMirror-overwrite\";\n      else\n        this.display.cursor.className = this.display.cursor.className.replace(\" CodeMirror-overwrite\", \"\");\n    },\n    hasFocus: function() { return this.state.focused; },\n\n    scrollTo: operation(null, function(x, y) {\n      updateScrollPos(this, x, y);\n    }),\n    getScrollInfo: function() {\n      var scroller = this.display.scroller, co = scrollerCutOff;\n      return {left: scroller.scrollLeft, top: scroller.scrollTop,\n              height: scroller.scrollHeight - co, width: scroller.scrollWidth - co,\n              clientHeight: scroller.clientHeight - co, clientWidth: scroller.clientWidth - co};\n    },\n\n    scrollIntoView: operation(null, function(pos, margin) {\n      if (typeof pos == \"number\") pos = Pos(pos, 0);\n      if (!margin) margin = 0;\n      var coords = pos;\n\n      if (!pos || pos.line != null) {\n        this.curOp.scrollToPos = pos ? clipPos(this.doc, pos) : this.doc.sel.head;\n        this.curOp.scrollToPosMargin = margin;\n        coords = cursorCoords(this, this.curOp.scrollToPos);\n      }\n      var sPos = calculateScrollPos(this, coords.left, coords.top - margin, coords.right, coords.bottom + margin);\n      updateScrollPos(this, sPos.scrollLeft, sPos.scrollTop);\n    }),\n\n    setSize: operation(null, function(width, height) {\n      function interpret(val) {\n        return typeof val == \"number\" || /^\\d+$/.test(String(val)) ? val + \"px\" : val;\n      }\n      if (width != null) this.display.wrapper.style.width = interpret(width);\n      if (height != null) this.display.wrapper.style.height = interpret(height);\n      if (this.options.lineWrapping)\n        this.display.measureLineCache.length = this.display.measureLineCachePos = 0;\n      this.curOp.forceUpdate = true;\n    }),\n\n    operation: function(f){return runInOp(this, f);},\n\n    refresh: operation(null, function() {\n      clearCaches(this);\n      updateScrollPos(this, this.doc.scrollLeft, this.doc.scrollTop);\n      regChange(this);\n    }),\n\n    swapDoc: operation(null, function(doc) {\n      var old = this.doc;\n      old.cm = null;\n      attachDoc(this, doc);\n      clearCaches(this);\n      resetInput(this, true);\n      updateScrollPos(this, doc.scrollLeft, doc.scrollTop);\n      return old;\n    }),\n\n    getInputField: function(){return this.display.input;},\n    getWrapperElement: function(){return this.display.wrapper;},\n    getScrollerElement: function(){return this.display.scroller;},\n    getGutterElement: function(){return this.display.gutters;}\n  };\n  eventMixin(CodeMirror);\n\n  // OPTION DEFAULTS\n\n  var optionHandlers = CodeMirror.optionHandlers = {};\n\n  // The default configuration options.\n  var defaults = CodeMirror.defaults = {};\n\n  function option(name, deflt, handle, notOnInit) {\n    CodeMirror.defaults[name] = deflt;\n    if (handle) optionHandlers[name] =\n      notOnInit ? function(cm, val, old) {if (old != Init) handle(cm, val, old);} : handle;\n  }\n\n  var Init = CodeMirror.Init = {toString: function(){return \"CodeMirror.Init\";}};\n\n  // These two are, on init, called from the constructor because they\n  // have to be initialized before the editor can start at all.\n  option(\"value\", \"\", function(cm, val) {\n    cm.setValue(val);\n  }, true);\n  option(\"mode\", null, function(cm, val) {\n    cm.doc.modeOption = val;\n    loadMode(cm);\n  }, true);\n\n  option(\"indentUnit\", 2, loadMode, true);\n  option(\"indentWithTabs\", false);\n  option(\"smartIndent\", true);\n  option(\"tabSize\", 4, function(cm) {\n    loadMode(cm);\n    clearCaches(cm);\n    regChange(cm);\n  }, true);\n  option(\"electricChars\", true);\n  option(\"rtlMoveVisually\", !windows);\n\n  option(\"theme\", \"default\", function(cm) {\n    themeChanged(cm);\n    guttersChanged(cm);\n  }, true);\n  option(\"keyMap\", \"default\", keyMapChanged);\n  option(\"extraKeys\", null);\n\n  option(\"onKeyEvent\", null);\n  option(\"onDragEvent\", null);\n\n  option(\"lineWrapping\", false, wrappingChanged, true);\n  option(\"gutters\", [], function(cm) {\n    setGuttersForLineNumbers(cm.options);\n    guttersChanged(cm);\n  }, true);\n  option(\"fixedGutter\", true, function(cm, val) {\n    cm.display.gutters.style.left = val ? compensateForHScroll(cm.display) + \"px\" : \"0\";\n    cm.refresh();\n  }, true);\n  option(\"coverGutterNextToScrollbar\", false, updateScrollbars, true);\n  option(\"lineNumbers\", false, function(cm) {\n    setGuttersForLineNumbers(cm.options);\n    guttersChanged(cm);\n  }, true);\n  option(\"firstLineNumber\", 1, guttersChanged, true);\n  option(\"lineNumberFormatter\", function(integer) {return integer;}, guttersChanged, true);\n  option(\"showCursorWhenSelecting\", false, updateSelection, true);\n\n  option(\"readOnly\", false, function(cm, val) {\n    if (val == \"nocursor\") {onBlur(cm); cm.display.input.blur();}\n    else if (!val) resetInput(cm, true);\n  });\n  option(\"dragDrop\", true);\n\n  option(\"cursorBlinkRate\", 530);\n  option(\"cursorScrollMargin\", 0);\n  option(\"cursorHeight\", 1);\n  option(\"workTime\", 100);\n  option(\"workDelay\", 100);\n  option(\"flattenSpans\", true);\n  option(\"pollInterval\", 100);\n  option(\"undoDepth\", 40, function(cm, val){cm.doc.history.undoDepth = val;});\n  option(\"historyEventDelay\", 500);\n  option(\"viewportMargin\", 10, function(cm){cm.refresh();}, true);\n  option(\"maxHighlightLength\", 10000, function(cm){loadMode(cm); cm.refresh();}, true);\n  option(\"moveInputWithCursor\", true, function(cm, val) {\n    if (!val) cm.display.inputDiv.style.top = cm.display.inputDiv.style.left = 0;\n  });\n\n  option(\"tabindex\", null, function(cm, val) {\n    cm.display.input.tabIndex = val || \"\";\n  });\n  option(\"autofocus\", null);\n\n  // MODE DEFINITION AND QUERYING\n\n  // Known modes, by name and by MIME\n  var modes = CodeMirror.modes = {}, mimeModes = CodeMirror.mimeModes = {};\n\n  CodeMirror.defineMode = function(name, mode) {\n    if (!CodeMirror.defaults.mode && name != \"null\") CodeMirror.defaults.mode = name;\n    if (arguments.length > 2) {\n      mode.dependencies = [];\n      for (var i = 2; i < arguments.length; ++i) mode.dependencies.push(arguments[i]);\n    }\n    modes[name] = mode;\n  };\n\n  CodeMirror.defineMIME = function(mime, spec) {\n    mimeModes[mime] = spec;\n  };\n\n  CodeMirror.resolveMode = function(spec) {\n    if (typeof spec == \"string\" && mimeModes.hasOwnProperty(spec)) {\n      spec = mimeModes[spec];\n    } else if (spec && typeof spec.name == \"string\" && mimeModes.hasOwnProperty(spec.name)) {\n      var found = mimeModes[spec.name];\n      spec = createObj(found, spec);\n      spec.name = found.name;\n    } else if (typeof spec == \"string\" && /^[\\w\\-]+\\/[\\w\\-]+\\+xml$/.test(spec)) {\n      return CodeMirror.resolveMode(\"application/xml\");\n    }\n    if (typeof spec == \"string\") return {name: spec};\n    else return spec || {name: \"null\"};\n  };\n\n  CodeMirror.getMode = function(options, spec) {\n    var spec = CodeMirror.resolveMode(spec);\n    var mfactory = modes[spec.name];\n    if (!mfactory) return CodeMirror.getMode(options, \"text/plain\");\n    var modeObj = mfactory(options, spec);\n    if (modeExtensions.hasOwnProperty(spec.name)) {\n      var exts = modeExtensions[spec.name];\n      for (var prop in exts) {\n        if (!exts.hasOwnProperty(prop)) continue;\n        if (modeObj.hasOwnProperty(prop)) modeObj[\"_\" + prop] = modeObj[prop];\n        modeObj[prop] = exts[prop];\n      }\n    }\n    modeObj.name = spec.name;\n\n    return modeObj;\n  };\n\n  CodeMirror.defineMode(\"null\", function() {\n    return {token: function(stream) {stream.skipToEnd();}};\n  });\n  CodeMirror.defineMIME(\"text/plain\", \"null\");\n\n  var modeExtensions = CodeMirror.modeExtensions = {};\n  CodeMirror.extendMode = function(mode, properties) {\n    var exts = modeExtensions.hasOwnProperty(mode) ? modeExtensions[mode] : (modeExtensions[mode] = {});\n    copyObj(properties, exts);\n  };\n\n  // EXTENSIONS\n\n  CodeMirror.defineExtension = function(name, func) {\n    CodeMirror.prototype[name] = func;\n  };\n  CodeMirror.defineDocExtension = function(name, func) {\n    Doc.prototype[name] = func;\n  };\n  CodeMirror.defineOption = option;\n\n  var initHooks = [];\n  CodeMirror.defineInitHook = function(f) {initHooks.push(f);};\n\n  var helpers = CodeMirror.helpers = {};\n  CodeMirror.registerHelper = function(type, name, value) {\n    if (!helpers.hasOwnProperty(type)) helpers[type] = CodeMirror[type] = {};\n    helpers[type][name] = value;\n  };\n\n  // UTILITIES\n\n  CodeMirror.isWordChar = isWordChar;\n\n  // MODE STATE HANDLING\n\n  // Utility functions for working with state. Exported because modes\n  // sometimes need to do this.\n  function copyState(mode, state) {\n    if (state === true) return state;\n    if (mode.copyState) return mode.copyState(state);\n    var nstate = {};\n    for (var n in state) {\n      var val = state[n];\n      if (val instanceof Array) val = val.concat([]);\n      nstate[n] = val;\n    }\n    return nstate;\n  }\n  CodeMirror.copyState = copyState;\n\n  function startState(mode, a1, a2) {\n    return mode.startState ? mode.startState(a1, a2) : true;\n  }\n  CodeMirror.startState = startState;\n\n  CodeMirror.innerMode = function(mode, state) {\n    while (mode.innerMode) {\n      var info = mode.innerMode(state);\n      if (!info || info.mode == mode) break;\n      state = info.state;\n      mode = info.mode;\n    }\n    return info || {mode: mode, state: state};\n  };\n\n  // STANDARD COMMANDS\n\n  var commands = CodeMirror.commands = {\n    selectAll: function(cm) {cm.setSelection(Pos(cm.firstLine(), 0), Pos(cm.lastLine()));},\n    killLine: function(cm) {\n      var from = cm.getCursor(true), to = cm.getCursor(false), sel = !posEq(from, to);\n      if (!sel && cm.getLine(from.line).length == from.ch)\n        cm.replaceRange(\"\", from, Pos(from.line + 1, 0), \"+delete\");\n      else cm.replaceRange(\"\", from, sel ? to : Pos(from.line), \"+delete\");\n    },\n    deleteLine: function(cm) {\n      var l = cm.getCursor().line;\n      cm.replaceRange(\"\", Pos(l, 0), Pos(l), \"+delete\");\n    },\n    delLineLeft: function(cm) {\n      var cur = cm.getCursor();\n      cm.replaceRange(\"\", Pos(cur.line, 0), cur, \"+delete\");\n    },\n    undo: function(cm) {cm.undo();},\n    redo: function(cm) {cm.redo();},\n    goDocStart: function(cm) {cm.extendSelection(Pos(cm.firstLine(), 0));},\n    goDocEnd: function(cm) {cm.extendSelection(Pos(cm.lastLine()));},\n    goLineStart: function(cm) {\n      cm.extendSelection(lineStart(cm, cm.getCursor().line));\n    },\n    goLineStartSmart: function(cm) {\n      var cur = cm.getCursor(), start = lineStart(cm, cur.line);\n      var line = cm.getLineHandle(start.line);\n      var order = getOrder(line);\n      if (!order || order[0].level == 0) {\n        var firstNonWS = Math.max(0, line.text.search(/\\S/));\n        var inWS = cur.line == start.line && cur.ch <= firstNonWS && cur.ch;\n        cm.extendSelection(Pos(start.line, inWS ? 0 : firstNonWS));\n      } else cm.extendSelection(start);\n    },\n    goLineEnd: function(cm) {\n      cm.extendSelection(lineEnd(cm, cm.getCursor().line));\n    },\n    goLineRight: function(cm) {\n      var top = cm.charCoords(cm.getCursor(), \"div\").top + 5;\n      cm.extendSelection(cm.coordsChar({left: cm.display.lineDiv.offsetWidth + 100, top: top}, \"div\"));\n    },\n    goLineLeft: function(cm) {\n      var top = cm.charCoords(cm.getCursor(), \"div\").top + 5;\n      cm.extendSelection(cm.coordsChar({left: 0, top: top}, \"div\"));\n    },\n    goLineUp: function(cm) {cm.moveV(-1, \"line\");},\n    goLineDown: function(cm) {cm.moveV(1, \"line\");},\n    goPageUp: function(cm) {cm.moveV(-1, \"page\");},\n    goPageDown: function(cm) {cm.moveV(1, \"page\");},\n    goCharLeft: function(cm) {cm.moveH(-1, \"char\");},\n    goCharRight: function(cm) {cm.moveH(1, \"char\");},\n    goColumnLeft: function(cm) {cm.moveH(-1, \"column\");},\n    goColumnRight: function(cm) {cm.moveH(1, \"column\");},\n    goWordLeft: function(cm) {cm.moveH(-1, \"word\");},\n    goGroupRight: function(cm) {cm.moveH(1, \"group\");},\n    goGroupLeft: function(cm) {cm.moveH(-1, \"group\");},\n    goWordRight: function(cm) {cm.moveH(1, \"word\");},\n    delCharBefore: function(cm) {cm.deleteH(-1, \"char\");},\n    delCharAfter: function(cm) {cm.deleteH(1, \"char\");},\n    delWordBefore: function(cm) {cm.deleteH(-1, \"word\");},\n    delWordAfter: function(cm) {cm.deleteH(1, \"word\");},\n    delGroupBefore: function(cm) {cm.deleteH(-1, \"group\");},\n    delGroupAfter: function(cm) {cm.deleteH(1, \"group\");},\n    indentAuto: function(cm) {cm.indentSelection(\"smart\");},\n    indentMore: function(cm) {cm.indentSelection(\"add\");},\n    indentLess: function(cm) {cm.indentSelection(\"subtract\");},\n    insertTab: function(cm) {cm.replaceSelection(\"\\t\", \"end\", \"+input\");},\n    defaultTab: function(cm) {\n      if (cm.somethingSelected()) cm.indentSelection(\"add\");\n      else cm.replaceSelection(\"\\t\", \"end\", \"+input\");\n    },\n    transposeChars: function(cm) {\n      var cur = cm.getCursor(), line = cm.getLine(cur.line);\n      if (cur.ch > 0 && cur.ch < line.length - 1)\n        cm.replaceRange(line.charAt(cur.ch) + line.charAt(cur.ch - 1),\n                        Pos(cur.line, cur.ch - 1), Pos(cur.line, cur.ch + 1));\n    },\n    newlineAndIndent: function(cm) {\n      operation(cm, function() {\n        cm.replaceSelection(\"\\n\", \"end\", \"+input\");\n        cm.indentLine(cm.getCursor().line, null, true);\n      })();\n    },\n    toggleOverwrite: function(cm) {cm.toggleOverwrite();}\n  };\n\n  // STANDARD KEYMAPS\n\n  var keyMap = CodeMirror.keyMap = {};\n  keyMap.basic = {\n    \"Left\": \"goCharLeft\", \"Right\": \"goCharRight\", \"Up\": \"goLineUp\", \"Down\": \"goLineDown\",\n    \"End\": \"goLineEnd\", \"Home\": \"goLineStartSmart\", \"PageUp\": \"goPageUp\", \"PageDown\": \"goPageDown\",\n    \"Delete\": \"delCharAfter\", \"Backspace\": \"delCharBefore\", \"Tab\": \"defaultTab\", \"Shift-Tab\": \"indentAuto\",\n    \"Enter\": \"newlineAndIndent\", \"Insert\": \"toggleOverwrite\"\n  };\n  // Note that the save and find-related commands aren't defined by\n  // default. Unknown commands are simply ignored.\n  keyMap.pcDefault = {\n    \"Ctrl-A\": \"selectAll\", \"Ctrl-D\": \"deleteLine\", \"Ctrl-Z\": \"undo\", \"Shift-Ctrl-Z\": \"redo\", \"Ctrl-Y\": \"redo\",\n    \"Ctrl-Home\": \"goDocStart\", \"Alt-Up\": \"goDocStart\", \"Ctrl-End\": \"goDocEnd\", \"Ctrl-Down\": \"goDocEnd\",\n    \"Ctrl-Left\": \"goGroupLeft\", \"Ctrl-Right\": \"goGroupRight\", \"Alt-Left\": \"goLineStart\", \"Alt-Right\": \"goLineEnd\",\n    \"Ctrl-Backspace\": \"delGroupBefore\", \"Ctrl-Delete\": \"delGroupAfter\", \"Ctrl-S\": \"save\", \"Ctrl-F\": \"find\",\n    \"Ctrl-G\": \"findNext\", \"Shift-Ctrl-G\": \"findPrev\", \"Shift-Ctrl-F\": \"replace\", \"Shift-Ctrl-R\": \"replaceAll\",\n    \"Ctrl-[\": \"indentLess\", \"Ctrl-]\": \"indentMore\",\n    fallthrough: \"basic\"\n  };\n  keyMap.macDefault = {\n    \"Cmd-A\": \"selectAll\", \"Cmd-D\": \"deleteLine\", \"Cmd-Z\": \"undo\", \"Shift-Cmd-Z\": \"redo\", \"Cmd-Y\": \"redo\",\n    \"Cmd-Up\": \"goDocStart\", \"Cmd-End\": \"goDocEnd\", \"Cmd-Down\": \"goDocEnd\", \"Alt-Left\": \"goGroupLeft\",\n    \"Alt-Right\": \"goGroupRight\", \"Cmd-Left\": \"goLineStart\", \"Cmd-Right\": \"goLineEnd\", \"Alt-Backspace\": \"delGroupBefore\",\n    \"Ctrl-Alt-Backspace\": \"delGroupAfter\", \"Alt-Delete\": \"delGroupAfter\", \"Cmd-S\": \"save\", \"Cmd-F\": \"find\",\n    \"Cmd-G\": \"findNext\", \"Shift-Cmd-G\": \"findPrev\", \"Cmd-Alt-F\": \"replace\", \"Shift-Cmd-Alt-F\": \"replaceAll\",\n    \"Cmd-[\": \"indentLess\", \"Cmd-]\": \"indentMore\", \"Cmd-Backspace\": \"delLineLeft\",\n    fallthrough: [\"basic\", \"emacsy\"]\n  };\n  keyMap[\"default\"] = mac ? keyMap.macDefault : keyMap.pcDefault;\n  keyMap.emacsy = {\n    \"Ctrl-F\": \"goCharRight\", \"Ctrl-B\": \"goCharLeft\", \"Ctrl-P\": \"goLineUp\", \"Ctrl-N\": \"goLineDown\",\n    \"Alt-F\": \"goWordRight\", \"Alt-B\": \"goWordLeft\", \"Ctrl-A\": \"goLineStart\", \"Ctrl-E\": \"goLineEnd\",\n    \"Ctrl-V\": \"goPageDown\", \"Shift-Ctrl-V\": \"goPageUp\", \"Ctrl-D\": \"delCharAfter\", \"Ctrl-H\": \"delCharBefore\",\n    \"Alt-D\": \"delWordAfter\", \"Alt-Backspace\": \"delWordBefore\", \"Ctrl-K\": \"killLine\", \"Ctrl-T\": \"transposeChars\"\n  };\n\n  // KEYMAP DISPATCH\n\n  function getKeyMap(val) {\n    if (typeof val == \"string\") return keyMap[val];\n    else return val;\n  }\n\n  function lookupKey(name, maps, handle) {\n    function lookup(map) {\n      map = getKeyMap(map);\n      var found = map[name];\n      if (found === false) return \"stop\";\n      if (found != null && handle(found)) return true;\n      if (map.nofallthrough) return \"stop\";\n\n      var fallthrough = map.fallthrough;\n      if (fallthrough == null) return false;\n      if (Object.prototype.toString.call(fallthrough) != \"[object Array]\")\n        return lookup(fallthrough);\n      for (var i = 0, e = fallthrough.length; i < e; ++i) {\n        var done = lookup(fallthrough[i]);\n        if (done) return done;\n      }\n      return false;\n    }\n\n    for (var i = 0; i < maps.length; ++i) {\n      var done = lookup(maps[i]);\n      if (done) return done != \"stop\";\n    }\n  }\n  function isModifierKey(event) {\n    var name = keyNames[event.keyCode];\n    return name == \"Ctrl\" || name == \"Alt\" || name == \"Shift\" || name == \"Mod\";\n  }\n  function keyName(event, noShift) {\n    if (opera && event.keyCode == 34 && event[\"char\"]) return false;\n    var name = keyNames[event.keyCode];\n    if (name == null || event.altGraphKey) return false;\n    if (event.altKey) name = \"Alt-\" + name;\n    if (flipCtrlCmd ? event.metaKey : event.ctrlKey) name = \"Ctrl-\" + name;\n    if (flipCtrlCmd ? event.ctrlKey : event.metaKey) name = \"Cmd-\" + name;\n    if (!noShift && event.shiftKey) name = \"Shift-\" + name;\n    return name;\n  }\n  CodeMirror.lookupKey = lookupKey;\n  CodeMirror.isModifierKey = isModifierKey;\n  CodeMirror.keyName = keyName;\n\n  // FROMTEXTAREA\n\n  CodeMirror.fromTextArea = function(textarea, options) {\n    if (!options) options = {};\n    options.value = textarea.value;\n    if (!options.tabindex && textarea.tabindex)\n      options.tabindex = textarea.tabindex;\n    if (!options.placeholder && textarea.placeholder)\n      options.placeholder = textarea.placeholder;\n    // Set autofocus to true if this textarea is focused, or if it has\n    // autofocus and no other element is focused.\n    if (options.autofocus == null) {\n      var hasFocus = document.body;\n      // doc.activeElement occasionally throws on IE\n      try { hasFocus = document.activeElement; } catch(e) {}\n      options.autofocus = hasFocus == textarea ||\n        textarea.getAttribute(\"autofocus\") != null && hasFocus == document.body;\n    }\n\n    function save() {textarea.value = cm.getValue();}\n    if (textarea.form) {\n      on(textarea.form, \"submit\", save);\n      // Deplorable hack to make the submit method do the right thing.\n      if (!options.leaveSubmitMethodAlone) {\n        var form = textarea.form, realSubmit = form.submit;\n        try {\n          var wrappedSubmit = form.submit = function() {\n            save();\n            form.submit = realSubmit;\n            form.submit();\n            form.submit = wrappedSubmit;\n          };\n        } catch(e) {}\n      }\n    }\n\n    textarea.style.display = \"none\";\n    var cm = CodeMirror(function(node) {\n      textarea.parentNode.insertBefore(node, textarea.nextSibling);\n    }, options);\n    cm.save = save;\n    cm.getTextArea = function() { return textarea; };\n    cm.toTextArea = function() {\n      save();\n      textarea.parentNode.removeChild(cm.getWrapperElement());\n      textarea.style.display = \"\";\n      if (textarea.form) {\n        off(textarea.form, \"submit\", save);\n        if (typeof textarea.form.submit == \"function\")\n          textarea.form.submit = realSubmit;\n      }\n    };\n    return cm;\n  };\n\n  // STRING STREAM\n\n  // Fed to the mode parsers, provides helper functions to make\n  // parsers more succinct.\n\n  // The character stream used by a mode's parser.\n  function StringStream(string, tabSize) {\n    this.pos = this.start = 0;\n    this.string = string;\n    this.tabSize = tabSize || 8;\n    this.lastColumnPos = this.lastColumnValue = 0;\n  }\n\n  StringStream.prototype = {\n    eol: function() {return this.pos >= this.string.length;},\n    sol: function() {return this.pos == 0;},\n    peek: function() {return this.string.charAt(this.pos) || undefined;},\n    next: function() {\n      if (this.pos < this.string.length)\n        return this.string.charAt(this.pos++);\n    },\n    eat: function(match) {\n      var ch = this.string.charAt(this.pos);\n      if (typeof match == \"string\") var ok = ch == match;\n      else var ok = ch && (match.test ? match.test(ch) : match(ch));\n      if (ok) {++this.pos; return ch;}\n    },\n    eatWhile: function(match) {\n      var start = this.pos;\n      while (this.eat(match)){}\n      return this.pos > start;\n    },\n    eatSpace: function() {\n      var start = this.pos;\n      while (/[\\s\\u00a0]/.test(this.string.charAt(this.pos))) ++this.pos;\n      return this.pos > start;\n    },\n    skipToEnd: function() {this.pos = this.string.length;},\n    skipTo: function(ch) {\n      var found = this.string.indexOf(ch, this.pos);\n      if (found > -1) {this.pos = found; return true;}\n    },\n    backUp: function(n) {this.pos -= n;},\n    column: function() {\n      if (this.lastColumnPos < this.start) {\n        this.lastColumnValue = countColumn(this.string, this.start, this.tabSize, this.lastColumnPos, this.lastColumnValue);\n        this.lastColumnPos = this.start;\n      }\n      return this.lastColumnValue;\n    },\n    indentation: function() {return countColumn(this.string, null, this.tabSize);},\n    match: function(pattern, consume, caseInsensitive) {\n      if (typeof pattern == \"string\") {\n        var cased = function(str) {return caseInsensitive ? str.toLowerCase() : str;};\n        var substr = this.string.substr(this.pos, pattern.length);\n        if (cased(substr) == cased(pattern)) {\n          if (consume !== false) this.pos += pattern.length;\n          return true;\n        }\n      } else {\n        var match = this.string.slice(this.pos).match(pattern);\n        if (match && match.index > 0) return null;\n        if (match && consume !== false) this.pos += match[0].length;\n        return match;\n      }\n    },\n    current: function(){return this.string.slice(this.start, this.pos);}\n  };\n  CodeMirror.StringStream = StringStream;\n\n  // TEXTMARKERS\n\n  function TextMarker(doc, type) {\n    this.lines = [];\n    this.type = type;\n    this.doc = doc;\n  }\n  CodeMirror.TextMarker = TextMarker;\n  eventMixin(TextMarker);\n\n  TextMarker.prototype.clear = function() {\n    if (this.explicitlyCleared) return;\n    var cm = this.doc.cm, withOp = cm && !cm.curOp;\n    if (withOp) startOperation(cm);\n    if (hasHandler(this, \"clear\")) {\n      var found = this.find();\n      if (found) signalLater(this, \"clear\", found.from, found.to);\n    }\n    var min = null, max = null;\n    for (var i = 0; i < this.lines.length; ++i) {\n      var line = this.lines[i];\n      var span = getMarkedSpanFor(line.markedSpans, this);\n      if (span.to != null) max = lineNo(line);\n      line.markedSpans = removeMarkedSpan(line.markedSpans, span);\n      if (span.from != null)\n        min = lineNo(line);\n      else if (this.collapsed && !lineIsHidden(this.doc, line) && cm)\n        updateLineHeight(line, textHeight(cm.display));\n    }\n    if (cm && this.collapsed && !cm.options.lineWrapping) for (var i = 0; i < this.lines.length; ++i) {\n      var visual = visualLine(cm.doc, this.lines[i]), len = lineLength(cm.doc, visual);\n      if (len > cm.display.maxLineLength) {\n        cm.display.maxLine = visual;\n        cm.display.maxLineLength = len;\n        cm.display.maxLineChanged = true;\n      }\n    }\n\n    if (min != null && cm) regChange(cm, min, max + 1);\n    this.lines.length = 0;\n    this.explicitlyCleared = true;\n    if (this.atomic && this.doc.cantEdit) {\n      this.doc.cantEdit = false;\n      if (cm) reCheckSelection(cm);\n    }\n    if (withOp) endOperation(cm);\n  };\n\n  TextMarker.prototype.find = function() {\n    var from, to;\n    for (var i = 0; i < this.lines.length; ++i) {\n      var line = this.lines[i];\n      var span = getMarkedSpanFor(line.markedSpans, this);\n      if (span.from != null || span.to != null) {\n        var found = lineNo(line);\n        if (span.from != null) from = Pos(found, span.from);\n        if (span.to != null) to = Pos(found, span.to);\n      }\n    }\n    if (this.type == \"bookmark\") return from;\n    return from && {from: from, to: to};\n  };\n\n  TextMarker.prototype.changed = function() {\n    var pos = this.find(), cm = this.doc.cm;\n    if (!pos || !cm) return;\n    if (this.type != \"bookmark\") pos = pos.from;\n    var line = getLine(this.doc, pos.line);\n    clearCachedMeasurement(cm, line);\n    if (pos.line >= cm.display.showingFrom && pos.line < cm.display.showingTo) {\n      for (var node = cm.display.lineDiv.firstChild; node; node = node.nextSibling) if (node.lineObj == line) {\n        if (node.offsetHeight != line.height) updateLineHeight(line, node.offsetHeight);\n        break;\n      }\n      runInOp(cm, function() {\n        cm.curOp.selectionChanged = cm.curOp.forceUpdate = cm.curOp.updateMaxLine = true;\n      });\n    }\n  };\n\n  TextMarker.prototype.attachLine = function(line) {\n    if (!this.lines.length && this.doc.cm) {\n      var op = this.doc.cm.curOp;\n      if (!op.maybeHiddenMarkers || indexOf(op.maybeHiddenMarkers, this) == -1)\n        (op.maybeUnhiddenMarkers || (op.maybeUnhiddenMarkers = [])).push(this);\n    }\n    this.lines.push(line);\n  };\n  TextMarker.prototype.detachLine = function(line) {\n    this.lines.splice(indexOf(this.lines, line), 1);\n    if (!this.lines.length && this.doc.cm) {\n      var op = this.doc.cm.curOp;\n      (op.maybeHiddenMarkers || (op.maybeHiddenMarkers = [])).push(this);\n    }\n  };\n\n  function markText(doc, from, to, options, type) {\n    if (options && options.shared) return markTextShared(doc, from, to, options, type);\n    if (doc.cm && !doc.cm.curOp) return operation(doc.cm, markText)(doc, from, to, options, type);\n\n    var marker = new TextMarker(doc, type);\n    if (type == \"range\" && !posLess(from, to)) return marker;\n    if (options) copyObj(options, marker);\n    if (marker.replacedWith) {\n      marker.collapsed = true;\n      marker.replacedWith = elt(\"span\", [marker.replacedWith], \"CodeMirror-widget\");\n      if (!options.handleMouseEvents) marker.replacedWith.ignoreEvents = true;\n    }\n    if (marker.collapsed) sawCollapsedSpans = true;\n\n    if (marker.addToHistory)\n      addToHistory(doc, {from: from, to: to, origin: \"markText\"},\n                   {head: doc.sel.head, anchor: doc.sel.anchor}, NaN);\n\n    var curLine = from.line, size = 0, collapsedAtStart, collapsedAtEnd, cm = doc.cm, updateMaxLine;\n    doc.iter(curLine, to.line + 1, function(line) {\n      if (cm && marker.collapsed && !cm.options.lineWrapping && visualLine(doc, line) == cm.display.maxLine)\n        updateMaxLine = true;\n      var span = {from: null, to: null, marker: marker};\n      size += line.text.length;\n      if (curLine == from.line) {span.from = from.ch; size -= from.ch;}\n      if (curLine == to.line) {span.to = to.ch; size -= line.text.length - to.ch;}\n      if (marker.collapsed) {\n        if (curLine == to.line) collapsedAtEnd = collapsedSpanAt(line, to.ch);\n        if (curLine == from.line) collapsedAtStart = collapsedSpanAt(line, from.ch);\n        else updateLineHeight(line, 0);\n      }\n      addMarkedSpan(line, span);\n      ++curLine;\n    });\n    if (marker.collapsed) doc.iter(from.line, to.line + 1, function(line) {\n      if (lineIsHidden(doc, line)) updateLineHeight(line, 0);\n    });\n\n    if (marker.clearOnEnter) on(marker, \"beforeCursorEnter\", function() { marker.clear(); });\n\n    if (marker.readOnly) {\n      sawReadOnlySpans = true;\n      if (doc.history.done.length || doc.history.undone.length)\n        doc.clearHistory();\n    }\n    if (marker.collapsed) {\n      if (collapsedAtStart != collapsedAtEnd)\n        throw new Error(\"Inserting collapsed marker overlapping an existing one\");\n      marker.size = size;\n      marker.atomic = true;\n    }\n    if (cm) {\n      if (updateMaxLine) cm.curOp.updateMaxLine = true;\n      if (marker.className || marker.title || marker.startStyle || marker.endStyle || marker.collapsed)\n        regChange(cm, from.line, to.line + 1);\n      if (marker.atomic) reCheckSelection(cm);\n    }\n    return marker;\n  }\n\n  // SHARED TEXTMARKERS\n\n  function SharedTextMarker(markers, primary) {\n    this.markers = markers;\n    this.primary = primary;\n    for (var i = 0, me = this; i < markers.length; ++i) {\n      markers[i].parent = this;\n      on(markers[i], \"clear\", function(){me.clear();});\n    }\n  }\n  CodeMirror.SharedTextMarker = SharedTextMarker;\n  eventMixin(SharedTextMarker);\n\n  SharedTextMarker.prototype.clear = function() {\n    if (this.explicitlyCleared) return;\n    this.explicitlyCleared = true;\n    for (var i = 0; i < this.markers.length; ++i)\n      this.markers[i].clear();\n    signalLater(this, \"clear\");\n  };\n  SharedTextMarker.prototype.find = function() {\n    return this.primary.find();\n  };\n\n  function markTextShared(doc, from, to, options, type) {\n    options = copyObj(options);\n    options.shared = false;\n    var markers = [markText(doc, from, to, options, type)], primary = markers[0];\n    var widget = options.replacedWith;\n    linkedDocs(doc, function(doc) {\n      if (widget) options.replacedWith = widget.cloneNode(true);\n      markers.push(markText(doc, clipPos(doc, from), clipPos(doc, to), options, type));\n      for (var i = 0; i < doc.linked.length; ++i)\n        if (doc.linked[i].isParent) return;\n      primary = lst(markers);\n    });\n    return new SharedTextMarker(markers, primary);\n  }\n\n  // TEXTMARKER SPANS\n\n  function getMarkedSpanFor(spans, marker) {\n    if (spans) for (var i = 0; i < spans.length; ++i) {\n      var span = spans[i];\n      if (span.marker == marker) return span;\n    }\n  }\n  function removeMarkedSpan(spans, span) {\n    for (var r, i = 0; i < spans.length; ++i)\n      if (spans[i] != span) (r || (r = [])).push(spans[i]);\n    return r;\n  }\n  function addMarkedSpan(line, span) {\n    line.markedSpans = line.markedSpans ? line.markedSpans.concat([span]) : [span];\n    span.marker.attachLine(line);\n  }\n\n  function markedSpansBefore(old, startCh, isInsert) {\n    if (old) for (var i = 0, nw; i < old.length; ++i) {\n      var span = old[i], marker = span.marker;\n      var startsBefore = span.from == null || (marker.inclusiveLeft ? span.from <= startCh : span.from < startCh);\n      if (startsBefore || marker.type == \"bookmark\" && span.from == startCh && (!isInsert || !span.marker.insertLeft)) {\n        var endsAfter = span.to == null || (marker.inclusiveRight ? span.to >= startCh : span.to > startCh);\n        (nw || (nw = [])).push({from: span.from,\n                                to: endsAfter ? null : span.to,\n                                marker: marker});\n      }\n    }\n    return nw;\n  }\n\n  function markedSpansAfter(old, endCh, isInsert) {\n    if (old) for (var i = 0, nw; i < old.length; ++i) {\n      var span = old[i], marker = span.marker;\n      var endsAfter = span.to == null || (marker.inclusiveRight ? span.to >= endCh : span.to > endCh);\n      if (endsAfter || marker.type == \"bookmark\" && span.from == endCh && (!isInsert || span.marker.insertLeft)) {\n        var startsBefore = span.from == null || (marker.inclusiveLeft ? span.from <= endCh : span.from < endCh);\n        (nw || (nw = [])).push({from: startsBefore ? null : span.from - endCh,\n                                to: span.to == null ? null : span.to - endCh,\n                                marker: marker});\n      }\n    }\n    return nw;\n  }\n\n  function stretchSpansOverChange(doc, change) {\n    var oldFirst = isLine(doc, change.from.line) && getLine(doc, change.from.line).markedSpans;\n    var oldLast = isLine(doc, change.to.line) && getLine(doc, change.to.line).markedSpans;\n    if (!oldFirst && !oldLast) return null;\n\n    var startCh = change.from.ch, endCh = change.to.ch, isInsert = posEq(change.from, change.to);\n    // Get the spans that 'stick out' on both sides\n    var first = markedSpansBefore(oldFirst, startCh, isInsert);\n    var last = markedSpansAfter(oldLast, endCh, isInsert);\n\n    // Next, merge those two ends\n    var sameLine = change.text.length == 1, offset = lst(change.text).length + (sameLine ? startCh : 0);\n    if (first) {\n      // Fix up .to properties of first\n      for (var i = 0; i < first.length; ++i) {\n        var span = first[i];\n        if (span.to == null) {\n          var found = getMarkedSpanFor(last, span.marker);\n          if (!found) span.to = startCh;\n          else if (sameLine) span.to = found.to == null ? null : found.to + offset;\n        }\n      }\n    }\n    if (last) {\n      // Fix up .from in last (or move them into first in case of sameLine)\n      for (var i = 0; i < last.length; ++i) {\n        var span = last[i];\n        if (span.to != null) span.to += offset;\n        if (span.from == null) {\n          var found = getMarkedSpanFor(first, span.marker);\n          if (!found) {\n            span.from = offset;\n            if (sameLine) (first || (first = [])).push(span);\n          }\n        } else {\n          span.from += offset;\n          if (sameLine) (first || (first = [])).push(span);\n        }\n      }\n    }\n    if (sameLine && first) {\n      // Make sure we didn't create any zero-length spans\n      for (var i = 0; i < first.length; ++i)\n        if (first[i].from != null && first[i].from == first[i].to && first[i].marker.type != \"bookmark\")\n          first.splice(i--, 1);\n      if (!first.length) first = null;\n    }\n\n    var newMarkers = [first];\n    if (!sameLine) {\n      // Fill gap with whole-line-spans\n      var gap = change.text.length - 2, gapMarkers;\n      if (gap > 0 && first)\n        for (var i = 0; i < first.length; ++i)\n          if (first[i].to == null)\n            (gapMarkers || (gapMarkers = [])).push({from: null, to: null, marker: first[i].marker});\n      for (var i = 0; i < gap; ++i)\n        newMarkers.push(gapMarkers);\n      newMarkers.push(last);\n    }\n    return newMarkers;\n  }\n\n  function mergeOldSpans(doc, change) {\n    var old = getOldSpans(doc, change);\n    var stretched = stretchSpansOverChange(doc, change);\n    if (!old) return stretched;\n    if (!stretched) return old;\n\n    for (var i = 0; i < old.length; ++i) {\n      var oldCur = old[i], stretchCur = stretched[i];\n      if (oldCur && stretchCur) {\n        spans: for (var j = 0; j < stretchCur.length; ++j) {\n          var span = stretchCur[j];\n          for (var k = 0; k < oldCur.length; ++k)\n            if (oldCur[k].marker == span.marker) continue spans;\n          oldCur.push(span);\n        }\n      } else if (stretchCur) {\n        old[i] = stretchCur;\n      }\n    }\n    return old;\n  }\n\n  function removeReadOnlyRanges(doc, from, to) {\n    var markers = null;\n    doc.iter(from.line, to.line + 1, function(line) {\n      if (line.markedSpans) for (var i = 0; i < line.markedSpans.length; ++i) {\n        var mark = line.markedSpans[i].marker;\n        if (mark.readOnly && (!markers || indexOf(markers, mark) == -1))\n          (markers || (markers = [])).push(mark);\n      }\n    });\n    if (!markers) return null;\n    var parts = [{from: from, to: to}];\n    for (var i = 0; i < markers.length; ++i) {\n      var mk = markers[i], m = mk.find();\n      for (var j = 0; j < parts.length; ++j) {\n        var p = parts[j];\n        if (posLess(p.to, m.from) || posLess(m.to, p.from)) continue;\n        var newParts = [j, 1];\n        if (posLess(p.from, m.from) || !mk.inclusiveLeft && posEq(p.from, m.from))\n          newParts.push({from: p.from, to: m.from});\n        if (posLess(m.to, p.to) || !mk.inclusiveRight && posEq(p.to, m.to))\n          newParts.push({from: m.to, to: p.to});\n        parts.splice.apply(parts, newParts);\n        j += newParts.length - 1;\n      }\n    }\n    return parts;\n  }\n\n  function collapsedSpanAt(line, ch) {\n    var sps = sawCollapsedSpans && line.markedSpans, found;\n    if (sps) for (var sp, i = 0; i < sps.length; ++i) {\n      sp = sps[i];\n      if (!sp.marker.collapsed) continue;\n      if ((sp.from == null || sp.from < ch) &&\n          (sp.to == null || sp.to > ch) &&\n          (!found || found.width < sp.marker.width))\n        found = sp.marker;\n    }\n    return found;\n  }\n  function collapsedSpanAtStart(line) { return collapsedSpanAt(line, -1); }\n  function collapsedSpanAtEnd(line) { return collapsedSpanAt(line, line.text.length + 1); }\n\n  function visualLine(doc, line) {\n    var merged;\n    while (merged = collapsedSpanAtStart(line))\n      line = getLine(doc, merged.find().from.line);\n    return line;\n  }\n\n  function lineIsHidden(doc, line) {\n    var sps = sawCollapsedSpans && line.markedSpans;\n    if (sps) for (var sp, i = 0; i < sps.length; ++i) {\n      sp = sps[i];\n      if (!sp.marker.collapsed) continue;\n      if (sp.from == null) return true;\n      if (sp.marker.replacedWith) continue;\n      if (sp.from == 0 && sp.marker.inclusiveLeft && lineIsHiddenInner(doc, line, sp))\n        return true;\n    }\n  }\n  function lineIsHiddenInner(doc, line, span) {\n    if (span.to == null) {\n      var end = span.marker.find().to, endLine = getLine(doc, end.line);\n      return lineIsHiddenInner(doc, endLine, getMarkedSpanFor(endLine.markedSpans, span.marker));\n    }\n    if (span.marker.inclusiveRight && span.to == line.text.length)\n      return true;\n    for (var sp, i = 0; i < line.markedSpans.length; ++i) {\n      sp = line.markedSpans[i];\n      if (sp.marker.collapsed && !sp.marker.replacedWith && sp.from == span.to &&\n          (sp.marker.inclusiveLeft || span.marker.inclusiveRight) &&\n          lineIsHiddenInner(doc, line, sp)) return true;\n    }\n  }\n\n  function detachMarkedSpans(line) {\n    var spans = line.markedSpans;\n    if (!spans) return;\n    for (var i = 0; i < spans.length; ++i)\n      spans[i].marker.detachLine(line);\n    line.markedSpans = null;\n  }\n\n  function attachMarkedSpans(line, spans) {\n    if (!spans) return;\n    for (var i = 0; i < spans.length; ++i)\n      spans[i].marker.attachLine(line);\n    line.markedSpans = spans;\n  }\n\n  // LINE WIDGETS\n\n  var LineWidget = CodeMirror.LineWidget = function(cm, node, options) {\n    if (options) for (var opt in options) if (options.hasOwnProperty(opt))\n      this[opt] = options[opt];\n    this.cm = cm;\n    this.node = node;\n  };\n  eventMixin(LineWidget);\n  function widgetOperation(f) {\n    return function() {\n      var withOp = !this.cm.curOp;\n      if (withOp) startOperation(this.cm);\n      try {var result = f.apply(this, arguments);}\n      finally {if (withOp) endOperation(this.cm);}\n      return result;\n    };\n  }\n  LineWidget.prototype.clear = widgetOperation(function() {\n    var ws = this.line.widgets, no = lineNo(this.line);\n    if (no == null || !ws) return;\n    for (var i = 0; i < ws.length; ++i) if (ws[i] == this) ws.splice(i--, 1);\n    if (!ws.length) this.line.widgets = null;\n    var aboveVisible = heightAtLine(this.cm, this.line) < this.cm.doc.scrollTop;\n    updateLineHeight(this.line, Math.max(0, this.line.height - widgetHeight(this)));\n    if (aboveVisible) addToScrollPos(this.cm, 0, -this.height);\n    regChange(this.cm, no, no + 1);\n  });\n  LineWidget.prototype.changed = widgetOperation(function() {\n    var oldH = this.height;\n    this.height = null;\n    var diff = widgetHeight(this) - oldH;\n    if (!diff) return;\n    updateLineHeight(this.line, this.line.height + diff);\n    var no = lineNo(this.line);\n    regChange(this.cm, no, no + 1);\n  });\n\n  function widgetHeight(widget) {\n    if (widget.height != null) return widget.height;\n    if (!widget.node.parentNode || widget.node.parentNode.nodeType != 1)\n      removeChildrenAndAdd(widget.cm.display.measure, elt(\"div\", [widget.node], null, \"position: relative\"));\n    return widget.height = widget.node.offsetHeight;\n  }\n\n  function addLineWidget(cm, handle, node, options) {\n    var widget = new LineWidget(cm, node, options);\n    if (widget.noHScroll) cm.display.alignWidgets = true;\n    changeLine(cm, handle, function(line) {\n      var widgets = line.widgets || (line.widgets = []);\n      if (widget.insertAt == null) widgets.push(widget);\n      else widgets.splice(Math.min(widgets.length - 1, Math.max(0, widget.insertAt)), 0, widget);\n      widget.line = line;\n      if (!lineIsHidden(cm.doc, line) || widget.showIfHidden) {\n        var aboveVisible = heightAtLine(cm, line) < cm.doc.scrollTop;\n        updateLineHeight(line, line.height + widgetHeight(widget));\n        if (aboveVisible) addToScrollPos(cm, 0, widget.height);\n      }\n      return true;\n    });\n    return widget;\n  }\n\n  // LINE DATA STRUCTURE\n\n  // Line objects. These hold state related to a line, including\n  // highlighting info (the styles array).\n  var Line = CodeMirror.Line = function(text, markedSpans, estimateHeight) {\n    this.text = text;\n    attachMarkedSpans(this, markedSpans);\n    this.height = estimateHeight ? estimateHeight(this) : 1;\n  };\n  eventMixin(Line);\n\n  function updateLine(line, text, markedSpans, estimateHeight) {\n    line.text = text;\n    if (line.stateAfter) line.stateAfter = null;\n    if (line.styles) line.styles = null;\n    if (line.order != null) line.order = null;\n    detachMarkedSpans(line);\n    attachMarkedSpans(line, markedSpans);\n    var estHeight = estimateHeight ? estimateHeight(line) : 1;\n    if (estHeight != line.height) updateLineHeight(line, estHeight);\n  }\n\n  function cleanUpLine(line) {\n    line.parent = null;\n    detachMarkedSpans(line);\n  }\n\n  // Run the given mode's parser over a line, update the styles\n  // array, which contains alternating fragments of text and CSS\n  // classes.\n  function runMode(cm, text, mode, state, f) {\n    var flattenSpans = mode.flattenSpans;\n    if (flattenSpans == null) flattenSpans = cm.options.flattenSpans;\n    var curStart = 0, curStyle = null;\n    var stream = new StringStream(text, cm.options.tabSize), style;\n    if (text == \"\" && mode.blankLine) mode.blankLine(state);\n    while (!stream.eol()) {\n      if (stream.pos > cm.options.maxHighlightLength) {\n        flattenSpans = false;\n        // Webkit seems to refuse to render text nodes longer than 57444 characters\n        stream.pos = Math.min(text.length, stream.start + 50000);\n        style = null;\n      } else {\n        style = mode.token(stream, state);\n      }\n      if (!flattenSpans || curStyle != style) {\n        if (curStart < stream.start) f(stream.start, curStyle);\n        curStart = stream.start; curStyle = style;\n      }\n      stream.start = stream.pos;\n    }\n    if (curStart < stream.pos) f(stream.pos, curStyle);\n  }\n\n  function highlightLine(cm, line, state) {\n    // A styles array always starts with a number identifying the\n    // mode/overlays that it is based on (for easy invalidation).\n    var st = [cm.state.modeGen];\n    // Compute the base array of styles\n    runMode(cm, line.text, cm.doc.mode, state, function(end, style) {st.push(end, style);});\n\n    // Run overlays, adjust style array.\n    for (var o = 0; o < cm.state.overlays.length; ++o) {\n      var overlay = cm.state.overlays[o], i = 1, at = 0;\n      runMode(cm, line.text, overlay.mode, true, function(end, style) {\n        var start = i;\n        // Ensure there's a token end at the current position, and that i points at it\n        while (at < end) {\n          var i_end = st[i];\n          if (i_end > end)\n            st.splice(i, 1, end, st[i+1], i_end);\n          i += 2;\n          at = Math.min(end, i_end);\n        }\n        if (!style) return;\n        if (overlay.opaque) {\n          st.splice(start, i - start, end, style);\n          i = start + 2;\n        } else {\n          for (; start < i; start += 2) {\n            var cur = st[start+1];\n            st[start+1] = cur ? cur + \" \" + style : style;\n          }\n        }\n      });\n    }\n\n    return st;\n  }\n\n  function getLineStyles(cm, line) {\n    if (!line.styles || line.styles[0] != cm.state.modeGen)\n      line.styles = highlightLine(cm, line, line.stateAfter = getStateBefore(cm, lineNo(line)));\n    return line.styles;\n  }\n\n  // Lightweight form of highlight -- proceed over this line and\n  // update state, but don't save a style array.\n  function processLine(cm, line, state) {\n    var mode = cm.doc.mode;\n    var stream = new StringStream(line.text, cm.options.tabSize);\n    if (line.text == \"\" && mode.blankLine) mode.blankLine(state);\n    while (!stream.eol() && stream.pos <= cm.options.maxHighlightLength) {\n      mode.token(stream, state);\n      stream.start = stream.pos;\n    }\n  }\n\n  var styleToClassCache = {};\n  function styleToClass(style) {\n    if (!style) return null;\n    return styleToClassCache[style] ||\n      (styleToClassCache[style] = \"cm-\" + style.replace(/ +/g, \" cm-\"));\n  }\n\n  function lineContent(cm, realLine, measure, copyWidgets) {\n    var merged, line = realLine, empty = true;\n    while (merged = collapsedSpanAtStart(line))\n      line = getLine(cm.doc, merged.find().from.line);\n\n    var builder = {pre: elt(\"pre\"), col: 0, pos: 0,\n                   measure: null, measuredSomething: false, cm: cm,\n                   copyWidgets: copyWidgets};\n    if (line.textClass) builder.pre.className = line.textClass;\n\n    do {\n      if (line.text) empty = false;\n      builder.measure = line == realLine && measure;\n      builder.pos = 0;\n      builder.addToken = builder.measure ? buildTokenMeasure : buildToken;\n      if ((ie || webkit) && cm.getOption(\"lineWrapping\"))\n        builder.addToken = buildTokenSplitSpaces(builder.addToken);\n      var next = insertLineContent(line, builder, getLineStyles(cm, line));\n      if (measure && line == realLine && !builder.measuredSomething) {\n        measure[0] = builder.pre.appendChild(zeroWidthElement(cm.display.measure));\n        builder.measuredSomething = true;\n      }\n      if (next) line = getLine(cm.doc, next.to.line);\n    } while (next);\n\n    if (measure && !builder.measuredSomething && !measure[0])\n      measure[0] = builder.pre.appendChild(empty ? elt(\"span\", \"\\u00a0\") : zeroWidthElement(cm.display.measure));\n    if (!builder.pre.firstChild && !lineIsHidden(cm.doc, realLine))\n      builder.pre.appendChild(document.createTextNode(\"\\u00a0\"));\n\n    var order;\n    // Work around problem with the reported dimensions of single-char\n    // direction spans on IE (issue #1129). See also the comment in\n    // cursorCoords.\n    if (measure && ie && (order = getOrder(line))) {\n      var l = order.length - 1;\n      if (order[l].from == order[l].to) --l;\n      var last = order[l], prev = order[l - 1];\n      if (last.from + 1 == last.to && prev && last.level < prev.level) {\n        var span = measure[builder.pos - 1];\n        if (span) span.parentNode.insertBefore(span.measureRight = zeroWidthElement(cm.display.measure),\n                                               span.nextSibling);\n      }\n    }\n\n    signal(cm, \"renderLine\", cm, realLine, builder.pre);\n    return builder.pre;\n  }\n\n  var tokenSpecialChars = /[\\t\\u0000-\\u0019\\u00ad\\u200b\\u2028\\u2029\\uFEFF]/g;\n  function buildToken(builder, text, style, startStyle, endStyle, title) {\n    if (!text) return;\n    if (!tokenSpecialChars.test(text)) {\n      builder.col += text.length;\n      var content = document.createTextNode(text);\n    } else {\n      var content = document.createDocumentFragment(), pos = 0;\n      while (true) {\n        tokenSpecialChars.lastIndex = pos;\n        var m = tokenSpecialChars.exec(text);\n        var skipped = m ? m.index - pos : text.length - pos;\n        if (skipped) {\n          content.appendChild(document.createTextNode(text.slice(pos, pos + skipped)));\n          builder.col += skipped;\n        }\n        if (!m) break;\n        pos += skipped + 1;\n        if (m[0] == \"\\t\") {\n          var tabSize = builder.cm.options.tabSize, tabWidth = tabSize - builder.col % tabSize;\n          content.appendChild(elt(\"span\", spaceStr(tabWidth), \"cm-tab\"));\n          builder.col += tabWidth;\n        } else {\n          var token = elt(\"span\", \"\\u2022\", \"cm-invalidchar\");\n          token.title = \"\\\\u\" + m[0].charCodeAt(0).toString(16);\n          content.appendChild(token);\n          builder.col += 1;\n        }\n      }\n    }\n    if (style || startStyle || endStyle || builder.measure) {\n      var fullStyle = style || \"\";\n      if (startStyle) fullStyle += startStyle;\n      if (endStyle) fullStyle += endStyle;\n      var token = elt(\"span\", [content], fullStyle);\n      if (title) token.title = title;\n      return builder.pre.appendChild(token);\n    }\n    builder.pre.appendChild(content);\n  }\n\n  function buildTokenMeasure(builder, text, style, startStyle, endStyle) {\n    var wrapping = builder.cm.options.lineWrapping;\n    for (var i = 0; i < text.length; ++i) {\n      var ch = text.charAt(i), start = i == 0;\n      if (ch >= \"\\ud800\" && ch < \"\\udbff\" && i < text.length - 1) {\n        ch = text.slice(i, i + 2);\n        ++i;\n      } else if (i && wrapping && spanAffectsWrapping(text, i)) {\n        builder.pre.appendChild(elt(\"wbr\"));\n      }\n      var old = builder.measure[builder.pos];\n      var span = builder.measure[builder.pos] =\n        buildToken(builder, ch, style,\n                   start && startStyle, i == text.length - 1 && endStyle);\n      if (old) span.leftSide = old.leftSide || old;\n      // In IE single-space nodes wrap differently than spaces\n      // embedded in larger text nodes, except when set to\n      // white-space: normal (issue #1268).\n      if (ie && wrapping && ch == \" \" && i && !/\\s/.test(text.charAt(i - 1)) &&\n          i < text.length - 1 && !/\\s/.test(text.charAt(i + 1)))\n        span.style.whiteSpace = \"normal\";\n      builder.pos += ch.length;\n    }\n    if (text.length) builder.measuredSomething = true;\n  }\n\n  function buildTokenSplitSpaces(inner) {\n    function split(old) {\n      var out = \" \";\n      for (var i = 0; i < old.length - 2; ++i) out += i % 2 ? \" \" : \"\\u00a0\";\n      out += \" \";\n      return out;\n    }\n    return function(builder, text, style, startStyle, endStyle, title) {\n      return inner(builder, text.replace(/ {3,}/, split), style, startStyle, endStyle, title);\n    };\n  }\n\n  function buildCollapsedSpan(builder, size, marker, ignoreWidget) {\n    var widget = !ignoreWidget && marker.replacedWith;\n    if (widget) {\n      if (builder.copyWidgets) widget = widget.cloneNode(true);\n      builder.pre.appendChild(widget);\n      if (builder.measure) {\n        if (size) {\n          builder.measure[builder.pos] = widget;\n        } else {\n          var elt = zeroWidthElement(builder.cm.display.measure);\n          if (marker.type == \"bookmark\" && !marker.insertLeft)\n            builder.measure[builder.pos] = builder.pre.appendChild(elt);\n          else if (builder.measure[builder.pos])\n            return;\n          else\n            builder.measure[builder.pos] = builder.pre.insertBefore(elt, widget);\n        }\n        builder.measuredSomething = true;\n      }\n    }\n    builder.pos += size;\n  }\n\n  // Outputs a number of spans to make up a line, taking highlighting\n  // and marked text into account.\n  function insertLineContent(line, builder, styles) {\n    var spans = line.markedSpans, allText = line.text, at = 0;\n    if (!spans) {\n      for (var i = 1; i < styles.length; i+=2)\n        builder.addToken(builder, allText.slice(at, at = styles[i]), styleToClass(styles[i+1]));\n      return;\n    }\n\n    var len = allText.length, pos = 0, i = 1, text = \"\", style;\n    var nextChange = 0, spanStyle, spanEndStyle, spanStartStyle, title, collapsed;\n    for (;;) {\n      if (nextChange == pos) { // Update current marker set\n        spanStyle = spanEndStyle = spanStartStyle = title = \"\";\n        collapsed = null; nextChange = Infinity;\n        var foundBookmarks = [];\n        for (var j = 0; j < spans.length; ++j) {\n          var sp = spans[j], m = sp.marker;\n          if (sp.from <= pos && (sp.to == null || sp.to > pos)) {\n            if (sp.to != null && nextChange > sp.to) { nextChange = sp.to; spanEndStyle = \"\"; }\n            if (m.className) spanStyle += \" \" + m.className;\n            if (m.startStyle && sp.from == pos) spanStartStyle += \" \" + m.startStyle;\n            if (m.endStyle && sp.to == nextChange) spanEndStyle += \" \" + m.endStyle;\n            if (m.title && !title) title = m.title;\n            if (m.collapsed && (!collapsed || collapsed.marker.size < m.size))\n              collapsed = sp;\n          } else if (sp.from > pos && nextChange > sp.from) {\n            nextChange = sp.from;\n          }\n          if (m.type == \"bookmark\" && sp.from == pos && m.replacedWith) foundBookmarks.push(m);\n        }\n        if (collapsed && (collapsed.from || 0) == pos) {\n          buildCollapsedSpan(builder, (collapsed.to == null ? len : collapsed.to) - pos,\n                             collapsed.marker, collapsed.from == null);\n          if (collapsed.to == null) return collapsed.marker.find();\n        }\n        if (!collapsed && foundBookmarks.length) for (var j = 0; j < foundBookmarks.length; ++j)\n          buildCollapsedSpan(builder, 0, foundBookmarks[j]);\n      }\n      if (pos >= len) break;\n\n      var upto = Math.min(len, nextChange);\n      while (true) {\n        if (text) {\n          var end = pos + text.length;\n          if (!collapsed) {\n            var tokenText = end > upto ? text.slice(0, upto - pos) : text;\n            builder.addToken(builder, tokenText, style ? style + spanStyle : spanStyle,\n                             spanStartStyle, pos + tokenText.length == nextChange ? spanEndStyle : \"\", title);\n          }\n          if (end >= upto) {text = text.slice(upto - pos); pos = upto; break;}\n          pos = end;\n          spanStartStyle = \"\";\n        }\n        text = allText.slice(at, at = styles[i++]);\n        style = styleToClass(styles[i++]);\n      }\n    }\n  }\n\n  // DOCUMENT DATA STRUCTURE\n\n  function updateDoc(doc, change, markedSpans, selAfter, estimateHeight) {\n    function spansFor(n) {return markedSpans ? markedSpans[n] : null;}\n    function update(line, text, spans) {\n      updateLine(line, text, spans, estimateHeight);\n      signalLater(line, \"change\", line, change);\n    }\n\n    var from = change.from, to = change.to, text = change.text;\n    var firstLine = getLine(doc, from.line), lastLine = getLine(doc, to.line);\n    var lastText = lst(text), lastSpans = spansFor(text.length - 1), nlines = to.line - from.line;\n\n    // First adjust the line structure\n    if (from.ch == 0 && to.ch == 0 && lastText == \"\") {\n      // This is a whole-line replace. Treated specially to make\n      // sure line objects move the way they are supposed to.\n      for (var i = 0, e = text.length - 1, added = []; i < e; ++i)\n        added.push(new Line(text[i], spansFor(i), estimateHeight));\n      update(lastLine, lastLine.text, lastSpans);\n      if (nlines) doc.remove(from.line, nlines);\n      if (added.length) doc.insert(from.line, added);\n    } else if (firstLine == lastLine) {\n      if (text.length == 1) {\n        update(firstLine, firstLine.text.slice(0, from.ch) + lastText + firstLine.text.slice(to.ch), lastSpans);\n      } else {\n        for (var added = [], i = 1, e = text.length - 1; i < e; ++i)\n          added.push(new Line(text[i], spansFor(i), estimateHeight));\n        added.push(new Line(lastText + firstLine.text.slice(to.ch), lastSpans, estimateHeight));\n        update(firstLine, firstLine.text.slice(0, from.ch) + text[0], spansFor(0));\n        doc.insert(from.line + 1, added);\n      }\n    } else if (text.length == 1) {\n      update(firstLine, firstLine.text.slice(0, from.ch) + text[0] + lastLine.text.slice(to.ch), spansFor(0));\n      doc.remove(from.line + 1, nlines);\n    } else {\n      update(firstLine, firstLine.text.slice(0, from.ch) + text[0], spansFor(0));\n      update(lastLine, lastText + lastLine.text.slice(to.ch), lastSpans);\n      for (var i = 1, e = text.length - 1, added = []; i < e; ++i)\n        added.push(new Line(text[i], spansFor(i), estimateHeight));\n      if (nlines > 1) doc.remove(from.line + 1, nlines - 1);\n      doc.insert(from.line + 1, added);\n    }\n\n    signalLater(doc, \"change\", doc, change);\n    setSelection(doc, selAfter.anchor, selAfter.head, null, true);\n  }\n\n  function LeafChunk(lines) {\n    this.lines = lines;\n    this.parent = null;\n    for (var i = 0, e = lines.length, height = 0; i < e; ++i) {\n      lines[i].parent = this;\n      height += lines[i].height;\n    }\n    this.height = height;\n  }\n\n  LeafChunk.prototype = {\n    chunkSize: function() { return this.lines.length; },\n    removeInner: function(at, n) {\n      for (var i = at, e = at + n; i < e; ++i) {\n        var line = this.lines[i];\n        this.height -= line.height;\n        cleanUpLine(line);\n        signalLater(line, \"delete\");\n      }\n      this.lines.splice(at, n);\n    },\n    collapse: function(lines) {\n      lines.splice.apply(lines, [lines.length, 0].concat(this.lines));\n    },\n    insertInner: function(at, lines, height) {\n      this.height += height;\n      this.lines = this.lines.slice(0, at).concat(lines).concat(this.lines.slice(at));\n      for (var i = 0, e = lines.length; i < e; ++i) lines[i].parent = this;\n    },\n    iterN: function(at, n, op) {\n      for (var e = at + n; at < e; ++at)\n        if (op(this.lines[at])) return true;\n    }\n  };\n\n  function BranchChunk(children) {\n    this.children = children;\n    var size = 0, height = 0;\n    for (var i = 0, e = children.length; i < e; ++i) {\n      var ch = children[i];\n      size += ch.chunkSize(); height += ch.height;\n      ch.parent = this;\n    }\n    this.size = size;\n    this.height = height;\n    this.parent = null;\n  }\n\n  BranchChunk.prototype = {\n    chunkSize: function() { return this.size; },\n    removeInner: function(at, n) {\n      this.size -= n;\n      for (var i = 0; i < this.children.length; ++i) {\n        var child = this.children[i], sz = child.chunkSize();\n        if (at < sz) {\n          var rm = Math.min(n, sz - at), oldHeight = child.height;\n          child.removeInner(at, rm);\n          this.height -= oldHeight - child.height;\n          if (sz == rm) { this.children.splice(i--, 1); child.parent = null; }\n          if ((n -= rm) == 0) break;\n          at = 0;\n        } else at -= sz;\n      }\n      if (this.size - n < 25) {\n        var lines = [];\n        this.collapse(lines);\n        this.children = [new LeafChunk(lines)];\n        this.children[0].parent = this;\n      }\n    },\n    collapse: function(lines) {\n      for (var i = 0, e = this.children.length; i < e; ++i) this.children[i].collapse(lines);\n    },\n    insertInner: function(at, lines, height) {\n      this.size += lines.length;\n      this.height += height;\n      for (var i = 0, e = this.children.length; i < e; ++i) {\n        var child = this.children[i], sz = child.chunkSize();\n        if (at <= sz) {\n          child.insertInner(at, lines, height);\n          if (child.lines && child.lines.length > 50) {\n            while (child.lines.length > 50) {\n              var spilled = child.lines.splice(child.lines.length - 25, 25);\n              var newleaf = new LeafChunk(spilled);\n              child.height -= newleaf.height;\n              this.children.splice(i + 1, 0, newleaf);\n              newleaf.parent = this;\n            }\n            this.maybeSpill();\n          }\n          break;\n        }\n        at -= sz;\n      }\n    },\n    maybeSpill: function() {\n      if (this.children.length <= 10) return;\n      var me = this;\n      do {\n        var spilled = me.children.splice(me.children.length - 5, 5);\n        var sibling = new BranchChunk(spilled);\n        if (!me.parent) { // Become the parent node\n          var copy = new BranchChunk(me.children);\n          copy.parent = me;\n          me.children = [copy, sibling];\n          me = copy;\n        } else {\n          me.size -= sibling.size;\n          me.height -= sibling.height;\n          var myIndex = indexOf(me.parent.children, me);\n          me.parent.children.splice(myIndex + 1, 0, sibling);\n        }\n        sibling.parent = me.parent;\n      } while (me.children.length > 10);\n      me.parent.maybeSpill();\n    },\n    iterN: function(at, n, op) {\n      for (var i = 0, e = this.children.length; i < e; ++i) {\n        var child = this.children[i], sz = child.chunkSize();\n        if (at < sz) {\n          var used = Math.min(n, sz - at);\n          if (child.iterN(at, used, op)) return true;\n          if ((n -= used) == 0) break;\n          at = 0;\n        } else at -= sz;\n      }\n    }\n  };\n\n  var nextDocId = 0;\n  var Doc = CodeMirror.Doc = function(text, mode, firstLine) {\n    if (!(this instanceof Doc)) return new Doc(text, mode, firstLine);\n    if (firstLine == null) firstLine = 0;\n\n    BranchChunk.call(this, [new LeafChunk([new Line(\"\", null)])]);\n    this.first = firstLine;\n    this.scrollTop = this.scrollLeft = 0;\n    this.cantEdit = false;\n    this.history = makeHistory();\n    this.cleanGeneration = 1;\n    this.frontier = firstLine;\n    var start = Pos(firstLine, 0);\n    this.sel = {from: start, to: start, head: start, anchor: start, shift: false, extend: false, goalColumn: null};\n    this.id = ++nextDocId;\n    this.modeOption = mode;\n\n    if (typeof text == \"string\") text = splitLines(text);\n    updateDoc(this, {from: start, to: start, text: text}, null, {head: start, anchor: start});\n  };\n\n  Doc.prototype = createObj(BranchChunk.prototype, {\n    constructor: Doc,\n    iter: function(from, to, op) {\n      if (op) this.iterN(from - this.first, to - from, op);\n      else this.iterN(this.first, this.first + this.size, from);\n    },\n\n    insert: function(at, lines) {\n      var height = 0;\n      for (var i = 0, e = lines.length; i < e; ++i) height += lines[i].height;\n      this.insertInner(at - this.first, lines, height);\n    },\n    remove: function(at, n) { this.removeInner(at - this.first, n); },\n\n    getValue: function(lineSep) {\n      var lines = getLines(this, this.first, this.first + this.size);\n      if (lineSep === false) return lines;\n      return lines.join(lineSep || \"\\n\");\n    },\n    setValue: function(code) {\n      var top = Pos(this.first, 0), last = this.first + this.size - 1;\n      makeChange(this, {from: top, to: Pos(last, getLine(this, last).text.length),\n                        text: splitLines(code), origin: \"setValue\"},\n                 {head: top, anchor: top}, true);\n    },\n    replaceRange: function(code, from, to, origin) {\n      from = clipPos(this, from);\n      to = to ? clipPos(this, to) : from;\n      replaceRange(this, code, from, to, origin);\n    },\n    getRange: function(from, to, lineSep) {\n      var lines = getBetween(this, clipPos(this, from), clipPos(this, to));\n      if (lineSep === false) return lines;\n      return lines.join(lineSep || \"\\n\");\n    },\n\n    getLine: function(line) {var l = this.getLineHandle(line); return l && l.text;},\n    setLine: function(line, text) {\n      if (isLine(this, line))\n        replaceRange(this, text, Pos(line, 0), clipPos(this, Pos(line)));\n    },\n    removeLine: function(line) {\n      if (line) replaceRange(this, \"\", clipPos(this, Pos(line - 1)), clipPos(this, Pos(line)));\n      else replaceRange(this, \"\", Pos(0, 0), clipPos(this, Pos(1, 0)));\n    },\n\n    getLineHandle: function(line) {if (isLine(this, line)) return getLine(this, line);},\n    getLineNumber: function(line) {return lineNo(line);},\n\n    getLineHandleVisualStart: function(line) {\n      if (typeof line == \"number\") line = getLine(this, line);\n      return visualLine(this, line);\n    },\n\n    lineCount: function() {return this.size;},\n    firstLine: function() {return this.first;},\n    lastLine: function() {return this.first + this.size - 1;},\n\n    clipPos: function(pos) {return clipPos(this, pos);},\n\n    getCursor: function(start) {\n      var sel = this.sel, pos;\n      if (start == null || start == \"head\") pos = sel.head;\n      else if (start == \"anchor\") pos = sel.anchor;\n      else if (start == \"end\" || start === false) pos = sel.to;\n      else pos = sel.from;\n      return copyPos(pos);\n    },\n    somethingSelected: function() {return !posEq(this.sel.head, this.sel.anchor);},\n\n    setCursor: docOperation(function(line, ch, extend) {\n      var pos = clipPos(this, typeof line == \"number\" ? Pos(line, ch || 0) : line);\n      if (extend) extendSelection(this, pos);\n      else setSelection(this, pos, pos);\n    }),\n    setSelection: docOperation(function(anchor, head, bias) {\n      setSelection(this, clipPos(this, anchor), clipPos(this, head || anchor), bias);\n    }),\n    extendSelection: docOperation(function(from, to, bias) {\n      extendSelection(this, clipPos(this, from), to && clipPos(this, to), bias);\n    }),\n\n    getSelection: function(lineSep) {return this.getRange(this.sel.from, this.sel.to, lineSep);},\n    replaceSelection: function(code, collapse, origin) {\n      makeChange(this, {from: this.sel.from, to: this.sel.to, text: splitLines(code), origin: origin}, collapse || \"around\");\n    },\n    undo: docOperation(function() {makeChangeFromHistory(this, \"undo\");}),\n    redo: docOperation(function() {makeChangeFromHistory(this, \"redo\");}),\n\n    setExtending: function(val) {this.sel.extend = val;},\n\n    historySize: function() {\n      var hist = this.history;\n      return {undo: hist.done.length, redo: hist.undone.length};\n    },\n    clearHistory: function() {this.history = makeHistory(this.history.maxGeneration);},\n\n    markClean: function() {\n      this.cleanGeneration = this.changeGeneration();\n    },\n    changeGeneration: function() {\n      this.history.lastOp = this.history.lastOrigin = null;\n      return this.history.generation;\n    },\n    isClean: function (gen) {\n      return this.history.generation == (gen || this.cleanGeneration);\n    },\n\n    getHistory: function() {\n      return {done: copyHistoryArray(this.history.done),\n              undone: copyHistoryArray(this.history.undone)};\n    },\n    setHistory: function(histData) {\n      var hist = this.history = makeHistory(this.history.maxGeneration);\n      hist.done = histData.done.slice(0);\n      hist.undone = histData.undone.slice(0);\n    },\n\n    markText: function(from, to, options) {\n      return markText(this, clipPos(this, from), clipPos(this, to), options, \"range\");\n    },\n    setBookmark: function(pos, options) {\n      var realOpts = {replacedWith: options && (options.nodeType == null ? options.widget : options),\n                      insertLeft: options && options.insertLeft};\n      pos = clipPos(this, pos);\n      return markText(this, pos, pos, realOpts, \"bookmark\");\n    },\n    findMarksAt: function(pos) {\n      pos = clipPos(this, pos);\n      var markers = [], spans = getLine(this, pos.line).markedSpans;\n      if (spans) for (var i = 0; i < spans.length; ++i) {\n        var span = spans[i];\n        if ((span.from == null || span.from <= pos.ch) &&\n            (span.to == null || span.to >= pos.ch))\n          markers.push(span.marker.parent || span.marker);\n      }\n      return markers;\n    },\n    getAllMarks: function() {\n      var markers = [];\n      this.iter(function(line) {\n        var sps = line.markedSpans;\n        if (sps) for (var i = 0; i < sps.length; ++i)\n          if (sps[i].from != null) markers.push(sps[i].marker);\n      });\n      return markers;\n    },\n\n    posFromIndex: function(off) {\n      var ch, lineNo = this.first;\n      this.iter(function(line) {\n        var sz = line.text.length + 1;\n        if (sz > off) { ch = off; return true; }\n        off -= sz;\n        ++lineNo;\n      });\n      return clipPos(this, Pos(lineNo, ch));\n    },\n    indexFromPos: function (coords) {\n      coords = clipPos(this, coords);\n      var index = coords.ch;\n      if (coords.line < this.first || coords.ch < 0) return 0;\n      this.iter(this.first, coords.line, function (line) {\n        index += line.text.length + 1;\n      });\n      return index;\n    },\n\n    copy: function(copyHistory) {\n      var doc = new Doc(getLines(this, this.first, this.first + this.size), this.modeOption, this.first);\n      doc.scrollTop = this.scrollTop; doc.scrollLeft = this.scrollLeft;\n      doc.sel = {from: this.sel.from, to: this.sel.to, head: this.sel.head, anchor: this.sel.anchor,\n                 shift: this.sel.shift, extend: false, goalColumn: this.sel.goalColumn};\n      if (copyHistory) {\n        doc.history.undoDepth = this.history.undoDepth;\n        doc.setHistory(this.getHistory());\n      }\n      return doc;\n    },\n\n    linkedDoc: function(options) {\n      if (!options) options = {};\n      var from = this.first, to = this.first + this.size;\n      if (options.from != null && options.from > from) from = options.from;\n      if (options.to != null && options.to < to) to = options.to;\n      var copy = new Doc(getLines(this, from, to), options.mode || this.modeOption, from);\n      if (options.sharedHist) copy.history = this.history;\n      (this.linked || (this.linked = [])).push({doc: copy, sharedHist: options.sharedHist});\n      copy.linked = [{doc: this, isParent: true, sharedHist: options.sharedHist}];\n      return copy;\n    },\n    unlinkDoc: function(other) {\n      if (other instanceof CodeMirror) other = other.doc;\n      if (this.linked) for (var i = 0; i < this.linked.length; ++i) {\n        var link = this.linked[i];\n        if (link.doc != other) continue;\n        this.linked.splice(i, 1);\n        other.unlinkDoc(this);\n        break;\n      }\n      // If the histories were shared, split them again\n      if (other.history == this.history) {\n        var splitIds = [other.id];\n        linkedDocs(other, function(doc) {splitIds.push(doc.id);}, true);\n        other.history = makeHistory();\n        other.history.done = copyHistoryArray(this.history.done, splitIds);\n        other.history.undone = copyHistoryArray(this.history.undone, splitIds);\n      }\n    },\n    iterLinkedDocs: function(f) {linkedDocs(this, f);},\n\n    getMode: function() {return this.mode;},\n    getEditor: function() {return this.cm;}\n  });\n\n  Doc.prototype.eachLine = Doc.prototype.iter;\n\n  // The Doc methods that should be available on CodeMirror instances\n  var dontDelegate = \"iter insert remove copy getEditor\".split(\" \");\n  for (var prop in Doc.prototype) if (Doc.prototype.hasOwnProperty(prop) && indexOf(dontDelegate, prop) < 0)\n    CodeMirror.prototype[prop] = (function(method) {\n      return function() {return method.apply(this.doc, arguments);};\n    })(Doc.prototype[prop]);\n\n  eventMixin(Doc);\n\n  function linkedDocs(doc, f, sharedHistOnly) {\n    function propagate(doc, skip, sharedHist) {\n      if (doc.linked) for (var i = 0; i < doc.linked.length; ++i) {\n        var rel = doc.linked[i];\n        if (rel.doc == skip) continue;\n        var shared = sharedHist && rel.sharedHist;\n        if (sharedHistOnly && !shared) continue;\n        f(rel.doc, shared);\n        propagate(rel.doc, doc, shared);\n      }\n    }\n    propagate(doc, null, true);\n  }\n\n  function attachDoc(cm, doc) {\n    if (doc.cm) throw new Error(\"This document is already in use.\");\n    cm.doc = doc;\n    doc.cm = cm;\n    estimateLineHeights(cm);\n    loadMode(cm);\n    if (!cm.options.lineWrapping) computeMaxLength(cm);\n    cm.options.mode = doc.modeOption;\n    regChange(cm);\n  }\n\n  // LINE UTILITIES\n\n  function getLine(chunk, n) {\n    n -= chunk.first;\n    while (!chunk.lines) {\n      for (var i = 0;; ++i) {\n        var child = chunk.children[i], sz = child.chunkSize();\n        if (n < sz) { chunk = child; break; }\n        n -= sz;\n      }\n    }\n    return chunk.lines[n];\n  }\n\n  function getBetween(doc, start, end) {\n    var out = [], n = start.line;\n    doc.iter(start.line, end.line + 1, function(line) {\n      var text = line.text;\n      if (n == end.line) text = text.slice(0, end.ch);\n      if (n == start.line) text = text.slice(start.ch);\n      out.push(text);\n      ++n;\n    });\n    return out;\n  }\n  function getLines(doc, from, to) {\n    var out = [];\n    doc.iter(from, to, function(line) { out.push(line.text); });\n    return out;\n  }\n\n  function updateLineHeight(line, height) {\n    var diff = height - line.height;\n    for (var n = line; n; n = n.parent) n.height += diff;\n  }\n\n  function lineNo(line) {\n    if (line.parent == null) return null;\n    var cur = line.parent, no = indexOf(cur.lines, line);\n    for (var chunk = cur.parent; chunk; cur = chunk, chunk = chunk.parent) {\n      for (var i = 0;; ++i) {\n        if (chunk.children[i] == cur) break;\n        no += chunk.children[i].chunkSize();\n      }\n    }\n    return no + cur.first;\n  }\n\n  function lineAtHeight(chunk, h) {\n    var n = chunk.first;\n    outer: do {\n      for (var i = 0, e = chunk.children.length; i < e; ++i) {\n        var child = chunk.children[i], ch = child.height;\n        if (h < ch) { chunk = child; continue outer; }\n        h -= ch;\n        n += child.chunkSize();\n      }\n      return n;\n    } while (!chunk.lines);\n    for (var i = 0, e = chunk.lines.length; i < e; ++i) {\n      var line = chunk.lines[i], lh = line.height;\n      if (h < lh) break;\n      h -= lh;\n    }\n    return n + i;\n  }\n\n  function heightAtLine(cm, lineObj) {\n    lineObj = visualLine(cm.doc, lineObj);\n\n    var h = 0, chunk = lineObj.parent;\n    for (var i = 0; i < chunk.lines.length; ++i) {\n      var line = chunk.lines[i];\n      if (line == lineObj) break;\n      else h += line.height;\n    }\n    for (var p = chunk.parent; p; chunk = p, p = chunk.parent) {\n      for (var i = 0; i < p.children.length; ++i) {\n        var cur = p.children[i];\n        if (cur == chunk) break;\n        else h += cur.height;\n      }\n    }\n    return h;\n  }\n\n  function getOrder(line) {\n    var order = line.order;\n    if (order == null) order = line.order = bidiOrdering(line.text);\n    return order;\n  }\n\n  // HISTORY\n\n  function makeHistory(startGen) {\n    return {\n      // Arrays of history events. Doing something adds an event to\n      // done and clears undo. Undoing moves events from done to\n      // undone, redoing moves them in the other direction.\n      done: [], undone: [], undoDepth: Infinity,\n      // Used to track when changes can be merged into a single undo\n      // event\n      lastTime: 0, lastOp: null, lastOrigin: null,\n      // Used by the isClean() method\n      generation: startGen || 1, maxGeneration: startGen || 1\n    };\n  }\n\n  function attachLocalSpans(doc, change, from, to) {\n    var existing = change[\"spans_\" + doc.id], n = 0;\n    doc.iter(Math.max(doc.first, from), Math.min(doc.first + doc.size, to), function(line) {\n      if (line.markedSpans)\n        (existing || (existing = change[\"spans_\" + doc.id] = {}))[n] = line.markedSpans;\n      ++n;\n    });\n  }\n\n  function historyChangeFromChange(doc, change) {\n    var from = { line: change.from.line, ch: change.from.ch };\n    var histChange = {from: from, to: changeEnd(change), text: getBetween(doc, change.from, change.to)};\n    attachLocalSpans(doc, histChange, change.from.line, change.to.line + 1);\n    linkedDocs(doc, function(doc) {attachLocalSpans(doc, histChange, change.from.line, change.to.line + 1);}, true);\n    return histChange;\n  }\n\n  function addToHistory(doc, change, selAfter, opId) {\n    var hist = doc.history;\n    hist.undone.length = 0;\n    var time = +new Date, cur = lst(hist.done);\n\n    if (cur &&\n        (hist.lastOp == opId ||\n         hist.lastOrigin == change.origin && change.origin &&\n         ((change.origin.charAt(0) == \"+\" && doc.cm && hist.lastTime > time - doc.cm.options.historyEventDelay) ||\n          change.origin.charAt(0) == \"*\"))) {\n      // Merge this change into the last event\n      var last = lst(cur.changes);\n      if (posEq(change.from, change.to) && posEq(change.from, last.to)) {\n        // Optimized case for simple insertion -- don't want to add\n        // new changesets for every character typed\n        last.to = changeEnd(change);\n      } else {\n        // Add new sub-event\n        cur.changes.push(historyChangeFromChange(doc, change));\n      }\n      cur.anchorAfter = selAfter.anchor; cur.headAfter = selAfter.head;\n    } else {\n      // Can not be merged, start a new event.\n      cur = {changes: [historyChangeFromChange(doc, change)],\n             generation: hist.generation,\n             anchorBefore: doc.sel.anchor, headBefore: doc.sel.head,\n             anchorAfter: selAfter.anchor, headAfter: selAfter.head};\n      hist.done.push(cur);\n      hist.generation = ++hist.maxGeneration;\n      while (hist.done.length > hist.undoDepth)\n        hist.done.shift();\n    }\n    hist.lastTime = time;\n    hist.lastOp = opId;\n    hist.lastOrigin = change.origin;\n  }\n\n  function removeClearedSpans(spans) {\n    if (!spans) return null;\n    for (var i = 0, out; i < spans.length; ++i) {\n      if (spans[i].marker.explicitlyCleared) { if (!out) out = spans.slice(0, i); }\n      else if (out) out.push(spans[i]);\n    }\n    return !out ? spans : out.length ? out : null;\n  }\n\n  function getOldSpans(doc, change) {\n    var found = change[\"spans_\" + doc.id];\n    if (!found) return null;\n    for (var i = 0, nw = []; i < change.text.length; ++i)\n      nw.push(removeClearedSpans(found[i]));\n    return nw;\n  }\n\n  // Used both to provide a JSON-safe object in .getHistory, and, when\n  // detaching a document, to split the history in two\n  function copyHistoryArray(events, newGroup) {\n    for (var i = 0, copy = []; i < events.length; ++i) {\n      var event = events[i], changes = event.changes, newChanges = [];\n      copy.push({changes: newChanges, anchorBefore: event.anchorBefore, headBefore: event.headBefore,\n                 anchorAfter: event.anchorAfter, headAfter: event.headAfter});\n      for (var j = 0; j < changes.length; ++j) {\n        var change = changes[j], m;\n        newChanges.push({from: change.from, to: change.to, text: change.text});\n        if (newGroup) for (var prop in change) if (m = prop.match(/^spans_(\\d+)$/)) {\n          if (indexOf(newGroup, Number(m[1])) > -1) {\n            lst(newChanges)[prop] = change[prop];\n            delete change[prop];\n          }\n        }\n      }\n    }\n    return copy;\n  }\n\n  // Rebasing/resetting history to deal with externally-sourced changes\n\n  function rebaseHistSel(pos, from, to, diff) {\n    if (to < pos.line) {\n      pos.line += diff;\n    } else if (from < pos.line) {\n      pos.line = from;\n      pos.ch = 0;\n    }\n  }\n\n  // Tries to rebase an array of history events given a change in the\n  // document. If the change touches the same lines as the event, the\n  // event, and everything 'behind' it, is discarded. If the change is\n  // before the event, the event's positions are updated. Uses a\n  // copy-on-write scheme for the positions, to avoid having to\n  // reallocate them all on every rebase, but also avoid problems with\n  // shared position objects being unsafely updated.\n  function rebaseHistArray(array, from, to, diff) {\n    for (var i = 0; i < array.length; ++i) {\n      var sub = array[i], ok = true;\n      for (var j = 0; j < sub.changes.length; ++j) {\n        var cur = sub.changes[j];\n        if (!sub.copied) { cur.from = copyPos(cur.from); cur.to = copyPos(cur.to); }\n        if (to < cur.from.line) {\n          cur.from.line += diff;\n          cur.to.line += diff;\n        } else if (from <= cur.to.line) {\n          ok = false;\n          break;\n        }\n      }\n      if (!sub.copied) {\n        sub.anchorBefore = copyPos(sub.anchorBefore); sub.headBefore = copyPos(sub.headBefore);\n        sub.anchorAfter = copyPos(sub.anchorAfter); sub.readAfter = copyPos(sub.headAfter);\n        sub.copied = true;\n      }\n      if (!ok) {\n        array.splice(0, i + 1);\n        i = 0;\n      } else {\n        rebaseHistSel(sub.anchorBefore); rebaseHistSel(sub.headBefore);\n        rebaseHistSel(sub.anchorAfter); rebaseHistSel(sub.headAfter);\n      }\n    }\n  }\n\n  function rebaseHist(hist, change) {\n    var from = change.from.line, to = change.to.line, diff = change.text.length - (to - from) - 1;\n    rebaseHistArray(hist.done, from, to, diff);\n    rebaseHistArray(hist.undone, from, to, diff);\n  }\n\n  // EVENT OPERATORS\n\n  function stopMethod() {e_stop(this);}\n  // Ensure an event has a stop method.\n  function addStop(event) {\n    if (!event.stop) event.stop = stopMethod;\n    return event;\n  }\n\n  function e_preventDefault(e) {\n    if (e.preventDefault) e.preventDefault();\n    else e.returnValue = false;\n  }\n  function e_stopPropagation(e) {\n    if (e.stopPropagation) e.stopPropagation();\n    else e.cancelBubble = true;\n  }\n  function e_defaultPrevented(e) {\n    return e.defaultPrevented != null ? e.defaultPrevented : e.returnValue == false;\n  }\n  function e_stop(e) {e_preventDefault(e); e_stopPropagation(e);}\n  CodeMirror.e_stop = e_stop;\n  CodeMirror.e_preventDefault = e_preventDefault;\n  CodeMirror.e_stopPropagation = e_stopPropagation;\n\n  function e_target(e) {return e.target || e.srcElement;}\n  function e_button(e) {\n    var b = e.which;\n    if (b == null) {\n      if (e.button & 1) b = 1;\n      else if (e.button & 2) b = 3;\n      else if (e.button & 4) b = 2;\n    }\n    if (mac && e.ctrlKey && b == 1) b = 3;\n    return b;\n  }\n\n  // EVENT HANDLING\n\n  function on(emitter, type, f) {\n    if (emitter.addEventListener)\n      emitter.addEventListener(type, f, false);\n    else if (emitter.attachEvent)\n      emitter.attachEvent(\"on\" + type, f);\n    else {\n      var map = emitter._handlers || (emitter._handlers = {});\n      var arr = map[type] || (map[type] = []);\n      arr.push(f);\n    }\n  }\n\n  function off(emitter, type, f) {\n    if (emitter.removeEventListener)\n      emitter.removeEventListener(type, f, false);\n    else if (emitter.detachEvent)\n      emitter.detachEvent(\"on\" + type, f);\n    else {\n      var arr = emitter._handlers && emitter._handlers[type];\n      if (!arr) return;\n      for (var i = 0; i < arr.length; ++i)\n        if (arr[i] == f) { arr.splice(i, 1); break; }\n    }\n  }\n\n  function signal(emitter, type /*, values...*/) {\n    var arr = emitter._handlers && emitter._handlers[type];\n    if (!arr) return;\n    var args = Array.prototype.slice.call(arguments, 2);\n    for (var i = 0; i < arr.length; ++i) arr[i].apply(null, args);\n  }\n\n  var delayedCallbacks, delayedCallbackDepth = 0;\n  function signalLater(emitter, type /*, values...*/) {\n    var arr = emitter._handlers && emitter._handlers[type];\n    if (!arr) return;\n    var args = Array.prototype.slice.call(arguments, 2);\n    if (!delayedCallbacks) {\n      ++delayedCallbackDepth;\n      delayedCallbacks = [];\n      setTimeout(fireDelayed, 0);\n    }\n    function bnd(f) {return function(){f.apply(null, args);};};\n    for (var i = 0; i < arr.length; ++i)\n      delayedCallbacks.push(bnd(arr[i]));\n  }\n\n  function signalDOMEvent(cm, e, override) {\n    signal(cm, override || e.type, cm, e);\n    return e_defaultPrevented(e) || e.codemirrorIgnore;\n  }\n\n  function fireDelayed() {\n    --delayedCallbackDepth;\n    var delayed = delayedCallbacks;\n    delayedCallbacks = null;\n    for (var i = 0; i < delayed.length; ++i) delayed[i]();\n  }\n\n  function hasHandler(emitter, type) {\n    var arr = emitter._handlers && emitter._handlers[type];\n    return arr && arr.length > 0;\n  }\n\n  CodeMirror.on = on; CodeMirror.off = off; CodeMirror.signal = signal;\n\n  function eventMixin(ctor) {\n    ctor.prototype.on = function(type, f) {on(this, type, f);};\n    ctor.prototype.off = function(type, f) {off(this, type, f);};\n  }\n\n  // MISC UTILITIES\n\n  // Number of pixels added to scroller and sizer to hide scrollbar\n  var scrollerCutOff = 30;\n\n  // Returned or thrown by various protocols to signal 'I'm not\n  // handling this'.\n  var Pass = CodeMirror.Pass = {toString: function(){return \"CodeMirror.Pass\";}};\n\n  function Delayed() {this.id = null;}\n  Delayed.prototype = {set: function(ms, f) {clearTimeout(this.id); this.id = setTimeout(f, ms);}};\n\n  // Counts the column offset in a string, taking tabs into account.\n  // Used mostly to find indentation.\n  function countColumn(string, end, tabSize, startIndex, startValue) {\n    if (end == null) {\n      end = string.search(/[^\\s\\u00a0]/);\n      if (end == -1) end = string.length;\n    }\n    for (var i = startIndex || 0, n = startValue || 0; i < end; ++i) {\n      if (string.charAt(i) == \"\\t\") n += tabSize - (n % tabSize);\n      else ++n;\n    }\n    return n;\n  }\n  CodeMirror.countColumn = countColumn;\n\n  var spaceStrs = [\"\"];\n  function spaceStr(n) {\n    while (spaceStrs.length <= n)\n      spaceStrs.push(lst(spaceStrs) + \" \");\n    return spaceStrs[n];\n  }\n\n  function lst(arr) { return arr[arr.length-1]; }\n\n  function selectInput(node) {\n    if (ios) { // Mobile Safari apparently has a bug where select() is broken.\n      node.selectionStart = 0;\n      node.selectionEnd = node.value.length;\n    } else {\n      // Suppress mysterious IE10 errors\n      try { node.select(); }\n      catch(_e) {}\n    }\n  }\n\n  function indexOf(collection, elt) {\n    if (collection.indexOf) return collection.indexOf(elt);\n    for (var i = 0, e = collection.length; i < e; ++i)\n      if (collection[i] == elt) return i;\n    return -1;\n  }\n\n  function createObj(base, props) {\n    function Obj() {}\n    Obj.prototype = base;\n    var inst = new Obj();\n    if (props) copyObj(props, inst);\n    return inst;\n  }\n\n  function copyObj(obj, target) {\n    if (!target) target = {};\n    for (var prop in obj) if (obj.hasOwnProperty(prop)) target[prop] = obj[prop];\n    return target;\n  }\n\n  function emptyArray(size) {\n    for (var a = [], i = 0; i < size; ++i) a.push(undefined);\n    return a;\n  }\n\n  function bind(f) {\n    var args = Array.prototype.slice.call(arguments, 1);\n    return function(){return f.apply(null, args);};\n  }\n\n  var nonASCIISingleCaseWordChar = /[\\u3040-\\u309f\\u30a0-\\u30ff\\u3400-\\u4db5\\u4e00-\\u9fcc\\uac00-\\ud7af]/;\n  function isWordChar(ch) {\n    return /\\w/.test(ch) || ch > \"\\x80\" &&\n      (ch.toUpperCase() != ch.toLowerCase() || nonASCIISingleCaseWordChar.test(ch));\n  }\n\n  function isEmpty(obj) {\n    for (var n in obj) if (obj.hasOwnProperty(n) && obj[n]) return false;\n    return true;\n  }\n\n  var isExtendingChar = /[\\u0300-\\u036F\\u0483-\\u0487\\u0488-\\u0489\\u0591-\\u05BD\\u05BF\\u05C1-\\u05C2\\u05C4-\\u05C5\\u05C7\\u0610-\\u061A\\u064B-\\u065F\\u0670\\u06D6-\\u06DC\\u06DF-\\u06E4\\u06E7-\\u06E8\\u06EA-\\u06ED\\uA66F\\uA670-\\uA672\\uA674-\\uA67D\\uA69F\\udc00-\\udfff]/;\n\n  // DOM UTILITIES\n\n  function elt(tag, content, className, style) {\n    var e = document.createElement(tag);\n    if (className) e.className = className;\n    if (style) e.style.cssText = style;\n    if (typeof content == \"string\") setTextContent(e, content);\n    else if (content) for (var i = 0; i < content.length; ++i) e.appendChild(content[i]);\n    return e;\n  }\n\n  function removeChildren(e) {\n    for (var count = e.childNodes.length; count > 0; --count)\n      e.removeChild(e.firstChild);\n    return e;\n  }\n\n  function removeChildrenAndAdd(parent, e) {\n    return removeChildren(parent).appendChild(e);\n  }\n\n  function setTextContent(e, str) {\n    if (ie_lt9) {\n      e.innerHTML = \"\";\n      e.appendChild(document.createTextNode(str));\n    } else e.textContent = str;\n  }\n\n  function getRect(node) {\n    return node.getBoundingClientRect();\n  }\n  CodeMirror.replaceGetRect = function(f) { getRect = f; };\n\n  // FEATURE DETECTION\n\n  // Detect drag-and-drop\n  var dragAndDrop = function() {\n    // There is *some* kind of drag-and-drop support in IE6-8, but I\n    // couldn't get it to work yet.\n    if (ie_lt9) return false;\n    var div = elt('div');\n    return \"draggable\" in div || \"dragDrop\" in div;\n  }();\n\n  // For a reason I have yet to figure out, some browsers disallow\n  // word wrapping between certain characters *only* if a new inline\n  // element is started between them. This makes it hard to reliably\n  // measure the position of things, since that requires inserting an\n  // extra span. This terribly fragile set of tests matches the\n  // character combinations that suffer from this phenomenon on the\n  // various browsers.\n  function spanAffectsWrapping() { return false; }\n  if (gecko) // Only for \"$'\"\n    spanAffectsWrapping = function(str, i) {\n      return str.charCodeAt(i - 1) == 36 && str.charCodeAt(i) == 39;\n    };\n  else if (safari && !/Version\\/([6-9]|\\d\\d)\\b/.test(navigator.userAgent))\n    spanAffectsWrapping = function(str, i) {\n      return /\\-[^ \\-?]|\\?[^ !\\'\\\"\\),.\\-\\/:;\\?\\]\\}]/.test(str.slice(i - 1, i + 1));\n    };\n  else if (webkit && !/Chrome\\/(?:29|[3-9]\\d|\\d\\d\\d)\\./.test(navigator.userAgent))\n    spanAffectsWrapping = function(str, i) {\n      if (i > 1 && str.charCodeAt(i - 1) == 45) {\n        if (/\\w/.test(str.charAt(i - 2)) && /[^\\-?\\.]/.test(str.charAt(i))) return true;\n        if (i > 2 && /[\\d\\.,]/.test(str.charAt(i - 2)) && /[\\d\\.,]/.test(str.charAt(i))) return false;\n      }\n      return /[~!#%&*)=+}\\]|\\\"\\.>,:;][({[<]|-[^\\-?\\.\\u2010-\\u201f\\u2026]|\\?[\\w~`@#$%\\^&*(_=+{[|><]|…[\\w~`@#$%\\^&*(_=+{[><]/.test(str.slice(i - 1, i + 1));\n    };\n\n  var knownScrollbarWidth;\n  function scrollbarWidth(measure) {\n    if (knownScrollbarWidth != null) return knownScrollbarWidth;\n    var test = elt(\"div\", null, null, \"width: 50px; height: 50px; overflow-x: scroll\");\n    removeChildrenAndAdd(measure, test);\n    if (test.offsetWidth)\n      knownScrollbarWidth = test.offsetHeight - test.clientHeight;\n    return knownScrollbarWidth || 0;\n  }\n\n  var zwspSupported;\n  function zeroWidthElement(measure) {\n    if (zwspSupported == null) {\n      var test = elt(\"span\", \"\\u200b\");\n      removeChildrenAndAdd(measure, elt(\"span\", [test, document.createTextNode(\"x\")]));\n      if (measure.firstChild.offsetHeight != 0)\n        zwspSupported = test.offsetWidth <= 1 && test.offsetHeight > 2 && !ie_lt8;\n    }\n    if (zwspSupported) return elt(\"span\", \"\\u200b\");\n    else return elt(\"span\", \"\\u00a0\", null, \"display: inline-block; width: 1px; margin-right: -1px\");\n  }\n\n  // See if \"\".split is the broken IE version, if so, provide an\n  // alternative way to split lines.\n  var splitLines = \"\\n\\nb\".split(/\\n/).length != 3 ? function(string) {\n    var pos = 0, result = [], l = string.length;\n    while (pos <= l) {\n      var nl = string.indexOf(\"\\n\", pos);\n      if (nl == -1) nl = string.length;\n      var line = string.slice(pos, string.charAt(nl - 1) == \"\\r\" ? nl - 1 : nl);\n      var rt = line.indexOf(\"\\r\");\n      if (rt != -1) {\n        result.push(line.slice(0, rt));\n        pos += rt + 1;\n      } else {\n        result.push(line);\n        pos = nl + 1;\n      }\n    }\n    return result;\n  } : function(string){return string.split(/\\r\\n?|\\n/);};\n  CodeMirror.splitLines = splitLines;\n\n  var hasSelection = window.getSelection ? function(te) {\n    try { return te.selectionStart != te.selectionEnd; }\n    catch(e) { return false; }\n  } : function(te) {\n    try {var range = te.ownerDocument.selection.createRange();}\n    catch(e) {}\n    if (!range || range.parentElement() != te) return false;\n    return range.compareEndPoints(\"StartToEnd\", range) != 0;\n  };\n\n  var hasCopyEvent = (function() {\n    var e = elt(\"div\");\n    if (\"oncopy\" in e) return true;\n    e.setAttribute(\"oncopy\", \"return;\");\n    return typeof e.oncopy == 'function';\n  })();\n\n  // KEY NAMING\n\n  var keyNames = {3: \"Enter\", 8: \"Backspace\", 9: \"Tab\", 13: \"Enter\", 16: \"Shift\", 17: \"Ctrl\", 18: \"Alt\",\n                  19: \"Pause\", 20: \"CapsLock\", 27: \"Esc\", 32: \"Space\", 33: \"PageUp\", 34: \"PageDown\", 35: \"End\",\n                  36: \"Home\", 37: \"Left\", 38: \"Up\", 39: \"Right\", 40: \"Down\", 44: \"PrintScrn\", 45: \"Insert\",\n                  46: \"Delete\", 59: \";\", 91: \"Mod\", 92: \"Mod\", 93: \"Mod\", 109: \"-\", 107: \"=\", 127: \"Delete\",\n                  186: \";\", 187: \"=\", 188: \",\", 189: \"-\", 190: \".\", 191: \"/\", 192: \"`\", 219: \"[\", 220: \"\\\\\",\n                  221: \"]\", 222: \"'\", 63276: \"PageUp\", 63277: \"PageDown\", 63275: \"End\", 63273: \"Home\",\n                  63234: \"Left\", 63232: \"Up\", 63235: \"Right\", 63233: \"Down\", 63302: \"Insert\", 63272: \"Delete\"};\n  CodeMirror.keyNames = keyNames;\n  (function() {\n    // Number keys\n    for (var i = 0; i < 10; i++) keyNames[i + 48] = String(i);\n    // Alphabetic keys\n    for (var i = 65; i <= 90; i++) keyNames[i] = String.fromCharCode(i);\n    // Function keys\n    for (var i = 1; i <= 12; i++) keyNames[i + 111] = keyNames[i + 63235] = \"F\" + i;\n  })();\n\n  // BIDI HELPERS\n\n  function iterateBidiSections(order, from, to, f) {\n    if (!order) return f(from, to, \"ltr\");\n    var found = false;\n    for (var i = 0; i < order.length; ++i) {\n      var part = order[i];\n      if (part.from < to && part.to > from || from == to && part.to == from) {\n        f(Math.max(part.from, from), Math.min(part.to, to), part.level == 1 ? \"rtl\" : \"ltr\");\n        found = true;\n      }\n    }\n    if (!found) f(from, to, \"ltr\");\n  }\n\n  function bidiLeft(part) { return part.level % 2 ? part.to : part.from; }\n  function bidiRight(part) { return part.level % 2 ? part.from : part.to; }\n\n  function lineLeft(line) { var order = getOrder(line); return order ? bidiLeft(order[0]) : 0; }\n  function lineRight(line) {\n    var order = getOrder(line);\n    if (!order) return line.text.length;\n    return bidiRight(lst(order));\n  }\n\n  function lineStart(cm, lineN) {\n    var line = getLine(cm.doc, lineN);\n    var visual = visualLine(cm.doc, line);\n    if (visual != line) lineN = lineNo(visual);\n    var order = getOrder(visual);\n    var ch = !order ? 0 : order[0].level % 2 ? lineRight(visual) : lineLeft(visual);\n    return Pos(lineN, ch);\n  }\n  function lineEnd(cm, lineN) {\n    var merged, line;\n    while (merged = collapsedSpanAtEnd(line = getLine(cm.doc, lineN)))\n      lineN = merged.find().to.line;\n    var order = getOrder(line);\n    var ch = !order ? line.text.length : order[0].level % 2 ? lineLeft(line) : lineRight(line);\n    return Pos(lineN, ch);\n  }\n\n  function compareBidiLevel(order, a, b) {\n    var linedir = order[0].level;\n    if (a == linedir) return true;\n    if (b == linedir) return false;\n    return a < b;\n  }\n  var bidiOther;\n  function getBidiPartAt(order, pos) {\n    for (var i = 0, found; i < order.length; ++i) {\n      var cur = order[i];\n      if (cur.from < pos && cur.to > pos) { bidiOther = null; return i; }\n      if (cur.from == pos || cur.to == pos) {\n        if (found == null) {\n          found = i;\n        } else if (compareBidiLevel(order, cur.level, order[found].level)) {\n          bidiOther = found;\n          return i;\n        } else {\n          bidiOther = i;\n          return found;\n        }\n      }\n    }\n    bidiOther = null;\n    return found;\n  }\n\n  function moveInLine(line, pos, dir, byUnit) {\n    if (!byUnit) return pos + dir;\n    do pos += dir;\n    while (pos > 0 && isExtendingChar.test(line.text.charAt(pos)));\n    return pos;\n  }\n\n  // This is somewhat involved. It is needed in order to move\n  // 'visually' through bi-directional text -- i.e., pressing left\n  // should make the cursor go left, even when in RTL text. The\n  // tricky part is the 'jumps', where RTL and LTR text touch each\n  // other. This often requires the cursor offset to move more than\n  // one unit, in order to visually move one unit.\n  function moveVisually(line, start, dir, byUnit) {\n    var bidi = getOrder(line);\n    if (!bidi) return moveLogically(line, start, dir, byUnit);\n    var pos = getBidiPartAt(bidi, start), part = bidi[pos];\n    var target = moveInLine(line, start, part.level % 2 ? -dir : dir, byUnit);\n\n    for (;;) {\n      if (target > part.from && target < part.to) return target;\n      if (target == part.from || target == part.to) {\n        if (getBidiPartAt(bidi, target) == pos) return target;\n        part = bidi[pos += dir];\n        return (dir > 0) == part.level % 2 ? part.to : part.from;\n      } else {\n        part = bidi[pos += dir];\n        if (!part) return null;\n        if ((dir > 0) == part.level % 2)\n          target = moveInLine(line, part.to, -1, byUnit);\n        else\n          target = moveInLine(line, part.from, 1, byUnit);\n      }\n    }\n  }\n\n  function moveLogically(line, start, dir, byUnit) {\n    var target = start + dir;\n    if (byUnit) while (target > 0 && isExtendingChar.test(line.text.charAt(target))) target += dir;\n    return target < 0 || target > line.text.length ? null : target;\n  }\n\n  // Bidirectional ordering algorithm\n  // See http://unicode.org/reports/tr9/tr9-13.html for the algorithm\n  // that this (partially) implements.\n\n  // One-char codes used for character types:\n  // L (L):   Left-to-Right\n  // R (R):   Right-to-Left\n  // r (AL):  Right-to-Left Arabic\n  // 1 (EN):  European Number\n  // + (ES):  European Number Separator\n  // % (ET):  European Number Terminator\n  // n (AN):  Arabic Number\n  // , (CS):  Common Number Separator\n  // m (NSM): Non-Spacing Mark\n  // b (BN):  Boundary Neutral\n  // s (B):   Paragraph Separator\n  // t (S):   Segment Separator\n  // w (WS):  Whitespace\n  // N (ON):  Other Neutrals\n\n  // Returns null if characters are ordered as they appear\n  // (left-to-right), or an array of sections ({from, to, level}\n  // objects) in the order in which they occur visually.\n  var bidiOrdering = (function() {\n    // Character types for codepoints 0 to 0xff\n    var lowTypes = \"bbbbbbbbbtstwsbbbbbbbbbbbbbbssstwNN%%%NNNNNN,N,N1111111111NNNNNNNLLLLLLLLLLLLLLLLLLLLLLLLLLNNNNNNLLLLLLLLLLLLLLLLLLLLLLLLLLNNNNbbbbbbsbbbbbbbbbbbbbbbbbbbbbbbbbb,N%%%%NNNNLNNNNN%%11NLNNN1LNNNNNLLLLLLLLLLLLLLLLLLLLLLLNLLLLLLLLLLLLLLLLLLLLLLLLLLLLLLLNLLLLLLLL\";\n    // Character types for codepoints 0x600 to 0x6ff\n    var arabicTypes = \"rrrrrrrrrrrr,rNNmmmmmmrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrmmmmmmmmmmmmmmrrrrrrrnnnnnnnnnn%nnrrrmrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrmmmmmmmmmmmmmmmmmmmNmmmmrrrrrrrrrrrrrrrrrr\";\n    function charType(code) {\n      if (code <= 0xff) return lowTypes.charAt(code);\n      else if (0x590 <= code && code <= 0x5f4) return \"R\";\n      else if (0x600 <= code && code <= 0x6ff) return arabicTypes.charAt(code - 0x600);\n      else if (0x700 <= code && code <= 0x8ac) return \"r\";\n      else return \"L\";\n    }\n\n    var bidiRE = /[\\u0590-\\u05f4\\u0600-\\u06ff\\u0700-\\u08ac]/;\n    var isNeutral = /[stwN]/, isStrong = /[LRr]/, countsAsLeft = /[Lb1n]/, countsAsNum = /[1n]/;\n    // Browsers seem to always treat the boundaries of block elements as being L.\n    var outerType = \"L\";\n\n    return function(str) {\n      if (!bidiRE.test(str)) return false;\n      var len = str.length, types = [];\n      for (var i = 0, type; i < len; ++i)\n        types.push(type = charType(str.charCodeAt(i)));\n\n      // W1. Examine each non-spacing mark (NSM) in the level run, and\n      // change the type of the NSM to the type of the previous\n      // character. If the NSM is at the start of the level run, it will\n      // get the type of sor.\n      for (var i = 0, prev = outerType; i < len; ++i) {\n        var type = types[i];\n        if (type == \"m\") types[i] = prev;\n        else prev = type;\n      }\n\n      // W2. Search backwards from each instance of a European number\n      // until the first strong type (R, L, AL, or sor) is found. If an\n      // AL is found, change the type of the European number to Arabic\n      // number.\n      // W3. Change all ALs to R.\n      for (var i = 0, cur = outerType; i < len; ++i) {\n        var type = types[i];\n        if (type == \"1\" && cur == \"r\") types[i] = \"n\";\n        else if (isStrong.test(type)) { cur = type; if (type == \"r\") types[i] = \"R\"; }\n      }\n\n      // W4. A single European separator between two European numbers\n      // changes to a European number. A single common separator between\n      // two numbers of the same type changes to that type.\n      for (var i = 1, prev = types[0]; i < len - 1; ++i) {\n        var type = types[i];\n        if (type == \"+\" && prev == \"1\" && types[i+1] == \"1\") types[i] = \"1\";\n        else if (type == \",\" && prev == types[i+1] &&\n                 (prev == \"1\" || prev == \"n\")) types[i] = prev;\n        prev = type;\n      }\n\n      // W5. A sequence of European terminators adjacent to European\n      // numbers changes to all European numbers.\n      // W6. Otherwise, separators and terminators change to Other\n      // Neutral.\n      for (var i = 0; i < len; ++i) {\n        var type = types[i];\n        if (type == \",\") types[i] = \"N\";\n        else if (type == \"%\") {\n          for (var end = i + 1; end < len && types[end] == \"%\"; ++end) {}\n          var replace = (i && types[i-1] == \"!\") || (end < len - 1 && types[end] == \"1\") ? \"1\" : \"N\";\n          for (var j = i; j < end; ++j) types[j] = replace;\n          i = end - 1;\n        }\n      }\n\n      // W7. Search backwards from each instance of a European number\n      // until the first strong type (R, L, or sor) is found. If an L is\n      // found, then change the type of the European number to L.\n      for (var i = 0, cur = outerType; i < len; ++i) {\n        var type = types[i];\n        if (cur == \"L\" && type == \"1\") types[i] = \"L\";\n        else if (isStrong.test(type)) cur = type;\n      }\n\n      // N1. A sequence of neutrals takes the direction of the\n      // surrounding strong text if the text on both sides has the same\n      // direction. European and Arabic numbers act as if they were R in\n      // terms of their influence on neutrals. Start-of-level-run (sor)\n      // and end-of-level-run (eor) are used at level run boundaries.\n      // N2. Any remaining neutrals take the embedding direction.\n      for (var i = 0; i < len; ++i) {\n        if (isNeutral.test(types[i])) {\n          for (var end = i + 1; end < len && isNeutral.test(types[end]); ++end) {}\n          var before = (i ? types[i-1] : outerType) == \"L\";\n          var after = (end < len - 1 ? types[end] : outerType) == \"L\";\n          var replace = before || after ? \"L\" : \"R\";\n          for (var j = i; j < end; ++j) types[j] = replace;\n          i = end - 1;\n        }\n      }\n\n      // Here we depart from the documented algorithm, in order to avoid\n      // building up an actual levels array. Since there are only three\n      // levels (0, 1, 2) in an implementation that doesn't take\n      // explicit embedding into account, we can build up the order on\n      // the fly, without following the level-based algorithm.\n      var order = [], m;\n      for (var i = 0; i < len;) {\n        if (countsAsLeft.test(types[i])) {\n          var start = i;\n          for (++i; i < len && countsAsLeft.test(types[i]); ++i) {}\n          order.push({from: start, to: i, level: 0});\n        } else {\n          var pos = i, at = order.length;\n          for (++i; i < len && types[i] != \"L\"; ++i) {}\n          for (var j = pos; j < i;) {\n            if (countsAsNum.test(types[j])) {\n              if (pos < j) order.splice(at, 0, {from: pos, to: j, level: 1});\n              var nstart = j;\n              for (++j; j < i && countsAsNum.test(types[j]); ++j) {}\n              order.splice(at, 0, {from: nstart, to: j, level: 2});\n              pos = j;\n            } else ++j;\n          }\n          if (pos < i) order.splice(at, 0, {from: pos, to: i, level: 1});\n        }\n      }\n      if (order[0].level == 1 && (m = str.match(/^\\s+/))) {\n        order[0].from = m[0].length;\n        order.unshift({from: 0, to: m[0].length, level: 0});\n      }\n      if (lst(order).level == 1 && (m = str.match(/\\s+$/))) {\n        lst(order).to -= m[0].length;\n        order.push({from: len - m[0].length, to: len, level: 0});\n      }\n      if (order[0].level != lst(order).level)\n        order.push({from: len, to: len, level: order[0].level});\n\n      return order;\n    };\n  })();\n\n  // THE END\n\n  CodeMirror.version = \"3.16.0\";\n\n  return CodeMirror;\n})();\n",
+
+	"static/lib/codemirror/mode/go/go.js": "function goMode(commentsOnly) {\n    return function(config) {\n        var indentUnit = config.indentUnit;\n\n        var keywords = {\n          \"break\":true, \"case\":true, \"chan\":true, \"const\":true, \"continue\":true,\n          \"default\":true, \"defer\":true, \"else\":true, \"fallthrough\":true, \"for\":true,\n          \"func\":true, \"go\":true, \"goto\":true, \"if\":true, \"import\":true,\n          \"interface\":true, \"map\":true, \"package\":true, \"range\":true, \"return\":true,\n          \"select\":true, \"struct\":true, \"switch\":true, \"type\":true, \"var\":true,\n          \"bool\":true, \"byte\":true, \"complex64\":true, \"complex128\":true, \"error\":true,\n          \"float32\":true, \"float64\":true, \"int8\":true, \"int16\":true, \"int32\":true,\n          \"int64\":true, \"rune\":true, \"string\":true, \"uint8\":true, \"uint16\":true, \"uint32\":true,\n          \"uint64\":true, \"int\":true, \"uint\":true, \"uintptr\":true\n        };\n\n        var atoms = {\n          \"true\":true, \"false\":true, \"iota\":true, \"nil\":true, \"append\":true,\n          \"cap\":true, \"close\":true, \"complex\":true, \"copy\":true, \"delete\":true, \"imag\":true,\n          \"len\":true, \"make\":true, \"new\":true, \"panic\":true, \"print\":true,\n          \"println\":true, \"real\":true, \"recover\":true\n        };\n\n        var isOperatorChar = /[+\\-*&^%:=<>!|\\/]/;\n\n        var curPunc;\n\n        function tokenBase(stream, state) {\n            var ch = stream.next();\n            if (ch == '\"' || ch == \"'\" || ch == \"`\") {\n                state.tokenize = tokenString(ch);\n                return state.tokenize(stream, state);\n            }\n            if (/[\\d\\.]/.test(ch)) {\n                if (ch == \".\") {\n                    stream.match(/^[0-9]+([eE][\\-+]?[0-9]+)?/);\n                } else if (ch == \"0\") {\n                    stream.match(/^[xX][0-9a-fA-F]+/) || stream.match(/^0[0-7]+/);\n                } else {\n                    stream.match(/^[0-9]*\\.?[0-9]*([eE][\\-+]?[0-9]+)?/);\n                }\n                return \"number\";\n            }\n            if (/[\\[\\]{}\\(\\),;\\:\\.]/.test(ch)) {\n                curPunc = ch;\n                return null;\n            }\n            if (ch == \"/\") {\n                if (stream.eat(\"*\")) {\n                    state.tokenize = tokenComment;\n                    return tokenComment(stream, state);\n                }\n                if (stream.eat(\"/\")) {\n                    stream.skipToEnd();\n                    return \"comment\";\n                }\n            }\n            if (isOperatorChar.test(ch)) {\n                stream.eatWhile(isOperatorChar);\n                return \"operator\";\n            }\n            stream.eatWhile(/[\\w\\$_]/);\n            var cur = stream.current();\n            if (keywords.propertyIsEnumerable(cur)) {\n                if (cur == \"case\" || cur == \"default\") curPunc = \"case\";\n                return \"keyword\";\n            }\n            if (atoms.propertyIsEnumerable(cur)) return \"atom\";\n            return \"variable\";\n        }\n\n        function tokenString(quote) {\n            return function(stream, state) {\n                var escaped = false,\n                    next, end = false;\n                while ((next = stream.next()) != null) {\n                    if (next == quote && !escaped) {\n                        end = true;\n                        break;\n                    }\n                    escaped = !escaped && next == \"\\\\\";\n                }\n                if (end || !(escaped || quote == \"`\"))\n                    state.tokenize = tokenBase;\n                return \"string\";\n            };\n        }\n\n        function tokenComment(stream, state) {\n            var maybeEnd = false,\n                ch;\n            while (ch = stream.next()) {\n                if (ch == \"/\" && maybeEnd) {\n                    state.tokenize = tokenBase;\n                    break;\n                }\n                maybeEnd = (ch == \"*\");\n            }\n            return \"comment\";\n        }\n\n        function Context(indented, column, type, align, prev) {\n            this.indented = indented;\n            this.column = column;\n            this.type = type;\n            this.align = align;\n            this.prev = prev;\n        }\n\n        function pushContext(state, col, type) {\n            return state.context = new Context(state.indented, col, type, null, state.context);\n        }\n\n        function popContext(state) {\n            var t = state.context.type;\n            if (t == \")\" || t == \"]\" || t == \"}\")\n                state.indented = state.context.indented;\n            return state.context = state.context.prev;\n        }\n\n        // Interface\n\n        return {\n            startState: function(basecolumn) {\n                return {\n                    tokenize: null,\n                    context: new Context((basecolumn || 0) - indentUnit, 0, \"top\", false),\n                    indented: 0,\n                    startOfLine: true\n                };\n            },\n\n            token: function(stream, state) {\n                var ctx = state.context;\n                if (stream.sol()) {\n                    if (ctx.align == null) ctx.align = false;\n                    state.indented = stream.indentation();\n                    state.startOfLine = true;\n                    if (ctx.type == \"case\") ctx.type = \"}\";\n                }\n                if (stream.eatSpace()) return null;\n                curPunc = null;\n                var style = (state.tokenize || tokenBase)(stream, state);\n                if (style == \"comment\") return style;\n                if (ctx.align == null) ctx.align = true;\n\n                if (curPunc == \"{\") pushContext(state, stream.column(), \"}\");\n                else if (curPunc == \"[\") pushContext(state, stream.column(), \"]\");\n                else if (curPunc == \"(\") pushContext(state, stream.column(), \")\");\n                else if (curPunc == \"case\") ctx.type = \"case\";\n                else if (curPunc == \"}\" && ctx.type == \"}\") ctx = popContext(state);\n                else if (curPunc == ctx.type) popContext(state);\n                state.startOfLine = false;\n                if (commentsOnly) return \"\";\n                return style;\n            },\n\n            indent: function(state, textAfter) {\n                if (state.tokenize != tokenBase && state.tokenize != null) return 0;\n                var ctx = state.context,\n                    firstChar = textAfter && textAfter.charAt(0);\n                if (ctx.type == \"case\" && /^(?:case|default)\\b/.test(textAfter)) {\n                    state.context.type = \"}\";\n                    return ctx.indented;\n                }\n                var closing = firstChar == ctx.type;\n                if (ctx.align) return ctx.column + (closing ? 0 : 1);\n                else return ctx.indented + (closing ? 0 : indentUnit);\n            },\n\n            electricChars: \"{}:\",\n            blockCommentStart: \"/*\",\n            blockCommentEnd: \"*/\",\n            lineComment: \"//\"\n        };\n    }\n}\n\nCodeMirror.defineMode(\"go\", goMode(false));\nCodeMirror.defineMIME(\"text/x-go\", \"go\");\n\nCodeMirror.defineMode(\"goComments\", goMode(true));\nCodeMirror.defineMIME(\"text/x-go-comments\", \"goComments\");\n",
+
+	"static/lib/jquery-ui.min.js": "/*! jQuery UI - v1.10.3 - 2013-09-26\n* http://jqueryui.com\n* Includes: jquery.ui.core.js, jquery.ui.widget.js, jquery.ui.mouse.js, jquery.ui.position.js, jquery.ui.draggable.js, jquery.ui.droppable.js, jquery.ui.resizable.js, jquery.ui.selectable.js, jquery.ui.sortable.js, jquery.ui.accordion.js, jquery.ui.autocomplete.js, jquery.ui.button.js, jquery.ui.datepicker.js, jquery.ui.dialog.js, jquery.ui.menu.js, jquery.ui.progressbar.js, jquery.ui.slider.js, jquery.ui.spinner.js, jquery.ui.tabs.js, jquery.ui.tooltip.js, jquery.ui.effect.js, jquery.ui.effect-blind.js, jquery.ui.effect-bounce.js, jquery.ui.effect-clip.js, jquery.ui.effect-drop.js, jquery.ui.effect-explode.js, jquery.ui.effect-fade.js, jquery.ui.effect-fold.js, jquery.ui.effect-highlight.js, jquery.ui.effect-pulsate.js, jquery.ui.effect-scale.js, jquery.ui.effect-shake.js, jquery.ui.effect-slide.js, jquery.ui.effect-transfer.js\n* Copyright 2013 jQuery Foundation and other contributors; Licensed MIT */\n\n(function(e,t){function i(t,i){var s,o,u,r=t.nodeName.toLowerCase();return\"area\"===r?(s=t.parentNode,o=s.name,t.href&&o&&\"map\"===s.nodeName.toLowerCase()?(u=e(\"img[usemap=#\"+o+\"]\")[0],!!u&&n(u)):!1):(/input|select|textarea|button|object/.test(r)?!t.disabled:\"a\"===r?t.href||i:i)&&n(t)}function n(t){return e.expr.filters.visible(t)&&!e(t).parents().addBack().filter(function(){return\"hidden\"===e.css(this,\"visibility\")}).length}var s=0,o=/^ui-id-\\d+$/;e.ui=e.ui||{},e.extend(e.ui,{version:\"1.10.3\",keyCode:{BACKSPACE:8,COMMA:188,DELETE:46,DOWN:40,END:35,ENTER:13,ESCAPE:27,HOME:36,LEFT:37,NUMPAD_ADD:107,NUMPAD_DECIMAL:110,NUMPAD_DIVIDE:111,NUMPAD_ENTER:108,NUMPAD_MULTIPLY:106,NUMPAD_SUBTRACT:109,PAGE_DOWN:34,PAGE_UP:33,PERIOD:190,RIGHT:39,SPACE:32,TAB:9,UP:38}}),e.fn.extend({focus:function(t){return function(i,n){return\"number\"==typeof i?this.each(function(){var t=this;setTimeout(function(){e(t).focus(),n&&n.call(t)},i)}):t.apply(this,arguments)}}(e.fn.focus),scrollParent:function(){var t;return t=e.ui.ie&&/(static|relative)/.test(this.css(\"position\"))||/absolute/.test(this.css(\"position\"))?this.parents().filter(function(){return/(relative|absolute|fixed)/.test(e.css(this,\"position\"))&&/(auto|scroll)/.test(e.css(this,\"overflow\")+e.css(this,\"overflow-y\")+e.css(this,\"overflow-x\"))}).eq(0):this.parents().filter(function(){return/(auto|scroll)/.test(e.css(this,\"overflow\")+e.css(this,\"overflow-y\")+e.css(this,\"overflow-x\"))}).eq(0),/fixed/.test(this.css(\"position\"))||!t.length?e(document):t},zIndex:function(i){if(i!==t)return this.css(\"zIndex\",i);if(this.length)for(var n,s,o=e(this[0]);o.length&&o[0]!==document;){if(n=o.css(\"position\"),(\"absolute\"===n||\"relative\"===n||\"fixed\"===n)&&(s=parseInt(o.css(\"zIndex\"),10),!isNaN(s)&&0!==s))return s;o=o.parent()}return 0},uniqueId:function(){return this.each(function(){this.id||(this.id=\"ui-id-\"+ ++s)})},removeUniqueId:function(){return this.each(function(){o.test(this.id)&&e(this).removeAttr(\"id\")})}}),e.extend(e.expr[\":\"],{data:e.expr.createPseudo?e.expr.createPseudo(function(t){return function(i){return!!e.data(i,t)}}):function(t,i,n){return!!e.data(t,n[3])},focusable:function(t){return i(t,!isNaN(e.attr(t,\"tabindex\")))},tabbable:function(t){var n=e.attr(t,\"tabindex\"),s=isNaN(n);return(s||n>=0)&&i(t,!s)}}),e(\"<a>\").outerWidth(1).jquery||e.each([\"Width\",\"Height\"],function(i,n){function s(t,i,n,s){return e.each(o,function(){i-=parseFloat(e.css(t,\"padding\"+this))||0,n&&(i-=parseFloat(e.css(t,\"border\"+this+\"Width\"))||0),s&&(i-=parseFloat(e.css(t,\"margin\"+this))||0)}),i}var o=\"Width\"===n?[\"Left\",\"Right\"]:[\"Top\",\"Bottom\"],u=n.toLowerCase(),r={innerWidth:e.fn.innerWidth,innerHeight:e.fn.innerHeight,outerWidth:e.fn.outerWidth,outerHeight:e.fn.outerHeight};e.fn[\"inner\"+n]=function(i){return i===t?r[\"inner\"+n].call(this):this.each(function(){e(this).css(u,s(this,i)+\"px\")})},e.fn[\"outer\"+n]=function(t,i){return\"number\"!=typeof t?r[\"outer\"+n].call(this,t):this.each(function(){e(this).css(u,s(this,t,!0,i)+\"px\")})}}),e.fn.addBack||(e.fn.addBack=function(e){return this.add(null==e?this.prevObject:this.prevObject.filter(e))}),e(\"<a>\").data(\"a-b\",\"a\").removeData(\"a-b\").data(\"a-b\")&&(e.fn.removeData=function(t){return function(i){return arguments.length?t.call(this,e.camelCase(i)):t.call(this)}}(e.fn.removeData)),e.ui.ie=!!/msie [\\w.]+/.exec(navigator.userAgent.toLowerCase()),e.support.selectstart=\"onselectstart\"in document.createElement(\"div\"),e.fn.extend({disableSelection:function(){return this.bind((e.support.selectstart?\"selectstart\":\"mousedown\")+\".ui-disableSelection\",function(e){e.preventDefault()})},enableSelection:function(){return this.unbind(\".ui-disableSelection\")}}),e.extend(e.ui,{plugin:{add:function(t,i,n){var s,o=e.ui[t].prototype;for(s in n)o.plugins[s]=o.plugins[s]||[],o.plugins[s].push([i,n[s]])},call:function(e,t,i){var n,s=e.plugins[t];if(s&&e.element[0].parentNode&&11!==e.element[0].parentNode.nodeType)for(n=0;s.length>n;n++)e.options[s[n][0]]&&s[n][1].apply(e.element,i)}},hasScroll:function(t,i){if(\"hidden\"===e(t).css(\"overflow\"))return!1;var n=i&&\"left\"===i?\"scrollLeft\":\"scrollTop\",s=!1;return t[n]>0?!0:(t[n]=1,s=t[n]>0,t[n]=0,s)}})})(jQuery);(function(e,t){var i=0,n=Array.prototype.slice,s=e.cleanData;e.cleanData=function(t){for(var i,n=0;null!=(i=t[n]);n++)try{e(i).triggerHandler(\"remove\")}catch(o){}s(t)},e.widget=function(i,n,s){var o,r,a,u,l={},h=i.split(\".\")[0];i=i.split(\".\")[1],o=h+\"-\"+i,s||(s=n,n=e.Widget),e.expr[\":\"][o.toLowerCase()]=function(t){return!!e.data(t,o)},e[h]=e[h]||{},r=e[h][i],a=e[h][i]=function(e,i){return this._createWidget?(arguments.length&&this._createWidget(e,i),t):new a(e,i)},e.extend(a,r,{version:s.version,_proto:e.extend({},s),_childConstructors:[]}),u=new n,u.options=e.widget.extend({},u.options),e.each(s,function(i,s){return e.isFunction(s)?(l[i]=function(){var e=function(){return n.prototype[i].apply(this,arguments)},t=function(e){return n.prototype[i].apply(this,e)};return function(){var i,n=this._super,o=this._superApply;return this._super=e,this._superApply=t,i=s.apply(this,arguments),this._super=n,this._superApply=o,i}}(),t):(l[i]=s,t)}),a.prototype=e.widget.extend(u,{widgetEventPrefix:r?u.widgetEventPrefix:i},l,{constructor:a,namespace:h,widgetName:i,widgetFullName:o}),r?(e.each(r._childConstructors,function(t,i){var n=i.prototype;e.widget(n.namespace+\".\"+n.widgetName,a,i._proto)}),delete r._childConstructors):n._childConstructors.push(a),e.widget.bridge(i,a)},e.widget.extend=function(i){for(var s,o,r=n.call(arguments,1),a=0,u=r.length;u>a;a++)for(s in r[a])o=r[a][s],r[a].hasOwnProperty(s)&&o!==t&&(i[s]=e.isPlainObject(o)?e.isPlainObject(i[s])?e.widget.extend({},i[s],o):e.widget.extend({},o):o);return i},e.widget.bridge=function(i,s){var o=s.prototype.widgetFullName||i;e.fn[i]=function(r){var a=\"string\"==typeof r,u=n.call(arguments,1),l=this;return r=!a&&u.length?e.widget.extend.apply(null,[r].concat(u)):r,a?this.each(function(){var n,s=e.data(this,o);return s?e.isFunction(s[r])&&\"_\"!==r.charAt(0)?(n=s[r].apply(s,u),n!==s&&n!==t?(l=n&&n.jquery?l.pushStack(n.get()):n,!1):t):e.error(\"no such method '\"+r+\"' for \"+i+\" widget instance\"):e.error(\"cannot call methods on \"+i+\" prior to initialization; \"+\"attempted to call method '\"+r+\"'\")}):this.each(function(){var t=e.data(this,o);t?t.option(r||{})._init():e.data(this,o,new s(r,this))}),l}},e.Widget=function(){},e.Widget._childConstructors=[],e.Widget.prototype={widgetName:\"widget\",widgetEventPrefix:\"\",defaultElement:\"<div>\",options:{disabled:!1,create:null},_createWidget:function(t,n){n=e(n||this.defaultElement||this)[0],this.element=e(n),this.uuid=i++,this.eventNamespace=\".\"+this.widgetName+this.uuid,this.options=e.widget.extend({},this.options,this._getCreateOptions(),t),this.bindings=e(),this.hoverable=e(),this.focusable=e(),n!==this&&(e.data(n,this.widgetFullName,this),this._on(!0,this.element,{remove:function(e){e.target===n&&this.destroy()}}),this.document=e(n.style?n.ownerDocument:n.document||n),this.window=e(this.document[0].defaultView||this.document[0].parentWindow)),this._create(),this._trigger(\"create\",null,this._getCreateEventData()),this._init()},_getCreateOptions:e.noop,_getCreateEventData:e.noop,_create:e.noop,_init:e.noop,destroy:function(){this._destroy(),this.element.unbind(this.eventNamespace).removeData(this.widgetName).removeData(this.widgetFullName).removeData(e.camelCase(this.widgetFullName)),this.widget().unbind(this.eventNamespace).removeAttr(\"aria-disabled\").removeClass(this.widgetFullName+\"-disabled \"+\"ui-state-disabled\"),this.bindings.unbind(this.eventNamespace),this.hoverable.removeClass(\"ui-state-hover\"),this.focusable.removeClass(\"ui-state-focus\")},_destroy:e.noop,widget:function(){return this.element},option:function(i,n){var s,o,r,a=i;if(0===arguments.length)return e.widget.extend({},this.options);if(\"string\"==typeof i)if(a={},s=i.split(\".\"),i=s.shift(),s.length){for(o=a[i]=e.widget.extend({},this.options[i]),r=0;s.length-1>r;r++)o[s[r]]=o[s[r]]||{},o=o[s[r]];if(i=s.pop(),n===t)return o[i]===t?null:o[i];o[i]=n}else{if(n===t)return this.options[i]===t?null:this.options[i];a[i]=n}return this._setOptions(a),this},_setOptions:function(e){var t;for(t in e)this._setOption(t,e[t]);return this},_setOption:function(e,t){return this.options[e]=t,\"disabled\"===e&&(this.widget().toggleClass(this.widgetFullName+\"-disabled ui-state-disabled\",!!t).attr(\"aria-disabled\",t),this.hoverable.removeClass(\"ui-state-hover\"),this.focusable.removeClass(\"ui-state-focus\")),this},enable:function(){return this._setOption(\"disabled\",!1)},disable:function(){return this._setOption(\"disabled\",!0)},_on:function(i,n,s){var o,r=this;\"boolean\"!=typeof i&&(s=n,n=i,i=!1),s?(n=o=e(n),this.bindings=this.bindings.add(n)):(s=n,n=this.element,o=this.widget()),e.each(s,function(s,a){function u(){return i||r.options.disabled!==!0&&!e(this).hasClass(\"ui-state-disabled\")?(\"string\"==typeof a?r[a]:a).apply(r,arguments):t}\"string\"!=typeof a&&(u.guid=a.guid=a.guid||u.guid||e.guid++);var l=s.match(/^(\\w+)\\s*(.*)$/),h=l[1]+r.eventNamespace,c=l[2];c?o.delegate(c,h,u):n.bind(h,u)})},_off:function(e,t){t=(t||\"\").split(\" \").join(this.eventNamespace+\" \")+this.eventNamespace,e.unbind(t).undelegate(t)},_delay:function(e,t){function i(){return(\"string\"==typeof e?n[e]:e).apply(n,arguments)}var n=this;return setTimeout(i,t||0)},_hoverable:function(t){this.hoverable=this.hoverable.add(t),this._on(t,{mouseenter:function(t){e(t.currentTarget).addClass(\"ui-state-hover\")},mouseleave:function(t){e(t.currentTarget).removeClass(\"ui-state-hover\")}})},_focusable:function(t){this.focusable=this.focusable.add(t),this._on(t,{focusin:function(t){e(t.currentTarget).addClass(\"ui-state-focus\")},focusout:function(t){e(t.currentTarget).removeClass(\"ui-state-focus\")}})},_trigger:function(t,i,n){var s,o,r=this.options[t];if(n=n||{},i=e.Event(i),i.type=(t===this.widgetEventPrefix?t:this.widgetEventPrefix+t).toLowerCase(),i.target=this.element[0],o=i.originalEvent)for(s in o)s in i||(i[s]=o[s]);return this.element.trigger(i,n),!(e.isFunction(r)&&r.apply(this.element[0],[i].concat(n))===!1||i.isDefaultPrevented())}},e.each({show:\"fadeIn\",hide:\"fadeOut\"},function(t,i){e.Widget.prototype[\"_\"+t]=function(n,s,o){\"string\"==typeof s&&(s={effect:s});var r,a=s?s===!0||\"number\"==typeof s?i:s.effect||i:t;s=s||{},\"number\"==typeof s&&(s={duration:s}),r=!e.isEmptyObject(s),s.complete=o,s.delay&&n.delay(s.delay),r&&e.effects&&e.effects.effect[a]?n[t](s):a!==t&&n[a]?n[a](s.duration,s.easing,o):n.queue(function(i){e(this)[t](),o&&o.call(n[0]),i()})}})})(jQuery);(function(e){var t=!1;e(document).mouseup(function(){t=!1}),e.widget(\"ui.mouse\",{version:\"1.10.3\",options:{cancel:\"input,textarea,button,select,option\",distance:1,delay:0},_mouseInit:function(){var t=this;this.element.bind(\"mousedown.\"+this.widgetName,function(e){return t._mouseDown(e)}).bind(\"click.\"+this.widgetName,function(a){return!0===e.data(a.target,t.widgetName+\".preventClickEvent\")?(e.removeData(a.target,t.widgetName+\".preventClickEvent\"),a.stopImmediatePropagation(),!1):undefined}),this.started=!1},_mouseDestroy:function(){this.element.unbind(\".\"+this.widgetName),this._mouseMoveDelegate&&e(document).unbind(\"mousemove.\"+this.widgetName,this._mouseMoveDelegate).unbind(\"mouseup.\"+this.widgetName,this._mouseUpDelegate)},_mouseDown:function(a){if(!t){this._mouseStarted&&this._mouseUp(a),this._mouseDownEvent=a;var i=this,r=1===a.which,n=\"string\"==typeof this.options.cancel&&a.target.nodeName?e(a.target).closest(this.options.cancel).length:!1;return r&&!n&&this._mouseCapture(a)?(this.mouseDelayMet=!this.options.delay,this.mouseDelayMet||(this._mouseDelayTimer=setTimeout(function(){i.mouseDelayMet=!0},this.options.delay)),this._mouseDistanceMet(a)&&this._mouseDelayMet(a)&&(this._mouseStarted=this._mouseStart(a)!==!1,!this._mouseStarted)?(a.preventDefault(),!0):(!0===e.data(a.target,this.widgetName+\".preventClickEvent\")&&e.removeData(a.target,this.widgetName+\".preventClickEvent\"),this._mouseMoveDelegate=function(e){return i._mouseMove(e)},this._mouseUpDelegate=function(e){return i._mouseUp(e)},e(document).bind(\"mousemove.\"+this.widgetName,this._mouseMoveDelegate).bind(\"mouseup.\"+this.widgetName,this._mouseUpDelegate),a.preventDefault(),t=!0,!0)):!0}},_mouseMove:function(t){return e.ui.ie&&(!document.documentMode||9>document.documentMode)&&!t.button?this._mouseUp(t):this._mouseStarted?(this._mouseDrag(t),t.preventDefault()):(this._mouseDistanceMet(t)&&this._mouseDelayMet(t)&&(this._mouseStarted=this._mouseStart(this._mouseDownEvent,t)!==!1,this._mouseStarted?this._mouseDrag(t):this._mouseUp(t)),!this._mouseStarted)},_mouseUp:function(t){return e(document).unbind(\"mousemove.\"+this.widgetName,this._mouseMoveDelegate).unbind(\"mouseup.\"+this.widgetName,this._mouseUpDelegate),this._mouseStarted&&(this._mouseStarted=!1,t.target===this._mouseDownEvent.target&&e.data(t.target,this.widgetName+\".preventClickEvent\",!0),this._mouseStop(t)),!1},_mouseDistanceMet:function(e){return Math.max(Math.abs(this._mouseDownEvent.pageX-e.pageX),Math.abs(this._mouseDownEvent.pageY-e.pageY))>=this.options.distance},_mouseDelayMet:function(){return this.mouseDelayMet},_mouseStart:function(){},_mouseDrag:function(){},_mouseStop:function(){},_mouseCapture:function(){return!0}})})(jQuery);(function(e,t){function i(e,t,i){return[parseFloat(e[0])*(f.test(e[0])?t/100:1),parseFloat(e[1])*(f.test(e[1])?i/100:1)]}function n(t,i){return parseInt(e.css(t,i),10)||0}function s(t){var i=t[0];return 9===i.nodeType?{width:t.width(),height:t.height(),offset:{top:0,left:0}}:e.isWindow(i)?{width:t.width(),height:t.height(),offset:{top:t.scrollTop(),left:t.scrollLeft()}}:i.preventDefault?{width:0,height:0,offset:{top:i.pageY,left:i.pageX}}:{width:t.outerWidth(),height:t.outerHeight(),offset:t.offset()}}e.ui=e.ui||{};var o,r=Math.max,a=Math.abs,l=Math.round,u=/left|center|right/,h=/top|center|bottom/,c=/[\\+\\-]\\d+(\\.[\\d]+)?%?/,d=/^\\w+/,f=/%$/,m=e.fn.position;e.position={scrollbarWidth:function(){if(o!==t)return o;var i,n,s=e(\"<div style='display:block;width:50px;height:50px;overflow:hidden;'><div style='height:100px;width:auto;'></div></div>\"),r=s.children()[0];return e(\"body\").append(s),i=r.offsetWidth,s.css(\"overflow\",\"scroll\"),n=r.offsetWidth,i===n&&(n=s[0].clientWidth),s.remove(),o=i-n},getScrollInfo:function(t){var i=t.isWindow?\"\":t.element.css(\"overflow-x\"),n=t.isWindow?\"\":t.element.css(\"overflow-y\"),s=\"scroll\"===i||\"auto\"===i&&t.width<t.element[0].scrollWidth,o=\"scroll\"===n||\"auto\"===n&&t.height<t.element[0].scrollHeight;return{width:o?e.position.scrollbarWidth():0,height:s?e.position.scrollbarWidth():0}},getWithinInfo:function(t){var i=e(t||window),n=e.isWindow(i[0]);return{element:i,isWindow:n,offset:i.offset()||{left:0,top:0},scrollLeft:i.scrollLeft(),scrollTop:i.scrollTop(),width:n?i.width():i.outerWidth(),height:n?i.height():i.outerHeight()}}},e.fn.position=function(t){if(!t||!t.of)return m.apply(this,arguments);t=e.extend({},t);var o,f,p,v,g,_,b=e(t.of),y=e.position.getWithinInfo(t.within),x=e.position.getScrollInfo(y),w=(t.collision||\"flip\").split(\" \"),C={};return _=s(b),b[0].preventDefault&&(t.at=\"left top\"),f=_.width,p=_.height,v=_.offset,g=e.extend({},v),e.each([\"my\",\"at\"],function(){var e,i,n=(t[this]||\"\").split(\" \");1===n.length&&(n=u.test(n[0])?n.concat([\"center\"]):h.test(n[0])?[\"center\"].concat(n):[\"center\",\"center\"]),n[0]=u.test(n[0])?n[0]:\"center\",n[1]=h.test(n[1])?n[1]:\"center\",e=c.exec(n[0]),i=c.exec(n[1]),C[this]=[e?e[0]:0,i?i[0]:0],t[this]=[d.exec(n[0])[0],d.exec(n[1])[0]]}),1===w.length&&(w[1]=w[0]),\"right\"===t.at[0]?g.left+=f:\"center\"===t.at[0]&&(g.left+=f/2),\"bottom\"===t.at[1]?g.top+=p:\"center\"===t.at[1]&&(g.top+=p/2),o=i(C.at,f,p),g.left+=o[0],g.top+=o[1],this.each(function(){var s,u,h=e(this),c=h.outerWidth(),d=h.outerHeight(),m=n(this,\"marginLeft\"),_=n(this,\"marginTop\"),T=c+m+n(this,\"marginRight\")+x.width,k=d+_+n(this,\"marginBottom\")+x.height,E=e.extend({},g),A=i(C.my,h.outerWidth(),h.outerHeight());\"right\"===t.my[0]?E.left-=c:\"center\"===t.my[0]&&(E.left-=c/2),\"bottom\"===t.my[1]?E.top-=d:\"center\"===t.my[1]&&(E.top-=d/2),E.left+=A[0],E.top+=A[1],e.support.offsetFractions||(E.left=l(E.left),E.top=l(E.top)),s={marginLeft:m,marginTop:_},e.each([\"left\",\"top\"],function(i,n){e.ui.position[w[i]]&&e.ui.position[w[i]][n](E,{targetWidth:f,targetHeight:p,elemWidth:c,elemHeight:d,collisionPosition:s,collisionWidth:T,collisionHeight:k,offset:[o[0]+A[0],o[1]+A[1]],my:t.my,at:t.at,within:y,elem:h})}),t.using&&(u=function(e){var i=v.left-E.left,n=i+f-c,s=v.top-E.top,o=s+p-d,l={target:{element:b,left:v.left,top:v.top,width:f,height:p},element:{element:h,left:E.left,top:E.top,width:c,height:d},horizontal:0>n?\"left\":i>0?\"right\":\"center\",vertical:0>o?\"top\":s>0?\"bottom\":\"middle\"};c>f&&f>a(i+n)&&(l.horizontal=\"center\"),d>p&&p>a(s+o)&&(l.vertical=\"middle\"),l.important=r(a(i),a(n))>r(a(s),a(o))?\"horizontal\":\"vertical\",t.using.call(this,e,l)}),h.offset(e.extend(E,{using:u}))})},e.ui.position={fit:{left:function(e,t){var i,n=t.within,s=n.isWindow?n.scrollLeft:n.offset.left,o=n.width,a=e.left-t.collisionPosition.marginLeft,l=s-a,u=a+t.collisionWidth-o-s;t.collisionWidth>o?l>0&&0>=u?(i=e.left+l+t.collisionWidth-o-s,e.left+=l-i):e.left=u>0&&0>=l?s:l>u?s+o-t.collisionWidth:s:l>0?e.left+=l:u>0?e.left-=u:e.left=r(e.left-a,e.left)},top:function(e,t){var i,n=t.within,s=n.isWindow?n.scrollTop:n.offset.top,o=t.within.height,a=e.top-t.collisionPosition.marginTop,l=s-a,u=a+t.collisionHeight-o-s;t.collisionHeight>o?l>0&&0>=u?(i=e.top+l+t.collisionHeight-o-s,e.top+=l-i):e.top=u>0&&0>=l?s:l>u?s+o-t.collisionHeight:s:l>0?e.top+=l:u>0?e.top-=u:e.top=r(e.top-a,e.top)}},flip:{left:function(e,t){var i,n,s=t.within,o=s.offset.left+s.scrollLeft,r=s.width,l=s.isWindow?s.scrollLeft:s.offset.left,u=e.left-t.collisionPosition.marginLeft,h=u-l,c=u+t.collisionWidth-r-l,d=\"left\"===t.my[0]?-t.elemWidth:\"right\"===t.my[0]?t.elemWidth:0,f=\"left\"===t.at[0]?t.targetWidth:\"right\"===t.at[0]?-t.targetWidth:0,m=-2*t.offset[0];0>h?(i=e.left+d+f+m+t.collisionWidth-r-o,(0>i||a(h)>i)&&(e.left+=d+f+m)):c>0&&(n=e.left-t.collisionPosition.marginLeft+d+f+m-l,(n>0||c>a(n))&&(e.left+=d+f+m))},top:function(e,t){var i,n,s=t.within,o=s.offset.top+s.scrollTop,r=s.height,l=s.isWindow?s.scrollTop:s.offset.top,u=e.top-t.collisionPosition.marginTop,h=u-l,c=u+t.collisionHeight-r-l,d=\"top\"===t.my[1],f=d?-t.elemHeight:\"bottom\"===t.my[1]?t.elemHeight:0,m=\"top\"===t.at[1]?t.targetHeight:\"bottom\"===t.at[1]?-t.targetHeight:0,p=-2*t.offset[1];0>h?(n=e.top+f+m+p+t.collisionHeight-r-o,e.top+f+m+p>h&&(0>n||a(h)>n)&&(e.top+=f+m+p)):c>0&&(i=e.top-t.collisionPosition.marginTop+f+m+p-l,e.top+f+m+p>c&&(i>0||c>a(i))&&(e.top+=f+m+p))}},flipfit:{left:function(){e.ui.position.flip.left.apply(this,arguments),e.ui.position.fit.left.apply(this,arguments)},top:function(){e.ui.position.flip.top.apply(this,arguments),e.ui.position.fit.top.apply(this,arguments)}}},function(){var t,i,n,s,o,r=document.getElementsByTagName(\"body\")[0],a=document.createElement(\"div\");t=document.createElement(r?\"div\":\"body\"),n={visibility:\"hidden\",width:0,height:0,border:0,margin:0,background:\"none\"},r&&e.extend(n,{position:\"absolute\",left:\"-1000px\",top:\"-1000px\"});for(o in n)t.style[o]=n[o];t.appendChild(a),i=r||document.documentElement,i.insertBefore(t,i.firstChild),a.style.cssText=\"position: absolute; left: 10.7432222px;\",s=e(a).offset().left,e.support.offsetFractions=s>10&&11>s,t.innerHTML=\"\",i.removeChild(t)}()})(jQuery);(function(e){e.widget(\"ui.draggable\",e.ui.mouse,{version:\"1.10.3\",widgetEventPrefix:\"drag\",options:{addClasses:!0,appendTo:\"parent\",axis:!1,connectToSortable:!1,containment:!1,cursor:\"auto\",cursorAt:!1,grid:!1,handle:!1,helper:\"original\",iframeFix:!1,opacity:!1,refreshPositions:!1,revert:!1,revertDuration:500,scope:\"default\",scroll:!0,scrollSensitivity:20,scrollSpeed:20,snap:!1,snapMode:\"both\",snapTolerance:20,stack:!1,zIndex:!1,drag:null,start:null,stop:null},_create:function(){\"original\"!==this.options.helper||/^(?:r|a|f)/.test(this.element.css(\"position\"))||(this.element[0].style.position=\"relative\"),this.options.addClasses&&this.element.addClass(\"ui-draggable\"),this.options.disabled&&this.element.addClass(\"ui-draggable-disabled\"),this._mouseInit()},_destroy:function(){this.element.removeClass(\"ui-draggable ui-draggable-dragging ui-draggable-disabled\"),this._mouseDestroy()},_mouseCapture:function(t){var a=this.options;return this.helper||a.disabled||e(t.target).closest(\".ui-resizable-handle\").length>0?!1:(this.handle=this._getHandle(t),this.handle?(e(a.iframeFix===!0?\"iframe\":a.iframeFix).each(function(){e(\"<div class='ui-draggable-iframeFix' style='background: #fff;'></div>\").css({width:this.offsetWidth+\"px\",height:this.offsetHeight+\"px\",position:\"absolute\",opacity:\"0.001\",zIndex:1e3}).css(e(this).offset()).appendTo(\"body\")}),!0):!1)},_mouseStart:function(t){var a=this.options;return this.helper=this._createHelper(t),this.helper.addClass(\"ui-draggable-dragging\"),this._cacheHelperProportions(),e.ui.ddmanager&&(e.ui.ddmanager.current=this),this._cacheMargins(),this.cssPosition=this.helper.css(\"position\"),this.scrollParent=this.helper.scrollParent(),this.offsetParent=this.helper.offsetParent(),this.offsetParentCssPosition=this.offsetParent.css(\"position\"),this.offset=this.positionAbs=this.element.offset(),this.offset={top:this.offset.top-this.margins.top,left:this.offset.left-this.margins.left},this.offset.scroll=!1,e.extend(this.offset,{click:{left:t.pageX-this.offset.left,top:t.pageY-this.offset.top},parent:this._getParentOffset(),relative:this._getRelativeOffset()}),this.originalPosition=this.position=this._generatePosition(t),this.originalPageX=t.pageX,this.originalPageY=t.pageY,a.cursorAt&&this._adjustOffsetFromHelper(a.cursorAt),this._setContainment(),this._trigger(\"start\",t)===!1?(this._clear(),!1):(this._cacheHelperProportions(),e.ui.ddmanager&&!a.dropBehaviour&&e.ui.ddmanager.prepareOffsets(this,t),this._mouseDrag(t,!0),e.ui.ddmanager&&e.ui.ddmanager.dragStart(this,t),!0)},_mouseDrag:function(t,a){if(\"fixed\"===this.offsetParentCssPosition&&(this.offset.parent=this._getParentOffset()),this.position=this._generatePosition(t),this.positionAbs=this._convertPositionTo(\"absolute\"),!a){var i=this._uiHash();if(this._trigger(\"drag\",t,i)===!1)return this._mouseUp({}),!1;this.position=i.position}return this.options.axis&&\"y\"===this.options.axis||(this.helper[0].style.left=this.position.left+\"px\"),this.options.axis&&\"x\"===this.options.axis||(this.helper[0].style.top=this.position.top+\"px\"),e.ui.ddmanager&&e.ui.ddmanager.drag(this,t),!1},_mouseStop:function(t){var a=this,i=!1;return e.ui.ddmanager&&!this.options.dropBehaviour&&(i=e.ui.ddmanager.drop(this,t)),this.dropped&&(i=this.dropped,this.dropped=!1),\"original\"!==this.options.helper||e.contains(this.element[0].ownerDocument,this.element[0])?(\"invalid\"===this.options.revert&&!i||\"valid\"===this.options.revert&&i||this.options.revert===!0||e.isFunction(this.options.revert)&&this.options.revert.call(this.element,i)?e(this.helper).animate(this.originalPosition,parseInt(this.options.revertDuration,10),function(){a._trigger(\"stop\",t)!==!1&&a._clear()}):this._trigger(\"stop\",t)!==!1&&this._clear(),!1):!1},_mouseUp:function(t){return e(\"div.ui-draggable-iframeFix\").each(function(){this.parentNode.removeChild(this)}),e.ui.ddmanager&&e.ui.ddmanager.dragStop(this,t),e.ui.mouse.prototype._mouseUp.call(this,t)},cancel:function(){return this.helper.is(\".ui-draggable-dragging\")?this._mouseUp({}):this._clear(),this},_getHandle:function(t){return this.options.handle?!!e(t.target).closest(this.element.find(this.options.handle)).length:!0},_createHelper:function(t){var a=this.options,i=e.isFunction(a.helper)?e(a.helper.apply(this.element[0],[t])):\"clone\"===a.helper?this.element.clone().removeAttr(\"id\"):this.element;return i.parents(\"body\").length||i.appendTo(\"parent\"===a.appendTo?this.element[0].parentNode:a.appendTo),i[0]===this.element[0]||/(fixed|absolute)/.test(i.css(\"position\"))||i.css(\"position\",\"absolute\"),i},_adjustOffsetFromHelper:function(t){\"string\"==typeof t&&(t=t.split(\" \")),e.isArray(t)&&(t={left:+t[0],top:+t[1]||0}),\"left\"in t&&(this.offset.click.left=t.left+this.margins.left),\"right\"in t&&(this.offset.click.left=this.helperProportions.width-t.right+this.margins.left),\"top\"in t&&(this.offset.click.top=t.top+this.margins.top),\"bottom\"in t&&(this.offset.click.top=this.helperProportions.height-t.bottom+this.margins.top)},_getParentOffset:function(){var t=this.offsetParent.offset();return\"absolute\"===this.cssPosition&&this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])&&(t.left+=this.scrollParent.scrollLeft(),t.top+=this.scrollParent.scrollTop()),(this.offsetParent[0]===document.body||this.offsetParent[0].tagName&&\"html\"===this.offsetParent[0].tagName.toLowerCase()&&e.ui.ie)&&(t={top:0,left:0}),{top:t.top+(parseInt(this.offsetParent.css(\"borderTopWidth\"),10)||0),left:t.left+(parseInt(this.offsetParent.css(\"borderLeftWidth\"),10)||0)}},_getRelativeOffset:function(){if(\"relative\"===this.cssPosition){var e=this.element.position();return{top:e.top-(parseInt(this.helper.css(\"top\"),10)||0)+this.scrollParent.scrollTop(),left:e.left-(parseInt(this.helper.css(\"left\"),10)||0)+this.scrollParent.scrollLeft()}}return{top:0,left:0}},_cacheMargins:function(){this.margins={left:parseInt(this.element.css(\"marginLeft\"),10)||0,top:parseInt(this.element.css(\"marginTop\"),10)||0,right:parseInt(this.element.css(\"marginRight\"),10)||0,bottom:parseInt(this.element.css(\"marginBottom\"),10)||0}},_cacheHelperProportions:function(){this.helperProportions={width:this.helper.outerWidth(),height:this.helper.outerHeight()}},_setContainment:function(){var t,a,i,r=this.options;return r.containment?\"window\"===r.containment?(this.containment=[e(window).scrollLeft()-this.offset.relative.left-this.offset.parent.left,e(window).scrollTop()-this.offset.relative.top-this.offset.parent.top,e(window).scrollLeft()+e(window).width()-this.helperProportions.width-this.margins.left,e(window).scrollTop()+(e(window).height()||document.body.parentNode.scrollHeight)-this.helperProportions.height-this.margins.top],undefined):\"document\"===r.containment?(this.containment=[0,0,e(document).width()-this.helperProportions.width-this.margins.left,(e(document).height()||document.body.parentNode.scrollHeight)-this.helperProportions.height-this.margins.top],undefined):r.containment.constructor===Array?(this.containment=r.containment,undefined):(\"parent\"===r.containment&&(r.containment=this.helper[0].parentNode),a=e(r.containment),i=a[0],i&&(t=\"hidden\"!==a.css(\"overflow\"),this.containment=[(parseInt(a.css(\"borderLeftWidth\"),10)||0)+(parseInt(a.css(\"paddingLeft\"),10)||0),(parseInt(a.css(\"borderTopWidth\"),10)||0)+(parseInt(a.css(\"paddingTop\"),10)||0),(t?Math.max(i.scrollWidth,i.offsetWidth):i.offsetWidth)-(parseInt(a.css(\"borderRightWidth\"),10)||0)-(parseInt(a.css(\"paddingRight\"),10)||0)-this.helperProportions.width-this.margins.left-this.margins.right,(t?Math.max(i.scrollHeight,i.offsetHeight):i.offsetHeight)-(parseInt(a.css(\"borderBottomWidth\"),10)||0)-(parseInt(a.css(\"paddingBottom\"),10)||0)-this.helperProportions.height-this.margins.top-this.margins.bottom],this.relative_container=a),undefined):(this.containment=null,undefined)},_convertPositionTo:function(t,a){a||(a=this.position);var i=\"absolute\"===t?1:-1,r=\"absolute\"!==this.cssPosition||this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])?this.scrollParent:this.offsetParent;return this.offset.scroll||(this.offset.scroll={top:r.scrollTop(),left:r.scrollLeft()}),{top:a.top+this.offset.relative.top*i+this.offset.parent.top*i-(\"fixed\"===this.cssPosition?-this.scrollParent.scrollTop():this.offset.scroll.top)*i,left:a.left+this.offset.relative.left*i+this.offset.parent.left*i-(\"fixed\"===this.cssPosition?-this.scrollParent.scrollLeft():this.offset.scroll.left)*i}},_generatePosition:function(t){var a,i,r,n,s=this.options,o=\"absolute\"!==this.cssPosition||this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])?this.scrollParent:this.offsetParent,d=t.pageX,u=t.pageY;return this.offset.scroll||(this.offset.scroll={top:o.scrollTop(),left:o.scrollLeft()}),this.originalPosition&&(this.containment&&(this.relative_container?(i=this.relative_container.offset(),a=[this.containment[0]+i.left,this.containment[1]+i.top,this.containment[2]+i.left,this.containment[3]+i.top]):a=this.containment,t.pageX-this.offset.click.left<a[0]&&(d=a[0]+this.offset.click.left),t.pageY-this.offset.click.top<a[1]&&(u=a[1]+this.offset.click.top),t.pageX-this.offset.click.left>a[2]&&(d=a[2]+this.offset.click.left),t.pageY-this.offset.click.top>a[3]&&(u=a[3]+this.offset.click.top)),s.grid&&(r=s.grid[1]?this.originalPageY+Math.round((u-this.originalPageY)/s.grid[1])*s.grid[1]:this.originalPageY,u=a?r-this.offset.click.top>=a[1]||r-this.offset.click.top>a[3]?r:r-this.offset.click.top>=a[1]?r-s.grid[1]:r+s.grid[1]:r,n=s.grid[0]?this.originalPageX+Math.round((d-this.originalPageX)/s.grid[0])*s.grid[0]:this.originalPageX,d=a?n-this.offset.click.left>=a[0]||n-this.offset.click.left>a[2]?n:n-this.offset.click.left>=a[0]?n-s.grid[0]:n+s.grid[0]:n)),{top:u-this.offset.click.top-this.offset.relative.top-this.offset.parent.top+(\"fixed\"===this.cssPosition?-this.scrollParent.scrollTop():this.offset.scroll.top),left:d-this.offset.click.left-this.offset.relative.left-this.offset.parent.left+(\"fixed\"===this.cssPosition?-this.scrollParent.scrollLeft():this.offset.scroll.left)}},_clear:function(){this.helper.removeClass(\"ui-draggable-dragging\"),this.helper[0]===this.element[0]||this.cancelHelperRemoval||this.helper.remove(),this.helper=null,this.cancelHelperRemoval=!1},_trigger:function(t,a,i){return i=i||this._uiHash(),e.ui.plugin.call(this,t,[a,i]),\"drag\"===t&&(this.positionAbs=this._convertPositionTo(\"absolute\")),e.Widget.prototype._trigger.call(this,t,a,i)},plugins:{},_uiHash:function(){return{helper:this.helper,position:this.position,originalPosition:this.originalPosition,offset:this.positionAbs}}}),e.ui.plugin.add(\"draggable\",\"connectToSortable\",{start:function(t,a){var i=e(this).data(\"ui-draggable\"),r=i.options,n=e.extend({},a,{item:i.element});i.sortables=[],e(r.connectToSortable).each(function(){var a=e.data(this,\"ui-sortable\");a&&!a.options.disabled&&(i.sortables.push({instance:a,shouldRevert:a.options.revert}),a.refreshPositions(),a._trigger(\"activate\",t,n))})},stop:function(t,a){var i=e(this).data(\"ui-draggable\"),r=e.extend({},a,{item:i.element});e.each(i.sortables,function(){this.instance.isOver?(this.instance.isOver=0,i.cancelHelperRemoval=!0,this.instance.cancelHelperRemoval=!1,this.shouldRevert&&(this.instance.options.revert=this.shouldRevert),this.instance._mouseStop(t),this.instance.options.helper=this.instance.options._helper,\"original\"===i.options.helper&&this.instance.currentItem.css({top:\"auto\",left:\"auto\"})):(this.instance.cancelHelperRemoval=!1,this.instance._trigger(\"deactivate\",t,r))})},drag:function(t,a){var i=e(this).data(\"ui-draggable\"),r=this;e.each(i.sortables,function(){var n=!1,s=this;this.instance.positionAbs=i.positionAbs,this.instance.helperProportions=i.helperProportions,this.instance.offset.click=i.offset.click,this.instance._intersectsWith(this.instance.containerCache)&&(n=!0,e.each(i.sortables,function(){return this.instance.positionAbs=i.positionAbs,this.instance.helperProportions=i.helperProportions,this.instance.offset.click=i.offset.click,this!==s&&this.instance._intersectsWith(this.instance.containerCache)&&e.contains(s.instance.element[0],this.instance.element[0])&&(n=!1),n})),n?(this.instance.isOver||(this.instance.isOver=1,this.instance.currentItem=e(r).clone().removeAttr(\"id\").appendTo(this.instance.element).data(\"ui-sortable-item\",!0),this.instance.options._helper=this.instance.options.helper,this.instance.options.helper=function(){return a.helper[0]},t.target=this.instance.currentItem[0],this.instance._mouseCapture(t,!0),this.instance._mouseStart(t,!0,!0),this.instance.offset.click.top=i.offset.click.top,this.instance.offset.click.left=i.offset.click.left,this.instance.offset.parent.left-=i.offset.parent.left-this.instance.offset.parent.left,this.instance.offset.parent.top-=i.offset.parent.top-this.instance.offset.parent.top,i._trigger(\"toSortable\",t),i.dropped=this.instance.element,i.currentItem=i.element,this.instance.fromOutside=i),this.instance.currentItem&&this.instance._mouseDrag(t)):this.instance.isOver&&(this.instance.isOver=0,this.instance.cancelHelperRemoval=!0,this.instance.options.revert=!1,this.instance._trigger(\"out\",t,this.instance._uiHash(this.instance)),this.instance._mouseStop(t,!0),this.instance.options.helper=this.instance.options._helper,this.instance.currentItem.remove(),this.instance.placeholder&&this.instance.placeholder.remove(),i._trigger(\"fromSortable\",t),i.dropped=!1)})}}),e.ui.plugin.add(\"draggable\",\"cursor\",{start:function(){var t=e(\"body\"),a=e(this).data(\"ui-draggable\").options;t.css(\"cursor\")&&(a._cursor=t.css(\"cursor\")),t.css(\"cursor\",a.cursor)},stop:function(){var t=e(this).data(\"ui-draggable\").options;t._cursor&&e(\"body\").css(\"cursor\",t._cursor)}}),e.ui.plugin.add(\"draggable\",\"opacity\",{start:function(t,a){var i=e(a.helper),r=e(this).data(\"ui-draggable\").options;i.css(\"opacity\")&&(r._opacity=i.css(\"opacity\")),i.css(\"opacity\",r.opacity)},stop:function(t,a){var i=e(this).data(\"ui-draggable\").options;i._opacity&&e(a.helper).css(\"opacity\",i._opacity)}}),e.ui.plugin.add(\"draggable\",\"scroll\",{start:function(){var t=e(this).data(\"ui-draggable\");t.scrollParent[0]!==document&&\"HTML\"!==t.scrollParent[0].tagName&&(t.overflowOffset=t.scrollParent.offset())},drag:function(t){var a=e(this).data(\"ui-draggable\"),i=a.options,r=!1;a.scrollParent[0]!==document&&\"HTML\"!==a.scrollParent[0].tagName?(i.axis&&\"x\"===i.axis||(a.overflowOffset.top+a.scrollParent[0].offsetHeight-t.pageY<i.scrollSensitivity?a.scrollParent[0].scrollTop=r=a.scrollParent[0].scrollTop+i.scrollSpeed:t.pageY-a.overflowOffset.top<i.scrollSensitivity&&(a.scrollParent[0].scrollTop=r=a.scrollParent[0].scrollTop-i.scrollSpeed)),i.axis&&\"y\"===i.axis||(a.overflowOffset.left+a.scrollParent[0].offsetWidth-t.pageX<i.scrollSensitivity?a.scrollParent[0].scrollLeft=r=a.scrollParent[0].scrollLeft+i.scrollSpeed:t.pageX-a.overflowOffset.left<i.scrollSensitivity&&(a.scrollParent[0].scrollLeft=r=a.scrollParent[0].scrollLeft-i.scrollSpeed))):(i.axis&&\"x\"===i.axis||(t.pageY-e(document).scrollTop()<i.scrollSensitivity?r=e(document).scrollTop(e(document).scrollTop()-i.scrollSpeed):e(window).height()-(t.pageY-e(document).scrollTop())<i.scrollSensitivity&&(r=e(document).scrollTop(e(document).scrollTop()+i.scrollSpeed))),i.axis&&\"y\"===i.axis||(t.pageX-e(document).scrollLeft()<i.scrollSensitivity?r=e(document).scrollLeft(e(document).scrollLeft()-i.scrollSpeed):e(window).width()-(t.pageX-e(document).scrollLeft())<i.scrollSensitivity&&(r=e(document).scrollLeft(e(document).scrollLeft()+i.scrollSpeed)))),r!==!1&&e.ui.ddmanager&&!i.dropBehaviour&&e.ui.ddmanager.prepareOffsets(a,t)}}),e.ui.plugin.add(\"draggable\",\"snap\",{start:function(){var t=e(this).data(\"ui-draggable\"),a=t.options;t.snapElements=[],e(a.snap.constructor!==String?a.snap.items||\":data(ui-draggable)\":a.snap).each(function(){var a=e(this),i=a.offset();this!==t.element[0]&&t.snapElements.push({item:this,width:a.outerWidth(),height:a.outerHeight(),top:i.top,left:i.left})})},drag:function(t,a){var i,r,n,s,o,d,u,l,h,c,m=e(this).data(\"ui-draggable\"),p=m.options,f=p.snapTolerance,g=a.offset.left,y=g+m.helperProportions.width,v=a.offset.top,x=v+m.helperProportions.height;for(h=m.snapElements.length-1;h>=0;h--)o=m.snapElements[h].left,d=o+m.snapElements[h].width,u=m.snapElements[h].top,l=u+m.snapElements[h].height,o-f>y||g>d+f||u-f>x||v>l+f||!e.contains(m.snapElements[h].item.ownerDocument,m.snapElements[h].item)?(m.snapElements[h].snapping&&m.options.snap.release&&m.options.snap.release.call(m.element,t,e.extend(m._uiHash(),{snapItem:m.snapElements[h].item})),m.snapElements[h].snapping=!1):(\"inner\"!==p.snapMode&&(i=f>=Math.abs(u-x),r=f>=Math.abs(l-v),n=f>=Math.abs(o-y),s=f>=Math.abs(d-g),i&&(a.position.top=m._convertPositionTo(\"relative\",{top:u-m.helperProportions.height,left:0}).top-m.margins.top),r&&(a.position.top=m._convertPositionTo(\"relative\",{top:l,left:0}).top-m.margins.top),n&&(a.position.left=m._convertPositionTo(\"relative\",{top:0,left:o-m.helperProportions.width}).left-m.margins.left),s&&(a.position.left=m._convertPositionTo(\"relative\",{top:0,left:d}).left-m.margins.left)),c=i||r||n||s,\"outer\"!==p.snapMode&&(i=f>=Math.abs(u-v),r=f>=Math.abs(l-x),n=f>=Math.abs(o-g),s=f>=Math.abs(d-y),i&&(a.position.top=m._convertPositionTo(\"relative\",{top:u,left:0}).top-m.margins.top),r&&(a.position.top=m._convertPositionTo(\"relative\",{top:l-m.helperProportions.height,left:0}).top-m.margins.top),n&&(a.position.left=m._convertPositionTo(\"relative\",{top:0,left:o}).left-m.margins.left),s&&(a.position.left=m._convertPositionTo(\"relative\",{top:0,left:d-m.helperProportions.width}).left-m.margins.left)),!m.snapElements[h].snapping&&(i||r||n||s||c)&&m.options.snap.snap&&m.options.snap.snap.call(m.element,t,e.extend(m._uiHash(),{snapItem:m.snapElements[h].item})),m.snapElements[h].snapping=i||r||n||s||c)}}),e.ui.plugin.add(\"draggable\",\"stack\",{start:function(){var t,a=this.data(\"ui-draggable\").options,i=e.makeArray(e(a.stack)).sort(function(t,a){return(parseInt(e(t).css(\"zIndex\"),10)||0)-(parseInt(e(a).css(\"zIndex\"),10)||0)});i.length&&(t=parseInt(e(i[0]).css(\"zIndex\"),10)||0,e(i).each(function(a){e(this).css(\"zIndex\",t+a)}),this.css(\"zIndex\",t+i.length))}}),e.ui.plugin.add(\"draggable\",\"zIndex\",{start:function(t,a){var i=e(a.helper),r=e(this).data(\"ui-draggable\").options;i.css(\"zIndex\")&&(r._zIndex=i.css(\"zIndex\")),i.css(\"zIndex\",r.zIndex)},stop:function(t,a){var i=e(this).data(\"ui-draggable\").options;i._zIndex&&e(a.helper).css(\"zIndex\",i._zIndex)}})})(jQuery);(function(e){function t(e,t,a){return e>t&&t+a>e}e.widget(\"ui.droppable\",{version:\"1.10.3\",widgetEventPrefix:\"drop\",options:{accept:\"*\",activeClass:!1,addClasses:!0,greedy:!1,hoverClass:!1,scope:\"default\",tolerance:\"intersect\",activate:null,deactivate:null,drop:null,out:null,over:null},_create:function(){var t=this.options,a=t.accept;this.isover=!1,this.isout=!0,this.accept=e.isFunction(a)?a:function(e){return e.is(a)},this.proportions={width:this.element[0].offsetWidth,height:this.element[0].offsetHeight},e.ui.ddmanager.droppables[t.scope]=e.ui.ddmanager.droppables[t.scope]||[],e.ui.ddmanager.droppables[t.scope].push(this),t.addClasses&&this.element.addClass(\"ui-droppable\")},_destroy:function(){for(var t=0,a=e.ui.ddmanager.droppables[this.options.scope];a.length>t;t++)a[t]===this&&a.splice(t,1);this.element.removeClass(\"ui-droppable ui-droppable-disabled\")},_setOption:function(t,a){\"accept\"===t&&(this.accept=e.isFunction(a)?a:function(e){return e.is(a)}),e.Widget.prototype._setOption.apply(this,arguments)},_activate:function(t){var a=e.ui.ddmanager.current;this.options.activeClass&&this.element.addClass(this.options.activeClass),a&&this._trigger(\"activate\",t,this.ui(a))},_deactivate:function(t){var a=e.ui.ddmanager.current;this.options.activeClass&&this.element.removeClass(this.options.activeClass),a&&this._trigger(\"deactivate\",t,this.ui(a))},_over:function(t){var a=e.ui.ddmanager.current;a&&(a.currentItem||a.element)[0]!==this.element[0]&&this.accept.call(this.element[0],a.currentItem||a.element)&&(this.options.hoverClass&&this.element.addClass(this.options.hoverClass),this._trigger(\"over\",t,this.ui(a)))},_out:function(t){var a=e.ui.ddmanager.current;a&&(a.currentItem||a.element)[0]!==this.element[0]&&this.accept.call(this.element[0],a.currentItem||a.element)&&(this.options.hoverClass&&this.element.removeClass(this.options.hoverClass),this._trigger(\"out\",t,this.ui(a)))},_drop:function(t,a){var i=a||e.ui.ddmanager.current,r=!1;return i&&(i.currentItem||i.element)[0]!==this.element[0]?(this.element.find(\":data(ui-droppable)\").not(\".ui-draggable-dragging\").each(function(){var t=e.data(this,\"ui-droppable\");return t.options.greedy&&!t.options.disabled&&t.options.scope===i.options.scope&&t.accept.call(t.element[0],i.currentItem||i.element)&&e.ui.intersect(i,e.extend(t,{offset:t.element.offset()}),t.options.tolerance)?(r=!0,!1):undefined}),r?!1:this.accept.call(this.element[0],i.currentItem||i.element)?(this.options.activeClass&&this.element.removeClass(this.options.activeClass),this.options.hoverClass&&this.element.removeClass(this.options.hoverClass),this._trigger(\"drop\",t,this.ui(i)),this.element):!1):!1},ui:function(e){return{draggable:e.currentItem||e.element,helper:e.helper,position:e.position,offset:e.positionAbs}}}),e.ui.intersect=function(e,a,i){if(!a.offset)return!1;var r,s,n=(e.positionAbs||e.position.absolute).left,o=n+e.helperProportions.width,d=(e.positionAbs||e.position.absolute).top,u=d+e.helperProportions.height,l=a.offset.left,h=l+a.proportions.width,c=a.offset.top,m=c+a.proportions.height;switch(i){case\"fit\":return n>=l&&h>=o&&d>=c&&m>=u;case\"intersect\":return n+e.helperProportions.width/2>l&&h>o-e.helperProportions.width/2&&d+e.helperProportions.height/2>c&&m>u-e.helperProportions.height/2;case\"pointer\":return r=(e.positionAbs||e.position.absolute).left+(e.clickOffset||e.offset.click).left,s=(e.positionAbs||e.position.absolute).top+(e.clickOffset||e.offset.click).top,t(s,c,a.proportions.height)&&t(r,l,a.proportions.width);case\"touch\":return(d>=c&&m>=d||u>=c&&m>=u||c>d&&u>m)&&(n>=l&&h>=n||o>=l&&h>=o||l>n&&o>h);default:return!1}},e.ui.ddmanager={current:null,droppables:{\"default\":[]},prepareOffsets:function(t,a){var i,r,s=e.ui.ddmanager.droppables[t.options.scope]||[],n=a?a.type:null,o=(t.currentItem||t.element).find(\":data(ui-droppable)\").addBack();e:for(i=0;s.length>i;i++)if(!(s[i].options.disabled||t&&!s[i].accept.call(s[i].element[0],t.currentItem||t.element))){for(r=0;o.length>r;r++)if(o[r]===s[i].element[0]){s[i].proportions.height=0;continue e}s[i].visible=\"none\"!==s[i].element.css(\"display\"),s[i].visible&&(\"mousedown\"===n&&s[i]._activate.call(s[i],a),s[i].offset=s[i].element.offset(),s[i].proportions={width:s[i].element[0].offsetWidth,height:s[i].element[0].offsetHeight})}},drop:function(t,a){var i=!1;return e.each((e.ui.ddmanager.droppables[t.options.scope]||[]).slice(),function(){this.options&&(!this.options.disabled&&this.visible&&e.ui.intersect(t,this,this.options.tolerance)&&(i=this._drop.call(this,a)||i),!this.options.disabled&&this.visible&&this.accept.call(this.element[0],t.currentItem||t.element)&&(this.isout=!0,this.isover=!1,this._deactivate.call(this,a)))}),i},dragStart:function(t,a){t.element.parentsUntil(\"body\").bind(\"scroll.droppable\",function(){t.options.refreshPositions||e.ui.ddmanager.prepareOffsets(t,a)})},drag:function(t,a){t.options.refreshPositions&&e.ui.ddmanager.prepareOffsets(t,a),e.each(e.ui.ddmanager.droppables[t.options.scope]||[],function(){if(!this.options.disabled&&!this.greedyChild&&this.visible){var i,r,s,n=e.ui.intersect(t,this,this.options.tolerance),o=!n&&this.isover?\"isout\":n&&!this.isover?\"isover\":null;o&&(this.options.greedy&&(r=this.options.scope,s=this.element.parents(\":data(ui-droppable)\").filter(function(){return e.data(this,\"ui-droppable\").options.scope===r}),s.length&&(i=e.data(s[0],\"ui-droppable\"),i.greedyChild=\"isover\"===o)),i&&\"isover\"===o&&(i.isover=!1,i.isout=!0,i._out.call(i,a)),this[o]=!0,this[\"isout\"===o?\"isover\":\"isout\"]=!1,this[\"isover\"===o?\"_over\":\"_out\"].call(this,a),i&&\"isout\"===o&&(i.isout=!1,i.isover=!0,i._over.call(i,a)))}})},dragStop:function(t,a){t.element.parentsUntil(\"body\").unbind(\"scroll.droppable\"),t.options.refreshPositions||e.ui.ddmanager.prepareOffsets(t,a)}}})(jQuery);(function(e){function t(e){return parseInt(e,10)||0}function i(e){return!isNaN(parseInt(e,10))}e.widget(\"ui.resizable\",e.ui.mouse,{version:\"1.10.3\",widgetEventPrefix:\"resize\",options:{alsoResize:!1,animate:!1,animateDuration:\"slow\",animateEasing:\"swing\",aspectRatio:!1,autoHide:!1,containment:!1,ghost:!1,grid:!1,handles:\"e,s,se\",helper:!1,maxHeight:null,maxWidth:null,minHeight:10,minWidth:10,zIndex:90,resize:null,start:null,stop:null},_create:function(){var t,i,a,r,s,n=this,o=this.options;if(this.element.addClass(\"ui-resizable\"),e.extend(this,{_aspectRatio:!!o.aspectRatio,aspectRatio:o.aspectRatio,originalElement:this.element,_proportionallyResizeElements:[],_helper:o.helper||o.ghost||o.animate?o.helper||\"ui-resizable-helper\":null}),this.element[0].nodeName.match(/canvas|textarea|input|select|button|img/i)&&(this.element.wrap(e(\"<div class='ui-wrapper' style='overflow: hidden;'></div>\").css({position:this.element.css(\"position\"),width:this.element.outerWidth(),height:this.element.outerHeight(),top:this.element.css(\"top\"),left:this.element.css(\"left\")})),this.element=this.element.parent().data(\"ui-resizable\",this.element.data(\"ui-resizable\")),this.elementIsWrapper=!0,this.element.css({marginLeft:this.originalElement.css(\"marginLeft\"),marginTop:this.originalElement.css(\"marginTop\"),marginRight:this.originalElement.css(\"marginRight\"),marginBottom:this.originalElement.css(\"marginBottom\")}),this.originalElement.css({marginLeft:0,marginTop:0,marginRight:0,marginBottom:0}),this.originalResizeStyle=this.originalElement.css(\"resize\"),this.originalElement.css(\"resize\",\"none\"),this._proportionallyResizeElements.push(this.originalElement.css({position:\"static\",zoom:1,display:\"block\"})),this.originalElement.css({margin:this.originalElement.css(\"margin\")}),this._proportionallyResize()),this.handles=o.handles||(e(\".ui-resizable-handle\",this.element).length?{n:\".ui-resizable-n\",e:\".ui-resizable-e\",s:\".ui-resizable-s\",w:\".ui-resizable-w\",se:\".ui-resizable-se\",sw:\".ui-resizable-sw\",ne:\".ui-resizable-ne\",nw:\".ui-resizable-nw\"}:\"e,s,se\"),this.handles.constructor===String)for(\"all\"===this.handles&&(this.handles=\"n,e,s,w,se,sw,ne,nw\"),t=this.handles.split(\",\"),this.handles={},i=0;t.length>i;i++)a=e.trim(t[i]),s=\"ui-resizable-\"+a,r=e(\"<div class='ui-resizable-handle \"+s+\"'></div>\"),r.css({zIndex:o.zIndex}),\"se\"===a&&r.addClass(\"ui-icon ui-icon-gripsmall-diagonal-se\"),this.handles[a]=\".ui-resizable-\"+a,this.element.append(r);this._renderAxis=function(t){var i,a,r,s;t=t||this.element;for(i in this.handles)this.handles[i].constructor===String&&(this.handles[i]=e(this.handles[i],this.element).show()),this.elementIsWrapper&&this.originalElement[0].nodeName.match(/textarea|input|select|button/i)&&(a=e(this.handles[i],this.element),s=/sw|ne|nw|se|n|s/.test(i)?a.outerHeight():a.outerWidth(),r=[\"padding\",/ne|nw|n/.test(i)?\"Top\":/se|sw|s/.test(i)?\"Bottom\":/^e$/.test(i)?\"Right\":\"Left\"].join(\"\"),t.css(r,s),this._proportionallyResize()),e(this.handles[i]).length},this._renderAxis(this.element),this._handles=e(\".ui-resizable-handle\",this.element).disableSelection(),this._handles.mouseover(function(){n.resizing||(this.className&&(r=this.className.match(/ui-resizable-(se|sw|ne|nw|n|e|s|w)/i)),n.axis=r&&r[1]?r[1]:\"se\")}),o.autoHide&&(this._handles.hide(),e(this.element).addClass(\"ui-resizable-autohide\").mouseenter(function(){o.disabled||(e(this).removeClass(\"ui-resizable-autohide\"),n._handles.show())}).mouseleave(function(){o.disabled||n.resizing||(e(this).addClass(\"ui-resizable-autohide\"),n._handles.hide())})),this._mouseInit()},_destroy:function(){this._mouseDestroy();var t,i=function(t){e(t).removeClass(\"ui-resizable ui-resizable-disabled ui-resizable-resizing\").removeData(\"resizable\").removeData(\"ui-resizable\").unbind(\".resizable\").find(\".ui-resizable-handle\").remove()};return this.elementIsWrapper&&(i(this.element),t=this.element,this.originalElement.css({position:t.css(\"position\"),width:t.outerWidth(),height:t.outerHeight(),top:t.css(\"top\"),left:t.css(\"left\")}).insertAfter(t),t.remove()),this.originalElement.css(\"resize\",this.originalResizeStyle),i(this.originalElement),this},_mouseCapture:function(t){var i,a,r=!1;for(i in this.handles)a=e(this.handles[i])[0],(a===t.target||e.contains(a,t.target))&&(r=!0);return!this.options.disabled&&r},_mouseStart:function(i){var a,r,s,n=this.options,o=this.element.position(),d=this.element;return this.resizing=!0,/absolute/.test(d.css(\"position\"))?d.css({position:\"absolute\",top:d.css(\"top\"),left:d.css(\"left\")}):d.is(\".ui-draggable\")&&d.css({position:\"absolute\",top:o.top,left:o.left}),this._renderProxy(),a=t(this.helper.css(\"left\")),r=t(this.helper.css(\"top\")),n.containment&&(a+=e(n.containment).scrollLeft()||0,r+=e(n.containment).scrollTop()||0),this.offset=this.helper.offset(),this.position={left:a,top:r},this.size=this._helper?{width:d.outerWidth(),height:d.outerHeight()}:{width:d.width(),height:d.height()},this.originalSize=this._helper?{width:d.outerWidth(),height:d.outerHeight()}:{width:d.width(),height:d.height()},this.originalPosition={left:a,top:r},this.sizeDiff={width:d.outerWidth()-d.width(),height:d.outerHeight()-d.height()},this.originalMousePosition={left:i.pageX,top:i.pageY},this.aspectRatio=\"number\"==typeof n.aspectRatio?n.aspectRatio:this.originalSize.width/this.originalSize.height||1,s=e(\".ui-resizable-\"+this.axis).css(\"cursor\"),e(\"body\").css(\"cursor\",\"auto\"===s?this.axis+\"-resize\":s),d.addClass(\"ui-resizable-resizing\"),this._propagate(\"start\",i),!0},_mouseDrag:function(t){var i,a=this.helper,r={},s=this.originalMousePosition,n=this.axis,o=this.position.top,d=this.position.left,u=this.size.width,h=this.size.height,l=t.pageX-s.left||0,c=t.pageY-s.top||0,m=this._change[n];return m?(i=m.apply(this,[t,l,c]),this._updateVirtualBoundaries(t.shiftKey),(this._aspectRatio||t.shiftKey)&&(i=this._updateRatio(i,t)),i=this._respectSize(i,t),this._updateCache(i),this._propagate(\"resize\",t),this.position.top!==o&&(r.top=this.position.top+\"px\"),this.position.left!==d&&(r.left=this.position.left+\"px\"),this.size.width!==u&&(r.width=this.size.width+\"px\"),this.size.height!==h&&(r.height=this.size.height+\"px\"),a.css(r),!this._helper&&this._proportionallyResizeElements.length&&this._proportionallyResize(),e.isEmptyObject(r)||this._trigger(\"resize\",t,this.ui()),!1):!1},_mouseStop:function(t){this.resizing=!1;var i,a,r,s,n,o,d,u=this.options,h=this;return this._helper&&(i=this._proportionallyResizeElements,a=i.length&&/textarea/i.test(i[0].nodeName),r=a&&e.ui.hasScroll(i[0],\"left\")?0:h.sizeDiff.height,s=a?0:h.sizeDiff.width,n={width:h.helper.width()-s,height:h.helper.height()-r},o=parseInt(h.element.css(\"left\"),10)+(h.position.left-h.originalPosition.left)||null,d=parseInt(h.element.css(\"top\"),10)+(h.position.top-h.originalPosition.top)||null,u.animate||this.element.css(e.extend(n,{top:d,left:o})),h.helper.height(h.size.height),h.helper.width(h.size.width),this._helper&&!u.animate&&this._proportionallyResize()),e(\"body\").css(\"cursor\",\"auto\"),this.element.removeClass(\"ui-resizable-resizing\"),this._propagate(\"stop\",t),this._helper&&this.helper.remove(),!1},_updateVirtualBoundaries:function(e){var t,a,r,s,n,o=this.options;n={minWidth:i(o.minWidth)?o.minWidth:0,maxWidth:i(o.maxWidth)?o.maxWidth:1/0,minHeight:i(o.minHeight)?o.minHeight:0,maxHeight:i(o.maxHeight)?o.maxHeight:1/0},(this._aspectRatio||e)&&(t=n.minHeight*this.aspectRatio,r=n.minWidth/this.aspectRatio,a=n.maxHeight*this.aspectRatio,s=n.maxWidth/this.aspectRatio,t>n.minWidth&&(n.minWidth=t),r>n.minHeight&&(n.minHeight=r),n.maxWidth>a&&(n.maxWidth=a),n.maxHeight>s&&(n.maxHeight=s)),this._vBoundaries=n},_updateCache:function(e){this.offset=this.helper.offset(),i(e.left)&&(this.position.left=e.left),i(e.top)&&(this.position.top=e.top),i(e.height)&&(this.size.height=e.height),i(e.width)&&(this.size.width=e.width)},_updateRatio:function(e){var t=this.position,a=this.size,r=this.axis;return i(e.height)?e.width=e.height*this.aspectRatio:i(e.width)&&(e.height=e.width/this.aspectRatio),\"sw\"===r&&(e.left=t.left+(a.width-e.width),e.top=null),\"nw\"===r&&(e.top=t.top+(a.height-e.height),e.left=t.left+(a.width-e.width)),e},_respectSize:function(e){var t=this._vBoundaries,a=this.axis,r=i(e.width)&&t.maxWidth&&t.maxWidth<e.width,s=i(e.height)&&t.maxHeight&&t.maxHeight<e.height,n=i(e.width)&&t.minWidth&&t.minWidth>e.width,o=i(e.height)&&t.minHeight&&t.minHeight>e.height,d=this.originalPosition.left+this.originalSize.width,u=this.position.top+this.size.height,h=/sw|nw|w/.test(a),l=/nw|ne|n/.test(a);return n&&(e.width=t.minWidth),o&&(e.height=t.minHeight),r&&(e.width=t.maxWidth),s&&(e.height=t.maxHeight),n&&h&&(e.left=d-t.minWidth),r&&h&&(e.left=d-t.maxWidth),o&&l&&(e.top=u-t.minHeight),s&&l&&(e.top=u-t.maxHeight),e.width||e.height||e.left||!e.top?e.width||e.height||e.top||!e.left||(e.left=null):e.top=null,e},_proportionallyResize:function(){if(this._proportionallyResizeElements.length){var e,t,i,a,r,s=this.helper||this.element;for(e=0;this._proportionallyResizeElements.length>e;e++){if(r=this._proportionallyResizeElements[e],!this.borderDif)for(this.borderDif=[],i=[r.css(\"borderTopWidth\"),r.css(\"borderRightWidth\"),r.css(\"borderBottomWidth\"),r.css(\"borderLeftWidth\")],a=[r.css(\"paddingTop\"),r.css(\"paddingRight\"),r.css(\"paddingBottom\"),r.css(\"paddingLeft\")],t=0;i.length>t;t++)this.borderDif[t]=(parseInt(i[t],10)||0)+(parseInt(a[t],10)||0);r.css({height:s.height()-this.borderDif[0]-this.borderDif[2]||0,width:s.width()-this.borderDif[1]-this.borderDif[3]||0})}}},_renderProxy:function(){var t=this.element,i=this.options;this.elementOffset=t.offset(),this._helper?(this.helper=this.helper||e(\"<div style='overflow:hidden;'></div>\"),this.helper.addClass(this._helper).css({width:this.element.outerWidth()-1,height:this.element.outerHeight()-1,position:\"absolute\",left:this.elementOffset.left+\"px\",top:this.elementOffset.top+\"px\",zIndex:++i.zIndex}),this.helper.appendTo(\"body\").disableSelection()):this.helper=this.element},_change:{e:function(e,t){return{width:this.originalSize.width+t}},w:function(e,t){var i=this.originalSize,a=this.originalPosition;return{left:a.left+t,width:i.width-t}},n:function(e,t,i){var a=this.originalSize,r=this.originalPosition;return{top:r.top+i,height:a.height-i}},s:function(e,t,i){return{height:this.originalSize.height+i}},se:function(t,i,a){return e.extend(this._change.s.apply(this,arguments),this._change.e.apply(this,[t,i,a]))},sw:function(t,i,a){return e.extend(this._change.s.apply(this,arguments),this._change.w.apply(this,[t,i,a]))},ne:function(t,i,a){return e.extend(this._change.n.apply(this,arguments),this._change.e.apply(this,[t,i,a]))},nw:function(t,i,a){return e.extend(this._change.n.apply(this,arguments),this._change.w.apply(this,[t,i,a]))}},_propagate:function(t,i){e.ui.plugin.call(this,t,[i,this.ui()]),\"resize\"!==t&&this._trigger(t,i,this.ui())},plugins:{},ui:function(){return{originalElement:this.originalElement,element:this.element,helper:this.helper,position:this.position,size:this.size,originalSize:this.originalSize,originalPosition:this.originalPosition}}}),e.ui.plugin.add(\"resizable\",\"animate\",{stop:function(t){var i=e(this).data(\"ui-resizable\"),a=i.options,r=i._proportionallyResizeElements,s=r.length&&/textarea/i.test(r[0].nodeName),n=s&&e.ui.hasScroll(r[0],\"left\")?0:i.sizeDiff.height,o=s?0:i.sizeDiff.width,d={width:i.size.width-o,height:i.size.height-n},u=parseInt(i.element.css(\"left\"),10)+(i.position.left-i.originalPosition.left)||null,h=parseInt(i.element.css(\"top\"),10)+(i.position.top-i.originalPosition.top)||null;i.element.animate(e.extend(d,h&&u?{top:h,left:u}:{}),{duration:a.animateDuration,easing:a.animateEasing,step:function(){var a={width:parseInt(i.element.css(\"width\"),10),height:parseInt(i.element.css(\"height\"),10),top:parseInt(i.element.css(\"top\"),10),left:parseInt(i.element.css(\"left\"),10)};r&&r.length&&e(r[0]).css({width:a.width,height:a.height}),i._updateCache(a),i._propagate(\"resize\",t)}})}}),e.ui.plugin.add(\"resizable\",\"containment\",{start:function(){var i,a,r,s,n,o,d,u=e(this).data(\"ui-resizable\"),h=u.options,l=u.element,c=h.containment,m=c instanceof e?c.get(0):/parent/.test(c)?l.parent().get(0):c;m&&(u.containerElement=e(m),/document/.test(c)||c===document?(u.containerOffset={left:0,top:0},u.containerPosition={left:0,top:0},u.parentData={element:e(document),left:0,top:0,width:e(document).width(),height:e(document).height()||document.body.parentNode.scrollHeight}):(i=e(m),a=[],e([\"Top\",\"Right\",\"Left\",\"Bottom\"]).each(function(e,r){a[e]=t(i.css(\"padding\"+r))}),u.containerOffset=i.offset(),u.containerPosition=i.position(),u.containerSize={height:i.innerHeight()-a[3],width:i.innerWidth()-a[1]},r=u.containerOffset,s=u.containerSize.height,n=u.containerSize.width,o=e.ui.hasScroll(m,\"left\")?m.scrollWidth:n,d=e.ui.hasScroll(m)?m.scrollHeight:s,u.parentData={element:m,left:r.left,top:r.top,width:o,height:d}))},resize:function(t){var i,a,r,s,n=e(this).data(\"ui-resizable\"),o=n.options,d=n.containerOffset,u=n.position,h=n._aspectRatio||t.shiftKey,l={top:0,left:0},c=n.containerElement;c[0]!==document&&/static/.test(c.css(\"position\"))&&(l=d),u.left<(n._helper?d.left:0)&&(n.size.width=n.size.width+(n._helper?n.position.left-d.left:n.position.left-l.left),h&&(n.size.height=n.size.width/n.aspectRatio),n.position.left=o.helper?d.left:0),u.top<(n._helper?d.top:0)&&(n.size.height=n.size.height+(n._helper?n.position.top-d.top:n.position.top),h&&(n.size.width=n.size.height*n.aspectRatio),n.position.top=n._helper?d.top:0),n.offset.left=n.parentData.left+n.position.left,n.offset.top=n.parentData.top+n.position.top,i=Math.abs((n._helper?n.offset.left-l.left:n.offset.left-l.left)+n.sizeDiff.width),a=Math.abs((n._helper?n.offset.top-l.top:n.offset.top-d.top)+n.sizeDiff.height),r=n.containerElement.get(0)===n.element.parent().get(0),s=/relative|absolute/.test(n.containerElement.css(\"position\")),r&&s&&(i-=n.parentData.left),i+n.size.width>=n.parentData.width&&(n.size.width=n.parentData.width-i,h&&(n.size.height=n.size.width/n.aspectRatio)),a+n.size.height>=n.parentData.height&&(n.size.height=n.parentData.height-a,h&&(n.size.width=n.size.height*n.aspectRatio))},stop:function(){var t=e(this).data(\"ui-resizable\"),i=t.options,a=t.containerOffset,r=t.containerPosition,s=t.containerElement,n=e(t.helper),o=n.offset(),d=n.outerWidth()-t.sizeDiff.width,u=n.outerHeight()-t.sizeDiff.height;t._helper&&!i.animate&&/relative/.test(s.css(\"position\"))&&e(this).css({left:o.left-r.left-a.left,width:d,height:u}),t._helper&&!i.animate&&/static/.test(s.css(\"position\"))&&e(this).css({left:o.left-r.left-a.left,width:d,height:u})}}),e.ui.plugin.add(\"resizable\",\"alsoResize\",{start:function(){var t=e(this).data(\"ui-resizable\"),i=t.options,a=function(t){e(t).each(function(){var t=e(this);t.data(\"ui-resizable-alsoresize\",{width:parseInt(t.width(),10),height:parseInt(t.height(),10),left:parseInt(t.css(\"left\"),10),top:parseInt(t.css(\"top\"),10)})})};\"object\"!=typeof i.alsoResize||i.alsoResize.parentNode?a(i.alsoResize):i.alsoResize.length?(i.alsoResize=i.alsoResize[0],a(i.alsoResize)):e.each(i.alsoResize,function(e){a(e)})},resize:function(t,i){var a=e(this).data(\"ui-resizable\"),r=a.options,s=a.originalSize,n=a.originalPosition,o={height:a.size.height-s.height||0,width:a.size.width-s.width||0,top:a.position.top-n.top||0,left:a.position.left-n.left||0},d=function(t,a){e(t).each(function(){var t=e(this),r=e(this).data(\"ui-resizable-alsoresize\"),s={},n=a&&a.length?a:t.parents(i.originalElement[0]).length?[\"width\",\"height\"]:[\"width\",\"height\",\"top\",\"left\"];e.each(n,function(e,t){var i=(r[t]||0)+(o[t]||0);i&&i>=0&&(s[t]=i||null)}),t.css(s)})};\"object\"!=typeof r.alsoResize||r.alsoResize.nodeType?d(r.alsoResize):e.each(r.alsoResize,function(e,t){d(e,t)})},stop:function(){e(this).removeData(\"resizable-alsoresize\")}}),e.ui.plugin.add(\"resizable\",\"ghost\",{start:function(){var t=e(this).data(\"ui-resizable\"),i=t.options,a=t.size;t.ghost=t.originalElement.clone(),t.ghost.css({opacity:.25,display:\"block\",position:\"relative\",height:a.height,width:a.width,margin:0,left:0,top:0}).addClass(\"ui-resizable-ghost\").addClass(\"string\"==typeof i.ghost?i.ghost:\"\"),t.ghost.appendTo(t.helper)},resize:function(){var t=e(this).data(\"ui-resizable\");t.ghost&&t.ghost.css({position:\"relative\",height:t.size.height,width:t.size.width})},stop:function(){var t=e(this).data(\"ui-resizable\");t.ghost&&t.helper&&t.helper.get(0).removeChild(t.ghost.get(0))}}),e.ui.plugin.add(\"resizable\",\"grid\",{resize:function(){var t=e(this).data(\"ui-resizable\"),i=t.options,a=t.size,r=t.originalSize,s=t.originalPosition,n=t.axis,o=\"number\"==typeof i.grid?[i.grid,i.grid]:i.grid,d=o[0]||1,u=o[1]||1,h=Math.round((a.width-r.width)/d)*d,l=Math.round((a.height-r.height)/u)*u,c=r.width+h,m=r.height+l,p=i.maxWidth&&c>i.maxWidth,f=i.maxHeight&&m>i.maxHeight,g=i.minWidth&&i.minWidth>c,y=i.minHeight&&i.minHeight>m;i.grid=o,g&&(c+=d),y&&(m+=u),p&&(c-=d),f&&(m-=u),/^(se|s|e)$/.test(n)?(t.size.width=c,t.size.height=m):/^(ne)$/.test(n)?(t.size.width=c,t.size.height=m,t.position.top=s.top-l):/^(sw)$/.test(n)?(t.size.width=c,t.size.height=m,t.position.left=s.left-h):(t.size.width=c,t.size.height=m,t.position.top=s.top-l,t.position.left=s.left-h)}})})(jQuery);(function(e){e.widget(\"ui.selectable\",e.ui.mouse,{version:\"1.10.3\",options:{appendTo:\"body\",autoRefresh:!0,distance:0,filter:\"*\",tolerance:\"touch\",selected:null,selecting:null,start:null,stop:null,unselected:null,unselecting:null},_create:function(){var t,i=this;this.element.addClass(\"ui-selectable\"),this.dragged=!1,this.refresh=function(){t=e(i.options.filter,i.element[0]),t.addClass(\"ui-selectee\"),t.each(function(){var t=e(this),i=t.offset();e.data(this,\"selectable-item\",{element:this,$element:t,left:i.left,top:i.top,right:i.left+t.outerWidth(),bottom:i.top+t.outerHeight(),startselected:!1,selected:t.hasClass(\"ui-selected\"),selecting:t.hasClass(\"ui-selecting\"),unselecting:t.hasClass(\"ui-unselecting\")})})},this.refresh(),this.selectees=t.addClass(\"ui-selectee\"),this._mouseInit(),this.helper=e(\"<div class='ui-selectable-helper'></div>\")},_destroy:function(){this.selectees.removeClass(\"ui-selectee\").removeData(\"selectable-item\"),this.element.removeClass(\"ui-selectable ui-selectable-disabled\"),this._mouseDestroy()},_mouseStart:function(t){var i=this,a=this.options;this.opos=[t.pageX,t.pageY],this.options.disabled||(this.selectees=e(a.filter,this.element[0]),this._trigger(\"start\",t),e(a.appendTo).append(this.helper),this.helper.css({left:t.pageX,top:t.pageY,width:0,height:0}),a.autoRefresh&&this.refresh(),this.selectees.filter(\".ui-selected\").each(function(){var a=e.data(this,\"selectable-item\");a.startselected=!0,t.metaKey||t.ctrlKey||(a.$element.removeClass(\"ui-selected\"),a.selected=!1,a.$element.addClass(\"ui-unselecting\"),a.unselecting=!0,i._trigger(\"unselecting\",t,{unselecting:a.element}))}),e(t.target).parents().addBack().each(function(){var a,r=e.data(this,\"selectable-item\");return r?(a=!t.metaKey&&!t.ctrlKey||!r.$element.hasClass(\"ui-selected\"),r.$element.removeClass(a?\"ui-unselecting\":\"ui-selected\").addClass(a?\"ui-selecting\":\"ui-unselecting\"),r.unselecting=!a,r.selecting=a,r.selected=a,a?i._trigger(\"selecting\",t,{selecting:r.element}):i._trigger(\"unselecting\",t,{unselecting:r.element}),!1):undefined}))},_mouseDrag:function(t){if(this.dragged=!0,!this.options.disabled){var i,a=this,r=this.options,s=this.opos[0],n=this.opos[1],o=t.pageX,d=t.pageY;return s>o&&(i=o,o=s,s=i),n>d&&(i=d,d=n,n=i),this.helper.css({left:s,top:n,width:o-s,height:d-n}),this.selectees.each(function(){var i=e.data(this,\"selectable-item\"),u=!1;i&&i.element!==a.element[0]&&(\"touch\"===r.tolerance?u=!(i.left>o||s>i.right||i.top>d||n>i.bottom):\"fit\"===r.tolerance&&(u=i.left>s&&o>i.right&&i.top>n&&d>i.bottom),u?(i.selected&&(i.$element.removeClass(\"ui-selected\"),i.selected=!1),i.unselecting&&(i.$element.removeClass(\"ui-unselecting\"),i.unselecting=!1),i.selecting||(i.$element.addClass(\"ui-selecting\"),i.selecting=!0,a._trigger(\"selecting\",t,{selecting:i.element}))):(i.selecting&&((t.metaKey||t.ctrlKey)&&i.startselected?(i.$element.removeClass(\"ui-selecting\"),i.selecting=!1,i.$element.addClass(\"ui-selected\"),i.selected=!0):(i.$element.removeClass(\"ui-selecting\"),i.selecting=!1,i.startselected&&(i.$element.addClass(\"ui-unselecting\"),i.unselecting=!0),a._trigger(\"unselecting\",t,{unselecting:i.element}))),i.selected&&(t.metaKey||t.ctrlKey||i.startselected||(i.$element.removeClass(\"ui-selected\"),i.selected=!1,i.$element.addClass(\"ui-unselecting\"),i.unselecting=!0,a._trigger(\"unselecting\",t,{unselecting:i.element})))))}),!1}},_mouseStop:function(t){var i=this;return this.dragged=!1,e(\".ui-unselecting\",this.element[0]).each(function(){var a=e.data(this,\"selectable-item\");a.$element.removeClass(\"ui-unselecting\"),a.unselecting=!1,a.startselected=!1,i._trigger(\"unselected\",t,{unselected:a.element})}),e(\".ui-selecting\",this.element[0]).each(function(){var a=e.data(this,\"selectable-item\");a.$element.removeClass(\"ui-selecting\").addClass(\"ui-selected\"),a.selecting=!1,a.selected=!0,a.startselected=!0,i._trigger(\"selected\",t,{selected:a.element})}),this._trigger(\"stop\",t),this.helper.remove(),!1}})})(jQuery);(function(e){function t(e,t,i){return e>t&&t+i>e}function i(e){return/left|right/.test(e.css(\"float\"))||/inline|table-cell/.test(e.css(\"display\"))}e.widget(\"ui.sortable\",e.ui.mouse,{version:\"1.10.3\",widgetEventPrefix:\"sort\",ready:!1,options:{appendTo:\"parent\",axis:!1,connectWith:!1,containment:!1,cursor:\"auto\",cursorAt:!1,dropOnEmpty:!0,forcePlaceholderSize:!1,forceHelperSize:!1,grid:!1,handle:!1,helper:\"original\",items:\"> *\",opacity:!1,placeholder:!1,revert:!1,scroll:!0,scrollSensitivity:20,scrollSpeed:20,scope:\"default\",tolerance:\"intersect\",zIndex:1e3,activate:null,beforeStop:null,change:null,deactivate:null,out:null,over:null,receive:null,remove:null,sort:null,start:null,stop:null,update:null},_create:function(){var e=this.options;this.containerCache={},this.element.addClass(\"ui-sortable\"),this.refresh(),this.floating=this.items.length?\"x\"===e.axis||i(this.items[0].item):!1,this.offset=this.element.offset(),this._mouseInit(),this.ready=!0},_destroy:function(){this.element.removeClass(\"ui-sortable ui-sortable-disabled\"),this._mouseDestroy();for(var e=this.items.length-1;e>=0;e--)this.items[e].item.removeData(this.widgetName+\"-item\");return this},_setOption:function(t,i){\"disabled\"===t?(this.options[t]=i,this.widget().toggleClass(\"ui-sortable-disabled\",!!i)):e.Widget.prototype._setOption.apply(this,arguments)},_mouseCapture:function(t,i){var a=null,s=!1,r=this;return this.reverting?!1:this.options.disabled||\"static\"===this.options.type?!1:(this._refreshItems(t),e(t.target).parents().each(function(){return e.data(this,r.widgetName+\"-item\")===r?(a=e(this),!1):undefined}),e.data(t.target,r.widgetName+\"-item\")===r&&(a=e(t.target)),a?!this.options.handle||i||(e(this.options.handle,a).find(\"*\").addBack().each(function(){this===t.target&&(s=!0)}),s)?(this.currentItem=a,this._removeCurrentsFromItems(),!0):!1:!1)},_mouseStart:function(t,i,a){var s,r,n=this.options;if(this.currentContainer=this,this.refreshPositions(),this.helper=this._createHelper(t),this._cacheHelperProportions(),this._cacheMargins(),this.scrollParent=this.helper.scrollParent(),this.offset=this.currentItem.offset(),this.offset={top:this.offset.top-this.margins.top,left:this.offset.left-this.margins.left},e.extend(this.offset,{click:{left:t.pageX-this.offset.left,top:t.pageY-this.offset.top},parent:this._getParentOffset(),relative:this._getRelativeOffset()}),this.helper.css(\"position\",\"absolute\"),this.cssPosition=this.helper.css(\"position\"),this.originalPosition=this._generatePosition(t),this.originalPageX=t.pageX,this.originalPageY=t.pageY,n.cursorAt&&this._adjustOffsetFromHelper(n.cursorAt),this.domPosition={prev:this.currentItem.prev()[0],parent:this.currentItem.parent()[0]},this.helper[0]!==this.currentItem[0]&&this.currentItem.hide(),this._createPlaceholder(),n.containment&&this._setContainment(),n.cursor&&\"auto\"!==n.cursor&&(r=this.document.find(\"body\"),this.storedCursor=r.css(\"cursor\"),r.css(\"cursor\",n.cursor),this.storedStylesheet=e(\"<style>*{ cursor: \"+n.cursor+\" !important; }</style>\").appendTo(r)),n.opacity&&(this.helper.css(\"opacity\")&&(this._storedOpacity=this.helper.css(\"opacity\")),this.helper.css(\"opacity\",n.opacity)),n.zIndex&&(this.helper.css(\"zIndex\")&&(this._storedZIndex=this.helper.css(\"zIndex\")),this.helper.css(\"zIndex\",n.zIndex)),this.scrollParent[0]!==document&&\"HTML\"!==this.scrollParent[0].tagName&&(this.overflowOffset=this.scrollParent.offset()),this._trigger(\"start\",t,this._uiHash()),this._preserveHelperProportions||this._cacheHelperProportions(),!a)for(s=this.containers.length-1;s>=0;s--)this.containers[s]._trigger(\"activate\",t,this._uiHash(this));return e.ui.ddmanager&&(e.ui.ddmanager.current=this),e.ui.ddmanager&&!n.dropBehaviour&&e.ui.ddmanager.prepareOffsets(this,t),this.dragging=!0,this.helper.addClass(\"ui-sortable-helper\"),this._mouseDrag(t),!0},_mouseDrag:function(t){var i,a,s,r,n=this.options,o=!1;for(this.position=this._generatePosition(t),this.positionAbs=this._convertPositionTo(\"absolute\"),this.lastPositionAbs||(this.lastPositionAbs=this.positionAbs),this.options.scroll&&(this.scrollParent[0]!==document&&\"HTML\"!==this.scrollParent[0].tagName?(this.overflowOffset.top+this.scrollParent[0].offsetHeight-t.pageY<n.scrollSensitivity?this.scrollParent[0].scrollTop=o=this.scrollParent[0].scrollTop+n.scrollSpeed:t.pageY-this.overflowOffset.top<n.scrollSensitivity&&(this.scrollParent[0].scrollTop=o=this.scrollParent[0].scrollTop-n.scrollSpeed),this.overflowOffset.left+this.scrollParent[0].offsetWidth-t.pageX<n.scrollSensitivity?this.scrollParent[0].scrollLeft=o=this.scrollParent[0].scrollLeft+n.scrollSpeed:t.pageX-this.overflowOffset.left<n.scrollSensitivity&&(this.scrollParent[0].scrollLeft=o=this.scrollParent[0].scrollLeft-n.scrollSpeed)):(t.pageY-e(document).scrollTop()<n.scrollSensitivity?o=e(document).scrollTop(e(document).scrollTop()-n.scrollSpeed):e(window).height()-(t.pageY-e(document).scrollTop())<n.scrollSensitivity&&(o=e(document).scrollTop(e(document).scrollTop()+n.scrollSpeed)),t.pageX-e(document).scrollLeft()<n.scrollSensitivity?o=e(document).scrollLeft(e(document).scrollLeft()-n.scrollSpeed):e(window).width()-(t.pageX-e(document).scrollLeft())<n.scrollSensitivity&&(o=e(document).scrollLeft(e(document).scrollLeft()+n.scrollSpeed))),o!==!1&&e.ui.ddmanager&&!n.dropBehaviour&&e.ui.ddmanager.prepareOffsets(this,t)),this.positionAbs=this._convertPositionTo(\"absolute\"),this.options.axis&&\"y\"===this.options.axis||(this.helper[0].style.left=this.position.left+\"px\"),this.options.axis&&\"x\"===this.options.axis||(this.helper[0].style.top=this.position.top+\"px\"),i=this.items.length-1;i>=0;i--)if(a=this.items[i],s=a.item[0],r=this._intersectsWithPointer(a),r&&a.instance===this.currentContainer&&s!==this.currentItem[0]&&this.placeholder[1===r?\"next\":\"prev\"]()[0]!==s&&!e.contains(this.placeholder[0],s)&&(\"semi-dynamic\"===this.options.type?!e.contains(this.element[0],s):!0)){if(this.direction=1===r?\"down\":\"up\",\"pointer\"!==this.options.tolerance&&!this._intersectsWithSides(a))break;this._rearrange(t,a),this._trigger(\"change\",t,this._uiHash());break}return this._contactContainers(t),e.ui.ddmanager&&e.ui.ddmanager.drag(this,t),this._trigger(\"sort\",t,this._uiHash()),this.lastPositionAbs=this.positionAbs,!1},_mouseStop:function(t,i){if(t){if(e.ui.ddmanager&&!this.options.dropBehaviour&&e.ui.ddmanager.drop(this,t),this.options.revert){var a=this,s=this.placeholder.offset(),r=this.options.axis,n={};r&&\"x\"!==r||(n.left=s.left-this.offset.parent.left-this.margins.left+(this.offsetParent[0]===document.body?0:this.offsetParent[0].scrollLeft)),r&&\"y\"!==r||(n.top=s.top-this.offset.parent.top-this.margins.top+(this.offsetParent[0]===document.body?0:this.offsetParent[0].scrollTop)),this.reverting=!0,e(this.helper).animate(n,parseInt(this.options.revert,10)||500,function(){a._clear(t)})}else this._clear(t,i);return!1}},cancel:function(){if(this.dragging){this._mouseUp({target:null}),\"original\"===this.options.helper?this.currentItem.css(this._storedCSS).removeClass(\"ui-sortable-helper\"):this.currentItem.show();for(var t=this.containers.length-1;t>=0;t--)this.containers[t]._trigger(\"deactivate\",null,this._uiHash(this)),this.containers[t].containerCache.over&&(this.containers[t]._trigger(\"out\",null,this._uiHash(this)),this.containers[t].containerCache.over=0)}return this.placeholder&&(this.placeholder[0].parentNode&&this.placeholder[0].parentNode.removeChild(this.placeholder[0]),\"original\"!==this.options.helper&&this.helper&&this.helper[0].parentNode&&this.helper.remove(),e.extend(this,{helper:null,dragging:!1,reverting:!1,_noFinalSort:null}),this.domPosition.prev?e(this.domPosition.prev).after(this.currentItem):e(this.domPosition.parent).prepend(this.currentItem)),this},serialize:function(t){var i=this._getItemsAsjQuery(t&&t.connected),a=[];return t=t||{},e(i).each(function(){var i=(e(t.item||this).attr(t.attribute||\"id\")||\"\").match(t.expression||/(.+)[\\-=_](.+)/);i&&a.push((t.key||i[1]+\"[]\")+\"=\"+(t.key&&t.expression?i[1]:i[2]))}),!a.length&&t.key&&a.push(t.key+\"=\"),a.join(\"&\")},toArray:function(t){var i=this._getItemsAsjQuery(t&&t.connected),a=[];return t=t||{},i.each(function(){a.push(e(t.item||this).attr(t.attribute||\"id\")||\"\")}),a},_intersectsWith:function(e){var t=this.positionAbs.left,i=t+this.helperProportions.width,a=this.positionAbs.top,s=a+this.helperProportions.height,r=e.left,n=r+e.width,o=e.top,h=o+e.height,l=this.offset.click.top,u=this.offset.click.left,d=\"x\"===this.options.axis||a+l>o&&h>a+l,c=\"y\"===this.options.axis||t+u>r&&n>t+u,p=d&&c;return\"pointer\"===this.options.tolerance||this.options.forcePointerForContainers||\"pointer\"!==this.options.tolerance&&this.helperProportions[this.floating?\"width\":\"height\"]>e[this.floating?\"width\":\"height\"]?p:t+this.helperProportions.width/2>r&&n>i-this.helperProportions.width/2&&a+this.helperProportions.height/2>o&&h>s-this.helperProportions.height/2},_intersectsWithPointer:function(e){var i=\"x\"===this.options.axis||t(this.positionAbs.top+this.offset.click.top,e.top,e.height),a=\"y\"===this.options.axis||t(this.positionAbs.left+this.offset.click.left,e.left,e.width),s=i&&a,r=this._getDragVerticalDirection(),n=this._getDragHorizontalDirection();return s?this.floating?n&&\"right\"===n||\"down\"===r?2:1:r&&(\"down\"===r?2:1):!1},_intersectsWithSides:function(e){var i=t(this.positionAbs.top+this.offset.click.top,e.top+e.height/2,e.height),a=t(this.positionAbs.left+this.offset.click.left,e.left+e.width/2,e.width),s=this._getDragVerticalDirection(),r=this._getDragHorizontalDirection();return this.floating&&r?\"right\"===r&&a||\"left\"===r&&!a:s&&(\"down\"===s&&i||\"up\"===s&&!i)},_getDragVerticalDirection:function(){var e=this.positionAbs.top-this.lastPositionAbs.top;return 0!==e&&(e>0?\"down\":\"up\")},_getDragHorizontalDirection:function(){var e=this.positionAbs.left-this.lastPositionAbs.left;return 0!==e&&(e>0?\"right\":\"left\")},refresh:function(e){return this._refreshItems(e),this.refreshPositions(),this},_connectWith:function(){var e=this.options;return e.connectWith.constructor===String?[e.connectWith]:e.connectWith},_getItemsAsjQuery:function(t){var i,a,s,r,n=[],o=[],h=this._connectWith();if(h&&t)for(i=h.length-1;i>=0;i--)for(s=e(h[i]),a=s.length-1;a>=0;a--)r=e.data(s[a],this.widgetFullName),r&&r!==this&&!r.options.disabled&&o.push([e.isFunction(r.options.items)?r.options.items.call(r.element):e(r.options.items,r.element).not(\".ui-sortable-helper\").not(\".ui-sortable-placeholder\"),r]);for(o.push([e.isFunction(this.options.items)?this.options.items.call(this.element,null,{options:this.options,item:this.currentItem}):e(this.options.items,this.element).not(\".ui-sortable-helper\").not(\".ui-sortable-placeholder\"),this]),i=o.length-1;i>=0;i--)o[i][0].each(function(){n.push(this)});return e(n)},_removeCurrentsFromItems:function(){var t=this.currentItem.find(\":data(\"+this.widgetName+\"-item)\");this.items=e.grep(this.items,function(e){for(var i=0;t.length>i;i++)if(t[i]===e.item[0])return!1;return!0})},_refreshItems:function(t){this.items=[],this.containers=[this];var i,a,s,r,n,o,h,l,u=this.items,d=[[e.isFunction(this.options.items)?this.options.items.call(this.element[0],t,{item:this.currentItem}):e(this.options.items,this.element),this]],c=this._connectWith();if(c&&this.ready)for(i=c.length-1;i>=0;i--)for(s=e(c[i]),a=s.length-1;a>=0;a--)r=e.data(s[a],this.widgetFullName),r&&r!==this&&!r.options.disabled&&(d.push([e.isFunction(r.options.items)?r.options.items.call(r.element[0],t,{item:this.currentItem}):e(r.options.items,r.element),r]),this.containers.push(r));for(i=d.length-1;i>=0;i--)for(n=d[i][1],o=d[i][0],a=0,l=o.length;l>a;a++)h=e(o[a]),h.data(this.widgetName+\"-item\",n),u.push({item:h,instance:n,width:0,height:0,left:0,top:0})},refreshPositions:function(t){this.offsetParent&&this.helper&&(this.offset.parent=this._getParentOffset());var i,a,s,r;for(i=this.items.length-1;i>=0;i--)a=this.items[i],a.instance!==this.currentContainer&&this.currentContainer&&a.item[0]!==this.currentItem[0]||(s=this.options.toleranceElement?e(this.options.toleranceElement,a.item):a.item,t||(a.width=s.outerWidth(),a.height=s.outerHeight()),r=s.offset(),a.left=r.left,a.top=r.top);if(this.options.custom&&this.options.custom.refreshContainers)this.options.custom.refreshContainers.call(this);else for(i=this.containers.length-1;i>=0;i--)r=this.containers[i].element.offset(),this.containers[i].containerCache.left=r.left,this.containers[i].containerCache.top=r.top,this.containers[i].containerCache.width=this.containers[i].element.outerWidth(),this.containers[i].containerCache.height=this.containers[i].element.outerHeight();return this},_createPlaceholder:function(t){t=t||this;var i,a=t.options;a.placeholder&&a.placeholder.constructor!==String||(i=a.placeholder,a.placeholder={element:function(){var a=t.currentItem[0].nodeName.toLowerCase(),s=e(\"<\"+a+\">\",t.document[0]).addClass(i||t.currentItem[0].className+\" ui-sortable-placeholder\").removeClass(\"ui-sortable-helper\");return\"tr\"===a?t.currentItem.children().each(function(){e(\"<td>&#160;</td>\",t.document[0]).attr(\"colspan\",e(this).attr(\"colspan\")||1).appendTo(s)}):\"img\"===a&&s.attr(\"src\",t.currentItem.attr(\"src\")),i||s.css(\"visibility\",\"hidden\"),s},update:function(e,s){(!i||a.forcePlaceholderSize)&&(s.height()||s.height(t.currentItem.innerHeight()-parseInt(t.currentItem.css(\"paddingTop\")||0,10)-parseInt(t.currentItem.css(\"paddingBottom\")||0,10)),s.width()||s.width(t.currentItem.innerWidth()-parseInt(t.currentItem.css(\"paddingLeft\")||0,10)-parseInt(t.currentItem.css(\"paddingRight\")||0,10)))}}),t.placeholder=e(a.placeholder.element.call(t.element,t.currentItem)),t.currentItem.after(t.placeholder),a.placeholder.update(t,t.placeholder)},_contactContainers:function(a){var s,r,n,o,h,l,u,d,c,p,m=null,f=null;for(s=this.containers.length-1;s>=0;s--)if(!e.contains(this.currentItem[0],this.containers[s].element[0]))if(this._intersectsWith(this.containers[s].containerCache)){if(m&&e.contains(this.containers[s].element[0],m.element[0]))continue;m=this.containers[s],f=s}else this.containers[s].containerCache.over&&(this.containers[s]._trigger(\"out\",a,this._uiHash(this)),this.containers[s].containerCache.over=0);if(m)if(1===this.containers.length)this.containers[f].containerCache.over||(this.containers[f]._trigger(\"over\",a,this._uiHash(this)),this.containers[f].containerCache.over=1);else{for(n=1e4,o=null,p=m.floating||i(this.currentItem),h=p?\"left\":\"top\",l=p?\"width\":\"height\",u=this.positionAbs[h]+this.offset.click[h],r=this.items.length-1;r>=0;r--)e.contains(this.containers[f].element[0],this.items[r].item[0])&&this.items[r].item[0]!==this.currentItem[0]&&(!p||t(this.positionAbs.top+this.offset.click.top,this.items[r].top,this.items[r].height))&&(d=this.items[r].item.offset()[h],c=!1,Math.abs(d-u)>Math.abs(d+this.items[r][l]-u)&&(c=!0,d+=this.items[r][l]),n>Math.abs(d-u)&&(n=Math.abs(d-u),o=this.items[r],this.direction=c?\"up\":\"down\"));if(!o&&!this.options.dropOnEmpty)return;if(this.currentContainer===this.containers[f])return;o?this._rearrange(a,o,null,!0):this._rearrange(a,null,this.containers[f].element,!0),this._trigger(\"change\",a,this._uiHash()),this.containers[f]._trigger(\"change\",a,this._uiHash(this)),this.currentContainer=this.containers[f],this.options.placeholder.update(this.currentContainer,this.placeholder),this.containers[f]._trigger(\"over\",a,this._uiHash(this)),this.containers[f].containerCache.over=1}},_createHelper:function(t){var i=this.options,a=e.isFunction(i.helper)?e(i.helper.apply(this.element[0],[t,this.currentItem])):\"clone\"===i.helper?this.currentItem.clone():this.currentItem;return a.parents(\"body\").length||e(\"parent\"!==i.appendTo?i.appendTo:this.currentItem[0].parentNode)[0].appendChild(a[0]),a[0]===this.currentItem[0]&&(this._storedCSS={width:this.currentItem[0].style.width,height:this.currentItem[0].style.height,position:this.currentItem.css(\"position\"),top:this.currentItem.css(\"top\"),left:this.currentItem.css(\"left\")}),(!a[0].style.width||i.forceHelperSize)&&a.width(this.currentItem.width()),(!a[0].style.height||i.forceHelperSize)&&a.height(this.currentItem.height()),a},_adjustOffsetFromHelper:function(t){\"string\"==typeof t&&(t=t.split(\" \")),e.isArray(t)&&(t={left:+t[0],top:+t[1]||0}),\"left\"in t&&(this.offset.click.left=t.left+this.margins.left),\"right\"in t&&(this.offset.click.left=this.helperProportions.width-t.right+this.margins.left),\"top\"in t&&(this.offset.click.top=t.top+this.margins.top),\"bottom\"in t&&(this.offset.click.top=this.helperProportions.height-t.bottom+this.margins.top)},_getParentOffset:function(){this.offsetParent=this.helper.offsetParent();var t=this.offsetParent.offset();return\"absolute\"===this.cssPosition&&this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])&&(t.left+=this.scrollParent.scrollLeft(),t.top+=this.scrollParent.scrollTop()),(this.offsetParent[0]===document.body||this.offsetParent[0].tagName&&\"html\"===this.offsetParent[0].tagName.toLowerCase()&&e.ui.ie)&&(t={top:0,left:0}),{top:t.top+(parseInt(this.offsetParent.css(\"borderTopWidth\"),10)||0),left:t.left+(parseInt(this.offsetParent.css(\"borderLeftWidth\"),10)||0)}},_getRelativeOffset:function(){if(\"relative\"===this.cssPosition){var e=this.currentItem.position();return{top:e.top-(parseInt(this.helper.css(\"top\"),10)||0)+this.scrollParent.scrollTop(),left:e.left-(parseInt(this.helper.css(\"left\"),10)||0)+this.scrollParent.scrollLeft()}}return{top:0,left:0}},_cacheMargins:function(){this.margins={left:parseInt(this.currentItem.css(\"marginLeft\"),10)||0,top:parseInt(this.currentItem.css(\"marginTop\"),10)||0}},_cacheHelperProportions:function(){this.helperProportions={width:this.helper.outerWidth(),height:this.helper.outerHeight()}},_setContainment:function(){var t,i,a,s=this.options;\"parent\"===s.containment&&(s.containment=this.helper[0].parentNode),(\"document\"===s.containment||\"window\"===s.containment)&&(this.containment=[0-this.offset.relative.left-this.offset.parent.left,0-this.offset.relative.top-this.offset.parent.top,e(\"document\"===s.containment?document:window).width()-this.helperProportions.width-this.margins.left,(e(\"document\"===s.containment?document:window).height()||document.body.parentNode.scrollHeight)-this.helperProportions.height-this.margins.top]),/^(document|window|parent)$/.test(s.containment)||(t=e(s.containment)[0],i=e(s.containment).offset(),a=\"hidden\"!==e(t).css(\"overflow\"),this.containment=[i.left+(parseInt(e(t).css(\"borderLeftWidth\"),10)||0)+(parseInt(e(t).css(\"paddingLeft\"),10)||0)-this.margins.left,i.top+(parseInt(e(t).css(\"borderTopWidth\"),10)||0)+(parseInt(e(t).css(\"paddingTop\"),10)||0)-this.margins.top,i.left+(a?Math.max(t.scrollWidth,t.offsetWidth):t.offsetWidth)-(parseInt(e(t).css(\"borderLeftWidth\"),10)||0)-(parseInt(e(t).css(\"paddingRight\"),10)||0)-this.helperProportions.width-this.margins.left,i.top+(a?Math.max(t.scrollHeight,t.offsetHeight):t.offsetHeight)-(parseInt(e(t).css(\"borderTopWidth\"),10)||0)-(parseInt(e(t).css(\"paddingBottom\"),10)||0)-this.helperProportions.height-this.margins.top])},_convertPositionTo:function(t,i){i||(i=this.position);var a=\"absolute\"===t?1:-1,s=\"absolute\"!==this.cssPosition||this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])?this.scrollParent:this.offsetParent,r=/(html|body)/i.test(s[0].tagName);return{top:i.top+this.offset.relative.top*a+this.offset.parent.top*a-(\"fixed\"===this.cssPosition?-this.scrollParent.scrollTop():r?0:s.scrollTop())*a,left:i.left+this.offset.relative.left*a+this.offset.parent.left*a-(\"fixed\"===this.cssPosition?-this.scrollParent.scrollLeft():r?0:s.scrollLeft())*a}},_generatePosition:function(t){var i,a,s=this.options,r=t.pageX,n=t.pageY,o=\"absolute\"!==this.cssPosition||this.scrollParent[0]!==document&&e.contains(this.scrollParent[0],this.offsetParent[0])?this.scrollParent:this.offsetParent,h=/(html|body)/i.test(o[0].tagName);return\"relative\"!==this.cssPosition||this.scrollParent[0]!==document&&this.scrollParent[0]!==this.offsetParent[0]||(this.offset.relative=this._getRelativeOffset()),this.originalPosition&&(this.containment&&(t.pageX-this.offset.click.left<this.containment[0]&&(r=this.containment[0]+this.offset.click.left),t.pageY-this.offset.click.top<this.containment[1]&&(n=this.containment[1]+this.offset.click.top),t.pageX-this.offset.click.left>this.containment[2]&&(r=this.containment[2]+this.offset.click.left),t.pageY-this.offset.click.top>this.containment[3]&&(n=this.containment[3]+this.offset.click.top)),s.grid&&(i=this.originalPageY+Math.round((n-this.originalPageY)/s.grid[1])*s.grid[1],n=this.containment?i-this.offset.click.top>=this.containment[1]&&i-this.offset.click.top<=this.containment[3]?i:i-this.offset.click.top>=this.containment[1]?i-s.grid[1]:i+s.grid[1]:i,a=this.originalPageX+Math.round((r-this.originalPageX)/s.grid[0])*s.grid[0],r=this.containment?a-this.offset.click.left>=this.containment[0]&&a-this.offset.click.left<=this.containment[2]?a:a-this.offset.click.left>=this.containment[0]?a-s.grid[0]:a+s.grid[0]:a)),{top:n-this.offset.click.top-this.offset.relative.top-this.offset.parent.top+(\"fixed\"===this.cssPosition?-this.scrollParent.scrollTop():h?0:o.scrollTop()),left:r-this.offset.click.left-this.offset.relative.left-this.offset.parent.left+(\"fixed\"===this.cssPosition?-this.scrollParent.scrollLeft():h?0:o.scrollLeft())}},_rearrange:function(e,t,i,a){i?i[0].appendChild(this.placeholder[0]):t.item[0].parentNode.insertBefore(this.placeholder[0],\"down\"===this.direction?t.item[0]:t.item[0].nextSibling),this.counter=this.counter?++this.counter:1;var s=this.counter;this._delay(function(){s===this.counter&&this.refreshPositions(!a)})},_clear:function(e,t){this.reverting=!1;var i,a=[];if(!this._noFinalSort&&this.currentItem.parent().length&&this.placeholder.before(this.currentItem),this._noFinalSort=null,this.helper[0]===this.currentItem[0]){for(i in this._storedCSS)(\"auto\"===this._storedCSS[i]||\"static\"===this._storedCSS[i])&&(this._storedCSS[i]=\"\");this.currentItem.css(this._storedCSS).removeClass(\"ui-sortable-helper\")}else this.currentItem.show();for(this.fromOutside&&!t&&a.push(function(e){this._trigger(\"receive\",e,this._uiHash(this.fromOutside))}),!this.fromOutside&&this.domPosition.prev===this.currentItem.prev().not(\".ui-sortable-helper\")[0]&&this.domPosition.parent===this.currentItem.parent()[0]||t||a.push(function(e){this._trigger(\"update\",e,this._uiHash())}),this!==this.currentContainer&&(t||(a.push(function(e){this._trigger(\"remove\",e,this._uiHash())}),a.push(function(e){return function(t){e._trigger(\"receive\",t,this._uiHash(this))}}.call(this,this.currentContainer)),a.push(function(e){return function(t){e._trigger(\"update\",t,this._uiHash(this))}}.call(this,this.currentContainer)))),i=this.containers.length-1;i>=0;i--)t||a.push(function(e){return function(t){e._trigger(\"deactivate\",t,this._uiHash(this))}}.call(this,this.containers[i])),this.containers[i].containerCache.over&&(a.push(function(e){return function(t){e._trigger(\"out\",t,this._uiHash(this))}}.call(this,this.containers[i])),this.containers[i].containerCache.over=0);if(this.storedCursor&&(this.document.find(\"body\").css(\"cursor\",this.storedCursor),this.storedStylesheet.remove()),this._storedOpacity&&this.helper.css(\"opacity\",this._storedOpacity),this._storedZIndex&&this.helper.css(\"zIndex\",\"auto\"===this._storedZIndex?\"\":this._storedZIndex),this.dragging=!1,this.cancelHelperRemoval){if(!t){for(this._trigger(\"beforeStop\",e,this._uiHash()),i=0;a.length>i;i++)a[i].call(this,e);this._trigger(\"stop\",e,this._uiHash())}return this.fromOutside=!1,!1}if(t||this._trigger(\"beforeStop\",e,this._uiHash()),this.placeholder[0].parentNode.removeChild(this.placeholder[0]),this.helper[0]!==this.currentItem[0]&&this.helper.remove(),this.helper=null,!t){for(i=0;a.length>i;i++)a[i].call(this,e);this._trigger(\"stop\",e,this._uiHash())}return this.fromOutside=!1,!0},_trigger:function(){e.Widget.prototype._trigger.apply(this,arguments)===!1&&this.cancel()},_uiHash:function(t){var i=t||this;return{helper:i.helper,placeholder:i.placeholder||e([]),position:i.position,originalPosition:i.originalPosition,offset:i.positionAbs,item:i.currentItem,sender:t?t.element:null}}})})(jQuery);(function(e){var t=0,a={},r={};a.height=a.paddingTop=a.paddingBottom=a.borderTopWidth=a.borderBottomWidth=\"hide\",r.height=r.paddingTop=r.paddingBottom=r.borderTopWidth=r.borderBottomWidth=\"show\",e.widget(\"ui.accordion\",{version:\"1.10.3\",options:{active:0,animate:{},collapsible:!1,event:\"click\",header:\"> li > :first-child,> :not(li):even\",heightStyle:\"auto\",icons:{activeHeader:\"ui-icon-triangle-1-s\",header:\"ui-icon-triangle-1-e\"},activate:null,beforeActivate:null},_create:function(){var t=this.options;this.prevShow=this.prevHide=e(),this.element.addClass(\"ui-accordion ui-widget ui-helper-reset\").attr(\"role\",\"tablist\"),t.collapsible||t.active!==!1&&null!=t.active||(t.active=0),this._processPanels(),0>t.active&&(t.active+=this.headers.length),this._refresh()},_getCreateEventData:function(){return{header:this.active,panel:this.active.length?this.active.next():e(),content:this.active.length?this.active.next():e()}},_createIcons:function(){var t=this.options.icons;t&&(e(\"<span>\").addClass(\"ui-accordion-header-icon ui-icon \"+t.header).prependTo(this.headers),this.active.children(\".ui-accordion-header-icon\").removeClass(t.header).addClass(t.activeHeader),this.headers.addClass(\"ui-accordion-icons\"))},_destroyIcons:function(){this.headers.removeClass(\"ui-accordion-icons\").children(\".ui-accordion-header-icon\").remove()},_destroy:function(){var e;this.element.removeClass(\"ui-accordion ui-widget ui-helper-reset\").removeAttr(\"role\"),this.headers.removeClass(\"ui-accordion-header ui-accordion-header-active ui-helper-reset ui-state-default ui-corner-all ui-state-active ui-state-disabled ui-corner-top\").removeAttr(\"role\").removeAttr(\"aria-selected\").removeAttr(\"aria-controls\").removeAttr(\"tabIndex\").each(function(){/^ui-accordion/.test(this.id)&&this.removeAttribute(\"id\")}),this._destroyIcons(),e=this.headers.next().css(\"display\",\"\").removeAttr(\"role\").removeAttr(\"aria-expanded\").removeAttr(\"aria-hidden\").removeAttr(\"aria-labelledby\").removeClass(\"ui-helper-reset ui-widget-content ui-corner-bottom ui-accordion-content ui-accordion-content-active ui-state-disabled\").each(function(){/^ui-accordion/.test(this.id)&&this.removeAttribute(\"id\")}),\"content\"!==this.options.heightStyle&&e.css(\"height\",\"\")},_setOption:function(e,t){return\"active\"===e?(this._activate(t),undefined):(\"event\"===e&&(this.options.event&&this._off(this.headers,this.options.event),this._setupEvents(t)),this._super(e,t),\"collapsible\"!==e||t||this.options.active!==!1||this._activate(0),\"icons\"===e&&(this._destroyIcons(),t&&this._createIcons()),\"disabled\"===e&&this.headers.add(this.headers.next()).toggleClass(\"ui-state-disabled\",!!t),undefined)},_keydown:function(t){if(!t.altKey&&!t.ctrlKey){var a=e.ui.keyCode,r=this.headers.length,i=this.headers.index(t.target),n=!1;switch(t.keyCode){case a.RIGHT:case a.DOWN:n=this.headers[(i+1)%r];break;case a.LEFT:case a.UP:n=this.headers[(i-1+r)%r];break;case a.SPACE:case a.ENTER:this._eventHandler(t);break;case a.HOME:n=this.headers[0];break;case a.END:n=this.headers[r-1]}n&&(e(t.target).attr(\"tabIndex\",-1),e(n).attr(\"tabIndex\",0),n.focus(),t.preventDefault())}},_panelKeyDown:function(t){t.keyCode===e.ui.keyCode.UP&&t.ctrlKey&&e(t.currentTarget).prev().focus()},refresh:function(){var t=this.options;this._processPanels(),t.active===!1&&t.collapsible===!0||!this.headers.length?(t.active=!1,this.active=e()):t.active===!1?this._activate(0):this.active.length&&!e.contains(this.element[0],this.active[0])?this.headers.length===this.headers.find(\".ui-state-disabled\").length?(t.active=!1,this.active=e()):this._activate(Math.max(0,t.active-1)):t.active=this.headers.index(this.active),this._destroyIcons(),this._refresh()},_processPanels:function(){this.headers=this.element.find(this.options.header).addClass(\"ui-accordion-header ui-helper-reset ui-state-default ui-corner-all\"),this.headers.next().addClass(\"ui-accordion-content ui-helper-reset ui-widget-content ui-corner-bottom\").filter(\":not(.ui-accordion-content-active)\").hide()},_refresh:function(){var a,r=this.options,i=r.heightStyle,n=this.element.parent(),s=this.accordionId=\"ui-accordion-\"+(this.element.attr(\"id\")||++t);this.active=this._findActive(r.active).addClass(\"ui-accordion-header-active ui-state-active ui-corner-top\").removeClass(\"ui-corner-all\"),this.active.next().addClass(\"ui-accordion-content-active\").show(),this.headers.attr(\"role\",\"tab\").each(function(t){var a=e(this),r=a.attr(\"id\"),i=a.next(),n=i.attr(\"id\");r||(r=s+\"-header-\"+t,a.attr(\"id\",r)),n||(n=s+\"-panel-\"+t,i.attr(\"id\",n)),a.attr(\"aria-controls\",n),i.attr(\"aria-labelledby\",r)}).next().attr(\"role\",\"tabpanel\"),this.headers.not(this.active).attr({\"aria-selected\":\"false\",tabIndex:-1}).next().attr({\"aria-expanded\":\"false\",\"aria-hidden\":\"true\"}).hide(),this.active.length?this.active.attr({\"aria-selected\":\"true\",tabIndex:0}).next().attr({\"aria-expanded\":\"true\",\"aria-hidden\":\"false\"}):this.headers.eq(0).attr(\"tabIndex\",0),this._createIcons(),this._setupEvents(r.event),\"fill\"===i?(a=n.height(),this.element.siblings(\":visible\").each(function(){var t=e(this),r=t.css(\"position\");\"absolute\"!==r&&\"fixed\"!==r&&(a-=t.outerHeight(!0))}),this.headers.each(function(){a-=e(this).outerHeight(!0)}),this.headers.next().each(function(){e(this).height(Math.max(0,a-e(this).innerHeight()+e(this).height()))}).css(\"overflow\",\"auto\")):\"auto\"===i&&(a=0,this.headers.next().each(function(){a=Math.max(a,e(this).css(\"height\",\"\").height())}).height(a))},_activate:function(t){var a=this._findActive(t)[0];a!==this.active[0]&&(a=a||this.active[0],this._eventHandler({target:a,currentTarget:a,preventDefault:e.noop}))},_findActive:function(t){return\"number\"==typeof t?this.headers.eq(t):e()},_setupEvents:function(t){var a={keydown:\"_keydown\"};t&&e.each(t.split(\" \"),function(e,t){a[t]=\"_eventHandler\"}),this._off(this.headers.add(this.headers.next())),this._on(this.headers,a),this._on(this.headers.next(),{keydown:\"_panelKeyDown\"}),this._hoverable(this.headers),this._focusable(this.headers)},_eventHandler:function(t){var a=this.options,r=this.active,i=e(t.currentTarget),n=i[0]===r[0],s=n&&a.collapsible,o=s?e():i.next(),d=r.next(),u={oldHeader:r,oldPanel:d,newHeader:s?e():i,newPanel:o};t.preventDefault(),n&&!a.collapsible||this._trigger(\"beforeActivate\",t,u)===!1||(a.active=s?!1:this.headers.index(i),this.active=n?e():i,this._toggle(u),r.removeClass(\"ui-accordion-header-active ui-state-active\"),a.icons&&r.children(\".ui-accordion-header-icon\").removeClass(a.icons.activeHeader).addClass(a.icons.header),n||(i.removeClass(\"ui-corner-all\").addClass(\"ui-accordion-header-active ui-state-active ui-corner-top\"),a.icons&&i.children(\".ui-accordion-header-icon\").removeClass(a.icons.header).addClass(a.icons.activeHeader),i.next().addClass(\"ui-accordion-content-active\")))},_toggle:function(t){var a=t.newPanel,r=this.prevShow.length?this.prevShow:t.oldPanel;this.prevShow.add(this.prevHide).stop(!0,!0),this.prevShow=a,this.prevHide=r,this.options.animate?this._animate(a,r,t):(r.hide(),a.show(),this._toggleComplete(t)),r.attr({\"aria-expanded\":\"false\",\"aria-hidden\":\"true\"}),r.prev().attr(\"aria-selected\",\"false\"),a.length&&r.length?r.prev().attr(\"tabIndex\",-1):a.length&&this.headers.filter(function(){return 0===e(this).attr(\"tabIndex\")}).attr(\"tabIndex\",-1),a.attr({\"aria-expanded\":\"true\",\"aria-hidden\":\"false\"}).prev().attr({\"aria-selected\":\"true\",tabIndex:0})},_animate:function(e,t,i){var n,s,o,d=this,u=0,c=e.length&&(!t.length||e.index()<t.index()),l=this.options.animate||{},h=c&&l.down||l,m=function(){d._toggleComplete(i)};return\"number\"==typeof h&&(o=h),\"string\"==typeof h&&(s=h),s=s||h.easing||l.easing,o=o||h.duration||l.duration,t.length?e.length?(n=e.show().outerHeight(),t.animate(a,{duration:o,easing:s,step:function(e,t){t.now=Math.round(e)}}),e.hide().animate(r,{duration:o,easing:s,complete:m,step:function(e,a){a.now=Math.round(e),\"height\"!==a.prop?u+=a.now:\"content\"!==d.options.heightStyle&&(a.now=Math.round(n-t.outerHeight()-u),u=0)}}),undefined):t.animate(a,o,s,m):e.animate(r,o,s,m)},_toggleComplete:function(e){var t=e.oldPanel;t.removeClass(\"ui-accordion-content-active\").prev().removeClass(\"ui-corner-top\").addClass(\"ui-corner-all\"),t.length&&(t.parent()[0].className=t.parent()[0].className),this._trigger(\"activate\",null,e)}})})(jQuery);(function(e){var t=0;e.widget(\"ui.autocomplete\",{version:\"1.10.3\",defaultElement:\"<input>\",options:{appendTo:null,autoFocus:!1,delay:300,minLength:1,position:{my:\"left top\",at:\"left bottom\",collision:\"none\"},source:null,change:null,close:null,focus:null,open:null,response:null,search:null,select:null},pending:0,_create:function(){var t,i,n,s=this.element[0].nodeName.toLowerCase(),u=\"textarea\"===s,o=\"input\"===s;this.isMultiLine=u?!0:o?!1:this.element.prop(\"isContentEditable\"),this.valueMethod=this.element[u||o?\"val\":\"text\"],this.isNewMenu=!0,this.element.addClass(\"ui-autocomplete-input\").attr(\"autocomplete\",\"off\"),this._on(this.element,{keydown:function(s){if(this.element.prop(\"readOnly\"))return t=!0,n=!0,i=!0,undefined;t=!1,n=!1,i=!1;var u=e.ui.keyCode;switch(s.keyCode){case u.PAGE_UP:t=!0,this._move(\"previousPage\",s);break;case u.PAGE_DOWN:t=!0,this._move(\"nextPage\",s);break;case u.UP:t=!0,this._keyEvent(\"previous\",s);break;case u.DOWN:t=!0,this._keyEvent(\"next\",s);break;case u.ENTER:case u.NUMPAD_ENTER:this.menu.active&&(t=!0,s.preventDefault(),this.menu.select(s));break;case u.TAB:this.menu.active&&this.menu.select(s);break;case u.ESCAPE:this.menu.element.is(\":visible\")&&(this._value(this.term),this.close(s),s.preventDefault());break;default:i=!0,this._searchTimeout(s)}},keypress:function(n){if(t)return t=!1,(!this.isMultiLine||this.menu.element.is(\":visible\"))&&n.preventDefault(),undefined;if(!i){var s=e.ui.keyCode;switch(n.keyCode){case s.PAGE_UP:this._move(\"previousPage\",n);break;case s.PAGE_DOWN:this._move(\"nextPage\",n);break;case s.UP:this._keyEvent(\"previous\",n);break;case s.DOWN:this._keyEvent(\"next\",n)}}},input:function(e){return n?(n=!1,e.preventDefault(),undefined):(this._searchTimeout(e),undefined)},focus:function(){this.selectedItem=null,this.previous=this._value()},blur:function(e){return this.cancelBlur?(delete this.cancelBlur,undefined):(clearTimeout(this.searching),this.close(e),this._change(e),undefined)}}),this._initSource(),this.menu=e(\"<ul>\").addClass(\"ui-autocomplete ui-front\").appendTo(this._appendTo()).menu({role:null}).hide().data(\"ui-menu\"),this._on(this.menu.element,{mousedown:function(t){t.preventDefault(),this.cancelBlur=!0,this._delay(function(){delete this.cancelBlur});var i=this.menu.element[0];e(t.target).closest(\".ui-menu-item\").length||this._delay(function(){var t=this;this.document.one(\"mousedown\",function(n){n.target===t.element[0]||n.target===i||e.contains(i,n.target)||t.close()})})},menufocus:function(t,i){if(this.isNewMenu&&(this.isNewMenu=!1,t.originalEvent&&/^mouse/.test(t.originalEvent.type)))return this.menu.blur(),this.document.one(\"mousemove\",function(){e(t.target).trigger(t.originalEvent)}),undefined;var n=i.item.data(\"ui-autocomplete-item\");!1!==this._trigger(\"focus\",t,{item:n})?t.originalEvent&&/^key/.test(t.originalEvent.type)&&this._value(n.value):this.liveRegion.text(n.value)},menuselect:function(e,t){var i=t.item.data(\"ui-autocomplete-item\"),n=this.previous;this.element[0]!==this.document[0].activeElement&&(this.element.focus(),this.previous=n,this._delay(function(){this.previous=n,this.selectedItem=i})),!1!==this._trigger(\"select\",e,{item:i})&&this._value(i.value),this.term=this._value(),this.close(e),this.selectedItem=i}}),this.liveRegion=e(\"<span>\",{role:\"status\",\"aria-live\":\"polite\"}).addClass(\"ui-helper-hidden-accessible\").insertBefore(this.element),this._on(this.window,{beforeunload:function(){this.element.removeAttr(\"autocomplete\")}})},_destroy:function(){clearTimeout(this.searching),this.element.removeClass(\"ui-autocomplete-input\").removeAttr(\"autocomplete\"),this.menu.element.remove(),this.liveRegion.remove()},_setOption:function(e,t){this._super(e,t),\"source\"===e&&this._initSource(),\"appendTo\"===e&&this.menu.element.appendTo(this._appendTo()),\"disabled\"===e&&t&&this.xhr&&this.xhr.abort()},_appendTo:function(){var t=this.options.appendTo;return t&&(t=t.jquery||t.nodeType?e(t):this.document.find(t).eq(0)),t||(t=this.element.closest(\".ui-front\")),t.length||(t=this.document[0].body),t},_initSource:function(){var t,i,n=this;e.isArray(this.options.source)?(t=this.options.source,this.source=function(i,n){n(e.ui.autocomplete.filter(t,i.term))}):\"string\"==typeof this.options.source?(i=this.options.source,this.source=function(t,s){n.xhr&&n.xhr.abort(),n.xhr=e.ajax({url:i,data:t,dataType:\"json\",success:function(e){s(e)},error:function(){s([])}})}):this.source=this.options.source},_searchTimeout:function(e){clearTimeout(this.searching),this.searching=this._delay(function(){this.term!==this._value()&&(this.selectedItem=null,this.search(null,e))},this.options.delay)},search:function(e,t){return e=null!=e?e:this._value(),this.term=this._value(),e.length<this.options.minLength?this.close(t):this._trigger(\"search\",t)!==!1?this._search(e):undefined},_search:function(e){this.pending++,this.element.addClass(\"ui-autocomplete-loading\"),this.cancelSearch=!1,this.source({term:e},this._response())},_response:function(){var e=this,i=++t;return function(n){i===t&&e.__response(n),e.pending--,e.pending||e.element.removeClass(\"ui-autocomplete-loading\")}},__response:function(e){e&&(e=this._normalize(e)),this._trigger(\"response\",null,{content:e}),!this.options.disabled&&e&&e.length&&!this.cancelSearch?(this._suggest(e),this._trigger(\"open\")):this._close()},close:function(e){this.cancelSearch=!0,this._close(e)},_close:function(e){this.menu.element.is(\":visible\")&&(this.menu.element.hide(),this.menu.blur(),this.isNewMenu=!0,this._trigger(\"close\",e))},_change:function(e){this.previous!==this._value()&&this._trigger(\"change\",e,{item:this.selectedItem})},_normalize:function(t){return t.length&&t[0].label&&t[0].value?t:e.map(t,function(t){return\"string\"==typeof t?{label:t,value:t}:e.extend({label:t.label||t.value,value:t.value||t.label},t)})},_suggest:function(t){var i=this.menu.element.empty();this._renderMenu(i,t),this.isNewMenu=!0,this.menu.refresh(),i.show(),this._resizeMenu(),i.position(e.extend({of:this.element},this.options.position)),this.options.autoFocus&&this.menu.next()},_resizeMenu:function(){var e=this.menu.element;e.outerWidth(Math.max(e.width(\"\").outerWidth()+1,this.element.outerWidth()))},_renderMenu:function(t,i){var n=this;e.each(i,function(e,i){n._renderItemData(t,i)})},_renderItemData:function(e,t){return this._renderItem(e,t).data(\"ui-autocomplete-item\",t)},_renderItem:function(t,i){return e(\"<li>\").append(e(\"<a>\").text(i.label)).appendTo(t)},_move:function(e,t){return this.menu.element.is(\":visible\")?this.menu.isFirstItem()&&/^previous/.test(e)||this.menu.isLastItem()&&/^next/.test(e)?(this._value(this.term),this.menu.blur(),undefined):(this.menu[e](t),undefined):(this.search(null,t),undefined)},widget:function(){return this.menu.element},_value:function(){return this.valueMethod.apply(this.element,arguments)},_keyEvent:function(e,t){(!this.isMultiLine||this.menu.element.is(\":visible\"))&&(this._move(e,t),t.preventDefault())}}),e.extend(e.ui.autocomplete,{escapeRegex:function(e){return e.replace(/[\\-\\[\\]{}()*+?.,\\\\\\^$|#\\s]/g,\"\\\\$&\")},filter:function(t,i){var n=RegExp(e.ui.autocomplete.escapeRegex(i),\"i\");return e.grep(t,function(e){return n.test(e.label||e.value||e)})}}),e.widget(\"ui.autocomplete\",e.ui.autocomplete,{options:{messages:{noResults:\"No search results.\",results:function(e){return e+(e>1?\" results are\":\" result is\")+\" available, use up and down arrow keys to navigate.\"}}},__response:function(e){var t;this._superApply(arguments),this.options.disabled||this.cancelSearch||(t=e&&e.length?this.options.messages.results(e.length):this.options.messages.noResults,this.liveRegion.text(t))}})})(jQuery);(function(e){var t,a,r,i,n=\"ui-button ui-widget ui-state-default ui-corner-all\",s=\"ui-state-hover ui-state-active \",o=\"ui-button-icons-only ui-button-icon-only ui-button-text-icons ui-button-text-icon-primary ui-button-text-icon-secondary ui-button-text-only\",d=function(){var t=e(this);setTimeout(function(){t.find(\":ui-button\").button(\"refresh\")},1)},u=function(t){var a=t.name,r=t.form,i=e([]);return a&&(a=a.replace(/'/g,\"\\\\'\"),i=r?e(r).find(\"[name='\"+a+\"']\"):e(\"[name='\"+a+\"']\",t.ownerDocument).filter(function(){return!this.form})),i};e.widget(\"ui.button\",{version:\"1.10.3\",defaultElement:\"<button>\",options:{disabled:null,text:!0,label:null,icons:{primary:null,secondary:null}},_create:function(){this.element.closest(\"form\").unbind(\"reset\"+this.eventNamespace).bind(\"reset\"+this.eventNamespace,d),\"boolean\"!=typeof this.options.disabled?this.options.disabled=!!this.element.prop(\"disabled\"):this.element.prop(\"disabled\",this.options.disabled),this._determineButtonType(),this.hasTitle=!!this.buttonElement.attr(\"title\");var s=this,o=this.options,l=\"checkbox\"===this.type||\"radio\"===this.type,h=l?\"\":\"ui-state-active\",c=\"ui-state-focus\";null===o.label&&(o.label=\"input\"===this.type?this.buttonElement.val():this.buttonElement.html()),this._hoverable(this.buttonElement),this.buttonElement.addClass(n).attr(\"role\",\"button\").bind(\"mouseenter\"+this.eventNamespace,function(){o.disabled||this===t&&e(this).addClass(\"ui-state-active\")}).bind(\"mouseleave\"+this.eventNamespace,function(){o.disabled||e(this).removeClass(h)}).bind(\"click\"+this.eventNamespace,function(e){o.disabled&&(e.preventDefault(),e.stopImmediatePropagation())}),this.element.bind(\"focus\"+this.eventNamespace,function(){s.buttonElement.addClass(c)}).bind(\"blur\"+this.eventNamespace,function(){s.buttonElement.removeClass(c)}),l&&(this.element.bind(\"change\"+this.eventNamespace,function(){i||s.refresh()}),this.buttonElement.bind(\"mousedown\"+this.eventNamespace,function(e){o.disabled||(i=!1,a=e.pageX,r=e.pageY)}).bind(\"mouseup\"+this.eventNamespace,function(e){o.disabled||(a!==e.pageX||r!==e.pageY)&&(i=!0)})),\"checkbox\"===this.type?this.buttonElement.bind(\"click\"+this.eventNamespace,function(){return o.disabled||i?!1:undefined}):\"radio\"===this.type?this.buttonElement.bind(\"click\"+this.eventNamespace,function(){if(o.disabled||i)return!1;e(this).addClass(\"ui-state-active\"),s.buttonElement.attr(\"aria-pressed\",\"true\");var t=s.element[0];u(t).not(t).map(function(){return e(this).button(\"widget\")[0]}).removeClass(\"ui-state-active\").attr(\"aria-pressed\",\"false\")}):(this.buttonElement.bind(\"mousedown\"+this.eventNamespace,function(){return o.disabled?!1:(e(this).addClass(\"ui-state-active\"),t=this,s.document.one(\"mouseup\",function(){t=null}),undefined)}).bind(\"mouseup\"+this.eventNamespace,function(){return o.disabled?!1:(e(this).removeClass(\"ui-state-active\"),undefined)}).bind(\"keydown\"+this.eventNamespace,function(t){return o.disabled?!1:((t.keyCode===e.ui.keyCode.SPACE||t.keyCode===e.ui.keyCode.ENTER)&&e(this).addClass(\"ui-state-active\"),undefined)}).bind(\"keyup\"+this.eventNamespace+\" blur\"+this.eventNamespace,function(){e(this).removeClass(\"ui-state-active\")}),this.buttonElement.is(\"a\")&&this.buttonElement.keyup(function(t){t.keyCode===e.ui.keyCode.SPACE&&e(this).click()})),this._setOption(\"disabled\",o.disabled),this._resetButton()},_determineButtonType:function(){var e,t,a;this.type=this.element.is(\"[type=checkbox]\")?\"checkbox\":this.element.is(\"[type=radio]\")?\"radio\":this.element.is(\"input\")?\"input\":\"button\",\"checkbox\"===this.type||\"radio\"===this.type?(e=this.element.parents().last(),t=\"label[for='\"+this.element.attr(\"id\")+\"']\",this.buttonElement=e.find(t),this.buttonElement.length||(e=e.length?e.siblings():this.element.siblings(),this.buttonElement=e.filter(t),this.buttonElement.length||(this.buttonElement=e.find(t))),this.element.addClass(\"ui-helper-hidden-accessible\"),a=this.element.is(\":checked\"),a&&this.buttonElement.addClass(\"ui-state-active\"),this.buttonElement.prop(\"aria-pressed\",a)):this.buttonElement=this.element},widget:function(){return this.buttonElement},_destroy:function(){this.element.removeClass(\"ui-helper-hidden-accessible\"),this.buttonElement.removeClass(n+\" \"+s+\" \"+o).removeAttr(\"role\").removeAttr(\"aria-pressed\").html(this.buttonElement.find(\".ui-button-text\").html()),this.hasTitle||this.buttonElement.removeAttr(\"title\")},_setOption:function(e,t){return this._super(e,t),\"disabled\"===e?(t?this.element.prop(\"disabled\",!0):this.element.prop(\"disabled\",!1),undefined):(this._resetButton(),undefined)},refresh:function(){var t=this.element.is(\"input, button\")?this.element.is(\":disabled\"):this.element.hasClass(\"ui-button-disabled\");t!==this.options.disabled&&this._setOption(\"disabled\",t),\"radio\"===this.type?u(this.element[0]).each(function(){e(this).is(\":checked\")?e(this).button(\"widget\").addClass(\"ui-state-active\").attr(\"aria-pressed\",\"true\"):e(this).button(\"widget\").removeClass(\"ui-state-active\").attr(\"aria-pressed\",\"false\")}):\"checkbox\"===this.type&&(this.element.is(\":checked\")?this.buttonElement.addClass(\"ui-state-active\").attr(\"aria-pressed\",\"true\"):this.buttonElement.removeClass(\"ui-state-active\").attr(\"aria-pressed\",\"false\"))},_resetButton:function(){if(\"input\"===this.type)return this.options.label&&this.element.val(this.options.label),undefined;var t=this.buttonElement.removeClass(o),a=e(\"<span></span>\",this.document[0]).addClass(\"ui-button-text\").html(this.options.label).appendTo(t.empty()).text(),r=this.options.icons,i=r.primary&&r.secondary,n=[];r.primary||r.secondary?(this.options.text&&n.push(\"ui-button-text-icon\"+(i?\"s\":r.primary?\"-primary\":\"-secondary\")),r.primary&&t.prepend(\"<span class='ui-button-icon-primary ui-icon \"+r.primary+\"'></span>\"),r.secondary&&t.append(\"<span class='ui-button-icon-secondary ui-icon \"+r.secondary+\"'></span>\"),this.options.text||(n.push(i?\"ui-button-icons-only\":\"ui-button-icon-only\"),this.hasTitle||t.attr(\"title\",e.trim(a)))):n.push(\"ui-button-text-only\"),t.addClass(n.join(\" \"))}}),e.widget(\"ui.buttonset\",{version:\"1.10.3\",options:{items:\"button, input[type=button], input[type=submit], input[type=reset], input[type=checkbox], input[type=radio], a, :data(ui-button)\"},_create:function(){this.element.addClass(\"ui-buttonset\")},_init:function(){this.refresh()},_setOption:function(e,t){\"disabled\"===e&&this.buttons.button(\"option\",e,t),this._super(e,t)},refresh:function(){var t=\"rtl\"===this.element.css(\"direction\");this.buttons=this.element.find(this.options.items).filter(\":ui-button\").button(\"refresh\").end().not(\":ui-button\").button().end().map(function(){return e(this).button(\"widget\")[0]}).removeClass(\"ui-corner-all ui-corner-left ui-corner-right\").filter(\":first\").addClass(t?\"ui-corner-right\":\"ui-corner-left\").end().filter(\":last\").addClass(t?\"ui-corner-left\":\"ui-corner-right\").end().end()},_destroy:function(){this.element.removeClass(\"ui-buttonset\"),this.buttons.map(function(){return e(this).button(\"widget\")[0]}).removeClass(\"ui-corner-left ui-corner-right\").end().button(\"destroy\")}})})(jQuery);(function(e,t){function i(){this._curInst=null,this._keyEvent=!1,this._disabledInputs=[],this._datepickerShowing=!1,this._inDialog=!1,this._mainDivId=\"ui-datepicker-div\",this._inlineClass=\"ui-datepicker-inline\",this._appendClass=\"ui-datepicker-append\",this._triggerClass=\"ui-datepicker-trigger\",this._dialogClass=\"ui-datepicker-dialog\",this._disableClass=\"ui-datepicker-disabled\",this._unselectableClass=\"ui-datepicker-unselectable\",this._currentClass=\"ui-datepicker-current-day\",this._dayOverClass=\"ui-datepicker-days-cell-over\",this.regional=[],this.regional[\"\"]={closeText:\"Done\",prevText:\"Prev\",nextText:\"Next\",currentText:\"Today\",monthNames:[\"January\",\"February\",\"March\",\"April\",\"May\",\"June\",\"July\",\"August\",\"September\",\"October\",\"November\",\"December\"],monthNamesShort:[\"Jan\",\"Feb\",\"Mar\",\"Apr\",\"May\",\"Jun\",\"Jul\",\"Aug\",\"Sep\",\"Oct\",\"Nov\",\"Dec\"],dayNames:[\"Sunday\",\"Monday\",\"Tuesday\",\"Wednesday\",\"Thursday\",\"Friday\",\"Saturday\"],dayNamesShort:[\"Sun\",\"Mon\",\"Tue\",\"Wed\",\"Thu\",\"Fri\",\"Sat\"],dayNamesMin:[\"Su\",\"Mo\",\"Tu\",\"We\",\"Th\",\"Fr\",\"Sa\"],weekHeader:\"Wk\",dateFormat:\"mm/dd/yy\",firstDay:0,isRTL:!1,showMonthAfterYear:!1,yearSuffix:\"\"},this._defaults={showOn:\"focus\",showAnim:\"fadeIn\",showOptions:{},defaultDate:null,appendText:\"\",buttonText:\"...\",buttonImage:\"\",buttonImageOnly:!1,hideIfNoPrevNext:!1,navigationAsDateFormat:!1,gotoCurrent:!1,changeMonth:!1,changeYear:!1,yearRange:\"c-10:c+10\",showOtherMonths:!1,selectOtherMonths:!1,showWeek:!1,calculateWeek:this.iso8601Week,shortYearCutoff:\"+10\",minDate:null,maxDate:null,duration:\"fast\",beforeShowDay:null,beforeShow:null,onSelect:null,onChangeMonthYear:null,onClose:null,numberOfMonths:1,showCurrentAtPos:0,stepMonths:1,stepBigMonths:12,altField:\"\",altFormat:\"\",constrainInput:!0,showButtonPanel:!1,autoSize:!1,disabled:!1},e.extend(this._defaults,this.regional[\"\"]),this.dpDiv=a(e(\"<div id='\"+this._mainDivId+\"' class='ui-datepicker ui-widget ui-widget-content ui-helper-clearfix ui-corner-all'></div>\"))}function a(t){var i=\"button, .ui-datepicker-prev, .ui-datepicker-next, .ui-datepicker-calendar td a\";return t.delegate(i,\"mouseout\",function(){e(this).removeClass(\"ui-state-hover\"),-1!==this.className.indexOf(\"ui-datepicker-prev\")&&e(this).removeClass(\"ui-datepicker-prev-hover\"),-1!==this.className.indexOf(\"ui-datepicker-next\")&&e(this).removeClass(\"ui-datepicker-next-hover\")}).delegate(i,\"mouseover\",function(){e.datepicker._isDisabledDatepicker(s.inline?t.parent()[0]:s.input[0])||(e(this).parents(\".ui-datepicker-calendar\").find(\"a\").removeClass(\"ui-state-hover\"),e(this).addClass(\"ui-state-hover\"),-1!==this.className.indexOf(\"ui-datepicker-prev\")&&e(this).addClass(\"ui-datepicker-prev-hover\"),-1!==this.className.indexOf(\"ui-datepicker-next\")&&e(this).addClass(\"ui-datepicker-next-hover\"))})}function n(t,i){e.extend(t,i);for(var a in i)null==i[a]&&(t[a]=i[a]);return t}e.extend(e.ui,{datepicker:{version:\"1.10.3\"}});var s,r=\"datepicker\";e.extend(i.prototype,{markerClassName:\"hasDatepicker\",maxRows:4,_widgetDatepicker:function(){return this.dpDiv},setDefaults:function(e){return n(this._defaults,e||{}),this},_attachDatepicker:function(t,i){var a,n,s;a=t.nodeName.toLowerCase(),n=\"div\"===a||\"span\"===a,t.id||(this.uuid+=1,t.id=\"dp\"+this.uuid),s=this._newInst(e(t),n),s.settings=e.extend({},i||{}),\"input\"===a?this._connectDatepicker(t,s):n&&this._inlineDatepicker(t,s)},_newInst:function(t,i){var n=t[0].id.replace(/([^A-Za-z0-9_\\-])/g,\"\\\\\\\\$1\");return{id:n,input:t,selectedDay:0,selectedMonth:0,selectedYear:0,drawMonth:0,drawYear:0,inline:i,dpDiv:i?a(e(\"<div class='\"+this._inlineClass+\" ui-datepicker ui-widget ui-widget-content ui-helper-clearfix ui-corner-all'></div>\")):this.dpDiv}},_connectDatepicker:function(t,i){var a=e(t);i.append=e([]),i.trigger=e([]),a.hasClass(this.markerClassName)||(this._attachments(a,i),a.addClass(this.markerClassName).keydown(this._doKeyDown).keypress(this._doKeyPress).keyup(this._doKeyUp),this._autoSize(i),e.data(t,r,i),i.settings.disabled&&this._disableDatepicker(t))},_attachments:function(t,i){var a,n,s,r=this._get(i,\"appendText\"),o=this._get(i,\"isRTL\");i.append&&i.append.remove(),r&&(i.append=e(\"<span class='\"+this._appendClass+\"'>\"+r+\"</span>\"),t[o?\"before\":\"after\"](i.append)),t.unbind(\"focus\",this._showDatepicker),i.trigger&&i.trigger.remove(),a=this._get(i,\"showOn\"),(\"focus\"===a||\"both\"===a)&&t.focus(this._showDatepicker),(\"button\"===a||\"both\"===a)&&(n=this._get(i,\"buttonText\"),s=this._get(i,\"buttonImage\"),i.trigger=e(this._get(i,\"buttonImageOnly\")?e(\"<img/>\").addClass(this._triggerClass).attr({src:s,alt:n,title:n}):e(\"<button type='button'></button>\").addClass(this._triggerClass).html(s?e(\"<img/>\").attr({src:s,alt:n,title:n}):n)),t[o?\"before\":\"after\"](i.trigger),i.trigger.click(function(){return e.datepicker._datepickerShowing&&e.datepicker._lastInput===t[0]?e.datepicker._hideDatepicker():e.datepicker._datepickerShowing&&e.datepicker._lastInput!==t[0]?(e.datepicker._hideDatepicker(),e.datepicker._showDatepicker(t[0])):e.datepicker._showDatepicker(t[0]),!1}))},_autoSize:function(e){if(this._get(e,\"autoSize\")&&!e.inline){var t,i,a,n,s=new Date(2009,11,20),r=this._get(e,\"dateFormat\");r.match(/[DM]/)&&(t=function(e){for(i=0,a=0,n=0;e.length>n;n++)e[n].length>i&&(i=e[n].length,a=n);return a},s.setMonth(t(this._get(e,r.match(/MM/)?\"monthNames\":\"monthNamesShort\"))),s.setDate(t(this._get(e,r.match(/DD/)?\"dayNames\":\"dayNamesShort\"))+20-s.getDay())),e.input.attr(\"size\",this._formatDate(e,s).length)}},_inlineDatepicker:function(t,i){var a=e(t);a.hasClass(this.markerClassName)||(a.addClass(this.markerClassName).append(i.dpDiv),e.data(t,r,i),this._setDate(i,this._getDefaultDate(i),!0),this._updateDatepicker(i),this._updateAlternate(i),i.settings.disabled&&this._disableDatepicker(t),i.dpDiv.css(\"display\",\"block\"))},_dialogDatepicker:function(t,i,a,s,o){var l,u,c,h,d,p=this._dialogInst;return p||(this.uuid+=1,l=\"dp\"+this.uuid,this._dialogInput=e(\"<input type='text' id='\"+l+\"' style='position: absolute; top: -100px; width: 0px;'/>\"),this._dialogInput.keydown(this._doKeyDown),e(\"body\").append(this._dialogInput),p=this._dialogInst=this._newInst(this._dialogInput,!1),p.settings={},e.data(this._dialogInput[0],r,p)),n(p.settings,s||{}),i=i&&i.constructor===Date?this._formatDate(p,i):i,this._dialogInput.val(i),this._pos=o?o.length?o:[o.pageX,o.pageY]:null,this._pos||(u=document.documentElement.clientWidth,c=document.documentElement.clientHeight,h=document.documentElement.scrollLeft||document.body.scrollLeft,d=document.documentElement.scrollTop||document.body.scrollTop,this._pos=[u/2-100+h,c/2-150+d]),this._dialogInput.css(\"left\",this._pos[0]+20+\"px\").css(\"top\",this._pos[1]+\"px\"),p.settings.onSelect=a,this._inDialog=!0,this.dpDiv.addClass(this._dialogClass),this._showDatepicker(this._dialogInput[0]),e.blockUI&&e.blockUI(this.dpDiv),e.data(this._dialogInput[0],r,p),this},_destroyDatepicker:function(t){var i,a=e(t),n=e.data(t,r);a.hasClass(this.markerClassName)&&(i=t.nodeName.toLowerCase(),e.removeData(t,r),\"input\"===i?(n.append.remove(),n.trigger.remove(),a.removeClass(this.markerClassName).unbind(\"focus\",this._showDatepicker).unbind(\"keydown\",this._doKeyDown).unbind(\"keypress\",this._doKeyPress).unbind(\"keyup\",this._doKeyUp)):(\"div\"===i||\"span\"===i)&&a.removeClass(this.markerClassName).empty())},_enableDatepicker:function(t){var i,a,n=e(t),s=e.data(t,r);n.hasClass(this.markerClassName)&&(i=t.nodeName.toLowerCase(),\"input\"===i?(t.disabled=!1,s.trigger.filter(\"button\").each(function(){this.disabled=!1}).end().filter(\"img\").css({opacity:\"1.0\",cursor:\"\"})):(\"div\"===i||\"span\"===i)&&(a=n.children(\".\"+this._inlineClass),a.children().removeClass(\"ui-state-disabled\"),a.find(\"select.ui-datepicker-month, select.ui-datepicker-year\").prop(\"disabled\",!1)),this._disabledInputs=e.map(this._disabledInputs,function(e){return e===t?null:e}))},_disableDatepicker:function(t){var i,a,n=e(t),s=e.data(t,r);n.hasClass(this.markerClassName)&&(i=t.nodeName.toLowerCase(),\"input\"===i?(t.disabled=!0,s.trigger.filter(\"button\").each(function(){this.disabled=!0}).end().filter(\"img\").css({opacity:\"0.5\",cursor:\"default\"})):(\"div\"===i||\"span\"===i)&&(a=n.children(\".\"+this._inlineClass),a.children().addClass(\"ui-state-disabled\"),a.find(\"select.ui-datepicker-month, select.ui-datepicker-year\").prop(\"disabled\",!0)),this._disabledInputs=e.map(this._disabledInputs,function(e){return e===t?null:e}),this._disabledInputs[this._disabledInputs.length]=t)},_isDisabledDatepicker:function(e){if(!e)return!1;for(var t=0;this._disabledInputs.length>t;t++)if(this._disabledInputs[t]===e)return!0;return!1},_getInst:function(t){try{return e.data(t,r)}catch(i){throw\"Missing instance data for this datepicker\"}},_optionDatepicker:function(i,a,s){var r,o,l,u,c=this._getInst(i);return 2===arguments.length&&\"string\"==typeof a?\"defaults\"===a?e.extend({},e.datepicker._defaults):c?\"all\"===a?e.extend({},c.settings):this._get(c,a):null:(r=a||{},\"string\"==typeof a&&(r={},r[a]=s),c&&(this._curInst===c&&this._hideDatepicker(),o=this._getDateDatepicker(i,!0),l=this._getMinMaxDate(c,\"min\"),u=this._getMinMaxDate(c,\"max\"),n(c.settings,r),null!==l&&r.dateFormat!==t&&r.minDate===t&&(c.settings.minDate=this._formatDate(c,l)),null!==u&&r.dateFormat!==t&&r.maxDate===t&&(c.settings.maxDate=this._formatDate(c,u)),\"disabled\"in r&&(r.disabled?this._disableDatepicker(i):this._enableDatepicker(i)),this._attachments(e(i),c),this._autoSize(c),this._setDate(c,o),this._updateAlternate(c),this._updateDatepicker(c)),t)},_changeDatepicker:function(e,t,i){this._optionDatepicker(e,t,i)},_refreshDatepicker:function(e){var t=this._getInst(e);t&&this._updateDatepicker(t)},_setDateDatepicker:function(e,t){var i=this._getInst(e);i&&(this._setDate(i,t),this._updateDatepicker(i),this._updateAlternate(i))},_getDateDatepicker:function(e,t){var i=this._getInst(e);return i&&!i.inline&&this._setDateFromField(i,t),i?this._getDate(i):null},_doKeyDown:function(t){var i,a,n,s=e.datepicker._getInst(t.target),r=!0,o=s.dpDiv.is(\".ui-datepicker-rtl\");if(s._keyEvent=!0,e.datepicker._datepickerShowing)switch(t.keyCode){case 9:e.datepicker._hideDatepicker(),r=!1;break;case 13:return n=e(\"td.\"+e.datepicker._dayOverClass+\":not(.\"+e.datepicker._currentClass+\")\",s.dpDiv),n[0]&&e.datepicker._selectDay(t.target,s.selectedMonth,s.selectedYear,n[0]),i=e.datepicker._get(s,\"onSelect\"),i?(a=e.datepicker._formatDate(s),i.apply(s.input?s.input[0]:null,[a,s])):e.datepicker._hideDatepicker(),!1;case 27:e.datepicker._hideDatepicker();break;case 33:e.datepicker._adjustDate(t.target,t.ctrlKey?-e.datepicker._get(s,\"stepBigMonths\"):-e.datepicker._get(s,\"stepMonths\"),\"M\");break;case 34:e.datepicker._adjustDate(t.target,t.ctrlKey?+e.datepicker._get(s,\"stepBigMonths\"):+e.datepicker._get(s,\"stepMonths\"),\"M\");break;case 35:(t.ctrlKey||t.metaKey)&&e.datepicker._clearDate(t.target),r=t.ctrlKey||t.metaKey;break;case 36:(t.ctrlKey||t.metaKey)&&e.datepicker._gotoToday(t.target),r=t.ctrlKey||t.metaKey;break;case 37:(t.ctrlKey||t.metaKey)&&e.datepicker._adjustDate(t.target,o?1:-1,\"D\"),r=t.ctrlKey||t.metaKey,t.originalEvent.altKey&&e.datepicker._adjustDate(t.target,t.ctrlKey?-e.datepicker._get(s,\"stepBigMonths\"):-e.datepicker._get(s,\"stepMonths\"),\"M\");break;case 38:(t.ctrlKey||t.metaKey)&&e.datepicker._adjustDate(t.target,-7,\"D\"),r=t.ctrlKey||t.metaKey;break;case 39:(t.ctrlKey||t.metaKey)&&e.datepicker._adjustDate(t.target,o?-1:1,\"D\"),r=t.ctrlKey||t.metaKey,t.originalEvent.altKey&&e.datepicker._adjustDate(t.target,t.ctrlKey?+e.datepicker._get(s,\"stepBigMonths\"):+e.datepicker._get(s,\"stepMonths\"),\"M\");break;case 40:(t.ctrlKey||t.metaKey)&&e.datepicker._adjustDate(t.target,7,\"D\"),r=t.ctrlKey||t.metaKey;break;default:r=!1}else 36===t.keyCode&&t.ctrlKey?e.datepicker._showDatepicker(this):r=!1;r&&(t.preventDefault(),t.stopPropagation())},_doKeyPress:function(i){var a,n,s=e.datepicker._getInst(i.target);return e.datepicker._get(s,\"constrainInput\")?(a=e.datepicker._possibleChars(e.datepicker._get(s,\"dateFormat\")),n=String.fromCharCode(null==i.charCode?i.keyCode:i.charCode),i.ctrlKey||i.metaKey||\" \">n||!a||a.indexOf(n)>-1):t},_doKeyUp:function(t){var i,a=e.datepicker._getInst(t.target);if(a.input.val()!==a.lastVal)try{i=e.datepicker.parseDate(e.datepicker._get(a,\"dateFormat\"),a.input?a.input.val():null,e.datepicker._getFormatConfig(a)),i&&(e.datepicker._setDateFromField(a),e.datepicker._updateAlternate(a),e.datepicker._updateDatepicker(a))}catch(n){}return!0},_showDatepicker:function(t){if(t=t.target||t,\"input\"!==t.nodeName.toLowerCase()&&(t=e(\"input\",t.parentNode)[0]),!e.datepicker._isDisabledDatepicker(t)&&e.datepicker._lastInput!==t){var i,a,s,r,o,l,u;i=e.datepicker._getInst(t),e.datepicker._curInst&&e.datepicker._curInst!==i&&(e.datepicker._curInst.dpDiv.stop(!0,!0),i&&e.datepicker._datepickerShowing&&e.datepicker._hideDatepicker(e.datepicker._curInst.input[0])),a=e.datepicker._get(i,\"beforeShow\"),s=a?a.apply(t,[t,i]):{},s!==!1&&(n(i.settings,s),i.lastVal=null,e.datepicker._lastInput=t,e.datepicker._setDateFromField(i),e.datepicker._inDialog&&(t.value=\"\"),e.datepicker._pos||(e.datepicker._pos=e.datepicker._findPos(t),e.datepicker._pos[1]+=t.offsetHeight),r=!1,e(t).parents().each(function(){return r|=\"fixed\"===e(this).css(\"position\"),!r}),o={left:e.datepicker._pos[0],top:e.datepicker._pos[1]},e.datepicker._pos=null,i.dpDiv.empty(),i.dpDiv.css({position:\"absolute\",display:\"block\",top:\"-1000px\"}),e.datepicker._updateDatepicker(i),o=e.datepicker._checkOffset(i,o,r),i.dpDiv.css({position:e.datepicker._inDialog&&e.blockUI?\"static\":r?\"fixed\":\"absolute\",display:\"none\",left:o.left+\"px\",top:o.top+\"px\"}),i.inline||(l=e.datepicker._get(i,\"showAnim\"),u=e.datepicker._get(i,\"duration\"),i.dpDiv.zIndex(e(t).zIndex()+1),e.datepicker._datepickerShowing=!0,e.effects&&e.effects.effect[l]?i.dpDiv.show(l,e.datepicker._get(i,\"showOptions\"),u):i.dpDiv[l||\"show\"](l?u:null),e.datepicker._shouldFocusInput(i)&&i.input.focus(),e.datepicker._curInst=i))}},_updateDatepicker:function(t){this.maxRows=4,s=t,t.dpDiv.empty().append(this._generateHTML(t)),this._attachHandlers(t),t.dpDiv.find(\".\"+this._dayOverClass+\" a\").mouseover();var i,a=this._getNumberOfMonths(t),n=a[1],r=17;t.dpDiv.removeClass(\"ui-datepicker-multi-2 ui-datepicker-multi-3 ui-datepicker-multi-4\").width(\"\"),n>1&&t.dpDiv.addClass(\"ui-datepicker-multi-\"+n).css(\"width\",r*n+\"em\"),t.dpDiv[(1!==a[0]||1!==a[1]?\"add\":\"remove\")+\"Class\"](\"ui-datepicker-multi\"),t.dpDiv[(this._get(t,\"isRTL\")?\"add\":\"remove\")+\"Class\"](\"ui-datepicker-rtl\"),t===e.datepicker._curInst&&e.datepicker._datepickerShowing&&e.datepicker._shouldFocusInput(t)&&t.input.focus(),t.yearshtml&&(i=t.yearshtml,setTimeout(function(){i===t.yearshtml&&t.yearshtml&&t.dpDiv.find(\"select.ui-datepicker-year:first\").replaceWith(t.yearshtml),i=t.yearshtml=null},0))},_shouldFocusInput:function(e){return e.input&&e.input.is(\":visible\")&&!e.input.is(\":disabled\")&&!e.input.is(\":focus\")},_checkOffset:function(t,i,a){var n=t.dpDiv.outerWidth(),s=t.dpDiv.outerHeight(),r=t.input?t.input.outerWidth():0,o=t.input?t.input.outerHeight():0,l=document.documentElement.clientWidth+(a?0:e(document).scrollLeft()),u=document.documentElement.clientHeight+(a?0:e(document).scrollTop());return i.left-=this._get(t,\"isRTL\")?n-r:0,i.left-=a&&i.left===t.input.offset().left?e(document).scrollLeft():0,i.top-=a&&i.top===t.input.offset().top+o?e(document).scrollTop():0,i.left-=Math.min(i.left,i.left+n>l&&l>n?Math.abs(i.left+n-l):0),i.top-=Math.min(i.top,i.top+s>u&&u>s?Math.abs(s+o):0),i},_findPos:function(t){for(var i,a=this._getInst(t),n=this._get(a,\"isRTL\");t&&(\"hidden\"===t.type||1!==t.nodeType||e.expr.filters.hidden(t));)t=t[n?\"previousSibling\":\"nextSibling\"];return i=e(t).offset(),[i.left,i.top]},_hideDatepicker:function(t){var i,a,n,s,o=this._curInst;!o||t&&o!==e.data(t,r)||this._datepickerShowing&&(i=this._get(o,\"showAnim\"),a=this._get(o,\"duration\"),n=function(){e.datepicker._tidyDialog(o)},e.effects&&(e.effects.effect[i]||e.effects[i])?o.dpDiv.hide(i,e.datepicker._get(o,\"showOptions\"),a,n):o.dpDiv[\"slideDown\"===i?\"slideUp\":\"fadeIn\"===i?\"fadeOut\":\"hide\"](i?a:null,n),i||n(),this._datepickerShowing=!1,s=this._get(o,\"onClose\"),s&&s.apply(o.input?o.input[0]:null,[o.input?o.input.val():\"\",o]),this._lastInput=null,this._inDialog&&(this._dialogInput.css({position:\"absolute\",left:\"0\",top:\"-100px\"}),e.blockUI&&(e.unblockUI(),e(\"body\").append(this.dpDiv))),this._inDialog=!1)},_tidyDialog:function(e){e.dpDiv.removeClass(this._dialogClass).unbind(\".ui-datepicker-calendar\")},_checkExternalClick:function(t){if(e.datepicker._curInst){var i=e(t.target),a=e.datepicker._getInst(i[0]);(i[0].id!==e.datepicker._mainDivId&&0===i.parents(\"#\"+e.datepicker._mainDivId).length&&!i.hasClass(e.datepicker.markerClassName)&&!i.closest(\".\"+e.datepicker._triggerClass).length&&e.datepicker._datepickerShowing&&(!e.datepicker._inDialog||!e.blockUI)||i.hasClass(e.datepicker.markerClassName)&&e.datepicker._curInst!==a)&&e.datepicker._hideDatepicker()}},_adjustDate:function(t,i,a){var n=e(t),s=this._getInst(n[0]);this._isDisabledDatepicker(n[0])||(this._adjustInstDate(s,i+(\"M\"===a?this._get(s,\"showCurrentAtPos\"):0),a),this._updateDatepicker(s))},_gotoToday:function(t){var i,a=e(t),n=this._getInst(a[0]);this._get(n,\"gotoCurrent\")&&n.currentDay?(n.selectedDay=n.currentDay,n.drawMonth=n.selectedMonth=n.currentMonth,n.drawYear=n.selectedYear=n.currentYear):(i=new Date,n.selectedDay=i.getDate(),n.drawMonth=n.selectedMonth=i.getMonth(),n.drawYear=n.selectedYear=i.getFullYear()),this._notifyChange(n),this._adjustDate(a)},_selectMonthYear:function(t,i,a){var n=e(t),s=this._getInst(n[0]);s[\"selected\"+(\"M\"===a?\"Month\":\"Year\")]=s[\"draw\"+(\"M\"===a?\"Month\":\"Year\")]=parseInt(i.options[i.selectedIndex].value,10),this._notifyChange(s),this._adjustDate(n)},_selectDay:function(t,i,a,n){var s,r=e(t);e(n).hasClass(this._unselectableClass)||this._isDisabledDatepicker(r[0])||(s=this._getInst(r[0]),s.selectedDay=s.currentDay=e(\"a\",n).html(),s.selectedMonth=s.currentMonth=i,s.selectedYear=s.currentYear=a,this._selectDate(t,this._formatDate(s,s.currentDay,s.currentMonth,s.currentYear)))},_clearDate:function(t){var i=e(t);this._selectDate(i,\"\")},_selectDate:function(t,i){var a,n=e(t),s=this._getInst(n[0]);i=null!=i?i:this._formatDate(s),s.input&&s.input.val(i),this._updateAlternate(s),a=this._get(s,\"onSelect\"),a?a.apply(s.input?s.input[0]:null,[i,s]):s.input&&s.input.trigger(\"change\"),s.inline?this._updateDatepicker(s):(this._hideDatepicker(),this._lastInput=s.input[0],\"object\"!=typeof s.input[0]&&s.input.focus(),this._lastInput=null)},_updateAlternate:function(t){var i,a,n,s=this._get(t,\"altField\");s&&(i=this._get(t,\"altFormat\")||this._get(t,\"dateFormat\"),a=this._getDate(t),n=this.formatDate(i,a,this._getFormatConfig(t)),e(s).each(function(){e(this).val(n)}))},noWeekends:function(e){var t=e.getDay();return[t>0&&6>t,\"\"]},iso8601Week:function(e){var t,i=new Date(e.getTime());return i.setDate(i.getDate()+4-(i.getDay()||7)),t=i.getTime(),i.setMonth(0),i.setDate(1),Math.floor(Math.round((t-i)/864e5)/7)+1},parseDate:function(i,a,n){if(null==i||null==a)throw\"Invalid arguments\";if(a=\"object\"==typeof a?\"\"+a:a+\"\",\"\"===a)return null;var s,r,o,l,u=0,c=(n?n.shortYearCutoff:null)||this._defaults.shortYearCutoff,h=\"string\"!=typeof c?c:(new Date).getFullYear()%100+parseInt(c,10),d=(n?n.dayNamesShort:null)||this._defaults.dayNamesShort,p=(n?n.dayNames:null)||this._defaults.dayNames,f=(n?n.monthNamesShort:null)||this._defaults.monthNamesShort,g=(n?n.monthNames:null)||this._defaults.monthNames,m=-1,_=-1,v=-1,y=-1,k=!1,D=function(e){var t=i.length>s+1&&i.charAt(s+1)===e;return t&&s++,t},b=function(e){var t=D(e),i=\"@\"===e?14:\"!\"===e?20:\"y\"===e&&t?4:\"o\"===e?3:2,n=RegExp(\"^\\\\d{1,\"+i+\"}\"),s=a.substring(u).match(n);if(!s)throw\"Missing number at position \"+u;return u+=s[0].length,parseInt(s[0],10)},w=function(i,n,s){var r=-1,o=e.map(D(i)?s:n,function(e,t){return[[t,e]]}).sort(function(e,t){return-(e[1].length-t[1].length)});if(e.each(o,function(e,i){var n=i[1];return a.substr(u,n.length).toLowerCase()===n.toLowerCase()?(r=i[0],u+=n.length,!1):t}),-1!==r)return r+1;throw\"Unknown name at position \"+u},M=function(){if(a.charAt(u)!==i.charAt(s))throw\"Unexpected literal at position \"+u;u++};for(s=0;i.length>s;s++)if(k)\"'\"!==i.charAt(s)||D(\"'\")?M():k=!1;else switch(i.charAt(s)){case\"d\":v=b(\"d\");break;case\"D\":w(\"D\",d,p);break;case\"o\":y=b(\"o\");break;case\"m\":_=b(\"m\");break;case\"M\":_=w(\"M\",f,g);break;case\"y\":m=b(\"y\");break;case\"@\":l=new Date(b(\"@\")),m=l.getFullYear(),_=l.getMonth()+1,v=l.getDate();break;case\"!\":l=new Date((b(\"!\")-this._ticksTo1970)/1e4),m=l.getFullYear(),_=l.getMonth()+1,v=l.getDate();break;case\"'\":D(\"'\")?M():k=!0;break;default:M()}if(a.length>u&&(o=a.substr(u),!/^\\s+/.test(o)))throw\"Extra/unparsed characters found in date: \"+o;if(-1===m?m=(new Date).getFullYear():100>m&&(m+=(new Date).getFullYear()-(new Date).getFullYear()%100+(h>=m?0:-100)),y>-1)for(_=1,v=y;;){if(r=this._getDaysInMonth(m,_-1),r>=v)break;_++,v-=r}if(l=this._daylightSavingAdjust(new Date(m,_-1,v)),l.getFullYear()!==m||l.getMonth()+1!==_||l.getDate()!==v)throw\"Invalid date\";return l},ATOM:\"yy-mm-dd\",COOKIE:\"D, dd M yy\",ISO_8601:\"yy-mm-dd\",RFC_822:\"D, d M y\",RFC_850:\"DD, dd-M-y\",RFC_1036:\"D, d M y\",RFC_1123:\"D, d M yy\",RFC_2822:\"D, d M yy\",RSS:\"D, d M y\",TICKS:\"!\",TIMESTAMP:\"@\",W3C:\"yy-mm-dd\",_ticksTo1970:1e7*60*60*24*(718685+Math.floor(492.5)-Math.floor(19.7)+Math.floor(4.925)),formatDate:function(e,t,i){if(!t)return\"\";var a,n=(i?i.dayNamesShort:null)||this._defaults.dayNamesShort,s=(i?i.dayNames:null)||this._defaults.dayNames,r=(i?i.monthNamesShort:null)||this._defaults.monthNamesShort,o=(i?i.monthNames:null)||this._defaults.monthNames,l=function(t){var i=e.length>a+1&&e.charAt(a+1)===t;return i&&a++,i},u=function(e,t,i){var a=\"\"+t;if(l(e))for(;i>a.length;)a=\"0\"+a;return a},c=function(e,t,i,a){return l(e)?a[t]:i[t]},h=\"\",d=!1;if(t)for(a=0;e.length>a;a++)if(d)\"'\"!==e.charAt(a)||l(\"'\")?h+=e.charAt(a):d=!1;else switch(e.charAt(a)){case\"d\":h+=u(\"d\",t.getDate(),2);break;case\"D\":h+=c(\"D\",t.getDay(),n,s);break;case\"o\":h+=u(\"o\",Math.round((new Date(t.getFullYear(),t.getMonth(),t.getDate()).getTime()-new Date(t.getFullYear(),0,0).getTime())/864e5),3);break;case\"m\":h+=u(\"m\",t.getMonth()+1,2);break;case\"M\":h+=c(\"M\",t.getMonth(),r,o);break;case\"y\":h+=l(\"y\")?t.getFullYear():(10>t.getYear()%100?\"0\":\"\")+t.getYear()%100;break;case\"@\":h+=t.getTime();break;case\"!\":h+=1e4*t.getTime()+this._ticksTo1970;break;case\"'\":l(\"'\")?h+=\"'\":d=!0;break;default:h+=e.charAt(a)}return h},_possibleChars:function(e){var t,i=\"\",a=!1,n=function(i){var a=e.length>t+1&&e.charAt(t+1)===i;return a&&t++,a};for(t=0;e.length>t;t++)if(a)\"'\"!==e.charAt(t)||n(\"'\")?i+=e.charAt(t):a=!1;else switch(e.charAt(t)){case\"d\":case\"m\":case\"y\":case\"@\":i+=\"0123456789\";break;case\"D\":case\"M\":return null;case\"'\":n(\"'\")?i+=\"'\":a=!0;break;default:i+=e.charAt(t)}return i},_get:function(e,i){return e.settings[i]!==t?e.settings[i]:this._defaults[i]},_setDateFromField:function(e,t){if(e.input.val()!==e.lastVal){var i=this._get(e,\"dateFormat\"),a=e.lastVal=e.input?e.input.val():null,n=this._getDefaultDate(e),s=n,r=this._getFormatConfig(e);try{s=this.parseDate(i,a,r)||n}catch(o){a=t?\"\":a}e.selectedDay=s.getDate(),e.drawMonth=e.selectedMonth=s.getMonth(),e.drawYear=e.selectedYear=s.getFullYear(),e.currentDay=a?s.getDate():0,e.currentMonth=a?s.getMonth():0,e.currentYear=a?s.getFullYear():0,this._adjustInstDate(e)}},_getDefaultDate:function(e){return this._restrictMinMax(e,this._determineDate(e,this._get(e,\"defaultDate\"),new Date))},_determineDate:function(t,i,a){var n=function(e){var t=new Date;return t.setDate(t.getDate()+e),t},s=function(i){try{return e.datepicker.parseDate(e.datepicker._get(t,\"dateFormat\"),i,e.datepicker._getFormatConfig(t))}catch(a){}for(var n=(i.toLowerCase().match(/^c/)?e.datepicker._getDate(t):null)||new Date,s=n.getFullYear(),r=n.getMonth(),o=n.getDate(),l=/([+\\-]?[0-9]+)\\s*(d|D|w|W|m|M|y|Y)?/g,u=l.exec(i);u;){switch(u[2]||\"d\"){case\"d\":case\"D\":o+=parseInt(u[1],10);break;case\"w\":case\"W\":o+=7*parseInt(u[1],10);break;case\"m\":case\"M\":r+=parseInt(u[1],10),o=Math.min(o,e.datepicker._getDaysInMonth(s,r));break;case\"y\":case\"Y\":s+=parseInt(u[1],10),o=Math.min(o,e.datepicker._getDaysInMonth(s,r))}u=l.exec(i)}return new Date(s,r,o)},r=null==i||\"\"===i?a:\"string\"==typeof i?s(i):\"number\"==typeof i?isNaN(i)?a:n(i):new Date(i.getTime());return r=r&&\"Invalid Date\"==\"\"+r?a:r,r&&(r.setHours(0),r.setMinutes(0),r.setSeconds(0),r.setMilliseconds(0)),this._daylightSavingAdjust(r)},_daylightSavingAdjust:function(e){return e?(e.setHours(e.getHours()>12?e.getHours()+2:0),e):null},_setDate:function(e,t,i){var a=!t,n=e.selectedMonth,s=e.selectedYear,r=this._restrictMinMax(e,this._determineDate(e,t,new Date));e.selectedDay=e.currentDay=r.getDate(),e.drawMonth=e.selectedMonth=e.currentMonth=r.getMonth(),e.drawYear=e.selectedYear=e.currentYear=r.getFullYear(),n===e.selectedMonth&&s===e.selectedYear||i||this._notifyChange(e),this._adjustInstDate(e),e.input&&e.input.val(a?\"\":this._formatDate(e))},_getDate:function(e){var t=!e.currentYear||e.input&&\"\"===e.input.val()?null:this._daylightSavingAdjust(new Date(e.currentYear,e.currentMonth,e.currentDay));return t},_attachHandlers:function(t){var i=this._get(t,\"stepMonths\"),a=\"#\"+t.id.replace(/\\\\\\\\/g,\"\\\\\");t.dpDiv.find(\"[data-handler]\").map(function(){var t={prev:function(){e.datepicker._adjustDate(a,-i,\"M\")},next:function(){e.datepicker._adjustDate(a,+i,\"M\")},hide:function(){e.datepicker._hideDatepicker()},today:function(){e.datepicker._gotoToday(a)},selectDay:function(){return e.datepicker._selectDay(a,+this.getAttribute(\"data-month\"),+this.getAttribute(\"data-year\"),this),!1},selectMonth:function(){return e.datepicker._selectMonthYear(a,this,\"M\"),!1},selectYear:function(){return e.datepicker._selectMonthYear(a,this,\"Y\"),!1}};e(this).bind(this.getAttribute(\"data-event\"),t[this.getAttribute(\"data-handler\")])})},_generateHTML:function(e){var t,i,a,n,s,r,o,l,u,c,h,d,p,f,g,m,_,v,y,k,D,b,w,M,x,C,I,T,N,A,F,S,E,Y,W,P,O,j,L,H=new Date,R=this._daylightSavingAdjust(new Date(H.getFullYear(),H.getMonth(),H.getDate())),K=this._get(e,\"isRTL\"),U=this._get(e,\"showButtonPanel\"),B=this._get(e,\"hideIfNoPrevNext\"),z=this._get(e,\"navigationAsDateFormat\"),q=this._getNumberOfMonths(e),G=this._get(e,\"showCurrentAtPos\"),$=this._get(e,\"stepMonths\"),V=1!==q[0]||1!==q[1],Q=this._daylightSavingAdjust(e.currentDay?new Date(e.currentYear,e.currentMonth,e.currentDay):new Date(9999,9,9)),J=this._getMinMaxDate(e,\"min\"),X=this._getMinMaxDate(e,\"max\"),Z=e.drawMonth-G,et=e.drawYear;if(0>Z&&(Z+=12,et--),X)for(t=this._daylightSavingAdjust(new Date(X.getFullYear(),X.getMonth()-q[0]*q[1]+1,X.getDate())),t=J&&J>t?J:t;this._daylightSavingAdjust(new Date(et,Z,1))>t;)Z--,0>Z&&(Z=11,et--);for(e.drawMonth=Z,e.drawYear=et,i=this._get(e,\"prevText\"),i=z?this.formatDate(i,this._daylightSavingAdjust(new Date(et,Z-$,1)),this._getFormatConfig(e)):i,a=this._canAdjustMonth(e,-1,et,Z)?\"<a class='ui-datepicker-prev ui-corner-all' data-handler='prev' data-event='click' title='\"+i+\"'><span class='ui-icon ui-icon-circle-triangle-\"+(K?\"e\":\"w\")+\"'>\"+i+\"</span></a>\":B?\"\":\"<a class='ui-datepicker-prev ui-corner-all ui-state-disabled' title='\"+i+\"'><span class='ui-icon ui-icon-circle-triangle-\"+(K?\"e\":\"w\")+\"'>\"+i+\"</span></a>\",n=this._get(e,\"nextText\"),n=z?this.formatDate(n,this._daylightSavingAdjust(new Date(et,Z+$,1)),this._getFormatConfig(e)):n,s=this._canAdjustMonth(e,1,et,Z)?\"<a class='ui-datepicker-next ui-corner-all' data-handler='next' data-event='click' title='\"+n+\"'><span class='ui-icon ui-icon-circle-triangle-\"+(K?\"w\":\"e\")+\"'>\"+n+\"</span></a>\":B?\"\":\"<a class='ui-datepicker-next ui-corner-all ui-state-disabled' title='\"+n+\"'><span class='ui-icon ui-icon-circle-triangle-\"+(K?\"w\":\"e\")+\"'>\"+n+\"</span></a>\",r=this._get(e,\"currentText\"),o=this._get(e,\"gotoCurrent\")&&e.currentDay?Q:R,r=z?this.formatDate(r,o,this._getFormatConfig(e)):r,l=e.inline?\"\":\"<button type='button' class='ui-datepicker-close ui-state-default ui-priority-primary ui-corner-all' data-handler='hide' data-event='click'>\"+this._get(e,\"closeText\")+\"</button>\",u=U?\"<div class='ui-datepicker-buttonpane ui-widget-content'>\"+(K?l:\"\")+(this._isInRange(e,o)?\"<button type='button' class='ui-datepicker-current ui-state-default ui-priority-secondary ui-corner-all' data-handler='today' data-event='click'>\"+r+\"</button>\":\"\")+(K?\"\":l)+\"</div>\":\"\",c=parseInt(this._get(e,\"firstDay\"),10),c=isNaN(c)?0:c,h=this._get(e,\"showWeek\"),d=this._get(e,\"dayNames\"),p=this._get(e,\"dayNamesMin\"),f=this._get(e,\"monthNames\"),g=this._get(e,\"monthNamesShort\"),m=this._get(e,\"beforeShowDay\"),_=this._get(e,\"showOtherMonths\"),v=this._get(e,\"selectOtherMonths\"),y=this._getDefaultDate(e),k=\"\",b=0;q[0]>b;b++){for(w=\"\",this.maxRows=4,M=0;q[1]>M;M++){if(x=this._daylightSavingAdjust(new Date(et,Z,e.selectedDay)),C=\" ui-corner-all\",I=\"\",V){if(I+=\"<div class='ui-datepicker-group\",q[1]>1)switch(M){case 0:I+=\" ui-datepicker-group-first\",C=\" ui-corner-\"+(K?\"right\":\"left\");break;case q[1]-1:I+=\" ui-datepicker-group-last\",C=\" ui-corner-\"+(K?\"left\":\"right\");break;default:I+=\" ui-datepicker-group-middle\",C=\"\"}I+=\"'>\"}for(I+=\"<div class='ui-datepicker-header ui-widget-header ui-helper-clearfix\"+C+\"'>\"+(/all|left/.test(C)&&0===b?K?s:a:\"\")+(/all|right/.test(C)&&0===b?K?a:s:\"\")+this._generateMonthYearHeader(e,Z,et,J,X,b>0||M>0,f,g)+\"</div><table class='ui-datepicker-calendar'><thead>\"+\"<tr>\",T=h?\"<th class='ui-datepicker-week-col'>\"+this._get(e,\"weekHeader\")+\"</th>\":\"\",D=0;7>D;D++)N=(D+c)%7,T+=\"<th\"+((D+c+6)%7>=5?\" class='ui-datepicker-week-end'\":\"\")+\">\"+\"<span title='\"+d[N]+\"'>\"+p[N]+\"</span></th>\";for(I+=T+\"</tr></thead><tbody>\",A=this._getDaysInMonth(et,Z),et===e.selectedYear&&Z===e.selectedMonth&&(e.selectedDay=Math.min(e.selectedDay,A)),F=(this._getFirstDayOfMonth(et,Z)-c+7)%7,S=Math.ceil((F+A)/7),E=V?this.maxRows>S?this.maxRows:S:S,this.maxRows=E,Y=this._daylightSavingAdjust(new Date(et,Z,1-F)),W=0;E>W;W++){for(I+=\"<tr>\",P=h?\"<td class='ui-datepicker-week-col'>\"+this._get(e,\"calculateWeek\")(Y)+\"</td>\":\"\",D=0;7>D;D++)O=m?m.apply(e.input?e.input[0]:null,[Y]):[!0,\"\"],j=Y.getMonth()!==Z,L=j&&!v||!O[0]||J&&J>Y||X&&Y>X,P+=\"<td class='\"+((D+c+6)%7>=5?\" ui-datepicker-week-end\":\"\")+(j?\" ui-datepicker-other-month\":\"\")+(Y.getTime()===x.getTime()&&Z===e.selectedMonth&&e._keyEvent||y.getTime()===Y.getTime()&&y.getTime()===x.getTime()?\" \"+this._dayOverClass:\"\")+(L?\" \"+this._unselectableClass+\" ui-state-disabled\":\"\")+(j&&!_?\"\":\" \"+O[1]+(Y.getTime()===Q.getTime()?\" \"+this._currentClass:\"\")+(Y.getTime()===R.getTime()?\" ui-datepicker-today\":\"\"))+\"'\"+(j&&!_||!O[2]?\"\":\" title='\"+O[2].replace(/'/g,\"&#39;\")+\"'\")+(L?\"\":\" data-handler='selectDay' data-event='click' data-month='\"+Y.getMonth()+\"' data-year='\"+Y.getFullYear()+\"'\")+\">\"+(j&&!_?\"&#xa0;\":L?\"<span class='ui-state-default'>\"+Y.getDate()+\"</span>\":\"<a class='ui-state-default\"+(Y.getTime()===R.getTime()?\" ui-state-highlight\":\"\")+(Y.getTime()===Q.getTime()?\" ui-state-active\":\"\")+(j?\" ui-priority-secondary\":\"\")+\"' href='#'>\"+Y.getDate()+\"</a>\")+\"</td>\",Y.setDate(Y.getDate()+1),Y=this._daylightSavingAdjust(Y);I+=P+\"</tr>\"}Z++,Z>11&&(Z=0,et++),I+=\"</tbody></table>\"+(V?\"</div>\"+(q[0]>0&&M===q[1]-1?\"<div class='ui-datepicker-row-break'></div>\":\"\"):\"\"),w+=I}k+=w}return k+=u,e._keyEvent=!1,k},_generateMonthYearHeader:function(e,t,i,a,n,s,r,o){var l,u,c,h,d,p,f,g,m=this._get(e,\"changeMonth\"),_=this._get(e,\"changeYear\"),v=this._get(e,\"showMonthAfterYear\"),y=\"<div class='ui-datepicker-title'>\",k=\"\";if(s||!m)k+=\"<span class='ui-datepicker-month'>\"+r[t]+\"</span>\";else{for(l=a&&a.getFullYear()===i,u=n&&n.getFullYear()===i,k+=\"<select class='ui-datepicker-month' data-handler='selectMonth' data-event='change'>\",c=0;12>c;c++)(!l||c>=a.getMonth())&&(!u||n.getMonth()>=c)&&(k+=\"<option value='\"+c+\"'\"+(c===t?\" selected='selected'\":\"\")+\">\"+o[c]+\"</option>\");k+=\"</select>\"}if(v||(y+=k+(!s&&m&&_?\"\":\"&#xa0;\")),!e.yearshtml)if(e.yearshtml=\"\",s||!_)y+=\"<span class='ui-datepicker-year'>\"+i+\"</span>\";else{for(h=this._get(e,\"yearRange\").split(\":\"),d=(new Date).getFullYear(),p=function(e){var t=e.match(/c[+\\-].*/)?i+parseInt(e.substring(1),10):e.match(/[+\\-].*/)?d+parseInt(e,10):parseInt(e,10);\nreturn isNaN(t)?d:t},f=p(h[0]),g=Math.max(f,p(h[1]||\"\")),f=a?Math.max(f,a.getFullYear()):f,g=n?Math.min(g,n.getFullYear()):g,e.yearshtml+=\"<select class='ui-datepicker-year' data-handler='selectYear' data-event='change'>\";g>=f;f++)e.yearshtml+=\"<option value='\"+f+\"'\"+(f===i?\" selected='selected'\":\"\")+\">\"+f+\"</option>\";e.yearshtml+=\"</select>\",y+=e.yearshtml,e.yearshtml=null}return y+=this._get(e,\"yearSuffix\"),v&&(y+=(!s&&m&&_?\"\":\"&#xa0;\")+k),y+=\"</div>\"},_adjustInstDate:function(e,t,i){var a=e.drawYear+(\"Y\"===i?t:0),n=e.drawMonth+(\"M\"===i?t:0),s=Math.min(e.selectedDay,this._getDaysInMonth(a,n))+(\"D\"===i?t:0),r=this._restrictMinMax(e,this._daylightSavingAdjust(new Date(a,n,s)));e.selectedDay=r.getDate(),e.drawMonth=e.selectedMonth=r.getMonth(),e.drawYear=e.selectedYear=r.getFullYear(),(\"M\"===i||\"Y\"===i)&&this._notifyChange(e)},_restrictMinMax:function(e,t){var i=this._getMinMaxDate(e,\"min\"),a=this._getMinMaxDate(e,\"max\"),n=i&&i>t?i:t;return a&&n>a?a:n},_notifyChange:function(e){var t=this._get(e,\"onChangeMonthYear\");t&&t.apply(e.input?e.input[0]:null,[e.selectedYear,e.selectedMonth+1,e])},_getNumberOfMonths:function(e){var t=this._get(e,\"numberOfMonths\");return null==t?[1,1]:\"number\"==typeof t?[1,t]:t},_getMinMaxDate:function(e,t){return this._determineDate(e,this._get(e,t+\"Date\"),null)},_getDaysInMonth:function(e,t){return 32-this._daylightSavingAdjust(new Date(e,t,32)).getDate()},_getFirstDayOfMonth:function(e,t){return new Date(e,t,1).getDay()},_canAdjustMonth:function(e,t,i,a){var n=this._getNumberOfMonths(e),s=this._daylightSavingAdjust(new Date(i,a+(0>t?t:n[0]*n[1]),1));return 0>t&&s.setDate(this._getDaysInMonth(s.getFullYear(),s.getMonth())),this._isInRange(e,s)},_isInRange:function(e,t){var i,a,n=this._getMinMaxDate(e,\"min\"),s=this._getMinMaxDate(e,\"max\"),r=null,o=null,l=this._get(e,\"yearRange\");return l&&(i=l.split(\":\"),a=(new Date).getFullYear(),r=parseInt(i[0],10),o=parseInt(i[1],10),i[0].match(/[+\\-].*/)&&(r+=a),i[1].match(/[+\\-].*/)&&(o+=a)),(!n||t.getTime()>=n.getTime())&&(!s||t.getTime()<=s.getTime())&&(!r||t.getFullYear()>=r)&&(!o||o>=t.getFullYear())},_getFormatConfig:function(e){var t=this._get(e,\"shortYearCutoff\");return t=\"string\"!=typeof t?t:(new Date).getFullYear()%100+parseInt(t,10),{shortYearCutoff:t,dayNamesShort:this._get(e,\"dayNamesShort\"),dayNames:this._get(e,\"dayNames\"),monthNamesShort:this._get(e,\"monthNamesShort\"),monthNames:this._get(e,\"monthNames\")}},_formatDate:function(e,t,i,a){t||(e.currentDay=e.selectedDay,e.currentMonth=e.selectedMonth,e.currentYear=e.selectedYear);var n=t?\"object\"==typeof t?t:this._daylightSavingAdjust(new Date(a,i,t)):this._daylightSavingAdjust(new Date(e.currentYear,e.currentMonth,e.currentDay));return this.formatDate(this._get(e,\"dateFormat\"),n,this._getFormatConfig(e))}}),e.fn.datepicker=function(t){if(!this.length)return this;e.datepicker.initialized||(e(document).mousedown(e.datepicker._checkExternalClick),e.datepicker.initialized=!0),0===e(\"#\"+e.datepicker._mainDivId).length&&e(\"body\").append(e.datepicker.dpDiv);var i=Array.prototype.slice.call(arguments,1);return\"string\"!=typeof t||\"isDisabled\"!==t&&\"getDate\"!==t&&\"widget\"!==t?\"option\"===t&&2===arguments.length&&\"string\"==typeof arguments[1]?e.datepicker[\"_\"+t+\"Datepicker\"].apply(e.datepicker,[this[0]].concat(i)):this.each(function(){\"string\"==typeof t?e.datepicker[\"_\"+t+\"Datepicker\"].apply(e.datepicker,[this].concat(i)):e.datepicker._attachDatepicker(this,t)}):e.datepicker[\"_\"+t+\"Datepicker\"].apply(e.datepicker,[this[0]].concat(i))},e.datepicker=new i,e.datepicker.initialized=!1,e.datepicker.uuid=(new Date).getTime(),e.datepicker.version=\"1.10.3\"})(jQuery);(function(e){var t={buttons:!0,height:!0,maxHeight:!0,maxWidth:!0,minHeight:!0,minWidth:!0,width:!0},a={maxHeight:!0,maxWidth:!0,minHeight:!0,minWidth:!0};e.widget(\"ui.dialog\",{version:\"1.10.3\",options:{appendTo:\"body\",autoOpen:!0,buttons:[],closeOnEscape:!0,closeText:\"close\",dialogClass:\"\",draggable:!0,hide:null,height:\"auto\",maxHeight:null,maxWidth:null,minHeight:150,minWidth:150,modal:!1,position:{my:\"center\",at:\"center\",of:window,collision:\"fit\",using:function(t){var a=e(this).css(t).offset().top;0>a&&e(this).css(\"top\",t.top-a)}},resizable:!0,show:null,title:null,width:300,beforeClose:null,close:null,drag:null,dragStart:null,dragStop:null,focus:null,open:null,resize:null,resizeStart:null,resizeStop:null},_create:function(){this.originalCss={display:this.element[0].style.display,width:this.element[0].style.width,minHeight:this.element[0].style.minHeight,maxHeight:this.element[0].style.maxHeight,height:this.element[0].style.height},this.originalPosition={parent:this.element.parent(),index:this.element.parent().children().index(this.element)},this.originalTitle=this.element.attr(\"title\"),this.options.title=this.options.title||this.originalTitle,this._createWrapper(),this.element.show().removeAttr(\"title\").addClass(\"ui-dialog-content ui-widget-content\").appendTo(this.uiDialog),this._createTitlebar(),this._createButtonPane(),this.options.draggable&&e.fn.draggable&&this._makeDraggable(),this.options.resizable&&e.fn.resizable&&this._makeResizable(),this._isOpen=!1},_init:function(){this.options.autoOpen&&this.open()},_appendTo:function(){var t=this.options.appendTo;return t&&(t.jquery||t.nodeType)?e(t):this.document.find(t||\"body\").eq(0)},_destroy:function(){var e,t=this.originalPosition;this._destroyOverlay(),this.element.removeUniqueId().removeClass(\"ui-dialog-content ui-widget-content\").css(this.originalCss).detach(),this.uiDialog.stop(!0,!0).remove(),this.originalTitle&&this.element.attr(\"title\",this.originalTitle),e=t.parent.children().eq(t.index),e.length&&e[0]!==this.element[0]?e.before(this.element):t.parent.append(this.element)},widget:function(){return this.uiDialog},disable:e.noop,enable:e.noop,close:function(t){var a=this;this._isOpen&&this._trigger(\"beforeClose\",t)!==!1&&(this._isOpen=!1,this._destroyOverlay(),this.opener.filter(\":focusable\").focus().length||e(this.document[0].activeElement).blur(),this._hide(this.uiDialog,this.options.hide,function(){a._trigger(\"close\",t)}))},isOpen:function(){return this._isOpen},moveToTop:function(){this._moveToTop()},_moveToTop:function(e,t){var a=!!this.uiDialog.nextAll(\":visible\").insertBefore(this.uiDialog).length;return a&&!t&&this._trigger(\"focus\",e),a},open:function(){var t=this;return this._isOpen?(this._moveToTop()&&this._focusTabbable(),undefined):(this._isOpen=!0,this.opener=e(this.document[0].activeElement),this._size(),this._position(),this._createOverlay(),this._moveToTop(null,!0),this._show(this.uiDialog,this.options.show,function(){t._focusTabbable(),t._trigger(\"focus\")}),this._trigger(\"open\"),undefined)},_focusTabbable:function(){var e=this.element.find(\"[autofocus]\");e.length||(e=this.element.find(\":tabbable\")),e.length||(e=this.uiDialogButtonPane.find(\":tabbable\")),e.length||(e=this.uiDialogTitlebarClose.filter(\":tabbable\")),e.length||(e=this.uiDialog),e.eq(0).focus()},_keepFocus:function(t){function a(){var t=this.document[0].activeElement,a=this.uiDialog[0]===t||e.contains(this.uiDialog[0],t);a||this._focusTabbable()}t.preventDefault(),a.call(this),this._delay(a)},_createWrapper:function(){this.uiDialog=e(\"<div>\").addClass(\"ui-dialog ui-widget ui-widget-content ui-corner-all ui-front \"+this.options.dialogClass).hide().attr({tabIndex:-1,role:\"dialog\"}).appendTo(this._appendTo()),this._on(this.uiDialog,{keydown:function(t){if(this.options.closeOnEscape&&!t.isDefaultPrevented()&&t.keyCode&&t.keyCode===e.ui.keyCode.ESCAPE)return t.preventDefault(),this.close(t),undefined;if(t.keyCode===e.ui.keyCode.TAB){var a=this.uiDialog.find(\":tabbable\"),i=a.filter(\":first\"),r=a.filter(\":last\");t.target!==r[0]&&t.target!==this.uiDialog[0]||t.shiftKey?t.target!==i[0]&&t.target!==this.uiDialog[0]||!t.shiftKey||(r.focus(1),t.preventDefault()):(i.focus(1),t.preventDefault())}},mousedown:function(e){this._moveToTop(e)&&this._focusTabbable()}}),this.element.find(\"[aria-describedby]\").length||this.uiDialog.attr({\"aria-describedby\":this.element.uniqueId().attr(\"id\")})},_createTitlebar:function(){var t;this.uiDialogTitlebar=e(\"<div>\").addClass(\"ui-dialog-titlebar ui-widget-header ui-corner-all ui-helper-clearfix\").prependTo(this.uiDialog),this._on(this.uiDialogTitlebar,{mousedown:function(t){e(t.target).closest(\".ui-dialog-titlebar-close\")||this.uiDialog.focus()}}),this.uiDialogTitlebarClose=e(\"<button></button>\").button({label:this.options.closeText,icons:{primary:\"ui-icon-closethick\"},text:!1}).addClass(\"ui-dialog-titlebar-close\").appendTo(this.uiDialogTitlebar),this._on(this.uiDialogTitlebarClose,{click:function(e){e.preventDefault(),this.close(e)}}),t=e(\"<span>\").uniqueId().addClass(\"ui-dialog-title\").prependTo(this.uiDialogTitlebar),this._title(t),this.uiDialog.attr({\"aria-labelledby\":t.attr(\"id\")})},_title:function(e){this.options.title||e.html(\"&#160;\"),e.text(this.options.title)},_createButtonPane:function(){this.uiDialogButtonPane=e(\"<div>\").addClass(\"ui-dialog-buttonpane ui-widget-content ui-helper-clearfix\"),this.uiButtonSet=e(\"<div>\").addClass(\"ui-dialog-buttonset\").appendTo(this.uiDialogButtonPane),this._createButtons()},_createButtons:function(){var t=this,a=this.options.buttons;return this.uiDialogButtonPane.remove(),this.uiButtonSet.empty(),e.isEmptyObject(a)||e.isArray(a)&&!a.length?(this.uiDialog.removeClass(\"ui-dialog-buttons\"),undefined):(e.each(a,function(a,i){var r,n;i=e.isFunction(i)?{click:i,text:a}:i,i=e.extend({type:\"button\"},i),r=i.click,i.click=function(){r.apply(t.element[0],arguments)},n={icons:i.icons,text:i.showText},delete i.icons,delete i.showText,e(\"<button></button>\",i).button(n).appendTo(t.uiButtonSet)}),this.uiDialog.addClass(\"ui-dialog-buttons\"),this.uiDialogButtonPane.appendTo(this.uiDialog),undefined)},_makeDraggable:function(){function t(e){return{position:e.position,offset:e.offset}}var a=this,i=this.options;this.uiDialog.draggable({cancel:\".ui-dialog-content, .ui-dialog-titlebar-close\",handle:\".ui-dialog-titlebar\",containment:\"document\",start:function(i,r){e(this).addClass(\"ui-dialog-dragging\"),a._blockFrames(),a._trigger(\"dragStart\",i,t(r))},drag:function(e,i){a._trigger(\"drag\",e,t(i))},stop:function(r,n){i.position=[n.position.left-a.document.scrollLeft(),n.position.top-a.document.scrollTop()],e(this).removeClass(\"ui-dialog-dragging\"),a._unblockFrames(),a._trigger(\"dragStop\",r,t(n))}})},_makeResizable:function(){function t(e){return{originalPosition:e.originalPosition,originalSize:e.originalSize,position:e.position,size:e.size}}var a=this,i=this.options,r=i.resizable,n=this.uiDialog.css(\"position\"),s=\"string\"==typeof r?r:\"n,e,s,w,se,sw,ne,nw\";this.uiDialog.resizable({cancel:\".ui-dialog-content\",containment:\"document\",alsoResize:this.element,maxWidth:i.maxWidth,maxHeight:i.maxHeight,minWidth:i.minWidth,minHeight:this._minHeight(),handles:s,start:function(i,r){e(this).addClass(\"ui-dialog-resizing\"),a._blockFrames(),a._trigger(\"resizeStart\",i,t(r))},resize:function(e,i){a._trigger(\"resize\",e,t(i))},stop:function(r,n){i.height=e(this).height(),i.width=e(this).width(),e(this).removeClass(\"ui-dialog-resizing\"),a._unblockFrames(),a._trigger(\"resizeStop\",r,t(n))}}).css(\"position\",n)},_minHeight:function(){var e=this.options;return\"auto\"===e.height?e.minHeight:Math.min(e.minHeight,e.height)},_position:function(){var e=this.uiDialog.is(\":visible\");e||this.uiDialog.show(),this.uiDialog.position(this.options.position),e||this.uiDialog.hide()},_setOptions:function(i){var r=this,n=!1,s={};e.each(i,function(e,i){r._setOption(e,i),e in t&&(n=!0),e in a&&(s[e]=i)}),n&&(this._size(),this._position()),this.uiDialog.is(\":data(ui-resizable)\")&&this.uiDialog.resizable(\"option\",s)},_setOption:function(e,t){var a,i,r=this.uiDialog;\"dialogClass\"===e&&r.removeClass(this.options.dialogClass).addClass(t),\"disabled\"!==e&&(this._super(e,t),\"appendTo\"===e&&this.uiDialog.appendTo(this._appendTo()),\"buttons\"===e&&this._createButtons(),\"closeText\"===e&&this.uiDialogTitlebarClose.button({label:\"\"+t}),\"draggable\"===e&&(a=r.is(\":data(ui-draggable)\"),a&&!t&&r.draggable(\"destroy\"),!a&&t&&this._makeDraggable()),\"position\"===e&&this._position(),\"resizable\"===e&&(i=r.is(\":data(ui-resizable)\"),i&&!t&&r.resizable(\"destroy\"),i&&\"string\"==typeof t&&r.resizable(\"option\",\"handles\",t),i||t===!1||this._makeResizable()),\"title\"===e&&this._title(this.uiDialogTitlebar.find(\".ui-dialog-title\")))},_size:function(){var e,t,a,i=this.options;this.element.show().css({width:\"auto\",minHeight:0,maxHeight:\"none\",height:0}),i.minWidth>i.width&&(i.width=i.minWidth),e=this.uiDialog.css({height:\"auto\",width:i.width}).outerHeight(),t=Math.max(0,i.minHeight-e),a=\"number\"==typeof i.maxHeight?Math.max(0,i.maxHeight-e):\"none\",\"auto\"===i.height?this.element.css({minHeight:t,maxHeight:a,height:\"auto\"}):this.element.height(Math.max(0,i.height-e)),this.uiDialog.is(\":data(ui-resizable)\")&&this.uiDialog.resizable(\"option\",\"minHeight\",this._minHeight())},_blockFrames:function(){this.iframeBlocks=this.document.find(\"iframe\").map(function(){var t=e(this);return e(\"<div>\").css({position:\"absolute\",width:t.outerWidth(),height:t.outerHeight()}).appendTo(t.parent()).offset(t.offset())[0]})},_unblockFrames:function(){this.iframeBlocks&&(this.iframeBlocks.remove(),delete this.iframeBlocks)},_allowInteraction:function(t){return e(t.target).closest(\".ui-dialog\").length?!0:!!e(t.target).closest(\".ui-datepicker\").length},_createOverlay:function(){if(this.options.modal){var t=this,a=this.widgetFullName;e.ui.dialog.overlayInstances||this._delay(function(){e.ui.dialog.overlayInstances&&this.document.bind(\"focusin.dialog\",function(i){t._allowInteraction(i)||(i.preventDefault(),e(\".ui-dialog:visible:last .ui-dialog-content\").data(a)._focusTabbable())})}),this.overlay=e(\"<div>\").addClass(\"ui-widget-overlay ui-front\").appendTo(this._appendTo()),this._on(this.overlay,{mousedown:\"_keepFocus\"}),e.ui.dialog.overlayInstances++}},_destroyOverlay:function(){this.options.modal&&this.overlay&&(e.ui.dialog.overlayInstances--,e.ui.dialog.overlayInstances||this.document.unbind(\"focusin.dialog\"),this.overlay.remove(),this.overlay=null)}}),e.ui.dialog.overlayInstances=0,e.uiBackCompat!==!1&&e.widget(\"ui.dialog\",e.ui.dialog,{_position:function(){var t,a=this.options.position,i=[],r=[0,0];a?((\"string\"==typeof a||\"object\"==typeof a&&\"0\"in a)&&(i=a.split?a.split(\" \"):[a[0],a[1]],1===i.length&&(i[1]=i[0]),e.each([\"left\",\"top\"],function(e,t){+i[e]===i[e]&&(r[e]=i[e],i[e]=t)}),a={my:i[0]+(0>r[0]?r[0]:\"+\"+r[0])+\" \"+i[1]+(0>r[1]?r[1]:\"+\"+r[1]),at:i.join(\" \")}),a=e.extend({},e.ui.dialog.prototype.options.position,a)):a=e.ui.dialog.prototype.options.position,t=this.uiDialog.is(\":visible\"),t||this.uiDialog.show(),this.uiDialog.position(a),t||this.uiDialog.hide()}})})(jQuery);(function(e){e.widget(\"ui.menu\",{version:\"1.10.3\",defaultElement:\"<ul>\",delay:300,options:{icons:{submenu:\"ui-icon-carat-1-e\"},menus:\"ul\",position:{my:\"left top\",at:\"right top\"},role:\"menu\",blur:null,focus:null,select:null},_create:function(){this.activeMenu=this.element,this.mouseHandled=!1,this.element.uniqueId().addClass(\"ui-menu ui-widget ui-widget-content ui-corner-all\").toggleClass(\"ui-menu-icons\",!!this.element.find(\".ui-icon\").length).attr({role:this.options.role,tabIndex:0}).bind(\"click\"+this.eventNamespace,e.proxy(function(e){this.options.disabled&&e.preventDefault()},this)),this.options.disabled&&this.element.addClass(\"ui-state-disabled\").attr(\"aria-disabled\",\"true\"),this._on({\"mousedown .ui-menu-item > a\":function(e){e.preventDefault()},\"click .ui-state-disabled > a\":function(e){e.preventDefault()},\"click .ui-menu-item:has(a)\":function(t){var i=e(t.target).closest(\".ui-menu-item\");!this.mouseHandled&&i.not(\".ui-state-disabled\").length&&(this.mouseHandled=!0,this.select(t),i.has(\".ui-menu\").length?this.expand(t):this.element.is(\":focus\")||(this.element.trigger(\"focus\",[!0]),this.active&&1===this.active.parents(\".ui-menu\").length&&clearTimeout(this.timer)))},\"mouseenter .ui-menu-item\":function(t){var i=e(t.currentTarget);i.siblings().children(\".ui-state-active\").removeClass(\"ui-state-active\"),this.focus(t,i)},mouseleave:\"collapseAll\",\"mouseleave .ui-menu\":\"collapseAll\",focus:function(e,t){var i=this.active||this.element.children(\".ui-menu-item\").eq(0);t||this.focus(e,i)},blur:function(t){this._delay(function(){e.contains(this.element[0],this.document[0].activeElement)||this.collapseAll(t)})},keydown:\"_keydown\"}),this.refresh(),this._on(this.document,{click:function(t){e(t.target).closest(\".ui-menu\").length||this.collapseAll(t),this.mouseHandled=!1}})},_destroy:function(){this.element.removeAttr(\"aria-activedescendant\").find(\".ui-menu\").addBack().removeClass(\"ui-menu ui-widget ui-widget-content ui-corner-all ui-menu-icons\").removeAttr(\"role\").removeAttr(\"tabIndex\").removeAttr(\"aria-labelledby\").removeAttr(\"aria-expanded\").removeAttr(\"aria-hidden\").removeAttr(\"aria-disabled\").removeUniqueId().show(),this.element.find(\".ui-menu-item\").removeClass(\"ui-menu-item\").removeAttr(\"role\").removeAttr(\"aria-disabled\").children(\"a\").removeUniqueId().removeClass(\"ui-corner-all ui-state-hover\").removeAttr(\"tabIndex\").removeAttr(\"role\").removeAttr(\"aria-haspopup\").children().each(function(){var t=e(this);t.data(\"ui-menu-submenu-carat\")&&t.remove()}),this.element.find(\".ui-menu-divider\").removeClass(\"ui-menu-divider ui-widget-content\")},_keydown:function(t){function i(e){return e.replace(/[\\-\\[\\]{}()*+?.,\\\\\\^$|#\\s]/g,\"\\\\$&\")}var n,s,u,a,o,r=!0;switch(t.keyCode){case e.ui.keyCode.PAGE_UP:this.previousPage(t);break;case e.ui.keyCode.PAGE_DOWN:this.nextPage(t);break;case e.ui.keyCode.HOME:this._move(\"first\",\"first\",t);break;case e.ui.keyCode.END:this._move(\"last\",\"last\",t);break;case e.ui.keyCode.UP:this.previous(t);break;case e.ui.keyCode.DOWN:this.next(t);break;case e.ui.keyCode.LEFT:this.collapse(t);break;case e.ui.keyCode.RIGHT:this.active&&!this.active.is(\".ui-state-disabled\")&&this.expand(t);break;case e.ui.keyCode.ENTER:case e.ui.keyCode.SPACE:this._activate(t);break;case e.ui.keyCode.ESCAPE:this.collapse(t);break;default:r=!1,s=this.previousFilter||\"\",u=String.fromCharCode(t.keyCode),a=!1,clearTimeout(this.filterTimer),u===s?a=!0:u=s+u,o=RegExp(\"^\"+i(u),\"i\"),n=this.activeMenu.children(\".ui-menu-item\").filter(function(){return o.test(e(this).children(\"a\").text())}),n=a&&-1!==n.index(this.active.next())?this.active.nextAll(\".ui-menu-item\"):n,n.length||(u=String.fromCharCode(t.keyCode),o=RegExp(\"^\"+i(u),\"i\"),n=this.activeMenu.children(\".ui-menu-item\").filter(function(){return o.test(e(this).children(\"a\").text())})),n.length?(this.focus(t,n),n.length>1?(this.previousFilter=u,this.filterTimer=this._delay(function(){delete this.previousFilter},1e3)):delete this.previousFilter):delete this.previousFilter}r&&t.preventDefault()},_activate:function(e){this.active.is(\".ui-state-disabled\")||(this.active.children(\"a[aria-haspopup='true']\").length?this.expand(e):this.select(e))},refresh:function(){var t,i=this.options.icons.submenu,n=this.element.find(this.options.menus);n.filter(\":not(.ui-menu)\").addClass(\"ui-menu ui-widget ui-widget-content ui-corner-all\").hide().attr({role:this.options.role,\"aria-hidden\":\"true\",\"aria-expanded\":\"false\"}).each(function(){var t=e(this),n=t.prev(\"a\"),s=e(\"<span>\").addClass(\"ui-menu-icon ui-icon \"+i).data(\"ui-menu-submenu-carat\",!0);n.attr(\"aria-haspopup\",\"true\").prepend(s),t.attr(\"aria-labelledby\",n.attr(\"id\"))}),t=n.add(this.element),t.children(\":not(.ui-menu-item):has(a)\").addClass(\"ui-menu-item\").attr(\"role\",\"presentation\").children(\"a\").uniqueId().addClass(\"ui-corner-all\").attr({tabIndex:-1,role:this._itemRole()}),t.children(\":not(.ui-menu-item)\").each(function(){var t=e(this);/[^\\-\\u2014\\u2013\\s]/.test(t.text())||t.addClass(\"ui-widget-content ui-menu-divider\")}),t.children(\".ui-state-disabled\").attr(\"aria-disabled\",\"true\"),this.active&&!e.contains(this.element[0],this.active[0])&&this.blur()},_itemRole:function(){return{menu:\"menuitem\",listbox:\"option\"}[this.options.role]},_setOption:function(e,t){\"icons\"===e&&this.element.find(\".ui-menu-icon\").removeClass(this.options.icons.submenu).addClass(t.submenu),this._super(e,t)},focus:function(e,t){var i,n;this.blur(e,e&&\"focus\"===e.type),this._scrollIntoView(t),this.active=t.first(),n=this.active.children(\"a\").addClass(\"ui-state-focus\"),this.options.role&&this.element.attr(\"aria-activedescendant\",n.attr(\"id\")),this.active.parent().closest(\".ui-menu-item\").children(\"a:first\").addClass(\"ui-state-active\"),e&&\"keydown\"===e.type?this._close():this.timer=this._delay(function(){this._close()},this.delay),i=t.children(\".ui-menu\"),i.length&&/^mouse/.test(e.type)&&this._startOpening(i),this.activeMenu=t.parent(),this._trigger(\"focus\",e,{item:t})},_scrollIntoView:function(t){var i,n,s,u,a,o;this._hasScroll()&&(i=parseFloat(e.css(this.activeMenu[0],\"borderTopWidth\"))||0,n=parseFloat(e.css(this.activeMenu[0],\"paddingTop\"))||0,s=t.offset().top-this.activeMenu.offset().top-i-n,u=this.activeMenu.scrollTop(),a=this.activeMenu.height(),o=t.height(),0>s?this.activeMenu.scrollTop(u+s):s+o>a&&this.activeMenu.scrollTop(u+s-a+o))},blur:function(e,t){t||clearTimeout(this.timer),this.active&&(this.active.children(\"a\").removeClass(\"ui-state-focus\"),this.active=null,this._trigger(\"blur\",e,{item:this.active}))},_startOpening:function(e){clearTimeout(this.timer),\"true\"===e.attr(\"aria-hidden\")&&(this.timer=this._delay(function(){this._close(),this._open(e)},this.delay))},_open:function(t){var i=e.extend({of:this.active},this.options.position);clearTimeout(this.timer),this.element.find(\".ui-menu\").not(t.parents(\".ui-menu\")).hide().attr(\"aria-hidden\",\"true\"),t.show().removeAttr(\"aria-hidden\").attr(\"aria-expanded\",\"true\").position(i)},collapseAll:function(t,i){clearTimeout(this.timer),this.timer=this._delay(function(){var n=i?this.element:e(t&&t.target).closest(this.element.find(\".ui-menu\"));n.length||(n=this.element),this._close(n),this.blur(t),this.activeMenu=n},this.delay)},_close:function(e){e||(e=this.active?this.active.parent():this.element),e.find(\".ui-menu\").hide().attr(\"aria-hidden\",\"true\").attr(\"aria-expanded\",\"false\").end().find(\"a.ui-state-active\").removeClass(\"ui-state-active\")},collapse:function(e){var t=this.active&&this.active.parent().closest(\".ui-menu-item\",this.element);t&&t.length&&(this._close(),this.focus(e,t))},expand:function(e){var t=this.active&&this.active.children(\".ui-menu \").children(\".ui-menu-item\").first();t&&t.length&&(this._open(t.parent()),this._delay(function(){this.focus(e,t)}))},next:function(e){this._move(\"next\",\"first\",e)},previous:function(e){this._move(\"prev\",\"last\",e)},isFirstItem:function(){return this.active&&!this.active.prevAll(\".ui-menu-item\").length},isLastItem:function(){return this.active&&!this.active.nextAll(\".ui-menu-item\").length},_move:function(e,t,i){var n;this.active&&(n=\"first\"===e||\"last\"===e?this.active[\"first\"===e?\"prevAll\":\"nextAll\"](\".ui-menu-item\").eq(-1):this.active[e+\"All\"](\".ui-menu-item\").eq(0)),n&&n.length&&this.active||(n=this.activeMenu.children(\".ui-menu-item\")[t]()),this.focus(i,n)},nextPage:function(t){var i,n,s;return this.active?(this.isLastItem()||(this._hasScroll()?(n=this.active.offset().top,s=this.element.height(),this.active.nextAll(\".ui-menu-item\").each(function(){return i=e(this),0>i.offset().top-n-s}),this.focus(t,i)):this.focus(t,this.activeMenu.children(\".ui-menu-item\")[this.active?\"last\":\"first\"]())),undefined):(this.next(t),undefined)},previousPage:function(t){var i,n,s;return this.active?(this.isFirstItem()||(this._hasScroll()?(n=this.active.offset().top,s=this.element.height(),this.active.prevAll(\".ui-menu-item\").each(function(){return i=e(this),i.offset().top-n+s>0}),this.focus(t,i)):this.focus(t,this.activeMenu.children(\".ui-menu-item\").first())),undefined):(this.next(t),undefined)},_hasScroll:function(){return this.element.outerHeight()<this.element.prop(\"scrollHeight\")},select:function(t){this.active=this.active||e(t.target).closest(\".ui-menu-item\");var i={item:this.active};this.active.has(\".ui-menu\").length||this.collapseAll(t,!0),this._trigger(\"select\",t,i)}})})(jQuery);(function(e,t){e.widget(\"ui.progressbar\",{version:\"1.10.3\",options:{max:100,value:0,change:null,complete:null},min:0,_create:function(){this.oldValue=this.options.value=this._constrainedValue(),this.element.addClass(\"ui-progressbar ui-widget ui-widget-content ui-corner-all\").attr({role:\"progressbar\",\"aria-valuemin\":this.min}),this.valueDiv=e(\"<div class='ui-progressbar-value ui-widget-header ui-corner-left'></div>\").appendTo(this.element),this._refreshValue()},_destroy:function(){this.element.removeClass(\"ui-progressbar ui-widget ui-widget-content ui-corner-all\").removeAttr(\"role\").removeAttr(\"aria-valuemin\").removeAttr(\"aria-valuemax\").removeAttr(\"aria-valuenow\"),this.valueDiv.remove()},value:function(e){return e===t?this.options.value:(this.options.value=this._constrainedValue(e),this._refreshValue(),t)},_constrainedValue:function(e){return e===t&&(e=this.options.value),this.indeterminate=e===!1,\"number\"!=typeof e&&(e=0),this.indeterminate?!1:Math.min(this.options.max,Math.max(this.min,e))},_setOptions:function(e){var t=e.value;delete e.value,this._super(e),this.options.value=this._constrainedValue(t),this._refreshValue()},_setOption:function(e,t){\"max\"===e&&(t=Math.max(this.min,t)),this._super(e,t)},_percentage:function(){return this.indeterminate?100:100*(this.options.value-this.min)/(this.options.max-this.min)},_refreshValue:function(){var t=this.options.value,a=this._percentage();this.valueDiv.toggle(this.indeterminate||t>this.min).toggleClass(\"ui-corner-right\",t===this.options.max).width(a.toFixed(0)+\"%\"),this.element.toggleClass(\"ui-progressbar-indeterminate\",this.indeterminate),this.indeterminate?(this.element.removeAttr(\"aria-valuenow\"),this.overlayDiv||(this.overlayDiv=e(\"<div class='ui-progressbar-overlay'></div>\").appendTo(this.valueDiv))):(this.element.attr({\"aria-valuemax\":this.options.max,\"aria-valuenow\":t}),this.overlayDiv&&(this.overlayDiv.remove(),this.overlayDiv=null)),this.oldValue!==t&&(this.oldValue=t,this._trigger(\"change\")),t===this.options.max&&this._trigger(\"complete\")}})})(jQuery);(function(e){var t=5;e.widget(\"ui.slider\",e.ui.mouse,{version:\"1.10.3\",widgetEventPrefix:\"slide\",options:{animate:!1,distance:0,max:100,min:0,orientation:\"horizontal\",range:!1,step:1,value:0,values:null,change:null,slide:null,start:null,stop:null},_create:function(){this._keySliding=!1,this._mouseSliding=!1,this._animateOff=!0,this._handleIndex=null,this._detectOrientation(),this._mouseInit(),this.element.addClass(\"ui-slider ui-slider-\"+this.orientation+\" ui-widget\"+\" ui-widget-content\"+\" ui-corner-all\"),this._refresh(),this._setOption(\"disabled\",this.options.disabled),this._animateOff=!1},_refresh:function(){this._createRange(),this._createHandles(),this._setupEvents(),this._refreshValue()},_createHandles:function(){var t,i,a=this.options,s=this.element.find(\".ui-slider-handle\").addClass(\"ui-state-default ui-corner-all\"),r=\"<a class='ui-slider-handle ui-state-default ui-corner-all' href='#'></a>\",n=[];for(i=a.values&&a.values.length||1,s.length>i&&(s.slice(i).remove(),s=s.slice(0,i)),t=s.length;i>t;t++)n.push(r);this.handles=s.add(e(n.join(\"\")).appendTo(this.element)),this.handle=this.handles.eq(0),this.handles.each(function(t){e(this).data(\"ui-slider-handle-index\",t)})},_createRange:function(){var t=this.options,i=\"\";t.range?(t.range===!0&&(t.values?t.values.length&&2!==t.values.length?t.values=[t.values[0],t.values[0]]:e.isArray(t.values)&&(t.values=t.values.slice(0)):t.values=[this._valueMin(),this._valueMin()]),this.range&&this.range.length?this.range.removeClass(\"ui-slider-range-min ui-slider-range-max\").css({left:\"\",bottom:\"\"}):(this.range=e(\"<div></div>\").appendTo(this.element),i=\"ui-slider-range ui-widget-header ui-corner-all\"),this.range.addClass(i+(\"min\"===t.range||\"max\"===t.range?\" ui-slider-range-\"+t.range:\"\"))):this.range=e([])},_setupEvents:function(){var e=this.handles.add(this.range).filter(\"a\");this._off(e),this._on(e,this._handleEvents),this._hoverable(e),this._focusable(e)},_destroy:function(){this.handles.remove(),this.range.remove(),this.element.removeClass(\"ui-slider ui-slider-horizontal ui-slider-vertical ui-widget ui-widget-content ui-corner-all\"),this._mouseDestroy()},_mouseCapture:function(t){var i,a,s,r,n,o,l,h,u=this,d=this.options;return d.disabled?!1:(this.elementSize={width:this.element.outerWidth(),height:this.element.outerHeight()},this.elementOffset=this.element.offset(),i={x:t.pageX,y:t.pageY},a=this._normValueFromMouse(i),s=this._valueMax()-this._valueMin()+1,this.handles.each(function(t){var i=Math.abs(a-u.values(t));(s>i||s===i&&(t===u._lastChangedValue||u.values(t)===d.min))&&(s=i,r=e(this),n=t)}),o=this._start(t,n),o===!1?!1:(this._mouseSliding=!0,this._handleIndex=n,r.addClass(\"ui-state-active\").focus(),l=r.offset(),h=!e(t.target).parents().addBack().is(\".ui-slider-handle\"),this._clickOffset=h?{left:0,top:0}:{left:t.pageX-l.left-r.width()/2,top:t.pageY-l.top-r.height()/2-(parseInt(r.css(\"borderTopWidth\"),10)||0)-(parseInt(r.css(\"borderBottomWidth\"),10)||0)+(parseInt(r.css(\"marginTop\"),10)||0)},this.handles.hasClass(\"ui-state-hover\")||this._slide(t,n,a),this._animateOff=!0,!0))},_mouseStart:function(){return!0},_mouseDrag:function(e){var t={x:e.pageX,y:e.pageY},i=this._normValueFromMouse(t);return this._slide(e,this._handleIndex,i),!1},_mouseStop:function(e){return this.handles.removeClass(\"ui-state-active\"),this._mouseSliding=!1,this._stop(e,this._handleIndex),this._change(e,this._handleIndex),this._handleIndex=null,this._clickOffset=null,this._animateOff=!1,!1},_detectOrientation:function(){this.orientation=\"vertical\"===this.options.orientation?\"vertical\":\"horizontal\"},_normValueFromMouse:function(e){var t,i,a,s,r;return\"horizontal\"===this.orientation?(t=this.elementSize.width,i=e.x-this.elementOffset.left-(this._clickOffset?this._clickOffset.left:0)):(t=this.elementSize.height,i=e.y-this.elementOffset.top-(this._clickOffset?this._clickOffset.top:0)),a=i/t,a>1&&(a=1),0>a&&(a=0),\"vertical\"===this.orientation&&(a=1-a),s=this._valueMax()-this._valueMin(),r=this._valueMin()+a*s,this._trimAlignValue(r)},_start:function(e,t){var i={handle:this.handles[t],value:this.value()};return this.options.values&&this.options.values.length&&(i.value=this.values(t),i.values=this.values()),this._trigger(\"start\",e,i)},_slide:function(e,t,i){var a,s,r;this.options.values&&this.options.values.length?(a=this.values(t?0:1),2===this.options.values.length&&this.options.range===!0&&(0===t&&i>a||1===t&&a>i)&&(i=a),i!==this.values(t)&&(s=this.values(),s[t]=i,r=this._trigger(\"slide\",e,{handle:this.handles[t],value:i,values:s}),a=this.values(t?0:1),r!==!1&&this.values(t,i,!0))):i!==this.value()&&(r=this._trigger(\"slide\",e,{handle:this.handles[t],value:i}),r!==!1&&this.value(i))},_stop:function(e,t){var i={handle:this.handles[t],value:this.value()};this.options.values&&this.options.values.length&&(i.value=this.values(t),i.values=this.values()),this._trigger(\"stop\",e,i)},_change:function(e,t){if(!this._keySliding&&!this._mouseSliding){var i={handle:this.handles[t],value:this.value()};this.options.values&&this.options.values.length&&(i.value=this.values(t),i.values=this.values()),this._lastChangedValue=t,this._trigger(\"change\",e,i)}},value:function(e){return arguments.length?(this.options.value=this._trimAlignValue(e),this._refreshValue(),this._change(null,0),undefined):this._value()},values:function(t,i){var a,s,r;if(arguments.length>1)return this.options.values[t]=this._trimAlignValue(i),this._refreshValue(),this._change(null,t),undefined;if(!arguments.length)return this._values();if(!e.isArray(arguments[0]))return this.options.values&&this.options.values.length?this._values(t):this.value();for(a=this.options.values,s=arguments[0],r=0;a.length>r;r+=1)a[r]=this._trimAlignValue(s[r]),this._change(null,r);this._refreshValue()},_setOption:function(t,i){var a,s=0;switch(\"range\"===t&&this.options.range===!0&&(\"min\"===i?(this.options.value=this._values(0),this.options.values=null):\"max\"===i&&(this.options.value=this._values(this.options.values.length-1),this.options.values=null)),e.isArray(this.options.values)&&(s=this.options.values.length),e.Widget.prototype._setOption.apply(this,arguments),t){case\"orientation\":this._detectOrientation(),this.element.removeClass(\"ui-slider-horizontal ui-slider-vertical\").addClass(\"ui-slider-\"+this.orientation),this._refreshValue();break;case\"value\":this._animateOff=!0,this._refreshValue(),this._change(null,0),this._animateOff=!1;break;case\"values\":for(this._animateOff=!0,this._refreshValue(),a=0;s>a;a+=1)this._change(null,a);this._animateOff=!1;break;case\"min\":case\"max\":this._animateOff=!0,this._refreshValue(),this._animateOff=!1;break;case\"range\":this._animateOff=!0,this._refresh(),this._animateOff=!1}},_value:function(){var e=this.options.value;return e=this._trimAlignValue(e)},_values:function(e){var t,i,a;if(arguments.length)return t=this.options.values[e],t=this._trimAlignValue(t);if(this.options.values&&this.options.values.length){for(i=this.options.values.slice(),a=0;i.length>a;a+=1)i[a]=this._trimAlignValue(i[a]);return i}return[]},_trimAlignValue:function(e){if(this._valueMin()>=e)return this._valueMin();if(e>=this._valueMax())return this._valueMax();var t=this.options.step>0?this.options.step:1,i=(e-this._valueMin())%t,a=e-i;return 2*Math.abs(i)>=t&&(a+=i>0?t:-t),parseFloat(a.toFixed(5))},_valueMin:function(){return this.options.min},_valueMax:function(){return this.options.max},_refreshValue:function(){var t,i,a,s,r,n=this.options.range,o=this.options,l=this,h=this._animateOff?!1:o.animate,u={};this.options.values&&this.options.values.length?this.handles.each(function(a){i=100*((l.values(a)-l._valueMin())/(l._valueMax()-l._valueMin())),u[\"horizontal\"===l.orientation?\"left\":\"bottom\"]=i+\"%\",e(this).stop(1,1)[h?\"animate\":\"css\"](u,o.animate),l.options.range===!0&&(\"horizontal\"===l.orientation?(0===a&&l.range.stop(1,1)[h?\"animate\":\"css\"]({left:i+\"%\"},o.animate),1===a&&l.range[h?\"animate\":\"css\"]({width:i-t+\"%\"},{queue:!1,duration:o.animate})):(0===a&&l.range.stop(1,1)[h?\"animate\":\"css\"]({bottom:i+\"%\"},o.animate),1===a&&l.range[h?\"animate\":\"css\"]({height:i-t+\"%\"},{queue:!1,duration:o.animate}))),t=i}):(a=this.value(),s=this._valueMin(),r=this._valueMax(),i=r!==s?100*((a-s)/(r-s)):0,u[\"horizontal\"===this.orientation?\"left\":\"bottom\"]=i+\"%\",this.handle.stop(1,1)[h?\"animate\":\"css\"](u,o.animate),\"min\"===n&&\"horizontal\"===this.orientation&&this.range.stop(1,1)[h?\"animate\":\"css\"]({width:i+\"%\"},o.animate),\"max\"===n&&\"horizontal\"===this.orientation&&this.range[h?\"animate\":\"css\"]({width:100-i+\"%\"},{queue:!1,duration:o.animate}),\"min\"===n&&\"vertical\"===this.orientation&&this.range.stop(1,1)[h?\"animate\":\"css\"]({height:i+\"%\"},o.animate),\"max\"===n&&\"vertical\"===this.orientation&&this.range[h?\"animate\":\"css\"]({height:100-i+\"%\"},{queue:!1,duration:o.animate}))},_handleEvents:{keydown:function(i){var a,s,r,n,o=e(i.target).data(\"ui-slider-handle-index\");switch(i.keyCode){case e.ui.keyCode.HOME:case e.ui.keyCode.END:case e.ui.keyCode.PAGE_UP:case e.ui.keyCode.PAGE_DOWN:case e.ui.keyCode.UP:case e.ui.keyCode.RIGHT:case e.ui.keyCode.DOWN:case e.ui.keyCode.LEFT:if(i.preventDefault(),!this._keySliding&&(this._keySliding=!0,e(i.target).addClass(\"ui-state-active\"),a=this._start(i,o),a===!1))return}switch(n=this.options.step,s=r=this.options.values&&this.options.values.length?this.values(o):this.value(),i.keyCode){case e.ui.keyCode.HOME:r=this._valueMin();break;case e.ui.keyCode.END:r=this._valueMax();break;case e.ui.keyCode.PAGE_UP:r=this._trimAlignValue(s+(this._valueMax()-this._valueMin())/t);break;case e.ui.keyCode.PAGE_DOWN:r=this._trimAlignValue(s-(this._valueMax()-this._valueMin())/t);break;case e.ui.keyCode.UP:case e.ui.keyCode.RIGHT:if(s===this._valueMax())return;r=this._trimAlignValue(s+n);break;case e.ui.keyCode.DOWN:case e.ui.keyCode.LEFT:if(s===this._valueMin())return;r=this._trimAlignValue(s-n)}this._slide(i,o,r)},click:function(e){e.preventDefault()},keyup:function(t){var i=e(t.target).data(\"ui-slider-handle-index\");this._keySliding&&(this._keySliding=!1,this._stop(t,i),this._change(t,i),e(t.target).removeClass(\"ui-state-active\"))}}})})(jQuery);(function(e){function t(e){return function(){var t=this.element.val();e.apply(this,arguments),this._refresh(),t!==this.element.val()&&this._trigger(\"change\")}}e.widget(\"ui.spinner\",{version:\"1.10.3\",defaultElement:\"<input>\",widgetEventPrefix:\"spin\",options:{culture:null,icons:{down:\"ui-icon-triangle-1-s\",up:\"ui-icon-triangle-1-n\"},incremental:!0,max:null,min:null,numberFormat:null,page:10,step:1,change:null,spin:null,start:null,stop:null},_create:function(){this._setOption(\"max\",this.options.max),this._setOption(\"min\",this.options.min),this._setOption(\"step\",this.options.step),this._value(this.element.val(),!0),this._draw(),this._on(this._events),this._refresh(),this._on(this.window,{beforeunload:function(){this.element.removeAttr(\"autocomplete\")}})},_getCreateOptions:function(){var t={},i=this.element;return e.each([\"min\",\"max\",\"step\"],function(e,a){var s=i.attr(a);void 0!==s&&s.length&&(t[a]=s)}),t},_events:{keydown:function(e){this._start(e)&&this._keydown(e)&&e.preventDefault()},keyup:\"_stop\",focus:function(){this.previous=this.element.val()},blur:function(e){return this.cancelBlur?(delete this.cancelBlur,void 0):(this._stop(),this._refresh(),this.previous!==this.element.val()&&this._trigger(\"change\",e),void 0)},mousewheel:function(e,t){if(t){if(!this.spinning&&!this._start(e))return!1;this._spin((t>0?1:-1)*this.options.step,e),clearTimeout(this.mousewheelTimer),this.mousewheelTimer=this._delay(function(){this.spinning&&this._stop(e)},100),e.preventDefault()}},\"mousedown .ui-spinner-button\":function(t){function i(){var e=this.element[0]===this.document[0].activeElement;e||(this.element.focus(),this.previous=a,this._delay(function(){this.previous=a}))}var a;a=this.element[0]===this.document[0].activeElement?this.previous:this.element.val(),t.preventDefault(),i.call(this),this.cancelBlur=!0,this._delay(function(){delete this.cancelBlur,i.call(this)}),this._start(t)!==!1&&this._repeat(null,e(t.currentTarget).hasClass(\"ui-spinner-up\")?1:-1,t)},\"mouseup .ui-spinner-button\":\"_stop\",\"mouseenter .ui-spinner-button\":function(t){return e(t.currentTarget).hasClass(\"ui-state-active\")?this._start(t)===!1?!1:(this._repeat(null,e(t.currentTarget).hasClass(\"ui-spinner-up\")?1:-1,t),void 0):void 0},\"mouseleave .ui-spinner-button\":\"_stop\"},_draw:function(){var e=this.uiSpinner=this.element.addClass(\"ui-spinner-input\").attr(\"autocomplete\",\"off\").wrap(this._uiSpinnerHtml()).parent().append(this._buttonHtml());this.element.attr(\"role\",\"spinbutton\"),this.buttons=e.find(\".ui-spinner-button\").attr(\"tabIndex\",-1).button().removeClass(\"ui-corner-all\"),this.buttons.height()>Math.ceil(.5*e.height())&&e.height()>0&&e.height(e.height()),this.options.disabled&&this.disable()},_keydown:function(t){var i=this.options,a=e.ui.keyCode;switch(t.keyCode){case a.UP:return this._repeat(null,1,t),!0;case a.DOWN:return this._repeat(null,-1,t),!0;case a.PAGE_UP:return this._repeat(null,i.page,t),!0;case a.PAGE_DOWN:return this._repeat(null,-i.page,t),!0}return!1},_uiSpinnerHtml:function(){return\"<span class='ui-spinner ui-widget ui-widget-content ui-corner-all'></span>\"},_buttonHtml:function(){return\"<a class='ui-spinner-button ui-spinner-up ui-corner-tr'><span class='ui-icon \"+this.options.icons.up+\"'>&#9650;</span>\"+\"</a>\"+\"<a class='ui-spinner-button ui-spinner-down ui-corner-br'>\"+\"<span class='ui-icon \"+this.options.icons.down+\"'>&#9660;</span>\"+\"</a>\"},_start:function(e){return this.spinning||this._trigger(\"start\",e)!==!1?(this.counter||(this.counter=1),this.spinning=!0,!0):!1},_repeat:function(e,t,i){e=e||500,clearTimeout(this.timer),this.timer=this._delay(function(){this._repeat(40,t,i)},e),this._spin(t*this.options.step,i)},_spin:function(e,t){var i=this.value()||0;this.counter||(this.counter=1),i=this._adjustValue(i+e*this._increment(this.counter)),this.spinning&&this._trigger(\"spin\",t,{value:i})===!1||(this._value(i),this.counter++)},_increment:function(t){var i=this.options.incremental;return i?e.isFunction(i)?i(t):Math.floor(t*t*t/5e4-t*t/500+17*t/200+1):1},_precision:function(){var e=this._precisionOf(this.options.step);return null!==this.options.min&&(e=Math.max(e,this._precisionOf(this.options.min))),e},_precisionOf:function(e){var t=\"\"+e,i=t.indexOf(\".\");return-1===i?0:t.length-i-1},_adjustValue:function(e){var t,i,a=this.options;return t=null!==a.min?a.min:0,i=e-t,i=Math.round(i/a.step)*a.step,e=t+i,e=parseFloat(e.toFixed(this._precision())),null!==a.max&&e>a.max?a.max:null!==a.min&&a.min>e?a.min:e},_stop:function(e){this.spinning&&(clearTimeout(this.timer),clearTimeout(this.mousewheelTimer),this.counter=0,this.spinning=!1,this._trigger(\"stop\",e))},_setOption:function(e,t){if(\"culture\"===e||\"numberFormat\"===e){var i=this._parse(this.element.val());return this.options[e]=t,this.element.val(this._format(i)),void 0}(\"max\"===e||\"min\"===e||\"step\"===e)&&\"string\"==typeof t&&(t=this._parse(t)),\"icons\"===e&&(this.buttons.first().find(\".ui-icon\").removeClass(this.options.icons.up).addClass(t.up),this.buttons.last().find(\".ui-icon\").removeClass(this.options.icons.down).addClass(t.down)),this._super(e,t),\"disabled\"===e&&(t?(this.element.prop(\"disabled\",!0),this.buttons.button(\"disable\")):(this.element.prop(\"disabled\",!1),this.buttons.button(\"enable\")))},_setOptions:t(function(e){this._super(e),this._value(this.element.val())}),_parse:function(e){return\"string\"==typeof e&&\"\"!==e&&(e=window.Globalize&&this.options.numberFormat?Globalize.parseFloat(e,10,this.options.culture):+e),\"\"===e||isNaN(e)?null:e},_format:function(e){return\"\"===e?\"\":window.Globalize&&this.options.numberFormat?Globalize.format(e,this.options.numberFormat,this.options.culture):e},_refresh:function(){this.element.attr({\"aria-valuemin\":this.options.min,\"aria-valuemax\":this.options.max,\"aria-valuenow\":this._parse(this.element.val())})},_value:function(e,t){var i;\"\"!==e&&(i=this._parse(e),null!==i&&(t||(i=this._adjustValue(i)),e=this._format(i))),this.element.val(e),this._refresh()},_destroy:function(){this.element.removeClass(\"ui-spinner-input\").prop(\"disabled\",!1).removeAttr(\"autocomplete\").removeAttr(\"role\").removeAttr(\"aria-valuemin\").removeAttr(\"aria-valuemax\").removeAttr(\"aria-valuenow\"),this.uiSpinner.replaceWith(this.element)},stepUp:t(function(e){this._stepUp(e)}),_stepUp:function(e){this._start()&&(this._spin((e||1)*this.options.step),this._stop())},stepDown:t(function(e){this._stepDown(e)}),_stepDown:function(e){this._start()&&(this._spin((e||1)*-this.options.step),this._stop())},pageUp:t(function(e){this._stepUp((e||1)*this.options.page)}),pageDown:t(function(e){this._stepDown((e||1)*this.options.page)}),value:function(e){return arguments.length?(t(this._value).call(this,e),void 0):this._parse(this.element.val())},widget:function(){return this.uiSpinner}})})(jQuery);(function(e,t){function i(){return++s}function a(e){return e.hash.length>1&&decodeURIComponent(e.href.replace(r,\"\"))===decodeURIComponent(location.href.replace(r,\"\"))}var s=0,r=/#.*$/;e.widget(\"ui.tabs\",{version:\"1.10.3\",delay:300,options:{active:null,collapsible:!1,event:\"click\",heightStyle:\"content\",hide:null,show:null,activate:null,beforeActivate:null,beforeLoad:null,load:null},_create:function(){var t=this,i=this.options;this.running=!1,this.element.addClass(\"ui-tabs ui-widget ui-widget-content ui-corner-all\").toggleClass(\"ui-tabs-collapsible\",i.collapsible).delegate(\".ui-tabs-nav > li\",\"mousedown\"+this.eventNamespace,function(t){e(this).is(\".ui-state-disabled\")&&t.preventDefault()}).delegate(\".ui-tabs-anchor\",\"focus\"+this.eventNamespace,function(){e(this).closest(\"li\").is(\".ui-state-disabled\")&&this.blur()}),this._processTabs(),i.active=this._initialActive(),e.isArray(i.disabled)&&(i.disabled=e.unique(i.disabled.concat(e.map(this.tabs.filter(\".ui-state-disabled\"),function(e){return t.tabs.index(e)}))).sort()),this.active=this.options.active!==!1&&this.anchors.length?this._findActive(i.active):e(),this._refresh(),this.active.length&&this.load(i.active)},_initialActive:function(){var i=this.options.active,a=this.options.collapsible,s=location.hash.substring(1);return null===i&&(s&&this.tabs.each(function(a,r){return e(r).attr(\"aria-controls\")===s?(i=a,!1):t}),null===i&&(i=this.tabs.index(this.tabs.filter(\".ui-tabs-active\"))),(null===i||-1===i)&&(i=this.tabs.length?0:!1)),i!==!1&&(i=this.tabs.index(this.tabs.eq(i)),-1===i&&(i=a?!1:0)),!a&&i===!1&&this.anchors.length&&(i=0),i},_getCreateEventData:function(){return{tab:this.active,panel:this.active.length?this._getPanelForTab(this.active):e()}},_tabKeydown:function(i){var a=e(this.document[0].activeElement).closest(\"li\"),s=this.tabs.index(a),r=!0;if(!this._handlePageNav(i)){switch(i.keyCode){case e.ui.keyCode.RIGHT:case e.ui.keyCode.DOWN:s++;break;case e.ui.keyCode.UP:case e.ui.keyCode.LEFT:r=!1,s--;break;case e.ui.keyCode.END:s=this.anchors.length-1;break;case e.ui.keyCode.HOME:s=0;break;case e.ui.keyCode.SPACE:return i.preventDefault(),clearTimeout(this.activating),this._activate(s),t;case e.ui.keyCode.ENTER:return i.preventDefault(),clearTimeout(this.activating),this._activate(s===this.options.active?!1:s),t;default:return}i.preventDefault(),clearTimeout(this.activating),s=this._focusNextTab(s,r),i.ctrlKey||(a.attr(\"aria-selected\",\"false\"),this.tabs.eq(s).attr(\"aria-selected\",\"true\"),this.activating=this._delay(function(){this.option(\"active\",s)},this.delay))}},_panelKeydown:function(t){this._handlePageNav(t)||t.ctrlKey&&t.keyCode===e.ui.keyCode.UP&&(t.preventDefault(),this.active.focus())},_handlePageNav:function(i){return i.altKey&&i.keyCode===e.ui.keyCode.PAGE_UP?(this._activate(this._focusNextTab(this.options.active-1,!1)),!0):i.altKey&&i.keyCode===e.ui.keyCode.PAGE_DOWN?(this._activate(this._focusNextTab(this.options.active+1,!0)),!0):t},_findNextTab:function(t,i){function a(){return t>s&&(t=0),0>t&&(t=s),t}for(var s=this.tabs.length-1;-1!==e.inArray(a(),this.options.disabled);)t=i?t+1:t-1;return t},_focusNextTab:function(e,t){return e=this._findNextTab(e,t),this.tabs.eq(e).focus(),e},_setOption:function(e,i){return\"active\"===e?(this._activate(i),t):\"disabled\"===e?(this._setupDisabled(i),t):(this._super(e,i),\"collapsible\"===e&&(this.element.toggleClass(\"ui-tabs-collapsible\",i),i||this.options.active!==!1||this._activate(0)),\"event\"===e&&this._setupEvents(i),\"heightStyle\"===e&&this._setupHeightStyle(i),t)},_tabId:function(e){return e.attr(\"aria-controls\")||\"ui-tabs-\"+i()},_sanitizeSelector:function(e){return e?e.replace(/[!\"$%&'()*+,.\\/:;<=>?@\\[\\]\\^`{|}~]/g,\"\\\\$&\"):\"\"},refresh:function(){var t=this.options,i=this.tablist.children(\":has(a[href])\");t.disabled=e.map(i.filter(\".ui-state-disabled\"),function(e){return i.index(e)}),this._processTabs(),t.active!==!1&&this.anchors.length?this.active.length&&!e.contains(this.tablist[0],this.active[0])?this.tabs.length===t.disabled.length?(t.active=!1,this.active=e()):this._activate(this._findNextTab(Math.max(0,t.active-1),!1)):t.active=this.tabs.index(this.active):(t.active=!1,this.active=e()),this._refresh()},_refresh:function(){this._setupDisabled(this.options.disabled),this._setupEvents(this.options.event),this._setupHeightStyle(this.options.heightStyle),this.tabs.not(this.active).attr({\"aria-selected\":\"false\",tabIndex:-1}),this.panels.not(this._getPanelForTab(this.active)).hide().attr({\"aria-expanded\":\"false\",\"aria-hidden\":\"true\"}),this.active.length?(this.active.addClass(\"ui-tabs-active ui-state-active\").attr({\"aria-selected\":\"true\",tabIndex:0}),this._getPanelForTab(this.active).show().attr({\"aria-expanded\":\"true\",\"aria-hidden\":\"false\"})):this.tabs.eq(0).attr(\"tabIndex\",0)},_processTabs:function(){var t=this;this.tablist=this._getList().addClass(\"ui-tabs-nav ui-helper-reset ui-helper-clearfix ui-widget-header ui-corner-all\").attr(\"role\",\"tablist\"),this.tabs=this.tablist.find(\"> li:has(a[href])\").addClass(\"ui-state-default ui-corner-top\").attr({role:\"tab\",tabIndex:-1}),this.anchors=this.tabs.map(function(){return e(\"a\",this)[0]}).addClass(\"ui-tabs-anchor\").attr({role:\"presentation\",tabIndex:-1}),this.panels=e(),this.anchors.each(function(i,s){var r,n,o,h=e(s).uniqueId().attr(\"id\"),l=e(s).closest(\"li\"),u=l.attr(\"aria-controls\");a(s)?(r=s.hash,n=t.element.find(t._sanitizeSelector(r))):(o=t._tabId(l),r=\"#\"+o,n=t.element.find(r),n.length||(n=t._createPanel(o),n.insertAfter(t.panels[i-1]||t.tablist)),n.attr(\"aria-live\",\"polite\")),n.length&&(t.panels=t.panels.add(n)),u&&l.data(\"ui-tabs-aria-controls\",u),l.attr({\"aria-controls\":r.substring(1),\"aria-labelledby\":h}),n.attr(\"aria-labelledby\",h)}),this.panels.addClass(\"ui-tabs-panel ui-widget-content ui-corner-bottom\").attr(\"role\",\"tabpanel\")},_getList:function(){return this.element.find(\"ol,ul\").eq(0)},_createPanel:function(t){return e(\"<div>\").attr(\"id\",t).addClass(\"ui-tabs-panel ui-widget-content ui-corner-bottom\").data(\"ui-tabs-destroy\",!0)},_setupDisabled:function(t){e.isArray(t)&&(t.length?t.length===this.anchors.length&&(t=!0):t=!1);for(var i,a=0;i=this.tabs[a];a++)t===!0||-1!==e.inArray(a,t)?e(i).addClass(\"ui-state-disabled\").attr(\"aria-disabled\",\"true\"):e(i).removeClass(\"ui-state-disabled\").removeAttr(\"aria-disabled\");this.options.disabled=t},_setupEvents:function(t){var i={click:function(e){e.preventDefault()}};t&&e.each(t.split(\" \"),function(e,t){i[t]=\"_eventHandler\"}),this._off(this.anchors.add(this.tabs).add(this.panels)),this._on(this.anchors,i),this._on(this.tabs,{keydown:\"_tabKeydown\"}),this._on(this.panels,{keydown:\"_panelKeydown\"}),this._focusable(this.tabs),this._hoverable(this.tabs)},_setupHeightStyle:function(t){var i,a=this.element.parent();\"fill\"===t?(i=a.height(),i-=this.element.outerHeight()-this.element.height(),this.element.siblings(\":visible\").each(function(){var t=e(this),a=t.css(\"position\");\"absolute\"!==a&&\"fixed\"!==a&&(i-=t.outerHeight(!0))}),this.element.children().not(this.panels).each(function(){i-=e(this).outerHeight(!0)}),this.panels.each(function(){e(this).height(Math.max(0,i-e(this).innerHeight()+e(this).height()))}).css(\"overflow\",\"auto\")):\"auto\"===t&&(i=0,this.panels.each(function(){i=Math.max(i,e(this).height(\"\").height())}).height(i))},_eventHandler:function(t){var i=this.options,a=this.active,s=e(t.currentTarget),r=s.closest(\"li\"),n=r[0]===a[0],o=n&&i.collapsible,h=o?e():this._getPanelForTab(r),l=a.length?this._getPanelForTab(a):e(),u={oldTab:a,oldPanel:l,newTab:o?e():r,newPanel:h};t.preventDefault(),r.hasClass(\"ui-state-disabled\")||r.hasClass(\"ui-tabs-loading\")||this.running||n&&!i.collapsible||this._trigger(\"beforeActivate\",t,u)===!1||(i.active=o?!1:this.tabs.index(r),this.active=n?e():r,this.xhr&&this.xhr.abort(),l.length||h.length||e.error(\"jQuery UI Tabs: Mismatching fragment identifier.\"),h.length&&this.load(this.tabs.index(r),t),this._toggle(t,u))},_toggle:function(t,i){function a(){r.running=!1,r._trigger(\"activate\",t,i)}function s(){i.newTab.closest(\"li\").addClass(\"ui-tabs-active ui-state-active\"),n.length&&r.options.show?r._show(n,r.options.show,a):(n.show(),a())}var r=this,n=i.newPanel,o=i.oldPanel;this.running=!0,o.length&&this.options.hide?this._hide(o,this.options.hide,function(){i.oldTab.closest(\"li\").removeClass(\"ui-tabs-active ui-state-active\"),s()}):(i.oldTab.closest(\"li\").removeClass(\"ui-tabs-active ui-state-active\"),o.hide(),s()),o.attr({\"aria-expanded\":\"false\",\"aria-hidden\":\"true\"}),i.oldTab.attr(\"aria-selected\",\"false\"),n.length&&o.length?i.oldTab.attr(\"tabIndex\",-1):n.length&&this.tabs.filter(function(){return 0===e(this).attr(\"tabIndex\")}).attr(\"tabIndex\",-1),n.attr({\"aria-expanded\":\"true\",\"aria-hidden\":\"false\"}),i.newTab.attr({\"aria-selected\":\"true\",tabIndex:0})},_activate:function(t){var i,a=this._findActive(t);a[0]!==this.active[0]&&(a.length||(a=this.active),i=a.find(\".ui-tabs-anchor\")[0],this._eventHandler({target:i,currentTarget:i,preventDefault:e.noop}))},_findActive:function(t){return t===!1?e():this.tabs.eq(t)},_getIndex:function(e){return\"string\"==typeof e&&(e=this.anchors.index(this.anchors.filter(\"[href$='\"+e+\"']\"))),e},_destroy:function(){this.xhr&&this.xhr.abort(),this.element.removeClass(\"ui-tabs ui-widget ui-widget-content ui-corner-all ui-tabs-collapsible\"),this.tablist.removeClass(\"ui-tabs-nav ui-helper-reset ui-helper-clearfix ui-widget-header ui-corner-all\").removeAttr(\"role\"),this.anchors.removeClass(\"ui-tabs-anchor\").removeAttr(\"role\").removeAttr(\"tabIndex\").removeUniqueId(),this.tabs.add(this.panels).each(function(){e.data(this,\"ui-tabs-destroy\")?e(this).remove():e(this).removeClass(\"ui-state-default ui-state-active ui-state-disabled ui-corner-top ui-corner-bottom ui-widget-content ui-tabs-active ui-tabs-panel\").removeAttr(\"tabIndex\").removeAttr(\"aria-live\").removeAttr(\"aria-busy\").removeAttr(\"aria-selected\").removeAttr(\"aria-labelledby\").removeAttr(\"aria-hidden\").removeAttr(\"aria-expanded\").removeAttr(\"role\")}),this.tabs.each(function(){var t=e(this),i=t.data(\"ui-tabs-aria-controls\");i?t.attr(\"aria-controls\",i).removeData(\"ui-tabs-aria-controls\"):t.removeAttr(\"aria-controls\")}),this.panels.show(),\"content\"!==this.options.heightStyle&&this.panels.css(\"height\",\"\")},enable:function(i){var a=this.options.disabled;a!==!1&&(i===t?a=!1:(i=this._getIndex(i),a=e.isArray(a)?e.map(a,function(e){return e!==i?e:null}):e.map(this.tabs,function(e,t){return t!==i?t:null})),this._setupDisabled(a))},disable:function(i){var a=this.options.disabled;if(a!==!0){if(i===t)a=!0;else{if(i=this._getIndex(i),-1!==e.inArray(i,a))return;a=e.isArray(a)?e.merge([i],a).sort():[i]}this._setupDisabled(a)}},load:function(t,i){t=this._getIndex(t);var s=this,r=this.tabs.eq(t),n=r.find(\".ui-tabs-anchor\"),o=this._getPanelForTab(r),h={tab:r,panel:o};a(n[0])||(this.xhr=e.ajax(this._ajaxSettings(n,i,h)),this.xhr&&\"canceled\"!==this.xhr.statusText&&(r.addClass(\"ui-tabs-loading\"),o.attr(\"aria-busy\",\"true\"),this.xhr.success(function(e){setTimeout(function(){o.html(e),s._trigger(\"load\",i,h)},1)}).complete(function(e,t){setTimeout(function(){\"abort\"===t&&s.panels.stop(!1,!0),r.removeClass(\"ui-tabs-loading\"),o.removeAttr(\"aria-busy\"),e===s.xhr&&delete s.xhr},1)})))},_ajaxSettings:function(t,i,a){var s=this;return{url:t.attr(\"href\"),beforeSend:function(t,r){return s._trigger(\"beforeLoad\",i,e.extend({jqXHR:t,ajaxSettings:r},a))}}},_getPanelForTab:function(t){var i=e(t).attr(\"aria-controls\");return this.element.find(this._sanitizeSelector(\"#\"+i))}})})(jQuery);(function(e){function t(t,i){var a=(t.attr(\"aria-describedby\")||\"\").split(/\\s+/);a.push(i),t.data(\"ui-tooltip-id\",i).attr(\"aria-describedby\",e.trim(a.join(\" \")))}function i(t){var i=t.data(\"ui-tooltip-id\"),a=(t.attr(\"aria-describedby\")||\"\").split(/\\s+/),s=e.inArray(i,a);-1!==s&&a.splice(s,1),t.removeData(\"ui-tooltip-id\"),a=e.trim(a.join(\" \")),a?t.attr(\"aria-describedby\",a):t.removeAttr(\"aria-describedby\")}var a=0;e.widget(\"ui.tooltip\",{version:\"1.10.3\",options:{content:function(){var t=e(this).attr(\"title\")||\"\";return e(\"<a>\").text(t).html()},hide:!0,items:\"[title]:not([disabled])\",position:{my:\"left top+15\",at:\"left bottom\",collision:\"flipfit flip\"},show:!0,tooltipClass:null,track:!1,close:null,open:null},_create:function(){this._on({mouseover:\"open\",focusin:\"open\"}),this.tooltips={},this.parents={},this.options.disabled&&this._disable()},_setOption:function(t,i){var a=this;return\"disabled\"===t?(this[i?\"_disable\":\"_enable\"](),this.options[t]=i,void 0):(this._super(t,i),\"content\"===t&&e.each(this.tooltips,function(e,t){a._updateContent(t)}),void 0)},_disable:function(){var t=this;e.each(this.tooltips,function(i,a){var s=e.Event(\"blur\");s.target=s.currentTarget=a[0],t.close(s,!0)}),this.element.find(this.options.items).addBack().each(function(){var t=e(this);t.is(\"[title]\")&&t.data(\"ui-tooltip-title\",t.attr(\"title\")).attr(\"title\",\"\")})},_enable:function(){this.element.find(this.options.items).addBack().each(function(){var t=e(this);t.data(\"ui-tooltip-title\")&&t.attr(\"title\",t.data(\"ui-tooltip-title\"))})},open:function(t){var i=this,a=e(t?t.target:this.element).closest(this.options.items);a.length&&!a.data(\"ui-tooltip-id\")&&(a.attr(\"title\")&&a.data(\"ui-tooltip-title\",a.attr(\"title\")),a.data(\"ui-tooltip-open\",!0),t&&\"mouseover\"===t.type&&a.parents().each(function(){var t,a=e(this);a.data(\"ui-tooltip-open\")&&(t=e.Event(\"blur\"),t.target=t.currentTarget=this,i.close(t,!0)),a.attr(\"title\")&&(a.uniqueId(),i.parents[this.id]={element:this,title:a.attr(\"title\")},a.attr(\"title\",\"\"))}),this._updateContent(a,t))},_updateContent:function(e,t){var i,a=this.options.content,s=this,n=t?t.type:null;return\"string\"==typeof a?this._open(t,e,a):(i=a.call(e[0],function(i){e.data(\"ui-tooltip-open\")&&s._delay(function(){t&&(t.type=n),this._open(t,e,i)})}),i&&this._open(t,e,i),void 0)},_open:function(i,a,s){function n(e){l.of=e,r.is(\":hidden\")||r.position(l)}var r,o,h,l=e.extend({},this.options.position);if(s){if(r=this._find(a),r.length)return r.find(\".ui-tooltip-content\").html(s),void 0;a.is(\"[title]\")&&(i&&\"mouseover\"===i.type?a.attr(\"title\",\"\"):a.removeAttr(\"title\")),r=this._tooltip(a),t(a,r.attr(\"id\")),r.find(\".ui-tooltip-content\").html(s),this.options.track&&i&&/^mouse/.test(i.type)?(this._on(this.document,{mousemove:n}),n(i)):r.position(e.extend({of:a},this.options.position)),r.hide(),this._show(r,this.options.show),this.options.show&&this.options.show.delay&&(h=this.delayedShow=setInterval(function(){r.is(\":visible\")&&(n(l.of),clearInterval(h))},e.fx.interval)),this._trigger(\"open\",i,{tooltip:r}),o={keyup:function(t){if(t.keyCode===e.ui.keyCode.ESCAPE){var i=e.Event(t);i.currentTarget=a[0],this.close(i,!0)}},remove:function(){this._removeTooltip(r)}},i&&\"mouseover\"!==i.type||(o.mouseleave=\"close\"),i&&\"focusin\"!==i.type||(o.focusout=\"close\"),this._on(!0,a,o)}},close:function(t){var a=this,s=e(t?t.currentTarget:this.element),n=this._find(s);this.closing||(clearInterval(this.delayedShow),s.data(\"ui-tooltip-title\")&&s.attr(\"title\",s.data(\"ui-tooltip-title\")),i(s),n.stop(!0),this._hide(n,this.options.hide,function(){a._removeTooltip(e(this))}),s.removeData(\"ui-tooltip-open\"),this._off(s,\"mouseleave focusout keyup\"),s[0]!==this.element[0]&&this._off(s,\"remove\"),this._off(this.document,\"mousemove\"),t&&\"mouseleave\"===t.type&&e.each(this.parents,function(t,i){e(i.element).attr(\"title\",i.title),delete a.parents[t]}),this.closing=!0,this._trigger(\"close\",t,{tooltip:n}),this.closing=!1)},_tooltip:function(t){var i=\"ui-tooltip-\"+a++,s=e(\"<div>\").attr({id:i,role:\"tooltip\"}).addClass(\"ui-tooltip ui-widget ui-corner-all ui-widget-content \"+(this.options.tooltipClass||\"\"));return e(\"<div>\").addClass(\"ui-tooltip-content\").appendTo(s),s.appendTo(this.document[0].body),this.tooltips[i]=t,s},_find:function(t){var i=t.data(\"ui-tooltip-id\");return i?e(\"#\"+i):e()},_removeTooltip:function(e){e.remove(),delete this.tooltips[e.attr(\"id\")]},_destroy:function(){var t=this;e.each(this.tooltips,function(i,a){var s=e.Event(\"blur\");s.target=s.currentTarget=a[0],t.close(s,!0),e(\"#\"+i).remove(),a.data(\"ui-tooltip-title\")&&(a.attr(\"title\",a.data(\"ui-tooltip-title\")),a.removeData(\"ui-tooltip-title\"))})}})})(jQuery);(function(e,t){var a=\"ui-effects-\";e.effects={effect:{}},function(e,t){function a(e,t,a){var i=h[t.type]||{};return null==e?a||!t.def?null:t.def:(e=i.floor?~~e:parseFloat(e),isNaN(e)?t.def:i.mod?(e+i.mod)%i.mod:0>e?0:e>i.max?i.max:e)}function i(a){var i=u(),r=i._rgba=[];return a=a.toLowerCase(),p(d,function(e,n){var s,o=n.re.exec(a),d=o&&n.parse(o),u=n.space||\"rgba\";return d?(s=i[u](d),i[l[u].cache]=s[l[u].cache],r=i._rgba=s._rgba,!1):t}),r.length?(\"0,0,0,0\"===r.join()&&e.extend(r,n.transparent),i):n[a]}function r(e,t,a){return a=(a+1)%1,1>6*a?e+6*(t-e)*a:1>2*a?t:2>3*a?e+6*(t-e)*(2/3-a):e}var n,s=\"backgroundColor borderBottomColor borderLeftColor borderRightColor borderTopColor color columnRuleColor outlineColor textDecorationColor textEmphasisColor\",o=/^([\\-+])=\\s*(\\d+\\.?\\d*)/,d=[{re:/rgba?\\(\\s*(\\d{1,3})\\s*,\\s*(\\d{1,3})\\s*,\\s*(\\d{1,3})\\s*(?:,\\s*(\\d?(?:\\.\\d+)?)\\s*)?\\)/,parse:function(e){return[e[1],e[2],e[3],e[4]]}},{re:/rgba?\\(\\s*(\\d+(?:\\.\\d+)?)\\%\\s*,\\s*(\\d+(?:\\.\\d+)?)\\%\\s*,\\s*(\\d+(?:\\.\\d+)?)\\%\\s*(?:,\\s*(\\d?(?:\\.\\d+)?)\\s*)?\\)/,parse:function(e){return[2.55*e[1],2.55*e[2],2.55*e[3],e[4]]}},{re:/#([a-f0-9]{2})([a-f0-9]{2})([a-f0-9]{2})/,parse:function(e){return[parseInt(e[1],16),parseInt(e[2],16),parseInt(e[3],16)]}},{re:/#([a-f0-9])([a-f0-9])([a-f0-9])/,parse:function(e){return[parseInt(e[1]+e[1],16),parseInt(e[2]+e[2],16),parseInt(e[3]+e[3],16)]}},{re:/hsla?\\(\\s*(\\d+(?:\\.\\d+)?)\\s*,\\s*(\\d+(?:\\.\\d+)?)\\%\\s*,\\s*(\\d+(?:\\.\\d+)?)\\%\\s*(?:,\\s*(\\d?(?:\\.\\d+)?)\\s*)?\\)/,space:\"hsla\",parse:function(e){return[e[1],e[2]/100,e[3]/100,e[4]]}}],u=e.Color=function(t,a,i,r){return new e.Color.fn.parse(t,a,i,r)},l={rgba:{props:{red:{idx:0,type:\"byte\"},green:{idx:1,type:\"byte\"},blue:{idx:2,type:\"byte\"}}},hsla:{props:{hue:{idx:0,type:\"degrees\"},saturation:{idx:1,type:\"percent\"},lightness:{idx:2,type:\"percent\"}}}},h={\"byte\":{floor:!0,max:255},percent:{max:1},degrees:{mod:360,floor:!0}},c=u.support={},m=e(\"<p>\")[0],p=e.each;m.style.cssText=\"background-color:rgba(1,1,1,.5)\",c.rgba=m.style.backgroundColor.indexOf(\"rgba\")>-1,p(l,function(e,t){t.cache=\"_\"+e,t.props.alpha={idx:3,type:\"percent\",def:1}}),u.fn=e.extend(u.prototype,{parse:function(r,s,o,d){if(r===t)return this._rgba=[null,null,null,null],this;(r.jquery||r.nodeType)&&(r=e(r).css(s),s=t);var h=this,c=e.type(r),m=this._rgba=[];return s!==t&&(r=[r,s,o,d],c=\"array\"),\"string\"===c?this.parse(i(r)||n._default):\"array\"===c?(p(l.rgba.props,function(e,t){m[t.idx]=a(r[t.idx],t)}),this):\"object\"===c?(r instanceof u?p(l,function(e,t){r[t.cache]&&(h[t.cache]=r[t.cache].slice())}):p(l,function(t,i){var n=i.cache;p(i.props,function(e,t){if(!h[n]&&i.to){if(\"alpha\"===e||null==r[e])return;h[n]=i.to(h._rgba)}h[n][t.idx]=a(r[e],t,!0)}),h[n]&&0>e.inArray(null,h[n].slice(0,3))&&(h[n][3]=1,i.from&&(h._rgba=i.from(h[n])))}),this):t},is:function(e){var a=u(e),i=!0,r=this;return p(l,function(e,n){var s,o=a[n.cache];return o&&(s=r[n.cache]||n.to&&n.to(r._rgba)||[],p(n.props,function(e,a){return null!=o[a.idx]?i=o[a.idx]===s[a.idx]:t})),i}),i},_space:function(){var e=[],t=this;return p(l,function(a,i){t[i.cache]&&e.push(a)}),e.pop()},transition:function(e,t){var i=u(e),r=i._space(),n=l[r],s=0===this.alpha()?u(\"transparent\"):this,o=s[n.cache]||n.to(s._rgba),d=o.slice();return i=i[n.cache],p(n.props,function(e,r){var n=r.idx,s=o[n],u=i[n],l=h[r.type]||{};null!==u&&(null===s?d[n]=u:(l.mod&&(u-s>l.mod/2?s+=l.mod:s-u>l.mod/2&&(s-=l.mod)),d[n]=a((u-s)*t+s,r)))}),this[r](d)},blend:function(t){if(1===this._rgba[3])return this;var a=this._rgba.slice(),i=a.pop(),r=u(t)._rgba;return u(e.map(a,function(e,t){return(1-i)*r[t]+i*e}))},toRgbaString:function(){var t=\"rgba(\",a=e.map(this._rgba,function(e,t){return null==e?t>2?1:0:e});return 1===a[3]&&(a.pop(),t=\"rgb(\"),t+a.join()+\")\"},toHslaString:function(){var t=\"hsla(\",a=e.map(this.hsla(),function(e,t){return null==e&&(e=t>2?1:0),t&&3>t&&(e=Math.round(100*e)+\"%\"),e});return 1===a[3]&&(a.pop(),t=\"hsl(\"),t+a.join()+\")\"},toHexString:function(t){var a=this._rgba.slice(),i=a.pop();return t&&a.push(~~(255*i)),\"#\"+e.map(a,function(e){return e=(e||0).toString(16),1===e.length?\"0\"+e:e}).join(\"\")},toString:function(){return 0===this._rgba[3]?\"transparent\":this.toRgbaString()}}),u.fn.parse.prototype=u.fn,l.hsla.to=function(e){if(null==e[0]||null==e[1]||null==e[2])return[null,null,null,e[3]];var t,a,i=e[0]/255,r=e[1]/255,n=e[2]/255,s=e[3],o=Math.max(i,r,n),d=Math.min(i,r,n),u=o-d,l=o+d,h=.5*l;return t=d===o?0:i===o?60*(r-n)/u+360:r===o?60*(n-i)/u+120:60*(i-r)/u+240,a=0===u?0:.5>=h?u/l:u/(2-l),[Math.round(t)%360,a,h,null==s?1:s]},l.hsla.from=function(e){if(null==e[0]||null==e[1]||null==e[2])return[null,null,null,e[3]];var t=e[0]/360,a=e[1],i=e[2],n=e[3],s=.5>=i?i*(1+a):i+a-i*a,o=2*i-s;return[Math.round(255*r(o,s,t+1/3)),Math.round(255*r(o,s,t)),Math.round(255*r(o,s,t-1/3)),n]},p(l,function(i,r){var n=r.props,s=r.cache,d=r.to,l=r.from;u.fn[i]=function(i){if(d&&!this[s]&&(this[s]=d(this._rgba)),i===t)return this[s].slice();var r,o=e.type(i),h=\"array\"===o||\"object\"===o?i:arguments,c=this[s].slice();return p(n,function(e,t){var i=h[\"object\"===o?e:t.idx];null==i&&(i=c[t.idx]),c[t.idx]=a(i,t)}),l?(r=u(l(c)),r[s]=c,r):u(c)},p(n,function(t,a){u.fn[t]||(u.fn[t]=function(r){var n,s=e.type(r),d=\"alpha\"===t?this._hsla?\"hsla\":\"rgba\":i,u=this[d](),l=u[a.idx];return\"undefined\"===s?l:(\"function\"===s&&(r=r.call(this,l),s=e.type(r)),null==r&&a.empty?this:(\"string\"===s&&(n=o.exec(r),n&&(r=l+parseFloat(n[2])*(\"+\"===n[1]?1:-1))),u[a.idx]=r,this[d](u)))})})}),u.hook=function(t){var a=t.split(\" \");p(a,function(t,a){e.cssHooks[a]={set:function(t,r){var n,s,o=\"\";if(\"transparent\"!==r&&(\"string\"!==e.type(r)||(n=i(r)))){if(r=u(n||r),!c.rgba&&1!==r._rgba[3]){for(s=\"backgroundColor\"===a?t.parentNode:t;(\"\"===o||\"transparent\"===o)&&s&&s.style;)try{o=e.css(s,\"backgroundColor\"),s=s.parentNode}catch(d){}r=r.blend(o&&\"transparent\"!==o?o:\"_default\")}r=r.toRgbaString()}try{t.style[a]=r}catch(d){}}},e.fx.step[a]=function(t){t.colorInit||(t.start=u(t.elem,a),t.end=u(t.end),t.colorInit=!0),e.cssHooks[a].set(t.elem,t.start.transition(t.end,t.pos))}})},u.hook(s),e.cssHooks.borderColor={expand:function(e){var t={};return p([\"Top\",\"Right\",\"Bottom\",\"Left\"],function(a,i){t[\"border\"+i+\"Color\"]=e}),t}},n=e.Color.names={aqua:\"#00ffff\",black:\"#000000\",blue:\"#0000ff\",fuchsia:\"#ff00ff\",gray:\"#808080\",green:\"#008000\",lime:\"#00ff00\",maroon:\"#800000\",navy:\"#000080\",olive:\"#808000\",purple:\"#800080\",red:\"#ff0000\",silver:\"#c0c0c0\",teal:\"#008080\",white:\"#ffffff\",yellow:\"#ffff00\",transparent:[null,null,null,0],_default:\"#ffffff\"}}(jQuery),function(){function a(t){var a,i,r=t.ownerDocument.defaultView?t.ownerDocument.defaultView.getComputedStyle(t,null):t.currentStyle,n={};if(r&&r.length&&r[0]&&r[r[0]])for(i=r.length;i--;)a=r[i],\"string\"==typeof r[a]&&(n[e.camelCase(a)]=r[a]);else for(a in r)\"string\"==typeof r[a]&&(n[a]=r[a]);return n}function i(t,a){var i,r,s={};for(i in a)r=a[i],t[i]!==r&&(n[i]||(e.fx.step[i]||!isNaN(parseFloat(r)))&&(s[i]=r));return s}var r=[\"add\",\"remove\",\"toggle\"],n={border:1,borderBottom:1,borderColor:1,borderLeft:1,borderRight:1,borderTop:1,borderWidth:1,margin:1,padding:1};e.each([\"borderLeftStyle\",\"borderRightStyle\",\"borderBottomStyle\",\"borderTopStyle\"],function(t,a){e.fx.step[a]=function(e){(\"none\"!==e.end&&!e.setAttr||1===e.pos&&!e.setAttr)&&(jQuery.style(e.elem,a,e.end),e.setAttr=!0)}}),e.fn.addBack||(e.fn.addBack=function(e){return this.add(null==e?this.prevObject:this.prevObject.filter(e))}),e.effects.animateClass=function(t,n,s,o){var d=e.speed(n,s,o);return this.queue(function(){var n,s=e(this),o=s.attr(\"class\")||\"\",u=d.children?s.find(\"*\").addBack():s;u=u.map(function(){var t=e(this);return{el:t,start:a(this)}}),n=function(){e.each(r,function(e,a){t[a]&&s[a+\"Class\"](t[a])})},n(),u=u.map(function(){return this.end=a(this.el[0]),this.diff=i(this.start,this.end),this}),s.attr(\"class\",o),u=u.map(function(){var t=this,a=e.Deferred(),i=e.extend({},d,{queue:!1,complete:function(){a.resolve(t)}});return this.el.animate(this.diff,i),a.promise()}),e.when.apply(e,u.get()).done(function(){n(),e.each(arguments,function(){var t=this.el;e.each(this.diff,function(e){t.css(e,\"\")})}),d.complete.call(s[0])})})},e.fn.extend({addClass:function(t){return function(a,i,r,n){return i?e.effects.animateClass.call(this,{add:a},i,r,n):t.apply(this,arguments)}}(e.fn.addClass),removeClass:function(t){return function(a,i,r,n){return arguments.length>1?e.effects.animateClass.call(this,{remove:a},i,r,n):t.apply(this,arguments)}}(e.fn.removeClass),toggleClass:function(a){return function(i,r,n,s,o){return\"boolean\"==typeof r||r===t?n?e.effects.animateClass.call(this,r?{add:i}:{remove:i},n,s,o):a.apply(this,arguments):e.effects.animateClass.call(this,{toggle:i},r,n,s)}}(e.fn.toggleClass),switchClass:function(t,a,i,r,n){return e.effects.animateClass.call(this,{add:a,remove:t},i,r,n)}})}(),function(){function i(t,a,i,r){return e.isPlainObject(t)&&(a=t,t=t.effect),t={effect:t},null==a&&(a={}),e.isFunction(a)&&(r=a,i=null,a={}),(\"number\"==typeof a||e.fx.speeds[a])&&(r=i,i=a,a={}),e.isFunction(i)&&(r=i,i=null),a&&e.extend(t,a),i=i||a.duration,t.duration=e.fx.off?0:\"number\"==typeof i?i:i in e.fx.speeds?e.fx.speeds[i]:e.fx.speeds._default,t.complete=r||a.complete,t}function r(t){return!t||\"number\"==typeof t||e.fx.speeds[t]?!0:\"string\"!=typeof t||e.effects.effect[t]?e.isFunction(t)?!0:\"object\"!=typeof t||t.effect?!1:!0:!0}e.extend(e.effects,{version:\"1.10.3\",save:function(e,t){for(var i=0;t.length>i;i++)null!==t[i]&&e.data(a+t[i],e[0].style[t[i]])},restore:function(e,i){var r,n;for(n=0;i.length>n;n++)null!==i[n]&&(r=e.data(a+i[n]),r===t&&(r=\"\"),e.css(i[n],r))},setMode:function(e,t){return\"toggle\"===t&&(t=e.is(\":hidden\")?\"show\":\"hide\"),t},getBaseline:function(e,t){var a,i;switch(e[0]){case\"top\":a=0;break;case\"middle\":a=.5;break;case\"bottom\":a=1;break;default:a=e[0]/t.height}switch(e[1]){case\"left\":i=0;break;case\"center\":i=.5;break;case\"right\":i=1;break;default:i=e[1]/t.width}return{x:i,y:a}},createWrapper:function(t){if(t.parent().is(\".ui-effects-wrapper\"))return t.parent();var a={width:t.outerWidth(!0),height:t.outerHeight(!0),\"float\":t.css(\"float\")},i=e(\"<div></div>\").addClass(\"ui-effects-wrapper\").css({fontSize:\"100%\",background:\"transparent\",border:\"none\",margin:0,padding:0}),r={width:t.width(),height:t.height()},n=document.activeElement;try{n.id}catch(s){n=document.body}return t.wrap(i),(t[0]===n||e.contains(t[0],n))&&e(n).focus(),i=t.parent(),\"static\"===t.css(\"position\")?(i.css({position:\"relative\"}),t.css({position:\"relative\"})):(e.extend(a,{position:t.css(\"position\"),zIndex:t.css(\"z-index\")}),e.each([\"top\",\"left\",\"bottom\",\"right\"],function(e,i){a[i]=t.css(i),isNaN(parseInt(a[i],10))&&(a[i]=\"auto\")}),t.css({position:\"relative\",top:0,left:0,right:\"auto\",bottom:\"auto\"})),t.css(r),i.css(a).show()},removeWrapper:function(t){var a=document.activeElement;return t.parent().is(\".ui-effects-wrapper\")&&(t.parent().replaceWith(t),(t[0]===a||e.contains(t[0],a))&&e(a).focus()),t},setTransition:function(t,a,i,r){return r=r||{},e.each(a,function(e,a){var n=t.cssUnit(a);n[0]>0&&(r[a]=n[0]*i+n[1])}),r}}),e.fn.extend({effect:function(){function t(t){function i(){e.isFunction(n)&&n.call(r[0]),e.isFunction(t)&&t()}var r=e(this),n=a.complete,o=a.mode;(r.is(\":hidden\")?\"hide\"===o:\"show\"===o)?(r[o](),i()):s.call(r[0],a,i)}var a=i.apply(this,arguments),r=a.mode,n=a.queue,s=e.effects.effect[a.effect];return e.fx.off||!s?r?this[r](a.duration,a.complete):this.each(function(){a.complete&&a.complete.call(this)}):n===!1?this.each(t):this.queue(n||\"fx\",t)},show:function(e){return function(t){if(r(t))return e.apply(this,arguments);var a=i.apply(this,arguments);return a.mode=\"show\",this.effect.call(this,a)}}(e.fn.show),hide:function(e){return function(t){if(r(t))return e.apply(this,arguments);var a=i.apply(this,arguments);return a.mode=\"hide\",this.effect.call(this,a)}}(e.fn.hide),toggle:function(e){return function(t){if(r(t)||\"boolean\"==typeof t)return e.apply(this,arguments);var a=i.apply(this,arguments);return a.mode=\"toggle\",this.effect.call(this,a)}}(e.fn.toggle),cssUnit:function(t){var a=this.css(t),i=[];return e.each([\"em\",\"px\",\"%\",\"pt\"],function(e,t){a.indexOf(t)>0&&(i=[parseFloat(a),t])}),i}})}(),function(){var t={};e.each([\"Quad\",\"Cubic\",\"Quart\",\"Quint\",\"Expo\"],function(e,a){t[a]=function(t){return Math.pow(t,e+2)}}),e.extend(t,{Sine:function(e){return 1-Math.cos(e*Math.PI/2)},Circ:function(e){return 1-Math.sqrt(1-e*e)},Elastic:function(e){return 0===e||1===e?e:-Math.pow(2,8*(e-1))*Math.sin((80*(e-1)-7.5)*Math.PI/15)},Back:function(e){return e*e*(3*e-2)},Bounce:function(e){for(var t,a=4;((t=Math.pow(2,--a))-1)/11>e;);return 1/Math.pow(4,3-a)-7.5625*Math.pow((3*t-2)/22-e,2)}}),e.each(t,function(t,a){e.easing[\"easeIn\"+t]=a,e.easing[\"easeOut\"+t]=function(e){return 1-a(1-e)},e.easing[\"easeInOut\"+t]=function(e){return.5>e?a(2*e)/2:1-a(-2*e+2)/2}})}()})(jQuery);(function(e){var t=/up|down|vertical/,a=/up|left|vertical|horizontal/;e.effects.effect.blind=function(i,r){var s,n,o,d=e(this),u=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"height\",\"width\"],l=e.effects.setMode(d,i.mode||\"hide\"),h=i.direction||\"up\",c=t.test(h),m=c?\"height\":\"width\",p=c?\"top\":\"left\",f=a.test(h),g={},y=\"show\"===l;d.parent().is(\".ui-effects-wrapper\")?e.effects.save(d.parent(),u):e.effects.save(d,u),d.show(),s=e.effects.createWrapper(d).css({overflow:\"hidden\"}),n=s[m](),o=parseFloat(s.css(p))||0,g[m]=y?n:0,f||(d.css(c?\"bottom\":\"right\",0).css(c?\"top\":\"left\",\"auto\").css({position:\"absolute\"}),g[p]=y?o:n+o),y&&(s.css(m,0),f||s.css(p,o+n)),s.animate(g,{duration:i.duration,easing:i.easing,queue:!1,complete:function(){\"hide\"===l&&d.hide(),e.effects.restore(d,u),e.effects.removeWrapper(d),r()}})}})(jQuery);(function(e){e.effects.effect.bounce=function(t,a){var i,r,s,n=e(this),o=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"height\",\"width\"],d=e.effects.setMode(n,t.mode||\"effect\"),u=\"hide\"===d,l=\"show\"===d,h=t.direction||\"up\",c=t.distance,m=t.times||5,p=2*m+(l||u?1:0),f=t.duration/p,g=t.easing,y=\"up\"===h||\"down\"===h?\"top\":\"left\",v=\"up\"===h||\"left\"===h,x=n.queue(),k=x.length;for((l||u)&&o.push(\"opacity\"),e.effects.save(n,o),n.show(),e.effects.createWrapper(n),c||(c=n[\"top\"===y?\"outerHeight\":\"outerWidth\"]()/3),l&&(s={opacity:1},s[y]=0,n.css(\"opacity\",0).css(y,v?2*-c:2*c).animate(s,f,g)),u&&(c/=Math.pow(2,m-1)),s={},s[y]=0,i=0;m>i;i++)r={},r[y]=(v?\"-=\":\"+=\")+c,n.animate(r,f,g).animate(s,f,g),c=u?2*c:c/2;u&&(r={opacity:0},r[y]=(v?\"-=\":\"+=\")+c,n.animate(r,f,g)),n.queue(function(){u&&n.hide(),e.effects.restore(n,o),e.effects.removeWrapper(n),a()}),k>1&&x.splice.apply(x,[1,0].concat(x.splice(k,p+1))),n.dequeue()}})(jQuery);(function(e){e.effects.effect.clip=function(t,a){var i,r,s,n=e(this),o=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"height\",\"width\"],d=e.effects.setMode(n,t.mode||\"hide\"),u=\"show\"===d,l=t.direction||\"vertical\",h=\"vertical\"===l,c=h?\"height\":\"width\",m=h?\"top\":\"left\",p={};e.effects.save(n,o),n.show(),i=e.effects.createWrapper(n).css({overflow:\"hidden\"}),r=\"IMG\"===n[0].tagName?i:n,s=r[c](),u&&(r.css(c,0),r.css(m,s/2)),p[c]=u?s:0,p[m]=u?0:s/2,r.animate(p,{queue:!1,duration:t.duration,easing:t.easing,complete:function(){u||n.hide(),e.effects.restore(n,o),e.effects.removeWrapper(n),a()}})}})(jQuery);(function(e){e.effects.effect.drop=function(t,a){var i,r=e(this),s=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"opacity\",\"height\",\"width\"],n=e.effects.setMode(r,t.mode||\"hide\"),o=\"show\"===n,d=t.direction||\"left\",u=\"up\"===d||\"down\"===d?\"top\":\"left\",l=\"up\"===d||\"left\"===d?\"pos\":\"neg\",h={opacity:o?1:0};e.effects.save(r,s),r.show(),e.effects.createWrapper(r),i=t.distance||r[\"top\"===u?\"outerHeight\":\"outerWidth\"](!0)/2,o&&r.css(\"opacity\",0).css(u,\"pos\"===l?-i:i),h[u]=(o?\"pos\"===l?\"+=\":\"-=\":\"pos\"===l?\"-=\":\"+=\")+i,r.animate(h,{queue:!1,duration:t.duration,easing:t.easing,complete:function(){\"hide\"===n&&r.hide(),e.effects.restore(r,s),e.effects.removeWrapper(r),a()}})}})(jQuery);(function(e){e.effects.effect.explode=function(t,a){function i(){x.push(this),x.length===h*c&&r()}function r(){m.css({visibility:\"visible\"}),e(x).remove(),f||m.hide(),a()}var s,n,o,d,u,l,h=t.pieces?Math.round(Math.sqrt(t.pieces)):3,c=h,m=e(this),p=e.effects.setMode(m,t.mode||\"hide\"),f=\"show\"===p,g=m.show().css(\"visibility\",\"hidden\").offset(),y=Math.ceil(m.outerWidth()/c),v=Math.ceil(m.outerHeight()/h),x=[];for(s=0;h>s;s++)for(d=g.top+s*v,l=s-(h-1)/2,n=0;c>n;n++)o=g.left+n*y,u=n-(c-1)/2,m.clone().appendTo(\"body\").wrap(\"<div></div>\").css({position:\"absolute\",visibility:\"visible\",left:-n*y,top:-s*v}).parent().addClass(\"ui-effects-explode\").css({position:\"absolute\",overflow:\"hidden\",width:y,height:v,left:o+(f?u*y:0),top:d+(f?l*v:0),opacity:f?0:1}).animate({left:o+(f?0:u*y),top:d+(f?0:l*v),opacity:f?1:0},t.duration||500,t.easing,i)}})(jQuery);(function(e){e.effects.effect.fade=function(t,a){var i=e(this),r=e.effects.setMode(i,t.mode||\"toggle\");i.animate({opacity:r},{queue:!1,duration:t.duration,easing:t.easing,complete:a})}})(jQuery);(function(e){e.effects.effect.fold=function(t,a){var i,r,s=e(this),n=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"height\",\"width\"],o=e.effects.setMode(s,t.mode||\"hide\"),d=\"show\"===o,u=\"hide\"===o,l=t.size||15,h=/([0-9]+)%/.exec(l),c=!!t.horizFirst,m=d!==c,p=m?[\"width\",\"height\"]:[\"height\",\"width\"],f=t.duration/2,g={},y={};e.effects.save(s,n),s.show(),i=e.effects.createWrapper(s).css({overflow:\"hidden\"}),r=m?[i.width(),i.height()]:[i.height(),i.width()],h&&(l=parseInt(h[1],10)/100*r[u?0:1]),d&&i.css(c?{height:0,width:l}:{height:l,width:0}),g[p[0]]=d?r[0]:l,y[p[1]]=d?r[1]:0,i.animate(g,f,t.easing).animate(y,f,t.easing,function(){u&&s.hide(),e.effects.restore(s,n),e.effects.removeWrapper(s),a()})}})(jQuery);(function(e){e.effects.effect.highlight=function(t,a){var i=e(this),r=[\"backgroundImage\",\"backgroundColor\",\"opacity\"],s=e.effects.setMode(i,t.mode||\"show\"),n={backgroundColor:i.css(\"backgroundColor\")};\"hide\"===s&&(n.opacity=0),e.effects.save(i,r),i.show().css({backgroundImage:\"none\",backgroundColor:t.color||\"#ffff99\"}).animate(n,{queue:!1,duration:t.duration,easing:t.easing,complete:function(){\"hide\"===s&&i.hide(),e.effects.restore(i,r),a()}})}})(jQuery);(function(e){e.effects.effect.pulsate=function(t,a){var i,r=e(this),s=e.effects.setMode(r,t.mode||\"show\"),n=\"show\"===s,o=\"hide\"===s,d=n||\"hide\"===s,u=2*(t.times||5)+(d?1:0),l=t.duration/u,h=0,c=r.queue(),m=c.length;for((n||!r.is(\":visible\"))&&(r.css(\"opacity\",0).show(),h=1),i=1;u>i;i++)r.animate({opacity:h},l,t.easing),h=1-h;r.animate({opacity:h},l,t.easing),r.queue(function(){o&&r.hide(),a()}),m>1&&c.splice.apply(c,[1,0].concat(c.splice(m,u+1))),r.dequeue()}})(jQuery);(function(e){e.effects.effect.puff=function(t,a){var i=e(this),r=e.effects.setMode(i,t.mode||\"hide\"),s=\"hide\"===r,n=parseInt(t.percent,10)||150,o=n/100,d={height:i.height(),width:i.width(),outerHeight:i.outerHeight(),outerWidth:i.outerWidth()};e.extend(t,{effect:\"scale\",queue:!1,fade:!0,mode:r,complete:a,percent:s?n:100,from:s?d:{height:d.height*o,width:d.width*o,outerHeight:d.outerHeight*o,outerWidth:d.outerWidth*o}}),i.effect(t)},e.effects.effect.scale=function(t,a){var i=e(this),r=e.extend(!0,{},t),s=e.effects.setMode(i,t.mode||\"effect\"),n=parseInt(t.percent,10)||(0===parseInt(t.percent,10)?0:\"hide\"===s?0:100),o=t.direction||\"both\",d=t.origin,u={height:i.height(),width:i.width(),outerHeight:i.outerHeight(),outerWidth:i.outerWidth()},l={y:\"horizontal\"!==o?n/100:1,x:\"vertical\"!==o?n/100:1};r.effect=\"size\",r.queue=!1,r.complete=a,\"effect\"!==s&&(r.origin=d||[\"middle\",\"center\"],r.restore=!0),r.from=t.from||(\"show\"===s?{height:0,width:0,outerHeight:0,outerWidth:0}:u),r.to={height:u.height*l.y,width:u.width*l.x,outerHeight:u.outerHeight*l.y,outerWidth:u.outerWidth*l.x},r.fade&&(\"show\"===s&&(r.from.opacity=0,r.to.opacity=1),\"hide\"===s&&(r.from.opacity=1,r.to.opacity=0)),i.effect(r)},e.effects.effect.size=function(t,a){var i,r,s,n=e(this),o=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"width\",\"height\",\"overflow\",\"opacity\"],d=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"overflow\",\"opacity\"],u=[\"width\",\"height\",\"overflow\"],l=[\"fontSize\"],h=[\"borderTopWidth\",\"borderBottomWidth\",\"paddingTop\",\"paddingBottom\"],c=[\"borderLeftWidth\",\"borderRightWidth\",\"paddingLeft\",\"paddingRight\"],m=e.effects.setMode(n,t.mode||\"effect\"),p=t.restore||\"effect\"!==m,f=t.scale||\"both\",g=t.origin||[\"middle\",\"center\"],y=n.css(\"position\"),v=p?o:d,x={height:0,width:0,outerHeight:0,outerWidth:0};\"show\"===m&&n.show(),i={height:n.height(),width:n.width(),outerHeight:n.outerHeight(),outerWidth:n.outerWidth()},\"toggle\"===t.mode&&\"show\"===m?(n.from=t.to||x,n.to=t.from||i):(n.from=t.from||(\"show\"===m?x:i),n.to=t.to||(\"hide\"===m?x:i)),s={from:{y:n.from.height/i.height,x:n.from.width/i.width},to:{y:n.to.height/i.height,x:n.to.width/i.width}},(\"box\"===f||\"both\"===f)&&(s.from.y!==s.to.y&&(v=v.concat(h),n.from=e.effects.setTransition(n,h,s.from.y,n.from),n.to=e.effects.setTransition(n,h,s.to.y,n.to)),s.from.x!==s.to.x&&(v=v.concat(c),n.from=e.effects.setTransition(n,c,s.from.x,n.from),n.to=e.effects.setTransition(n,c,s.to.x,n.to))),(\"content\"===f||\"both\"===f)&&s.from.y!==s.to.y&&(v=v.concat(l).concat(u),n.from=e.effects.setTransition(n,l,s.from.y,n.from),n.to=e.effects.setTransition(n,l,s.to.y,n.to)),e.effects.save(n,v),n.show(),e.effects.createWrapper(n),n.css(\"overflow\",\"hidden\").css(n.from),g&&(r=e.effects.getBaseline(g,i),n.from.top=(i.outerHeight-n.outerHeight())*r.y,n.from.left=(i.outerWidth-n.outerWidth())*r.x,n.to.top=(i.outerHeight-n.to.outerHeight)*r.y,n.to.left=(i.outerWidth-n.to.outerWidth)*r.x),n.css(n.from),(\"content\"===f||\"both\"===f)&&(h=h.concat([\"marginTop\",\"marginBottom\"]).concat(l),c=c.concat([\"marginLeft\",\"marginRight\"]),u=o.concat(h).concat(c),n.find(\"*[width]\").each(function(){var a=e(this),i={height:a.height(),width:a.width(),outerHeight:a.outerHeight(),outerWidth:a.outerWidth()};p&&e.effects.save(a,u),a.from={height:i.height*s.from.y,width:i.width*s.from.x,outerHeight:i.outerHeight*s.from.y,outerWidth:i.outerWidth*s.from.x},a.to={height:i.height*s.to.y,width:i.width*s.to.x,outerHeight:i.height*s.to.y,outerWidth:i.width*s.to.x},s.from.y!==s.to.y&&(a.from=e.effects.setTransition(a,h,s.from.y,a.from),a.to=e.effects.setTransition(a,h,s.to.y,a.to)),s.from.x!==s.to.x&&(a.from=e.effects.setTransition(a,c,s.from.x,a.from),a.to=e.effects.setTransition(a,c,s.to.x,a.to)),a.css(a.from),a.animate(a.to,t.duration,t.easing,function(){p&&e.effects.restore(a,u)})})),n.animate(n.to,{queue:!1,duration:t.duration,easing:t.easing,complete:function(){0===n.to.opacity&&n.css(\"opacity\",n.from.opacity),\"hide\"===m&&n.hide(),e.effects.restore(n,v),p||(\"static\"===y?n.css({position:\"relative\",top:n.to.top,left:n.to.left}):e.each([\"top\",\"left\"],function(e,t){n.css(t,function(t,a){var i=parseInt(a,10),r=e?n.to.left:n.to.top;return\"auto\"===a?r+\"px\":i+r+\"px\"})})),e.effects.removeWrapper(n),a()}})}})(jQuery);(function(e){e.effects.effect.shake=function(t,a){var i,r=e(this),s=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"height\",\"width\"],n=e.effects.setMode(r,t.mode||\"effect\"),o=t.direction||\"left\",d=t.distance||20,u=t.times||3,l=2*u+1,h=Math.round(t.duration/l),c=\"up\"===o||\"down\"===o?\"top\":\"left\",m=\"up\"===o||\"left\"===o,p={},f={},g={},y=r.queue(),v=y.length;for(e.effects.save(r,s),r.show(),e.effects.createWrapper(r),p[c]=(m?\"-=\":\"+=\")+d,f[c]=(m?\"+=\":\"-=\")+2*d,g[c]=(m?\"-=\":\"+=\")+2*d,r.animate(p,h,t.easing),i=1;u>i;i++)r.animate(f,h,t.easing).animate(g,h,t.easing);r.animate(f,h,t.easing).animate(p,h/2,t.easing).queue(function(){\"hide\"===n&&r.hide(),e.effects.restore(r,s),e.effects.removeWrapper(r),a()}),v>1&&y.splice.apply(y,[1,0].concat(y.splice(v,l+1))),r.dequeue()}})(jQuery);(function(e){e.effects.effect.slide=function(t,a){var i,r=e(this),s=[\"position\",\"top\",\"bottom\",\"left\",\"right\",\"width\",\"height\"],n=e.effects.setMode(r,t.mode||\"show\"),o=\"show\"===n,d=t.direction||\"left\",u=\"up\"===d||\"down\"===d?\"top\":\"left\",l=\"up\"===d||\"left\"===d,h={};e.effects.save(r,s),r.show(),i=t.distance||r[\"top\"===u?\"outerHeight\":\"outerWidth\"](!0),e.effects.createWrapper(r).css({overflow:\"hidden\"}),o&&r.css(u,l?isNaN(i)?\"-\"+i:-i:i),h[u]=(o?l?\"+=\":\"-=\":l?\"-=\":\"+=\")+i,r.animate(h,{queue:!1,duration:t.duration,easing:t.easing,complete:function(){\"hide\"===n&&r.hide(),e.effects.restore(r,s),e.effects.removeWrapper(r),a()}})}})(jQuery);(function(e){e.effects.effect.transfer=function(t,a){var i=e(this),r=e(t.to),s=\"fixed\"===r.css(\"position\"),n=e(\"body\"),o=s?n.scrollTop():0,d=s?n.scrollLeft():0,u=r.offset(),l={top:u.top-o,left:u.left-d,height:r.innerHeight(),width:r.innerWidth()},h=i.offset(),c=e(\"<div class='ui-effects-transfer'></div>\").appendTo(document.body).addClass(t.className).css({top:h.top-o,left:h.left-d,height:i.innerHeight(),width:i.innerWidth(),position:s?\"fixed\":\"absolute\"}).animate(l,t.duration,t.easing,function(){c.remove(),a()})}})(jQuery);",
+
+	"static/lib/jquery.min.js": "/*! jQuery v1.10.2 | (c) 2005, 2013 jQuery Foundation, Inc. | jquery.org/license\n//@ sourceMappingURL=jquery-1.10.2.min.map\n*/\n(function(e,t){var n,r,i=typeof t,o=e.location,a=e.document,s=a.documentElement,l=e.jQuery,u=e.$,c={},p=[],f=\"1.10.2\",d=p.concat,h=p.push,g=p.slice,m=p.indexOf,y=c.toString,v=c.hasOwnProperty,b=f.trim,x=function(e,t){return new x.fn.init(e,t,r)},w=/[+-]?(?:\\d*\\.|)\\d+(?:[eE][+-]?\\d+|)/.source,T=/\\S+/g,C=/^[\\s\\uFEFF\\xA0]+|[\\s\\uFEFF\\xA0]+$/g,N=/^(?:\\s*(<[\\w\\W]+>)[^>]*|#([\\w-]*))$/,k=/^<(\\w+)\\s*\\/?>(?:<\\/\\1>|)$/,E=/^[\\],:{}\\s]*$/,S=/(?:^|:|,)(?:\\s*\\[)+/g,A=/\\\\(?:[\"\\\\\\/bfnrt]|u[\\da-fA-F]{4})/g,j=/\"[^\"\\\\\\r\\n]*\"|true|false|null|-?(?:\\d+\\.|)\\d+(?:[eE][+-]?\\d+|)/g,D=/^-ms-/,L=/-([\\da-z])/gi,H=function(e,t){return t.toUpperCase()},q=function(e){(a.addEventListener||\"load\"===e.type||\"complete\"===a.readyState)&&(_(),x.ready())},_=function(){a.addEventListener?(a.removeEventListener(\"DOMContentLoaded\",q,!1),e.removeEventListener(\"load\",q,!1)):(a.detachEvent(\"onreadystatechange\",q),e.detachEvent(\"onload\",q))};x.fn=x.prototype={jquery:f,constructor:x,init:function(e,n,r){var i,o;if(!e)return this;if(\"string\"==typeof e){if(i=\"<\"===e.charAt(0)&&\">\"===e.charAt(e.length-1)&&e.length>=3?[null,e,null]:N.exec(e),!i||!i[1]&&n)return!n||n.jquery?(n||r).find(e):this.constructor(n).find(e);if(i[1]){if(n=n instanceof x?n[0]:n,x.merge(this,x.parseHTML(i[1],n&&n.nodeType?n.ownerDocument||n:a,!0)),k.test(i[1])&&x.isPlainObject(n))for(i in n)x.isFunction(this[i])?this[i](n[i]):this.attr(i,n[i]);return this}if(o=a.getElementById(i[2]),o&&o.parentNode){if(o.id!==i[2])return r.find(e);this.length=1,this[0]=o}return this.context=a,this.selector=e,this}return e.nodeType?(this.context=this[0]=e,this.length=1,this):x.isFunction(e)?r.ready(e):(e.selector!==t&&(this.selector=e.selector,this.context=e.context),x.makeArray(e,this))},selector:\"\",length:0,toArray:function(){return g.call(this)},get:function(e){return null==e?this.toArray():0>e?this[this.length+e]:this[e]},pushStack:function(e){var t=x.merge(this.constructor(),e);return t.prevObject=this,t.context=this.context,t},each:function(e,t){return x.each(this,e,t)},ready:function(e){return x.ready.promise().done(e),this},slice:function(){return this.pushStack(g.apply(this,arguments))},first:function(){return this.eq(0)},last:function(){return this.eq(-1)},eq:function(e){var t=this.length,n=+e+(0>e?t:0);return this.pushStack(n>=0&&t>n?[this[n]]:[])},map:function(e){return this.pushStack(x.map(this,function(t,n){return e.call(t,n,t)}))},end:function(){return this.prevObject||this.constructor(null)},push:h,sort:[].sort,splice:[].splice},x.fn.init.prototype=x.fn,x.extend=x.fn.extend=function(){var e,n,r,i,o,a,s=arguments[0]||{},l=1,u=arguments.length,c=!1;for(\"boolean\"==typeof s&&(c=s,s=arguments[1]||{},l=2),\"object\"==typeof s||x.isFunction(s)||(s={}),u===l&&(s=this,--l);u>l;l++)if(null!=(o=arguments[l]))for(i in o)e=s[i],r=o[i],s!==r&&(c&&r&&(x.isPlainObject(r)||(n=x.isArray(r)))?(n?(n=!1,a=e&&x.isArray(e)?e:[]):a=e&&x.isPlainObject(e)?e:{},s[i]=x.extend(c,a,r)):r!==t&&(s[i]=r));return s},x.extend({expando:\"jQuery\"+(f+Math.random()).replace(/\\D/g,\"\"),noConflict:function(t){return e.$===x&&(e.$=u),t&&e.jQuery===x&&(e.jQuery=l),x},isReady:!1,readyWait:1,holdReady:function(e){e?x.readyWait++:x.ready(!0)},ready:function(e){if(e===!0?!--x.readyWait:!x.isReady){if(!a.body)return setTimeout(x.ready);x.isReady=!0,e!==!0&&--x.readyWait>0||(n.resolveWith(a,[x]),x.fn.trigger&&x(a).trigger(\"ready\").off(\"ready\"))}},isFunction:function(e){return\"function\"===x.type(e)},isArray:Array.isArray||function(e){return\"array\"===x.type(e)},isWindow:function(e){return null!=e&&e==e.window},isNumeric:function(e){return!isNaN(parseFloat(e))&&isFinite(e)},type:function(e){return null==e?e+\"\":\"object\"==typeof e||\"function\"==typeof e?c[y.call(e)]||\"object\":typeof e},isPlainObject:function(e){var n;if(!e||\"object\"!==x.type(e)||e.nodeType||x.isWindow(e))return!1;try{if(e.constructor&&!v.call(e,\"constructor\")&&!v.call(e.constructor.prototype,\"isPrototypeOf\"))return!1}catch(r){return!1}if(x.support.ownLast)for(n in e)return v.call(e,n);for(n in e);return n===t||v.call(e,n)},isEmptyObject:function(e){var t;for(t in e)return!1;return!0},error:function(e){throw Error(e)},parseHTML:function(e,t,n){if(!e||\"string\"!=typeof e)return null;\"boolean\"==typeof t&&(n=t,t=!1),t=t||a;var r=k.exec(e),i=!n&&[];return r?[t.createElement(r[1])]:(r=x.buildFragment([e],t,i),i&&x(i).remove(),x.merge([],r.childNodes))},parseJSON:function(n){return e.JSON&&e.JSON.parse?e.JSON.parse(n):null===n?n:\"string\"==typeof n&&(n=x.trim(n),n&&E.test(n.replace(A,\"@\").replace(j,\"]\").replace(S,\"\")))?Function(\"return \"+n)():(x.error(\"Invalid JSON: \"+n),t)},parseXML:function(n){var r,i;if(!n||\"string\"!=typeof n)return null;try{e.DOMParser?(i=new DOMParser,r=i.parseFromString(n,\"text/xml\")):(r=new ActiveXObject(\"Microsoft.XMLDOM\"),r.async=\"false\",r.loadXML(n))}catch(o){r=t}return r&&r.documentElement&&!r.getElementsByTagName(\"parsererror\").length||x.error(\"Invalid XML: \"+n),r},noop:function(){},globalEval:function(t){t&&x.trim(t)&&(e.execScript||function(t){e.eval.call(e,t)})(t)},camelCase:function(e){return e.replace(D,\"ms-\").replace(L,H)},nodeName:function(e,t){return e.nodeName&&e.nodeName.toLowerCase()===t.toLowerCase()},each:function(e,t,n){var r,i=0,o=e.length,a=M(e);if(n){if(a){for(;o>i;i++)if(r=t.apply(e[i],n),r===!1)break}else for(i in e)if(r=t.apply(e[i],n),r===!1)break}else if(a){for(;o>i;i++)if(r=t.call(e[i],i,e[i]),r===!1)break}else for(i in e)if(r=t.call(e[i],i,e[i]),r===!1)break;return e},trim:b&&!b.call(\"\\ufeff\\u00a0\")?function(e){return null==e?\"\":b.call(e)}:function(e){return null==e?\"\":(e+\"\").replace(C,\"\")},makeArray:function(e,t){var n=t||[];return null!=e&&(M(Object(e))?x.merge(n,\"string\"==typeof e?[e]:e):h.call(n,e)),n},inArray:function(e,t,n){var r;if(t){if(m)return m.call(t,e,n);for(r=t.length,n=n?0>n?Math.max(0,r+n):n:0;r>n;n++)if(n in t&&t[n]===e)return n}return-1},merge:function(e,n){var r=n.length,i=e.length,o=0;if(\"number\"==typeof r)for(;r>o;o++)e[i++]=n[o];else while(n[o]!==t)e[i++]=n[o++];return e.length=i,e},grep:function(e,t,n){var r,i=[],o=0,a=e.length;for(n=!!n;a>o;o++)r=!!t(e[o],o),n!==r&&i.push(e[o]);return i},map:function(e,t,n){var r,i=0,o=e.length,a=M(e),s=[];if(a)for(;o>i;i++)r=t(e[i],i,n),null!=r&&(s[s.length]=r);else for(i in e)r=t(e[i],i,n),null!=r&&(s[s.length]=r);return d.apply([],s)},guid:1,proxy:function(e,n){var r,i,o;return\"string\"==typeof n&&(o=e[n],n=e,e=o),x.isFunction(e)?(r=g.call(arguments,2),i=function(){return e.apply(n||this,r.concat(g.call(arguments)))},i.guid=e.guid=e.guid||x.guid++,i):t},access:function(e,n,r,i,o,a,s){var l=0,u=e.length,c=null==r;if(\"object\"===x.type(r)){o=!0;for(l in r)x.access(e,n,l,r[l],!0,a,s)}else if(i!==t&&(o=!0,x.isFunction(i)||(s=!0),c&&(s?(n.call(e,i),n=null):(c=n,n=function(e,t,n){return c.call(x(e),n)})),n))for(;u>l;l++)n(e[l],r,s?i:i.call(e[l],l,n(e[l],r)));return o?e:c?n.call(e):u?n(e[0],r):a},now:function(){return(new Date).getTime()},swap:function(e,t,n,r){var i,o,a={};for(o in t)a[o]=e.style[o],e.style[o]=t[o];i=n.apply(e,r||[]);for(o in t)e.style[o]=a[o];return i}}),x.ready.promise=function(t){if(!n)if(n=x.Deferred(),\"complete\"===a.readyState)setTimeout(x.ready);else if(a.addEventListener)a.addEventListener(\"DOMContentLoaded\",q,!1),e.addEventListener(\"load\",q,!1);else{a.attachEvent(\"onreadystatechange\",q),e.attachEvent(\"onload\",q);var r=!1;try{r=null==e.frameElement&&a.documentElement}catch(i){}r&&r.doScroll&&function o(){if(!x.isReady){try{r.doScroll(\"left\")}catch(e){return setTimeout(o,50)}_(),x.ready()}}()}return n.promise(t)},x.each(\"Boolean Number String Function Array Date RegExp Object Error\".split(\" \"),function(e,t){c[\"[object \"+t+\"]\"]=t.toLowerCase()});function M(e){var t=e.length,n=x.type(e);return x.isWindow(e)?!1:1===e.nodeType&&t?!0:\"array\"===n||\"function\"!==n&&(0===t||\"number\"==typeof t&&t>0&&t-1 in e)}r=x(a),function(e,t){var n,r,i,o,a,s,l,u,c,p,f,d,h,g,m,y,v,b=\"sizzle\"+-new Date,w=e.document,T=0,C=0,N=st(),k=st(),E=st(),S=!1,A=function(e,t){return e===t?(S=!0,0):0},j=typeof t,D=1<<31,L={}.hasOwnProperty,H=[],q=H.pop,_=H.push,M=H.push,O=H.slice,F=H.indexOf||function(e){var t=0,n=this.length;for(;n>t;t++)if(this[t]===e)return t;return-1},B=\"checked|selected|async|autofocus|autoplay|controls|defer|disabled|hidden|ismap|loop|multiple|open|readonly|required|scoped\",P=\"[\\\\x20\\\\t\\\\r\\\\n\\\\f]\",R=\"(?:\\\\\\\\.|[\\\\w-]|[^\\\\x00-\\\\xa0])+\",W=R.replace(\"w\",\"w#\"),$=\"\\\\[\"+P+\"*(\"+R+\")\"+P+\"*(?:([*^$|!~]?=)\"+P+\"*(?:(['\\\"])((?:\\\\\\\\.|[^\\\\\\\\])*?)\\\\3|(\"+W+\")|)|)\"+P+\"*\\\\]\",I=\":(\"+R+\")(?:\\\\(((['\\\"])((?:\\\\\\\\.|[^\\\\\\\\])*?)\\\\3|((?:\\\\\\\\.|[^\\\\\\\\()[\\\\]]|\"+$.replace(3,8)+\")*)|.*)\\\\)|)\",z=RegExp(\"^\"+P+\"+|((?:^|[^\\\\\\\\])(?:\\\\\\\\.)*)\"+P+\"+$\",\"g\"),X=RegExp(\"^\"+P+\"*,\"+P+\"*\"),U=RegExp(\"^\"+P+\"*([>+~]|\"+P+\")\"+P+\"*\"),V=RegExp(P+\"*[+~]\"),Y=RegExp(\"=\"+P+\"*([^\\\\]'\\\"]*)\"+P+\"*\\\\]\",\"g\"),J=RegExp(I),G=RegExp(\"^\"+W+\"$\"),Q={ID:RegExp(\"^#(\"+R+\")\"),CLASS:RegExp(\"^\\\\.(\"+R+\")\"),TAG:RegExp(\"^(\"+R.replace(\"w\",\"w*\")+\")\"),ATTR:RegExp(\"^\"+$),PSEUDO:RegExp(\"^\"+I),CHILD:RegExp(\"^:(only|first|last|nth|nth-last)-(child|of-type)(?:\\\\(\"+P+\"*(even|odd|(([+-]|)(\\\\d*)n|)\"+P+\"*(?:([+-]|)\"+P+\"*(\\\\d+)|))\"+P+\"*\\\\)|)\",\"i\"),bool:RegExp(\"^(?:\"+B+\")$\",\"i\"),needsContext:RegExp(\"^\"+P+\"*[>+~]|:(even|odd|eq|gt|lt|nth|first|last)(?:\\\\(\"+P+\"*((?:-\\\\d)?\\\\d*)\"+P+\"*\\\\)|)(?=[^-]|$)\",\"i\")},K=/^[^{]+\\{\\s*\\[native \\w/,Z=/^(?:#([\\w-]+)|(\\w+)|\\.([\\w-]+))$/,et=/^(?:input|select|textarea|button)$/i,tt=/^h\\d$/i,nt=/'|\\\\/g,rt=RegExp(\"\\\\\\\\([\\\\da-f]{1,6}\"+P+\"?|(\"+P+\")|.)\",\"ig\"),it=function(e,t,n){var r=\"0x\"+t-65536;return r!==r||n?t:0>r?String.fromCharCode(r+65536):String.fromCharCode(55296|r>>10,56320|1023&r)};try{M.apply(H=O.call(w.childNodes),w.childNodes),H[w.childNodes.length].nodeType}catch(ot){M={apply:H.length?function(e,t){_.apply(e,O.call(t))}:function(e,t){var n=e.length,r=0;while(e[n++]=t[r++]);e.length=n-1}}}function at(e,t,n,i){var o,a,s,l,u,c,d,m,y,x;if((t?t.ownerDocument||t:w)!==f&&p(t),t=t||f,n=n||[],!e||\"string\"!=typeof e)return n;if(1!==(l=t.nodeType)&&9!==l)return[];if(h&&!i){if(o=Z.exec(e))if(s=o[1]){if(9===l){if(a=t.getElementById(s),!a||!a.parentNode)return n;if(a.id===s)return n.push(a),n}else if(t.ownerDocument&&(a=t.ownerDocument.getElementById(s))&&v(t,a)&&a.id===s)return n.push(a),n}else{if(o[2])return M.apply(n,t.getElementsByTagName(e)),n;if((s=o[3])&&r.getElementsByClassName&&t.getElementsByClassName)return M.apply(n,t.getElementsByClassName(s)),n}if(r.qsa&&(!g||!g.test(e))){if(m=d=b,y=t,x=9===l&&e,1===l&&\"object\"!==t.nodeName.toLowerCase()){c=mt(e),(d=t.getAttribute(\"id\"))?m=d.replace(nt,\"\\\\$&\"):t.setAttribute(\"id\",m),m=\"[id='\"+m+\"'] \",u=c.length;while(u--)c[u]=m+yt(c[u]);y=V.test(e)&&t.parentNode||t,x=c.join(\",\")}if(x)try{return M.apply(n,y.querySelectorAll(x)),n}catch(T){}finally{d||t.removeAttribute(\"id\")}}}return kt(e.replace(z,\"$1\"),t,n,i)}function st(){var e=[];function t(n,r){return e.push(n+=\" \")>o.cacheLength&&delete t[e.shift()],t[n]=r}return t}function lt(e){return e[b]=!0,e}function ut(e){var t=f.createElement(\"div\");try{return!!e(t)}catch(n){return!1}finally{t.parentNode&&t.parentNode.removeChild(t),t=null}}function ct(e,t){var n=e.split(\"|\"),r=e.length;while(r--)o.attrHandle[n[r]]=t}function pt(e,t){var n=t&&e,r=n&&1===e.nodeType&&1===t.nodeType&&(~t.sourceIndex||D)-(~e.sourceIndex||D);if(r)return r;if(n)while(n=n.nextSibling)if(n===t)return-1;return e?1:-1}function ft(e){return function(t){var n=t.nodeName.toLowerCase();return\"input\"===n&&t.type===e}}function dt(e){return function(t){var n=t.nodeName.toLowerCase();return(\"input\"===n||\"button\"===n)&&t.type===e}}function ht(e){return lt(function(t){return t=+t,lt(function(n,r){var i,o=e([],n.length,t),a=o.length;while(a--)n[i=o[a]]&&(n[i]=!(r[i]=n[i]))})})}s=at.isXML=function(e){var t=e&&(e.ownerDocument||e).documentElement;return t?\"HTML\"!==t.nodeName:!1},r=at.support={},p=at.setDocument=function(e){var n=e?e.ownerDocument||e:w,i=n.defaultView;return n!==f&&9===n.nodeType&&n.documentElement?(f=n,d=n.documentElement,h=!s(n),i&&i.attachEvent&&i!==i.top&&i.attachEvent(\"onbeforeunload\",function(){p()}),r.attributes=ut(function(e){return e.className=\"i\",!e.getAttribute(\"className\")}),r.getElementsByTagName=ut(function(e){return e.appendChild(n.createComment(\"\")),!e.getElementsByTagName(\"*\").length}),r.getElementsByClassName=ut(function(e){return e.innerHTML=\"<div class='a'></div><div class='a i'></div>\",e.firstChild.className=\"i\",2===e.getElementsByClassName(\"i\").length}),r.getById=ut(function(e){return d.appendChild(e).id=b,!n.getElementsByName||!n.getElementsByName(b).length}),r.getById?(o.find.ID=function(e,t){if(typeof t.getElementById!==j&&h){var n=t.getElementById(e);return n&&n.parentNode?[n]:[]}},o.filter.ID=function(e){var t=e.replace(rt,it);return function(e){return e.getAttribute(\"id\")===t}}):(delete o.find.ID,o.filter.ID=function(e){var t=e.replace(rt,it);return function(e){var n=typeof e.getAttributeNode!==j&&e.getAttributeNode(\"id\");return n&&n.value===t}}),o.find.TAG=r.getElementsByTagName?function(e,n){return typeof n.getElementsByTagName!==j?n.getElementsByTagName(e):t}:function(e,t){var n,r=[],i=0,o=t.getElementsByTagName(e);if(\"*\"===e){while(n=o[i++])1===n.nodeType&&r.push(n);return r}return o},o.find.CLASS=r.getElementsByClassName&&function(e,n){return typeof n.getElementsByClassName!==j&&h?n.getElementsByClassName(e):t},m=[],g=[],(r.qsa=K.test(n.querySelectorAll))&&(ut(function(e){e.innerHTML=\"<select><option selected=''></option></select>\",e.querySelectorAll(\"[selected]\").length||g.push(\"\\\\[\"+P+\"*(?:value|\"+B+\")\"),e.querySelectorAll(\":checked\").length||g.push(\":checked\")}),ut(function(e){var t=n.createElement(\"input\");t.setAttribute(\"type\",\"hidden\"),e.appendChild(t).setAttribute(\"t\",\"\"),e.querySelectorAll(\"[t^='']\").length&&g.push(\"[*^$]=\"+P+\"*(?:''|\\\"\\\")\"),e.querySelectorAll(\":enabled\").length||g.push(\":enabled\",\":disabled\"),e.querySelectorAll(\"*,:x\"),g.push(\",.*:\")})),(r.matchesSelector=K.test(y=d.webkitMatchesSelector||d.mozMatchesSelector||d.oMatchesSelector||d.msMatchesSelector))&&ut(function(e){r.disconnectedMatch=y.call(e,\"div\"),y.call(e,\"[s!='']:x\"),m.push(\"!=\",I)}),g=g.length&&RegExp(g.join(\"|\")),m=m.length&&RegExp(m.join(\"|\")),v=K.test(d.contains)||d.compareDocumentPosition?function(e,t){var n=9===e.nodeType?e.documentElement:e,r=t&&t.parentNode;return e===r||!(!r||1!==r.nodeType||!(n.contains?n.contains(r):e.compareDocumentPosition&&16&e.compareDocumentPosition(r)))}:function(e,t){if(t)while(t=t.parentNode)if(t===e)return!0;return!1},A=d.compareDocumentPosition?function(e,t){if(e===t)return S=!0,0;var i=t.compareDocumentPosition&&e.compareDocumentPosition&&e.compareDocumentPosition(t);return i?1&i||!r.sortDetached&&t.compareDocumentPosition(e)===i?e===n||v(w,e)?-1:t===n||v(w,t)?1:c?F.call(c,e)-F.call(c,t):0:4&i?-1:1:e.compareDocumentPosition?-1:1}:function(e,t){var r,i=0,o=e.parentNode,a=t.parentNode,s=[e],l=[t];if(e===t)return S=!0,0;if(!o||!a)return e===n?-1:t===n?1:o?-1:a?1:c?F.call(c,e)-F.call(c,t):0;if(o===a)return pt(e,t);r=e;while(r=r.parentNode)s.unshift(r);r=t;while(r=r.parentNode)l.unshift(r);while(s[i]===l[i])i++;return i?pt(s[i],l[i]):s[i]===w?-1:l[i]===w?1:0},n):f},at.matches=function(e,t){return at(e,null,null,t)},at.matchesSelector=function(e,t){if((e.ownerDocument||e)!==f&&p(e),t=t.replace(Y,\"='$1']\"),!(!r.matchesSelector||!h||m&&m.test(t)||g&&g.test(t)))try{var n=y.call(e,t);if(n||r.disconnectedMatch||e.document&&11!==e.document.nodeType)return n}catch(i){}return at(t,f,null,[e]).length>0},at.contains=function(e,t){return(e.ownerDocument||e)!==f&&p(e),v(e,t)},at.attr=function(e,n){(e.ownerDocument||e)!==f&&p(e);var i=o.attrHandle[n.toLowerCase()],a=i&&L.call(o.attrHandle,n.toLowerCase())?i(e,n,!h):t;return a===t?r.attributes||!h?e.getAttribute(n):(a=e.getAttributeNode(n))&&a.specified?a.value:null:a},at.error=function(e){throw Error(\"Syntax error, unrecognized expression: \"+e)},at.uniqueSort=function(e){var t,n=[],i=0,o=0;if(S=!r.detectDuplicates,c=!r.sortStable&&e.slice(0),e.sort(A),S){while(t=e[o++])t===e[o]&&(i=n.push(o));while(i--)e.splice(n[i],1)}return e},a=at.getText=function(e){var t,n=\"\",r=0,i=e.nodeType;if(i){if(1===i||9===i||11===i){if(\"string\"==typeof e.textContent)return e.textContent;for(e=e.firstChild;e;e=e.nextSibling)n+=a(e)}else if(3===i||4===i)return e.nodeValue}else for(;t=e[r];r++)n+=a(t);return n},o=at.selectors={cacheLength:50,createPseudo:lt,match:Q,attrHandle:{},find:{},relative:{\">\":{dir:\"parentNode\",first:!0},\" \":{dir:\"parentNode\"},\"+\":{dir:\"previousSibling\",first:!0},\"~\":{dir:\"previousSibling\"}},preFilter:{ATTR:function(e){return e[1]=e[1].replace(rt,it),e[3]=(e[4]||e[5]||\"\").replace(rt,it),\"~=\"===e[2]&&(e[3]=\" \"+e[3]+\" \"),e.slice(0,4)},CHILD:function(e){return e[1]=e[1].toLowerCase(),\"nth\"===e[1].slice(0,3)?(e[3]||at.error(e[0]),e[4]=+(e[4]?e[5]+(e[6]||1):2*(\"even\"===e[3]||\"odd\"===e[3])),e[5]=+(e[7]+e[8]||\"odd\"===e[3])):e[3]&&at.error(e[0]),e},PSEUDO:function(e){var n,r=!e[5]&&e[2];return Q.CHILD.test(e[0])?null:(e[3]&&e[4]!==t?e[2]=e[4]:r&&J.test(r)&&(n=mt(r,!0))&&(n=r.indexOf(\")\",r.length-n)-r.length)&&(e[0]=e[0].slice(0,n),e[2]=r.slice(0,n)),e.slice(0,3))}},filter:{TAG:function(e){var t=e.replace(rt,it).toLowerCase();return\"*\"===e?function(){return!0}:function(e){return e.nodeName&&e.nodeName.toLowerCase()===t}},CLASS:function(e){var t=N[e+\" \"];return t||(t=RegExp(\"(^|\"+P+\")\"+e+\"(\"+P+\"|$)\"))&&N(e,function(e){return t.test(\"string\"==typeof e.className&&e.className||typeof e.getAttribute!==j&&e.getAttribute(\"class\")||\"\")})},ATTR:function(e,t,n){return function(r){var i=at.attr(r,e);return null==i?\"!=\"===t:t?(i+=\"\",\"=\"===t?i===n:\"!=\"===t?i!==n:\"^=\"===t?n&&0===i.indexOf(n):\"*=\"===t?n&&i.indexOf(n)>-1:\"$=\"===t?n&&i.slice(-n.length)===n:\"~=\"===t?(\" \"+i+\" \").indexOf(n)>-1:\"|=\"===t?i===n||i.slice(0,n.length+1)===n+\"-\":!1):!0}},CHILD:function(e,t,n,r,i){var o=\"nth\"!==e.slice(0,3),a=\"last\"!==e.slice(-4),s=\"of-type\"===t;return 1===r&&0===i?function(e){return!!e.parentNode}:function(t,n,l){var u,c,p,f,d,h,g=o!==a?\"nextSibling\":\"previousSibling\",m=t.parentNode,y=s&&t.nodeName.toLowerCase(),v=!l&&!s;if(m){if(o){while(g){p=t;while(p=p[g])if(s?p.nodeName.toLowerCase()===y:1===p.nodeType)return!1;h=g=\"only\"===e&&!h&&\"nextSibling\"}return!0}if(h=[a?m.firstChild:m.lastChild],a&&v){c=m[b]||(m[b]={}),u=c[e]||[],d=u[0]===T&&u[1],f=u[0]===T&&u[2],p=d&&m.childNodes[d];while(p=++d&&p&&p[g]||(f=d=0)||h.pop())if(1===p.nodeType&&++f&&p===t){c[e]=[T,d,f];break}}else if(v&&(u=(t[b]||(t[b]={}))[e])&&u[0]===T)f=u[1];else while(p=++d&&p&&p[g]||(f=d=0)||h.pop())if((s?p.nodeName.toLowerCase()===y:1===p.nodeType)&&++f&&(v&&((p[b]||(p[b]={}))[e]=[T,f]),p===t))break;return f-=i,f===r||0===f%r&&f/r>=0}}},PSEUDO:function(e,t){var n,r=o.pseudos[e]||o.setFilters[e.toLowerCase()]||at.error(\"unsupported pseudo: \"+e);return r[b]?r(t):r.length>1?(n=[e,e,\"\",t],o.setFilters.hasOwnProperty(e.toLowerCase())?lt(function(e,n){var i,o=r(e,t),a=o.length;while(a--)i=F.call(e,o[a]),e[i]=!(n[i]=o[a])}):function(e){return r(e,0,n)}):r}},pseudos:{not:lt(function(e){var t=[],n=[],r=l(e.replace(z,\"$1\"));return r[b]?lt(function(e,t,n,i){var o,a=r(e,null,i,[]),s=e.length;while(s--)(o=a[s])&&(e[s]=!(t[s]=o))}):function(e,i,o){return t[0]=e,r(t,null,o,n),!n.pop()}}),has:lt(function(e){return function(t){return at(e,t).length>0}}),contains:lt(function(e){return function(t){return(t.textContent||t.innerText||a(t)).indexOf(e)>-1}}),lang:lt(function(e){return G.test(e||\"\")||at.error(\"unsupported lang: \"+e),e=e.replace(rt,it).toLowerCase(),function(t){var n;do if(n=h?t.lang:t.getAttribute(\"xml:lang\")||t.getAttribute(\"lang\"))return n=n.toLowerCase(),n===e||0===n.indexOf(e+\"-\");while((t=t.parentNode)&&1===t.nodeType);return!1}}),target:function(t){var n=e.location&&e.location.hash;return n&&n.slice(1)===t.id},root:function(e){return e===d},focus:function(e){return e===f.activeElement&&(!f.hasFocus||f.hasFocus())&&!!(e.type||e.href||~e.tabIndex)},enabled:function(e){return e.disabled===!1},disabled:function(e){return e.disabled===!0},checked:function(e){var t=e.nodeName.toLowerCase();return\"input\"===t&&!!e.checked||\"option\"===t&&!!e.selected},selected:function(e){return e.parentNode&&e.parentNode.selectedIndex,e.selected===!0},empty:function(e){for(e=e.firstChild;e;e=e.nextSibling)if(e.nodeName>\"@\"||3===e.nodeType||4===e.nodeType)return!1;return!0},parent:function(e){return!o.pseudos.empty(e)},header:function(e){return tt.test(e.nodeName)},input:function(e){return et.test(e.nodeName)},button:function(e){var t=e.nodeName.toLowerCase();return\"input\"===t&&\"button\"===e.type||\"button\"===t},text:function(e){var t;return\"input\"===e.nodeName.toLowerCase()&&\"text\"===e.type&&(null==(t=e.getAttribute(\"type\"))||t.toLowerCase()===e.type)},first:ht(function(){return[0]}),last:ht(function(e,t){return[t-1]}),eq:ht(function(e,t,n){return[0>n?n+t:n]}),even:ht(function(e,t){var n=0;for(;t>n;n+=2)e.push(n);return e}),odd:ht(function(e,t){var n=1;for(;t>n;n+=2)e.push(n);return e}),lt:ht(function(e,t,n){var r=0>n?n+t:n;for(;--r>=0;)e.push(r);return e}),gt:ht(function(e,t,n){var r=0>n?n+t:n;for(;t>++r;)e.push(r);return e})}},o.pseudos.nth=o.pseudos.eq;for(n in{radio:!0,checkbox:!0,file:!0,password:!0,image:!0})o.pseudos[n]=ft(n);for(n in{submit:!0,reset:!0})o.pseudos[n]=dt(n);function gt(){}gt.prototype=o.filters=o.pseudos,o.setFilters=new gt;function mt(e,t){var n,r,i,a,s,l,u,c=k[e+\" \"];if(c)return t?0:c.slice(0);s=e,l=[],u=o.preFilter;while(s){(!n||(r=X.exec(s)))&&(r&&(s=s.slice(r[0].length)||s),l.push(i=[])),n=!1,(r=U.exec(s))&&(n=r.shift(),i.push({value:n,type:r[0].replace(z,\" \")}),s=s.slice(n.length));for(a in o.filter)!(r=Q[a].exec(s))||u[a]&&!(r=u[a](r))||(n=r.shift(),i.push({value:n,type:a,matches:r}),s=s.slice(n.length));if(!n)break}return t?s.length:s?at.error(e):k(e,l).slice(0)}function yt(e){var t=0,n=e.length,r=\"\";for(;n>t;t++)r+=e[t].value;return r}function vt(e,t,n){var r=t.dir,o=n&&\"parentNode\"===r,a=C++;return t.first?function(t,n,i){while(t=t[r])if(1===t.nodeType||o)return e(t,n,i)}:function(t,n,s){var l,u,c,p=T+\" \"+a;if(s){while(t=t[r])if((1===t.nodeType||o)&&e(t,n,s))return!0}else while(t=t[r])if(1===t.nodeType||o)if(c=t[b]||(t[b]={}),(u=c[r])&&u[0]===p){if((l=u[1])===!0||l===i)return l===!0}else if(u=c[r]=[p],u[1]=e(t,n,s)||i,u[1]===!0)return!0}}function bt(e){return e.length>1?function(t,n,r){var i=e.length;while(i--)if(!e[i](t,n,r))return!1;return!0}:e[0]}function xt(e,t,n,r,i){var o,a=[],s=0,l=e.length,u=null!=t;for(;l>s;s++)(o=e[s])&&(!n||n(o,r,i))&&(a.push(o),u&&t.push(s));return a}function wt(e,t,n,r,i,o){return r&&!r[b]&&(r=wt(r)),i&&!i[b]&&(i=wt(i,o)),lt(function(o,a,s,l){var u,c,p,f=[],d=[],h=a.length,g=o||Nt(t||\"*\",s.nodeType?[s]:s,[]),m=!e||!o&&t?g:xt(g,f,e,s,l),y=n?i||(o?e:h||r)?[]:a:m;if(n&&n(m,y,s,l),r){u=xt(y,d),r(u,[],s,l),c=u.length;while(c--)(p=u[c])&&(y[d[c]]=!(m[d[c]]=p))}if(o){if(i||e){if(i){u=[],c=y.length;while(c--)(p=y[c])&&u.push(m[c]=p);i(null,y=[],u,l)}c=y.length;while(c--)(p=y[c])&&(u=i?F.call(o,p):f[c])>-1&&(o[u]=!(a[u]=p))}}else y=xt(y===a?y.splice(h,y.length):y),i?i(null,a,y,l):M.apply(a,y)})}function Tt(e){var t,n,r,i=e.length,a=o.relative[e[0].type],s=a||o.relative[\" \"],l=a?1:0,c=vt(function(e){return e===t},s,!0),p=vt(function(e){return F.call(t,e)>-1},s,!0),f=[function(e,n,r){return!a&&(r||n!==u)||((t=n).nodeType?c(e,n,r):p(e,n,r))}];for(;i>l;l++)if(n=o.relative[e[l].type])f=[vt(bt(f),n)];else{if(n=o.filter[e[l].type].apply(null,e[l].matches),n[b]){for(r=++l;i>r;r++)if(o.relative[e[r].type])break;return wt(l>1&&bt(f),l>1&&yt(e.slice(0,l-1).concat({value:\" \"===e[l-2].type?\"*\":\"\"})).replace(z,\"$1\"),n,r>l&&Tt(e.slice(l,r)),i>r&&Tt(e=e.slice(r)),i>r&&yt(e))}f.push(n)}return bt(f)}function Ct(e,t){var n=0,r=t.length>0,a=e.length>0,s=function(s,l,c,p,d){var h,g,m,y=[],v=0,b=\"0\",x=s&&[],w=null!=d,C=u,N=s||a&&o.find.TAG(\"*\",d&&l.parentNode||l),k=T+=null==C?1:Math.random()||.1;for(w&&(u=l!==f&&l,i=n);null!=(h=N[b]);b++){if(a&&h){g=0;while(m=e[g++])if(m(h,l,c)){p.push(h);break}w&&(T=k,i=++n)}r&&((h=!m&&h)&&v--,s&&x.push(h))}if(v+=b,r&&b!==v){g=0;while(m=t[g++])m(x,y,l,c);if(s){if(v>0)while(b--)x[b]||y[b]||(y[b]=q.call(p));y=xt(y)}M.apply(p,y),w&&!s&&y.length>0&&v+t.length>1&&at.uniqueSort(p)}return w&&(T=k,u=C),x};return r?lt(s):s}l=at.compile=function(e,t){var n,r=[],i=[],o=E[e+\" \"];if(!o){t||(t=mt(e)),n=t.length;while(n--)o=Tt(t[n]),o[b]?r.push(o):i.push(o);o=E(e,Ct(i,r))}return o};function Nt(e,t,n){var r=0,i=t.length;for(;i>r;r++)at(e,t[r],n);return n}function kt(e,t,n,i){var a,s,u,c,p,f=mt(e);if(!i&&1===f.length){if(s=f[0]=f[0].slice(0),s.length>2&&\"ID\"===(u=s[0]).type&&r.getById&&9===t.nodeType&&h&&o.relative[s[1].type]){if(t=(o.find.ID(u.matches[0].replace(rt,it),t)||[])[0],!t)return n;e=e.slice(s.shift().value.length)}a=Q.needsContext.test(e)?0:s.length;while(a--){if(u=s[a],o.relative[c=u.type])break;if((p=o.find[c])&&(i=p(u.matches[0].replace(rt,it),V.test(s[0].type)&&t.parentNode||t))){if(s.splice(a,1),e=i.length&&yt(s),!e)return M.apply(n,i),n;break}}}return l(e,f)(i,t,!h,n,V.test(e)),n}r.sortStable=b.split(\"\").sort(A).join(\"\")===b,r.detectDuplicates=S,p(),r.sortDetached=ut(function(e){return 1&e.compareDocumentPosition(f.createElement(\"div\"))}),ut(function(e){return e.innerHTML=\"<a href='#'></a>\",\"#\"===e.firstChild.getAttribute(\"href\")})||ct(\"type|href|height|width\",function(e,n,r){return r?t:e.getAttribute(n,\"type\"===n.toLowerCase()?1:2)}),r.attributes&&ut(function(e){return e.innerHTML=\"<input/>\",e.firstChild.setAttribute(\"value\",\"\"),\"\"===e.firstChild.getAttribute(\"value\")})||ct(\"value\",function(e,n,r){return r||\"input\"!==e.nodeName.toLowerCase()?t:e.defaultValue}),ut(function(e){return null==e.getAttribute(\"disabled\")})||ct(B,function(e,n,r){var i;return r?t:(i=e.getAttributeNode(n))&&i.specified?i.value:e[n]===!0?n.toLowerCase():null}),x.find=at,x.expr=at.selectors,x.expr[\":\"]=x.expr.pseudos,x.unique=at.uniqueSort,x.text=at.getText,x.isXMLDoc=at.isXML,x.contains=at.contains}(e);var O={};function F(e){var t=O[e]={};return x.each(e.match(T)||[],function(e,n){t[n]=!0}),t}x.Callbacks=function(e){e=\"string\"==typeof e?O[e]||F(e):x.extend({},e);var n,r,i,o,a,s,l=[],u=!e.once&&[],c=function(t){for(r=e.memory&&t,i=!0,a=s||0,s=0,o=l.length,n=!0;l&&o>a;a++)if(l[a].apply(t[0],t[1])===!1&&e.stopOnFalse){r=!1;break}n=!1,l&&(u?u.length&&c(u.shift()):r?l=[]:p.disable())},p={add:function(){if(l){var t=l.length;(function i(t){x.each(t,function(t,n){var r=x.type(n);\"function\"===r?e.unique&&p.has(n)||l.push(n):n&&n.length&&\"string\"!==r&&i(n)})})(arguments),n?o=l.length:r&&(s=t,c(r))}return this},remove:function(){return l&&x.each(arguments,function(e,t){var r;while((r=x.inArray(t,l,r))>-1)l.splice(r,1),n&&(o>=r&&o--,a>=r&&a--)}),this},has:function(e){return e?x.inArray(e,l)>-1:!(!l||!l.length)},empty:function(){return l=[],o=0,this},disable:function(){return l=u=r=t,this},disabled:function(){return!l},lock:function(){return u=t,r||p.disable(),this},locked:function(){return!u},fireWith:function(e,t){return!l||i&&!u||(t=t||[],t=[e,t.slice?t.slice():t],n?u.push(t):c(t)),this},fire:function(){return p.fireWith(this,arguments),this},fired:function(){return!!i}};return p},x.extend({Deferred:function(e){var t=[[\"resolve\",\"done\",x.Callbacks(\"once memory\"),\"resolved\"],[\"reject\",\"fail\",x.Callbacks(\"once memory\"),\"rejected\"],[\"notify\",\"progress\",x.Callbacks(\"memory\")]],n=\"pending\",r={state:function(){return n},always:function(){return i.done(arguments).fail(arguments),this},then:function(){var e=arguments;return x.Deferred(function(n){x.each(t,function(t,o){var a=o[0],s=x.isFunction(e[t])&&e[t];i[o[1]](function(){var e=s&&s.apply(this,arguments);e&&x.isFunction(e.promise)?e.promise().done(n.resolve).fail(n.reject).progress(n.notify):n[a+\"With\"](this===r?n.promise():this,s?[e]:arguments)})}),e=null}).promise()},promise:function(e){return null!=e?x.extend(e,r):r}},i={};return r.pipe=r.then,x.each(t,function(e,o){var a=o[2],s=o[3];r[o[1]]=a.add,s&&a.add(function(){n=s},t[1^e][2].disable,t[2][2].lock),i[o[0]]=function(){return i[o[0]+\"With\"](this===i?r:this,arguments),this},i[o[0]+\"With\"]=a.fireWith}),r.promise(i),e&&e.call(i,i),i},when:function(e){var t=0,n=g.call(arguments),r=n.length,i=1!==r||e&&x.isFunction(e.promise)?r:0,o=1===i?e:x.Deferred(),a=function(e,t,n){return function(r){t[e]=this,n[e]=arguments.length>1?g.call(arguments):r,n===s?o.notifyWith(t,n):--i||o.resolveWith(t,n)}},s,l,u;if(r>1)for(s=Array(r),l=Array(r),u=Array(r);r>t;t++)n[t]&&x.isFunction(n[t].promise)?n[t].promise().done(a(t,u,n)).fail(o.reject).progress(a(t,l,s)):--i;return i||o.resolveWith(u,n),o.promise()}}),x.support=function(t){var n,r,o,s,l,u,c,p,f,d=a.createElement(\"div\");if(d.setAttribute(\"className\",\"t\"),d.innerHTML=\"  <link/><table></table><a href='/a'>a</a><input type='checkbox'/>\",n=d.getElementsByTagName(\"*\")||[],r=d.getElementsByTagName(\"a\")[0],!r||!r.style||!n.length)return t;s=a.createElement(\"select\"),u=s.appendChild(a.createElement(\"option\")),o=d.getElementsByTagName(\"input\")[0],r.style.cssText=\"top:1px;float:left;opacity:.5\",t.getSetAttribute=\"t\"!==d.className,t.leadingWhitespace=3===d.firstChild.nodeType,t.tbody=!d.getElementsByTagName(\"tbody\").length,t.htmlSerialize=!!d.getElementsByTagName(\"link\").length,t.style=/top/.test(r.getAttribute(\"style\")),t.hrefNormalized=\"/a\"===r.getAttribute(\"href\"),t.opacity=/^0.5/.test(r.style.opacity),t.cssFloat=!!r.style.cssFloat,t.checkOn=!!o.value,t.optSelected=u.selected,t.enctype=!!a.createElement(\"form\").enctype,t.html5Clone=\"<:nav></:nav>\"!==a.createElement(\"nav\").cloneNode(!0).outerHTML,t.inlineBlockNeedsLayout=!1,t.shrinkWrapBlocks=!1,t.pixelPosition=!1,t.deleteExpando=!0,t.noCloneEvent=!0,t.reliableMarginRight=!0,t.boxSizingReliable=!0,o.checked=!0,t.noCloneChecked=o.cloneNode(!0).checked,s.disabled=!0,t.optDisabled=!u.disabled;try{delete d.test}catch(h){t.deleteExpando=!1}o=a.createElement(\"input\"),o.setAttribute(\"value\",\"\"),t.input=\"\"===o.getAttribute(\"value\"),o.value=\"t\",o.setAttribute(\"type\",\"radio\"),t.radioValue=\"t\"===o.value,o.setAttribute(\"checked\",\"t\"),o.setAttribute(\"name\",\"t\"),l=a.createDocumentFragment(),l.appendChild(o),t.appendChecked=o.checked,t.checkClone=l.cloneNode(!0).cloneNode(!0).lastChild.checked,d.attachEvent&&(d.attachEvent(\"onclick\",function(){t.noCloneEvent=!1}),d.cloneNode(!0).click());for(f in{submit:!0,change:!0,focusin:!0})d.setAttribute(c=\"on\"+f,\"t\"),t[f+\"Bubbles\"]=c in e||d.attributes[c].expando===!1;d.style.backgroundClip=\"content-box\",d.cloneNode(!0).style.backgroundClip=\"\",t.clearCloneStyle=\"content-box\"===d.style.backgroundClip;for(f in x(t))break;return t.ownLast=\"0\"!==f,x(function(){var n,r,o,s=\"padding:0;margin:0;border:0;display:block;box-sizing:content-box;-moz-box-sizing:content-box;-webkit-box-sizing:content-box;\",l=a.getElementsByTagName(\"body\")[0];l&&(n=a.createElement(\"div\"),n.style.cssText=\"border:0;width:0;height:0;position:absolute;top:0;left:-9999px;margin-top:1px\",l.appendChild(n).appendChild(d),d.innerHTML=\"<table><tr><td></td><td>t</td></tr></table>\",o=d.getElementsByTagName(\"td\"),o[0].style.cssText=\"padding:0;margin:0;border:0;display:none\",p=0===o[0].offsetHeight,o[0].style.display=\"\",o[1].style.display=\"none\",t.reliableHiddenOffsets=p&&0===o[0].offsetHeight,d.innerHTML=\"\",d.style.cssText=\"box-sizing:border-box;-moz-box-sizing:border-box;-webkit-box-sizing:border-box;padding:1px;border:1px;display:block;width:4px;margin-top:1%;position:absolute;top:1%;\",x.swap(l,null!=l.style.zoom?{zoom:1}:{},function(){t.boxSizing=4===d.offsetWidth}),e.getComputedStyle&&(t.pixelPosition=\"1%\"!==(e.getComputedStyle(d,null)||{}).top,t.boxSizingReliable=\"4px\"===(e.getComputedStyle(d,null)||{width:\"4px\"}).width,r=d.appendChild(a.createElement(\"div\")),r.style.cssText=d.style.cssText=s,r.style.marginRight=r.style.width=\"0\",d.style.width=\"1px\",t.reliableMarginRight=!parseFloat((e.getComputedStyle(r,null)||{}).marginRight)),typeof d.style.zoom!==i&&(d.innerHTML=\"\",d.style.cssText=s+\"width:1px;padding:1px;display:inline;zoom:1\",t.inlineBlockNeedsLayout=3===d.offsetWidth,d.style.display=\"block\",d.innerHTML=\"<div></div>\",d.firstChild.style.width=\"5px\",t.shrinkWrapBlocks=3!==d.offsetWidth,t.inlineBlockNeedsLayout&&(l.style.zoom=1)),l.removeChild(n),n=d=o=r=null)}),n=s=l=u=r=o=null,t\n}({});var B=/(?:\\{[\\s\\S]*\\}|\\[[\\s\\S]*\\])$/,P=/([A-Z])/g;function R(e,n,r,i){if(x.acceptData(e)){var o,a,s=x.expando,l=e.nodeType,u=l?x.cache:e,c=l?e[s]:e[s]&&s;if(c&&u[c]&&(i||u[c].data)||r!==t||\"string\"!=typeof n)return c||(c=l?e[s]=p.pop()||x.guid++:s),u[c]||(u[c]=l?{}:{toJSON:x.noop}),(\"object\"==typeof n||\"function\"==typeof n)&&(i?u[c]=x.extend(u[c],n):u[c].data=x.extend(u[c].data,n)),a=u[c],i||(a.data||(a.data={}),a=a.data),r!==t&&(a[x.camelCase(n)]=r),\"string\"==typeof n?(o=a[n],null==o&&(o=a[x.camelCase(n)])):o=a,o}}function W(e,t,n){if(x.acceptData(e)){var r,i,o=e.nodeType,a=o?x.cache:e,s=o?e[x.expando]:x.expando;if(a[s]){if(t&&(r=n?a[s]:a[s].data)){x.isArray(t)?t=t.concat(x.map(t,x.camelCase)):t in r?t=[t]:(t=x.camelCase(t),t=t in r?[t]:t.split(\" \")),i=t.length;while(i--)delete r[t[i]];if(n?!I(r):!x.isEmptyObject(r))return}(n||(delete a[s].data,I(a[s])))&&(o?x.cleanData([e],!0):x.support.deleteExpando||a!=a.window?delete a[s]:a[s]=null)}}}x.extend({cache:{},noData:{applet:!0,embed:!0,object:\"clsid:D27CDB6E-AE6D-11cf-96B8-444553540000\"},hasData:function(e){return e=e.nodeType?x.cache[e[x.expando]]:e[x.expando],!!e&&!I(e)},data:function(e,t,n){return R(e,t,n)},removeData:function(e,t){return W(e,t)},_data:function(e,t,n){return R(e,t,n,!0)},_removeData:function(e,t){return W(e,t,!0)},acceptData:function(e){if(e.nodeType&&1!==e.nodeType&&9!==e.nodeType)return!1;var t=e.nodeName&&x.noData[e.nodeName.toLowerCase()];return!t||t!==!0&&e.getAttribute(\"classid\")===t}}),x.fn.extend({data:function(e,n){var r,i,o=null,a=0,s=this[0];if(e===t){if(this.length&&(o=x.data(s),1===s.nodeType&&!x._data(s,\"parsedAttrs\"))){for(r=s.attributes;r.length>a;a++)i=r[a].name,0===i.indexOf(\"data-\")&&(i=x.camelCase(i.slice(5)),$(s,i,o[i]));x._data(s,\"parsedAttrs\",!0)}return o}return\"object\"==typeof e?this.each(function(){x.data(this,e)}):arguments.length>1?this.each(function(){x.data(this,e,n)}):s?$(s,e,x.data(s,e)):null},removeData:function(e){return this.each(function(){x.removeData(this,e)})}});function $(e,n,r){if(r===t&&1===e.nodeType){var i=\"data-\"+n.replace(P,\"-$1\").toLowerCase();if(r=e.getAttribute(i),\"string\"==typeof r){try{r=\"true\"===r?!0:\"false\"===r?!1:\"null\"===r?null:+r+\"\"===r?+r:B.test(r)?x.parseJSON(r):r}catch(o){}x.data(e,n,r)}else r=t}return r}function I(e){var t;for(t in e)if((\"data\"!==t||!x.isEmptyObject(e[t]))&&\"toJSON\"!==t)return!1;return!0}x.extend({queue:function(e,n,r){var i;return e?(n=(n||\"fx\")+\"queue\",i=x._data(e,n),r&&(!i||x.isArray(r)?i=x._data(e,n,x.makeArray(r)):i.push(r)),i||[]):t},dequeue:function(e,t){t=t||\"fx\";var n=x.queue(e,t),r=n.length,i=n.shift(),o=x._queueHooks(e,t),a=function(){x.dequeue(e,t)};\"inprogress\"===i&&(i=n.shift(),r--),i&&(\"fx\"===t&&n.unshift(\"inprogress\"),delete o.stop,i.call(e,a,o)),!r&&o&&o.empty.fire()},_queueHooks:function(e,t){var n=t+\"queueHooks\";return x._data(e,n)||x._data(e,n,{empty:x.Callbacks(\"once memory\").add(function(){x._removeData(e,t+\"queue\"),x._removeData(e,n)})})}}),x.fn.extend({queue:function(e,n){var r=2;return\"string\"!=typeof e&&(n=e,e=\"fx\",r--),r>arguments.length?x.queue(this[0],e):n===t?this:this.each(function(){var t=x.queue(this,e,n);x._queueHooks(this,e),\"fx\"===e&&\"inprogress\"!==t[0]&&x.dequeue(this,e)})},dequeue:function(e){return this.each(function(){x.dequeue(this,e)})},delay:function(e,t){return e=x.fx?x.fx.speeds[e]||e:e,t=t||\"fx\",this.queue(t,function(t,n){var r=setTimeout(t,e);n.stop=function(){clearTimeout(r)}})},clearQueue:function(e){return this.queue(e||\"fx\",[])},promise:function(e,n){var r,i=1,o=x.Deferred(),a=this,s=this.length,l=function(){--i||o.resolveWith(a,[a])};\"string\"!=typeof e&&(n=e,e=t),e=e||\"fx\";while(s--)r=x._data(a[s],e+\"queueHooks\"),r&&r.empty&&(i++,r.empty.add(l));return l(),o.promise(n)}});var z,X,U=/[\\t\\r\\n\\f]/g,V=/\\r/g,Y=/^(?:input|select|textarea|button|object)$/i,J=/^(?:a|area)$/i,G=/^(?:checked|selected)$/i,Q=x.support.getSetAttribute,K=x.support.input;x.fn.extend({attr:function(e,t){return x.access(this,x.attr,e,t,arguments.length>1)},removeAttr:function(e){return this.each(function(){x.removeAttr(this,e)})},prop:function(e,t){return x.access(this,x.prop,e,t,arguments.length>1)},removeProp:function(e){return e=x.propFix[e]||e,this.each(function(){try{this[e]=t,delete this[e]}catch(n){}})},addClass:function(e){var t,n,r,i,o,a=0,s=this.length,l=\"string\"==typeof e&&e;if(x.isFunction(e))return this.each(function(t){x(this).addClass(e.call(this,t,this.className))});if(l)for(t=(e||\"\").match(T)||[];s>a;a++)if(n=this[a],r=1===n.nodeType&&(n.className?(\" \"+n.className+\" \").replace(U,\" \"):\" \")){o=0;while(i=t[o++])0>r.indexOf(\" \"+i+\" \")&&(r+=i+\" \");n.className=x.trim(r)}return this},removeClass:function(e){var t,n,r,i,o,a=0,s=this.length,l=0===arguments.length||\"string\"==typeof e&&e;if(x.isFunction(e))return this.each(function(t){x(this).removeClass(e.call(this,t,this.className))});if(l)for(t=(e||\"\").match(T)||[];s>a;a++)if(n=this[a],r=1===n.nodeType&&(n.className?(\" \"+n.className+\" \").replace(U,\" \"):\"\")){o=0;while(i=t[o++])while(r.indexOf(\" \"+i+\" \")>=0)r=r.replace(\" \"+i+\" \",\" \");n.className=e?x.trim(r):\"\"}return this},toggleClass:function(e,t){var n=typeof e;return\"boolean\"==typeof t&&\"string\"===n?t?this.addClass(e):this.removeClass(e):x.isFunction(e)?this.each(function(n){x(this).toggleClass(e.call(this,n,this.className,t),t)}):this.each(function(){if(\"string\"===n){var t,r=0,o=x(this),a=e.match(T)||[];while(t=a[r++])o.hasClass(t)?o.removeClass(t):o.addClass(t)}else(n===i||\"boolean\"===n)&&(this.className&&x._data(this,\"__className__\",this.className),this.className=this.className||e===!1?\"\":x._data(this,\"__className__\")||\"\")})},hasClass:function(e){var t=\" \"+e+\" \",n=0,r=this.length;for(;r>n;n++)if(1===this[n].nodeType&&(\" \"+this[n].className+\" \").replace(U,\" \").indexOf(t)>=0)return!0;return!1},val:function(e){var n,r,i,o=this[0];{if(arguments.length)return i=x.isFunction(e),this.each(function(n){var o;1===this.nodeType&&(o=i?e.call(this,n,x(this).val()):e,null==o?o=\"\":\"number\"==typeof o?o+=\"\":x.isArray(o)&&(o=x.map(o,function(e){return null==e?\"\":e+\"\"})),r=x.valHooks[this.type]||x.valHooks[this.nodeName.toLowerCase()],r&&\"set\"in r&&r.set(this,o,\"value\")!==t||(this.value=o))});if(o)return r=x.valHooks[o.type]||x.valHooks[o.nodeName.toLowerCase()],r&&\"get\"in r&&(n=r.get(o,\"value\"))!==t?n:(n=o.value,\"string\"==typeof n?n.replace(V,\"\"):null==n?\"\":n)}}}),x.extend({valHooks:{option:{get:function(e){var t=x.find.attr(e,\"value\");return null!=t?t:e.text}},select:{get:function(e){var t,n,r=e.options,i=e.selectedIndex,o=\"select-one\"===e.type||0>i,a=o?null:[],s=o?i+1:r.length,l=0>i?s:o?i:0;for(;s>l;l++)if(n=r[l],!(!n.selected&&l!==i||(x.support.optDisabled?n.disabled:null!==n.getAttribute(\"disabled\"))||n.parentNode.disabled&&x.nodeName(n.parentNode,\"optgroup\"))){if(t=x(n).val(),o)return t;a.push(t)}return a},set:function(e,t){var n,r,i=e.options,o=x.makeArray(t),a=i.length;while(a--)r=i[a],(r.selected=x.inArray(x(r).val(),o)>=0)&&(n=!0);return n||(e.selectedIndex=-1),o}}},attr:function(e,n,r){var o,a,s=e.nodeType;if(e&&3!==s&&8!==s&&2!==s)return typeof e.getAttribute===i?x.prop(e,n,r):(1===s&&x.isXMLDoc(e)||(n=n.toLowerCase(),o=x.attrHooks[n]||(x.expr.match.bool.test(n)?X:z)),r===t?o&&\"get\"in o&&null!==(a=o.get(e,n))?a:(a=x.find.attr(e,n),null==a?t:a):null!==r?o&&\"set\"in o&&(a=o.set(e,r,n))!==t?a:(e.setAttribute(n,r+\"\"),r):(x.removeAttr(e,n),t))},removeAttr:function(e,t){var n,r,i=0,o=t&&t.match(T);if(o&&1===e.nodeType)while(n=o[i++])r=x.propFix[n]||n,x.expr.match.bool.test(n)?K&&Q||!G.test(n)?e[r]=!1:e[x.camelCase(\"default-\"+n)]=e[r]=!1:x.attr(e,n,\"\"),e.removeAttribute(Q?n:r)},attrHooks:{type:{set:function(e,t){if(!x.support.radioValue&&\"radio\"===t&&x.nodeName(e,\"input\")){var n=e.value;return e.setAttribute(\"type\",t),n&&(e.value=n),t}}}},propFix:{\"for\":\"htmlFor\",\"class\":\"className\"},prop:function(e,n,r){var i,o,a,s=e.nodeType;if(e&&3!==s&&8!==s&&2!==s)return a=1!==s||!x.isXMLDoc(e),a&&(n=x.propFix[n]||n,o=x.propHooks[n]),r!==t?o&&\"set\"in o&&(i=o.set(e,r,n))!==t?i:e[n]=r:o&&\"get\"in o&&null!==(i=o.get(e,n))?i:e[n]},propHooks:{tabIndex:{get:function(e){var t=x.find.attr(e,\"tabindex\");return t?parseInt(t,10):Y.test(e.nodeName)||J.test(e.nodeName)&&e.href?0:-1}}}}),X={set:function(e,t,n){return t===!1?x.removeAttr(e,n):K&&Q||!G.test(n)?e.setAttribute(!Q&&x.propFix[n]||n,n):e[x.camelCase(\"default-\"+n)]=e[n]=!0,n}},x.each(x.expr.match.bool.source.match(/\\w+/g),function(e,n){var r=x.expr.attrHandle[n]||x.find.attr;x.expr.attrHandle[n]=K&&Q||!G.test(n)?function(e,n,i){var o=x.expr.attrHandle[n],a=i?t:(x.expr.attrHandle[n]=t)!=r(e,n,i)?n.toLowerCase():null;return x.expr.attrHandle[n]=o,a}:function(e,n,r){return r?t:e[x.camelCase(\"default-\"+n)]?n.toLowerCase():null}}),K&&Q||(x.attrHooks.value={set:function(e,n,r){return x.nodeName(e,\"input\")?(e.defaultValue=n,t):z&&z.set(e,n,r)}}),Q||(z={set:function(e,n,r){var i=e.getAttributeNode(r);return i||e.setAttributeNode(i=e.ownerDocument.createAttribute(r)),i.value=n+=\"\",\"value\"===r||n===e.getAttribute(r)?n:t}},x.expr.attrHandle.id=x.expr.attrHandle.name=x.expr.attrHandle.coords=function(e,n,r){var i;return r?t:(i=e.getAttributeNode(n))&&\"\"!==i.value?i.value:null},x.valHooks.button={get:function(e,n){var r=e.getAttributeNode(n);return r&&r.specified?r.value:t},set:z.set},x.attrHooks.contenteditable={set:function(e,t,n){z.set(e,\"\"===t?!1:t,n)}},x.each([\"width\",\"height\"],function(e,n){x.attrHooks[n]={set:function(e,r){return\"\"===r?(e.setAttribute(n,\"auto\"),r):t}}})),x.support.hrefNormalized||x.each([\"href\",\"src\"],function(e,t){x.propHooks[t]={get:function(e){return e.getAttribute(t,4)}}}),x.support.style||(x.attrHooks.style={get:function(e){return e.style.cssText||t},set:function(e,t){return e.style.cssText=t+\"\"}}),x.support.optSelected||(x.propHooks.selected={get:function(e){var t=e.parentNode;return t&&(t.selectedIndex,t.parentNode&&t.parentNode.selectedIndex),null}}),x.each([\"tabIndex\",\"readOnly\",\"maxLength\",\"cellSpacing\",\"cellPadding\",\"rowSpan\",\"colSpan\",\"useMap\",\"frameBorder\",\"contentEditable\"],function(){x.propFix[this.toLowerCase()]=this}),x.support.enctype||(x.propFix.enctype=\"encoding\"),x.each([\"radio\",\"checkbox\"],function(){x.valHooks[this]={set:function(e,n){return x.isArray(n)?e.checked=x.inArray(x(e).val(),n)>=0:t}},x.support.checkOn||(x.valHooks[this].get=function(e){return null===e.getAttribute(\"value\")?\"on\":e.value})});var Z=/^(?:input|select|textarea)$/i,et=/^key/,tt=/^(?:mouse|contextmenu)|click/,nt=/^(?:focusinfocus|focusoutblur)$/,rt=/^([^.]*)(?:\\.(.+)|)$/;function it(){return!0}function ot(){return!1}function at(){try{return a.activeElement}catch(e){}}x.event={global:{},add:function(e,n,r,o,a){var s,l,u,c,p,f,d,h,g,m,y,v=x._data(e);if(v){r.handler&&(c=r,r=c.handler,a=c.selector),r.guid||(r.guid=x.guid++),(l=v.events)||(l=v.events={}),(f=v.handle)||(f=v.handle=function(e){return typeof x===i||e&&x.event.triggered===e.type?t:x.event.dispatch.apply(f.elem,arguments)},f.elem=e),n=(n||\"\").match(T)||[\"\"],u=n.length;while(u--)s=rt.exec(n[u])||[],g=y=s[1],m=(s[2]||\"\").split(\".\").sort(),g&&(p=x.event.special[g]||{},g=(a?p.delegateType:p.bindType)||g,p=x.event.special[g]||{},d=x.extend({type:g,origType:y,data:o,handler:r,guid:r.guid,selector:a,needsContext:a&&x.expr.match.needsContext.test(a),namespace:m.join(\".\")},c),(h=l[g])||(h=l[g]=[],h.delegateCount=0,p.setup&&p.setup.call(e,o,m,f)!==!1||(e.addEventListener?e.addEventListener(g,f,!1):e.attachEvent&&e.attachEvent(\"on\"+g,f))),p.add&&(p.add.call(e,d),d.handler.guid||(d.handler.guid=r.guid)),a?h.splice(h.delegateCount++,0,d):h.push(d),x.event.global[g]=!0);e=null}},remove:function(e,t,n,r,i){var o,a,s,l,u,c,p,f,d,h,g,m=x.hasData(e)&&x._data(e);if(m&&(c=m.events)){t=(t||\"\").match(T)||[\"\"],u=t.length;while(u--)if(s=rt.exec(t[u])||[],d=g=s[1],h=(s[2]||\"\").split(\".\").sort(),d){p=x.event.special[d]||{},d=(r?p.delegateType:p.bindType)||d,f=c[d]||[],s=s[2]&&RegExp(\"(^|\\\\.)\"+h.join(\"\\\\.(?:.*\\\\.|)\")+\"(\\\\.|$)\"),l=o=f.length;while(o--)a=f[o],!i&&g!==a.origType||n&&n.guid!==a.guid||s&&!s.test(a.namespace)||r&&r!==a.selector&&(\"**\"!==r||!a.selector)||(f.splice(o,1),a.selector&&f.delegateCount--,p.remove&&p.remove.call(e,a));l&&!f.length&&(p.teardown&&p.teardown.call(e,h,m.handle)!==!1||x.removeEvent(e,d,m.handle),delete c[d])}else for(d in c)x.event.remove(e,d+t[u],n,r,!0);x.isEmptyObject(c)&&(delete m.handle,x._removeData(e,\"events\"))}},trigger:function(n,r,i,o){var s,l,u,c,p,f,d,h=[i||a],g=v.call(n,\"type\")?n.type:n,m=v.call(n,\"namespace\")?n.namespace.split(\".\"):[];if(u=f=i=i||a,3!==i.nodeType&&8!==i.nodeType&&!nt.test(g+x.event.triggered)&&(g.indexOf(\".\")>=0&&(m=g.split(\".\"),g=m.shift(),m.sort()),l=0>g.indexOf(\":\")&&\"on\"+g,n=n[x.expando]?n:new x.Event(g,\"object\"==typeof n&&n),n.isTrigger=o?2:3,n.namespace=m.join(\".\"),n.namespace_re=n.namespace?RegExp(\"(^|\\\\.)\"+m.join(\"\\\\.(?:.*\\\\.|)\")+\"(\\\\.|$)\"):null,n.result=t,n.target||(n.target=i),r=null==r?[n]:x.makeArray(r,[n]),p=x.event.special[g]||{},o||!p.trigger||p.trigger.apply(i,r)!==!1)){if(!o&&!p.noBubble&&!x.isWindow(i)){for(c=p.delegateType||g,nt.test(c+g)||(u=u.parentNode);u;u=u.parentNode)h.push(u),f=u;f===(i.ownerDocument||a)&&h.push(f.defaultView||f.parentWindow||e)}d=0;while((u=h[d++])&&!n.isPropagationStopped())n.type=d>1?c:p.bindType||g,s=(x._data(u,\"events\")||{})[n.type]&&x._data(u,\"handle\"),s&&s.apply(u,r),s=l&&u[l],s&&x.acceptData(u)&&s.apply&&s.apply(u,r)===!1&&n.preventDefault();if(n.type=g,!o&&!n.isDefaultPrevented()&&(!p._default||p._default.apply(h.pop(),r)===!1)&&x.acceptData(i)&&l&&i[g]&&!x.isWindow(i)){f=i[l],f&&(i[l]=null),x.event.triggered=g;try{i[g]()}catch(y){}x.event.triggered=t,f&&(i[l]=f)}return n.result}},dispatch:function(e){e=x.event.fix(e);var n,r,i,o,a,s=[],l=g.call(arguments),u=(x._data(this,\"events\")||{})[e.type]||[],c=x.event.special[e.type]||{};if(l[0]=e,e.delegateTarget=this,!c.preDispatch||c.preDispatch.call(this,e)!==!1){s=x.event.handlers.call(this,e,u),n=0;while((o=s[n++])&&!e.isPropagationStopped()){e.currentTarget=o.elem,a=0;while((i=o.handlers[a++])&&!e.isImmediatePropagationStopped())(!e.namespace_re||e.namespace_re.test(i.namespace))&&(e.handleObj=i,e.data=i.data,r=((x.event.special[i.origType]||{}).handle||i.handler).apply(o.elem,l),r!==t&&(e.result=r)===!1&&(e.preventDefault(),e.stopPropagation()))}return c.postDispatch&&c.postDispatch.call(this,e),e.result}},handlers:function(e,n){var r,i,o,a,s=[],l=n.delegateCount,u=e.target;if(l&&u.nodeType&&(!e.button||\"click\"!==e.type))for(;u!=this;u=u.parentNode||this)if(1===u.nodeType&&(u.disabled!==!0||\"click\"!==e.type)){for(o=[],a=0;l>a;a++)i=n[a],r=i.selector+\" \",o[r]===t&&(o[r]=i.needsContext?x(r,this).index(u)>=0:x.find(r,this,null,[u]).length),o[r]&&o.push(i);o.length&&s.push({elem:u,handlers:o})}return n.length>l&&s.push({elem:this,handlers:n.slice(l)}),s},fix:function(e){if(e[x.expando])return e;var t,n,r,i=e.type,o=e,s=this.fixHooks[i];s||(this.fixHooks[i]=s=tt.test(i)?this.mouseHooks:et.test(i)?this.keyHooks:{}),r=s.props?this.props.concat(s.props):this.props,e=new x.Event(o),t=r.length;while(t--)n=r[t],e[n]=o[n];return e.target||(e.target=o.srcElement||a),3===e.target.nodeType&&(e.target=e.target.parentNode),e.metaKey=!!e.metaKey,s.filter?s.filter(e,o):e},props:\"altKey bubbles cancelable ctrlKey currentTarget eventPhase metaKey relatedTarget shiftKey target timeStamp view which\".split(\" \"),fixHooks:{},keyHooks:{props:\"char charCode key keyCode\".split(\" \"),filter:function(e,t){return null==e.which&&(e.which=null!=t.charCode?t.charCode:t.keyCode),e}},mouseHooks:{props:\"button buttons clientX clientY fromElement offsetX offsetY pageX pageY screenX screenY toElement\".split(\" \"),filter:function(e,n){var r,i,o,s=n.button,l=n.fromElement;return null==e.pageX&&null!=n.clientX&&(i=e.target.ownerDocument||a,o=i.documentElement,r=i.body,e.pageX=n.clientX+(o&&o.scrollLeft||r&&r.scrollLeft||0)-(o&&o.clientLeft||r&&r.clientLeft||0),e.pageY=n.clientY+(o&&o.scrollTop||r&&r.scrollTop||0)-(o&&o.clientTop||r&&r.clientTop||0)),!e.relatedTarget&&l&&(e.relatedTarget=l===e.target?n.toElement:l),e.which||s===t||(e.which=1&s?1:2&s?3:4&s?2:0),e}},special:{load:{noBubble:!0},focus:{trigger:function(){if(this!==at()&&this.focus)try{return this.focus(),!1}catch(e){}},delegateType:\"focusin\"},blur:{trigger:function(){return this===at()&&this.blur?(this.blur(),!1):t},delegateType:\"focusout\"},click:{trigger:function(){return x.nodeName(this,\"input\")&&\"checkbox\"===this.type&&this.click?(this.click(),!1):t},_default:function(e){return x.nodeName(e.target,\"a\")}},beforeunload:{postDispatch:function(e){e.result!==t&&(e.originalEvent.returnValue=e.result)}}},simulate:function(e,t,n,r){var i=x.extend(new x.Event,n,{type:e,isSimulated:!0,originalEvent:{}});r?x.event.trigger(i,null,t):x.event.dispatch.call(t,i),i.isDefaultPrevented()&&n.preventDefault()}},x.removeEvent=a.removeEventListener?function(e,t,n){e.removeEventListener&&e.removeEventListener(t,n,!1)}:function(e,t,n){var r=\"on\"+t;e.detachEvent&&(typeof e[r]===i&&(e[r]=null),e.detachEvent(r,n))},x.Event=function(e,n){return this instanceof x.Event?(e&&e.type?(this.originalEvent=e,this.type=e.type,this.isDefaultPrevented=e.defaultPrevented||e.returnValue===!1||e.getPreventDefault&&e.getPreventDefault()?it:ot):this.type=e,n&&x.extend(this,n),this.timeStamp=e&&e.timeStamp||x.now(),this[x.expando]=!0,t):new x.Event(e,n)},x.Event.prototype={isDefaultPrevented:ot,isPropagationStopped:ot,isImmediatePropagationStopped:ot,preventDefault:function(){var e=this.originalEvent;this.isDefaultPrevented=it,e&&(e.preventDefault?e.preventDefault():e.returnValue=!1)},stopPropagation:function(){var e=this.originalEvent;this.isPropagationStopped=it,e&&(e.stopPropagation&&e.stopPropagation(),e.cancelBubble=!0)},stopImmediatePropagation:function(){this.isImmediatePropagationStopped=it,this.stopPropagation()}},x.each({mouseenter:\"mouseover\",mouseleave:\"mouseout\"},function(e,t){x.event.special[e]={delegateType:t,bindType:t,handle:function(e){var n,r=this,i=e.relatedTarget,o=e.handleObj;return(!i||i!==r&&!x.contains(r,i))&&(e.type=o.origType,n=o.handler.apply(this,arguments),e.type=t),n}}}),x.support.submitBubbles||(x.event.special.submit={setup:function(){return x.nodeName(this,\"form\")?!1:(x.event.add(this,\"click._submit keypress._submit\",function(e){var n=e.target,r=x.nodeName(n,\"input\")||x.nodeName(n,\"button\")?n.form:t;r&&!x._data(r,\"submitBubbles\")&&(x.event.add(r,\"submit._submit\",function(e){e._submit_bubble=!0}),x._data(r,\"submitBubbles\",!0))}),t)},postDispatch:function(e){e._submit_bubble&&(delete e._submit_bubble,this.parentNode&&!e.isTrigger&&x.event.simulate(\"submit\",this.parentNode,e,!0))},teardown:function(){return x.nodeName(this,\"form\")?!1:(x.event.remove(this,\"._submit\"),t)}}),x.support.changeBubbles||(x.event.special.change={setup:function(){return Z.test(this.nodeName)?((\"checkbox\"===this.type||\"radio\"===this.type)&&(x.event.add(this,\"propertychange._change\",function(e){\"checked\"===e.originalEvent.propertyName&&(this._just_changed=!0)}),x.event.add(this,\"click._change\",function(e){this._just_changed&&!e.isTrigger&&(this._just_changed=!1),x.event.simulate(\"change\",this,e,!0)})),!1):(x.event.add(this,\"beforeactivate._change\",function(e){var t=e.target;Z.test(t.nodeName)&&!x._data(t,\"changeBubbles\")&&(x.event.add(t,\"change._change\",function(e){!this.parentNode||e.isSimulated||e.isTrigger||x.event.simulate(\"change\",this.parentNode,e,!0)}),x._data(t,\"changeBubbles\",!0))}),t)},handle:function(e){var n=e.target;return this!==n||e.isSimulated||e.isTrigger||\"radio\"!==n.type&&\"checkbox\"!==n.type?e.handleObj.handler.apply(this,arguments):t},teardown:function(){return x.event.remove(this,\"._change\"),!Z.test(this.nodeName)}}),x.support.focusinBubbles||x.each({focus:\"focusin\",blur:\"focusout\"},function(e,t){var n=0,r=function(e){x.event.simulate(t,e.target,x.event.fix(e),!0)};x.event.special[t]={setup:function(){0===n++&&a.addEventListener(e,r,!0)},teardown:function(){0===--n&&a.removeEventListener(e,r,!0)}}}),x.fn.extend({on:function(e,n,r,i,o){var a,s;if(\"object\"==typeof e){\"string\"!=typeof n&&(r=r||n,n=t);for(a in e)this.on(a,n,r,e[a],o);return this}if(null==r&&null==i?(i=n,r=n=t):null==i&&(\"string\"==typeof n?(i=r,r=t):(i=r,r=n,n=t)),i===!1)i=ot;else if(!i)return this;return 1===o&&(s=i,i=function(e){return x().off(e),s.apply(this,arguments)},i.guid=s.guid||(s.guid=x.guid++)),this.each(function(){x.event.add(this,e,i,r,n)})},one:function(e,t,n,r){return this.on(e,t,n,r,1)},off:function(e,n,r){var i,o;if(e&&e.preventDefault&&e.handleObj)return i=e.handleObj,x(e.delegateTarget).off(i.namespace?i.origType+\".\"+i.namespace:i.origType,i.selector,i.handler),this;if(\"object\"==typeof e){for(o in e)this.off(o,n,e[o]);return this}return(n===!1||\"function\"==typeof n)&&(r=n,n=t),r===!1&&(r=ot),this.each(function(){x.event.remove(this,e,r,n)})},trigger:function(e,t){return this.each(function(){x.event.trigger(e,t,this)})},triggerHandler:function(e,n){var r=this[0];return r?x.event.trigger(e,n,r,!0):t}});var st=/^.[^:#\\[\\.,]*$/,lt=/^(?:parents|prev(?:Until|All))/,ut=x.expr.match.needsContext,ct={children:!0,contents:!0,next:!0,prev:!0};x.fn.extend({find:function(e){var t,n=[],r=this,i=r.length;if(\"string\"!=typeof e)return this.pushStack(x(e).filter(function(){for(t=0;i>t;t++)if(x.contains(r[t],this))return!0}));for(t=0;i>t;t++)x.find(e,r[t],n);return n=this.pushStack(i>1?x.unique(n):n),n.selector=this.selector?this.selector+\" \"+e:e,n},has:function(e){var t,n=x(e,this),r=n.length;return this.filter(function(){for(t=0;r>t;t++)if(x.contains(this,n[t]))return!0})},not:function(e){return this.pushStack(ft(this,e||[],!0))},filter:function(e){return this.pushStack(ft(this,e||[],!1))},is:function(e){return!!ft(this,\"string\"==typeof e&&ut.test(e)?x(e):e||[],!1).length},closest:function(e,t){var n,r=0,i=this.length,o=[],a=ut.test(e)||\"string\"!=typeof e?x(e,t||this.context):0;for(;i>r;r++)for(n=this[r];n&&n!==t;n=n.parentNode)if(11>n.nodeType&&(a?a.index(n)>-1:1===n.nodeType&&x.find.matchesSelector(n,e))){n=o.push(n);break}return this.pushStack(o.length>1?x.unique(o):o)},index:function(e){return e?\"string\"==typeof e?x.inArray(this[0],x(e)):x.inArray(e.jquery?e[0]:e,this):this[0]&&this[0].parentNode?this.first().prevAll().length:-1},add:function(e,t){var n=\"string\"==typeof e?x(e,t):x.makeArray(e&&e.nodeType?[e]:e),r=x.merge(this.get(),n);return this.pushStack(x.unique(r))},addBack:function(e){return this.add(null==e?this.prevObject:this.prevObject.filter(e))}});function pt(e,t){do e=e[t];while(e&&1!==e.nodeType);return e}x.each({parent:function(e){var t=e.parentNode;return t&&11!==t.nodeType?t:null},parents:function(e){return x.dir(e,\"parentNode\")},parentsUntil:function(e,t,n){return x.dir(e,\"parentNode\",n)},next:function(e){return pt(e,\"nextSibling\")},prev:function(e){return pt(e,\"previousSibling\")},nextAll:function(e){return x.dir(e,\"nextSibling\")},prevAll:function(e){return x.dir(e,\"previousSibling\")},nextUntil:function(e,t,n){return x.dir(e,\"nextSibling\",n)},prevUntil:function(e,t,n){return x.dir(e,\"previousSibling\",n)},siblings:function(e){return x.sibling((e.parentNode||{}).firstChild,e)},children:function(e){return x.sibling(e.firstChild)},contents:function(e){return x.nodeName(e,\"iframe\")?e.contentDocument||e.contentWindow.document:x.merge([],e.childNodes)}},function(e,t){x.fn[e]=function(n,r){var i=x.map(this,t,n);return\"Until\"!==e.slice(-5)&&(r=n),r&&\"string\"==typeof r&&(i=x.filter(r,i)),this.length>1&&(ct[e]||(i=x.unique(i)),lt.test(e)&&(i=i.reverse())),this.pushStack(i)}}),x.extend({filter:function(e,t,n){var r=t[0];return n&&(e=\":not(\"+e+\")\"),1===t.length&&1===r.nodeType?x.find.matchesSelector(r,e)?[r]:[]:x.find.matches(e,x.grep(t,function(e){return 1===e.nodeType}))},dir:function(e,n,r){var i=[],o=e[n];while(o&&9!==o.nodeType&&(r===t||1!==o.nodeType||!x(o).is(r)))1===o.nodeType&&i.push(o),o=o[n];return i},sibling:function(e,t){var n=[];for(;e;e=e.nextSibling)1===e.nodeType&&e!==t&&n.push(e);return n}});function ft(e,t,n){if(x.isFunction(t))return x.grep(e,function(e,r){return!!t.call(e,r,e)!==n});if(t.nodeType)return x.grep(e,function(e){return e===t!==n});if(\"string\"==typeof t){if(st.test(t))return x.filter(t,e,n);t=x.filter(t,e)}return x.grep(e,function(e){return x.inArray(e,t)>=0!==n})}function dt(e){var t=ht.split(\"|\"),n=e.createDocumentFragment();if(n.createElement)while(t.length)n.createElement(t.pop());return n}var ht=\"abbr|article|aside|audio|bdi|canvas|data|datalist|details|figcaption|figure|footer|header|hgroup|mark|meter|nav|output|progress|section|summary|time|video\",gt=/ jQuery\\d+=\"(?:null|\\d+)\"/g,mt=RegExp(\"<(?:\"+ht+\")[\\\\s/>]\",\"i\"),yt=/^\\s+/,vt=/<(?!area|br|col|embed|hr|img|input|link|meta|param)(([\\w:]+)[^>]*)\\/>/gi,bt=/<([\\w:]+)/,xt=/<tbody/i,wt=/<|&#?\\w+;/,Tt=/<(?:script|style|link)/i,Ct=/^(?:checkbox|radio)$/i,Nt=/checked\\s*(?:[^=]|=\\s*.checked.)/i,kt=/^$|\\/(?:java|ecma)script/i,Et=/^true\\/(.*)/,St=/^\\s*<!(?:\\[CDATA\\[|--)|(?:\\]\\]|--)>\\s*$/g,At={option:[1,\"<select multiple='multiple'>\",\"</select>\"],legend:[1,\"<fieldset>\",\"</fieldset>\"],area:[1,\"<map>\",\"</map>\"],param:[1,\"<object>\",\"</object>\"],thead:[1,\"<table>\",\"</table>\"],tr:[2,\"<table><tbody>\",\"</tbody></table>\"],col:[2,\"<table><tbody></tbody><colgroup>\",\"</colgroup></table>\"],td:[3,\"<table><tbody><tr>\",\"</tr></tbody></table>\"],_default:x.support.htmlSerialize?[0,\"\",\"\"]:[1,\"X<div>\",\"</div>\"]},jt=dt(a),Dt=jt.appendChild(a.createElement(\"div\"));At.optgroup=At.option,At.tbody=At.tfoot=At.colgroup=At.caption=At.thead,At.th=At.td,x.fn.extend({text:function(e){return x.access(this,function(e){return e===t?x.text(this):this.empty().append((this[0]&&this[0].ownerDocument||a).createTextNode(e))},null,e,arguments.length)},append:function(){return this.domManip(arguments,function(e){if(1===this.nodeType||11===this.nodeType||9===this.nodeType){var t=Lt(this,e);t.appendChild(e)}})},prepend:function(){return this.domManip(arguments,function(e){if(1===this.nodeType||11===this.nodeType||9===this.nodeType){var t=Lt(this,e);t.insertBefore(e,t.firstChild)}})},before:function(){return this.domManip(arguments,function(e){this.parentNode&&this.parentNode.insertBefore(e,this)})},after:function(){return this.domManip(arguments,function(e){this.parentNode&&this.parentNode.insertBefore(e,this.nextSibling)})},remove:function(e,t){var n,r=e?x.filter(e,this):this,i=0;for(;null!=(n=r[i]);i++)t||1!==n.nodeType||x.cleanData(Ft(n)),n.parentNode&&(t&&x.contains(n.ownerDocument,n)&&_t(Ft(n,\"script\")),n.parentNode.removeChild(n));return this},empty:function(){var e,t=0;for(;null!=(e=this[t]);t++){1===e.nodeType&&x.cleanData(Ft(e,!1));while(e.firstChild)e.removeChild(e.firstChild);e.options&&x.nodeName(e,\"select\")&&(e.options.length=0)}return this},clone:function(e,t){return e=null==e?!1:e,t=null==t?e:t,this.map(function(){return x.clone(this,e,t)})},html:function(e){return x.access(this,function(e){var n=this[0]||{},r=0,i=this.length;if(e===t)return 1===n.nodeType?n.innerHTML.replace(gt,\"\"):t;if(!(\"string\"!=typeof e||Tt.test(e)||!x.support.htmlSerialize&&mt.test(e)||!x.support.leadingWhitespace&&yt.test(e)||At[(bt.exec(e)||[\"\",\"\"])[1].toLowerCase()])){e=e.replace(vt,\"<$1></$2>\");try{for(;i>r;r++)n=this[r]||{},1===n.nodeType&&(x.cleanData(Ft(n,!1)),n.innerHTML=e);n=0}catch(o){}}n&&this.empty().append(e)},null,e,arguments.length)},replaceWith:function(){var e=x.map(this,function(e){return[e.nextSibling,e.parentNode]}),t=0;return this.domManip(arguments,function(n){var r=e[t++],i=e[t++];i&&(r&&r.parentNode!==i&&(r=this.nextSibling),x(this).remove(),i.insertBefore(n,r))},!0),t?this:this.remove()},detach:function(e){return this.remove(e,!0)},domManip:function(e,t,n){e=d.apply([],e);var r,i,o,a,s,l,u=0,c=this.length,p=this,f=c-1,h=e[0],g=x.isFunction(h);if(g||!(1>=c||\"string\"!=typeof h||x.support.checkClone)&&Nt.test(h))return this.each(function(r){var i=p.eq(r);g&&(e[0]=h.call(this,r,i.html())),i.domManip(e,t,n)});if(c&&(l=x.buildFragment(e,this[0].ownerDocument,!1,!n&&this),r=l.firstChild,1===l.childNodes.length&&(l=r),r)){for(a=x.map(Ft(l,\"script\"),Ht),o=a.length;c>u;u++)i=l,u!==f&&(i=x.clone(i,!0,!0),o&&x.merge(a,Ft(i,\"script\"))),t.call(this[u],i,u);if(o)for(s=a[a.length-1].ownerDocument,x.map(a,qt),u=0;o>u;u++)i=a[u],kt.test(i.type||\"\")&&!x._data(i,\"globalEval\")&&x.contains(s,i)&&(i.src?x._evalUrl(i.src):x.globalEval((i.text||i.textContent||i.innerHTML||\"\").replace(St,\"\")));l=r=null}return this}});function Lt(e,t){return x.nodeName(e,\"table\")&&x.nodeName(1===t.nodeType?t:t.firstChild,\"tr\")?e.getElementsByTagName(\"tbody\")[0]||e.appendChild(e.ownerDocument.createElement(\"tbody\")):e}function Ht(e){return e.type=(null!==x.find.attr(e,\"type\"))+\"/\"+e.type,e}function qt(e){var t=Et.exec(e.type);return t?e.type=t[1]:e.removeAttribute(\"type\"),e}function _t(e,t){var n,r=0;for(;null!=(n=e[r]);r++)x._data(n,\"globalEval\",!t||x._data(t[r],\"globalEval\"))}function Mt(e,t){if(1===t.nodeType&&x.hasData(e)){var n,r,i,o=x._data(e),a=x._data(t,o),s=o.events;if(s){delete a.handle,a.events={};for(n in s)for(r=0,i=s[n].length;i>r;r++)x.event.add(t,n,s[n][r])}a.data&&(a.data=x.extend({},a.data))}}function Ot(e,t){var n,r,i;if(1===t.nodeType){if(n=t.nodeName.toLowerCase(),!x.support.noCloneEvent&&t[x.expando]){i=x._data(t);for(r in i.events)x.removeEvent(t,r,i.handle);t.removeAttribute(x.expando)}\"script\"===n&&t.text!==e.text?(Ht(t).text=e.text,qt(t)):\"object\"===n?(t.parentNode&&(t.outerHTML=e.outerHTML),x.support.html5Clone&&e.innerHTML&&!x.trim(t.innerHTML)&&(t.innerHTML=e.innerHTML)):\"input\"===n&&Ct.test(e.type)?(t.defaultChecked=t.checked=e.checked,t.value!==e.value&&(t.value=e.value)):\"option\"===n?t.defaultSelected=t.selected=e.defaultSelected:(\"input\"===n||\"textarea\"===n)&&(t.defaultValue=e.defaultValue)}}x.each({appendTo:\"append\",prependTo:\"prepend\",insertBefore:\"before\",insertAfter:\"after\",replaceAll:\"replaceWith\"},function(e,t){x.fn[e]=function(e){var n,r=0,i=[],o=x(e),a=o.length-1;for(;a>=r;r++)n=r===a?this:this.clone(!0),x(o[r])[t](n),h.apply(i,n.get());return this.pushStack(i)}});function Ft(e,n){var r,o,a=0,s=typeof e.getElementsByTagName!==i?e.getElementsByTagName(n||\"*\"):typeof e.querySelectorAll!==i?e.querySelectorAll(n||\"*\"):t;if(!s)for(s=[],r=e.childNodes||e;null!=(o=r[a]);a++)!n||x.nodeName(o,n)?s.push(o):x.merge(s,Ft(o,n));return n===t||n&&x.nodeName(e,n)?x.merge([e],s):s}function Bt(e){Ct.test(e.type)&&(e.defaultChecked=e.checked)}x.extend({clone:function(e,t,n){var r,i,o,a,s,l=x.contains(e.ownerDocument,e);if(x.support.html5Clone||x.isXMLDoc(e)||!mt.test(\"<\"+e.nodeName+\">\")?o=e.cloneNode(!0):(Dt.innerHTML=e.outerHTML,Dt.removeChild(o=Dt.firstChild)),!(x.support.noCloneEvent&&x.support.noCloneChecked||1!==e.nodeType&&11!==e.nodeType||x.isXMLDoc(e)))for(r=Ft(o),s=Ft(e),a=0;null!=(i=s[a]);++a)r[a]&&Ot(i,r[a]);if(t)if(n)for(s=s||Ft(e),r=r||Ft(o),a=0;null!=(i=s[a]);a++)Mt(i,r[a]);else Mt(e,o);return r=Ft(o,\"script\"),r.length>0&&_t(r,!l&&Ft(e,\"script\")),r=s=i=null,o},buildFragment:function(e,t,n,r){var i,o,a,s,l,u,c,p=e.length,f=dt(t),d=[],h=0;for(;p>h;h++)if(o=e[h],o||0===o)if(\"object\"===x.type(o))x.merge(d,o.nodeType?[o]:o);else if(wt.test(o)){s=s||f.appendChild(t.createElement(\"div\")),l=(bt.exec(o)||[\"\",\"\"])[1].toLowerCase(),c=At[l]||At._default,s.innerHTML=c[1]+o.replace(vt,\"<$1></$2>\")+c[2],i=c[0];while(i--)s=s.lastChild;if(!x.support.leadingWhitespace&&yt.test(o)&&d.push(t.createTextNode(yt.exec(o)[0])),!x.support.tbody){o=\"table\"!==l||xt.test(o)?\"<table>\"!==c[1]||xt.test(o)?0:s:s.firstChild,i=o&&o.childNodes.length;while(i--)x.nodeName(u=o.childNodes[i],\"tbody\")&&!u.childNodes.length&&o.removeChild(u)}x.merge(d,s.childNodes),s.textContent=\"\";while(s.firstChild)s.removeChild(s.firstChild);s=f.lastChild}else d.push(t.createTextNode(o));s&&f.removeChild(s),x.support.appendChecked||x.grep(Ft(d,\"input\"),Bt),h=0;while(o=d[h++])if((!r||-1===x.inArray(o,r))&&(a=x.contains(o.ownerDocument,o),s=Ft(f.appendChild(o),\"script\"),a&&_t(s),n)){i=0;while(o=s[i++])kt.test(o.type||\"\")&&n.push(o)}return s=null,f},cleanData:function(e,t){var n,r,o,a,s=0,l=x.expando,u=x.cache,c=x.support.deleteExpando,f=x.event.special;for(;null!=(n=e[s]);s++)if((t||x.acceptData(n))&&(o=n[l],a=o&&u[o])){if(a.events)for(r in a.events)f[r]?x.event.remove(n,r):x.removeEvent(n,r,a.handle);\nu[o]&&(delete u[o],c?delete n[l]:typeof n.removeAttribute!==i?n.removeAttribute(l):n[l]=null,p.push(o))}},_evalUrl:function(e){return x.ajax({url:e,type:\"GET\",dataType:\"script\",async:!1,global:!1,\"throws\":!0})}}),x.fn.extend({wrapAll:function(e){if(x.isFunction(e))return this.each(function(t){x(this).wrapAll(e.call(this,t))});if(this[0]){var t=x(e,this[0].ownerDocument).eq(0).clone(!0);this[0].parentNode&&t.insertBefore(this[0]),t.map(function(){var e=this;while(e.firstChild&&1===e.firstChild.nodeType)e=e.firstChild;return e}).append(this)}return this},wrapInner:function(e){return x.isFunction(e)?this.each(function(t){x(this).wrapInner(e.call(this,t))}):this.each(function(){var t=x(this),n=t.contents();n.length?n.wrapAll(e):t.append(e)})},wrap:function(e){var t=x.isFunction(e);return this.each(function(n){x(this).wrapAll(t?e.call(this,n):e)})},unwrap:function(){return this.parent().each(function(){x.nodeName(this,\"body\")||x(this).replaceWith(this.childNodes)}).end()}});var Pt,Rt,Wt,$t=/alpha\\([^)]*\\)/i,It=/opacity\\s*=\\s*([^)]*)/,zt=/^(top|right|bottom|left)$/,Xt=/^(none|table(?!-c[ea]).+)/,Ut=/^margin/,Vt=RegExp(\"^(\"+w+\")(.*)$\",\"i\"),Yt=RegExp(\"^(\"+w+\")(?!px)[a-z%]+$\",\"i\"),Jt=RegExp(\"^([+-])=(\"+w+\")\",\"i\"),Gt={BODY:\"block\"},Qt={position:\"absolute\",visibility:\"hidden\",display:\"block\"},Kt={letterSpacing:0,fontWeight:400},Zt=[\"Top\",\"Right\",\"Bottom\",\"Left\"],en=[\"Webkit\",\"O\",\"Moz\",\"ms\"];function tn(e,t){if(t in e)return t;var n=t.charAt(0).toUpperCase()+t.slice(1),r=t,i=en.length;while(i--)if(t=en[i]+n,t in e)return t;return r}function nn(e,t){return e=t||e,\"none\"===x.css(e,\"display\")||!x.contains(e.ownerDocument,e)}function rn(e,t){var n,r,i,o=[],a=0,s=e.length;for(;s>a;a++)r=e[a],r.style&&(o[a]=x._data(r,\"olddisplay\"),n=r.style.display,t?(o[a]||\"none\"!==n||(r.style.display=\"\"),\"\"===r.style.display&&nn(r)&&(o[a]=x._data(r,\"olddisplay\",ln(r.nodeName)))):o[a]||(i=nn(r),(n&&\"none\"!==n||!i)&&x._data(r,\"olddisplay\",i?n:x.css(r,\"display\"))));for(a=0;s>a;a++)r=e[a],r.style&&(t&&\"none\"!==r.style.display&&\"\"!==r.style.display||(r.style.display=t?o[a]||\"\":\"none\"));return e}x.fn.extend({css:function(e,n){return x.access(this,function(e,n,r){var i,o,a={},s=0;if(x.isArray(n)){for(o=Rt(e),i=n.length;i>s;s++)a[n[s]]=x.css(e,n[s],!1,o);return a}return r!==t?x.style(e,n,r):x.css(e,n)},e,n,arguments.length>1)},show:function(){return rn(this,!0)},hide:function(){return rn(this)},toggle:function(e){return\"boolean\"==typeof e?e?this.show():this.hide():this.each(function(){nn(this)?x(this).show():x(this).hide()})}}),x.extend({cssHooks:{opacity:{get:function(e,t){if(t){var n=Wt(e,\"opacity\");return\"\"===n?\"1\":n}}}},cssNumber:{columnCount:!0,fillOpacity:!0,fontWeight:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,widows:!0,zIndex:!0,zoom:!0},cssProps:{\"float\":x.support.cssFloat?\"cssFloat\":\"styleFloat\"},style:function(e,n,r,i){if(e&&3!==e.nodeType&&8!==e.nodeType&&e.style){var o,a,s,l=x.camelCase(n),u=e.style;if(n=x.cssProps[l]||(x.cssProps[l]=tn(u,l)),s=x.cssHooks[n]||x.cssHooks[l],r===t)return s&&\"get\"in s&&(o=s.get(e,!1,i))!==t?o:u[n];if(a=typeof r,\"string\"===a&&(o=Jt.exec(r))&&(r=(o[1]+1)*o[2]+parseFloat(x.css(e,n)),a=\"number\"),!(null==r||\"number\"===a&&isNaN(r)||(\"number\"!==a||x.cssNumber[l]||(r+=\"px\"),x.support.clearCloneStyle||\"\"!==r||0!==n.indexOf(\"background\")||(u[n]=\"inherit\"),s&&\"set\"in s&&(r=s.set(e,r,i))===t)))try{u[n]=r}catch(c){}}},css:function(e,n,r,i){var o,a,s,l=x.camelCase(n);return n=x.cssProps[l]||(x.cssProps[l]=tn(e.style,l)),s=x.cssHooks[n]||x.cssHooks[l],s&&\"get\"in s&&(a=s.get(e,!0,r)),a===t&&(a=Wt(e,n,i)),\"normal\"===a&&n in Kt&&(a=Kt[n]),\"\"===r||r?(o=parseFloat(a),r===!0||x.isNumeric(o)?o||0:a):a}}),e.getComputedStyle?(Rt=function(t){return e.getComputedStyle(t,null)},Wt=function(e,n,r){var i,o,a,s=r||Rt(e),l=s?s.getPropertyValue(n)||s[n]:t,u=e.style;return s&&(\"\"!==l||x.contains(e.ownerDocument,e)||(l=x.style(e,n)),Yt.test(l)&&Ut.test(n)&&(i=u.width,o=u.minWidth,a=u.maxWidth,u.minWidth=u.maxWidth=u.width=l,l=s.width,u.width=i,u.minWidth=o,u.maxWidth=a)),l}):a.documentElement.currentStyle&&(Rt=function(e){return e.currentStyle},Wt=function(e,n,r){var i,o,a,s=r||Rt(e),l=s?s[n]:t,u=e.style;return null==l&&u&&u[n]&&(l=u[n]),Yt.test(l)&&!zt.test(n)&&(i=u.left,o=e.runtimeStyle,a=o&&o.left,a&&(o.left=e.currentStyle.left),u.left=\"fontSize\"===n?\"1em\":l,l=u.pixelLeft+\"px\",u.left=i,a&&(o.left=a)),\"\"===l?\"auto\":l});function on(e,t,n){var r=Vt.exec(t);return r?Math.max(0,r[1]-(n||0))+(r[2]||\"px\"):t}function an(e,t,n,r,i){var o=n===(r?\"border\":\"content\")?4:\"width\"===t?1:0,a=0;for(;4>o;o+=2)\"margin\"===n&&(a+=x.css(e,n+Zt[o],!0,i)),r?(\"content\"===n&&(a-=x.css(e,\"padding\"+Zt[o],!0,i)),\"margin\"!==n&&(a-=x.css(e,\"border\"+Zt[o]+\"Width\",!0,i))):(a+=x.css(e,\"padding\"+Zt[o],!0,i),\"padding\"!==n&&(a+=x.css(e,\"border\"+Zt[o]+\"Width\",!0,i)));return a}function sn(e,t,n){var r=!0,i=\"width\"===t?e.offsetWidth:e.offsetHeight,o=Rt(e),a=x.support.boxSizing&&\"border-box\"===x.css(e,\"boxSizing\",!1,o);if(0>=i||null==i){if(i=Wt(e,t,o),(0>i||null==i)&&(i=e.style[t]),Yt.test(i))return i;r=a&&(x.support.boxSizingReliable||i===e.style[t]),i=parseFloat(i)||0}return i+an(e,t,n||(a?\"border\":\"content\"),r,o)+\"px\"}function ln(e){var t=a,n=Gt[e];return n||(n=un(e,t),\"none\"!==n&&n||(Pt=(Pt||x(\"<iframe frameborder='0' width='0' height='0'/>\").css(\"cssText\",\"display:block !important\")).appendTo(t.documentElement),t=(Pt[0].contentWindow||Pt[0].contentDocument).document,t.write(\"<!doctype html><html><body>\"),t.close(),n=un(e,t),Pt.detach()),Gt[e]=n),n}function un(e,t){var n=x(t.createElement(e)).appendTo(t.body),r=x.css(n[0],\"display\");return n.remove(),r}x.each([\"height\",\"width\"],function(e,n){x.cssHooks[n]={get:function(e,r,i){return r?0===e.offsetWidth&&Xt.test(x.css(e,\"display\"))?x.swap(e,Qt,function(){return sn(e,n,i)}):sn(e,n,i):t},set:function(e,t,r){var i=r&&Rt(e);return on(e,t,r?an(e,n,r,x.support.boxSizing&&\"border-box\"===x.css(e,\"boxSizing\",!1,i),i):0)}}}),x.support.opacity||(x.cssHooks.opacity={get:function(e,t){return It.test((t&&e.currentStyle?e.currentStyle.filter:e.style.filter)||\"\")?.01*parseFloat(RegExp.$1)+\"\":t?\"1\":\"\"},set:function(e,t){var n=e.style,r=e.currentStyle,i=x.isNumeric(t)?\"alpha(opacity=\"+100*t+\")\":\"\",o=r&&r.filter||n.filter||\"\";n.zoom=1,(t>=1||\"\"===t)&&\"\"===x.trim(o.replace($t,\"\"))&&n.removeAttribute&&(n.removeAttribute(\"filter\"),\"\"===t||r&&!r.filter)||(n.filter=$t.test(o)?o.replace($t,i):o+\" \"+i)}}),x(function(){x.support.reliableMarginRight||(x.cssHooks.marginRight={get:function(e,n){return n?x.swap(e,{display:\"inline-block\"},Wt,[e,\"marginRight\"]):t}}),!x.support.pixelPosition&&x.fn.position&&x.each([\"top\",\"left\"],function(e,n){x.cssHooks[n]={get:function(e,r){return r?(r=Wt(e,n),Yt.test(r)?x(e).position()[n]+\"px\":r):t}}})}),x.expr&&x.expr.filters&&(x.expr.filters.hidden=function(e){return 0>=e.offsetWidth&&0>=e.offsetHeight||!x.support.reliableHiddenOffsets&&\"none\"===(e.style&&e.style.display||x.css(e,\"display\"))},x.expr.filters.visible=function(e){return!x.expr.filters.hidden(e)}),x.each({margin:\"\",padding:\"\",border:\"Width\"},function(e,t){x.cssHooks[e+t]={expand:function(n){var r=0,i={},o=\"string\"==typeof n?n.split(\" \"):[n];for(;4>r;r++)i[e+Zt[r]+t]=o[r]||o[r-2]||o[0];return i}},Ut.test(e)||(x.cssHooks[e+t].set=on)});var cn=/%20/g,pn=/\\[\\]$/,fn=/\\r?\\n/g,dn=/^(?:submit|button|image|reset|file)$/i,hn=/^(?:input|select|textarea|keygen)/i;x.fn.extend({serialize:function(){return x.param(this.serializeArray())},serializeArray:function(){return this.map(function(){var e=x.prop(this,\"elements\");return e?x.makeArray(e):this}).filter(function(){var e=this.type;return this.name&&!x(this).is(\":disabled\")&&hn.test(this.nodeName)&&!dn.test(e)&&(this.checked||!Ct.test(e))}).map(function(e,t){var n=x(this).val();return null==n?null:x.isArray(n)?x.map(n,function(e){return{name:t.name,value:e.replace(fn,\"\\r\\n\")}}):{name:t.name,value:n.replace(fn,\"\\r\\n\")}}).get()}}),x.param=function(e,n){var r,i=[],o=function(e,t){t=x.isFunction(t)?t():null==t?\"\":t,i[i.length]=encodeURIComponent(e)+\"=\"+encodeURIComponent(t)};if(n===t&&(n=x.ajaxSettings&&x.ajaxSettings.traditional),x.isArray(e)||e.jquery&&!x.isPlainObject(e))x.each(e,function(){o(this.name,this.value)});else for(r in e)gn(r,e[r],n,o);return i.join(\"&\").replace(cn,\"+\")};function gn(e,t,n,r){var i;if(x.isArray(t))x.each(t,function(t,i){n||pn.test(e)?r(e,i):gn(e+\"[\"+(\"object\"==typeof i?t:\"\")+\"]\",i,n,r)});else if(n||\"object\"!==x.type(t))r(e,t);else for(i in t)gn(e+\"[\"+i+\"]\",t[i],n,r)}x.each(\"blur focus focusin focusout load resize scroll unload click dblclick mousedown mouseup mousemove mouseover mouseout mouseenter mouseleave change select submit keydown keypress keyup error contextmenu\".split(\" \"),function(e,t){x.fn[t]=function(e,n){return arguments.length>0?this.on(t,null,e,n):this.trigger(t)}}),x.fn.extend({hover:function(e,t){return this.mouseenter(e).mouseleave(t||e)},bind:function(e,t,n){return this.on(e,null,t,n)},unbind:function(e,t){return this.off(e,null,t)},delegate:function(e,t,n,r){return this.on(t,e,n,r)},undelegate:function(e,t,n){return 1===arguments.length?this.off(e,\"**\"):this.off(t,e||\"**\",n)}});var mn,yn,vn=x.now(),bn=/\\?/,xn=/#.*$/,wn=/([?&])_=[^&]*/,Tn=/^(.*?):[ \\t]*([^\\r\\n]*)\\r?$/gm,Cn=/^(?:about|app|app-storage|.+-extension|file|res|widget):$/,Nn=/^(?:GET|HEAD)$/,kn=/^\\/\\//,En=/^([\\w.+-]+:)(?:\\/\\/([^\\/?#:]*)(?::(\\d+)|)|)/,Sn=x.fn.load,An={},jn={},Dn=\"*/\".concat(\"*\");try{yn=o.href}catch(Ln){yn=a.createElement(\"a\"),yn.href=\"\",yn=yn.href}mn=En.exec(yn.toLowerCase())||[];function Hn(e){return function(t,n){\"string\"!=typeof t&&(n=t,t=\"*\");var r,i=0,o=t.toLowerCase().match(T)||[];if(x.isFunction(n))while(r=o[i++])\"+\"===r[0]?(r=r.slice(1)||\"*\",(e[r]=e[r]||[]).unshift(n)):(e[r]=e[r]||[]).push(n)}}function qn(e,n,r,i){var o={},a=e===jn;function s(l){var u;return o[l]=!0,x.each(e[l]||[],function(e,l){var c=l(n,r,i);return\"string\"!=typeof c||a||o[c]?a?!(u=c):t:(n.dataTypes.unshift(c),s(c),!1)}),u}return s(n.dataTypes[0])||!o[\"*\"]&&s(\"*\")}function _n(e,n){var r,i,o=x.ajaxSettings.flatOptions||{};for(i in n)n[i]!==t&&((o[i]?e:r||(r={}))[i]=n[i]);return r&&x.extend(!0,e,r),e}x.fn.load=function(e,n,r){if(\"string\"!=typeof e&&Sn)return Sn.apply(this,arguments);var i,o,a,s=this,l=e.indexOf(\" \");return l>=0&&(i=e.slice(l,e.length),e=e.slice(0,l)),x.isFunction(n)?(r=n,n=t):n&&\"object\"==typeof n&&(a=\"POST\"),s.length>0&&x.ajax({url:e,type:a,dataType:\"html\",data:n}).done(function(e){o=arguments,s.html(i?x(\"<div>\").append(x.parseHTML(e)).find(i):e)}).complete(r&&function(e,t){s.each(r,o||[e.responseText,t,e])}),this},x.each([\"ajaxStart\",\"ajaxStop\",\"ajaxComplete\",\"ajaxError\",\"ajaxSuccess\",\"ajaxSend\"],function(e,t){x.fn[t]=function(e){return this.on(t,e)}}),x.extend({active:0,lastModified:{},etag:{},ajaxSettings:{url:yn,type:\"GET\",isLocal:Cn.test(mn[1]),global:!0,processData:!0,async:!0,contentType:\"application/x-www-form-urlencoded; charset=UTF-8\",accepts:{\"*\":Dn,text:\"text/plain\",html:\"text/html\",xml:\"application/xml, text/xml\",json:\"application/json, text/javascript\"},contents:{xml:/xml/,html:/html/,json:/json/},responseFields:{xml:\"responseXML\",text:\"responseText\",json:\"responseJSON\"},converters:{\"* text\":String,\"text html\":!0,\"text json\":x.parseJSON,\"text xml\":x.parseXML},flatOptions:{url:!0,context:!0}},ajaxSetup:function(e,t){return t?_n(_n(e,x.ajaxSettings),t):_n(x.ajaxSettings,e)},ajaxPrefilter:Hn(An),ajaxTransport:Hn(jn),ajax:function(e,n){\"object\"==typeof e&&(n=e,e=t),n=n||{};var r,i,o,a,s,l,u,c,p=x.ajaxSetup({},n),f=p.context||p,d=p.context&&(f.nodeType||f.jquery)?x(f):x.event,h=x.Deferred(),g=x.Callbacks(\"once memory\"),m=p.statusCode||{},y={},v={},b=0,w=\"canceled\",C={readyState:0,getResponseHeader:function(e){var t;if(2===b){if(!c){c={};while(t=Tn.exec(a))c[t[1].toLowerCase()]=t[2]}t=c[e.toLowerCase()]}return null==t?null:t},getAllResponseHeaders:function(){return 2===b?a:null},setRequestHeader:function(e,t){var n=e.toLowerCase();return b||(e=v[n]=v[n]||e,y[e]=t),this},overrideMimeType:function(e){return b||(p.mimeType=e),this},statusCode:function(e){var t;if(e)if(2>b)for(t in e)m[t]=[m[t],e[t]];else C.always(e[C.status]);return this},abort:function(e){var t=e||w;return u&&u.abort(t),k(0,t),this}};if(h.promise(C).complete=g.add,C.success=C.done,C.error=C.fail,p.url=((e||p.url||yn)+\"\").replace(xn,\"\").replace(kn,mn[1]+\"//\"),p.type=n.method||n.type||p.method||p.type,p.dataTypes=x.trim(p.dataType||\"*\").toLowerCase().match(T)||[\"\"],null==p.crossDomain&&(r=En.exec(p.url.toLowerCase()),p.crossDomain=!(!r||r[1]===mn[1]&&r[2]===mn[2]&&(r[3]||(\"http:\"===r[1]?\"80\":\"443\"))===(mn[3]||(\"http:\"===mn[1]?\"80\":\"443\")))),p.data&&p.processData&&\"string\"!=typeof p.data&&(p.data=x.param(p.data,p.traditional)),qn(An,p,n,C),2===b)return C;l=p.global,l&&0===x.active++&&x.event.trigger(\"ajaxStart\"),p.type=p.type.toUpperCase(),p.hasContent=!Nn.test(p.type),o=p.url,p.hasContent||(p.data&&(o=p.url+=(bn.test(o)?\"&\":\"?\")+p.data,delete p.data),p.cache===!1&&(p.url=wn.test(o)?o.replace(wn,\"$1_=\"+vn++):o+(bn.test(o)?\"&\":\"?\")+\"_=\"+vn++)),p.ifModified&&(x.lastModified[o]&&C.setRequestHeader(\"If-Modified-Since\",x.lastModified[o]),x.etag[o]&&C.setRequestHeader(\"If-None-Match\",x.etag[o])),(p.data&&p.hasContent&&p.contentType!==!1||n.contentType)&&C.setRequestHeader(\"Content-Type\",p.contentType),C.setRequestHeader(\"Accept\",p.dataTypes[0]&&p.accepts[p.dataTypes[0]]?p.accepts[p.dataTypes[0]]+(\"*\"!==p.dataTypes[0]?\", \"+Dn+\"; q=0.01\":\"\"):p.accepts[\"*\"]);for(i in p.headers)C.setRequestHeader(i,p.headers[i]);if(p.beforeSend&&(p.beforeSend.call(f,C,p)===!1||2===b))return C.abort();w=\"abort\";for(i in{success:1,error:1,complete:1})C[i](p[i]);if(u=qn(jn,p,n,C)){C.readyState=1,l&&d.trigger(\"ajaxSend\",[C,p]),p.async&&p.timeout>0&&(s=setTimeout(function(){C.abort(\"timeout\")},p.timeout));try{b=1,u.send(y,k)}catch(N){if(!(2>b))throw N;k(-1,N)}}else k(-1,\"No Transport\");function k(e,n,r,i){var c,y,v,w,T,N=n;2!==b&&(b=2,s&&clearTimeout(s),u=t,a=i||\"\",C.readyState=e>0?4:0,c=e>=200&&300>e||304===e,r&&(w=Mn(p,C,r)),w=On(p,w,C,c),c?(p.ifModified&&(T=C.getResponseHeader(\"Last-Modified\"),T&&(x.lastModified[o]=T),T=C.getResponseHeader(\"etag\"),T&&(x.etag[o]=T)),204===e||\"HEAD\"===p.type?N=\"nocontent\":304===e?N=\"notmodified\":(N=w.state,y=w.data,v=w.error,c=!v)):(v=N,(e||!N)&&(N=\"error\",0>e&&(e=0))),C.status=e,C.statusText=(n||N)+\"\",c?h.resolveWith(f,[y,N,C]):h.rejectWith(f,[C,N,v]),C.statusCode(m),m=t,l&&d.trigger(c?\"ajaxSuccess\":\"ajaxError\",[C,p,c?y:v]),g.fireWith(f,[C,N]),l&&(d.trigger(\"ajaxComplete\",[C,p]),--x.active||x.event.trigger(\"ajaxStop\")))}return C},getJSON:function(e,t,n){return x.get(e,t,n,\"json\")},getScript:function(e,n){return x.get(e,t,n,\"script\")}}),x.each([\"get\",\"post\"],function(e,n){x[n]=function(e,r,i,o){return x.isFunction(r)&&(o=o||i,i=r,r=t),x.ajax({url:e,type:n,dataType:o,data:r,success:i})}});function Mn(e,n,r){var i,o,a,s,l=e.contents,u=e.dataTypes;while(\"*\"===u[0])u.shift(),o===t&&(o=e.mimeType||n.getResponseHeader(\"Content-Type\"));if(o)for(s in l)if(l[s]&&l[s].test(o)){u.unshift(s);break}if(u[0]in r)a=u[0];else{for(s in r){if(!u[0]||e.converters[s+\" \"+u[0]]){a=s;break}i||(i=s)}a=a||i}return a?(a!==u[0]&&u.unshift(a),r[a]):t}function On(e,t,n,r){var i,o,a,s,l,u={},c=e.dataTypes.slice();if(c[1])for(a in e.converters)u[a.toLowerCase()]=e.converters[a];o=c.shift();while(o)if(e.responseFields[o]&&(n[e.responseFields[o]]=t),!l&&r&&e.dataFilter&&(t=e.dataFilter(t,e.dataType)),l=o,o=c.shift())if(\"*\"===o)o=l;else if(\"*\"!==l&&l!==o){if(a=u[l+\" \"+o]||u[\"* \"+o],!a)for(i in u)if(s=i.split(\" \"),s[1]===o&&(a=u[l+\" \"+s[0]]||u[\"* \"+s[0]])){a===!0?a=u[i]:u[i]!==!0&&(o=s[0],c.unshift(s[1]));break}if(a!==!0)if(a&&e[\"throws\"])t=a(t);else try{t=a(t)}catch(p){return{state:\"parsererror\",error:a?p:\"No conversion from \"+l+\" to \"+o}}}return{state:\"success\",data:t}}x.ajaxSetup({accepts:{script:\"text/javascript, application/javascript, application/ecmascript, application/x-ecmascript\"},contents:{script:/(?:java|ecma)script/},converters:{\"text script\":function(e){return x.globalEval(e),e}}}),x.ajaxPrefilter(\"script\",function(e){e.cache===t&&(e.cache=!1),e.crossDomain&&(e.type=\"GET\",e.global=!1)}),x.ajaxTransport(\"script\",function(e){if(e.crossDomain){var n,r=a.head||x(\"head\")[0]||a.documentElement;return{send:function(t,i){n=a.createElement(\"script\"),n.async=!0,e.scriptCharset&&(n.charset=e.scriptCharset),n.src=e.url,n.onload=n.onreadystatechange=function(e,t){(t||!n.readyState||/loaded|complete/.test(n.readyState))&&(n.onload=n.onreadystatechange=null,n.parentNode&&n.parentNode.removeChild(n),n=null,t||i(200,\"success\"))},r.insertBefore(n,r.firstChild)},abort:function(){n&&n.onload(t,!0)}}}});var Fn=[],Bn=/(=)\\?(?=&|$)|\\?\\?/;x.ajaxSetup({jsonp:\"callback\",jsonpCallback:function(){var e=Fn.pop()||x.expando+\"_\"+vn++;return this[e]=!0,e}}),x.ajaxPrefilter(\"json jsonp\",function(n,r,i){var o,a,s,l=n.jsonp!==!1&&(Bn.test(n.url)?\"url\":\"string\"==typeof n.data&&!(n.contentType||\"\").indexOf(\"application/x-www-form-urlencoded\")&&Bn.test(n.data)&&\"data\");return l||\"jsonp\"===n.dataTypes[0]?(o=n.jsonpCallback=x.isFunction(n.jsonpCallback)?n.jsonpCallback():n.jsonpCallback,l?n[l]=n[l].replace(Bn,\"$1\"+o):n.jsonp!==!1&&(n.url+=(bn.test(n.url)?\"&\":\"?\")+n.jsonp+\"=\"+o),n.converters[\"script json\"]=function(){return s||x.error(o+\" was not called\"),s[0]},n.dataTypes[0]=\"json\",a=e[o],e[o]=function(){s=arguments},i.always(function(){e[o]=a,n[o]&&(n.jsonpCallback=r.jsonpCallback,Fn.push(o)),s&&x.isFunction(a)&&a(s[0]),s=a=t}),\"script\"):t});var Pn,Rn,Wn=0,$n=e.ActiveXObject&&function(){var e;for(e in Pn)Pn[e](t,!0)};function In(){try{return new e.XMLHttpRequest}catch(t){}}function zn(){try{return new e.ActiveXObject(\"Microsoft.XMLHTTP\")}catch(t){}}x.ajaxSettings.xhr=e.ActiveXObject?function(){return!this.isLocal&&In()||zn()}:In,Rn=x.ajaxSettings.xhr(),x.support.cors=!!Rn&&\"withCredentials\"in Rn,Rn=x.support.ajax=!!Rn,Rn&&x.ajaxTransport(function(n){if(!n.crossDomain||x.support.cors){var r;return{send:function(i,o){var a,s,l=n.xhr();if(n.username?l.open(n.type,n.url,n.async,n.username,n.password):l.open(n.type,n.url,n.async),n.xhrFields)for(s in n.xhrFields)l[s]=n.xhrFields[s];n.mimeType&&l.overrideMimeType&&l.overrideMimeType(n.mimeType),n.crossDomain||i[\"X-Requested-With\"]||(i[\"X-Requested-With\"]=\"XMLHttpRequest\");try{for(s in i)l.setRequestHeader(s,i[s])}catch(u){}l.send(n.hasContent&&n.data||null),r=function(e,i){var s,u,c,p;try{if(r&&(i||4===l.readyState))if(r=t,a&&(l.onreadystatechange=x.noop,$n&&delete Pn[a]),i)4!==l.readyState&&l.abort();else{p={},s=l.status,u=l.getAllResponseHeaders(),\"string\"==typeof l.responseText&&(p.text=l.responseText);try{c=l.statusText}catch(f){c=\"\"}s||!n.isLocal||n.crossDomain?1223===s&&(s=204):s=p.text?200:404}}catch(d){i||o(-1,d)}p&&o(s,c,p,u)},n.async?4===l.readyState?setTimeout(r):(a=++Wn,$n&&(Pn||(Pn={},x(e).unload($n)),Pn[a]=r),l.onreadystatechange=r):r()},abort:function(){r&&r(t,!0)}}}});var Xn,Un,Vn=/^(?:toggle|show|hide)$/,Yn=RegExp(\"^(?:([+-])=|)(\"+w+\")([a-z%]*)$\",\"i\"),Jn=/queueHooks$/,Gn=[nr],Qn={\"*\":[function(e,t){var n=this.createTween(e,t),r=n.cur(),i=Yn.exec(t),o=i&&i[3]||(x.cssNumber[e]?\"\":\"px\"),a=(x.cssNumber[e]||\"px\"!==o&&+r)&&Yn.exec(x.css(n.elem,e)),s=1,l=20;if(a&&a[3]!==o){o=o||a[3],i=i||[],a=+r||1;do s=s||\".5\",a/=s,x.style(n.elem,e,a+o);while(s!==(s=n.cur()/r)&&1!==s&&--l)}return i&&(a=n.start=+a||+r||0,n.unit=o,n.end=i[1]?a+(i[1]+1)*i[2]:+i[2]),n}]};function Kn(){return setTimeout(function(){Xn=t}),Xn=x.now()}function Zn(e,t,n){var r,i=(Qn[t]||[]).concat(Qn[\"*\"]),o=0,a=i.length;for(;a>o;o++)if(r=i[o].call(n,t,e))return r}function er(e,t,n){var r,i,o=0,a=Gn.length,s=x.Deferred().always(function(){delete l.elem}),l=function(){if(i)return!1;var t=Xn||Kn(),n=Math.max(0,u.startTime+u.duration-t),r=n/u.duration||0,o=1-r,a=0,l=u.tweens.length;for(;l>a;a++)u.tweens[a].run(o);return s.notifyWith(e,[u,o,n]),1>o&&l?n:(s.resolveWith(e,[u]),!1)},u=s.promise({elem:e,props:x.extend({},t),opts:x.extend(!0,{specialEasing:{}},n),originalProperties:t,originalOptions:n,startTime:Xn||Kn(),duration:n.duration,tweens:[],createTween:function(t,n){var r=x.Tween(e,u.opts,t,n,u.opts.specialEasing[t]||u.opts.easing);return u.tweens.push(r),r},stop:function(t){var n=0,r=t?u.tweens.length:0;if(i)return this;for(i=!0;r>n;n++)u.tweens[n].run(1);return t?s.resolveWith(e,[u,t]):s.rejectWith(e,[u,t]),this}}),c=u.props;for(tr(c,u.opts.specialEasing);a>o;o++)if(r=Gn[o].call(u,e,c,u.opts))return r;return x.map(c,Zn,u),x.isFunction(u.opts.start)&&u.opts.start.call(e,u),x.fx.timer(x.extend(l,{elem:e,anim:u,queue:u.opts.queue})),u.progress(u.opts.progress).done(u.opts.done,u.opts.complete).fail(u.opts.fail).always(u.opts.always)}function tr(e,t){var n,r,i,o,a;for(n in e)if(r=x.camelCase(n),i=t[r],o=e[n],x.isArray(o)&&(i=o[1],o=e[n]=o[0]),n!==r&&(e[r]=o,delete e[n]),a=x.cssHooks[r],a&&\"expand\"in a){o=a.expand(o),delete e[r];for(n in o)n in e||(e[n]=o[n],t[n]=i)}else t[r]=i}x.Animation=x.extend(er,{tweener:function(e,t){x.isFunction(e)?(t=e,e=[\"*\"]):e=e.split(\" \");var n,r=0,i=e.length;for(;i>r;r++)n=e[r],Qn[n]=Qn[n]||[],Qn[n].unshift(t)},prefilter:function(e,t){t?Gn.unshift(e):Gn.push(e)}});function nr(e,t,n){var r,i,o,a,s,l,u=this,c={},p=e.style,f=e.nodeType&&nn(e),d=x._data(e,\"fxshow\");n.queue||(s=x._queueHooks(e,\"fx\"),null==s.unqueued&&(s.unqueued=0,l=s.empty.fire,s.empty.fire=function(){s.unqueued||l()}),s.unqueued++,u.always(function(){u.always(function(){s.unqueued--,x.queue(e,\"fx\").length||s.empty.fire()})})),1===e.nodeType&&(\"height\"in t||\"width\"in t)&&(n.overflow=[p.overflow,p.overflowX,p.overflowY],\"inline\"===x.css(e,\"display\")&&\"none\"===x.css(e,\"float\")&&(x.support.inlineBlockNeedsLayout&&\"inline\"!==ln(e.nodeName)?p.zoom=1:p.display=\"inline-block\")),n.overflow&&(p.overflow=\"hidden\",x.support.shrinkWrapBlocks||u.always(function(){p.overflow=n.overflow[0],p.overflowX=n.overflow[1],p.overflowY=n.overflow[2]}));for(r in t)if(i=t[r],Vn.exec(i)){if(delete t[r],o=o||\"toggle\"===i,i===(f?\"hide\":\"show\"))continue;c[r]=d&&d[r]||x.style(e,r)}if(!x.isEmptyObject(c)){d?\"hidden\"in d&&(f=d.hidden):d=x._data(e,\"fxshow\",{}),o&&(d.hidden=!f),f?x(e).show():u.done(function(){x(e).hide()}),u.done(function(){var t;x._removeData(e,\"fxshow\");for(t in c)x.style(e,t,c[t])});for(r in c)a=Zn(f?d[r]:0,r,u),r in d||(d[r]=a.start,f&&(a.end=a.start,a.start=\"width\"===r||\"height\"===r?1:0))}}function rr(e,t,n,r,i){return new rr.prototype.init(e,t,n,r,i)}x.Tween=rr,rr.prototype={constructor:rr,init:function(e,t,n,r,i,o){this.elem=e,this.prop=n,this.easing=i||\"swing\",this.options=t,this.start=this.now=this.cur(),this.end=r,this.unit=o||(x.cssNumber[n]?\"\":\"px\")},cur:function(){var e=rr.propHooks[this.prop];return e&&e.get?e.get(this):rr.propHooks._default.get(this)},run:function(e){var t,n=rr.propHooks[this.prop];return this.pos=t=this.options.duration?x.easing[this.easing](e,this.options.duration*e,0,1,this.options.duration):e,this.now=(this.end-this.start)*t+this.start,this.options.step&&this.options.step.call(this.elem,this.now,this),n&&n.set?n.set(this):rr.propHooks._default.set(this),this}},rr.prototype.init.prototype=rr.prototype,rr.propHooks={_default:{get:function(e){var t;return null==e.elem[e.prop]||e.elem.style&&null!=e.elem.style[e.prop]?(t=x.css(e.elem,e.prop,\"\"),t&&\"auto\"!==t?t:0):e.elem[e.prop]},set:function(e){x.fx.step[e.prop]?x.fx.step[e.prop](e):e.elem.style&&(null!=e.elem.style[x.cssProps[e.prop]]||x.cssHooks[e.prop])?x.style(e.elem,e.prop,e.now+e.unit):e.elem[e.prop]=e.now}}},rr.propHooks.scrollTop=rr.propHooks.scrollLeft={set:function(e){e.elem.nodeType&&e.elem.parentNode&&(e.elem[e.prop]=e.now)}},x.each([\"toggle\",\"show\",\"hide\"],function(e,t){var n=x.fn[t];x.fn[t]=function(e,r,i){return null==e||\"boolean\"==typeof e?n.apply(this,arguments):this.animate(ir(t,!0),e,r,i)}}),x.fn.extend({fadeTo:function(e,t,n,r){return this.filter(nn).css(\"opacity\",0).show().end().animate({opacity:t},e,n,r)},animate:function(e,t,n,r){var i=x.isEmptyObject(e),o=x.speed(t,n,r),a=function(){var t=er(this,x.extend({},e),o);(i||x._data(this,\"finish\"))&&t.stop(!0)};return a.finish=a,i||o.queue===!1?this.each(a):this.queue(o.queue,a)},stop:function(e,n,r){var i=function(e){var t=e.stop;delete e.stop,t(r)};return\"string\"!=typeof e&&(r=n,n=e,e=t),n&&e!==!1&&this.queue(e||\"fx\",[]),this.each(function(){var t=!0,n=null!=e&&e+\"queueHooks\",o=x.timers,a=x._data(this);if(n)a[n]&&a[n].stop&&i(a[n]);else for(n in a)a[n]&&a[n].stop&&Jn.test(n)&&i(a[n]);for(n=o.length;n--;)o[n].elem!==this||null!=e&&o[n].queue!==e||(o[n].anim.stop(r),t=!1,o.splice(n,1));(t||!r)&&x.dequeue(this,e)})},finish:function(e){return e!==!1&&(e=e||\"fx\"),this.each(function(){var t,n=x._data(this),r=n[e+\"queue\"],i=n[e+\"queueHooks\"],o=x.timers,a=r?r.length:0;for(n.finish=!0,x.queue(this,e,[]),i&&i.stop&&i.stop.call(this,!0),t=o.length;t--;)o[t].elem===this&&o[t].queue===e&&(o[t].anim.stop(!0),o.splice(t,1));for(t=0;a>t;t++)r[t]&&r[t].finish&&r[t].finish.call(this);delete n.finish})}});function ir(e,t){var n,r={height:e},i=0;for(t=t?1:0;4>i;i+=2-t)n=Zt[i],r[\"margin\"+n]=r[\"padding\"+n]=e;return t&&(r.opacity=r.width=e),r}x.each({slideDown:ir(\"show\"),slideUp:ir(\"hide\"),slideToggle:ir(\"toggle\"),fadeIn:{opacity:\"show\"},fadeOut:{opacity:\"hide\"},fadeToggle:{opacity:\"toggle\"}},function(e,t){x.fn[e]=function(e,n,r){return this.animate(t,e,n,r)}}),x.speed=function(e,t,n){var r=e&&\"object\"==typeof e?x.extend({},e):{complete:n||!n&&t||x.isFunction(e)&&e,duration:e,easing:n&&t||t&&!x.isFunction(t)&&t};return r.duration=x.fx.off?0:\"number\"==typeof r.duration?r.duration:r.duration in x.fx.speeds?x.fx.speeds[r.duration]:x.fx.speeds._default,(null==r.queue||r.queue===!0)&&(r.queue=\"fx\"),r.old=r.complete,r.complete=function(){x.isFunction(r.old)&&r.old.call(this),r.queue&&x.dequeue(this,r.queue)},r},x.easing={linear:function(e){return e},swing:function(e){return.5-Math.cos(e*Math.PI)/2}},x.timers=[],x.fx=rr.prototype.init,x.fx.tick=function(){var e,n=x.timers,r=0;for(Xn=x.now();n.length>r;r++)e=n[r],e()||n[r]!==e||n.splice(r--,1);n.length||x.fx.stop(),Xn=t},x.fx.timer=function(e){e()&&x.timers.push(e)&&x.fx.start()},x.fx.interval=13,x.fx.start=function(){Un||(Un=setInterval(x.fx.tick,x.fx.interval))},x.fx.stop=function(){clearInterval(Un),Un=null},x.fx.speeds={slow:600,fast:200,_default:400},x.fx.step={},x.expr&&x.expr.filters&&(x.expr.filters.animated=function(e){return x.grep(x.timers,function(t){return e===t.elem}).length}),x.fn.offset=function(e){if(arguments.length)return e===t?this:this.each(function(t){x.offset.setOffset(this,e,t)});var n,r,o={top:0,left:0},a=this[0],s=a&&a.ownerDocument;if(s)return n=s.documentElement,x.contains(n,a)?(typeof a.getBoundingClientRect!==i&&(o=a.getBoundingClientRect()),r=or(s),{top:o.top+(r.pageYOffset||n.scrollTop)-(n.clientTop||0),left:o.left+(r.pageXOffset||n.scrollLeft)-(n.clientLeft||0)}):o},x.offset={setOffset:function(e,t,n){var r=x.css(e,\"position\");\"static\"===r&&(e.style.position=\"relative\");var i=x(e),o=i.offset(),a=x.css(e,\"top\"),s=x.css(e,\"left\"),l=(\"absolute\"===r||\"fixed\"===r)&&x.inArray(\"auto\",[a,s])>-1,u={},c={},p,f;l?(c=i.position(),p=c.top,f=c.left):(p=parseFloat(a)||0,f=parseFloat(s)||0),x.isFunction(t)&&(t=t.call(e,n,o)),null!=t.top&&(u.top=t.top-o.top+p),null!=t.left&&(u.left=t.left-o.left+f),\"using\"in t?t.using.call(e,u):i.css(u)}},x.fn.extend({position:function(){if(this[0]){var e,t,n={top:0,left:0},r=this[0];return\"fixed\"===x.css(r,\"position\")?t=r.getBoundingClientRect():(e=this.offsetParent(),t=this.offset(),x.nodeName(e[0],\"html\")||(n=e.offset()),n.top+=x.css(e[0],\"borderTopWidth\",!0),n.left+=x.css(e[0],\"borderLeftWidth\",!0)),{top:t.top-n.top-x.css(r,\"marginTop\",!0),left:t.left-n.left-x.css(r,\"marginLeft\",!0)}}},offsetParent:function(){return this.map(function(){var e=this.offsetParent||s;while(e&&!x.nodeName(e,\"html\")&&\"static\"===x.css(e,\"position\"))e=e.offsetParent;return e||s})}}),x.each({scrollLeft:\"pageXOffset\",scrollTop:\"pageYOffset\"},function(e,n){var r=/Y/.test(n);x.fn[e]=function(i){return x.access(this,function(e,i,o){var a=or(e);return o===t?a?n in a?a[n]:a.document.documentElement[i]:e[i]:(a?a.scrollTo(r?x(a).scrollLeft():o,r?o:x(a).scrollTop()):e[i]=o,t)},e,i,arguments.length,null)}});function or(e){return x.isWindow(e)?e:9===e.nodeType?e.defaultView||e.parentWindow:!1}x.each({Height:\"height\",Width:\"width\"},function(e,n){x.each({padding:\"inner\"+e,content:n,\"\":\"outer\"+e},function(r,i){x.fn[i]=function(i,o){var a=arguments.length&&(r||\"boolean\"!=typeof i),s=r||(i===!0||o===!0?\"margin\":\"border\");return x.access(this,function(n,r,i){var o;return x.isWindow(n)?n.document.documentElement[\"client\"+e]:9===n.nodeType?(o=n.documentElement,Math.max(n.body[\"scroll\"+e],o[\"scroll\"+e],n.body[\"offset\"+e],o[\"offset\"+e],o[\"client\"+e])):i===t?x.css(n,r,s):x.style(n,r,i,s)},n,a?i:t,a,null)}})}),x.fn.size=function(){return this.length},x.fn.andSelf=x.fn.addBack,\"object\"==typeof module&&module&&\"object\"==typeof module.exports?module.exports=x:(e.jQuery=e.$=x,\"function\"==typeof define&&define.amd&&define(\"jquery\",[],function(){return x}))})(window);\n",
+
+	"static/partials/editor.html": "<div id=\"editor-container\" onpageup=\"prevPage()\" onpagedown=\"nextPage()\">\n    <div class=\"relative-content\" ng->\n        <div vertical-slide left=\"#left-side\" right=\"#right-side\"></div>\n        <div id=\"right-side\">\n            <div class=\"relative-content\" autofocus=\"toc.lessons[lessonId].Pages[curPage-1].Files.length==0\">\n                <div class=\"slide-content\" ng-bind-html-unsafe=\"toc.lessons[lessonId].Pages[curPage-1].Content\"></div>\n\n                <div class=\"bar module-bar\">\n                    <a href=\"\" class=\"prev-page\" ng-click=\"prevPage()\">&lt;</a>\n                    <span>{{curPage}}/{{toc.lessons[lessonId].Pages.length}}</span>\n                    <a href=\"\" class=\"next-page\" ng-click=\"nextPage()\">&gt;</a>\n                </div>\n            </div>\n        </div>\n\n        <div id=\"left-side\" class=\"relative-content\">\n            <div id=\"explorer\" ng-class=\"{hidden: toc.lessons[lessonId].Pages[curPage-1].Files.length==0}\">\n                <a class=\"menu-button\" ng-repeat=\"f in toc.lessons[lessonId].Pages[curPage-1].Files\" ng-click=\"openFile($index)\" ng-class=\"{active: $index==curFile}\">{{f.Name}}</a>\n                <a syntax-checkbox ng-class=\"{active: editor.syntax}\" class=\"menu-button syntax-checkbox\">{{'syntax' | i18n}}</a> \n            </div>\n\n            <div class=\"relative-content\" ng-class=\"{hidden: toc.lessons[lessonId].Pages[curPage-1].Files.length==0}\">\n\n                <div id=\"top-part\">\n                    <div class=\"relative-content\">\n                        <div id=\"file-editor\">\n                            <textarea ui-codemirror ng-model=\"toc.lessons[lessonId].Pages[curPage-1].Files[curFile].Content\"></textarea>\n                        </div>\n                    </div>\n                </div>\n\n                <div horizontal-slide top=\"#top-part\" bottom=\"#bottom-part\"></div>\n\n                <div id=\"bottom-part\">\n                    <div class=\"relative-content\">\n                        <!--div id=\"file-menu\" ng-controller=\"OutputCtrl\"-->\n                        <div id=\"file-menu\">\n                            <a class=\"menu-button\" id=\"run\" ng-click=\"run()\">{{'run' | i18n}}</a>\n                            <a class=\"menu-button\" id=\"check\" ng-click=\"check()\" ng-show=\"toc.lessons[lessonId].Pages[curPage-1].Files[curFile].Check\">{{'check' | i18n}}</a>\n                            <a class=\"menu-button\" id=\"format\" ng-click=\"format()\">{{'format' | i18n}}</a>\n                            <a class=\"menu-button\" id=\"reset\" ng-click=\"reset()\">{{'reset' | i18n}}</a>\n                        </div>\n\n                        <div class=\"output\" ng-repeat=\"f in toc.lessons[lessonId].Pages[curPage-1].Files\" ng-class=\"{active: $index==curFile}\" ng-bind-html-unsafe=\"f.Output\">\n                        </div>\n                    </div>\n                </div>\n            </div>\n        </div>\n\n    </div>\n</div>\n",
+
+	"static/partials/lesson.html": "<div class=\"lesson\">\n    <a href=\"/{{name}}\" class=\"lesson-title\">{{title}}</a>\n    <p>{{description}}</p>\n</div>\n",
+
+	"static/partials/list.html": "<div class=\"wrapper\">\n    <div class=\"container\">\n\n        <div class=\"page-header\">\n            <h1>{{'welcome' | i18n}}</h1>\n        </div>\n\n        <form class=\"session\" ng-controller=\"SessionCtrl\" ng-show=\"enabled\" ng-submit=\"resume()\">\n            <label>{{'sessionname' | i18n}} <input type=\"text\" ng-model=\"name\"></label>\n            <label>{{'sessionpin' | i18n}} <input type=\"password\" ng-model=\"pin\"></label>\n            <input type=\"submit\" value=\"{{'resume' | i18n}}\">\n            <span class=\"error\" ng-show=\"error\">{{error}}</span>\n            <span ng-show=\"session.Last\">\n                {{'visited' | i18n}} {{session.Visited.length}} ·\n                <a href=\"/{{session.Last}}\">{{'continue' | i18n}}</a>\n            </span>\n        </form>\n\n        <div class=\"module\" ng-repeat=\"m in toc.modules\">\n            <p id=\"{{m.id}}\" class=\"module-title\">{{m.title}}</p>\n            <div ng-bind-html-unsafe=\"m.description\"></div>\n\n            <div class=\"lesson\" ng-repeat=\"l in m.lessons\">\n                <a href=\"/{{l}}\" class=\"lesson-title\">{{m.lesson[l].Title}}</a>\n                <p>{{m.lesson[l].Description}}</p>\n            </div>\n        </div>\n\n    </div>\n</div>\n",
+
+	"static/partials/toc-button.html": "<img class=\"nav\" src=\"/static/img/burger.png\" alt=\"menu\">",
+
+	"static/partials/toc.html": "<div class=\"toc\">\n    <ul>\n        <li ng-repeat=\"m in toc.modules\" class=\"toc-module\" id=\"toc-m-{{m.id}}\">\n            <span>{{m.title}}</span>\n            <ul>\n                <li ng-repeat=\"l in m.lessons\" class=\"toc-lesson\" id=\"toc-l-{{l}}\" ng-class=\"{active: l==params.lessonId}\">\n                    <span ng-click=\"toggleLesson(l)\">{{m.lesson[l].Title}}</span>\n                    <ul>\n                        <li ng-repeat=\"p in m.lesson[l].Pages\" class=\"toc-page\" ng-class=\"{active: l==params.lessonId && $index+1==params.pageNumber}\">\n                            <a href=\"/{{l}}/{{$index+1}}\" ng-click=\"hideTOC(true)\">{{p | pageTitle}}</a>\n                        </li>\n                    </ul>\n                </li>\n            </ul>\n        </li>\n    </ul>\n    <div class=\"click-catcher\" ng-click=\"hideTOC(false)\"></div>\n</div>\n",
+
+	"template/action.tmpl": "{{/*{\nThis is the action template.\nIt determines how the formatting actions are rendered.\n*/}}\n\n{{define \"section\"}}\n  {{with trtitle .Elem}}\n  <h2 class=\"english\">{{$.Title}}</h2>\n  <h2 class=\"chinese\">{{.}}</h2>\n  {{else}}\n  <h2>{{.Title}}</h2>\n  {{end}}\n  {{range .Elem}}{{elem $.Template .}}{{end}}\n{{end}}\n\n{{define \"trtitle\"}}{{/* shown with the title of the section */}}{{end}}\n\n{{define \"list\"}}\n  <ul>\n  {{range .Bullet}}\n    <li>{{style .}}</li>\n  {{end}}\n  </ul>\n{{end}}\n\n{{define \"text\"}}\n  {{if .Pre}}\n  <pre>{{range .Lines}}{{.}}{{end}}</pre>\n  {{else}}\n  <p>\n    {{range $i, $l := .Lines}}{{if $i}}{{template \"newline\"}}\n    {{end}}{{style $l}}{{end}}\n  </p>\n  {{end}}\n{{end}}\n\n{{define \"code\"}}\n\t{{if .Play}}\n\t\t{{/* playable code is not displayed in the slides */}}\n\t{{else}}\n\t\t<div>{{.Text}}</div>\n\t{{end}}\n{{end}}\n\n{{define \"image\"}}\n  <img src=\"{{.URL}}\"{{with .Height}} height=\"{{.}}\"{{end}}{{with .Width}} width=\"{{.}}\"{{end}}>\n{{end}}\n\n{{define \"link\"}}\n  <p class=\"link\"><a href=\"{{.URL}}\" target=\"_blank\">{{style .Label}}</a></p>\n{{end}}\n\n{{define \"html\"}}{{.HTML}}{{end}}\n\n{{define \"newline\"}}\n{{/* No automatic line break. Paragraphs are free-form. */}}\n{{end}}\n",
+
+	"template/index.tmpl": "<!doctype html>\n<html lang=\"{{.Lang}}\" ng-app=\"tour\">\n\n<head>\n    <meta charset=\"utf-8\">\n    <title>{{index .Messages \"title\"}}</title>\n    <meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0, minimum-scale=1.0, maximum-scale=1.0, user-scalable=no\">\n    <meta name=\"apple-mobile-web-app-capable\" content=\"yes\">\n    <meta name=\"mobile-web-app-capable\" content=\"yes\">\n    <link rel=\"shortcut icon\" sizes=\"196x196\" href=\"/static/img/favicon.ico\">\n    <link rel=\"stylesheet\" href=\"/static/css/app.css\" />\n    <link rel=\"stylesheet\" href=\"/static/lib/codemirror/lib/codemirror.css\">\n    <link href='//fonts.googleapis.com/css?family=Inconsolata' rel='stylesheet' type='text/css'>\n</head>\n\n<body>\n    <div class=\"bar top-bar\">\n        <a class=\"left logo\" href=\"/list\">{{index .Messages \"title\"}}</a>\n        <div table-of-contents-button=\".toc\"></div>\n        <div language-switch></div>\n    </div>\n\n    <div table-of-contents></div>\n\n    <div ng-view ng-cloak class=\"ng-cloak\"></div>\n\n    <script src=\"/script.js\"></script>\n    <script>\n    window.transport = {{.Transport}}();\n    window.socketAddr = \"{{.SocketAddr}}\";\n\n    function highlight(selector) {\n        var speed = 50;\n        var obj = $(selector).stop(true, true)\n        for (var i = 0; i < 5; i++) {\n            obj.addClass(\"highlight\", speed)\n            obj.delay(speed)\n            obj.removeClass(\"highlight\", speed)\n        }\n    }\n\n    function highlightAndClick(selector) {\n        highlight(selector);\n        setTimeout(function() {\n            $(selector)[0].click()\n        }, 750);\n    }\n\n    function click(selector) {\n        $(selector)[0].click();\n    }\n    </script>\n</body>\n\n</html>\n",
+
+	"template/progress.tmpl": "<!doctype html>\n<html lang=\"zh-CN\">\n\n<head>\n    <meta charset=\"utf-8\">\n    <title>Go 语言之旅 - 学习进度</title>\n    <style>\n    body {\n        font-family: sans-serif;\n        margin: 20px;\n        color: #333;\n    }\n    table {\n        border-collapse: collapse;\n        margin-bottom: 30px;\n    }\n    th, td {\n        border: 1px solid #ddd;\n        padding: 4px 10px;\n        text-align: right;\n    }\n    th:first-child, td:first-child {\n        text-align: left;\n    }\n    th {\n        background-color: #e0ebf5;\n    }\n    .anonymous {\n        color: #999;\n        font-family: monospace;\n    }\n    </style>\n</head>\n\n<body>\n    <h1>学习进度</h1>\n\n    <h2>各模块完成情况</h2>\n    <table>\n        <tr>\n            <th>模块</th>\n            <th>页数</th>\n            <th>开始学习</th>\n            <th>全部完成</th>\n            <th>平均完成度</th>\n            <th>运行次数</th>\n            <th>成功运行</th>\n        </tr>\n        {{range .Modules}}\n        <tr>\n            <td>{{.Title}}</td>\n            <td>{{.Pages}}</td>\n            <td>{{.Started}}</td>\n            <td>{{.Completed}}</td>\n            <td>{{percent .Average}}</td>\n            <td>{{.Runs}}</td>\n            <td>{{.Passes}}</td>\n        </tr>\n        {{end}}\n    </table>\n\n    <h2>学员</h2>\n    {{with .Sessions}}\n    <table>\n        <tr>\n            <th>学员</th>\n            <th>最近访问</th>\n            <th>最后一页</th>\n            <th>完成度</th>\n            <th>运行次数</th>\n            <th>成功运行</th>\n        </tr>\n        {{range .}}\n        <tr>\n            <td{{if .Anonymous}} class=\"anonymous\"{{end}}>{{.Name}}</td>\n            <td>{{.Updated.Format \"2006-01-02 15:04\"}}</td>\n            <td>{{with .Last}}<a href=\"/{{.}}\">{{.}}</a>{{end}}</td>\n            <td>{{percent .Completion}}</td>\n            <td>{{.Runs}}</td>\n            <td>{{.Passes}}</td>\n        </tr>\n        {{end}}\n    </table>\n    {{else}}\n    <p>还没有学员的记录。</p>\n    {{end}}\n</body>\n\n</html>\n",
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bundle holds the files of the tour, for the gotour binaries built
+// with the bundle tag. The files are generated from the tour root by
+//
+//	go generate github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle
+//
+// which must be run again whenever the files of the tour change.
+package bundle // import "github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle"
+
+//go:generate go run makebundle.go
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// root is the tour root, relative to the directory of the package.
+const root = ".."
+
+// dirs lists the directories of the tour root that are bundled: all that
+// the server reads, and the solutions of the exercises for the trainers.
+var dirs = []string{
+	"checks",
+	"content",
+	"gopath",
+	"i18n",
+	"solutions",
+	"static",
+	"template",
+}
+
+const header = `// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by "makebundle"; DO NOT EDIT.
+
+package bundle
+
+`
+
+// Generate reads the files of the tour and returns the source of a file
+// that declares them as a map of string constants, keyed by their slash
+// separated paths relative to the tour root.
+func Generate() ([]byte, error) {
+	var files []string
+	for _, dir := range dirs {
+		err := filepath.Walk(filepath.Join(root, dir), func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(fi.Name(), ".") {
+				if fi.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if fi.Mode().IsRegular() {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(header)
+	buf.WriteString("// Files holds the files of the tour.\nvar Files = map[string]string{\n")
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(buf, "\t%q: %s,\n\n", filepath.ToSlash(rel), strconv.Quote(string(b)))
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
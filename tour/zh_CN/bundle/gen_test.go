@@ -0,0 +1,28 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bundle
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestBundleIsUpToDate(t *testing.T) {
+	old, err := ioutil.ReadFile("files.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(old, buf) {
+		t.Error(`files.go is stale. Run:
+  $ go generate github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle
+  $ git diff
+to see the differences.`)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// Command makebundle writes the files of the tour to "files.go".
+// It is intended to be invoked via "go generate" (directive in "gen.go").
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle"
+)
+
+func main() {
+	buf, err := bundle.Generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "generating files.go: %v\n", err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile("files.go", buf, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "writing files.go: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// This file is built together with a program of the learner and the checks
+// of the exercise, <lesson>/<program> in this directory. The init function
+// of the checks calls the functions the exercise asks for, reports each
+// check with expect and calls finish, so the program ends before its main
+// function runs.
+//
+// The checks are run by the tour server when the learner asks for them;
+// see gotour/check.go.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+var checkFailed bool
+
+// expect reports a check, described by format and args, that passed if ok.
+func expect(ok bool, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if ok {
+		fmt.Println("PASS: " + msg)
+	} else {
+		checkFailed = true
+		fmt.Println("FAIL: " + msg)
+	}
+}
+
+// finish ends the program after the checks, before main runs.
+func finish() {
+	if checkFailed {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,32 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import "golang.org/x/tour/tree"
+
+func init() {
+	for k := 1; k <= 10; k++ {
+		var got []int
+		ch := make(chan int)
+		go Walk(tree.New(k), ch)
+		for v := range ch {
+			got = append(got, v)
+		}
+		ok := len(got) == 10
+		for i, v := range got {
+			ok = ok && v == (i+1)*k
+		}
+		expect(ok, "Walk(tree.New(%d)) 发送了 %v，期望 %d, %d, ..., %d", k, got, k, 2*k, 10*k)
+
+		expect(Same(tree.New(k), tree.New(k)), "Same(tree.New(%d), tree.New(%d)) = false，期望 true", k, k)
+		expect(!Same(tree.New(k), tree.New(k+1)), "Same(tree.New(%d), tree.New(%d)) = true，期望 false", k, k+1)
+	}
+	small := &tree.Tree{Value: 1}
+	expect(!Same(small, tree.New(1)), "Same(单节点树, tree.New(1)) = true，期望 false")
+	expect(!Same(tree.New(1), small), "Same(tree.New(1), 单节点树) = true，期望 false")
+	finish()
+}
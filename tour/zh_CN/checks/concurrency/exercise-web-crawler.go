@@ -0,0 +1,40 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import "sync"
+
+// countingFetcher counts the fetches of each URL.
+type countingFetcher struct {
+	f Fetcher
+
+	mu    sync.Mutex
+	count map[string]int
+}
+
+func (c *countingFetcher) Fetch(url string) (string, []string, error) {
+	c.mu.Lock()
+	c.count[url]++
+	c.mu.Unlock()
+	return c.f.Fetch(url)
+}
+
+func init() {
+	c := &countingFetcher{f: fetcher, count: make(map[string]int)}
+	Crawl("http://golang.org/", 4, c)
+	for _, url := range []string{
+		"http://golang.org/",
+		"http://golang.org/pkg/",
+		"http://golang.org/cmd/",
+		"http://golang.org/pkg/fmt/",
+		"http://golang.org/pkg/os/",
+	} {
+		expect(c.count[url] == 1, "%s 被抓取了 %d 次，期望只抓取一次", url, c.count[url])
+	}
+	expect(len(c.count) == 5, "抓取了 %d 个 URL，期望 5 个", len(c.count))
+	finish()
+}
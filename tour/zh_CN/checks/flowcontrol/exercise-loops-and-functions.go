@@ -0,0 +1,17 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import "math"
+
+func init() {
+	for _, x := range []float64{1, 2, 3, 10, 100, 12345} {
+		got := Sqrt(x)
+		expect(math.Abs(got-math.Sqrt(x)) < 1e-5, "Sqrt(%g) = %g，期望 %g", x, got, math.Sqrt(x))
+	}
+	finish()
+}
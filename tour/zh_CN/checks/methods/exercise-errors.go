@@ -0,0 +1,29 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"math"
+	"strings"
+)
+
+func init() {
+	for _, x := range []float64{1, 2, 3, 10, 1e6} {
+		got, err := Sqrt(x)
+		expect(err == nil && math.Abs(got-math.Sqrt(x)) < 1e-6, "Sqrt(%g) = %g, %v，期望 %g, nil", x, got, err, math.Sqrt(x))
+	}
+	_, err := Sqrt(-2)
+	expect(err != nil, "Sqrt(-2) 返回非 nil 的错误")
+	if err != nil {
+		_, ok := err.(ErrNegativeSqrt)
+		expect(ok, "Sqrt(-2) 返回的错误类型为 %T，期望 ErrNegativeSqrt", err)
+		// Error must not format e with %v, which would call Error again.
+		msg := err.Error()
+		expect(strings.Contains(msg, "-2"), "Sqrt(-2) 的错误信息 %q 中应包含 -2", msg)
+	}
+	finish()
+}
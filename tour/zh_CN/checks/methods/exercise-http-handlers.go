@@ -0,0 +1,27 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+func init() {
+	for _, c := range []struct {
+		h    http.Handler
+		want string
+	}{
+		{String("I'm a frayed knot."), "I'm a frayed knot."},
+		{&Struct{"Hello", ":", "Gophers!"}, "Hello: Gophers!"},
+	} {
+		w := httptest.NewRecorder()
+		c.h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		expect(w.Body.String() == c.want, "%#v 返回了 %q，期望 %q", c.h, w.Body.String(), c.want)
+	}
+	finish()
+}
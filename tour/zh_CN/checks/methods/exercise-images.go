@@ -0,0 +1,37 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"image"
+	"reflect"
+)
+
+func init() {
+	// The fields of Image are up to the learner: set those that are
+	// exported integers, such as a width and a height, to 256.
+	v := reflect.New(reflect.TypeOf(Image{})).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.CanSet() && f.Kind() == reflect.Int {
+			f.SetInt(256)
+		}
+	}
+	var m image.Image = v.Interface().(Image)
+
+	b := m.Bounds()
+	expect(!b.Empty(), "Bounds() = %v，期望非空矩形", b)
+	expect(m.ColorModel() != nil, "ColorModel() 返回非 nil 的颜色模型")
+	if !b.Empty() && m.ColorModel() != nil {
+		ok := true
+		for _, p := range []image.Point{b.Min, b.Max.Sub(image.Pt(1, 1))} {
+			c := m.At(p.X, p.Y)
+			ok = ok && c != nil && m.ColorModel().Convert(c) != nil
+		}
+		expect(ok, "At 对 Bounds() 角上的点返回非 nil 的颜色")
+	}
+	finish()
+}
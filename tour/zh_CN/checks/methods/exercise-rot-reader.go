@@ -0,0 +1,25 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	for _, c := range []struct{ in, want string }{
+		{"Lbh penpxrq gur pbqr!", "You cracked the code!"},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZ", "NOPQRSTUVWXYZABCDEFGHIJKLM"},
+		{"abcdefghijklmnopqrstuvwxyz", "nopqrstuvwxyzabcdefghijklm"},
+		{"0123 -_.!?", "0123 -_.!?"},
+	} {
+		b, err := ioutil.ReadAll(rot13Reader{strings.NewReader(c.in)})
+		expect(err == nil && string(b) == c.want, "从 %q 的 rot13Reader 读到 %q, %v，期望 %q", c.in, b, err, c.want)
+	}
+	finish()
+}
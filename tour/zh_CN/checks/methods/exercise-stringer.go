@@ -0,0 +1,24 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import "fmt"
+
+func init() {
+	for _, c := range []struct {
+		ip   IPAddr
+		want string
+	}{
+		{IPAddr{127, 0, 0, 1}, "127.0.0.1"},
+		{IPAddr{8, 8, 8, 8}, "8.8.8.8"},
+		{IPAddr{255, 0, 10, 200}, "255.0.10.200"},
+	} {
+		got := fmt.Sprint(c.ip)
+		expect(got == c.want, "fmt.Sprint(%#v) = %q，期望 %q", [4]byte(c.ip), got, c.want)
+	}
+	finish()
+}
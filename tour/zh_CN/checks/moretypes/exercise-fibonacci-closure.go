@@ -0,0 +1,26 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+func init() {
+	f := fibonacci()
+	var got []int
+	for i := 0; i < 10; i++ {
+		got = append(got, f())
+	}
+	// The sequence may start with 0, 1 or with 1, 1.
+	ok := len(got) == 10 && (got[0] == 0 || got[0] == 1) && got[1] == 1
+	for i := 2; ok && i < len(got); i++ {
+		ok = got[i] == got[i-1]+got[i-2]
+	}
+	expect(ok, "fibonacci() 返回了 %v，期望连续的斐波那契数", got)
+
+	g := fibonacci()
+	g()
+	expect(f() != g(), "fibonacci() 返回的每个闭包各自保存状态")
+	finish()
+}
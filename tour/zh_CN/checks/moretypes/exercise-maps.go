@@ -0,0 +1,24 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import "reflect"
+
+func init() {
+	for _, c := range []struct {
+		in   string
+		want map[string]int
+	}{
+		{"", map[string]int{}},
+		{"I am learning Go!", map[string]int{"I": 1, "am": 1, "learning": 1, "Go!": 1}},
+		{"a  b\ta\nb a", map[string]int{"a": 3, "b": 2}},
+	} {
+		got := WordCount(c.in)
+		expect(reflect.DeepEqual(got, c.want), "WordCount(%q) = %v，期望 %v", c.in, got, c.want)
+	}
+	finish()
+}
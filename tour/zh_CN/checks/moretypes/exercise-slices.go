@@ -0,0 +1,19 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+func init() {
+	for _, c := range []struct{ dx, dy int }{{256, 256}, {10, 20}, {30, 5}} {
+		p := Pic(c.dx, c.dy)
+		ok := len(p) == c.dy
+		for _, row := range p {
+			ok = ok && len(row) == c.dx
+		}
+		expect(ok, "Pic(%d, %d) 返回 %d 行、每行 %d 个值", c.dx, c.dy, c.dy, c.dx)
+	}
+	finish()
+}
@@ -0,0 +1,48 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pic implements the picture display used by the exercises of the
+// tour. Programs print images as a line starting with "IMAGE:" followed by
+// the base64 encoding of a PNG file, which the tour front end displays.
+package pic
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+)
+
+// Show displays a picture defined by the function f, which is called with
+// the width and height of the picture and returns a slice of rows of
+// grayscale values. The values are shown in shades of blue.
+func Show(f func(dx, dy int) [][]uint8) {
+	const (
+		dx = 256
+		dy = 256
+	)
+	data := f(dx, dy)
+	m := image.NewNRGBA(image.Rect(0, 0, dx, dy))
+	for y := 0; y < dy; y++ {
+		for x := 0; x < dx; x++ {
+			v := data[y][x]
+			i := y*m.Stride + x*4
+			m.Pix[i] = v
+			m.Pix[i+1] = v
+			m.Pix[i+2] = 255
+			m.Pix[i+3] = 255
+		}
+	}
+	ShowImage(m)
+}
+
+// ShowImage displays the image m.
+func ShowImage(m image.Image) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, m); err != nil {
+		panic(err)
+	}
+	fmt.Println("IMAGE:" + base64.StdEncoding.EncodeToString(buf.Bytes()))
+}
@@ -0,0 +1,64 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// A bilingual page of the tour holds its English text and the Chinese
+// translation in the sections of the _tr helper files, as the articles of
+// the blog do, followed by the programs of the page. The translated title
+// is given by a .title directive at the start of the Chinese section:
+//
+//	* Packages
+//
+//	.html _tr/div_begin_en.html
+//
+//	Every Go program is made up of packages.
+//
+//	.html _tr/div_end.html
+//
+//	.html _tr/div_begin_zh_CN.html
+//
+//	.title 包
+//
+//	每个 Go 程序都是由包构成的。
+//
+//	.html _tr/div_end.html
+//
+//	.play basics/packages.go
+//
+// The front end shows either language or both, as the learner chooses.
+// The tourpo command extracts the pages into PO files for translators and
+// merges the translations back into this form.
+
+import (
+	"strings"
+
+	"golang.org/x/tools/present"
+)
+
+func init() {
+	present.Register("title", parseTitle)
+}
+
+// trTitle is the translated title of a page.
+type trTitle struct {
+	Text string
+}
+
+func (t trTitle) TemplateName() string { return "trtitle" }
+
+func parseTitle(_ *present.Context, _ string, _ int, text string) (present.Elem, error) {
+	return trTitle{strings.TrimSpace(strings.TrimPrefix(text, ".title"))}, nil
+}
+
+// translatedTitle returns the translated title among the elements of a
+// page, or the empty string if it has none.
+func translatedTitle(elems []present.Elem) string {
+	for _, e := range elems {
+		if t, ok := e.(trTitle); ok {
+			return t.Text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,16 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build bundle
+
+package main
+
+import (
+	"github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle"
+	"golang.org/x/tools/godoc/vfs/mapfs"
+)
+
+func init() {
+	bundled = mapfs.New(bundle.Files)
+}
@@ -0,0 +1,143 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// This file checks the solutions of the learners to the exercises of the
+// tour. The checks of the exercise <lesson>/<program> are in the file
+// /checks/<lesson>/<program> of the tour files; they are built together with
+// the learner's program and /checks/check.go, and print a line per check:
+//
+//	PASS: <check>
+//	FAIL: <check>
+//
+// A request to /compile with a "check" form value naming an exercise runs
+// its checks instead of the program's main function. The reply is the
+// usual one of the playground, with IsTest and TestsFailed set and the
+// result of each check in Tests.
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
+	"golang.org/x/tools/godoc/vfs"
+)
+
+// checkName matches the names of exercises: <lesson>/<program>.
+var checkName = regexp.MustCompile(`^[a-z]+/[a-z0-9-]+\.go$`)
+
+// hasCheck reports whether the program name of lesson has checks.
+func hasCheck(lesson, name string) bool {
+	_, err := tourFS.Stat(path.Join("/checks", lesson, name))
+	return err == nil
+}
+
+// checkFiles returns the files to build with the program of the named
+// exercise to check it.
+func checkFiles(name string) (map[string][]byte, error) {
+	common, err := vfs.ReadFile(tourFS, "/checks/check.go")
+	if err != nil {
+		return nil, err
+	}
+	check, err := vfs.ReadFile(tourFS, path.Join("/checks", name))
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		"check.go":      common,
+		"prog_check.go": check,
+	}, nil
+}
+
+// A checkResult is the result of one check.
+type checkResult struct {
+	Name   string
+	Passed bool
+}
+
+// checkResponse is the reply to a request to check an exercise.
+type checkResponse struct {
+	*sandbox.Response
+	Tests []checkResult
+}
+
+// checkResults sets the results of the checks in r from the output of the
+// checked program.
+func checkResults(r *checkResponse) {
+	r.IsTest = true
+	var out []string
+	for _, e := range r.Events {
+		if e.Kind == "stdout" {
+			out = append(out, e.Message)
+		}
+	}
+	for _, line := range strings.Split(strings.Join(out, ""), "\n") {
+		switch {
+		case strings.HasPrefix(line, "PASS: "):
+			r.Tests = append(r.Tests, checkResult{strings.TrimPrefix(line, "PASS: "), true})
+		case strings.HasPrefix(line, "FAIL: "):
+			r.Tests = append(r.Tests, checkResult{strings.TrimPrefix(line, "FAIL: "), false})
+			r.TestsFailed++
+		}
+	}
+	if r.TestsFailed == 0 && (r.Status != 0 || r.Errors != "" || len(r.Tests) == 0) {
+		// The program panicked, ran too long or exited before the checks
+		// completed.
+		r.Tests = append(r.Tests, checkResult{"检查未能完成", false})
+		r.TestsFailed++
+	}
+}
+
+// compileHandler serves /compile: it runs programs with runner, as the
+// playground does, or checks exercises.
+type compileHandler struct {
+	runner *sandbox.Runner
+}
+
+func (h compileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("check")
+	if name == "" {
+		h.runner.ServeHTTP(w, r)
+		return
+	}
+	if r.Method != "POST" {
+		http.Error(w, "POST only", http.StatusMethodNotAllowed)
+		return
+	}
+	if !checkName.MatchString(name) {
+		http.Error(w, "bad exercise name", http.StatusBadRequest)
+		return
+	}
+	files, err := checkFiles(name)
+	if os.IsNotExist(err) {
+		http.Error(w, "no checks for "+name, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		http.Error(w, "error reading checks", http.StatusInternalServerError)
+		return
+	}
+	files["prog.go"] = []byte(r.FormValue("body"))
+	resp, err := h.runner.RunFiles(r.Context(), files)
+	if err != nil {
+		log.Printf("checking %s: %v", name, err)
+		http.Error(w, "error running program", http.StatusInternalServerError)
+		return
+	}
+	cr := &checkResponse{Response: resp}
+	if resp.Errors == "" || resp.Errors == "process took too long" {
+		checkResults(cr)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(cr); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
+	"golang.org/x/tools/godoc/vfs"
+	"golang.org/x/tools/present"
+)
+
+// The tests in this file build and run every program of the tour and the
+// solutions of the exercises, so they take a while; they are skipped in
+// short mode.
+//
+// The output of each lesson program is compared with the golden file
+// testdata/golden/<lesson>/<program>.out. Programs whose output is not
+// deterministic, that are meant not to build, or that run until stopped are
+// listed in testdata/programs.txt.
+//
+// Each solution in ../solutions is checked with the checks of its exercise
+// in ../checks, listed in testdata/solutions.txt, through the /compile
+// handler. This proves that the checks can be passed; the programs of the
+// exercises as given must not pass them.
+
+var update = flag.Bool("update", false, "update the golden files of the lesson programs")
+
+const (
+	tourRoot       = ".."
+	programTimeout = 10 * time.Second
+	errTimeout     = "process took too long"
+)
+
+// Modes of programs, as listed in testdata/programs.txt.
+const (
+	modeGolden   = ""                 // output must match the golden file
+	modeNondet   = "nondeterministic" // must build and run; output is not checked
+	modeBuildErr = "builderror"       // must fail to build
+	modeTimeout  = "timeout"          // must run until stopped, like a server
+)
+
+func newTestRunner(t *testing.T, timeout time.Duration) *sandbox.Runner {
+	gopath, err := filepath.Abs(filepath.Join(tourRoot, "gopath"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sandbox.NewRunner(sandbox.Config{
+		Timeout: timeout,
+		GOPATH:  []string{gopath},
+	})
+}
+
+// A program is a .play program of a lesson.
+type program struct {
+	name string // <lesson>/<file name>
+	code []byte
+}
+
+// lessonPrograms parses the lessons and returns their programs. Parsing
+// fails if a program does not exist.
+func lessonPrograms(t *testing.T) []program {
+	articles, err := filepath.Glob(filepath.Join(tourRoot, "content", "*.article"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(articles) == 0 {
+		t.Fatal("no lessons found")
+	}
+	present.PlayEnabled = true // or findPlayCode finds nothing
+	var progs []program
+	for _, name := range articles {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, err := present.Parse(f, name, 0)
+		f.Close()
+		if err != nil {
+			t.Errorf("parsing %s: %v", name, err)
+			continue
+		}
+		lesson := strings.TrimSuffix(filepath.Base(name), ".article")
+		for _, sec := range doc.Sections {
+			for _, c := range findPlayCode(sec) {
+				progs = append(progs, program{lesson + "/" + c.FileName, c.Raw})
+			}
+		}
+	}
+	if len(progs) == 0 {
+		t.Fatal("no programs found")
+	}
+	return progs
+}
+
+// readTable reads the named file of two columns, separated by white space,
+// and returns the second column keyed by the first. Text after # is a
+// comment.
+func readTable(name string) (map[string]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 2 {
+			return nil, fmt.Errorf("%s:%d: want two columns", name, n)
+		}
+		m[f[0]] = f[1]
+	}
+	return m, sc.Err()
+}
+
+// readModes reads the program modes listed in the named file. Each line
+// holds a program name and its mode.
+func readModes(name string) (map[string]string, error) {
+	modes, err := readTable(name)
+	if err != nil {
+		return nil, err
+	}
+	for prog, mode := range modes {
+		switch mode {
+		case modeNondet, modeBuildErr, modeTimeout:
+		default:
+			return nil, fmt.Errorf("%s: %s: unknown mode %q", name, prog, mode)
+		}
+	}
+	return modes, nil
+}
+
+// transcript returns the output of a program run as a single text.
+func transcript(resp *sandbox.Response) []byte {
+	var b bytes.Buffer
+	for _, e := range resp.Events {
+		b.WriteString(e.Message)
+	}
+	if resp.Status != 0 {
+		fmt.Fprintf(&b, "\n[exit status %d]\n", resp.Status)
+	}
+	return b.Bytes()
+}
+
+func TestLessons(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	modes, err := readModes("testdata/programs.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := newTestRunner(t, programTimeout)
+	// Programs that run until stopped are stopped early.
+	shortRunner := newTestRunner(t, time.Second)
+
+	progs := lessonPrograms(t)
+	seen := make(map[string]bool)
+	for _, p := range progs {
+		seen[p.name] = true
+	}
+	for name := range modes {
+		if !seen[name] {
+			t.Errorf("testdata/programs.txt: %s is not a program of the tour", name)
+		}
+	}
+
+	for _, p := range progs {
+		p := p
+		mode := modes[p.name]
+		t.Run(p.name, func(t *testing.T) {
+			t.Parallel()
+			r := runner
+			if mode == modeTimeout {
+				r = shortRunner
+			}
+			resp, err := r.Run(context.Background(), string(p.code))
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch mode {
+			case modeBuildErr:
+				if resp.Errors == "" || resp.Errors == errTimeout {
+					t.Errorf("program built, want build error")
+				}
+				return
+			case modeTimeout:
+				if resp.Errors != errTimeout {
+					t.Errorf("program stopped before the time limit (errors %q), want it to run until stopped", resp.Errors)
+				}
+				return
+			}
+			if resp.Errors != "" {
+				t.Fatalf("run failed:\n%s", resp.Errors)
+			}
+			if mode == modeNondet {
+				return
+			}
+			got := transcript(resp)
+			golden := filepath.Join("testdata", "golden", filepath.FromSlash(strings.TrimSuffix(p.name, ".go")+".out"))
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v (run with -update to create it)", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("output differs from %s\ngot:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
+
+func TestSolutions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	exercises, err := readTable("testdata/solutions.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	solutions, err := filepath.Glob(filepath.Join(tourRoot, "solutions", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(solutions) == 0 {
+		t.Fatal("no solutions found")
+	}
+	tourFS = vfs.OS(tourRoot)
+	checksDir := filepath.Join(tourRoot, "checks")
+	h := compileHandler{newTestRunner(t, programTimeout)}
+
+	proven := make(map[string]bool)
+	for _, sol := range solutions {
+		sol := sol
+		name := filepath.Base(sol)
+		exercise := exercises[name]
+		proven[exercise] = true
+		t.Run(strings.TrimSuffix(name, ".go"), func(t *testing.T) {
+			t.Parallel()
+			code, err := ioutil.ReadFile(sol)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var resp *checkResponse
+			if exercise != "" {
+				resp = check(t, h, exercise, code)
+			} else {
+				resp = checkSolution(t, h, name, code)
+			}
+			if resp.Errors != "" {
+				t.Fatalf("run failed:\n%s", resp.Errors)
+			}
+			for _, c := range resp.Tests {
+				if !c.Passed {
+					t.Error(c.Name)
+				}
+			}
+		})
+	}
+
+	// The checks of each exercise must be passed by a solution, and not by
+	// the program of the exercise as given.
+	checks, err := filepath.Glob(filepath.Join(checksDir, "*", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range checks {
+		exercise := filepath.Base(filepath.Dir(c)) + "/" + filepath.Base(c)
+		if !proven[exercise] {
+			t.Errorf("%s: no solution in testdata/solutions.txt passes the checks", exercise)
+		}
+		t.Run("exercise/"+exercise, func(t *testing.T) {
+			t.Parallel()
+			code, err := ioutil.ReadFile(filepath.Join(tourRoot, "content", filepath.FromSlash(exercise)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp := check(t, h, exercise, code); resp.Errors == "" && resp.TestsFailed == 0 {
+				t.Errorf("the program of the exercise passes its checks unchanged")
+			}
+		})
+	}
+}
+
+// check checks code against the checks of exercise, as the front end does.
+func check(t *testing.T, h http.Handler, exercise string, code []byte) *checkResponse {
+	form := url.Values{"version": {"2"}, "body": {string(code)}, "check": {exercise}}
+	req := httptest.NewRequest("POST", "/compile", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("/compile: %d %s", w.Code, w.Body)
+	}
+	var resp checkResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response == nil {
+		t.Fatalf("/compile: empty response")
+	}
+	return &resp
+}
+
+// checkSolution checks the named solution, which solves no exercise as
+// given, against testdata/solutions/<solution>_check.go.
+func checkSolution(t *testing.T, h compileHandler, name string, code []byte) *checkResponse {
+	common, err := ioutil.ReadFile(filepath.Join(tourRoot, "checks", "check.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	extra, err := ioutil.ReadFile(filepath.Join("testdata", "solutions", strings.TrimSuffix(name, ".go")+"_check.go"))
+	if err != nil {
+		t.Fatalf("no checks for solution: %v", err)
+	}
+	resp, err := h.runner.RunFiles(context.Background(), map[string][]byte{
+		"prog.go":       code,
+		"check.go":      common,
+		"prog_check.go": extra,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := &checkResponse{Response: resp}
+	if resp.Errors == "" {
+		checkResults(cr)
+	}
+	return cr
+}
@@ -0,0 +1,143 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/godoc/vfs"
+)
+
+// The tour reads its files from a virtual file system rooted at the tour
+// root, with slash-separated paths such as /content/welcome.article: the
+// tour root on disk, or the files embedded in the binary by the bundle
+// build mode (see bundle.go), possibly overridden by a directory.
+
+// tourFS is the file system of the tour, set by initTour.
+var tourFS vfs.FileSystem
+
+// bundled holds the files of the tour embedded in the binary, or is nil if
+// the binary was built without them.
+var bundled vfs.FileSystem
+
+// overlay returns the file system of the bundled files in which the files
+// of the tour root dir, if any, replace those of the bundle.
+func overlay(dir string) vfs.FileSystem {
+	return overlayFS{vfs.OS(dir), bundled}
+}
+
+// An overlayFS holds the files of top and those of base that top does not
+// have. Its directories hold the entries of both.
+type overlayFS struct {
+	top, base vfs.FileSystem
+}
+
+func (fs overlayFS) String() string {
+	return "overlay(" + fs.top.String() + ", " + fs.base.String() + ")"
+}
+
+func (fs overlayFS) RootType(path string) vfs.RootType { return "" }
+
+func (fs overlayFS) Open(path string) (vfs.ReadSeekCloser, error) {
+	f, err := fs.top.Open(path)
+	if os.IsNotExist(err) {
+		return fs.base.Open(path)
+	}
+	return f, err
+}
+
+func (fs overlayFS) Lstat(path string) (os.FileInfo, error) {
+	fi, err := fs.top.Lstat(path)
+	if os.IsNotExist(err) {
+		return fs.base.Lstat(path)
+	}
+	return fi, err
+}
+
+func (fs overlayFS) Stat(path string) (os.FileInfo, error) {
+	fi, err := fs.top.Stat(path)
+	if os.IsNotExist(err) {
+		return fs.base.Stat(path)
+	}
+	return fi, err
+}
+
+func (fs overlayFS) ReadDir(path string) ([]os.FileInfo, error) {
+	top, err := fs.top.ReadDir(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	base, err1 := fs.base.ReadDir(path)
+	if err1 != nil && !os.IsNotExist(err1) {
+		return nil, err1
+	}
+	if err != nil && err1 != nil {
+		return nil, err
+	}
+	have := make(map[string]bool)
+	for _, fi := range top {
+		have[fi.Name()] = true
+	}
+	all := top
+	for _, fi := range base {
+		if !have[fi.Name()] {
+			all = append(all, fi)
+		}
+	}
+	sort.Sort(byName(all))
+	return all, nil
+}
+
+type byName []os.FileInfo
+
+func (s byName) Len() int           { return len(s) }
+func (s byName) Less(i, j int) bool { return s[i].Name() < s[j].Name() }
+func (s byName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// parseTemplate parses the template in the named file of fs as a template
+// associated with t, named after the file.
+func parseTemplate(t *template.Template, fs vfs.FileSystem, name string) error {
+	b, err := vfs.ReadFile(fs, name)
+	if err != nil {
+		return err
+	}
+	_, err = t.New(path.Base(name)).Parse(string(b))
+	return err
+}
+
+// extract copies the tree rooted at the directory dir of fs into the
+// directory dst on disk, replacing the files already there.
+func extract(fs vfs.FileSystem, dir, dst string) error {
+	fis, err := fs.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		src, target := path.Join(dir, fi.Name()), filepath.Join(dst, fi.Name())
+		if fi.IsDir() {
+			if err := extract(fs, src, target); err != nil {
+				return err
+			}
+			continue
+		}
+		b, err := vfs.ReadFile(fs, src)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(target, b, 0644); err != nil {
+			return fmt.Errorf("extracting %s: %v", src, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// This file generates the localized values of the tour front end, the UI
+// strings and the table of contents, from the files in the i18n directory
+// of the tour root:
+//
+//	i18n/toc.txt                the modules of the tour and their lessons
+//	i18n/messages/<lang>.txt    the messages of one language
+//
+// Both are line-oriented. Blank lines and lines starting with # are ignored.
+// Each line of toc.txt holds a module id followed by the names of its
+// lessons. Each line of a message catalog holds a key, white space and the
+// message; lines starting with white space continue the message of the
+// previous line. The title and description of a module are the messages
+// with keys toc.<id>.title and toc.<id>.description.
+//
+// The English catalog is the reference: every catalog should have the keys
+// it has, and messages missing from a catalog are shown in English.
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/godoc/vfs"
+)
+
+// refLang is the language of the reference catalog.
+const refLang = "en"
+
+// tocModules is the table of contents, with the titles and descriptions in
+// the language of the UI.
+var tocModules []tocModule
+
+// A catalog maps message keys to messages.
+type catalog map[string]string
+
+// A tocModule is a module of the table of contents, in the form the front
+// end expects.
+type tocModule struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Lessons     []string `json:"lessons"`
+}
+
+// initValues reads the table of contents and the message catalogs in fs,
+// reports their problems, and returns the messages for lang and the
+// JavaScript that defines the tableOfContents and translation values.
+func initValues(fs vfs.FileSystem, lang string) (catalog, []byte, error) {
+	const dir = "/i18n"
+	toc, err := readTOC(fs, path.Join(dir, "toc.txt"))
+	if err != nil {
+		return nil, nil, err
+	}
+	catalogs, err := readCatalogs(fs, path.Join(dir, "messages"))
+	if err != nil {
+		return nil, nil, err
+	}
+	ref, ok := catalogs[refLang]
+	if !ok {
+		return nil, nil, fmt.Errorf("no %s message catalog in %s", refLang, dir)
+	}
+	if _, ok := catalogs[lang]; !ok {
+		return nil, nil, fmt.Errorf("no %s message catalog in %s", lang, dir)
+	}
+	for _, p := range checkValues(toc, catalogs) {
+		log.Print(p)
+	}
+
+	msgs := make(catalog)
+	for k, v := range ref {
+		msgs[k] = v
+	}
+	for k, v := range catalogs[lang] {
+		msgs[k] = v
+	}
+	for i := range toc {
+		m := &toc[i]
+		m.Title = msgs["toc."+m.ID+".title"]
+		m.Description = msgs["toc."+m.ID+".description"]
+	}
+	ui := make(catalog)
+	for k, v := range msgs {
+		if !strings.HasPrefix(k, "toc.") {
+			ui[k] = v
+		}
+	}
+
+	tocModules = toc
+
+	tocJSON, err := json.Marshal(toc)
+	if err != nil {
+		return nil, nil, err
+	}
+	uiJSON, err := json.Marshal(ui)
+	if err != nil {
+		return nil, nil, err
+	}
+	var js bytes.Buffer
+	fmt.Fprintf(&js, "\nangular.module('tour.values').\nvalue('tableOfContents', %s).\nvalue('translation', %s);\n", tocJSON, uiJSON)
+	return msgs, js.Bytes(), nil
+}
+
+// checkValues returns the problems of the table of contents and the
+// catalogs: lessons that are listed twice or have no content, and keys
+// missing from or unknown to a catalog.
+func checkValues(toc []tocModule, catalogs map[string]catalog) []string {
+	var problems []string
+	want := make(map[string]bool)
+	for k := range catalogs[refLang] {
+		if !strings.HasPrefix(k, "toc.") {
+			want[k] = true
+		}
+	}
+	listed := make(map[string]bool)
+	for _, m := range toc {
+		want["toc."+m.ID+".title"] = true
+		want["toc."+m.ID+".description"] = true
+		for _, l := range m.Lessons {
+			if listed[l] {
+				problems = append(problems, fmt.Sprintf("toc.txt: lesson %q is listed more than once", l))
+			}
+			listed[l] = true
+			if _, ok := lessons[l]; !ok {
+				problems = append(problems, fmt.Sprintf("toc.txt: lesson %q has no content", l))
+			}
+		}
+	}
+	var names []string
+	for name := range lessons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !listed[name] {
+			problems = append(problems, fmt.Sprintf("toc.txt: lesson %q is not in the table of contents", name))
+		}
+	}
+
+	var langs []string
+	for lang := range catalogs {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	for _, lang := range langs {
+		c := catalogs[lang]
+		var missing, unknown []string
+		for k := range want {
+			if _, ok := c[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+		for k := range c {
+			if !want[k] {
+				unknown = append(unknown, k)
+			}
+		}
+		sort.Strings(missing)
+		sort.Strings(unknown)
+		for _, k := range missing {
+			problems = append(problems, fmt.Sprintf("messages/%s.txt: missing key %s", lang, k))
+		}
+		for _, k := range unknown {
+			problems = append(problems, fmt.Sprintf("messages/%s.txt: unknown key %s", lang, k))
+		}
+	}
+	return problems
+}
+
+// readTOC reads the table of contents in the named file of fs.
+func readTOC(fs vfs.FileSystem, name string) ([]tocModule, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var toc []tocModule
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			return nil, fmt.Errorf("%s:%d: module %q has no lessons", name, n, f[0])
+		}
+		toc = append(toc, tocModule{ID: f[0], Lessons: f[1:]})
+	}
+	return toc, sc.Err()
+}
+
+// readCatalogs reads the message catalogs in the directory dir of fs, keyed
+// by language.
+func readCatalogs(fs vfs.FileSystem, dir string) (map[string]catalog, error) {
+	files, err := fs.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	catalogs := make(map[string]catalog)
+	for _, fi := range files {
+		if path.Ext(fi.Name()) != ".txt" {
+			continue
+		}
+		c, err := readCatalog(fs, path.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		catalogs[strings.TrimSuffix(fi.Name(), ".txt")] = c
+	}
+	return catalogs, nil
+}
+
+// readCatalog reads the message catalog in the named file of fs.
+func readCatalog(fs vfs.FileSystem, name string) (catalog, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c := make(catalog)
+	var last string // key of the previous message
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		text := sc.Text()
+		line := strings.TrimSpace(text)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case text[0] == ' ' || text[0] == '\t':
+			if last == "" {
+				return nil, fmt.Errorf("%s:%d: continuation line without a message", name, n)
+			}
+			c[last] += "\n" + line
+			continue
+		}
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			return nil, fmt.Errorf("%s:%d: missing message for key %q", name, n, line)
+		}
+		key := line[:i]
+		if _, ok := c[key]; ok {
+			return nil, fmt.Errorf("%s:%d: duplicate key %q", name, n, key)
+		}
+		c[key] = strings.TrimSpace(line[i:])
+		last = key
+	}
+	return c, sc.Err()
+}
@@ -0,0 +1,283 @@
+// Copyright 2013 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command gotour serves the Chinese Go tour of tour/zh_CN on the local
+// machine.
+//
+// Code is formatted and run by the server itself, so the tour works without
+// a network connection. Programs are built with the local go command and run
+// in a sandbox with time and output limits; the packages the exercises
+// import, such as golang.org/x/tour/pic, are provided by the workspace in
+// tour/zh_CN/gopath. The solutions of the learners to the exercises are
+// checked by the hidden checks in tour/zh_CN/checks.
+//
+// With the -progress flag, the server keeps the progress of each learner in
+// the named file: the pages visited and the programs run. Learners who give
+// their name and a PIN in the table of contents can resume the tour from
+// another machine, and trainers can follow the completion of each module at
+// /progress/admin, which is only served to the local machine unless
+// -admin_password is set.
+//
+// For trainings without network access, gotour can be built as a single
+// binary holding all the files of the tour, generated into the bundle
+// package:
+//
+//	go generate github.com/golang-china/golangdoc.translations/tour/zh_CN/bundle
+//	go build -tags bundle github.com/golang-china/golangdoc.translations/tour/zh_CN/gotour
+//
+// Such a binary serves the tour from anywhere, with the same URLs. Given
+// -root, it serves the files of that directory instead of the bundled
+// ones, so a lesson can be edited without rebuilding; files missing from
+// the directory are still served from the bundle. Running programs still
+// needs the go command.
+//
+// If the port of the default address is taken, gotour listens on a free
+// one.
+//
+// Usage:
+//
+//	gotour [-http=127.0.0.1:3999] [-root=dir] [-lang=zh_CN] [-openbrowser=true]
+//	       [-progress=file] [-admin_password=password]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/build"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/golang-china/golangdoc.translations/internal/sandbox"
+	"golang.org/x/tools/godoc/vfs"
+	"golang.org/x/tools/godoc/vfs/httpfs"
+)
+
+const basePkg = "github.com/golang-china/golangdoc.translations/tour/zh_CN"
+
+var (
+	httpListen  = flag.String("http", "127.0.0.1:3999", "host:port to listen on")
+	rootDir     = flag.String("root", "", "tour root directory (default: found in the current directory or GOPATH, or bundled)")
+	openBrowser = flag.Bool("openbrowser", true, "open browser automatically")
+	lang        = flag.String("lang", "zh_CN", "language of the UI: the name of a message catalog in i18n/messages")
+	runTimeout  = flag.Duration("run_timeout", 10*time.Second, "time limit for running a program")
+
+	progressFile  = flag.String("progress", "", "file in which to keep the progress of learners (default: progress is kept by the browser only)")
+	adminPassword = flag.String("admin_password", "", "password of the progress admin page (default: the page is served to this machine only)")
+)
+
+func main() {
+	flag.Parse()
+
+	host, port, err := net.SplitHostPort(*httpListen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if host == "" {
+		host = "localhost"
+	}
+	if host != "127.0.0.1" && host != "localhost" {
+		log.Print(localhostWarning)
+	}
+	httpAddr := host + ":" + port
+
+	fs, gopath, err := tourFiles()
+	if err != nil {
+		log.Fatalf("Couldn't find tour files: %v", err)
+	}
+
+	files := http.FileServer(httpfs.New(fs))
+	img := vfs.NameSpace{}
+	img.Bind("/", fs, "/static/img", vfs.BindReplace)
+	http.Handle("/favicon.ico", http.FileServer(httpfs.New(img)))
+	http.Handle("/static/", files)
+	http.Handle("/content/", files)
+
+	http.HandleFunc("/", rootHandler)
+	http.HandleFunc("/lesson/", lessonHandler)
+	http.Handle("/compile", compileHandler{sandbox.NewRunner(sandbox.Config{
+		Timeout: *runTimeout,
+		GOPATH:  []string{gopath},
+	})})
+
+	if *progressFile != "" {
+		if err := initProgress(fs, *progressFile, *adminPassword); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := initTour(fs, "HTTPTransport", *lang); err != nil {
+		log.Fatal(err)
+	}
+
+	l, err := listen(httpAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go func() {
+		url := "http://" + l.Addr().String()
+		if waitServer(url) && *openBrowser && startBrowser(url) {
+			log.Printf("A browser window should open. If not, please visit %s", url)
+		} else {
+			log.Printf("Please open your web browser and visit %s", url)
+		}
+	}()
+	log.Fatal(http.Serve(l, nil))
+}
+
+// listen listens on addr or, if addr is the default address and its port is
+// taken, on a free port of the same host.
+func listen(addr string) (net.Listener, error) {
+	l, err := net.Listen("tcp", addr)
+	if err == nil || flagSet("http") {
+		return l, err
+	}
+	host, _, _ := net.SplitHostPort(addr)
+	log.Printf("Couldn't listen on %s (%v); using a free port", addr, err)
+	return net.Listen("tcp", net.JoinHostPort(host, "0"))
+}
+
+// flagSet reports whether the named flag was given on the command line.
+func flagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// tourFiles returns the file system of the tour files and the workspace of
+// the packages that programs may import. The files are those bundled in the
+// binary, overridden by the directory given by -root if any, or the files of
+// the tour root directory.
+func tourFiles() (fs vfs.FileSystem, gopath string, err error) {
+	if bundled == nil {
+		root, err := findRoot()
+		if err != nil {
+			return nil, "", err
+		}
+		log.Println("Serving content from", root)
+		return vfs.OS(root), filepath.Join(root, "gopath"), nil
+	}
+	fs = bundled
+	if *rootDir != "" {
+		log.Println("Serving content from", *rootDir, "over the bundled content")
+		fs = overlay(*rootDir)
+	} else {
+		log.Println("Serving the bundled content")
+	}
+	// The go command needs the workspace on disk.
+	gopath = filepath.Join(os.TempDir(), "gotour-gopath")
+	if err := extract(fs, "/gopath", gopath); err != nil {
+		return nil, "", err
+	}
+	return fs, gopath, nil
+}
+
+// findRoot returns the tour root directory: the one given by -root, the
+// current directory or its parent if they hold the tour, or the tour
+// package in GOPATH.
+func findRoot() (string, error) {
+	if *rootDir != "" {
+		return *rootDir, nil
+	}
+	isRoot := func(dir string) bool {
+		_, err := os.Stat(filepath.Join(dir, "content", "welcome.article"))
+		return err == nil
+	}
+	for _, dir := range []string{".", ".."} {
+		if isRoot(dir) {
+			return filepath.Abs(dir)
+		}
+	}
+	p, err := build.Default.Import(basePkg, "", build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+	if !isRoot(p.Dir) {
+		return "", fmt.Errorf("no tour content in %s", p.Dir)
+	}
+	return p.Dir, nil
+}
+
+// rootHandler returns a handler for all the requests except the ones for lessons.
+// Learners coming back to the tour's home page are sent to the last page
+// they visited.
+func rootHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/" && progress != nil {
+		if last := progress.lastPage(r); last != "" {
+			http.Redirect(w, r, "/"+last, http.StatusFound)
+			return
+		}
+	}
+	if err := renderUI(w); err != nil {
+		log.Println(err)
+	}
+}
+
+// lessonHandler handler the HTTP requests for lessons.
+func lessonHandler(w http.ResponseWriter, r *http.Request) {
+	lesson := strings.TrimPrefix(r.URL.Path, "/lesson/")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := writeLesson(lesson, w); err != nil {
+		if err == errLessonNotFound {
+			http.NotFound(w, r)
+		} else {
+			log.Println(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+const localhostWarning = `
+WARNING!  WARNING!  WARNING!
+
+I appear to be listening on an address that is not localhost.
+Anyone with access to this address and port will have access
+to this machine as the user running gotour.
+
+If you don't understand this message, hit Control-C to terminate this process.
+
+WARNING!  WARNING!  WARNING!
+`
+
+// waitServer waits some time for the http Server to start
+// serving url. The return value reports whether it starts.
+func waitServer(url string) bool {
+	tries := 20
+	for tries > 0 {
+		resp, err := http.Get(url)
+		if err == nil {
+			resp.Body.Close()
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+		tries--
+	}
+	return false
+}
+
+// startBrowser tries to open the URL in a browser, and returns
+// whether it succeed.
+func startBrowser(url string) bool {
+	// try to start the browser
+	var args []string
+	switch runtime.GOOS {
+	case "darwin":
+		args = []string{"open"}
+	case "windows":
+		args = []string{"cmd", "/c", "start"}
+	default:
+		args = []string{"xdg-open"}
+	}
+	cmd := exec.Command(args[0], append(args[1:], url)...)
+	return cmd.Start() == nil
+}
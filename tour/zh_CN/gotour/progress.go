@@ -0,0 +1,456 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// This file keeps the progress of learners on the server, so that they can
+// resume the tour on another machine and trainers can follow a class.
+//
+// A session is created for each browser the first time it reports
+// progress, and is identified by a cookie. Sessions are anonymous until
+// the learner gives a name and a PIN; giving the name of an existing
+// session with its PIN resumes that session on another browser. The PIN
+// keeps learners out of each other's sessions in a class, but the store is
+// still meant for a trusted network, not for the public internet.
+//
+// The front end reports the pages visited and the programs run under
+// /progress/. The admin page, /progress/admin, aggregates the completion of
+// each module of the table of contents.
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/tools/godoc/vfs"
+)
+
+// sessionCookie is the name of the cookie holding the session ID.
+const sessionCookie = "tour-session"
+
+// A session records the progress of one learner.
+type session struct {
+	ID      string
+	Name    string // empty for anonymous sessions
+	PIN     string `json:",omitempty"` // hash of the PIN of a named session (see pinHash)
+	Created time.Time
+	Updated time.Time
+	Last    string          // last page visited, as "<lesson>/<page>"
+	Visited map[string]bool // pages visited, as "<lesson>/<page>"
+	Runs    map[string]int  // runs per program, as "<lesson>/<page>/<file>"
+	Passes  map[string]int  // successful runs per program
+}
+
+func newSession(name string) *session {
+	now := time.Now()
+	return &session{
+		ID:      newSessionID(),
+		Name:    name,
+		Created: now,
+		Updated: now,
+		Visited: make(map[string]bool),
+		Runs:    make(map[string]int),
+		Passes:  make(map[string]int),
+	}
+}
+
+func newSessionID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// pinHash returns the hash of the PIN of the session of the given ID, as
+// stored.
+func pinHash(id, pin string) string {
+	h := sha256.Sum256([]byte(id + "\x00" + pin))
+	return hex.EncodeToString(h[:])
+}
+
+// setPIN sets the PIN that resumes ss on another browser.
+func (ss *session) setPIN(pin string) {
+	ss.PIN = pinHash(ss.ID, pin)
+}
+
+// checkPIN reports whether pin is the PIN of ss. Sessions named before
+// PINs were kept have none, and cannot be resumed on another browser.
+func (ss *session) checkPIN(pin string) bool {
+	return ss.PIN != "" && subtle.ConstantTimeCompare([]byte(ss.PIN), []byte(pinHash(ss.ID, pin))) == 1
+}
+
+// A progressStore holds the sessions and persists them to a JSON file.
+type progressStore struct {
+	file  string
+	admin string // password of the admin page; empty for local access only
+	tmpl  *template.Template
+
+	mu       sync.Mutex
+	sessions map[string]*session // by ID
+}
+
+// progress is the store of the server, or nil if progress is not kept.
+var progress *progressStore
+
+// initProgress loads the sessions stored in the named file, if it exists,
+// and registers the /progress/ handlers.
+func initProgress(fs vfs.FileSystem, file, admin string) error {
+	tmpl := template.New("").Funcs(template.FuncMap{
+		"percent": func(f float64) string { return strconv.Itoa(int(f*100+0.5)) + "%" },
+	})
+	if err := parseTemplate(tmpl, fs, "/template/progress.tmpl"); err != nil {
+		return fmt.Errorf("parse progress.tmpl: %v", err)
+	}
+	s := &progressStore{
+		file:     file,
+		admin:    admin,
+		tmpl:     tmpl,
+		sessions: make(map[string]*session),
+	}
+	data, err := ioutil.ReadFile(file)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		var list []*session
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		for _, ss := range list {
+			s.sessions[ss.ID] = ss
+		}
+	}
+	progress = s
+
+	http.HandleFunc("/progress/session", s.handleSession)
+	http.HandleFunc("/progress/visit", s.handleVisit)
+	http.HandleFunc("/progress/run", s.handleRun)
+	http.HandleFunc("/progress/admin", s.handleAdmin)
+	return nil
+}
+
+// save writes the sessions to the store's file. s.mu must be held.
+func (s *progressStore) save() error {
+	list := make([]*session, 0, len(s.sessions))
+	for _, ss := range s.sessions {
+		list = append(list, ss)
+	}
+	sort.Sort(sessionsByCreated(list))
+	data, err := json.MarshalIndent(list, "", "\t")
+	if err != nil {
+		return err
+	}
+	// Write a temporary file and rename it, so that a crash never leaves
+	// a truncated store behind.
+	tmp := s.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
+
+type sessionsByCreated []*session
+
+func (s sessionsByCreated) Len() int           { return len(s) }
+func (s sessionsByCreated) Less(i, j int) bool { return s[i].Created.Before(s[j].Created) }
+func (s sessionsByCreated) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// current returns the session of the request, or nil if it has none.
+// s.mu must be held.
+func (s *progressStore) current(r *http.Request) *session {
+	c, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return nil
+	}
+	return s.sessions[c.Value]
+}
+
+// use makes ss the session of the client. s.mu must be held.
+func (s *progressStore) use(w http.ResponseWriter, ss *session) {
+	s.sessions[ss.ID] = ss
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    ss.ID,
+		Path:     "/",
+		Expires:  time.Now().AddDate(1, 0, 0),
+		HttpOnly: true,
+	})
+}
+
+// lastPage returns the last page visited in the session of the request, or
+// the empty string.
+func (s *progressStore) lastPage(r *http.Request) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ss := s.current(r); ss != nil {
+		return ss.Last
+	}
+	return ""
+}
+
+// sessionInfo is the JSON form of a session sent to the front end.
+type sessionInfo struct {
+	ID      string
+	Name    string
+	Last    string
+	Visited []string
+}
+
+// handleSession returns the session of the client on GET. On POST, it
+// switches the client to the session with the "name" form value, if the
+// "pin" form value is its PIN, or names the current anonymous session, or a
+// new one, with the name and PIN if there is no such session. An empty name
+// switches the client to a new anonymous session.
+func (s *progressStore) handleSession(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss := s.current(r)
+	if r.Method == "POST" {
+		name, pin := strings.TrimSpace(r.FormValue("name")), r.FormValue("pin")
+		var found *session
+		if name != "" {
+			for _, other := range s.sessions {
+				if other.Name == name {
+					found = other
+					break
+				}
+			}
+		}
+		switch {
+		case found != nil && found == ss:
+			// The client holds the session already; let it give a
+			// PIN to a session named before PINs were kept.
+			if found.PIN == "" && pin != "" {
+				found.setPIN(pin)
+			}
+		case found != nil:
+			if !found.checkPIN(pin) {
+				http.Error(w, "wrong PIN for this name", http.StatusForbidden)
+				return
+			}
+			ss = found
+		case name == "":
+			ss = newSession("")
+		case pin == "":
+			http.Error(w, "a PIN is required to name a session", http.StatusBadRequest)
+			return
+		case ss != nil && ss.Name == "":
+			ss.Name = name
+			ss.setPIN(pin)
+			ss.Updated = time.Now()
+		default:
+			ss = newSession(name)
+			ss.setPIN(pin)
+		}
+		s.use(w, ss)
+		if err := s.save(); err != nil {
+			log.Printf("saving progress: %v", err)
+		}
+	}
+
+	info := sessionInfo{Visited: []string{}}
+	if ss != nil {
+		info.ID, info.Name, info.Last = ss.ID, ss.Name, ss.Last
+		for p := range ss.Visited {
+			info.Visited = append(info.Visited, p)
+		}
+		sort.Strings(info.Visited)
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(info)
+}
+
+// record calls f with the session of the client, creating an anonymous one
+// if needed, and saves the store.
+func (s *progressStore) record(w http.ResponseWriter, r *http.Request, f func(*session)) {
+	if r.Method != "POST" {
+		http.Error(w, "POST only", http.StatusMethodNotAllowed)
+		return
+	}
+	lesson := r.FormValue("lesson")
+	page, err := strconv.Atoi(r.FormValue("page"))
+	if _, ok := lessons[lesson]; !ok || err != nil || page < 1 || page > lessonPages[lesson] {
+		http.Error(w, "bad lesson or page", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss := s.current(r)
+	if ss == nil {
+		ss = newSession("")
+		s.use(w, ss)
+	}
+	f(ss)
+	ss.Updated = time.Now()
+	if err := s.save(); err != nil {
+		log.Printf("saving progress: %v", err)
+		http.Error(w, "error saving progress", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleVisit records the visit of the page given by the "lesson" and
+// "page" form values.
+func (s *progressStore) handleVisit(w http.ResponseWriter, r *http.Request) {
+	s.record(w, r, func(ss *session) {
+		p := r.FormValue("lesson") + "/" + r.FormValue("page")
+		ss.Visited[p] = true
+		ss.Last = p
+	})
+}
+
+// handleRun records a run of the program in the "file" form value of the
+// given lesson page. The "ok" form value reports whether it built and
+// exited successfully.
+func (s *progressStore) handleRun(w http.ResponseWriter, r *http.Request) {
+	s.record(w, r, func(ss *session) {
+		p := r.FormValue("lesson") + "/" + r.FormValue("page") + "/" + r.FormValue("file")
+		ss.Runs[p]++
+		if r.FormValue("ok") == "true" {
+			ss.Passes[p]++
+		}
+	})
+}
+
+// allowAdmin reports whether the request may see the admin page: it must
+// carry the admin password or, if there is none, come from this machine.
+func (s *progressStore) allowAdmin(r *http.Request) bool {
+	if s.admin != "" {
+		_, pw, ok := r.BasicAuth()
+		return ok && subtle.ConstantTimeCompare([]byte(pw), []byte(s.admin)) == 1
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// moduleStats is the completion of a module of the tour.
+type moduleStats struct {
+	Title     string
+	Pages     int     // pages in the module
+	Started   int     // sessions that visited a page of the module
+	Completed int     // sessions that visited all its pages
+	Average   float64 // mean fraction of pages visited by the started sessions
+	Runs      int     // programs run
+	Passes    int     // programs that built and exited successfully
+}
+
+// sessionStats is the progress of one session.
+type sessionStats struct {
+	Name       string // name, or short hash of the ID of an anonymous session
+	Anonymous  bool
+	Updated    time.Time
+	Last       string
+	Completion float64 // fraction of the pages of the tour visited
+	Runs       int
+	Passes     int
+}
+
+// handleAdmin serves the progress of all the sessions, per module of the
+// table of contents and per session.
+func (s *progressStore) handleAdmin(w http.ResponseWriter, r *http.Request) {
+	if !s.allowAdmin(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="gotour"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	var data struct {
+		Modules  []moduleStats
+		Sessions []sessionStats
+	}
+	total := 0
+	for _, m := range tocModules {
+		st := moduleStats{Title: m.Title}
+		for _, l := range m.Lessons {
+			st.Pages += lessonPages[l]
+		}
+		total += st.Pages
+		for _, ss := range s.sessions {
+			visited := 0
+			for _, l := range m.Lessons {
+				for p := 1; p <= lessonPages[l]; p++ {
+					if ss.Visited[l+"/"+strconv.Itoa(p)] {
+						visited++
+					}
+				}
+				st.Runs += countLesson(ss.Runs, l)
+				st.Passes += countLesson(ss.Passes, l)
+			}
+			if visited == 0 {
+				continue
+			}
+			st.Started++
+			if visited == st.Pages {
+				st.Completed++
+			}
+			st.Average += float64(visited) / float64(st.Pages)
+		}
+		if st.Started > 0 {
+			st.Average /= float64(st.Started)
+		}
+		data.Modules = append(data.Modules, st)
+	}
+	var list []*session
+	for _, ss := range s.sessions {
+		list = append(list, ss)
+	}
+	sort.Sort(sessionsByCreated(list))
+	for _, ss := range list {
+		st := sessionStats{Name: ss.Name, Updated: ss.Updated, Last: ss.Last}
+		if st.Name == "" {
+			// The ID is the cookie of the session: show only enough
+			// of its hash to tell the sessions apart.
+			h := sha256.Sum256([]byte(ss.ID))
+			st.Name, st.Anonymous = hex.EncodeToString(h[:4]), true
+		}
+		if total > 0 {
+			st.Completion = float64(len(ss.Visited)) / float64(total)
+		}
+		st.Runs = countLesson(ss.Runs, "")
+		st.Passes = countLesson(ss.Passes, "")
+		data.Sessions = append(data.Sessions, st)
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := s.tmpl.ExecuteTemplate(w, "progress.tmpl", data); err != nil {
+		log.Println(err)
+	}
+}
+
+// countLesson returns the sum of the counts of the programs of lesson, or
+// of all programs if lesson is empty.
+func countLesson(counts map[string]int, lesson string) int {
+	n := 0
+	for p, c := range counts {
+		if lesson == "" || strings.HasPrefix(p, lesson+"/") {
+			n += c
+		}
+	}
+	return n
+}
@@ -0,0 +1,98 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// postSession posts the name and PIN to the session handler of s with the
+// cookie of the given session ID, if any, and returns the response.
+func postSession(s *progressStore, id, name, pin string) *httptest.ResponseRecorder {
+	form := url.Values{"name": {name}, "pin": {pin}}
+	r := httptest.NewRequest("POST", "/progress/session", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if id != "" {
+		r.AddCookie(&http.Cookie{Name: sessionCookie, Value: id})
+	}
+	w := httptest.NewRecorder()
+	s.handleSession(w, r)
+	return w
+}
+
+func TestSessionPIN(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tour-progress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := &progressStore{
+		file:     filepath.Join(dir, "progress.json"),
+		sessions: make(map[string]*session),
+	}
+	id := func(w *httptest.ResponseRecorder) string {
+		var info sessionInfo
+		if err := json.NewDecoder(w.Body).Decode(&info); err != nil {
+			t.Fatalf("session: %v", err)
+		}
+		return info.ID
+	}
+
+	if w := postSession(s, "", "gopher", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("naming a session without a PIN: status %d, want 400", w.Code)
+	}
+	w := postSession(s, "", "gopher", "1234")
+	if w.Code != http.StatusOK {
+		t.Fatalf("naming a session: status %d, want 200", w.Code)
+	}
+	gopher := id(w)
+	if pin := s.sessions[gopher].PIN; pin == "" || strings.Contains(pin, "1234") {
+		t.Errorf("stored PIN = %q, want a hash", pin)
+	}
+
+	// Another browser.
+	if w := postSession(s, "", "gopher", "4321"); w.Code != http.StatusForbidden {
+		t.Errorf("resuming with a wrong PIN: status %d, want 403", w.Code)
+	}
+	if w := postSession(s, "", "gopher", ""); w.Code != http.StatusForbidden {
+		t.Errorf("resuming without a PIN: status %d, want 403", w.Code)
+	}
+	if w := postSession(s, "", "gopher", "1234"); w.Code != http.StatusOK || id(w) != gopher {
+		t.Errorf("resuming with the PIN: status %d, want 200 and the session", w.Code)
+	}
+
+	// A session named before PINs were kept is resumed only by its own
+	// browser, which may then give it a PIN.
+	old := newSession("old")
+	s.sessions[old.ID] = old
+	if w := postSession(s, "", "old", ""); w.Code != http.StatusForbidden {
+		t.Errorf("resuming a session without PIN: status %d, want 403", w.Code)
+	}
+	if w := postSession(s, old.ID, "old", "5678"); w.Code != http.StatusOK || id(w) != old.ID {
+		t.Errorf("giving a PIN to the own session: status %d, want 200 and the session", w.Code)
+	}
+	if w := postSession(s, "", "old", "5678"); w.Code != http.StatusOK || id(w) != old.ID {
+		t.Errorf("resuming with the new PIN: status %d, want 200 and the session", w.Code)
+	}
+}
+
+func TestAdminPassword(t *testing.T) {
+	s := &progressStore{admin: "secret"}
+	for pw, want := range map[string]bool{"secret": true, "secre": false, "": false, "secrets": false} {
+		r := httptest.NewRequest("GET", "/progress/admin", nil)
+		r.SetBasicAuth("admin", pw)
+		if got := s.allowAdmin(r); got != want {
+			t.Errorf("allowAdmin with password %q = %v, want %v", pw, got, want)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2013 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/godoc/static"
+	"golang.org/x/tools/godoc/vfs"
+	"golang.org/x/tools/present"
+)
+
+var (
+	uiContent         []byte
+	lessons           = make(map[string][]byte)
+	lessonPages       = make(map[string]int) // number of pages of each lesson
+	errLessonNotFound = errors.New("lesson not found")
+)
+
+// initTour loads the lessons and the HTML templates from the given tour
+// file system, renders the UI to the uiContent global variable and sets up
+// /script.js. Transport is the JavaScript constructor of the transport used
+// to run code and lang selects the message catalog of the UI.
+func initTour(fs vfs.FileSystem, transport, lang string) error {
+	tourFS = fs
+
+	// Make sure playground is enabled before rendering.
+	present.PlayEnabled = true
+
+	// Set up templates.
+	tmpl := present.Template().Funcs(template.FuncMap{
+		"trtitle": translatedTitle,
+	})
+	if err := parseTemplate(tmpl, fs, "/template/action.tmpl"); err != nil {
+		return fmt.Errorf("parse templates: %v", err)
+	}
+
+	// Init lessons.
+	if err := initLessons(tmpl, fs, "/content"); err != nil {
+		return fmt.Errorf("init lessons: %v", err)
+	}
+
+	// Init localized values.
+	msgs, values, err := initValues(fs, lang)
+	if err != nil {
+		return fmt.Errorf("init values: %v", err)
+	}
+
+	// Init UI
+	ui := template.New("")
+	if err := parseTemplate(ui, fs, "/template/index.tmpl"); err != nil {
+		return fmt.Errorf("parse index.tmpl: %v", err)
+	}
+	buf := new(bytes.Buffer)
+
+	data := struct {
+		SocketAddr string
+		Transport  template.JS
+		Lang       string
+		Messages   catalog
+	}{"", template.JS(transport), strings.Replace(lang, "_", "-", -1), msgs}
+
+	if err := ui.ExecuteTemplate(buf, "index.tmpl", data); err != nil {
+		return fmt.Errorf("render UI: %v", err)
+	}
+	uiContent = buf.Bytes()
+
+	return initScript(fs, values)
+}
+
+// initLessons finds all the lessons in the passed directory of fs, renders
+// them, using the given template and saves the content in the lessons map.
+func initLessons(tmpl *template.Template, fs vfs.FileSystem, content string) error {
+	fis, err := fs.ReadDir(content)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		f := fi.Name()
+		if path.Ext(f) != ".article" {
+			continue
+		}
+		content, pages, err := parseLesson(tmpl, fs, path.Join(content, f))
+		if err != nil {
+			return fmt.Errorf("parsing %v: %v", f, err)
+		}
+		name := strings.TrimSuffix(f, ".article")
+		lessons[name] = content
+		lessonPages[name] = pages
+	}
+	return nil
+}
+
+// File defines the JSON form of a code file in a page.
+type File struct {
+	Name    string
+	Content string
+	Hash    string
+	Check   bool // the exercise has checks; see check.go
+}
+
+// Page defines the JSON form of a tour lesson page.
+type Page struct {
+	Title   string
+	TrTitle string // translated title of a bilingual page
+	Content string
+	Files   []File
+}
+
+// Lesson defines the JSON form of a tour lesson.
+type Lesson struct {
+	Title       string
+	Description string
+	Pages       []Page
+}
+
+// parseLesson parses the lesson in the named file of fs and returns its
+// JSON form, with the pages rendered by tmpl, and its number of pages.
+func parseLesson(tmpl *template.Template, fs vfs.FileSystem, file string) ([]byte, int, error) {
+	f, err := fs.Open(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	// The files included by the lesson are found in fs as well.
+	ctx := &present.Context{ReadFile: func(name string) ([]byte, error) {
+		return vfs.ReadFile(fs, filepath.ToSlash(name))
+	}}
+	doc, err := ctx.Parse(f, file, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	name := strings.TrimSuffix(path.Base(file), ".article")
+	lesson := Lesson{
+		doc.Title,
+		doc.Subtitle,
+		make([]Page, len(doc.Sections)),
+	}
+
+	for i, sec := range doc.Sections {
+		p := &lesson.Pages[i]
+		w := new(bytes.Buffer)
+		if err := sec.Render(w, tmpl); err != nil {
+			return nil, 0, fmt.Errorf("render section: %v", err)
+		}
+		p.Title = sec.Title
+		p.TrTitle = translatedTitle(sec.Elem)
+		p.Content = w.String()
+		codes := findPlayCode(sec)
+		p.Files = make([]File, len(codes))
+		for i, c := range codes {
+			f := &p.Files[i]
+			f.Name = c.FileName
+			f.Content = string(c.Raw)
+			hash := sha1.Sum(c.Raw)
+			f.Hash = base64.StdEncoding.EncodeToString(hash[:])
+			f.Check = hasCheck(name, c.FileName)
+		}
+	}
+
+	w := new(bytes.Buffer)
+	if err := json.NewEncoder(w).Encode(lesson); err != nil {
+		return nil, 0, fmt.Errorf("encode lesson: %v", err)
+	}
+	return w.Bytes(), len(lesson.Pages), nil
+}
+
+// findPlayCode returns a slide with all the Code elements in the given
+// Elem with Play set to true.
+func findPlayCode(e present.Elem) []*present.Code {
+	var r []*present.Code
+	switch v := e.(type) {
+	case present.Code:
+		if v.Play {
+			r = append(r, &v)
+		}
+	case present.Section:
+		for _, s := range v.Elem {
+			r = append(r, findPlayCode(s)...)
+		}
+	}
+	return r
+}
+
+// writeLesson writes the tour content to the provided Writer.
+func writeLesson(name string, w io.Writer) error {
+	if uiContent == nil {
+		panic("writeLesson called before successful initTour")
+	}
+	if len(name) == 0 {
+		return writeAllLessons(w)
+	}
+	l, ok := lessons[name]
+	if !ok {
+		return errLessonNotFound
+	}
+	_, err := w.Write(l)
+	return err
+}
+
+// writeAllLessons writes all the lessons, as a JSON object keyed by lesson
+// name, to the provided Writer.
+func writeAllLessons(w io.Writer) error {
+	var names []string
+	for name := range lessons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if _, err := fmt.Fprint(w, "{"); err != nil {
+		return err
+	}
+	for i, name := range names {
+		if i > 0 {
+			if _, err := fmt.Fprint(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%q:%s", name, lessons[name]); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, "}")
+	return err
+}
+
+// renderUI writes the tour UI to the provided Writer.
+func renderUI(w io.Writer) error {
+	if uiContent == nil {
+		panic("renderUI called before successful initTour")
+	}
+	_, err := w.Write(uiContent)
+	return err
+}
+
+// initScript concatenates all the javascript files needed to render
+// the tour UI, followed by the generated values, and serves the result on
+// /script.js. The serverProgress value tells the front end whether to
+// report progress to the server.
+func initScript(fs vfs.FileSystem, values []byte) error {
+	modTime := time.Now()
+	b := new(bytes.Buffer)
+
+	content, ok := static.Files["playground.js"]
+	if !ok {
+		return fmt.Errorf("playground.js not found in static files")
+	}
+	b.WriteString(content)
+
+	// Keep this list in dependency order
+	files := []string{
+		"/static/lib/jquery.min.js",
+		"/static/lib/jquery-ui.min.js",
+		"/static/lib/angular.min.js",
+		"/static/lib/codemirror/lib/codemirror.js",
+		"/static/lib/codemirror/mode/go/go.js",
+		"/static/lib/angular-ui.min.js",
+		"/static/js/app.js",
+		"/static/js/controllers.js",
+		"/static/js/directives.js",
+		"/static/js/services.js",
+		"/static/js/values.js",
+	}
+
+	for _, file := range files {
+		f, err := vfs.ReadFile(fs, file)
+		if err != nil {
+			return fmt.Errorf("couldn't open %v: %v", file, err)
+		}
+		_, err = b.Write(f)
+		if err != nil {
+			return fmt.Errorf("error concatenating %v: %v", file, err)
+		}
+	}
+	b.Write(values)
+	fmt.Fprintf(b, "angular.module('tour.values').value('serverProgress', %t);\n", progress != nil)
+
+	http.HandleFunc("/script.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-type", "application/javascript")
+		// Set expiration time in one week.
+		w.Header().Set("Cache-control", "max-age=604800")
+		http.ServeContent(w, r, "", modTime, bytes.NewReader(b.Bytes()))
+	})
+
+	return nil
+}
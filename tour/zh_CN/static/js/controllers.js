@@ -10,8 +10,8 @@
 angular.module('tour.controllers', []).
 
 // Navigation controller
-controller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n', 'run', 'fmt', 'editor', 'analytics', 'storage',
-    function($scope, $routeParams, $location, toc, i18n, run, fmt, editor, analytics, storage) {
+controller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n', 'run', 'check', 'fmt', 'editor', 'analytics', 'storage', 'progress',
+    function($scope, $routeParams, $location, toc, i18n, run, check, fmt, editor, analytics, storage, progress) {
         var lessons = [];
         toc.lessons.then(function(v) {
             lessons = v;
@@ -42,6 +42,7 @@ controller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n',
             var l = $routeParams.lessonId;
             if (page >= 1 && page <= lessons[$scope.lessonId].Pages.length) {
                 $scope.curPage = page;
+                progress.visit(l, page);
             } else {
                 l = (page < 1) ? toc.prevLesson(l) : toc.nextLesson(l);
                 if (l === '') { // If there's not previous or next
@@ -74,8 +75,23 @@ controller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n',
         $scope.run = function() {
             log('info', i18n.l('waiting'));
             var f = file();
+            var lesson = $scope.lessonId,
+                page = $scope.curPage;
             run(f.Content, $('.output.active > pre')[0], {
                 path: f.Name
+            }, function(ok) {
+                progress.run(lesson, page, f.Name, ok);
+            });
+        };
+
+        // check runs the hidden checks of the exercise on the current file.
+        $scope.check = function() {
+            log('info', i18n.l('waiting'));
+            var f = file();
+            var lesson = $scope.lessonId,
+                page = $scope.curPage;
+            check(f.Content, lesson + '/' + f.Name, $('.output.active > pre')[0], function(ok) {
+                progress.run(lesson, page, f.Name, ok);
             });
         };
 
@@ -99,4 +115,32 @@ controller('EditorCtrl', ['$scope', '$routeParams', '$location', 'toc', 'i18n',
             file().Content = file().OrigContent;
         };
     }
+]).
+
+// Session controller, to resume the tour on another machine.
+controller('SessionCtrl', ['$scope', 'i18n', 'progress',
+    function($scope, i18n, progress) {
+        $scope.enabled = progress.enabled;
+        $scope.session = null;
+        $scope.name = '';
+        $scope.pin = '';
+        $scope.error = '';
+
+        var show = function(data) {
+            $scope.session = data.data;
+            $scope.name = data.data.Name;
+            $scope.pin = '';
+            $scope.error = '';
+        };
+        var fail = function(data) {
+            $scope.error = i18n.l(data.status == 403 ? 'pinwrong' : 'pinrequired');
+        };
+        if (progress.enabled) {
+            progress.session().then(show);
+        }
+
+        $scope.resume = function() {
+            progress.resume($scope.name, $scope.pin).then(show, fail);
+        };
+    }
 ]);
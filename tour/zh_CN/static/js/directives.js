@@ -136,6 +136,30 @@ directive('horizontalSlide', ['editor',
     }
 ]).
 
+// language-switch shows the language of the content and changes it on
+// click, or when L is pressed outside of the editor.
+directive('languageSwitch', ['contentLang', 'i18n',
+    function(contentLang, i18n) {
+        return {
+            restrict: 'A',
+            template: '<a class="lang-switch" href="" ng-click="switchLang()">{{label()}}</a>',
+            link: function(scope) {
+                scope.label = function() {
+                    return i18n.l('lang' + contentLang.lang);
+                };
+                scope.switchLang = contentLang.next;
+                $(document).keyup(function(evt) {
+                    if ($(evt.target).closest('.CodeMirror, input, textarea').length > 0) return;
+                    var key = evt.key || evt.keyCode;
+                    if (key == 'l' || key == 76) {
+                        scope.$apply(contentLang.next);
+                    }
+                });
+            }
+        };
+    }
+]).
+
 directive('tableOfContentsButton', function() {
     var speed = 250;
     return {
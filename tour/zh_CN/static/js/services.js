@@ -24,35 +24,117 @@ factory('i18n', ['translation',
         return {
             l: function(key) {
                 if (translation[key]) return translation[key];
-                return '(no translation for ' + key + ')';
+                return key;
             }
         };
     }
 ]).
 
+// Translation filter for templates: {{'run' | i18n}}
+filter('i18n', ['i18n',
+    function(i18n) {
+        return i18n.l;
+    }
+]).
+
 // Running code
 factory('run', ['$window', 'editor',
     function(win, editor) {
-        var writeInterceptor = function(writer) {
+        // The playground transport passes on only the text of the build
+        // errors; keep the diagnostics of the last reply of /compile, see
+        // internal/sandbox/diag.go, to show their explanations.
+        var diagnostics = [];
+        $.ajaxPrefilter('json', function(options) {
+            if (options.url != '/compile') return;
+            options.dataFilter = function(text) {
+                try {
+                    diagnostics = $.parseJSON(text).Diagnostics || [];
+                } catch (e) {
+                    diagnostics = [];
+                }
+                return text;
+            };
+        });
+        // writeInterceptor highlights the lines with errors and calls done,
+        // if given, with whether the program built and exited successfully.
+        var writeInterceptor = function(writer, done) {
+            var finished = false;
+            var finish = function(ok) {
+                if (!finished && done) done(ok);
+                finished = true;
+            };
             return function(write) {
+                if (write.Kind == 'start') {
+                    finished = false;
+                } else if (write.Kind == 'end') {
+                    // The body of the end event holds the exit status or
+                    // the timeout error.
+                    finish(!write.Body);
+                } else if (write.Kind == 'system') {
+                    // Build failures end with a system event.
+                    finish(false);
+                }
                 if (write.Kind == 'stderr') {
-                    var lines = write.Body.split('\n');
-                    for (var i in lines) {
-                        var match = lines[i].match(/.*\.go:([0-9]+): ([^\n]*)/);
-                        if (match !== null) {
-                            editor.highlight(match[1], match[2]);
-                        }
-                    }
+                    editor.highlightErrors(write.Body, diagnostics);
                 }
                 writer(write);
             };
         };
-        return function(code, output, options) {
+        return function(code, output, options, done) {
             // PlaygroundOutput is defined in playground.js which is prepended
             // to the generated script.js in gotour/tour.go.
             // The next line removes the jshint warning.
             // global PlaygroundOutput
-            win.transport.Run(code, writeInterceptor(PlaygroundOutput(output)), options);
+            win.transport.Run(code, writeInterceptor(PlaygroundOutput(output), done), options);
+        };
+    }
+]).
+
+// Checking the solution of an exercise with its hidden checks; see
+// gotour/check.go. The reply is that of a program run, with the result of
+// each check.
+factory('check', ['$window', 'i18n', 'editor',
+    function(win, i18n, editor) {
+        return function(code, exercise, output, done) {
+            var write = PlaygroundOutput(output);
+            $.ajax('/compile', {
+                type: 'POST',
+                data: {
+                    'version': 2,
+                    'body': code,
+                    'check': exercise
+                },
+                dataType: 'json',
+                success: function(data) {
+                    write({Kind: 'start'});
+                    if (data.Errors && !data.IsTest) {
+                        editor.highlightErrors(data.Errors, data.Diagnostics || []);
+                        write({Kind: 'stderr', Body: data.Errors});
+                        write({Kind: 'system', Body: '\nGo build failed.'});
+                        done(false);
+                        return;
+                    }
+                    for (var i = 0; i < data.Tests.length; i++) {
+                        var t = data.Tests[i];
+                        write({
+                            Kind: t.Passed ? 'stdout' : 'stderr',
+                            Body: (t.Passed ? '✓ ' : '✗ ') + t.Name + '\n'
+                        });
+                    }
+                    var ok = data.TestsFailed === 0;
+                    write({
+                        Kind: 'system',
+                        Body: '\n' + (ok ? i18n.l('checkpass') : i18n.l('checkfail').replace('{n}', data.TestsFailed))
+                    });
+                    done(ok);
+                },
+                error: function() {
+                    write({Kind: 'start'});
+                    write({Kind: 'stderr', Body: i18n.l('errcomm')});
+                    write({Kind: 'end'});
+                    done(false);
+                }
+            });
         };
     }
 ]).
@@ -74,6 +156,55 @@ factory('fmt', ['$http',
     }
 ]).
 
+// Progress kept by the tour server, if it keeps it; see gotour/progress.go.
+factory('progress', ['$http', '$log', 'serverProgress',
+    function($http, $log, serverProgress) {
+        var headers = {
+            'Content-Type': 'application/x-www-form-urlencoded'
+        };
+        var post = function(path, params) {
+            return $http.post('/progress/' + path, $.param(params), {
+                headers: headers
+            });
+        };
+        var report = function(path, params) {
+            if (!serverProgress) return;
+            post(path, params).then(null, function(error) {
+                $log.error('error reporting progress: ', error);
+            });
+        };
+        return {
+            enabled: serverProgress,
+            // session returns a promise of the session of this browser.
+            session: function() {
+                return $http.get('/progress/session');
+            },
+            // resume switches to the session with the given name and PIN,
+            // creating it if needed, and returns a promise of it.
+            resume: function(name, pin) {
+                return post('session', {
+                    name: name,
+                    pin: pin
+                });
+            },
+            visit: function(lesson, page) {
+                report('visit', {
+                    lesson: lesson,
+                    page: page
+                });
+            },
+            run: function(lesson, page, file, ok) {
+                report('run', {
+                    lesson: lesson,
+                    page: page,
+                    file: file,
+                    ok: ok
+                });
+            }
+        };
+    }
+]).
+
 // Local storage, persistent to page refreshing.
 factory('storage', ['$window',
     function(win) {
@@ -99,9 +230,49 @@ factory('storage', ['$window',
     }
 ]).
 
+// Language of the content of bilingual pages: 'en', 'zh' or 'both'. The
+// choice is kept in local storage, so it persists across lessons and
+// visits, and is shown by a class on the body: lang-en, lang-zh or
+// lang-both.
+factory('contentLang', ['storage',
+    function(storage) {
+        var langs = ['zh', 'en', 'both'];
+        var ctx = {
+            lang: storage.get('contentLang') || 'zh',
+            set: function(lang) {
+                ctx.lang = lang;
+                storage.set('contentLang', lang);
+                $('body').removeClass('lang-en lang-zh lang-both').addClass('lang-' + lang);
+            },
+            next: function() {
+                ctx.set(langs[(langs.indexOf(ctx.lang) + 1) % langs.length]);
+            }
+        };
+        if (langs.indexOf(ctx.lang) < 0) ctx.lang = 'zh';
+        ctx.set(ctx.lang);
+        return ctx;
+    }
+]).
+
+// Title of a page in the chosen language: {{page | pageTitle}}
+filter('pageTitle', ['contentLang',
+    function(contentLang) {
+        return function(page) {
+            if (!page) return '';
+            if (!page.TrTitle || contentLang.lang == 'en') return page.Title;
+            if (contentLang.lang == 'both') return page.TrTitle + ' (' + page.Title + ')';
+            return page.TrTitle;
+        };
+    }
+]).
+
 // Editor context service, kept through the whole app.
 factory('editor', ['$window', 'storage',
     function(win, storage) {
+        // Line widgets showing the explanations of errors, and the lines
+        // and explanations they show.
+        var widgets = [];
+        var explained = [];
         var ctx = {
             syntax: storage.get('syntax') === 'true',
             toggleSyntax: function() {
@@ -125,12 +296,43 @@ factory('editor', ['$window', 'storage',
                 };
                 set();
             },
-            highlight: function(line, message) {
+            highlight: function(line, message, explanation) {
+                // Show the explanation below the line, once per line. This
+                // redraws the line, so it comes before the highlighting.
+                var key = line + ':' + explanation;
+                if (explanation && explained.indexOf(key) < 0 && $('.CodeMirror').length > 0) {
+                    explained.push(key);
+                    var node = $('<div class="line-explanation"></div>').text(explanation)[0];
+                    widgets.push($('.CodeMirror')[0].CodeMirror.addLineWidget(line - 1, node));
+                }
                 $('.CodeMirror-code > div:nth-child(' + line + ')')
                     .addClass('line-error').attr('title', message);
             },
+            // highlightErrors highlights the lines with the build errors in
+            // text, with the explanations of the matching diagnostics.
+            highlightErrors: function(text, diagnostics) {
+                var lines = text.split('\n');
+                for (var i in lines) {
+                    var match = lines[i].match(/.*\.go:([0-9]+):(?:[0-9]+:)? ([^\n]*)/);
+                    if (match === null) continue;
+                    var explanation = '';
+                    for (var j = 0; j < diagnostics.length; j++) {
+                        var d = diagnostics[j];
+                        if (d.Line == match[1] && d.Message.indexOf(match[2]) === 0) {
+                            explanation = d.Explanation;
+                            break;
+                        }
+                    }
+                    ctx.highlight(match[1], match[2], explanation);
+                }
+            },
             onChange: function() {
                 $('.line-error').removeClass('line-error').attr('title', null);
+                for (var i = 0; i < widgets.length; i++) {
+                    widgets[i].clear();
+                }
+                widgets = [];
+                explained = [];
             }
         };
         // Set in the window so the onChange function in the codemirror config
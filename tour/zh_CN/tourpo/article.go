@@ -0,0 +1,194 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// Lines of a bilingual page, as described in gotour/bilingual.go.
+const (
+	beginEN    = ".html _tr/div_begin_en.html"
+	beginZH    = ".html _tr/div_begin_zh_CN.html"
+	endSection = ".html _tr/div_end.html"
+	titleCmd   = ".title"
+)
+
+// An article is a lesson of the tour, split into pages.
+type article struct {
+	name   string
+	header []string // lines before the first page
+	pages  []*page
+}
+
+// A page is a slide of a lesson. Its lines are, in order, the heading,
+// blank lines, the body, and the tail: the programs of the page and blank
+// lines. The body of a bilingual page is written in the sections of both
+// languages.
+type page struct {
+	line    int // line number of the heading
+	title   string
+	lead    []string // blank lines after the heading
+	body    []string
+	trTitle string
+	trBody  []string
+	tail    []string
+}
+
+// bilingual reports whether p has a translation.
+func (p *page) bilingual() bool {
+	return p.trTitle != "" || len(p.trBody) > 0
+}
+
+func isHeading(line string) bool {
+	return strings.HasPrefix(line, "* ")
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// isTail reports whether line may be part of the tail of a page.
+func isTail(line string) bool {
+	return isBlank(line) || strings.HasPrefix(line, ".play ") || strings.HasPrefix(line, ".code ")
+}
+
+// readArticle reads and parses the named article.
+func readArticle(name string) (*article, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	a, err := parseArticle(name, string(data))
+	if err != nil {
+		return nil, err
+	}
+	// Merging the article's own translation must give back the article,
+	// or translations could not be merged without other changes.
+	if out := a.bytes(); !bytes.Equal(out, data) {
+		return nil, fmt.Errorf("%s: unsupported layout: the article cannot be written back unchanged", name)
+	}
+	return a, nil
+}
+
+// parseArticle parses the text of the named article.
+func parseArticle(name, text string) (*article, error) {
+	lines := strings.Split(text, "\n")
+	a := &article{name: name}
+	i := 0
+	for i < len(lines) && !isHeading(lines[i]) {
+		i++
+	}
+	a.header = lines[:i]
+	for i < len(lines) {
+		j := i + 1
+		for j < len(lines) && !isHeading(lines[j]) {
+			j++
+		}
+		p, err := parsePage(lines[i:j])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, i+1, err)
+		}
+		p.line = i + 1
+		a.pages = append(a.pages, p)
+		i = j
+	}
+	return a, nil
+}
+
+// parsePage parses the lines of a page, from its heading to the next one.
+func parsePage(lines []string) (*page, error) {
+	p := &page{title: strings.TrimSpace(strings.TrimPrefix(lines[0], "* "))}
+	lines = lines[1:]
+	i := 0
+	for i < len(lines) && isBlank(lines[i]) {
+		i++
+	}
+	p.lead, lines = lines[:i], lines[i:]
+
+	if len(lines) == 0 || lines[0] != beginEN {
+		j := len(lines)
+		for j > 0 && isTail(lines[j-1]) {
+			j--
+		}
+		p.body, p.tail = lines[:j], lines[j:]
+		return p, nil
+	}
+
+	// A bilingual page.
+	en, rest, err := section(lines, beginEN)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < 2 || !isBlank(rest[0]) || rest[1] != beginZH {
+		return nil, fmt.Errorf("English section not followed by a Chinese one after a blank line")
+	}
+	zh, rest, err := section(rest[1:], beginZH)
+	if err != nil {
+		return nil, err
+	}
+	if len(zh) > 0 && strings.HasPrefix(zh[0], titleCmd+" ") {
+		if len(zh) == 1 || !isBlank(zh[1]) {
+			return nil, fmt.Errorf("%s not followed by a blank line", titleCmd)
+		}
+		p.trTitle = strings.TrimSpace(strings.TrimPrefix(zh[0], titleCmd))
+		zh = zh[2:]
+	}
+	for _, l := range rest {
+		if !isTail(l) {
+			return nil, fmt.Errorf("text after the Chinese section: %q", l)
+		}
+	}
+	p.body, p.trBody, p.tail = en, zh, rest
+	return p, nil
+}
+
+// section returns the lines of the section that starts lines with the
+// begin line, without the blank lines that surround them, and the lines
+// after the section.
+func section(lines []string, begin string) (body, rest []string, err error) {
+	end := -1
+	for i, l := range lines {
+		if l == endSection {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, nil, fmt.Errorf("%s is never closed", begin)
+	}
+	body = lines[1:end]
+	if len(body) < 2 || !isBlank(body[0]) || !isBlank(body[len(body)-1]) {
+		return nil, nil, fmt.Errorf("%s section must start and end with a blank line", begin)
+	}
+	return body[1 : len(body)-1], lines[end+1:], nil
+}
+
+// bytes returns the text of the article.
+func (a *article) bytes() []byte {
+	lines := append([]string(nil), a.header...)
+	for _, p := range a.pages {
+		lines = append(lines, "* "+p.title)
+		lines = append(lines, p.lead...)
+		if !p.bilingual() {
+			lines = append(lines, p.body...)
+			lines = append(lines, p.tail...)
+			continue
+		}
+		lines = append(lines, beginEN, "")
+		lines = append(lines, p.body...)
+		lines = append(lines, "", endSection, "", beginZH, "")
+		if p.trTitle != "" {
+			lines = append(lines, titleCmd+" "+p.trTitle, "")
+		}
+		lines = append(lines, p.trBody...)
+		lines = append(lines, "", endSection)
+		lines = append(lines, p.tail...)
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,243 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command tourpo extracts the pages of the tour lessons into PO files for
+// translators and merges the translations back into the lessons.
+//
+// Usage:
+//
+//	tourpo extract [-po=dir] [lesson.article ...]
+//	tourpo merge [-po=dir] [lesson.article ...]
+//
+// With no arguments, tourpo works on the lessons in tour/zh_CN/content,
+// found in the current directory or its parent.
+//
+// Extract writes, for each lesson, the file <lesson>.po in the PO
+// directory, with a translation unit for the title and one for the body of
+// each page. The context of a unit, such as "basics/3/title", names the
+// lesson, the page and the part of the page; the translation of a bilingual
+// page is the translation of its units.
+//
+// Merge rewrites each lesson with the translations of its PO file, making
+// the translated pages bilingual (see gotour/bilingual.go). Untranslated
+// units leave the page as it is. Units whose English text differs from
+// that of the lesson are stale and are not merged; the same goes for fuzzy
+// units. Stale and fuzzy units are reported.
+//
+// Extracting a lesson and merging the result gives back the same lesson,
+// byte for byte; tourpo refuses to work on lessons it could not write back
+// unchanged.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golang-china/golangdoc.translations/internal/po"
+)
+
+var poDir = flag.String("po", "", "directory of the PO files (default: i18n/content next to the lessons)")
+
+// lang is the language of the translations.
+const lang = "zh_CN"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: tourpo extract|merge [-po=dir] [lesson.article ...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("tourpo: ")
+	flag.Usage = usage
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+	flag.CommandLine.Parse(os.Args[2:])
+
+	files := flag.Args()
+	if len(files) == 0 {
+		var err error
+		if files, err = defaultLessons(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	dir := *poDir
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(files[0]), "..", "i18n", "content")
+	}
+
+	var run func(a *article, poFile string) error
+	switch cmd {
+	case "extract":
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			log.Fatal(err)
+		}
+		run = extract
+	case "merge":
+		run = merge
+	default:
+		usage()
+	}
+	failed := false
+	for _, name := range files {
+		a, err := readArticle(name)
+		if err == nil {
+			err = run(a, filepath.Join(dir, lessonName(name)+".po"))
+		}
+		if err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// defaultLessons returns the lessons of the tour in the current directory
+// or its parent.
+func defaultLessons() ([]string, error) {
+	for _, dir := range []string{".", ".."} {
+		files, err := filepath.Glob(filepath.Join(dir, "content", "*.article"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) > 0 {
+			return files, nil
+		}
+	}
+	return nil, fmt.Errorf("no lessons found; run tourpo in tour/zh_CN or name the lessons")
+}
+
+func lessonName(file string) string {
+	return strings.TrimSuffix(filepath.Base(file), ".article")
+}
+
+// context returns the context of a part of the nth page of lesson.
+func context(lesson string, n int, part string) string {
+	return lesson + "/" + strconv.Itoa(n) + "/" + part
+}
+
+// extract writes the translation units of a to the named PO file.
+func extract(a *article, poFile string) error {
+	lesson := lessonName(a.name)
+	f := &po.File{Header: po.Header(lang)}
+	for i, p := range a.pages {
+		ref := po.Ref(filepath.Base(a.name), p.line)
+		f.Units = append(f.Units, &po.Unit{
+			Refs:    []string{ref},
+			Context: context(lesson, i+1, "title"),
+			ID:      p.title,
+			Str:     p.trTitle,
+		})
+		if len(p.body) == 0 {
+			continue
+		}
+		f.Units = append(f.Units, &po.Unit{
+			Extracted: []string{"Text in present format: keep the links, the code and the directives."},
+			Refs:      []string{ref},
+			Context:   context(lesson, i+1, "body"),
+			ID:        strings.Join(p.body, "\n"),
+			Str:       strings.Join(p.trBody, "\n"),
+		})
+	}
+	var b bytes.Buffer
+	if err := po.Write(&b, f); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(poFile, b.Bytes(), 0644)
+}
+
+// merge merges the translations of the named PO file into a and writes a
+// back if it changed.
+func merge(a *article, poFile string) error {
+	r, err := os.Open(poFile)
+	if err != nil {
+		return err
+	}
+	f, err := po.Read(r)
+	r.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %v", poFile, err)
+	}
+	units := f.Index()
+	// Units are also found by their English text, so that translations
+	// survive pages being added or removed before them.
+	byID := make(map[string]*po.Unit)
+	dup := make(map[string]bool)
+	for _, u := range f.Units {
+		if byID[u.ID] != nil {
+			dup[u.ID] = true
+		}
+		byID[u.ID] = u
+	}
+
+	lesson := lessonName(a.name)
+	// translation returns the translation of the English text id, found
+	// by context, and whether there is a usable one.
+	translation := func(ctx, id string) (string, bool) {
+		u := units[ctx]
+		if u == nil || u.ID != id {
+			if u != nil && u.Str != "" {
+				log.Printf("%s: %s: stale translation: the English text changed", poFile, ctx)
+			}
+			u = byID[id]
+			if u == nil || dup[id] {
+				return "", false
+			}
+		}
+		if u.Str == "" {
+			return "", false
+		}
+		if u.Fuzzy() {
+			log.Printf("%s: %s: fuzzy translation not merged", poFile, u.Context)
+			return "", false
+		}
+		for _, l := range strings.Split(u.Str, "\n") {
+			if isHeading(l) || l == beginEN || l == beginZH || l == endSection {
+				log.Printf("%s: %s: translation not merged: line %q would break the page", poFile, u.Context, l)
+				return "", false
+			}
+		}
+		if strings.Contains(u.Context, "/title") && strings.Contains(u.Str, "\n") {
+			log.Printf("%s: %s: translation not merged: titles are one line", poFile, u.Context)
+			return "", false
+		}
+		return u.Str, true
+	}
+
+	for i, p := range a.pages {
+		// Keep the translations of the article that have no unit.
+		title, body := p.trTitle, p.trBody
+		if s, ok := translation(context(lesson, i+1, "title"), p.title); ok {
+			title = s
+		}
+		if len(p.body) > 0 {
+			if s, ok := translation(context(lesson, i+1, "body"), strings.Join(p.body, "\n")); ok {
+				body = strings.Split(s, "\n")
+			}
+		}
+		p.trTitle, p.trBody = title, body
+	}
+
+	old, err := ioutil.ReadFile(a.name)
+	if err != nil {
+		return err
+	}
+	out := a.bytes()
+	if bytes.Equal(old, out) {
+		return nil
+	}
+	return ioutil.WriteFile(a.name, out, 0644)
+}